@@ -0,0 +1,162 @@
+package all
+
+import (
+	"context"
+	"time"
+
+	commentdao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	videodao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// devPageTokenSecret signs the page tokens in dev mode, where the tokens need not survive restarts
+const devPageTokenSecret = "dev-mode-page-token-secret"
+
+// debugSeed, debugVideos and debugComments are the fixtures seeded in debug mode, the seed is fixed so the
+// IDs of the videos and the comments stay the same on every restart
+const (
+	debugSeed     = 1
+	debugVideos   = 20
+	debugComments = 20
+)
+
+func newDevCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dev",
+		Short: "starts all the services in one process without any infrastructure for local development",
+		RunE:  runDev,
+	}
+}
+
+type DevArgs struct {
+	GRPCAddr                   string `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
+	HTTPAddr                   string `long:"http_addr" env:"HTTP_ADDR" default:":8080"`
+	DataDir                    string `long:"data_dir" env:"DATA_DIR" description:"the directory storing the uploaded objects" default:".dev"`
+	Debug                      bool   `long:"debug" env:"DEBUG" description:"log every request at debug level and seed the fixture videos and comments of the default tenant"`
+	runkit.GracefulConfig      `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig        `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig       `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig       `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	serverkit.GrpcServerConfig `group:"grpc_server" namespace:"grpc_server" env-namespace:"GRPC_SERVER"`
+	grpckit.GrpcWebConfig      `group:"grpc_web" namespace:"grpc_web" env-namespace:"GRPC_WEB"`
+	configkit.FileConfig
+}
+
+// runDev runs the modules on the in-memory DAOs, the local storage and the in-process event bus instead of
+// PostgreSQL, MongoDB, Redis, MinIO and Kafka, so they run without docker-compose. The data except the uploaded
+// objects are lost on exit.
+func runDev(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args DevArgs
+	config := configkit.Load(&args)
+	args.applyDebug()
+	// dev mode runs without mTLS unless configured, then the peers cannot be granted scopes by their SPIFFE IDs
+	if args.GrpcServerConfig.TLS.CAFile == "" {
+		args.GrpcServerConfig.Authz.AllowAll = true
+	}
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(DevArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		nextArgs := next.(*DevArgs)
+		nextArgs.applyDebug()
+		logger.SetLevel(nextArgs.LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	// the video events are sent to and consumed from the same topic, like the video topic of docker-compose
+	eventBus := eventkit.NewMemoryBus("video")
+	lifecycle.OnClose("event bus", eventBus.Close)
+
+	commentDAO := commentdao.NewMemoryCommentDAO()
+
+	m := &modules{
+		commentDAO:          commentDAO,
+		commentPubSub:       commentdao.NewMemoryCommentPubSub(),
+		commentDraftDAO:     commentdao.NewMemoryCommentDraftDAO(commentdao.DefaultCommentDraftTTL),
+		commentLikeCounter:  commentdao.NewMemoryCommentLikeCounterDAO(commentDAO),
+		userBlockDAO:        commentdao.NewMemoryUserBlockDAO(),
+		userReputationDAO:   commentdao.NewMemoryUserReputationDAO(),
+		bannedPatternDAO:    commentdao.NewMemoryBannedPatternDAO(),
+		bannedPatternPubSub: commentdao.NewMemoryBannedPatternPubSub(),
+		videoDAO:            videodao.NewMemoryVideoDAO(),
+		pollDAO:             videodao.NewMemoryPollDAO(),
+		pollVoteDAO:         videodao.NewMemoryPollVoteDAO(),
+		thumbnailStatsDAO:   videodao.NewMemoryThumbnailStatsDAO(),
+		watchHistoryDAO:     videodao.NewMemoryWatchHistoryDAO(),
+		watchProgressPubSub: videodao.NewMemoryWatchProgressPubSub(),
+		userSettingsDAO:     videodao.NewMemoryUserSettingsDAO(),
+		claimDAO:            videodao.NewMemoryClaimDAO(),
+		storage:             storagekit.NewLocalStorage(ctx, &storagekit.LocalConfig{Dir: args.DataDir, Bucket: "videos"}),
+		producer:            eventBus,
+		consumer:            eventBus,
+		pageTokens:          pagekit.NewCodec(ctx, &pagekit.Config{Secret: devPageTokenSecret, TTL: 24 * time.Hour}),
+		// the only process runs the jobs without locks, jitters or metrics
+		scheduler: scheduler.NewScheduler(ctx, &scheduler.SchedulerConfig{}, nonrecording.NewNoopMeterProvider().Meter("")),
+	}
+
+	if args.Debug {
+		ctx := tenantkit.WithTenantID(ctx, tenantkit.DefaultTenantID)
+		videos, comments, err := seedTenant(ctx, fixturekit.NewGenerator(debugSeed).Fork(tenantkit.DefaultTenantID), m.videoDAO, m.commentDAO, debugVideos, debugComments)
+		if err != nil {
+			logger.Fatal("failed to seed tenant", zap.Error(err), zap.String("tenant_id", tenantkit.DefaultTenantID))
+		}
+
+		logger.Info("debug mode is enabled, the server reflection is served for grpcurl and grpcui",
+			zap.String("grpc_addr", args.GRPCAddr), zap.Int("videos", videos), zap.Int("comments", comments))
+	}
+
+	conf := &serverConfig{
+		grpcAddr:   args.GRPCAddr,
+		httpAddr:   args.HTTPAddr,
+		grpcServer: &args.GrpcServerConfig,
+		grpcWeb:    &args.GrpcWebConfig,
+	}
+
+	return lifecycle.Run(serveModules(ctx, lifecycle, adminServer, conf, m))
+}
+
+// applyDebug sets the logger and the server to log every request at debug level in debug mode. The redacted
+// fields stay redacted, so the logs read like the ones of the deployments.
+func (args *DevArgs) applyDebug() {
+	if !args.Debug {
+		return
+	}
+
+	args.LoggerConfig.Level = logkit.LoggerLevel(zapcore.DebugLevel)
+	args.LoggerConfig.Development = true
+	args.GrpcServerConfig.LogRequests = true
+}
@@ -0,0 +1,16 @@
+package all
+
+import "github.com/spf13/cobra"
+
+func NewAllCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all [mode]",
+		Short: "start all the services in one process",
+	}
+
+	cmd.AddCommand(newServeCommand())
+	cmd.AddCommand(newDevCommand())
+	cmd.AddCommand(newSeedCommand())
+
+	return cmd
+}
@@ -0,0 +1,238 @@
+package all
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	commentdao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	commentpbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	commentservice "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/service"
+	videodao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/gateway"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	videoservice "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/service"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/stream"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/client"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// inProcessTarget is the target of the in-process connections, which are dialed through the buffer listener
+const inProcessTarget = "in-process"
+
+// inProcessBufferSize is the buffer size of the in-process connections
+const inProcessBufferSize = 1 << 20
+
+// modules are the backends of the modules, either the infrastructure or the in-memory alternatives of dev mode.
+type modules struct {
+	commentDAO          commentdao.CommentDAO
+	commentPubSub       commentdao.CommentPubSub
+	commentDraftDAO     commentdao.CommentDraftDAO
+	commentLikeCounter  commentdao.CommentLikeCounterDAO
+	userBlockDAO        commentdao.UserBlockDAO
+	userReputationDAO   commentdao.UserReputationDAO
+	bannedPatternDAO    commentdao.BannedPatternDAO
+	bannedPatternPubSub commentdao.BannedPatternPubSub
+	videoDAO            videodao.VideoDAO
+	pollDAO             videodao.PollDAO
+	pollVoteDAO         videodao.PollVoteDAO
+	thumbnailStatsDAO   videodao.ThumbnailStatsDAO
+	watchHistoryDAO     videodao.WatchHistoryDAO
+	// watchHistoryFlusher is nil if the watch history is not kept in a hot store
+	watchHistoryFlusher videodao.WatchHistoryFlusher
+	watchProgressPubSub videodao.WatchProgressPubSub
+	userSettingsDAO     videodao.UserSettingsDAO
+	claimDAO            videodao.ClaimDAO
+	storage             storagekit.Storage
+	producer            eventkit.Producer
+	consumer            eventkit.Consumer
+	pageTokens          *pagekit.Codec
+	// scheduler runs the background jobs of the modules while they are served
+	scheduler *scheduler.Scheduler
+}
+
+// serverConfig is where all the services are served.
+type serverConfig struct {
+	grpcAddr   string
+	httpAddr   string
+	grpcServer *serverkit.GrpcServerConfig
+	grpcWeb    *grpckit.GrpcWebConfig
+}
+
+// serveModules serves the comment and video services on one gRPC server along with the gateway of both,
+// and consumes the video events. The services call each other, and the gateway calls them, through
+// in-process connections, so the requests skip the network but still pass the interceptors of both sides.
+func serveModules(ctx context.Context, lifecycle *runkit.Lifecycle, adminServer *adminkit.AdminServer, conf *serverConfig, m *modules, opts ...serverkit.GrpcServerOption) runkit.GracefulRunFunc {
+	logger := logkit.FromContext(ctx)
+
+	inProcessLis := bufconn.Listen(inProcessBufferSize)
+	dialOpts := []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return inProcessLis.DialContext(ctx)
+		}),
+		grpc.WithChainUnaryInterceptor(tenantkit.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+	}
+
+	// the in-process clients have no retry budget, so they record no metrics
+	noopMeter := nonrecording.NewNoopMeterProvider().Meter("")
+
+	commentClient := client.NewCommentClient(ctx, inProcessClientConfig(), noopMeter, dialOpts...)
+	lifecycle.OnClose("comment gRPC client", commentClient.Close)
+
+	videoClient := client.NewVideoClient(ctx, inProcessClientConfig(), noopMeter, dialOpts...)
+	lifecycle.OnClose("video gRPC client", videoClient.Close)
+
+	gatewayConn := grpckit.NewGrpcClientConn(ctx, &inProcessClientConfig().GrpcClientConnConfig, dialOpts...)
+	lifecycle.OnClose("gateway gRPC client connection", gatewayConn.Close)
+
+	bannedPatternFilter := commentservice.NewBannedPatternFilter(ctx, m.bannedPatternDAO, m.bannedPatternPubSub)
+	lifecycle.OnClose("banned pattern filter", bannedPatternFilter.Close)
+
+	commentSvc := commentservice.NewService(m.commentDAO, m.commentPubSub, videoClient, m.storage,
+		commentservice.WithBannedPatterns(m.bannedPatternDAO, bannedPatternFilter),
+		commentservice.WithCommentDrafts(m.commentDraftDAO),
+		commentservice.WithUserBlocks(m.userBlockDAO),
+		// the users scored below zero cannot post links
+		commentservice.WithUserReputations(m.userReputationDAO, 0),
+		commentservice.WithLikeCounter(m.commentLikeCounter),
+		commentservice.WithPageTokens(m.pageTokens),
+	)
+	commentSvcV2 := commentservice.NewServiceV2(commentSvc, m.pageTokens)
+	pollCloser := videoservice.NewPollCloser(ctx, m.pollDAO, m.pollVoteDAO)
+	if err := pollCloser.Schedule(m.scheduler, videoservice.DefaultPollCloseSchedule); err != nil {
+		logger.Fatal("failed to schedule poll close job", zap.Error(err))
+	}
+
+	if m.watchHistoryFlusher != nil {
+		watchHistoryFlusher := videoservice.NewWatchHistoryFlusher(ctx, m.watchHistoryFlusher)
+		if err := watchHistoryFlusher.Schedule(m.scheduler, videoservice.DefaultWatchHistoryFlushSchedule); err != nil {
+			logger.Fatal("failed to schedule watch history flush job", zap.Error(err))
+		}
+	}
+
+	videoSvc := videoservice.NewService(m.videoDAO, m.storage, commentClient, m.producer,
+		videoservice.WithPolls(m.pollDAO, m.pollVoteDAO),
+		videoservice.WithThumbnailStats(m.thumbnailStatsDAO),
+		videoservice.WithWatchHistory(m.watchHistoryDAO),
+		videoservice.WithWatchProgressSync(m.watchProgressPubSub),
+		videoservice.WithUserSettings(m.userSettingsDAO),
+		videoservice.WithClaims(m.claimDAO),
+		videoservice.WithPageTokens(m.pageTokens),
+	)
+	streamSvc := stream.NewStream(m.videoDAO, m.producer, stream.WithFingerprints(m.storage))
+
+	scopes := commentservice.MethodScopes()
+	for method, scope := range videoservice.MethodScopes() {
+		scopes[method] = scope
+	}
+
+	opts = append(opts,
+		serverkit.WithMethodScopes(scopes),
+		serverkit.WithErrorMappings(append(commentservice.ErrorMappings(), videoservice.ErrorMappings()...)...),
+	)
+	grpcServer := serverkit.NewGrpcServer(ctx, conf.grpcServer, opts...)
+	commentpb.RegisterCommentServer(grpcServer, commentSvc)
+	commentpbv2.RegisterCommentServer(grpcServer, commentSvcV2)
+	videopb.RegisterVideoServer(grpcServer, videoSvc)
+
+	if faults := grpcServer.FaultInjector(); faults != nil {
+		adminServer.Handle("/faults", faults)
+	}
+
+	logger.Info("listen to gRPC addr", zap.String("grpc_addr", conf.grpcAddr))
+	grpcLis, err := net.Listen("tcp", conf.grpcAddr)
+	if err != nil {
+		logger.Fatal("failed to listen gRPC addr", zap.Error(err))
+	}
+
+	logger.Info("listen to HTTP addr", zap.String("http_addr", conf.httpAddr))
+	httpLis, err := net.Listen("tcp", conf.httpAddr)
+	if err != nil {
+		logger.Fatal("failed to listen HTTP addr", zap.Error(err))
+	}
+
+	mux := grpckit.NewGatewayMux(tenantkit.HeaderMatcher)
+
+	uploadHandler := gateway.NewHandler(videopb.NewVideoClient(gatewayConn), logger)
+	if err := mux.HandlePath("POST", "/v1/videos", uploadHandler.HandleUploadVideo); err != nil {
+		logger.Fatal("failed to register additional routes", zap.Error(err))
+	}
+
+	httpServer := &http.Server{
+		// the gRPC-Web requests are proxied to the gRPC address, since the proxy speaks HTTP/2 over TCP
+		Handler: otelkit.NewHTTPHandler(grpckit.NewGrpcWebHandler(conf.grpcWeb, conf.grpcAddr, mux,
+			&commentpb.Comment_ServiceDesc, &commentpbv2.Comment_ServiceDesc, &videopb.Video_ServiceDesc)),
+	}
+
+	// the servers are shut down in the reverse order, so the gateway stops before the services it calls
+	lifecycle.OnShutdown("gRPC server", grpcServer.Shutdown)
+	lifecycle.OnShutdown("HTTP server", httpServer.Shutdown)
+
+	return func(ctx context.Context) error {
+		if err := commentpb.RegisterCommentHandler(ctx, mux, gatewayConn.ClientConn); err != nil {
+			logger.Fatal("failed to register comment handler to HTTP server", zap.Error(err))
+		}
+
+		if err := commentpbv2.RegisterCommentHandler(ctx, mux, gatewayConn.ClientConn); err != nil {
+			logger.Fatal("failed to register comment v2 handler to HTTP server", zap.Error(err))
+		}
+
+		if err := videopb.RegisterVideoHandler(ctx, mux, gatewayConn.ClientConn); err != nil {
+			logger.Fatal("failed to register video handler to HTTP server", zap.Error(err))
+		}
+
+		for _, lis := range []net.Listener{inProcessLis, grpcLis} {
+			go func(lis net.Listener) {
+				if err := grpcServer.Serve(lis); err != nil {
+					logger.Error("failed to run gRPC server", zap.Error(err))
+				}
+			}(lis)
+		}
+
+		go func() {
+			if err := httpServer.Serve(httpLis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Fatal("failed to run HTTP server", zap.Error(err))
+			}
+		}()
+
+		handler := videopb.NewHandleVideoCreatedConsumerHandler(streamSvc, logkit.NewSaramaLogger(logger))
+		if err := m.consumer.Consume(ctx, handler); err != nil {
+			return err
+		}
+
+		// the scheduler runs until the context is done, then waits for the running jobs
+		return m.scheduler.Run(ctx)
+	}
+}
+
+// inProcessClientConfig returns the config of the in-process clients, which neither retry nor hedge the requests
+// since the requests of the clients and the servers are served by the same process.
+func inProcessClientConfig() *client.Config {
+	return &client.Config{
+		GrpcClientConnConfig: grpckit.GrpcClientConnConfig{
+			Timeout:    30 * time.Second,
+			ServerAddr: inProcessTarget,
+		},
+		PoolSize:       1,
+		RequestTimeout: 10 * time.Second,
+		MaxAttempts:    1,
+	}
+}
@@ -0,0 +1,133 @@
+package all
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commentdao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	videodao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"go.uber.org/zap"
+)
+
+func newSeedCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "seed",
+		Short: "populates the databases of a dev environment with the fixture videos and comments",
+		RunE:  runSeed,
+	}
+}
+
+type SeedArgs struct {
+	Seed                 int64    `long:"seed" env:"SEED" description:"the seed of the fixtures, a seed populates the same fixtures on every run" default:"1"`
+	Videos               int      `long:"videos" env:"VIDEOS" description:"the number of videos of each tenant" default:"20"`
+	Comments             int      `long:"comments" env:"COMMENTS" description:"the most comments of a video, each video has a random number of comments up to it" default:"50"`
+	TenantIDs            []string `long:"tenant_ids" env:"TENANT_IDS" env-delim:"," description:"the tenants populated" default:"default"`
+	logkit.LoggerConfig  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	pgkit.PGConfig       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	mongokit.MongoConfig `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	rediskit.RedisConfig `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	configkit.FileConfig
+}
+
+// runSeed populates the videos and their comment threads generated from the seed. The fixtures replace the ones
+// populated before, so the command is run again to reset a dev environment. The comment lists cached before
+// expire by their TTL, and the video shards are not supported, as the dev environment does not shard the videos.
+func runSeed(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args SeedArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig)
+	defer func() {
+		_ = pgClient.Close()
+	}()
+
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, &args.PGConfig, nonrecording.NewNoopMeterProvider().Meter(""))
+	defer func() {
+		_ = stmtCache.Close()
+	}()
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig)
+	defer func() {
+		_ = mongoClient.Close()
+	}()
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig)
+	defer func() {
+		_ = redisClient.Close()
+	}()
+
+	videoCollection := mongoClient.Database().Collection("videos")
+	if err := videodao.CreateVideoIndexes(ctx, videoCollection); err != nil {
+		logger.Fatal("failed to create video indexes", zap.Error(err))
+	}
+
+	// the video DAO invalidates the cached videos and video lists of the videos populated
+	videoDAO := videodao.NewRedisVideoDAO(redisClient, videodao.NewMongoVideoDAO(videoCollection))
+	commentDAO := commentdao.NewPGCommentDAO(pgClient, stmtCache)
+
+	g := fixturekit.NewGenerator(args.Seed)
+	for _, tenantID := range args.TenantIDs {
+		// each tenant has fixtures of its own, as the IDs are unique across the tenants
+		videos, comments, err := seedTenant(tenantkit.WithTenantID(ctx, tenantID), g.Fork(tenantID), videoDAO, commentDAO, args.Videos, args.Comments)
+		if err != nil {
+			logger.Fatal("failed to seed tenant", zap.Error(err), zap.String("tenant_id", tenantID))
+		}
+
+		logger.Info("seed tenant successfully", zap.String("tenant_id", tenantID), zap.Int("videos", videos), zap.Int("comments", comments))
+	}
+
+	logger.Info("seed successfully, terminating ...")
+
+	return nil
+}
+
+// seedTenant populates the videos and their comments of the tenant of the context, and returns their numbers.
+func seedTenant(ctx context.Context, g *fixturekit.Generator, videoDAO videodao.VideoDAO, commentDAO commentdao.CommentDAO, videos, maxComments int) (int, int, error) {
+	comments := 0
+
+	for i := 0; i < videos; i++ {
+		// each video is generated by a generator of its own, so the videos stay the same with more comments
+		vg := g.Fork(fmt.Sprintf("video-%d", i))
+
+		video := videodao.NewVideoFixture(vg)
+		err := videoDAO.Create(ctx, video)
+		if errors.Is(err, videodao.ErrVideoAlreadyExists) {
+			err = videoDAO.Update(ctx, video)
+		}
+		if err != nil {
+			return i, comments, err
+		}
+
+		videoComments := commentdao.NewCommentFixtures(vg, video.ID.Hex(), video.CreatedAt, vg.Intn(maxComments+1))
+		if err := commentDAO.DeleteByVideoID(ctx, video.ID.Hex()); err != nil {
+			return i, comments, err
+		}
+
+		n, err := commentDAO.BulkImport(ctx, videoComments)
+		if err != nil {
+			return i, comments, err
+		}
+		comments += n
+	}
+
+	return videos, comments, nil
+}
@@ -0,0 +1,179 @@
+package all
+
+import (
+	"context"
+
+	commentdao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	videodao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/counterkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newServeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "starts all the services in one process on the infrastructure of the modules",
+		RunE:  runServe,
+	}
+}
+
+type ServeArgs struct {
+	GRPCAddr                             string         `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
+	HTTPAddr                             string         `long:"http_addr" env:"HTTP_ADDR" default:":8080"`
+	PageTokenConfig                      pagekit.Config `group:"page_token" namespace:"page_token" env-namespace:"PAGE_TOKEN"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	mongokit.MongoConfig                 `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	storagekit.MinIOConfig               `group:"minio" namespace:"minio" env-namespace:"MINIO"`
+	cryptokit.EncryptionConfig           `group:"encryption" namespace:"encryption" env-namespace:"ENCRYPTION"`
+	serverkit.GrpcServerConfig           `group:"grpc_server" namespace:"grpc_server" env-namespace:"GRPC_SERVER"`
+	grpckit.GrpcWebConfig                `group:"grpc_web" namespace:"grpc_web" env-namespace:"GRPC_WEB"`
+	scheduler.SchedulerConfig            `group:"scheduler" namespace:"scheduler" env-namespace:"SCHEDULER"`
+	counterkit.CounterConfig             `group:"like_counter" namespace:"like_counter" env-namespace:"LIKE_COUNTER"`
+	eventkit.TransportConfig
+	eventkit.ProducerConfig
+	eventkit.ConsumerConfig
+	configkit.FileConfig
+}
+
+func runServe(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args ServeArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, &args.PGConfig, meter)
+	lifecycle.OnClose("pg statement cache", stmtCache.Close)
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig, mongokit.WithMeter(meter))
+	lifecycle.OnClose("mongo client", mongoClient.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	producer := eventkit.NewProducer(ctx, &args.TransportConfig, &args.ProducerConfig)
+	lifecycle.OnClose("event producer", producer.Close)
+
+	consumer := eventkit.NewConsumer(ctx, &args.TransportConfig, &args.ConsumerConfig)
+	lifecycle.OnClose("event consumer", consumer.Close)
+
+	if args.Transport == eventkit.TransportKafka {
+		if lagMonitor := kafkakit.NewLagMonitor(ctx, &args.KafkaConsumerConfig, meter); lagMonitor != nil {
+			lifecycle.OnClose("consumer lag monitor", lagMonitor.Close)
+			adminServer.Handle("/consumer_lag", lagMonitor)
+		}
+	}
+
+	videoCollection := mongoClient.Database().Collection("videos")
+	if err := videodao.CreateVideoIndexes(ctx, videoCollection); err != nil {
+		logger.Fatal("failed to create video indexes", zap.Error(err))
+	}
+
+	pollCollection := mongoClient.Database().Collection("polls")
+	if err := videodao.CreatePollIndexes(ctx, pollCollection); err != nil {
+		logger.Fatal("failed to create poll indexes", zap.Error(err))
+	}
+
+	claimCollection := mongoClient.Database().Collection("claims")
+	if err := videodao.CreateClaimIndexes(ctx, claimCollection); err != nil {
+		logger.Fatal("failed to create claim indexes", zap.Error(err))
+	}
+
+	var commentDAO commentdao.CommentDAO = commentdao.NewRedisCommentDAO(redisClient, commentdao.NewPGCommentDAO(pgClient, stmtCache))
+	var commentDraftDAO commentdao.CommentDraftDAO = commentdao.NewRedisCommentDraftDAO(redisClient, commentdao.DefaultCommentDraftTTL)
+	if kms := cryptokit.NewKMS(ctx, &args.EncryptionConfig); kms != nil {
+		envelope := cryptokit.NewEnvelope(ctx, kms, cryptokit.NewPGKeyStore(ctx, pgClient), &args.EncryptionConfig)
+		commentDAO = commentdao.NewEncryptedCommentDAO(commentDAO, envelope)
+		commentDraftDAO = commentdao.NewEncryptedCommentDraftDAO(commentDraftDAO, envelope)
+	}
+
+	// the jobs are locked in Redis, so the processes serving the modules run each of them once
+	jobScheduler := scheduler.NewScheduler(ctx, &args.SchedulerConfig, meter, scheduler.WithRedisLock(redisClient))
+
+	likeCounters := counterkit.NewCounters(ctx, commentdao.CommentLikeCounterName, redisClient, pgClient, &args.CounterConfig,
+		counterkit.WithSnapshotFunc(commentdao.CommentLikeSnapshotFunc(commentDAO)),
+	)
+	if err := likeCounters.Schedule(jobScheduler); err != nil {
+		logger.Fatal("failed to schedule like counter snapshot job", zap.Error(err))
+	}
+
+	watchHistoryDAO := videodao.NewRedisWatchHistoryDAO(redisClient, videodao.NewPGWatchHistoryDAO(pgClient))
+
+	m := &modules{
+		commentDAO:          commentDAO,
+		commentPubSub:       commentdao.NewRedisCommentPubSub(redisClient),
+		commentDraftDAO:     commentDraftDAO,
+		commentLikeCounter:  commentdao.NewCounterCommentLikeCounterDAO(likeCounters),
+		userBlockDAO:        commentdao.NewPGUserBlockDAO(pgClient),
+		userReputationDAO:   commentdao.NewPGUserReputationDAO(pgClient),
+		bannedPatternDAO:    commentdao.NewPGBannedPatternDAO(pgClient),
+		bannedPatternPubSub: commentdao.NewRedisBannedPatternPubSub(redisClient),
+		videoDAO:            videodao.NewRedisVideoDAO(redisClient, videodao.NewMongoVideoDAO(videoCollection)),
+		pollDAO:             videodao.NewMongoPollDAO(pollCollection),
+		pollVoteDAO:         videodao.NewRedisPollVoteDAO(redisClient),
+		thumbnailStatsDAO:   videodao.NewRedisThumbnailStatsDAO(redisClient),
+		watchHistoryDAO:     watchHistoryDAO,
+		watchHistoryFlusher: watchHistoryDAO,
+		watchProgressPubSub: videodao.NewRedisWatchProgressPubSub(redisClient),
+		userSettingsDAO:     videodao.NewRedisUserSettingsDAO(redisClient, videodao.NewPGUserSettingsDAO(pgClient)),
+		claimDAO:            videodao.NewMongoClaimDAO(claimCollection),
+		storage:             storagekit.NewMinIOClient(ctx, &args.MinIOConfig),
+		producer:            producer,
+		consumer:            consumer,
+		pageTokens:          pagekit.NewCodec(ctx, &args.PageTokenConfig),
+		scheduler:           jobScheduler,
+	}
+
+	conf := &serverConfig{
+		grpcAddr:   args.GRPCAddr,
+		httpAddr:   args.HTTPAddr,
+		grpcServer: &args.GrpcServerConfig,
+		grpcWeb:    &args.GrpcWebConfig,
+	}
+
+	return lifecycle.Run(serveModules(ctx, lifecycle, adminServer, conf, m, serverkit.WithMeter(meter)))
+}
@@ -2,20 +2,30 @@ package comment
 
 import (
 	"context"
-	"log"
 	"net"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/service"
-	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/client"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/counterkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/ratekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -30,22 +40,37 @@ func newAPICommand() *cobra.Command {
 }
 
 type APIArgs struct {
-	GRPCAddr                             string                       `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
-	VideoClientConnConfig                grpckit.GrpcClientConnConfig `group:"video" namespace:"video" env-namespace:"VIDEO"`
+	GRPCAddr                             string         `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
+	MigrationSource                      string         `long:"migration_source" env:"MIGRATION_SOURCE" description:"the migration files source directory to check on start, not checked if empty"`
+	CommentDraftTTL                      time.Duration  `long:"comment_draft_ttl" env:"COMMENT_DRAFT_TTL" default:"168h" description:"the time a comment draft is kept since it is saved"`
+	VideoClientConfig                    client.Config  `group:"video" namespace:"video" env-namespace:"VIDEO"`
+	PageTokenConfig                      pagekit.Config `group:"page_token" namespace:"page_token" env-namespace:"PAGE_TOKEN"`
 	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
 	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
 	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
+	storagekit.MinIOConfig               `group:"minio" namespace:"minio" env-namespace:"MINIO"`
 	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	ratekit.RateLimitConfig              `group:"rate_limit" namespace:"rate_limit" env-namespace:"RATE_LIMIT"`
+	hedgekit.HedgeConfig                 `group:"hedge" namespace:"hedge" env-namespace:"HEDGE"`
+	cryptokit.EncryptionConfig           `group:"encryption" namespace:"encryption" env-namespace:"ENCRYPTION"`
+	service.EmbeddingConfig              `group:"embedding" namespace:"embedding" env-namespace:"EMBEDDING"`
+	service.DuplicateConfig              `group:"duplicate" namespace:"duplicate" env-namespace:"DUPLICATE"`
+	service.ReputationConfig             `group:"reputation" namespace:"reputation" env-namespace:"REPUTATION"`
+	service.RankingConfig                `group:"ranking" namespace:"ranking" env-namespace:"RANKING"`
+	counterkit.CounterConfig             `group:"like_counter" namespace:"like_counter" env-namespace:"LIKE_COUNTER"`
 	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	serverkit.GrpcServerConfig           `group:"grpc_server" namespace:"grpc_server" env-namespace:"GRPC_SERVER"`
+	configkit.FileConfig
 }
 
 func runAPI(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	var args APIArgs
-	if _, err := flags.NewParser(&args, flags.Default).Parse(); err != nil {
-		log.Fatal("failed to parse flag", err.Error())
-	}
+	config := configkit.Load(&args)
 
 	logger := logkit.NewLogger(&args.LoggerConfig)
 	defer func() {
@@ -54,57 +79,168 @@ func runAPI(_ *cobra.Command, _ []string) error {
 
 	ctx = logger.WithContext(ctx)
 
-	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig)
-	defer func() {
-		if err := pgClient.Close(); err != nil {
-			logger.Fatal("failed to close pg client", zap.Error(err))
-		}
-	}()
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
 
-	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig)
-	defer func() {
-		if err := redisClient.Close(); err != nil {
-			logger.Fatal("failed to close redis client", zap.Error(err))
-		}
-	}()
+	// the log level, the rate limits and the cache TTLs are reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(APIArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*APIArgs).LoggerConfig.Level)
+		return nil
+	})
 
-	videoClientConn := grpckit.NewGrpcClientConn(ctx, &args.VideoClientConnConfig)
-	defer func() {
-		if err := videoClientConn.Close(); err != nil {
-			logger.Fatal("failed to close video gRPC client", zap.Error(err))
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	// the instances are not ready until the migration job applies the migrations the queries depend on
+	if args.MigrationSource != "" {
+		migrationConf := &migrationkit.MigrationConfig{Source: args.MigrationSource, URL: args.PGConfig.URL}
+		adminServer.AddWarmUp("migration", func(context.Context) error {
+			return migrationkit.CheckMigrated(migrationConf)
+		})
+
+		for region, url := range regionURLs(ctx, &args.RegionConfig) {
+			regionMigrationConf := &migrationkit.MigrationConfig{Source: args.MigrationSource, URL: url}
+			adminServer.AddWarmUp("migration of region "+region, func(context.Context) error {
+				return migrationkit.CheckMigrated(regionMigrationConf)
+			})
 		}
-	}()
+	}
+
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, &args.PGConfig, meter)
+	lifecycle.OnClose("pg statement cache", stmtCache.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	rateLimiter := ratekit.NewRateLimiter(ctx, redisClient, &args.RateLimitConfig)
+	lifecycle.OnClose("rate limiter", rateLimiter.Close)
+	reloader.OnReload("rate limiter", func(next interface{}) error {
+		return rateLimiter.SetLimits(&next.(*APIArgs).RateLimitConfig)
+	})
+
+	videoClient := client.NewVideoClient(ctx, &args.VideoClientConfig, meter,
+		grpc.WithChainUnaryInterceptor(meter.UnaryClientInterceptor(), tenantkit.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+	)
+	lifecycle.OnClose("video gRPC client", videoClient.Close)
+
+	// the comment lists missing the cache are read from the replicas with hedging to cut the tail latency
+	replicaDAOs := make([]dao.CommentDAO, 0, len(args.PGConfig.ReplicaURLs))
+	for _, replicaConf := range args.PGConfig.Replicas() {
+		replicaClient := pgkit.NewPGClient(ctx, replicaConf, pgkit.WithMeter(meter))
+		lifecycle.OnClose("pg replica client", replicaClient.Close)
+
+		replicaStmtCache := pgkit.NewStmtCache(ctx, replicaClient, replicaConf, meter)
+		lifecycle.OnClose("pg replica statement cache", replicaStmtCache.Close)
+
+		replicaDAOs = append(replicaDAOs, dao.NewPGCommentDAO(replicaClient, replicaStmtCache))
+	}
+	hedger := hedgekit.NewHedger(ctx, "comment.list_by_video_id", &args.HedgeConfig, meter)
 
-	pgCommentDAO := dao.NewPGCommentDAO(pgClient)
-	commentDAO := dao.NewRedisCommentDAO(redisClient, pgCommentDAO)
-	videoClient := videopb.NewVideoClient(videoClientConn)
+	pgCommentDAO := dao.NewPGCommentDAO(pgClient, stmtCache)
+	var commentDAO dao.CommentDAO = dao.NewRedisCommentDAO(redisClient, dao.NewHedgedCommentDAO(pgCommentDAO, replicaDAOs, hedger))
 
-	svc := service.NewService(commentDAO, videoClient)
+	// the comments of the tenants pinned to the other regions bypass the cache and the replicas of the home region
+	regionPGClients := newRegionPGClients(ctx, lifecycle, &args.PGConfig, &args.RegionConfig, meter)
+	commentDAO = newRegionalCommentDAO(commentDAO, regionPGClients, &args.RegionConfig)
+
+	commentDraftDAO := newRegionalCommentDraftDAO(ctx, lifecycle, dao.NewRedisCommentDraftDAO(redisClient, args.CommentDraftTTL),
+		&args.RedisConfig, args.CommentDraftTTL, &args.RegionConfig, meter)
+
+	// the contents are encrypted above the cache, so the cache keeps the ciphertexts as well
+	if kms := cryptokit.NewKMS(ctx, &args.EncryptionConfig); kms != nil {
+		envelope := cryptokit.NewEnvelope(ctx, kms, cryptokit.NewPGKeyStore(ctx, pgClient), &args.EncryptionConfig)
+		commentDAO = dao.NewEncryptedCommentDAO(commentDAO, envelope)
+		commentDraftDAO = dao.NewEncryptedCommentDraftDAO(commentDraftDAO, envelope)
+	}
+
+	commentPubSub := dao.NewRedisCommentPubSub(redisClient)
+	storage := storagekit.NewRegionalMinIOClient(ctx, storagekit.NewMinIOClient(ctx, &args.MinIOConfig), &args.MinIOConfig, &args.RegionalMinIOConfig, &args.RegionConfig.RegionConfig)
+
+	// the banned patterns are reloaded on every replica once changed
+	bannedPatternDAO := dao.NewPGBannedPatternDAO(pgClient)
+	bannedPatternFilter := service.NewBannedPatternFilter(ctx, bannedPatternDAO, dao.NewRedisBannedPatternPubSub(redisClient))
+	lifecycle.OnClose("banned pattern filter", bannedPatternFilter.Close)
+
+	// the blocks of the users of the pinned tenants are kept in their regions along with their comments
+	userBlockDAO := newRegionalUserBlockDAO(dao.NewPGUserBlockDAO(pgClient), regionPGClients, &args.RegionConfig)
+	userReputationDAO := newRegionalUserReputationDAO(dao.NewPGUserReputationDAO(pgClient), regionPGClients, &args.RegionConfig)
+
+	// the queries are embedded by the model of the embedder command, semantic search is disabled without it
+	var embedder service.Embedder
+	if args.EmbeddingConfig.URL != "" {
+		embedder = service.NewHTTPEmbedder(&args.EmbeddingConfig)
+	}
+
+	// the near-duplicate comments of a user are detected within the window only, and not at all without it
+	var commentFingerprintDAO dao.CommentFingerprintDAO
+	if args.DuplicateConfig.Window > 0 {
+		commentFingerprintDAO = dao.NewRedisCommentFingerprintDAO(redisClient, args.DuplicateConfig.Window)
+	}
+
+	// the likes are counted in Redis and snapshotted to the comments by the jobs command
+	likeCounters := counterkit.NewCounters(ctx, dao.CommentLikeCounterName, redisClient, pgClient, &args.CounterConfig)
+
+	// the ranking experiment is the feature flag of the rankings of the comment lists, reloaded without restart as well
+	rankingExperiment := service.NewRankingExperiment(ctx, &args.RankingConfig)
+	reloader.OnReload("ranking experiment", func(next interface{}) error {
+		return rankingExperiment.SetConfig(&next.(*APIArgs).RankingConfig)
+	})
+
+	pageTokens := pagekit.NewCodec(ctx, &args.PageTokenConfig)
+
+	svc := service.NewService(commentDAO, commentPubSub, videoClient, storage,
+		service.WithBannedPatterns(bannedPatternDAO, bannedPatternFilter),
+		service.WithCommentDrafts(commentDraftDAO),
+		service.WithUserBlocks(userBlockDAO),
+		service.WithUserReputations(userReputationDAO, args.ReputationConfig.LinkThreshold),
+		service.WithSemanticSearch(embedder),
+		service.WithDuplicateDetection(commentFingerprintDAO, args.DuplicateConfig.Action),
+		service.WithRankingExperiment(rankingExperiment),
+		service.WithLikeCounter(dao.NewCounterCommentLikeCounterDAO(likeCounters)),
+		service.WithPageTokens(pageTokens),
+	)
+	svcV2 := service.NewServiceV2(svc, pageTokens)
 
 	logger.Info("listen to gRPC addr", zap.String("grpc_addr", args.GRPCAddr))
 	lis, err := net.Listen("tcp", args.GRPCAddr)
 	if err != nil {
 		logger.Fatal("failed to listen gRPC addr", zap.Error(err))
 	}
-	defer func() {
-		if err := lis.Close(); err != nil {
-			logger.Fatal("failed to close gRPC listener", zap.Error(err))
-		}
-	}()
 
-	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
-	defer func() {
-		if err := meter.Close(); err != nil {
-			logger.Fatal("failed to close meter", zap.Error(err))
-		}
-	}()
+	grpcServer := serverkit.NewGrpcServer(ctx, &args.GrpcServerConfig,
+		serverkit.WithMeter(meter),
+		serverkit.WithMethodScopes(service.MethodScopes()),
+		serverkit.WithErrorMappings(service.ErrorMappings()...),
+		serverkit.WithUnaryInterceptors(rateLimiter.UnaryServerInterceptor()),
+		serverkit.WithStreamInterceptors(rateLimiter.StreamServerInterceptor()),
+	)
+
+	if faults := grpcServer.FaultInjector(); faults != nil {
+		adminServer.Handle("/faults", faults)
+	}
+
+	// the server is registered last, so it stops accepting and drains the requests before the clients are closed
+	lifecycle.OnShutdown("gRPC server", grpcServer.Shutdown)
 
-	return runkit.GracefulRun(serveGRPC(lis, svc, logger, grpc.UnaryInterceptor(meter.UnaryServerInterceptor())), &args.GracefulConfig)
+	return lifecycle.Run(serveGRPC(lis, grpcServer, svc, svcV2, logger))
 }
 
-func serveGRPC(lis net.Listener, svc pb.CommentServer, logger *logkit.Logger, opt ...grpc.ServerOption) runkit.GracefulRunFunc {
-	grpcServer := grpc.NewServer(opt...)
+func serveGRPC(lis net.Listener, grpcServer *serverkit.GrpcServer, svc pb.CommentServer, svcV2 pbv2.CommentServer, logger *logkit.Logger) runkit.GracefulRunFunc {
+	// both API versions are served, so the v1 clients keep working while migrating to v2
 	pb.RegisterCommentServer(grpcServer, svc)
+	pbv2.RegisterCommentServer(grpcServer, svcV2)
 
 	return func(ctx context.Context) error {
 		go func() {
@@ -115,8 +251,6 @@ func serveGRPC(lis net.Listener, svc pb.CommentServer, logger *logkit.Logger, op
 
 		<-ctx.Done()
 
-		grpcServer.GracefulStop()
-
 		return nil
 	}
 }
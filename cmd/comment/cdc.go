@@ -0,0 +1,132 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/Shopify/sarama"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newCDCCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cdc",
+		Short: "starts comment change data capture consumer",
+		RunE:  runCDC,
+	}
+}
+
+type CDCArgs struct {
+	ReplayFrom                           string `long:"replay_from" env:"REPLAY_FROM" description:"replay the changes since the given RFC 3339 time before consuming"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	kafkakit.KafkaConsumerConfig         `group:"kafka_consumer" namespace:"kafka_consumer" env-namespace:"KAFKA_CONSUMER"`
+	configkit.FileConfig
+}
+
+func runCDC(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args CDCArgs
+	config := configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	// the log level, the rate limits and the cache TTLs are reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(CDCArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*CDCArgs).LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	if lagMonitor := kafkakit.NewLagMonitor(ctx, &args.KafkaConsumerConfig, meter); lagMonitor != nil {
+		lifecycle.OnClose("consumer lag monitor", lagMonitor.Close)
+		adminServer.Handle("/consumer_lag", lagMonitor)
+	}
+
+	if args.ReplayFrom != "" {
+		replayFrom, err := time.Parse(time.RFC3339, args.ReplayFrom)
+		if err != nil {
+			logger.Fatal("failed to parse replay from time", zap.Error(err))
+		}
+
+		replayChanges(logger, &args.KafkaConsumerConfig, replayFrom)
+	}
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	consumer := kafkakit.NewKafkaConsumer(ctx, &args.KafkaConsumerConfig)
+	lifecycle.OnClose("Kafka consumer", consumer.Close)
+
+	// the changes are consumed from the database directly, so the cache is not read here
+	commentDAO := dao.NewRedisCommentDAO(redisClient, nil)
+	handler := cdckit.NewConsumerGroupHandler(dao.NewCommentCacheInvalidator(commentDAO), logger)
+
+	return lifecycle.Run(serveCDCConsumer(consumer, handler))
+}
+
+func replayChanges(logger *logkit.Logger, conf *kafkakit.KafkaConsumerConfig, replayFrom time.Time) {
+	client, err := sarama.NewClient(conf.Addrs, sarama.NewConfig())
+	if err != nil {
+		logger.Fatal("failed to create Kafka client", zap.Error(err))
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			logger.Fatal("failed to close Kafka client", zap.Error(err))
+		}
+	}()
+
+	offsets, err := kafkakit.ResolveOffsets(client, conf.Topic, nil, kafkakit.ReplayPosition{Time: replayFrom})
+	if err != nil {
+		logger.Fatal("failed to resolve replay offsets", zap.Error(err))
+	}
+
+	if _, err := kafkakit.ResetOffsets(client, conf.Group, conf.Topic, offsets); err != nil {
+		logger.Fatal("failed to reset consumer group offsets", zap.Error(err))
+	}
+
+	logger.Info("reset consumer group offsets to replay changes", zap.Time("replay_from", replayFrom))
+}
+
+func serveCDCConsumer(consumer *kafkakit.KafkaConsumer, handler sarama.ConsumerGroupHandler) runkit.GracefulRunFunc {
+	return func(ctx context.Context) error {
+		if err := consumer.Consume(ctx, handler); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,87 @@
+package comment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newEncryptionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "encryption",
+		Short: "shows and rewraps the data keys encrypting the comments after the master key is rotated",
+		RunE:  runEncryption,
+	}
+}
+
+type EncryptionArgs struct {
+	Action                     string `long:"action" env:"ACTION" description:"the action on the data keys" choice:"status" choice:"rewrap" default:"status"`
+	cryptokit.EncryptionConfig `group:"encryption" namespace:"encryption" env-namespace:"ENCRYPTION"`
+	logkit.LoggerConfig        `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	pgkit.PGConfig             `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	configkit.FileConfig
+}
+
+// runEncryption manages the data keys of the comments. The master key is rotated by adding the new key to the KMS
+// first, then the data keys are rewrapped by the new key, after which the old key is removed from the KMS. The
+// comments encrypted are left as they are, since the data keys encrypting them are not changed.
+func runEncryption(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args EncryptionArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	logger = logger.With(zap.String("action", args.Action))
+	ctx = logger.WithContext(ctx)
+
+	kms := cryptokit.NewKMS(ctx, &args.EncryptionConfig)
+	if kms == nil {
+		logger.Fatal("failed to manage data keys", zap.Error(errors.New("the KMS is none")))
+	}
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig)
+	defer func() {
+		if err := pgClient.Close(); err != nil {
+			logger.Fatal("failed to close pg client", zap.Error(err))
+		}
+	}()
+
+	envelope := cryptokit.NewEnvelope(ctx, kms, cryptokit.NewPGKeyStore(ctx, pgClient), &args.EncryptionConfig)
+
+	switch args.Action {
+	case "status":
+		counts, err := envelope.DataKeys(ctx)
+		if err != nil {
+			logger.Fatal("failed to list data keys", zap.Error(err))
+		}
+
+		currentKeyID, err := kms.CurrentKeyID(ctx)
+		if err != nil {
+			logger.Fatal("failed to get current master key", zap.Error(err))
+		}
+
+		for kmsKeyID, count := range counts {
+			logger.Info("data keys", zap.String("kms_key_id", kmsKeyID), zap.Int("count", count), zap.Bool("current", kmsKeyID == currentKeyID))
+		}
+	case "rewrap":
+		rewrapped, err := envelope.Rewrap(ctx)
+		if err != nil {
+			logger.Fatal("failed to rewrap data keys", zap.Int("rewrapped", rewrapped), zap.Error(err))
+		}
+
+		logger.Info("rewrap data keys successfully", zap.Int("rewrapped", rewrapped))
+	}
+
+	return nil
+}
@@ -2,16 +2,23 @@ package comment
 
 import (
 	"context"
-	"log"
+	"errors"
 	"net"
 	"net/http"
+	"strings"
 
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -26,19 +33,25 @@ func newGatewayCommand() *cobra.Command {
 }
 
 type GatewayArgs struct {
-	HTTPAddr                     string `long:"http_addr" env:"HTTP_ADDR" default:":8080"`
-	grpckit.GrpcClientConnConfig `group:"grpc" namespace:"grpc" env-namespace:"GRPC"`
-	runkit.GracefulConfig        `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
-	logkit.LoggerConfig          `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	HTTPAddr                             string `long:"http_addr" env:"HTTP_ADDR" default:":8080"`
+	grpckit.GrpcClientConnConfig         `group:"grpc" namespace:"grpc" env-namespace:"GRPC"`
+	grpckit.GrpcWebConfig                `group:"grpc_web" namespace:"grpc_web" env-namespace:"GRPC_WEB"`
+	httpkit.CacheConfig                  `group:"cache" namespace:"cache" env-namespace:"CACHE"`
+	httpkit.AbuseConfig                  `group:"abuse" namespace:"abuse" env-namespace:"ABUSE"`
+	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	configkit.FileConfig
 }
 
 func runGateway(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	var args GatewayArgs
-	if _, err := flags.NewParser(&args, flags.Default).Parse(); err != nil {
-		log.Fatal("failed to parse flag", err.Error())
-	}
+	config := configkit.Load(&args)
 
 	logger := logkit.NewLogger(&args.LoggerConfig)
 	defer func() {
@@ -47,51 +60,104 @@ func runGateway(_ *cobra.Command, _ []string) error {
 
 	ctx = logger.WithContext(ctx)
 
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	// the log level, the rate limits and the cache TTLs are reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(GatewayArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*GatewayArgs).LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
 	logger.Info("listen to HTTP addr", zap.String("http_addr", args.HTTPAddr))
 	lis, err := net.Listen("tcp", args.HTTPAddr)
 	if err != nil {
 		logger.Fatal("failed to listen HTTP addr", zap.Error(err))
 	}
-	defer func() {
-		if err := lis.Close(); err != nil {
-			logger.Fatal("failed to close HTTP listener", zap.Error(err))
-		}
-	}()
 
-	conn := grpckit.NewGrpcClientConn(ctx, &args.GrpcClientConnConfig)
-	defer func() {
-		if err := conn.Close(); err != nil {
-			logger.Fatal("failed to close gRPC client connection", zap.Error(err))
-		}
-	}()
+	conn := grpckit.NewGrpcClientConn(ctx, &args.GrpcClientConnConfig, grpc.WithChainUnaryInterceptor(meter.UnaryClientInterceptor()))
+	lifecycle.OnClose("gRPC client connection", conn.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	httpCache := httpkit.NewCache(&args.CacheConfig, commentCacheTag)
+	reloader.OnReload("HTTP cache", func(next interface{}) error {
+		httpCache.SetTTL(next.(*GatewayArgs).CacheConfig.TTL)
+		return nil
+	})
 
-	return runkit.GracefulRun(serveHTTP(lis, conn.ClientConn, logger), &args.GracefulConfig)
+	// the bans are managed on the admin server, e.g. to ban a scraper before it hits the limit
+	abuseGuard := httpkit.NewAbuseGuard(ctx, redisClient, &args.AbuseConfig)
+	adminServer.Handle("/abuse/bans", abuseGuard)
+
+	return lifecycle.Run(serveHTTP(lifecycle, lis, conn.ClientConn, &args.GrpcWebConfig, args.ServerAddr, httpCache, abuseGuard, redisClient, logger))
 }
 
-func serveHTTP(lis net.Listener, conn *grpc.ClientConn, logger *logkit.Logger) runkit.GracefulRunFunc {
-	mux := runtime.NewServeMux()
+func serveHTTP(lifecycle *runkit.Lifecycle, lis net.Listener, conn *grpc.ClientConn, webConf *grpckit.GrpcWebConfig, serverAddr string, httpCache *httpkit.Cache, abuseGuard *httpkit.AbuseGuard, redisClient *rediskit.RedisClient, logger *logkit.Logger) runkit.GracefulRunFunc {
+	mux := grpckit.NewGatewayMux(tenantkit.HeaderMatcher)
 
 	httpServer := &http.Server{
-		Handler: mux,
+		// serve gRPC-Web requests of the browsers along with the REST routes, traced from the gateway,
+		// the abusive clients are rejected before anything else
+		Handler: otelkit.NewHTTPHandler(abuseGuard.Handler(grpckit.NewGrpcWebHandler(webConf, serverAddr, httpCache.Handler(mux), &pb.Comment_ServiceDesc, &pbv2.Comment_ServiceDesc))),
 	}
+	lifecycle.OnShutdown("HTTP server", httpServer.Shutdown)
 
 	return func(ctx context.Context) error {
 		if err := pb.RegisterCommentHandler(ctx, mux, conn); err != nil {
 			logger.Fatal("failed to register handler to HTTP server", zap.Error(err))
 		}
 
+		if err := pbv2.RegisterCommentHandler(ctx, mux, conn); err != nil {
+			logger.Fatal("failed to register v2 handler to HTTP server", zap.Error(err))
+		}
+
+		go func() {
+			if err := httpCache.SubscribeInvalidation(logger.WithContext(ctx), redisClient); err != nil {
+				logger.Error("failed to subscribe cache invalidation", zap.Error(err))
+			}
+		}()
+
 		go func() {
-			if err := httpServer.Serve(lis); err != nil {
+			if err := httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
 				logger.Fatal("failed to run HTTP server", zap.Error(err))
 			}
 		}()
 
 		<-ctx.Done()
 
-		if err := httpServer.Shutdown(context.Background()); err != nil {
-			logger.Fatal("failed to shutdown HTTP server", zap.Error(err))
+		return nil
+	}
+}
+
+// commentCacheTag caches the comment lists of the videos of both API versions.
+func commentCacheTag(req *http.Request) (string, bool) {
+	videoID := strings.TrimPrefix(req.URL.Path, "/v1/comments/")
+	if videoID == req.URL.Path {
+		// the v2 path is /v2/videos/{video_id}/comments
+		videoID = strings.TrimPrefix(req.URL.Path, "/v2/videos/")
+		if videoID == req.URL.Path || !strings.HasSuffix(videoID, "/comments") {
+			return "", false
 		}
 
-		return nil
+		videoID = strings.TrimSuffix(videoID, "/comments")
 	}
+
+	if videoID == "" || strings.Contains(videoID, "/") {
+		return "", false
+	}
+
+	return dao.CommentListCacheTag(videoID), true
 }
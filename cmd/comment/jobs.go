@@ -0,0 +1,115 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/counterkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newJobsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "jobs",
+		Short: "starts comment background job scheduler",
+		RunE:  runJobs,
+	}
+}
+
+type JobsArgs struct {
+	PartitionSchedule                    string `long:"partition_schedule" env:"PARTITION_SCHEDULE" description:"the cron expression of the partition maintenance job" default:"@daily"`
+	PartitionAhead                       int    `long:"partition_ahead" env:"PARTITION_AHEAD" description:"the number of future monthly partitions to create" default:"3"`
+	PartitionRetentionMonths             int    `long:"partition_retention_months" env:"PARTITION_RETENTION_MONTHS" description:"drop partitions older than the given months, 0 to keep all partitions" default:"0"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
+	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	scheduler.SchedulerConfig            `group:"scheduler" namespace:"scheduler" env-namespace:"SCHEDULER"`
+	counterkit.CounterConfig             `group:"like_counter" namespace:"like_counter" env-namespace:"LIKE_COUNTER"`
+	configkit.FileConfig
+}
+
+// runJobs runs the background jobs of the comments on their schedules. Every replica runs the scheduler,
+// and each run is locked in Redis, so the jobs keep running as long as any replica is up.
+func runJobs(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args JobsArgs
+	config := configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	// the log level is reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(JobsArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*JobsArgs).LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	jobScheduler := scheduler.NewScheduler(ctx, &args.SchedulerConfig, meter, scheduler.WithRedisLock(redisClient))
+
+	regionPGClients := newRegionPGClients(ctx, lifecycle, &args.PGConfig, &args.RegionConfig, meter)
+
+	regionPartitionDAOs := make(map[string]dao.CommentPartitionDAO, len(regionPGClients))
+	for region, regionClient := range regionPGClients {
+		regionPartitionDAOs[region] = dao.NewPGCommentPartitionDAO(regionClient.client)
+	}
+
+	partitionDAO := dao.NewPGCommentPartitionDAO(pgClient)
+	if err := jobScheduler.Add("comment_partition", args.PartitionSchedule, func(ctx context.Context) error {
+		return maintainRegionPartitions(ctx, partitionDAO, regionPartitionDAOs, args.PartitionAhead, args.PartitionRetentionMonths)
+	}); err != nil {
+		logger.Fatal("failed to schedule partition maintenance job", zap.Error(err))
+	}
+
+	// the likes counted by the API are copied to the comments of their tenants, in the regions the tenants are pinned to
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, &args.PGConfig, meter)
+	lifecycle.OnClose("pg statement cache", stmtCache.Close)
+
+	commentDAO := newRegionalCommentDAO(dao.NewPGCommentDAO(pgClient, stmtCache), regionPGClients, &args.RegionConfig)
+	likeCounters := counterkit.NewCounters(ctx, dao.CommentLikeCounterName, redisClient, pgClient, &args.CounterConfig,
+		counterkit.WithSnapshotFunc(dao.CommentLikeSnapshotFunc(commentDAO)),
+	)
+	if err := likeCounters.Schedule(jobScheduler); err != nil {
+		logger.Fatal("failed to schedule like counter snapshot job", zap.Error(err))
+	}
+
+	return lifecycle.Run(jobScheduler.Run)
+}
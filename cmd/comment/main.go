@@ -11,6 +11,15 @@ func NewCommentCommand() *cobra.Command {
 	cmd.AddCommand(newAPICommand())
 	cmd.AddCommand(newGatewayCommand())
 	cmd.AddCommand(newMigrationCommand())
+	cmd.AddCommand(newPartitionCommand())
+	cmd.AddCommand(newSchemaChangeCommand())
+	cmd.AddCommand(newEncryptionCommand())
+	cmd.AddCommand(newJobsCommand())
+	cmd.AddCommand(newCDCCommand())
+	cmd.AddCommand(newModeratorCommand())
+	cmd.AddCommand(newAbuseCommand())
+	cmd.AddCommand(newTrendingCommand())
+	cmd.AddCommand(newEmbedderCommand())
 
 	return cmd
 }
@@ -2,11 +2,10 @@ package comment
 
 import (
 	"context"
-	"log"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
-	flags "github.com/jessevdk/go-flags"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -22,15 +21,15 @@ func newMigrationCommand() *cobra.Command {
 type MigrationArgs struct {
 	logkit.LoggerConfig          `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
 	migrationkit.MigrationConfig `group:"migration" namespace:"migration" env-namespace:"MIGRATION"`
+	RegionConfig                 `group:"region" namespace:"region" env-namespace:"REGION"`
+	configkit.FileConfig
 }
 
 func runMigration(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	var args MigrationArgs
-	if _, err := flags.NewParser(&args, flags.Default).Parse(); err != nil {
-		log.Fatal("failed to parse flag", err.Error())
-	}
+	configkit.Load(&args)
 
 	logger := logkit.NewLogger(&args.LoggerConfig)
 	defer func() {
@@ -44,6 +43,18 @@ func runMigration(_ *cobra.Command, _ []string) error {
 		logger.Fatal("failed to run migration", zap.Error(err))
 	}
 
+	// the databases of the regions the tenants are pinned to take the same migrations as the home one
+	urls := regionURLs(ctx, &args.RegionConfig)
+	for _, region := range args.RegionConfig.Regions() {
+		regionConf := args.MigrationConfig
+		regionConf.URL = urls[region]
+
+		regionMigration := migrationkit.NewMigration(ctx, &regionConf)
+		if err := regionMigration.Up(); err != nil {
+			logger.Fatal("failed to run migration of region", zap.String("region", region), zap.Error(err))
+		}
+	}
+
 	logger.Info("run migration job successfully, terminating ...")
 
 	return nil
@@ -0,0 +1,111 @@
+package comment
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/service"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newModeratorCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "moderator",
+		Short: "starts comment toxicity moderator consuming the changes of the comments",
+		RunE:  runModerator,
+	}
+}
+
+type ModeratorArgs struct {
+	service.ToxicityConfig               `group:"toxicity" namespace:"toxicity" env-namespace:"TOXICITY"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
+	cryptokit.EncryptionConfig           `group:"encryption" namespace:"encryption" env-namespace:"ENCRYPTION"`
+	kafkakit.KafkaConsumerConfig         `group:"kafka_consumer" namespace:"kafka_consumer" env-namespace:"KAFKA_CONSUMER"`
+	configkit.FileConfig
+}
+
+// runModerator scores the comments created by the toxicity model server, and holds the toxic ones for moderation.
+// It consumes the change events of the comments like the cdc command, but by a consumer group of its own, so
+// the cache invalidation is not held back while the model server is down.
+func runModerator(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args ModeratorArgs
+	config := configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	if args.ToxicityConfig.URL == "" {
+		logger.Fatal("failed to start moderator", zap.Error(errors.New("the toxicity model server URL is empty")))
+	}
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	// the log level is reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(ModeratorArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*ModeratorArgs).LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	if lagMonitor := kafkakit.NewLagMonitor(ctx, &args.KafkaConsumerConfig, meter); lagMonitor != nil {
+		lifecycle.OnClose("consumer lag monitor", lagMonitor.Close)
+		adminServer.Handle("/consumer_lag", lagMonitor)
+	}
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, &args.PGConfig, meter)
+	lifecycle.OnClose("pg statement cache", stmtCache.Close)
+
+	// the comments are read from the primary, as the change events may be ahead of the replicas
+	var commentDAO dao.CommentDAO = dao.NewPGCommentDAO(pgClient, stmtCache)
+	commentDAO = newRegionalCommentDAO(commentDAO, newRegionPGClients(ctx, lifecycle, &args.PGConfig, &args.RegionConfig, meter), &args.RegionConfig)
+
+	if kms := cryptokit.NewKMS(ctx, &args.EncryptionConfig); kms != nil {
+		envelope := cryptokit.NewEnvelope(ctx, kms, cryptokit.NewPGKeyStore(ctx, pgClient), &args.EncryptionConfig)
+		commentDAO = dao.NewEncryptedCommentDAO(commentDAO, envelope)
+	}
+
+	consumer := kafkakit.NewKafkaConsumer(ctx, &args.KafkaConsumerConfig)
+	lifecycle.OnClose("Kafka consumer", consumer.Close)
+
+	moderator := service.NewToxicityModerator(commentDAO, service.NewHTTPToxicityScorer(&args.ToxicityConfig), args.ToxicityConfig.Threshold)
+	handler := cdckit.NewConsumerGroupHandler(moderator, logger)
+
+	return lifecycle.Run(serveCDCConsumer(consumer, handler))
+}
@@ -0,0 +1,129 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newPartitionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "partition",
+		Short: "runs the comment table partition maintenance job",
+		RunE:  runPartition,
+	}
+}
+
+type PartitionArgs struct {
+	Ahead               int `long:"ahead" env:"AHEAD" description:"the number of future monthly partitions to create" default:"3"`
+	RetentionMonths     int `long:"retention_months" env:"RETENTION_MONTHS" description:"drop partitions of the comments and their history older than the given months, 0 to keep all partitions" default:"0"`
+	logkit.LoggerConfig `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	pgkit.PGConfig      `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	RegionConfig        `group:"region" namespace:"region" env-namespace:"REGION"`
+	configkit.FileConfig
+}
+
+func runPartition(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args PartitionArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig)
+	defer func() {
+		if err := pgClient.Close(); err != nil {
+			logger.Fatal("failed to close pg client", zap.Error(err))
+		}
+	}()
+
+	regionPartitionDAOs := make(map[string]dao.CommentPartitionDAO)
+	for region, regionConf := range regionPGConfigs(ctx, &args.PGConfig, &args.RegionConfig) {
+		regionClient := pgkit.NewPGClient(ctx, regionConf)
+		defer func() {
+			if err := regionClient.Close(); err != nil {
+				logger.Fatal("failed to close pg region client", zap.Error(err))
+			}
+		}()
+
+		regionPartitionDAOs[region] = dao.NewPGCommentPartitionDAO(regionClient)
+	}
+
+	partitionDAO := dao.NewPGCommentPartitionDAO(pgClient)
+	if err := maintainRegionPartitions(ctx, partitionDAO, regionPartitionDAOs, args.Ahead, args.RetentionMonths); err != nil {
+		logger.Fatal("failed to maintain partitions", zap.Error(err))
+	}
+
+	logger.Info("run partition job successfully, terminating ...")
+
+	return nil
+}
+
+// maintainRegionPartitions maintains the partitions of the home database and then of the databases of the regions,
+// the comments of the pinned tenants are partitioned in their regions by the same months.
+func maintainRegionPartitions(ctx context.Context, homeDAO dao.CommentPartitionDAO, regionDAOs map[string]dao.CommentPartitionDAO, ahead, retentionMonths int) error {
+	if err := maintainPartitions(ctx, homeDAO, ahead, retentionMonths); err != nil {
+		return err
+	}
+
+	regions := make([]string, 0, len(regionDAOs))
+	for region := range regionDAOs {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		regionCtx := logkit.FromContext(ctx).With(zap.String("region", region)).WithContext(ctx)
+		if err := maintainPartitions(regionCtx, regionDAOs[region], ahead, retentionMonths); err != nil {
+			return fmt.Errorf("failed to maintain partitions of region %s: %w", region, err)
+		}
+	}
+
+	return nil
+}
+
+// maintainPartitions creates the partitions of this month and the months ahead,
+// and drops the partitions older than the retention if any.
+func maintainPartitions(ctx context.Context, partitionDAO dao.CommentPartitionDAO, ahead, retentionMonths int) error {
+	logger := logkit.FromContext(ctx)
+
+	// anchor to the first day of the month, adding months to the end of a month skips the next month
+	thisMonth := dao.NewCommentPartition(time.Now()).Month
+
+	for i := 0; i <= ahead; i++ {
+		partition, err := partitionDAO.CreatePartition(ctx, thisMonth.AddDate(0, i, 0))
+		if err != nil {
+			return fmt.Errorf("failed to create partition: %w", err)
+		}
+
+		logger.Info("ensure partition exists", zap.String("partition", partition.Name))
+	}
+
+	if retentionMonths > 0 {
+		before := thisMonth.AddDate(0, -retentionMonths, 0)
+
+		dropped, err := partitionDAO.DropPartitionsBefore(ctx, before)
+		for _, partition := range dropped {
+			logger.Info("drop partition", zap.String("partition", partition.Name))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to drop partitions before %v: %w", before, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// RegionConfig pins the comments of the tenants to the PostgreSQL databases and the object storages of their regions.
+type RegionConfig struct {
+	tenantkit.RegionConfig
+	storagekit.RegionalMinIOConfig
+	URLs       map[string]string `long:"urls" env:"URLS" env-delim:"," description:"the URLs of PostgreSQL of the regions, as region:url pairs, required for every region a tenant is pinned to"`
+	RedisAddrs map[string]string `long:"redis_addrs" env:"REDIS_ADDRS" env-delim:"," description:"the addresses of Redis of the regions keeping the comment drafts, as region:addr pairs, required for every region a tenant is pinned to by the API"`
+}
+
+// regionPGClient is the PostgreSQL client of a region along with its statement cache.
+type regionPGClient struct {
+	client    *pgkit.PGClient
+	stmtCache *pgkit.StmtCache
+}
+
+// newRegionPGClients returns the PostgreSQL clients of the regions any tenant is pinned to, which are shared by the
+// regional DAOs of a command.
+func newRegionPGClients(ctx context.Context, lifecycle *runkit.Lifecycle, pgConf *pgkit.PGConfig, conf *RegionConfig, meter *otelkit.PrometheusServiceMeter) map[string]*regionPGClient {
+	regionConfs := regionPGConfigs(ctx, pgConf, conf)
+
+	clients := make(map[string]*regionPGClient, len(regionConfs))
+	for region, regionConf := range regionConfs {
+		regionClient := pgkit.NewPGClient(ctx, regionConf, pgkit.WithMeter(meter))
+		lifecycle.OnClose("pg region client", regionClient.Close)
+
+		regionStmtCache := pgkit.NewStmtCache(ctx, regionClient, regionConf, meter)
+		lifecycle.OnClose("pg region statement cache", regionStmtCache.Close)
+
+		clients[region] = &regionPGClient{client: regionClient, stmtCache: regionStmtCache}
+	}
+
+	return clients
+}
+
+// newRegionalCommentDAO returns the DAO keeping the comments of the pinned tenants in the databases of their regions,
+// the home DAO is returned as is if no tenant is pinned. The regional databases are neither cached nor replicated,
+// so the comments of the pinned tenants never leave their regions.
+func newRegionalCommentDAO(homeDAO dao.CommentDAO, clients map[string]*regionPGClient, conf *RegionConfig) dao.CommentDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.CommentDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewPGCommentDAO(client.client, client.stmtCache)
+	}
+
+	return dao.NewRegionalCommentDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalUserBlockDAO returns the DAO keeping the blocks of the users of the pinned tenants in the databases of
+// their regions, the home DAO is returned as is if no tenant is pinned.
+func newRegionalUserBlockDAO(homeDAO dao.UserBlockDAO, clients map[string]*regionPGClient, conf *RegionConfig) dao.UserBlockDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.UserBlockDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewPGUserBlockDAO(client.client)
+	}
+
+	return dao.NewRegionalUserBlockDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalUserReputationDAO returns the DAO keeping the reputations of the users of the pinned tenants in the
+// databases of their regions, the home DAO is returned as is if no tenant is pinned.
+func newRegionalUserReputationDAO(homeDAO dao.UserReputationDAO, clients map[string]*regionPGClient, conf *RegionConfig) dao.UserReputationDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.UserReputationDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewPGUserReputationDAO(client.client)
+	}
+
+	return dao.NewRegionalUserReputationDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalCommentDraftDAO returns the DAO keeping the drafts of the pinned tenants in the Redis of their regions,
+// the home DAO is returned as is if no tenant is pinned.
+func newRegionalCommentDraftDAO(ctx context.Context, lifecycle *runkit.Lifecycle, homeDAO dao.CommentDraftDAO, redisConf *rediskit.RedisConfig, ttl time.Duration, conf *RegionConfig, meter *otelkit.PrometheusServiceMeter) dao.CommentDraftDAO {
+	regions := conf.Regions()
+	if len(regions) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.CommentDraftDAO, len(regions))
+	for _, region := range regions {
+		addr, ok := conf.RedisAddrs[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find Redis address of region", zap.String("region", region))
+		}
+
+		regionConf := *redisConf
+		regionConf.Addr = addr
+
+		regionClient := rediskit.NewRedisClient(ctx, &regionConf, rediskit.WithMeter(meter))
+		lifecycle.OnClose("redis region client", regionClient.Close)
+
+		regionDAOs[region] = dao.NewRedisCommentDraftDAO(regionClient, ttl)
+	}
+
+	return dao.NewRegionalCommentDraftDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// regionPGConfigs returns the PostgreSQL configs of the regions any tenant is pinned to by the regions, which share
+// the other settings of the home one except the replicas.
+func regionPGConfigs(ctx context.Context, pgConf *pgkit.PGConfig, conf *RegionConfig) map[string]*pgkit.PGConfig {
+	urls := regionURLs(ctx, conf)
+
+	regionConfs := make(map[string]*pgkit.PGConfig, len(urls))
+	for region, url := range urls {
+		regionConf := *pgConf
+		regionConf.URL = url
+		regionConf.ReplicaURLs = nil
+
+		regionConfs[region] = &regionConf
+	}
+
+	return regionConfs
+}
+
+// regionURLs returns the PostgreSQL URLs of the regions any tenant is pinned to by the regions.
+func regionURLs(ctx context.Context, conf *RegionConfig) map[string]string {
+	regions := conf.Regions()
+
+	urls := make(map[string]string, len(regions))
+	for _, region := range regions {
+		url, ok := conf.URLs[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find PostgreSQL URL of region", zap.String("region", region))
+		}
+
+		urls[region] = url
+	}
+
+	return urls
+}
@@ -0,0 +1,125 @@
+package comment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newSchemaChangeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema-change",
+		Short: "shows, moves, backfills and verifies the online schema changes of the comments table",
+		RunE:  runSchemaChange,
+	}
+}
+
+type SchemaChangeArgs struct {
+	Action                      string `long:"action" env:"ACTION" description:"the action on the schema change" choice:"status" choice:"stage" choice:"backfill" choice:"verify" default:"status"`
+	Change                      string `long:"change" env:"CHANGE" description:"the name of the schema change, required except for the status action"`
+	Stage                       string `long:"stage" env:"STAGE" description:"the stage to move the schema change to by the stage action: off, dual_write, read_new or complete"`
+	migrationkit.BackfillConfig `group:"backfill" namespace:"backfill" env-namespace:"BACKFILL"`
+	logkit.LoggerConfig         `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	pgkit.PGConfig              `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	configkit.FileConfig
+}
+
+// runSchemaChange runs a step of an online schema change of the comments table. The change is dual written first,
+// then backfilled and verified before the reads are cut over to the new column, see migrationkit.Stage.
+func runSchemaChange(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args SchemaChangeArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	logger = logger.With(zap.String("action", args.Action), zap.String("schema_change", args.Change))
+	ctx = logger.WithContext(ctx)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig)
+	defer func() {
+		if err := pgClient.Close(); err != nil {
+			logger.Fatal("failed to close pg client", zap.Error(err))
+		}
+	}()
+
+	if err := schemaChange(ctx, pgClient, &args); err != nil {
+		logger.Fatal("failed to run schema change", zap.Error(err))
+	}
+
+	return nil
+}
+
+func schemaChange(ctx context.Context, pgClient *pgkit.PGClient, args *SchemaChangeArgs) error {
+	logger := logkit.FromContext(ctx)
+
+	if args.Action == "status" {
+		stages, err := migrationkit.ListStages(ctx, pgClient)
+		if err != nil {
+			return err
+		}
+
+		for name := range dao.CommentSchemaChanges {
+			if _, ok := stages[name]; !ok {
+				stages[name] = migrationkit.StageOff
+			}
+		}
+
+		for name, stage := range stages {
+			logger.Info("schema change stage", zap.String("name", name), zap.Stringer("stage", stage))
+		}
+
+		return nil
+	}
+
+	change, ok := dao.CommentSchemaChanges[args.Change]
+	if !ok {
+		return fmt.Errorf("unknown schema change %q", args.Change)
+	}
+
+	switch args.Action {
+	case "stage":
+		stage, err := migrationkit.ParseStage(args.Stage)
+		if err != nil {
+			return err
+		}
+
+		previous, err := migrationkit.SetStage(ctx, pgClient, change.Name, stage)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("move schema change stage", zap.Stringer("from", previous), zap.Stringer("to", stage))
+	case "backfill":
+		updated, err := migrationkit.Backfill(ctx, pgClient, change, &args.BackfillConfig)
+		if err != nil {
+			return err
+		}
+
+		logger.Info("backfill schema change successfully", zap.Int("updated", updated))
+	case "verify":
+		result, err := migrationkit.Verify(ctx, pgClient, change, args.VerifyBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if result.Mismatched > 0 {
+			return fmt.Errorf("%d of %d rows mismatch, e.g. %v", result.Mismatched, result.Checked, result.Samples)
+		}
+
+		logger.Info("verify schema change successfully", zap.Int("checked", result.Checked))
+	}
+
+	return nil
+}
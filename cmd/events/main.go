@@ -0,0 +1,14 @@
+package events
+
+import "github.com/spf13/cobra"
+
+func NewEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events [command]",
+		Short: "operate the event streams",
+	}
+
+	cmd.AddCommand(newReplayCommand())
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newReplayCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay",
+		Short: "replays the events of a topic into a consumer group from an offset or a time",
+		RunE:  runReplay,
+	}
+}
+
+type ReplayArgs struct {
+	From                         string  `long:"from" env:"FROM" description:"where the replay starts in each partition: an RFC 3339 time, an offset, oldest or newest" required:"true"`
+	Partitions                   []int32 `long:"partitions" env:"PARTITIONS" env-delim:"," description:"the partitions to replay, all the partitions if empty"`
+	DryRun                       bool    `long:"dry_run" env:"DRY_RUN" description:"print the offsets without resetting them"`
+	logkit.LoggerConfig          `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	kafkakit.KafkaConsumerConfig `group:"kafka_consumer" namespace:"kafka_consumer" env-namespace:"KAFKA_CONSUMER"`
+	configkit.FileConfig
+}
+
+// runReplay rewinds the checkpoints of the consumer group, so the group consumes the events again from the position
+// once its consumers start, e.g. to rebuild a store derived from the events. The consumers of the group must be
+// stopped first, and they must be idempotent, since the events before their last checkpoints are handled twice.
+func runReplay(_ *cobra.Command, _ []string) error {
+	var args ReplayArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	logger = logger.With(
+		zap.String("topic", args.Topic),
+		zap.String("group", args.Group),
+		zap.String("from", args.From),
+	)
+
+	pos, err := kafkakit.ParseReplayPosition(args.From)
+	if err != nil {
+		logger.Fatal("failed to parse replay position", zap.Error(err))
+	}
+
+	client, err := sarama.NewClient(args.Addrs, sarama.NewConfig())
+	if err != nil {
+		logger.Fatal("failed to create Kafka client", zap.Error(err))
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			logger.Fatal("failed to close Kafka client", zap.Error(err))
+		}
+	}()
+
+	offsets, err := kafkakit.ResolveOffsets(client, args.Topic, args.Partitions, pos)
+	if err != nil {
+		logger.Fatal("failed to resolve replay offsets", zap.Error(err))
+	}
+
+	if args.DryRun {
+		for partition, offset := range offsets {
+			logger.Info("resolve replay offset", zap.Int32("partition", partition), zap.Int64("offset", offset))
+		}
+
+		return nil
+	}
+
+	previous, err := kafkakit.ResetOffsets(client, args.Group, args.Topic, offsets)
+	if err != nil {
+		logger.Fatal("failed to reset consumer group offsets", zap.Error(err))
+	}
+
+	for partition, offset := range offsets {
+		logger.Info("reset consumer group offset",
+			zap.Int32("partition", partition),
+			zap.Int64("previous_offset", previous[partition]),
+			zap.Int64("offset", offset),
+		)
+	}
+
+	logger.Info("replay events successfully, terminating ...")
+
+	return nil
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"log"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/cmd/all"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/cmd/comment"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/cmd/events"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/cmd/video"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +18,8 @@ func main() {
 
 	cmd.AddCommand(video.NewVideoCommand())
 	cmd.AddCommand(comment.NewCommentCommand())
+	cmd.AddCommand(all.NewAllCommand())
+	cmd.AddCommand(events.NewEventsCommand())
 
 	if err := cmd.Execute(); err != nil {
 		log.Fatal(err)
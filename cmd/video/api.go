@@ -2,23 +2,32 @@ package video
 
 import (
 	"context"
-	"log"
 	"net"
 
-	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/service"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/client"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/ratekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/spf13/cobra"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -32,24 +41,35 @@ func newAPICommand() *cobra.Command {
 }
 
 type APIArgs struct {
-	GRPCAddr                             string                       `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
-	CommentClientConnConfig              grpckit.GrpcClientConnConfig `group:"comment" namespace:"comment" env-namespace:"COMMENT"`
+	GRPCAddr                             string        `long:"grpc_addr" env:"GRPC_ADDR" default:":8081"`
+	CachePrefillLimit                    int64         `long:"cache_prefill_limit" env:"CACHE_PREFILL_LIMIT" description:"the number of videos to cache on start before ready, not prefilled if zero" default:"0"`
+	CommentClientConfig                  client.Config `group:"comment" namespace:"comment" env-namespace:"COMMENT"`
 	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
 	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
 	mongokit.MongoConfig                 `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	VideoShardConfig                     `group:"shard" namespace:"shard" env-namespace:"SHARD"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
 	storagekit.MinIOConfig               `group:"minio" namespace:"minio" env-namespace:"MINIO"`
+	httpkit.SignedURLConfig              `group:"signed_url" namespace:"signed_url" env-namespace:"SIGNED_URL"`
+	PageTokenConfig                      pagekit.Config `group:"page_token" namespace:"page_token" env-namespace:"PAGE_TOKEN"`
 	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	ratekit.RateLimitConfig              `group:"rate_limit" namespace:"rate_limit" env-namespace:"RATE_LIMIT"`
+	hedgekit.HedgeConfig                 `group:"hedge" namespace:"hedge" env-namespace:"HEDGE"`
 	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
-	kafkakit.KafkaProducerConfig         `group:"kafka_producer" namespace:"kafka_producer" env-namespace:"KAFKA_PRODUCER"`
+	serverkit.GrpcServerConfig           `group:"grpc_server" namespace:"grpc_server" env-namespace:"GRPC_SERVER"`
+	eventkit.TransportConfig
+	eventkit.ProducerConfig
+	configkit.FileConfig
 }
 
 func runAPI(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	var args APIArgs
-	if _, err := flags.NewParser(&args, flags.Default).Parse(); err != nil {
-		log.Fatal("failed to parse flag", err.Error())
-	}
+	config := configkit.Load(&args)
 
 	logger := logkit.NewLogger(&args.LoggerConfig)
 	defer func() {
@@ -58,64 +78,158 @@ func runAPI(_ *cobra.Command, _ []string) error {
 
 	ctx = logger.WithContext(ctx)
 
-	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig)
-	defer func() {
-		if err := mongoClient.Close(); err != nil {
-			logger.Fatal("failed to close mongo client", zap.Error(err))
-		}
-	}()
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
 
-	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig)
-	defer func() {
-		if err := redisClient.Close(); err != nil {
-			logger.Fatal("failed to close redis client", zap.Error(err))
-		}
-	}()
+	// the log level, the rate limits and the cache TTLs are reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(APIArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*APIArgs).LoggerConfig.Level)
+		return nil
+	})
 
-	commentClientConn := grpckit.NewGrpcClientConn(ctx, &args.CommentClientConnConfig)
-	defer func() {
-		if err := commentClientConn.Close(); err != nil {
-			logger.Fatal("failed to close comment gRPC client", zap.Error(err))
-		}
-	}()
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
 
-	producer := kafkakit.NewKafkaProducer(ctx, &args.KafkaProducerConfig)
-	defer func() {
-		if err := producer.Close(); err != nil {
-			logger.Fatal("failed to close Kafka producer", zap.Error(err))
-		}
-	}()
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
 
-	mongoVideoDAO := dao.NewMongoVideoDAO(mongoClient.Database().Collection("videos"))
-	videoDAO := dao.NewRedisVideoDAO(redisClient, mongoVideoDAO)
-	storage := storagekit.NewMinIOClient(ctx, &args.MinIOConfig)
-	commentClient := commentpb.NewCommentClient(commentClientConn)
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig, mongokit.WithMeter(meter))
+	lifecycle.OnClose("mongo client", mongoClient.Close)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	rateLimiter := ratekit.NewRateLimiter(ctx, redisClient, &args.RateLimitConfig)
+	lifecycle.OnClose("rate limiter", rateLimiter.Close)
+	reloader.OnReload("rate limiter", func(next interface{}) error {
+		return rateLimiter.SetLimits(&next.(*APIArgs).RateLimitConfig)
+	})
+
+	commentClient := client.NewCommentClient(ctx, &args.CommentClientConfig, meter,
+		grpc.WithChainUnaryInterceptor(meter.UnaryClientInterceptor(), tenantkit.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+	)
+	lifecycle.OnClose("comment gRPC client", commentClient.Close)
+
+	producer := eventkit.NewProducer(ctx, &args.TransportConfig, &args.ProducerConfig)
+	lifecycle.OnClose("event producer", producer.Close)
+
+	// the videos missing the cache are read from the secondaries with hedging to cut the tail latency,
+	// each read is routed to one of the secondaries by the driver, so a hedged read likely reaches another one
+	mongoVideoDAO := newVideoDAO(ctx, mongoClient, &args.VideoShardConfig)
+	replicaVideoDAO := newVideoDAO(ctx, mongoClient, &args.VideoShardConfig, options.Collection().SetReadPreference(readpref.SecondaryPreferred()))
+	hedger := hedgekit.NewHedger(ctx, "video.get", &args.HedgeConfig, meter)
+	var videoDAO dao.VideoDAO = dao.NewRedisVideoDAO(redisClient, dao.NewHedgedVideoDAO(mongoVideoDAO, []dao.VideoDAO{replicaVideoDAO}, hedger))
+
+	// the data of the tenants pinned to the other regions bypass the caches and the secondaries of the home region
+	regionMongoClients := newRegionMongoClients(ctx, lifecycle, &args.MongoConfig, &args.RegionConfig, meter)
+	regionPGClients := newRegionPGClients(ctx, lifecycle, &args.PGConfig, &args.RegionConfig, meter)
+	regionRedisClients := newRegionRedisClients(ctx, lifecycle, &args.RedisConfig, &args.RegionConfig, meter)
+
+	videoDAO = newRegionalVideoDAO(ctx, videoDAO, regionMongoClients, &args.RegionConfig)
+	storage := storagekit.NewRegionalMinIOClient(ctx, storagekit.NewMinIOClient(ctx, &args.MinIOConfig), &args.MinIOConfig, &args.RegionalMinIOConfig, &args.RegionConfig.RegionConfig)
+
+	// the instances are not ready until the first page of the videos is cached, so they do not stampede MongoDB on rollout
+	if args.CachePrefillLimit > 0 {
+		adminServer.AddWarmUp("cache prefill", func(ctx context.Context) error {
+			return prefillVideoCache(ctx, videoDAO, args.CachePrefillLimit)
+		})
+	}
 
-	svc := service.NewService(videoDAO, storage, commentClient, producer)
+	// the polls are few next to the videos, so they are kept unsharded in the region of their tenant, and their votes
+	// are counted in Redis until they are closed, by the jobs command once they are due
+	pollDAO := newRegionalPollDAO(ctx, dao.NewMongoPollDAO(newPollCollection(ctx, mongoClient.Database())), regionMongoClients, &args.RegionConfig)
+	pollVoteDAO := newRegionalPollVoteDAO(dao.NewRedisPollVoteDAO(redisClient), regionRedisClients, &args.RegionConfig)
+
+	// the claims are kept unsharded in the region of their tenant like the polls, a video has one active claim at most
+	claimDAO := newRegionalClaimDAO(ctx, dao.NewMongoClaimDAO(newClaimCollection(ctx, mongoClient.Database())), regionMongoClients, &args.RegionConfig)
+
+	// the players record the progress every few seconds, so it is kept in Redis and flushed to Postgres
+	// by the video jobs, where it is kept after the hot progress expires
+	watchHistoryDAO := newRegionalWatchHistoryDAO(dao.NewRedisWatchHistoryDAO(redisClient, dao.NewPGWatchHistoryDAO(pgClient)), regionPGClients, &args.RegionConfig)
+	userSettingsDAO := newRegionalUserSettingsDAO(dao.NewRedisUserSettingsDAO(redisClient, dao.NewPGUserSettingsDAO(pgClient)), regionPGClients, &args.RegionConfig)
+
+	// the video URLs are signed for the media route of the gateway if the keys are set, which shares the keys
+	svc := service.NewService(videoDAO, storage, commentClient, producer,
+		service.WithURLSigner(httpkit.NewURLSigner(ctx, &args.SignedURLConfig)),
+		service.WithPolls(pollDAO, pollVoteDAO),
+		service.WithThumbnailStats(dao.NewRedisThumbnailStatsDAO(redisClient)),
+		service.WithWatchHistory(watchHistoryDAO),
+		service.WithWatchProgressSync(dao.NewRedisWatchProgressPubSub(redisClient)),
+		service.WithUserSettings(userSettingsDAO),
+		service.WithClaims(claimDAO),
+		service.WithPageTokens(pagekit.NewCodec(ctx, &args.PageTokenConfig)),
+	)
 
 	logger.Info("listen to gRPC addr", zap.String("grpc_addr", args.GRPCAddr))
 	lis, err := net.Listen("tcp", args.GRPCAddr)
 	if err != nil {
 		logger.Fatal("failed to listen gRPC addr", zap.Error(err))
 	}
-	defer func() {
-		if err := lis.Close(); err != nil {
-			logger.Fatal("failed to close gRPC listener", zap.Error(err))
-		}
-	}()
 
-	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
-	defer func() {
-		if err := meter.Close(); err != nil {
-			logger.Fatal("failed to close meter", zap.Error(err))
+	grpcServer := serverkit.NewGrpcServer(ctx, &args.GrpcServerConfig,
+		serverkit.WithMeter(meter),
+		serverkit.WithMethodScopes(service.MethodScopes()),
+		serverkit.WithErrorMappings(service.ErrorMappings()...),
+		serverkit.WithUnaryInterceptors(rateLimiter.UnaryServerInterceptor()),
+		serverkit.WithStreamInterceptors(rateLimiter.StreamServerInterceptor()),
+	)
+
+	if faults := grpcServer.FaultInjector(); faults != nil {
+		adminServer.Handle("/faults", faults)
+	}
+
+	// the server is registered last, so it stops accepting and drains the requests before the clients are closed
+	lifecycle.OnShutdown("gRPC server", grpcServer.Shutdown)
+
+	return lifecycle.Run(serveGRPC(lis, grpcServer, svc, logger))
+}
+
+// prefillVideoCache caches the first page of the videos of the default tenant along with each of the videos.
+func prefillVideoCache(ctx context.Context, videoDAO dao.VideoDAO, limit int64) error {
+	videos, err := videoDAO.List(ctx, limit, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, video := range videos {
+		if _, err := videoDAO.Get(ctx, video.ID); err != nil {
+			return err
 		}
-	}()
+	}
+
+	return nil
+}
+
+// newPollCollection returns the poll collection of the database with the indexes created.
+func newPollCollection(ctx context.Context, database *mongo.Database) *mongo.Collection {
+	collection := database.Collection("polls")
+	if err := dao.CreatePollIndexes(ctx, collection); err != nil {
+		logkit.FromContext(ctx).Fatal("failed to create poll indexes", zap.String("database", database.Name()), zap.Error(err))
+	}
 
-	return runkit.GracefulRun(serveGRPC(lis, svc, logger, grpc.UnaryInterceptor(meter.UnaryServerInterceptor())), &args.GracefulConfig)
+	return collection
 }
 
-func serveGRPC(lis net.Listener, svc pb.VideoServer, logger *logkit.Logger, opt ...grpc.ServerOption) runkit.GracefulRunFunc {
-	grpcServer := grpc.NewServer(opt...)
+// newClaimCollection returns the claim collection of the database with the indexes created.
+func newClaimCollection(ctx context.Context, database *mongo.Database) *mongo.Collection {
+	collection := database.Collection("claims")
+	if err := dao.CreateClaimIndexes(ctx, collection); err != nil {
+		logkit.FromContext(ctx).Fatal("failed to create claim indexes", zap.String("database", database.Name()), zap.Error(err))
+	}
+
+	return collection
+}
+
+func serveGRPC(lis net.Listener, grpcServer *serverkit.GrpcServer, svc pb.VideoServer, logger *logkit.Logger) runkit.GracefulRunFunc {
 	pb.RegisterVideoServer(grpcServer, svc)
 
 	return func(ctx context.Context) error {
@@ -127,8 +241,6 @@ func serveGRPC(lis net.Listener, svc pb.VideoServer, logger *logkit.Logger, opt
 
 		<-ctx.Done()
 
-		grpcServer.GracefulStop()
-
 		return nil
 	}
 }
@@ -0,0 +1,108 @@
+package video
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/service"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newJobsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "jobs",
+		Short: "starts video background job scheduler",
+		RunE:  runJobs,
+	}
+}
+
+type JobsArgs struct {
+	PollCloseSchedule                    string `long:"poll_close_schedule" env:"POLL_CLOSE_SCHEDULE" description:"the cron expression of the job closing the polls due" default:"@every 10s"`
+	WatchHistoryFlushSchedule            string `long:"watch_history_flush_schedule" env:"WATCH_HISTORY_FLUSH_SCHEDULE" description:"the cron expression of the job flushing the watch history to Postgres" default:"@every 30s"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	mongokit.MongoConfig                 `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	pgkit.PGConfig                       `group:"postgres" namespace:"postgres" env-namespace:"POSTGRES"`
+	rediskit.RedisConfig                 `group:"redis" namespace:"redis" env-namespace:"REDIS"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
+	scheduler.SchedulerConfig            `group:"scheduler" namespace:"scheduler" env-namespace:"SCHEDULER"`
+	configkit.FileConfig
+}
+
+// runJobs runs the background jobs of the videos on their schedules. Every replica runs the scheduler,
+// and each run is locked in Redis, so the jobs keep running as long as any replica is up.
+func runJobs(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args JobsArgs
+	config := configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
+
+	// the log level is reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(JobsArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*JobsArgs).LoggerConfig.Level)
+		return nil
+	})
+
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig, mongokit.WithMeter(meter))
+	lifecycle.OnClose("mongo client", mongoClient.Close)
+
+	pgClient := pgkit.NewPGClient(ctx, &args.PGConfig, pgkit.WithMeter(meter))
+	lifecycle.OnClose("pg client", pgClient.Close)
+
+	redisClient := rediskit.NewRedisClient(ctx, &args.RedisConfig, rediskit.WithMeter(meter))
+	lifecycle.OnClose("redis client", redisClient.Close)
+
+	jobScheduler := scheduler.NewScheduler(ctx, &args.SchedulerConfig, meter, scheduler.WithRedisLock(redisClient))
+
+	// the polls due are closed with the votes counted in Redis by the API, the polls of every tenant in every region at once
+	regionMongoClients := newRegionMongoClients(ctx, lifecycle, &args.MongoConfig, &args.RegionConfig, meter)
+	regionRedisClients := newRegionRedisClients(ctx, lifecycle, &args.RedisConfig, &args.RegionConfig, meter)
+	pollDAO := newRegionalPollDAO(ctx, dao.NewMongoPollDAO(mongoClient.Database().Collection("polls")), regionMongoClients, &args.RegionConfig)
+	pollVoteDAO := newRegionalPollVoteDAO(dao.NewRedisPollVoteDAO(redisClient), regionRedisClients, &args.RegionConfig)
+	pollCloser := service.NewPollCloser(ctx, pollDAO, pollVoteDAO)
+	if err := pollCloser.Schedule(jobScheduler, args.PollCloseSchedule); err != nil {
+		logger.Fatal("failed to schedule poll close job", zap.Error(err))
+	}
+
+	// the progress recorded in Redis by the API is flushed to Postgres, where it is kept after it expires in Redis
+	watchHistoryFlusher := service.NewWatchHistoryFlusher(ctx, dao.NewRedisWatchHistoryDAO(redisClient, dao.NewPGWatchHistoryDAO(pgClient)))
+	if err := watchHistoryFlusher.Schedule(jobScheduler, args.WatchHistoryFlushSchedule); err != nil {
+		logger.Fatal("failed to schedule watch history flush job", zap.Error(err))
+	}
+
+	return lifecycle.Run(jobScheduler.Run)
+}
@@ -11,6 +11,9 @@ func NewVideoCommand() *cobra.Command {
 	cmd.AddCommand(newAPICommand())
 	cmd.AddCommand(newGatewayCommand())
 	cmd.AddCommand(newStreamCommand())
+	cmd.AddCommand(newReshardCommand())
+	cmd.AddCommand(newMigrationCommand())
+	cmd.AddCommand(newJobsCommand())
 
 	return cmd
 }
@@ -0,0 +1,65 @@
+package video
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newMigrationCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migration",
+		Short: "runs the video module migration job",
+		RunE:  runMigration,
+	}
+}
+
+type MigrationArgs struct {
+	logkit.LoggerConfig          `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	migrationkit.MigrationConfig `group:"migration" namespace:"migration" env-namespace:"MIGRATION"`
+	RegionConfig                 `group:"region" namespace:"region" env-namespace:"REGION"`
+	configkit.FileConfig
+}
+
+func runMigration(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args MigrationArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	migration := migrationkit.NewMigration(ctx, &args.MigrationConfig)
+	if err := migration.Up(); err != nil {
+		logger.Fatal("failed to run migration", zap.Error(err))
+	}
+
+	// the PostgreSQL of the regions the tenants are pinned to take the same migrations as the home one
+	for _, region := range args.RegionConfig.Regions() {
+		url, ok := args.RegionConfig.PostgresURLs[region]
+		if !ok {
+			logger.Fatal("failed to find PostgreSQL URL of region", zap.String("region", region))
+		}
+
+		regionConf := args.MigrationConfig
+		regionConf.URL = url
+
+		regionMigration := migrationkit.NewMigration(ctx, &regionConf)
+		if err := regionMigration.Up(); err != nil {
+			logger.Fatal("failed to run migration of region", zap.String("region", region), zap.Error(err))
+		}
+	}
+
+	logger.Info("run migration job successfully, terminating ...")
+
+	return nil
+}
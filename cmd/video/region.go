@@ -0,0 +1,190 @@
+package video
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// RegionConfig pins the data of the tenants to the databases and the object storages of their regions.
+type RegionConfig struct {
+	tenantkit.RegionConfig
+	storagekit.RegionalMinIOConfig
+	URLs         map[string]string `long:"urls" env:"URLS" env-delim:"," description:"the URLs of MongoDB of the regions, as region:url pairs, required for every region a tenant is pinned to"`
+	PostgresURLs map[string]string `long:"postgres_urls" env:"POSTGRES_URLS" env-delim:"," description:"the URLs of PostgreSQL of the regions, as region:url pairs, required for every region a tenant is pinned to by the API and the migration"`
+	RedisAddrs   map[string]string `long:"redis_addrs" env:"REDIS_ADDRS" env-delim:"," description:"the addresses of Redis of the regions, as region:addr pairs, required for every region a tenant is pinned to by the API and the jobs"`
+}
+
+// newRegionMongoClients returns the MongoDB clients of the regions any tenant is pinned to, which share the
+// database name and the other settings of the home one.
+func newRegionMongoClients(ctx context.Context, lifecycle *runkit.Lifecycle, mongoConf *mongokit.MongoConfig, conf *RegionConfig, meter *otelkit.PrometheusServiceMeter) map[string]*mongokit.MongoClient {
+	regions := conf.Regions()
+
+	clients := make(map[string]*mongokit.MongoClient, len(regions))
+	for _, region := range regions {
+		url, ok := conf.URLs[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find MongoDB URL of region", zap.String("region", region))
+		}
+
+		regionConf := *mongoConf
+		regionConf.URL = url
+
+		regionClient := mongokit.NewMongoClient(ctx, &regionConf, mongokit.WithMeter(meter))
+		lifecycle.OnClose("mongo region client", regionClient.Close)
+
+		clients[region] = regionClient
+	}
+
+	return clients
+}
+
+// newRegionPGClients returns the PostgreSQL clients of the regions any tenant is pinned to, which share the other
+// settings of the home one except the replicas.
+func newRegionPGClients(ctx context.Context, lifecycle *runkit.Lifecycle, pgConf *pgkit.PGConfig, conf *RegionConfig, meter *otelkit.PrometheusServiceMeter) map[string]*pgkit.PGClient {
+	regions := conf.Regions()
+
+	clients := make(map[string]*pgkit.PGClient, len(regions))
+	for _, region := range regions {
+		url, ok := conf.PostgresURLs[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find PostgreSQL URL of region", zap.String("region", region))
+		}
+
+		regionConf := *pgConf
+		regionConf.URL = url
+		regionConf.ReplicaURLs = nil
+
+		regionClient := pgkit.NewPGClient(ctx, &regionConf, pgkit.WithMeter(meter))
+		lifecycle.OnClose("pg region client", regionClient.Close)
+
+		clients[region] = regionClient
+	}
+
+	return clients
+}
+
+// newRegionRedisClients returns the Redis clients of the regions any tenant is pinned to, which share the other
+// settings of the home one.
+func newRegionRedisClients(ctx context.Context, lifecycle *runkit.Lifecycle, redisConf *rediskit.RedisConfig, conf *RegionConfig, meter *otelkit.PrometheusServiceMeter) map[string]*rediskit.RedisClient {
+	regions := conf.Regions()
+
+	clients := make(map[string]*rediskit.RedisClient, len(regions))
+	for _, region := range regions {
+		addr, ok := conf.RedisAddrs[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find Redis address of region", zap.String("region", region))
+		}
+
+		regionConf := *redisConf
+		regionConf.Addr = addr
+
+		regionClient := rediskit.NewRedisClient(ctx, &regionConf, rediskit.WithMeter(meter))
+		lifecycle.OnClose("redis region client", regionClient.Close)
+
+		clients[region] = regionClient
+	}
+
+	return clients
+}
+
+// newRegionalVideoDAO returns the DAO keeping the videos of the pinned tenants in the MongoDB of their regions,
+// the home DAO is returned as is if no tenant is pinned. The regional databases are neither cached nor sharded,
+// so the videos of the pinned tenants never leave their regions.
+func newRegionalVideoDAO(ctx context.Context, homeDAO dao.VideoDAO, clients map[string]*mongokit.MongoClient, conf *RegionConfig) dao.VideoDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.VideoDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewMongoVideoDAO(newVideoCollection(ctx, client.Database()))
+	}
+
+	return dao.NewRegionalVideoDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalPollDAO returns the DAO keeping the polls of the pinned tenants in the MongoDB of their regions,
+// the home DAO is returned as is if no tenant is pinned.
+func newRegionalPollDAO(ctx context.Context, homeDAO dao.PollDAO, clients map[string]*mongokit.MongoClient, conf *RegionConfig) dao.PollDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.PollDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewMongoPollDAO(newPollCollection(ctx, client.Database()))
+	}
+
+	return dao.NewRegionalPollDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalPollVoteDAO returns the DAO counting the votes of the pinned tenants in the Redis of their regions,
+// the home DAO is returned as is if no tenant is pinned.
+func newRegionalPollVoteDAO(homeDAO dao.PollVoteDAO, clients map[string]*rediskit.RedisClient, conf *RegionConfig) dao.PollVoteDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.PollVoteDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewRedisPollVoteDAO(client)
+	}
+
+	return dao.NewRegionalPollVoteDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalClaimDAO returns the DAO keeping the claims of the pinned tenants in the MongoDB of their regions,
+// the home DAO is returned as is if no tenant is pinned.
+func newRegionalClaimDAO(ctx context.Context, homeDAO dao.ClaimDAO, clients map[string]*mongokit.MongoClient, conf *RegionConfig) dao.ClaimDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.ClaimDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewMongoClaimDAO(newClaimCollection(ctx, client.Database()))
+	}
+
+	return dao.NewRegionalClaimDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalWatchHistoryDAO returns the DAO keeping the watch progress of the pinned tenants in the PostgreSQL of
+// their regions, the home DAO is returned as is if no tenant is pinned. The progress is recorded in the regional
+// databases directly, since the jobs flush the progress recorded in the Redis of the home region only.
+func newRegionalWatchHistoryDAO(homeDAO dao.WatchHistoryDAO, clients map[string]*pgkit.PGClient, conf *RegionConfig) dao.WatchHistoryDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.WatchHistoryDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewPGWatchHistoryDAO(client)
+	}
+
+	return dao.NewRegionalWatchHistoryDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
+
+// newRegionalUserSettingsDAO returns the DAO keeping the settings of the pinned tenants in the PostgreSQL of their
+// regions without the cache, the home DAO is returned as is if no tenant is pinned.
+func newRegionalUserSettingsDAO(homeDAO dao.UserSettingsDAO, clients map[string]*pgkit.PGClient, conf *RegionConfig) dao.UserSettingsDAO {
+	if len(clients) == 0 {
+		return homeDAO
+	}
+
+	regionDAOs := make(map[string]dao.UserSettingsDAO, len(clients))
+	for region, client := range clients {
+		regionDAOs[region] = dao.NewPGUserSettingsDAO(client)
+	}
+
+	return dao.NewRegionalUserSettingsDAO(homeDAO, regionDAOs, &conf.RegionConfig)
+}
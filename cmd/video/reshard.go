@@ -0,0 +1,69 @@
+package video
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func newReshardCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reshard",
+		Short: "moves the videos from the old shard map to the new shard map",
+		RunE:  runReshard,
+	}
+}
+
+type ReshardArgs struct {
+	FromDatabases        []string `long:"from_databases" env:"FROM_DATABASES" env-delim:"," description:"the MongoDB databases of the old shard map" required:"true"`
+	ToDatabases          []string `long:"to_databases" env:"TO_DATABASES" env-delim:"," description:"the MongoDB databases of the new shard map" required:"true"`
+	BatchSize            int64    `long:"batch_size" env:"BATCH_SIZE" description:"the number of videos listed at once" default:"100"`
+	TenantIDs            []string `long:"tenant_ids" env:"TENANT_IDS" env-delim:"," description:"the tenants whose videos are moved" default:"default"`
+	logkit.LoggerConfig  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	mongokit.MongoConfig `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	configkit.FileConfig
+}
+
+func runReshard(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+
+	var args ReshardArgs
+	configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx = logger.WithContext(ctx)
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig)
+	defer func() {
+		if err := mongoClient.Close(); err != nil {
+			logger.Fatal("failed to close mongo client", zap.Error(err))
+		}
+	}()
+
+	from := newVideoShards(ctx, mongoClient, args.FromDatabases)
+	to := newVideoShards(ctx, mongoClient, args.ToDatabases)
+
+	// the video DAO is scoped to a tenant, so the videos are moved tenant by tenant
+	for _, tenantID := range args.TenantIDs {
+		moved, err := dao.ReshardVideos(tenantkit.WithTenantID(ctx, tenantID), from, to, args.BatchSize)
+		if err != nil {
+			logger.Fatal("failed to reshard videos", zap.Error(err), zap.String("tenant_id", tenantID), zap.Int("moved", moved))
+		}
+
+		logger.Info("reshard videos of the tenant successfully", zap.String("tenant_id", tenantID), zap.Int("moved", moved))
+	}
+
+	logger.Info("reshard videos successfully, terminating ...")
+
+	return nil
+}
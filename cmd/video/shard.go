@@ -0,0 +1,50 @@
+package video
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+)
+
+type VideoShardConfig struct {
+	Databases []string `long:"databases" env:"DATABASES" env-delim:"," description:"the MongoDB databases of the video shards, the videos are not sharded if empty"`
+}
+
+// newVideoDAO returns the mongo video DAO, sharded if the shard map is configured.
+// The options of the collections set e.g. the read preference of the DAO.
+func newVideoDAO(ctx context.Context, mongoClient *mongokit.MongoClient, conf *VideoShardConfig, opts ...*options.CollectionOptions) dao.VideoDAO {
+	if len(conf.Databases) == 0 {
+		return dao.NewMongoVideoDAO(newVideoCollection(ctx, mongoClient.Database(), opts...))
+	}
+
+	return dao.NewShardedVideoDAO(newVideoShards(ctx, mongoClient, conf.Databases, opts...))
+}
+
+func newVideoShards(ctx context.Context, mongoClient *mongokit.MongoClient, databases []string, opts ...*options.CollectionOptions) []*dao.VideoShard {
+	shards := make([]*dao.VideoShard, 0, len(databases))
+	for _, database := range databases {
+		shards = append(shards, &dao.VideoShard{
+			Name: database,
+			DAO:  dao.NewMongoVideoDAO(newVideoCollection(ctx, mongoClient.Client.Database(database), opts...)),
+		})
+	}
+
+	return shards
+}
+
+// newVideoCollection returns the video collection of the database with the indexes created.
+func newVideoCollection(ctx context.Context, database *mongo.Database, opts ...*options.CollectionOptions) *mongo.Collection {
+	logger := logkit.FromContext(ctx).With(zap.String("database", database.Name()))
+
+	collection := database.Collection("videos", opts...)
+	if err := dao.CreateVideoIndexes(ctx, collection); err != nil {
+		logger.Fatal("failed to create video indexes", zap.Error(err))
+	}
+
+	return collection
+}
@@ -2,18 +2,19 @@ package video
 
 import (
 	"context"
-	"log"
 
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/stream"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/adminkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/runkit"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
 	"github.com/spf13/cobra"
-	"go.uber.org/zap"
 )
 
 func newStreamCommand() *cobra.Command {
@@ -25,20 +26,26 @@ func newStreamCommand() *cobra.Command {
 }
 
 type StreamArgs struct {
-	runkit.GracefulConfig        `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
-	logkit.LoggerConfig          `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
-	mongokit.MongoConfig         `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
-	kafkakit.KafkaProducerConfig `group:"kafka_producer" namespace:"kafka_producer" env-namespace:"KAFKA_PRODUCER"`
-	kafkakit.KafkaConsumerConfig `group:"kafka_consumer" namespace:"kafka_consumer" env-namespace:"KAFKA_CONSUMER"`
+	runkit.GracefulConfig                `group:"graceful" namespace:"graceful" env-namespace:"GRACEFUL"`
+	logkit.LoggerConfig                  `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+	adminkit.AdminConfig                 `group:"admin" namespace:"admin" env-namespace:"ADMIN"`
+	otelkit.TracerConfig                 `group:"tracer" namespace:"tracer" env-namespace:"TRACER"`
+	otelkit.PrometheusServiceMeterConfig `group:"meter" namespace:"meter" env-namespace:"METER"`
+	mongokit.MongoConfig                 `group:"mongo" namespace:"mongo" env-namespace:"MONGO"`
+	storagekit.MinIOConfig               `group:"minio" namespace:"minio" env-namespace:"MINIO"`
+	VideoShardConfig                     `group:"shard" namespace:"shard" env-namespace:"SHARD"`
+	RegionConfig                         `group:"region" namespace:"region" env-namespace:"REGION"`
+	eventkit.TransportConfig
+	eventkit.ProducerConfig
+	eventkit.ConsumerConfig
+	configkit.FileConfig
 }
 
 func runStream(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
 	var args StreamArgs
-	if _, err := flags.NewParser(&args, flags.Default).Parse(); err != nil {
-		log.Fatal("failed to parse flag", err.Error())
-	}
+	config := configkit.Load(&args)
 
 	logger := logkit.NewLogger(&args.LoggerConfig)
 	defer func() {
@@ -47,39 +54,59 @@ func runStream(_ *cobra.Command, _ []string) error {
 
 	ctx = logger.WithContext(ctx)
 
-	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig)
-	defer func() {
-		if err := mongoClient.Close(); err != nil {
-			logger.Fatal("failed to close mongo client", zap.Error(err))
-		}
-	}()
+	lifecycle := runkit.NewLifecycle(ctx, &args.GracefulConfig)
 
-	producer := kafkakit.NewKafkaProducer(ctx, &args.KafkaProducerConfig)
-	defer func() {
-		if err := producer.Close(); err != nil {
-			logger.Fatal("failed to close Kafka producer", zap.Error(err))
-		}
-	}()
+	// the log level, the rate limits and the cache TTLs are reloaded without restart, the others on restart only
+	reloader := configkit.NewReloader(ctx, config, func() interface{} { return new(StreamArgs) })
+	lifecycle.OnClose("config reloader", reloader.Close)
+	reloader.OnReload("logger", func(next interface{}) error {
+		logger.SetLevel(next.(*StreamArgs).LoggerConfig.Level)
+		return nil
+	})
 
-	consumer := kafkakit.NewKafkaConsumer(ctx, &args.KafkaConsumerConfig)
-	defer func() {
-		if err := consumer.Close(); err != nil {
-			logger.Fatal("failed to close Kafka consumer", zap.Error(err))
+	tracerProvider := otelkit.NewTracerProvider(ctx, &args.TracerConfig)
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+
+	adminServer := adminkit.NewAdminServer(ctx, &args.AdminConfig)
+	lifecycle.OnShutdown("admin server", adminServer.Shutdown)
+	adminServer.OnQuit(lifecycle.Quit)
+
+	meter := otelkit.NewPrometheusServiceMeter(ctx, &args.PrometheusServiceMeterConfig)
+	lifecycle.OnClose("meter", meter.Close)
+
+	// the autoscaler scales the workers by the lag of the consumer group, NATS reports the pending messages itself
+	if args.Transport == eventkit.TransportKafka {
+		if lagMonitor := kafkakit.NewLagMonitor(ctx, &args.KafkaConsumerConfig, meter); lagMonitor != nil {
+			lifecycle.OnClose("consumer lag monitor", lagMonitor.Close)
+			adminServer.Handle("/consumer_lag", lagMonitor)
 		}
-	}()
+	}
+
+	mongoClient := mongokit.NewMongoClient(ctx, &args.MongoConfig, mongokit.WithMeter(meter))
+	lifecycle.OnClose("mongo client", mongoClient.Close)
+
+	producer := eventkit.NewProducer(ctx, &args.TransportConfig, &args.ProducerConfig)
+	lifecycle.OnClose("event producer", producer.Close)
+
+	consumer := eventkit.NewConsumer(ctx, &args.TransportConfig, &args.ConsumerConfig)
+	lifecycle.OnClose("event consumer", consumer.Close)
+
+	regionMongoClients := newRegionMongoClients(ctx, lifecycle, &args.MongoConfig, &args.RegionConfig, meter)
+	videoDAO := newRegionalVideoDAO(ctx, newVideoDAO(ctx, mongoClient, &args.VideoShardConfig), regionMongoClients, &args.RegionConfig)
 
-	videoDAO := dao.NewMongoVideoDAO(mongoClient.Database().Collection("videos"))
+	// the video files are fingerprinted from the storage while transcoded, for matching the copyright claims
+	storage := storagekit.NewRegionalMinIOClient(ctx, storagekit.NewMinIOClient(ctx, &args.MinIOConfig), &args.MinIOConfig, &args.RegionalMinIOConfig, &args.RegionConfig.RegionConfig)
 
-	svc := stream.NewStream(videoDAO, producer)
+	svc := stream.NewStream(videoDAO, producer, stream.WithFingerprints(storage))
 
-	return runkit.GracefulRun(serveConsumer(consumer, svc, logger), &args.GracefulConfig)
+	return lifecycle.Run(serveConsumer(consumer, svc, logger))
 }
 
-func serveConsumer(consumer *kafkakit.KafkaConsumer, svc pb.VideoStreamServer, logger *logkit.Logger) runkit.GracefulRunFunc {
-	handlers := pb.NewVideoStreamHandlers(svc, logkit.NewSaramaLogger(logger))
+func serveConsumer(consumer eventkit.Consumer, svc pb.VideoStreamServer, logger *logkit.Logger) runkit.GracefulRunFunc {
+	handler := pb.NewHandleVideoCreatedConsumerHandler(svc, logkit.NewSaramaLogger(logger))
 
 	return func(ctx context.Context) error {
-		if err := consumer.Consume(ctx, handlers.HandleVideoCreatedHandler); err != nil {
+		if err := consumer.Consume(ctx, handler); err != nil {
 			return err
 		}
 
@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// the kinds of the banned patterns
+const (
+	// BannedPatternKindWord matches a word or phrase case-insensitively as a whole word
+	BannedPatternKindWord = "word"
+	// BannedPatternKindRegex matches a regular expression of the RE2 syntax
+	BannedPatternKindRegex = "regex"
+)
+
+// BannedPattern is a word or pattern banned from the comment contents of a tenant.
+type BannedPattern struct {
+	ID        uuid.UUID
+	TenantID  string
+	Kind      string
+	Pattern   string
+	CreatedAt time.Time
+}
+
+func (p *BannedPattern) ToProto() *pb.BannedPattern {
+	pbPattern := &pb.BannedPattern{
+		Kind:    BannedPatternKindToProto(p.Kind),
+		Pattern: p.Pattern,
+	}
+
+	// the candidates of the dry runs are not created
+	if p.ID != uuid.Nil {
+		pbPattern.Id = p.ID.String()
+		pbPattern.CreatedAt = timestamppb.New(p.CreatedAt)
+	}
+
+	return pbPattern
+}
+
+func BannedPatternKindToProto(kind string) pb.BannedPatternKind {
+	switch kind {
+	case BannedPatternKindWord:
+		return pb.BannedPatternKind_BANNED_PATTERN_KIND_WORD
+	case BannedPatternKindRegex:
+		return pb.BannedPatternKind_BANNED_PATTERN_KIND_REGEX
+	default:
+		return pb.BannedPatternKind_BANNED_PATTERN_KIND_UNSPECIFIED
+	}
+}
+
+// BannedPatternKindFromProto returns the kind of the proto kind, or an empty kind if it is unspecified.
+func BannedPatternKindFromProto(kind pb.BannedPatternKind) string {
+	switch kind {
+	case pb.BannedPatternKind_BANNED_PATTERN_KIND_WORD:
+		return BannedPatternKindWord
+	case pb.BannedPatternKind_BANNED_PATTERN_KIND_REGEX:
+		return BannedPatternKindRegex
+	default:
+		return ""
+	}
+}
+
+// BannedPatternDAO keeps the banned patterns of the tenant of the context.
+type BannedPatternDAO interface {
+	// List lists the banned patterns in the order of creation
+	List(ctx context.Context) ([]*BannedPattern, error)
+	Create(ctx context.Context, pattern *BannedPattern) (uuid.UUID, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+var (
+	ErrBannedPatternNotFound      = errors.New("banned pattern not found")
+	ErrBannedPatternAlreadyExists = errors.New("banned pattern already exists")
+)
+
+// BannedPatternPubSub notifies the replicas of the changes of the banned patterns of the tenants,
+// so that they reload the patterns of the tenant instead of waiting for their caches to expire.
+type BannedPatternPubSub interface {
+	// Publish notifies the change of the banned patterns of the tenant of the context
+	Publish(ctx context.Context) error
+	// Subscribe receives the tenants whose banned patterns are changed,
+	// the channel is closed after the context is done.
+	Subscribe(ctx context.Context) (<-chan string, error)
+}
+
+// bannedPatternChannel is the channel of the changes of the banned patterns of every tenant
+const bannedPatternChannel = "banned_patterns"
+
+// bannedPatternSubscriptionBufferSize is the number of changes buffered for a slow subscriber
+const bannedPatternSubscriptionBufferSize = 16
+
+func NewFakeBannedPattern(kind string) *BannedPattern {
+	return &BannedPattern{
+		ID:      uuid.New(),
+		Kind:    kind,
+		Pattern: fmt.Sprintf("banned-%s", uuid.NewString()[:8]),
+	}
+}
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// memoryBannedPatternDAO keeps the banned patterns in memory, it is meant for running
+// the modules without PostgreSQL in local development.
+type memoryBannedPatternDAO struct {
+	mu       sync.RWMutex
+	patterns map[uuid.UUID]*BannedPattern
+}
+
+var _ BannedPatternDAO = (*memoryBannedPatternDAO)(nil)
+
+func NewMemoryBannedPatternDAO() *memoryBannedPatternDAO {
+	return &memoryBannedPatternDAO{
+		patterns: make(map[uuid.UUID]*BannedPattern),
+	}
+}
+
+func (dao *memoryBannedPatternDAO) List(ctx context.Context) ([]*BannedPattern, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var patterns []*BannedPattern
+	for _, pattern := range dao.patterns {
+		if pattern.TenantID == tenantID {
+			p := *pattern
+			patterns = append(patterns, &p)
+		}
+	}
+
+	sort.Slice(patterns, func(i, j int) bool {
+		if !patterns[i].CreatedAt.Equal(patterns[j].CreatedAt) {
+			return patterns[i].CreatedAt.Before(patterns[j].CreatedAt)
+		}
+
+		return patterns[i].ID.String() < patterns[j].ID.String()
+	})
+
+	return patterns, nil
+}
+
+func (dao *memoryBannedPatternDAO) Create(ctx context.Context, pattern *BannedPattern) (uuid.UUID, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	pattern.TenantID = tenantkit.FromContext(ctx)
+
+	for id, stored := range dao.patterns {
+		if id == pattern.ID || (stored.TenantID == pattern.TenantID && stored.Kind == pattern.Kind && stored.Pattern == pattern.Pattern) {
+			return uuid.Nil, ErrBannedPatternAlreadyExists
+		}
+	}
+
+	if pattern.ID == uuid.Nil {
+		pattern.ID = uuid.New()
+	}
+	if pattern.CreatedAt.IsZero() {
+		pattern.CreatedAt = time.Now()
+	}
+
+	p := *pattern
+	dao.patterns[p.ID] = &p
+
+	return pattern.ID, nil
+}
+
+func (dao *memoryBannedPatternDAO) Delete(ctx context.Context, id uuid.UUID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	pattern, ok := dao.patterns[id]
+	if !ok || pattern.TenantID != tenantkit.FromContext(ctx) {
+		return ErrBannedPatternNotFound
+	}
+
+	delete(dao.patterns, id)
+
+	return nil
+}
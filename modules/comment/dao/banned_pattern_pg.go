@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// pgBannedPatternDAO scopes every query to the tenant of the context.
+type pgBannedPatternDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ BannedPatternDAO = (*pgBannedPatternDAO)(nil)
+
+func NewPGBannedPatternDAO(pgClient *pgkit.PGClient) *pgBannedPatternDAO {
+	return &pgBannedPatternDAO{
+		client: pgClient,
+	}
+}
+
+func (dao *pgBannedPatternDAO) List(ctx context.Context) ([]*BannedPattern, error) {
+	var patterns []*BannedPattern
+
+	query := dao.client.ModelContext(ctx, &patterns).Where("tenant_id = ?", tenantkit.FromContext(ctx))
+	if err := pgkit.OrderBy(query, pgkit.Asc("created_at"), pgkit.Asc("id")).Select(); err != nil {
+		return nil, err
+	}
+
+	return patterns, nil
+}
+
+func (dao *pgBannedPatternDAO) Create(ctx context.Context, pattern *BannedPattern) (uuid.UUID, error) {
+	pattern.TenantID = tenantkit.FromContext(ctx)
+
+	// the ID and the creation time are set by the database if they are empty
+	if _, err := dao.client.ModelContext(ctx, pattern).Returning("*").Insert(); err != nil {
+		if pgkit.IsUniqueViolation(err) {
+			return uuid.Nil, ErrBannedPatternAlreadyExists
+		}
+
+		return uuid.Nil, err
+	}
+
+	return pattern.ID, nil
+}
+
+func (dao *pgBannedPatternDAO) Delete(ctx context.Context, id uuid.UUID) error {
+	if res, err := dao.client.ModelContext(ctx, &BannedPattern{ID: id}).WherePK().Where("tenant_id = ?", tenantkit.FromContext(ctx)).Delete(); err != nil {
+		return err
+	} else if res.RowsAffected() == 0 {
+		return ErrBannedPatternNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PGBannedPatternDAO", func() {
+	var (
+		bannedPatternDAO *pgBannedPatternDAO
+		ctx              context.Context
+		tenantID         string
+	)
+
+	BeforeEach(func() {
+		bannedPatternDAO = NewPGBannedPatternDAO(pgClient)
+
+		// every test has its own tenant, so the patterns of the other tests are not listed
+		tenantID = "banned-" + uuid.NewString()[:8]
+		ctx = tenantkit.WithTenantID(context.Background(), tenantID)
+	})
+
+	AfterEach(func() {
+		pgExec("DELETE FROM banned_patterns WHERE tenant_id = ?;", tenantID)
+	})
+
+	Describe("Create", func() {
+		var pattern *BannedPattern
+
+		BeforeEach(func() {
+			pattern = NewFakeBannedPattern(BannedPatternKindWord)
+			pattern.ID = uuid.Nil
+		})
+
+		When("success", func() {
+			It("creates the pattern in the tenant", func() {
+				id, err := bannedPatternDAO.Create(ctx, pattern)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).NotTo(Equal(uuid.Nil))
+				Expect(pattern.TenantID).To(Equal(tenantID))
+				Expect(pattern.CreatedAt).NotTo(BeZero())
+
+				patterns, err := bannedPatternDAO.List(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(patterns).To(HaveLen(1))
+				Expect(patterns[0].ID).To(Equal(id))
+				Expect(patterns[0].Pattern).To(Equal(pattern.Pattern))
+			})
+		})
+
+		When("the pattern already exists", func() {
+			It("returns ErrBannedPatternAlreadyExists", func() {
+				_, err := bannedPatternDAO.Create(ctx, &BannedPattern{Kind: pattern.Kind, Pattern: pattern.Pattern})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = bannedPatternDAO.Create(ctx, pattern)
+				Expect(err).To(MatchError(ErrBannedPatternAlreadyExists))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		When("the patterns are in another tenant", func() {
+			It("does not list them", func() {
+				_, err := bannedPatternDAO.Create(tenantkit.WithTenantID(ctx, tenantID+"-another"), NewFakeBannedPattern(BannedPatternKindRegex))
+				Expect(err).NotTo(HaveOccurred())
+				defer pgExec("DELETE FROM banned_patterns WHERE tenant_id = ?;", tenantID+"-another")
+
+				patterns, err := bannedPatternDAO.List(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(patterns).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		When("the pattern exists", func() {
+			It("deletes the pattern", func() {
+				id, err := bannedPatternDAO.Create(ctx, NewFakeBannedPattern(BannedPatternKindWord))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bannedPatternDAO.Delete(ctx, id)).To(Succeed())
+				Expect(bannedPatternDAO.List(ctx)).To(BeEmpty())
+			})
+		})
+
+		When("the pattern is not found", func() {
+			It("returns ErrBannedPatternNotFound", func() {
+				Expect(bannedPatternDAO.Delete(ctx, uuid.New())).To(MatchError(ErrBannedPatternNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryBannedPatternPubSub notifies the subscribers in the same process, it is meant for running
+// the modules without Redis in local development. The changes overflowing the buffer of a slow
+// subscriber are dropped like the ones of Redis Pub/Sub.
+type memoryBannedPatternPubSub struct {
+	mu          sync.RWMutex
+	subscribers map[chan string]struct{}
+}
+
+var _ BannedPatternPubSub = (*memoryBannedPatternPubSub)(nil)
+
+func NewMemoryBannedPatternPubSub() *memoryBannedPatternPubSub {
+	return &memoryBannedPatternPubSub{
+		subscribers: make(map[chan string]struct{}),
+	}
+}
+
+func (ps *memoryBannedPatternPubSub) Publish(ctx context.Context) error {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	for ch := range ps.subscribers {
+		select {
+		case ch <- tenantkit.FromContext(ctx):
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (ps *memoryBannedPatternPubSub) Subscribe(ctx context.Context) (<-chan string, error) {
+	tenantIDs := make(chan string, bannedPatternSubscriptionBufferSize)
+
+	ps.mu.Lock()
+	ps.subscribers[tenantIDs] = struct{}{}
+	ps.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		ps.mu.Lock()
+		defer ps.mu.Unlock()
+
+		delete(ps.subscribers, tenantIDs)
+		close(tenantIDs)
+	}()
+
+	return tenantIDs, nil
+}
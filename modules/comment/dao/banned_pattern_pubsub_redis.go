@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// redisBannedPatternPubSub publishes the tenants to a Redis Pub/Sub channel shared by the tenants,
+// the changes published while a subscriber is not connected are not delivered to it.
+type redisBannedPatternPubSub struct {
+	client *rediskit.RedisClient
+}
+
+var _ BannedPatternPubSub = (*redisBannedPatternPubSub)(nil)
+
+func NewRedisBannedPatternPubSub(client *rediskit.RedisClient) *redisBannedPatternPubSub {
+	return &redisBannedPatternPubSub{
+		client: client,
+	}
+}
+
+func (ps *redisBannedPatternPubSub) Publish(ctx context.Context) error {
+	return ps.client.Publish(ctx, bannedPatternChannel, tenantkit.FromContext(ctx)).Err()
+}
+
+func (ps *redisBannedPatternPubSub) Subscribe(ctx context.Context) (<-chan string, error) {
+	sub := ps.client.Subscribe(ctx, bannedPatternChannel)
+
+	// wait for the confirmation so that no change published after Subscribe returns is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+
+	logger := logkit.FromContext(ctx)
+	tenantIDs := make(chan string, bannedPatternSubscriptionBufferSize)
+
+	go func() {
+		defer close(tenantIDs)
+		defer func() {
+			if err := sub.Close(); err != nil {
+				logger.Error("failed to close banned pattern subscription", zap.Error(err))
+			}
+		}()
+
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+
+				select {
+				case tenantIDs <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return tenantIDs, nil
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BannedPatternRedisPubSub", func() {
+	var (
+		pubsub *redisBannedPatternPubSub
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(logkit.NewNopLogger().WithContext(context.Background()))
+		pubsub = NewRedisBannedPatternPubSub(redisClient)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Subscribe", func() {
+		var tenantIDs <-chan string
+
+		BeforeEach(func() {
+			var err error
+			tenantIDs, err = pubsub.Subscribe(ctx)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the patterns of a tenant are changed", func() {
+			It("receives the tenant", func() {
+				Expect(pubsub.Publish(tenantkit.WithTenantID(ctx, "banned-tenant"))).To(Succeed())
+				Eventually(tenantIDs).Should(Receive(Equal("banned-tenant")))
+			})
+		})
+
+		When("context is done", func() {
+			It("closes the channel", func() {
+				cancel()
+				Eventually(tenantIDs).Should(BeClosed())
+			})
+		})
+	})
+})
@@ -4,46 +4,222 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/markdownkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Comment struct {
-	ID        uuid.UUID
-	VideoID   string
-	Content   string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             uuid.UUID
+	TenantID       string
+	VideoID        string
+	ParentID       uuid.UUID // the comment replied to, uuid.Nil for the top-level comments
+	UserID         string    // the author of the X-User-Id header, empty if the comment is created without a user
+	Content        string
+	ContentHTML    string        // the sanitized HTML rendered from the markdown content, empty if it is not rendered yet
+	Sentiment      float64       `pg:",use_zero"` // the sentiment score of the content from -1 to 1, zero if it is not scored
+	Quality        float64       `pg:",use_zero"` // the quality score of the content from 0 to 1, zero if it is not scored
+	Status         CommentStatus `pg:",use_zero"`
+	Toxicity       float64       `pg:",use_zero"` // the toxicity score of the content from 0 to 1, zero if it is not held
+	Likes          int64         `pg:",use_zero"`
+	CollapsedCount int64         `pg:",use_zero"` // the number of the near-duplicate comments of the same user collapsed into it
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func (c *Comment) ToProto() *pb.CommentInfo {
 	return &pb.CommentInfo{
+		Id:             c.ID.String(),
+		VideoId:        c.VideoID,
+		ParentId:       c.parentID(),
+		UserId:         c.UserID,
+		Content:        c.Content,
+		Sentiment:      c.Sentiment,
+		Status:         pb.CommentStatus(c.Status),
+		Toxicity:       c.Toxicity,
+		Likes:          c.Likes,
+		CollapsedCount: c.CollapsedCount,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// QualityLikeWeight is the quality score added by the first like of a comment, the likes add to the score by their
+// logarithm, so the first likes count the most and a popular comment does not bury the others for good.
+const QualityLikeWeight = 0.25
+
+// QualityScore returns the score ranking the comment by quality, the quality of its content plus its likes.
+func (c *Comment) QualityScore() float64 {
+	return c.Quality + QualityLikeWeight*math.Log1p(float64(c.Likes))
+}
+
+func (c *Comment) ToProtoV2() *pbv2.CommentInfo {
+	return &pbv2.CommentInfo{
 		Id:        c.ID.String(),
 		VideoId:   c.VideoID,
+		ParentId:  c.parentID(),
 		Content:   c.Content,
 		CreatedAt: timestamppb.New(c.CreatedAt),
 		UpdatedAt: timestamppb.New(c.UpdatedAt),
 	}
 }
 
+// RenderContent renders the markdown content into the sanitized HTML stored along with it.
+func (c *Comment) RenderContent() {
+	c.ContentHTML = markdownkit.Render(c.Content)
+}
+
+// HTML returns the sanitized HTML of the content, which is rendered on the fly if it is not stored,
+// e.g. for the comments imported or created before the contents were rendered.
+func (c *Comment) HTML() string {
+	if c.ContentHTML != "" {
+		return c.ContentHTML
+	}
+
+	return markdownkit.Render(c.Content)
+}
+
+func (c *Comment) parentID() string {
+	if c.ParentID == uuid.Nil {
+		return ""
+	}
+
+	return c.ParentID.String()
+}
+
+// Cursor is the position of a comment in the order of creation, the comments after it are listed by the next page.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (c *Comment) Cursor() *Cursor {
+	return &Cursor{CreatedAt: c.CreatedAt, ID: c.ID}
+}
+
+// PendingCursor is the position of a comment in the order of moderation, the most toxic first and then in the order
+// of creation. The toxicity of a held comment does not change, so the cursor is stable as the comments are moderated.
+type PendingCursor struct {
+	Toxicity  float64
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func (c *Comment) PendingCursor() *PendingCursor {
+	return &PendingCursor{Toxicity: c.Toxicity, CreatedAt: c.CreatedAt, ID: c.ID}
+}
+
+// CommentStatus is the moderation status of a comment, the values are the same as pb.CommentStatus.
+type CommentStatus int
+
+const (
+	// CommentStatusPublished is the status of the comments listed
+	CommentStatusPublished CommentStatus = iota
+	// CommentStatusPending is the status of the comments held for moderation, which are not listed until approved
+	CommentStatusPending
+)
+
+// SentimentFilter selects the comments by the sign of their sentiment scores.
+type SentimentFilter int
+
+const (
+	SentimentFilterAll SentimentFilter = iota
+	SentimentFilterPositive
+	SentimentFilterNeutral
+	SentimentFilterNegative
+)
+
+// SentimentOrder orders the comments by their sentiment scores, the ties are in the order of update.
+type SentimentOrder int
+
+const (
+	// SentimentOrderNone orders the comments by update only, like ListByVideoID
+	SentimentOrderNone SentimentOrder = iota
+	SentimentOrderDesc
+	SentimentOrderAsc
+)
+
+// CommentDAO stores the comments of the tenants of the contexts. The lists of a video skip the comments held for
+// moderation, which are listed by ListPending only.
 type CommentDAO interface {
 	ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error)
+	// ListByVideoIDAsOf lists the comments of the video as they were at the time
+	ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error)
+	// ListBySentiment lists the comments of the video selected by the filter in the order of their sentiment scores
+	ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error)
+	// ListByQuality lists the comments of the video in the order of their quality scores, see Comment.QualityScore
+	ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error)
+	// ListByParentID lists the replies of the parent comment of the video in the order of creation after the cursor,
+	// the top-level comments are listed if parentID is uuid.Nil, and the first page is listed if after is nil
+	ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error)
+	// EachByVideoID calls fn with the comments of the video batch by batch in the order of creation,
+	// the comments are read batch by batch instead of all at once, and the iteration stops at the first error of fn
+	EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error
+	// ListTop lists the comments of the video in the order of their engagement scores, the comments whose engagements
+	// are not tracked yet are not listed
+	ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error)
+	// ListSimilar lists the comments of the video nearest to the embedding by the cosine distance, the comments not
+	// embedded yet, or embedded into other dimensions, e.g. by another model, are not listed
+	ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error)
+	// ListPending lists the comments of the tenant held for moderation after the cursor, the most toxic ones first,
+	// from the first one if the cursor is nil
+	ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error)
+	Get(ctx context.Context, id uuid.UUID) (*Comment, error)
+	// GetAsOf gets the comment as it was at the time
+	GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error)
 	Create(ctx context.Context, comment *Comment) (uuid.UUID, error)
 	Update(ctx context.Context, comment *Comment) error
+	// Hold holds the comment for moderation along with its toxicity score, the update time is not changed
+	Hold(ctx context.Context, id uuid.UUID, toxicity float64) error
+	// Approve publishes the comment held for moderation, the update time is not changed
+	Approve(ctx context.Context, id uuid.UUID) error
+	// Like records the like of the user on the comment once, and returns whether the like is new. The likes of the
+	// comment are counted by a CommentLikeCounterDAO, which sets them by SetLikes
+	Like(ctx context.Context, id uuid.UUID, userID string) (bool, error)
+	// SetLikes sets the likes of the comment, the update time is not changed. It is a no-op if the comment does not
+	// exist, e.g. it is deleted after it is liked
+	SetLikes(ctx context.Context, id uuid.UUID, likes int64) error
+	// Collapse adds a near-duplicate comment collapsed into the comment, the update time is not changed
+	Collapse(ctx context.Context, id uuid.UUID) error
+	// TrackEngagement adds the engagements of the comment to the engagement scores: the comment itself and a reply to
+	// its parent at its creation once, and the likes not tracked yet at the time. It is called again harmlessly
+	TrackEngagement(ctx context.Context, comment *Comment, at time.Time) error
+	// GetEmbeddingUpdatedAt gets the update time of the comment whose content is embedded, so an unchanged content is
+	// not embedded again, and it is zero if the comment is not embedded
+	GetEmbeddingUpdatedAt(ctx context.Context, id uuid.UUID) (time.Time, error)
+	// UpdateEmbedding stores the embedding of the content of the comment along with its update time, which replaces
+	// the previous one
+	UpdateEmbedding(ctx context.Context, comment *Comment, embedding []float32) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	DeleteByVideoID(ctx context.Context, videoID string) error
+	BulkImport(ctx context.Context, comments []*Comment) (int, error)
+	Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error)
 }
 
 var (
-	ErrCommentNotFound = errors.New("comment not found")
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrCommentAlreadyExists = errors.New("comment already exists")
+	ErrInvalidBatchSize     = errors.New("invalid batch size")
 )
 
-func listCommentKey(videoID string, limit, offset int) string {
-	return fmt.Sprintf("listComment:%s:%d:%d", videoID, limit, offset)
+func listCommentKey(tenantID, videoID string, limit, offset int) string {
+	return fmt.Sprintf("listComment:%s:%s:%d:%d", tenantID, videoID, limit, offset)
+}
+
+func listCommentKeyPattern(tenantID, videoID string) string {
+	return fmt.Sprintf("listComment:%s:%s:*", tenantID, videoID)
+}
+
+// CommentListCacheTag is the gateway cache tag of the comment lists of the video
+func CommentListCacheTag(videoID string) string {
+	return "comments:" + videoID
 }
 
 func NewFakeComment(videoID string) *Comment {
@@ -52,8 +228,9 @@ func NewFakeComment(videoID string) *Comment {
 	}
 
 	return &Comment{
-		ID:      uuid.New(),
-		VideoID: videoID,
-		Content: "comment test",
+		ID:       uuid.New(),
+		TenantID: tenantkit.DefaultTenantID,
+		VideoID:  videoID,
+		Content:  "comment test",
 	}
 }
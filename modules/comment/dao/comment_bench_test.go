@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// benchmarkCommentDAOs runs the benchmark against every implementation of CommentDAO on the hot paths of the service.
+func benchmarkCommentDAOs(b *testing.B, benchmark func(b *testing.B, commentDAO CommentDAO)) {
+	pgClient, stmtCache, redisClient, err := newTestClients()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		_ = stmtCache.Close()
+		_ = pgClient.Close()
+		_ = redisClient.Close()
+	})
+
+	b.Run("pg", func(b *testing.B) {
+		benchmark(b, NewPGCommentDAO(pgClient, stmtCache))
+	})
+
+	b.Run("redis", func(b *testing.B) {
+		benchmark(b, NewRedisCommentDAO(redisClient, NewPGCommentDAO(pgClient, stmtCache)))
+	})
+
+	b.Run("memory", func(b *testing.B) {
+		benchmark(b, NewMemoryCommentDAO())
+	})
+}
+
+func BenchmarkCommentDAO_ListByVideoID(b *testing.B) {
+	benchmarkCommentDAOs(b, func(b *testing.B, commentDAO CommentDAO) {
+		ctx := context.Background()
+		videoID := primitive.NewObjectID().Hex()
+
+		for i := 0; i < 100; i++ {
+			if _, err := commentDAO.Create(ctx, NewFakeComment(videoID)); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.Cleanup(func() {
+			_ = commentDAO.DeleteByVideoID(ctx, videoID)
+		})
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := commentDAO.ListByVideoID(ctx, videoID, 10, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkCommentDAO_Create(b *testing.B) {
+	benchmarkCommentDAOs(b, func(b *testing.B, commentDAO CommentDAO) {
+		ctx := context.Background()
+		videoID := primitive.NewObjectID().Hex()
+		b.Cleanup(func() {
+			_ = commentDAO.DeleteByVideoID(ctx, videoID)
+		})
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := commentDAO.Create(ctx, NewFakeComment(videoID)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
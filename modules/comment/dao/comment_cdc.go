@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// NewCommentCacheInvalidator returns the change handler of the comments table,
+// which deletes the cached comment lists of the video of the changed comment,
+// and invalidates the responses of the lists cached by the gateways.
+func NewCommentCacheInvalidator(dao *redisCommentDAO) cdckit.Handler {
+	return cdckit.HandlerFunc(func(ctx context.Context, event *cdckit.ChangeEvent) error {
+		var row struct {
+			TenantID string `json:"tenant_id"`
+			VideoID  string `json:"video_id"`
+		}
+		if err := json.Unmarshal(event.Row(), &row); err != nil {
+			return err
+		}
+
+		ctx = tenantkit.WithTenantID(ctx, row.TenantID)
+		if err := dao.DeleteListCache(ctx, row.VideoID); err != nil {
+			return err
+		}
+
+		return httpkit.PublishCacheInvalidation(ctx, dao.client, CommentListCacheTag(row.VideoID))
+	})
+}
@@ -0,0 +1,671 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("CommentDAO conformance", func() {
+	Describe("pgCommentDAO", func() {
+		itBehavesLikeCommentDAO(func() CommentDAO {
+			return NewPGCommentDAO(pgClient, stmtCache)
+		})
+	})
+
+	Describe("redisCommentDAO", func() {
+		itBehavesLikeCommentDAO(func() CommentDAO {
+			return NewRedisCommentDAO(redisClient, NewPGCommentDAO(pgClient, stmtCache))
+		})
+	})
+
+	Describe("memoryCommentDAO", func() {
+		itBehavesLikeCommentDAO(func() CommentDAO {
+			return NewMemoryCommentDAO()
+		})
+	})
+})
+
+// itBehavesLikeCommentDAO specifies the semantics every CommentDAO shares. Every spec comments on videos of its own,
+// and lists each video once after the writes, so the specs run against the shared databases and the cached lists.
+func itBehavesLikeCommentDAO(newCommentDAO func() CommentDAO) {
+	var (
+		commentDAO CommentDAO
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = newCommentDAO()
+		ctx = context.Background()
+		videoID = primitive.NewObjectID().Hex()
+
+		DeferCleanup(func() {
+			Expect(commentDAO.DeleteByVideoID(ctx, videoID)).To(Succeed())
+		})
+	})
+
+	create := func(comment *Comment) *Comment {
+		_, err := commentDAO.Create(ctx, comment)
+		Expect(err).NotTo(HaveOccurred())
+
+		return comment
+	}
+
+	// expectList lists the comments of the video and expects them to be the comments in order
+	expectList := func(limit, offset int, expected ...*Comment) {
+		comments, err := commentDAO.ListByVideoID(ctx, videoID, limit, offset)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(comments).To(HaveLen(len(expected)))
+		for i := range expected {
+			Expect(comments[i]).To(matchComment(expected[i]))
+		}
+	}
+
+	Describe("Get", func() {
+		It("gets the created comment", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Get(ctx, comment.ID)).To(matchComment(comment))
+		})
+
+		It("returns ErrCommentNotFound if the comment does not exist", func() {
+			_, err := commentDAO.Get(ctx, uuid.New())
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+
+			_, err := commentDAO.Get(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("Create", func() {
+		It("returns ErrCommentAlreadyExists if the comment exists", func() {
+			comment := NewFakeComment(videoID)
+			comment.CreatedAt = time.Now()
+			create(comment)
+
+			_, err := commentDAO.Create(ctx, comment)
+			Expect(err).To(MatchError(ErrCommentAlreadyExists))
+		})
+	})
+
+	Describe("Update", func() {
+		It("updates the content, the sentiment and the update time of the comment", func() {
+			comment := create(NewFakeComment(videoID))
+			created, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(commentDAO.Update(ctx, &Comment{ID: comment.ID, Content: "updated", Sentiment: 0.5})).To(Succeed())
+
+			updated, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Content).To(Equal("updated"))
+			Expect(updated.Sentiment).To(Equal(0.5))
+			Expect(updated.CreatedAt).To(BeTemporally("==", created.CreatedAt))
+			Expect(updated.UpdatedAt).To(BeTemporally(">", created.UpdatedAt))
+		})
+
+		It("returns ErrCommentNotFound if the comment does not exist", func() {
+			Expect(commentDAO.Update(ctx, &Comment{ID: uuid.New(), Content: "updated"})).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+
+			err := commentDAO.Update(tenantkit.WithTenantID(ctx, "another-tenant"), &Comment{ID: comment.ID, Content: "updated"})
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the comment", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+
+			_, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment does not exist", func() {
+			Expect(commentDAO.Delete(ctx, uuid.New())).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Delete(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID)).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("DeleteByVideoID", func() {
+		It("deletes the comments of the video only", func() {
+			comment := create(NewFakeComment(videoID))
+			another := create(NewFakeComment(""))
+			DeferCleanup(func() {
+				Expect(commentDAO.Delete(ctx, another.ID)).To(Succeed())
+			})
+
+			Expect(commentDAO.DeleteByVideoID(ctx, videoID)).To(Succeed())
+
+			_, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.Get(ctx, another.ID)).To(matchComment(another))
+		})
+	})
+
+	Describe("ListByVideoID", func() {
+		It("lists the comments of the video in the order of update", func() {
+			first := create(NewFakeComment(videoID))
+			second := create(NewFakeComment(videoID))
+			third := create(NewFakeComment(videoID))
+			create(NewFakeComment(""))
+
+			Expect(commentDAO.Update(ctx, &Comment{ID: first.ID, Content: "updated"})).To(Succeed())
+			first.Content = "updated"
+
+			expectList(0, 0, second, third, first)
+		})
+
+		It("paginates the comments by the limit and the offset", func() {
+			var comments []*Comment
+			for i := 0; i < 4; i++ {
+				comments = append(comments, create(NewFakeComment(videoID)))
+			}
+
+			expectList(2, 1, comments[1], comments[2])
+			expectList(2, 3, comments[3])
+			expectList(2, 4)
+		})
+
+		It("lists nothing if the video has no comments", func() {
+			expectList(0, 0)
+		})
+
+		It("does not list the comments of another tenant", func() {
+			create(NewFakeComment(videoID))
+
+			Expect(commentDAO.ListByVideoID(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, 0, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("ListBySentiment", func() {
+		var negative, neutral, positive, mostPositive *Comment
+
+		BeforeEach(func() {
+			newComment := func(sentiment float64) *Comment {
+				comment := NewFakeComment(videoID)
+				comment.Sentiment = sentiment
+
+				return create(comment)
+			}
+
+			positive = newComment(0.5)
+			negative = newComment(-1)
+			neutral = newComment(0)
+			mostPositive = newComment(1)
+			create(NewFakeComment(""))
+		})
+
+		// expectListBySentiment lists the comments of the video and expects them to be the comments in order
+		expectListBySentiment := func(filter SentimentFilter, order SentimentOrder, limit, offset int, expected ...*Comment) {
+			comments, err := commentDAO.ListBySentiment(ctx, videoID, filter, order, limit, offset)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(comments[i]).To(matchComment(expected[i]))
+			}
+		}
+
+		It("lists the comments of the video in the order of the sentiment scores", func() {
+			expectListBySentiment(SentimentFilterAll, SentimentOrderDesc, 0, 0, mostPositive, positive, neutral, negative)
+			expectListBySentiment(SentimentFilterAll, SentimentOrderAsc, 0, 0, negative, neutral, positive, mostPositive)
+		})
+
+		It("lists the comments of the sentiment in the order of update", func() {
+			expectListBySentiment(SentimentFilterPositive, SentimentOrderNone, 0, 0, positive, mostPositive)
+			expectListBySentiment(SentimentFilterNeutral, SentimentOrderNone, 0, 0, neutral)
+			expectListBySentiment(SentimentFilterNegative, SentimentOrderNone, 0, 0, negative)
+		})
+
+		It("paginates the comments by the limit and the offset", func() {
+			expectListBySentiment(SentimentFilterAll, SentimentOrderDesc, 2, 1, positive, neutral)
+		})
+
+		It("does not list the comments of another tenant", func() {
+			Expect(commentDAO.ListBySentiment(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, SentimentFilterAll, SentimentOrderDesc, 0, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("ListByQuality", func() {
+		var poor, fair, good *Comment
+
+		BeforeEach(func() {
+			newComment := func(quality float64) *Comment {
+				comment := NewFakeComment(videoID)
+				comment.Quality = quality
+
+				return create(comment)
+			}
+
+			fair = newComment(0.5)
+			poor = newComment(0.1)
+			good = newComment(0.9)
+			create(NewFakeComment(""))
+		})
+
+		// expectListByQuality lists the comments of the video and expects them to be the comments in order
+		expectListByQuality := func(limit, offset int, expected ...*Comment) {
+			comments, err := commentDAO.ListByQuality(ctx, videoID, limit, offset)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(comments[i].ID).To(Equal(expected[i].ID))
+			}
+		}
+
+		It("lists the comments of the video in the order of the quality scores", func() {
+			expectListByQuality(0, 0, good, fair, poor)
+			expectListByQuality(1, 1, fair)
+		})
+
+		It("adds the likes to the quality scores", func() {
+			Expect(commentDAO.SetLikes(ctx, poor.ID, 3)).To(Succeed())
+
+			// 0.1 + 0.25 * ln(4) is about 0.45, and 0.5 + 0.25 * ln(1) is 0.5
+			expectListByQuality(0, 0, good, fair, poor)
+
+			Expect(commentDAO.SetLikes(ctx, poor.ID, 4)).To(Succeed())
+			expectListByQuality(0, 0, good, poor, fair)
+		})
+	})
+
+	Describe("Like", func() {
+		It("records the like of a user once", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Like(ctx, comment.ID, "user")).To(BeTrue())
+			Expect(commentDAO.Like(ctx, comment.ID, "user")).To(BeFalse())
+			Expect(commentDAO.Like(ctx, comment.ID, "another user")).To(BeTrue())
+		})
+
+		It("returns ErrCommentNotFound if the comment does not exist", func() {
+			_, err := commentDAO.Like(ctx, uuid.New(), "user")
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+
+			_, err := commentDAO.Like(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID, "user")
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("SetLikes", func() {
+		It("sets the likes of the comment without changing the update time", func() {
+			comment := create(NewFakeComment(videoID))
+			created, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(commentDAO.SetLikes(ctx, comment.ID, 2)).To(Succeed())
+
+			liked, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(liked.Likes).To(Equal(int64(2)))
+			Expect(liked.UpdatedAt).To(BeTemporally("==", created.UpdatedAt))
+		})
+
+		It("does not set the likes of the comment of another tenant or the comment not existing", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.SetLikes(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID, 2)).To(Succeed())
+			Expect(commentDAO.SetLikes(ctx, uuid.New(), 2)).To(Succeed())
+
+			stored, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stored.Likes).To(BeZero())
+		})
+	})
+
+	Describe("Collapse", func() {
+		It("adds a duplicate to the comment without changing the update time", func() {
+			comment := create(NewFakeComment(videoID))
+			created, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(commentDAO.Collapse(ctx, comment.ID)).To(Succeed())
+
+			collapsed, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collapsed.CollapsedCount).To(Equal(int64(1)))
+			Expect(collapsed.UpdatedAt).To(BeTemporally("==", created.UpdatedAt))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Collapse(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID)).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.Collapse(ctx, uuid.New())).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("ListTop", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Now()
+		})
+
+		// createAt creates a comment of the video at the time
+		createAt := func(at time.Time) *Comment {
+			comment := NewFakeComment(videoID)
+			comment.CreatedAt = at
+			comment.UpdatedAt = at
+
+			return create(comment)
+		}
+
+		// like adds the likes to the comment like the snapshots of the like counter
+		like := func(comment *Comment, likes int64) {
+			stored, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commentDAO.SetLikes(ctx, comment.ID, stored.Likes+likes)).To(Succeed())
+		}
+
+		// track tracks the engagements of the comment as it is stored
+		track := func(comment *Comment, at time.Time) {
+			stored, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commentDAO.TrackEngagement(ctx, stored, at)).To(Succeed())
+		}
+
+		// expectListTop lists the top comments of the video and expects them to be the comments in order
+		expectListTop := func(limit, offset int, expected ...*Comment) {
+			comments, err := commentDAO.ListTop(ctx, videoID, limit, offset)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(comments[i]).To(matchComment(expected[i]))
+			}
+		}
+
+		It("lists the tracked comments of the video in the order of their likes and replies", func() {
+			quiet := createAt(now)
+			liked := createAt(now)
+			replied := createAt(now)
+			reply := NewFakeComment(videoID)
+			reply.ParentID = replied.ID
+			reply.CreatedAt = now.Add(-time.Second)
+			reply.UpdatedAt = reply.CreatedAt
+			create(reply)
+			createAt(now)
+
+			like(liked, 3)
+			for _, comment := range []*Comment{quiet, liked, replied, reply} {
+				track(comment, now)
+			}
+			liked.Likes = 3
+
+			expectListTop(0, 0, liked, replied, quiet, reply)
+			expectListTop(2, 1, replied, quiet)
+		})
+
+		It("decays the engagements by age", func() {
+			old := createAt(now.Add(-3 * EngagementHalfLife))
+			like(old, 3)
+			track(old, now.Add(-3*EngagementHalfLife))
+			old.Likes = 3
+
+			recent := createAt(now)
+			track(recent, now)
+
+			expectListTop(0, 0, recent, old)
+		})
+
+		It("tracks the engagements once if they are tracked again", func() {
+			liked := createAt(now)
+			like(liked, 1)
+			track(liked, now)
+			like(liked, 2)
+			track(liked, now)
+			track(liked, now)
+			liked.Likes = 3
+
+			replied := createAt(now)
+			reply := NewFakeComment(videoID)
+			reply.ParentID = replied.ID
+			reply.CreatedAt = now.Add(-time.Second)
+			reply.UpdatedAt = reply.CreatedAt
+			create(reply)
+			track(replied, now)
+			track(reply, now)
+			track(reply, now)
+
+			// the replied comment would outrank the liked one if the reply were added twice
+			expectListTop(0, 0, liked, replied, reply)
+		})
+
+		It("does not list the held comments", func() {
+			held := createAt(now)
+			track(held, now)
+
+			Expect(commentDAO.Hold(ctx, held.ID, 0.9)).To(Succeed())
+
+			expectListTop(0, 0)
+		})
+
+		It("does not list the comments of another tenant", func() {
+			track(createAt(now), now)
+
+			Expect(commentDAO.ListTop(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, 0, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("ListSimilar", func() {
+		// embed creates a comment of the video with the embedding of its content
+		embed := func(embedding ...float32) *Comment {
+			comment := create(NewFakeComment(videoID))
+
+			stored, err := commentDAO.Get(ctx, comment.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commentDAO.UpdateEmbedding(ctx, stored, embedding)).To(Succeed())
+
+			return stored
+		}
+
+		// expectListSimilar lists the comments of the video similar to the embedding and expects them to be the
+		// comments in order
+		expectListSimilar := func(embedding []float32, limit int, expected ...*Comment) {
+			comments, err := commentDAO.ListSimilar(ctx, videoID, embedding, limit)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(comments[i]).To(matchComment(expected[i]))
+			}
+		}
+
+		It("lists the embedded comments of the video nearest to the embedding first", func() {
+			far := embed(0, 1, 0)
+			near := embed(1, 0.1, 0)
+			nearest := embed(2, 0, 0)
+			create(NewFakeComment(videoID))
+
+			expectListSimilar([]float32{1, 0, 0}, 0, nearest, near, far)
+			expectListSimilar([]float32{1, 0, 0}, 2, nearest, near)
+		})
+
+		It("replaces the embedding of the comment", func() {
+			comment := embed(1, 0, 0)
+			other := embed(0.5, 0.5, 0)
+			Expect(commentDAO.UpdateEmbedding(ctx, comment, []float32{0, 1, 0})).To(Succeed())
+
+			expectListSimilar([]float32{1, 0, 0}, 0, other, comment)
+		})
+
+		It("does not list the comments embedded into other dimensions", func() {
+			comment := embed(1, 0, 0)
+			embed(1, 0)
+
+			expectListSimilar([]float32{1, 0, 0}, 0, comment)
+		})
+
+		It("does not list the held comments", func() {
+			held := embed(1, 0, 0)
+			Expect(commentDAO.Hold(ctx, held.ID, 0.9)).To(Succeed())
+
+			expectListSimilar([]float32{1, 0, 0}, 0)
+		})
+
+		It("does not list the comments of another tenant", func() {
+			embed(1, 0, 0)
+
+			Expect(commentDAO.ListSimilar(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, []float32{1, 0, 0}, 0)).To(BeEmpty())
+		})
+
+		It("gets the update time of the comment embedded", func() {
+			comment := embed(1, 0, 0)
+
+			Expect(commentDAO.GetEmbeddingUpdatedAt(ctx, comment.ID)).To(BeTemporally("==", comment.UpdatedAt))
+			Expect(commentDAO.GetEmbeddingUpdatedAt(ctx, uuid.New())).To(BeZero())
+			Expect(commentDAO.GetEmbeddingUpdatedAt(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID)).To(BeZero())
+		})
+	})
+
+	Describe("moderation", func() {
+		// the pending comments are of the whole tenant, which the other specs share
+		listPending := func() []*Comment {
+			comments, err := commentDAO.ListPending(ctx, nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			return comments
+		}
+
+		It("holds the comment from the lists of the video until it is approved", func() {
+			held := create(NewFakeComment(videoID))
+			published := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Hold(ctx, held.ID, 0.9)).To(Succeed())
+
+			Expect(listPending()).To(ContainElement(matchComment(held)))
+			Expect(listPending()).NotTo(ContainElement(matchComment(published)))
+			Expect(commentDAO.Get(ctx, held.ID)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":   Equal(CommentStatusPending),
+				"Toxicity": Equal(0.9),
+			})))
+
+			Expect(commentDAO.Approve(ctx, held.ID)).To(Succeed())
+
+			Expect(listPending()).NotTo(ContainElement(matchComment(held)))
+			Expect(commentDAO.Get(ctx, held.ID)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":   Equal(CommentStatusPublished),
+				"Toxicity": Equal(0.9),
+			})))
+			expectList(0, 0, held, published)
+		})
+
+		It("lists the pending comments after the cursor, the most toxic first", func() {
+			first := create(NewFakeComment(videoID))
+			second := create(NewFakeComment(videoID))
+			third := create(NewFakeComment(videoID))
+
+			// the first two tie on the toxicity, so they are listed in the order of creation
+			Expect(commentDAO.Hold(ctx, first.ID, 0.61)).To(Succeed())
+			Expect(commentDAO.Hold(ctx, second.ID, 0.61)).To(Succeed())
+			Expect(commentDAO.Hold(ctx, third.ID, 0.6)).To(Succeed())
+
+			first, err := commentDAO.Get(ctx, first.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			comments, err := commentDAO.ListPending(ctx, first.PendingCursor(), 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(2))
+			Expect(comments[0]).To(matchComment(second))
+			Expect(comments[1]).To(matchComment(third))
+		})
+
+		It("does not list the held comments of the video", func() {
+			held := create(NewFakeComment(videoID))
+			published := create(NewFakeComment(videoID))
+
+			Expect(commentDAO.Hold(ctx, held.ID, 0.9)).To(Succeed())
+
+			expectList(0, 0, published)
+		})
+
+		It("returns ErrCommentNotFound if the comment does not exist", func() {
+			Expect(commentDAO.Hold(ctx, uuid.New(), 0.9)).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.Approve(ctx, uuid.New())).To(MatchError(ErrCommentNotFound))
+		})
+
+		It("returns ErrCommentNotFound if the comment belongs to another tenant", func() {
+			comment := create(NewFakeComment(videoID))
+			otherCtx := tenantkit.WithTenantID(ctx, "another-tenant")
+
+			Expect(commentDAO.Hold(otherCtx, comment.ID, 0.9)).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.ListPending(otherCtx, nil, 0)).NotTo(ContainElement(matchComment(comment)))
+		})
+	})
+
+	Describe("concurrency", func() {
+		const concurrency = 16
+
+		It("creates the comments created concurrently", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < concurrency; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					_, err := commentDAO.Create(ctx, NewFakeComment(videoID))
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			Expect(commentDAO.ListByVideoID(ctx, videoID, 0, 0)).To(HaveLen(concurrency))
+		})
+
+		It("creates a comment created concurrently once", func() {
+			comment := NewFakeComment(videoID)
+			comment.CreatedAt = time.Now()
+
+			errs := make(chan error, concurrency)
+			var wg sync.WaitGroup
+			for i := 0; i < concurrency; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					duplicate := *comment
+					_, err := commentDAO.Create(ctx, &duplicate)
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			created := 0
+			for err := range errs {
+				if err == nil {
+					created++
+					continue
+				}
+				Expect(err).To(MatchError(ErrCommentAlreadyExists))
+			}
+			Expect(created).To(Equal(1))
+		})
+	})
+}
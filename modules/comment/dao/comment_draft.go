@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CommentDraft is the draft of a comment of a user on a video, a user has at most one draft on a video.
+type CommentDraft struct {
+	// ID is new every time the draft is saved, so a promoted draft is told apart from the draft saved since
+	ID        uuid.UUID
+	VideoID   string
+	Content   string
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (d *CommentDraft) ToProto() *pb.CommentDraft {
+	return &pb.CommentDraft{
+		Id:        d.ID.String(),
+		VideoId:   d.VideoID,
+		Content:   d.Content,
+		UpdatedAt: timestamppb.New(d.UpdatedAt),
+		ExpiresAt: timestamppb.New(d.ExpiresAt),
+	}
+}
+
+// CommentDraftDAO keeps the drafts of the users in the tenant of the context, a draft expires after the TTL of the
+// DAO since it is saved.
+type CommentDraftDAO interface {
+	// Save saves the draft of the user on the video of the draft in place of the existing one, and sets the new ID,
+	// the update and expiry times of it
+	Save(ctx context.Context, userID string, draft *CommentDraft) error
+	Get(ctx context.Context, userID, videoID string) (*CommentDraft, error)
+	// Delete deletes the draft of the user on the video if it is the draft of the ID, it is a no-op otherwise
+	Delete(ctx context.Context, userID, videoID string, id uuid.UUID) error
+}
+
+var ErrCommentDraftNotFound = errors.New("comment draft not found")
+
+// DefaultCommentDraftTTL is the time a draft is kept since it is saved
+const DefaultCommentDraftTTL = 7 * 24 * time.Hour
+
+func commentDraftKey(tenantID, userID, videoID string) string {
+	return fmt.Sprintf("commentDraft:%s:%s:%s", tenantID, userID, videoID)
+}
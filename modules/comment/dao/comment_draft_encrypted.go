@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/google/uuid"
+)
+
+// encryptedCommentDraftDAO encrypts the contents of the drafts at rest by the envelope like encryptedCommentDAO.
+type encryptedCommentDraftDAO struct {
+	baseDAO  CommentDraftDAO
+	envelope *cryptokit.Envelope
+}
+
+var _ CommentDraftDAO = (*encryptedCommentDraftDAO)(nil)
+
+func NewEncryptedCommentDraftDAO(baseDAO CommentDraftDAO, envelope *cryptokit.Envelope) *encryptedCommentDraftDAO {
+	return &encryptedCommentDraftDAO{
+		baseDAO:  baseDAO,
+		envelope: envelope,
+	}
+}
+
+func (dao *encryptedCommentDraftDAO) Save(ctx context.Context, userID string, draft *CommentDraft) error {
+	content, err := dao.envelope.Encrypt(ctx, draft.Content)
+	if err != nil {
+		return err
+	}
+
+	d := *draft
+	d.Content = content
+
+	if err := dao.baseDAO.Save(ctx, userID, &d); err != nil {
+		return err
+	}
+
+	draft.ID = d.ID
+	draft.UpdatedAt = d.UpdatedAt
+	draft.ExpiresAt = d.ExpiresAt
+
+	return nil
+}
+
+func (dao *encryptedCommentDraftDAO) Get(ctx context.Context, userID, videoID string) (*CommentDraft, error) {
+	draft, err := dao.baseDAO.Get(ctx, userID, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.Content, err = dao.envelope.Decrypt(ctx, draft.Content); err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+func (dao *encryptedCommentDraftDAO) Delete(ctx context.Context, userID, videoID string, id uuid.UUID) error {
+	return dao.baseDAO.Delete(ctx, userID, videoID, id)
+}
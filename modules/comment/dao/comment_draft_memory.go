@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// memoryCommentDraftDAO keeps the drafts in memory, it is meant for running the modules without Redis in local
+// development. The expired drafts are not read, and are replaced as they are saved again.
+type memoryCommentDraftDAO struct {
+	mu     sync.Mutex
+	drafts map[string]*CommentDraft
+	ttl    time.Duration
+}
+
+var _ CommentDraftDAO = (*memoryCommentDraftDAO)(nil)
+
+func NewMemoryCommentDraftDAO(ttl time.Duration) *memoryCommentDraftDAO {
+	return &memoryCommentDraftDAO{
+		drafts: make(map[string]*CommentDraft),
+		ttl:    ttl,
+	}
+}
+
+func (dao *memoryCommentDraftDAO) Save(ctx context.Context, userID string, draft *CommentDraft) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := commentDraftKey(tenantkit.FromContext(ctx), userID, draft.VideoID)
+
+	draft.ID = uuid.New()
+	draft.UpdatedAt = time.Now()
+	draft.ExpiresAt = draft.UpdatedAt.Add(dao.ttl)
+
+	d := *draft
+	dao.drafts[key] = &d
+
+	return nil
+}
+
+func (dao *memoryCommentDraftDAO) Get(ctx context.Context, userID, videoID string) (*CommentDraft, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	draft, ok := dao.get(commentDraftKey(tenantkit.FromContext(ctx), userID, videoID))
+	if !ok {
+		return nil, ErrCommentDraftNotFound
+	}
+
+	d := *draft
+	return &d, nil
+}
+
+func (dao *memoryCommentDraftDAO) Delete(ctx context.Context, userID, videoID string, id uuid.UUID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := commentDraftKey(tenantkit.FromContext(ctx), userID, videoID)
+	if draft, ok := dao.get(key); ok && draft.ID == id {
+		delete(dao.drafts, key)
+	}
+
+	return nil
+}
+
+// get returns the draft of the key unless it is expired, the lock must be held.
+func (dao *memoryCommentDraftDAO) get(key string) (*CommentDraft, bool) {
+	draft, ok := dao.drafts[key]
+	if !ok || !time.Now().Before(draft.ExpiresAt) {
+		return nil, false
+	}
+
+	return draft, true
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// redisCommentDraftDAO keeps the drafts in Redis hashes of the ID, the content and the update time, which expire
+// by the TTL of Redis.
+type redisCommentDraftDAO struct {
+	client *rediskit.RedisClient
+	ttl    time.Duration
+}
+
+var _ CommentDraftDAO = (*redisCommentDraftDAO)(nil)
+
+// deleteCommentDraftScript deletes the draft if it is the draft of the ID.
+var deleteCommentDraftScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "id") == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func NewRedisCommentDraftDAO(client *rediskit.RedisClient, ttl time.Duration) *redisCommentDraftDAO {
+	return &redisCommentDraftDAO{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (dao *redisCommentDraftDAO) Save(ctx context.Context, userID string, draft *CommentDraft) error {
+	key := commentDraftKey(tenantkit.FromContext(ctx), userID, draft.VideoID)
+	id, updatedAt := uuid.New(), time.Now()
+
+	if _, err := dao.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "id", id.String(), "content", draft.Content, "updated_at", updatedAt.UnixNano())
+		pipe.Expire(ctx, key, dao.ttl)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	draft.ID = id
+	draft.UpdatedAt = updatedAt
+	draft.ExpiresAt = updatedAt.Add(dao.ttl)
+
+	return nil
+}
+
+func (dao *redisCommentDraftDAO) Get(ctx context.Context, userID, videoID string) (*CommentDraft, error) {
+	fields, err := dao.client.HGetAll(ctx, commentDraftKey(tenantkit.FromContext(ctx), userID, videoID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, ErrCommentDraftNotFound
+	}
+
+	id, err := uuid.Parse(fields["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := strconv.ParseInt(fields["updated_at"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommentDraft{
+		ID:        id,
+		VideoID:   videoID,
+		Content:   fields["content"],
+		UpdatedAt: time.Unix(0, updatedAt),
+		ExpiresAt: time.Unix(0, updatedAt).Add(dao.ttl),
+	}, nil
+}
+
+func (dao *redisCommentDraftDAO) Delete(ctx context.Context, userID, videoID string, id uuid.UUID) error {
+	key := commentDraftKey(tenantkit.FromContext(ctx), userID, videoID)
+
+	return deleteCommentDraftScript.Run(ctx, dao.client, []string{key}, id.String()).Err()
+}
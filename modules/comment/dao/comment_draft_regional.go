@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// regionalCommentDraftDAO routes the drafts to the DAO of the region the tenant of the context is pinned to
+// like regionalCommentDAO, so the drafts are kept along with the comments they become.
+type regionalCommentDraftDAO struct {
+	home    CommentDraftDAO
+	regions map[string]CommentDraftDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ CommentDraftDAO = (*regionalCommentDraftDAO)(nil)
+
+func NewRegionalCommentDraftDAO(home CommentDraftDAO, regions map[string]CommentDraftDAO, conf *tenantkit.RegionConfig) *regionalCommentDraftDAO {
+	return &regionalCommentDraftDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalCommentDraftDAO) Save(ctx context.Context, userID string, draft *CommentDraft) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Save(ctx, userID, draft)
+}
+
+func (dao *regionalCommentDraftDAO) Get(ctx context.Context, userID, videoID string) (*CommentDraft, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, userID, videoID)
+}
+
+func (dao *regionalCommentDraftDAO) Delete(ctx context.Context, userID, videoID string, id uuid.UUID) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Delete(ctx, userID, videoID, id)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalCommentDAO.
+func (dao *regionalCommentDraftDAO) regionOf(ctx context.Context) (CommentDraftDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
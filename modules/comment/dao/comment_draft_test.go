@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = DescribeTable("CommentDraftDAO", func(newDraftDAO func() CommentDraftDAO) {
+	var (
+		draftDAO CommentDraftDAO
+		ctx      context.Context
+		userID   string
+		videoID  string
+	)
+
+	draftDAO = newDraftDAO()
+	ctx = context.Background()
+	userID = uuid.NewString()
+	videoID = primitive.NewObjectID().Hex()
+
+	By("getting the draft not saved")
+	_, err := draftDAO.Get(ctx, userID, videoID)
+	Expect(err).To(MatchError(ErrCommentDraftNotFound))
+
+	By("saving the draft")
+	draft := &CommentDraft{VideoID: videoID, Content: "first"}
+	Expect(draftDAO.Save(ctx, userID, draft)).To(Succeed())
+	Expect(draft.ID).NotTo(Equal(uuid.Nil))
+	Expect(draft.ExpiresAt).To(BeTemporally("~", draft.UpdatedAt.Add(time.Hour), time.Millisecond))
+
+	got, err := draftDAO.Get(ctx, userID, videoID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(got.ID).To(Equal(draft.ID))
+	Expect(got.VideoID).To(Equal(videoID))
+	Expect(got.Content).To(Equal("first"))
+	Expect(got.UpdatedAt).To(BeTemporally("~", draft.UpdatedAt, time.Millisecond))
+
+	By("saving the draft again")
+	saved := &CommentDraft{VideoID: videoID, Content: "second"}
+	Expect(draftDAO.Save(ctx, userID, saved)).To(Succeed())
+	Expect(saved.ID).NotTo(Equal(draft.ID))
+
+	got, err = draftDAO.Get(ctx, userID, videoID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(got.Content).To(Equal("second"))
+
+	By("keeping the drafts of the other users and tenants apart")
+	_, err = draftDAO.Get(ctx, uuid.NewString(), videoID)
+	Expect(err).To(MatchError(ErrCommentDraftNotFound))
+	_, err = draftDAO.Get(tenantkit.WithTenantID(ctx, "draft-tenant"), userID, videoID)
+	Expect(err).To(MatchError(ErrCommentDraftNotFound))
+
+	By("deleting the draft of the ID before it is saved again")
+	Expect(draftDAO.Delete(ctx, userID, videoID, draft.ID)).To(Succeed())
+	_, err = draftDAO.Get(ctx, userID, videoID)
+	Expect(err).NotTo(HaveOccurred())
+
+	By("deleting the draft of its ID")
+	Expect(draftDAO.Delete(ctx, userID, videoID, saved.ID)).To(Succeed())
+	_, err = draftDAO.Get(ctx, userID, videoID)
+	Expect(err).To(MatchError(ErrCommentDraftNotFound))
+},
+	Entry("redis", func() CommentDraftDAO { return NewRedisCommentDraftDAO(redisClient, time.Hour) }),
+	Entry("memory", func() CommentDraftDAO { return NewMemoryCommentDraftDAO(time.Hour) }),
+)
+
+var _ = Describe("memoryCommentDraftDAO", func() {
+	It("does not get the expired drafts", func() {
+		draftDAO := NewMemoryCommentDraftDAO(time.Millisecond)
+		ctx := context.Background()
+		draft := &CommentDraft{VideoID: primitive.NewObjectID().Hex(), Content: "expired"}
+
+		Expect(draftDAO.Save(ctx, "user", draft)).To(Succeed())
+
+		Eventually(func() error {
+			_, err := draftDAO.Get(ctx, "user", draft.VideoID)
+			return err
+		}).Should(MatchError(ErrCommentDraftNotFound))
+	})
+})
+
+var _ = Describe("regionalCommentDraftDAO", func() {
+	var (
+		homeDAO  CommentDraftDAO
+		euDAO    CommentDraftDAO
+		draftDAO CommentDraftDAO
+	)
+
+	BeforeEach(func() {
+		homeDAO = NewMemoryCommentDraftDAO(time.Hour)
+		euDAO = NewMemoryCommentDraftDAO(time.Hour)
+		draftDAO = NewRegionalCommentDraftDAO(homeDAO, map[string]CommentDraftDAO{"eu": euDAO}, &tenantkit.RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu": "eu",
+				"course-ap": "ap",
+			},
+		})
+	})
+
+	It("keeps the drafts of the pinned tenants in their regions", func() {
+		ctx := tenantkit.WithTenantID(context.Background(), "course-eu")
+		draft := &CommentDraft{VideoID: primitive.NewObjectID().Hex(), Content: "pinned"}
+
+		Expect(draftDAO.Save(ctx, "user", draft)).To(Succeed())
+
+		Expect(euDAO.Get(ctx, "user", draft.VideoID)).To(Equal(draft))
+		_, err := homeDAO.Get(ctx, "user", draft.VideoID)
+		Expect(err).To(MatchError(ErrCommentDraftNotFound))
+	})
+
+	It("returns ErrRegionNotConfigured for the tenants pinned to a region not configured", func() {
+		ctx := tenantkit.WithTenantID(context.Background(), "course-ap")
+
+		Expect(draftDAO.Save(ctx, "user", &CommentDraft{VideoID: primitive.NewObjectID().Hex()})).To(MatchError(ErrRegionNotConfigured))
+	})
+})
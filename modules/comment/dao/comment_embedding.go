@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// commentEmbedding is the embedding of the content of a comment by the embedder consumer, the comments not embedded
+// yet are not searched.
+type commentEmbedding struct {
+	tableName struct{} `pg:"comment_embeddings"` //nolint:unused,structcheck
+
+	ID        uuid.UUID
+	TenantID  string
+	VideoID   string
+	Embedding []float32 // stored as a pgvector vector by vectorLiteral
+	UpdatedAt time.Time // the update time of the comment embedded
+}
+
+// vectorLiteral returns the text representation of the embedding as a pgvector vector, e.g. [1,0.5,-2].
+func vectorLiteral(embedding []float32) string {
+	var sb strings.Builder
+
+	sb.WriteByte('[')
+	for i, v := range embedding {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+
+	return sb.String()
+}
+
+// cosineDistance returns the cosine distance of the embeddings of the same dimensions like the <=> operator of
+// pgvector, from 0 for the same direction to 2 for the opposite one.
+func cosineDistance(a, b []float32) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	return 1 - dot/math.Sqrt(normA*normB)
+}
@@ -0,0 +1,226 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/google/uuid"
+)
+
+// encryptedCommentDAO encrypts the contents of the comments and their rendered HTML at rest by the envelope: they
+// are encrypted before they are written to the base DAO, and decrypted after they are read from it, so the base
+// DAOs, e.g. Postgres, its history and the cache, keep only the ciphertexts. The contents written before the
+// encryption is enabled are read as they are.
+type encryptedCommentDAO struct {
+	CommentDAO
+
+	envelope *cryptokit.Envelope
+}
+
+var _ CommentDAO = (*encryptedCommentDAO)(nil)
+
+func NewEncryptedCommentDAO(baseDAO CommentDAO, envelope *cryptokit.Envelope) *encryptedCommentDAO {
+	return &encryptedCommentDAO{
+		CommentDAO: baseDAO,
+		envelope:   envelope,
+	}
+}
+
+func (dao *encryptedCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListByVideoID(ctx, videoID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListByVideoIDAsOf(ctx, videoID, asOf, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListBySentiment(ctx, videoID, filter, order, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListByQuality(ctx, videoID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListTop(ctx, videoID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListSimilar(ctx, videoID, embedding, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListPending(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error) {
+	comments, err := dao.CommentDAO.ListByParentID(ctx, videoID, parentID, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, dao.decrypt(ctx, comments...)
+}
+
+func (dao *encryptedCommentDAO) EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error {
+	return dao.CommentDAO.EachByVideoID(ctx, videoID, batchSize, func(comments []*Comment) error {
+		if err := dao.decrypt(ctx, comments...); err != nil {
+			return err
+		}
+
+		return fn(comments)
+	})
+}
+
+func (dao *encryptedCommentDAO) Get(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	comment, err := dao.CommentDAO.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, dao.decrypt(ctx, comment)
+}
+
+func (dao *encryptedCommentDAO) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error) {
+	comment, err := dao.CommentDAO.GetAsOf(ctx, id, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, dao.decrypt(ctx, comment)
+}
+
+// Create creates the copy of the comment with the content encrypted, and copies back the fields set by the base DAO,
+// e.g. the timestamps, so the comment of the caller keeps the plaintext.
+func (dao *encryptedCommentDAO) Create(ctx context.Context, comment *Comment) (uuid.UUID, error) {
+	encrypted, err := dao.encrypt(ctx, comment)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := dao.CommentDAO.Create(ctx, encrypted)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	copyBack(comment, encrypted)
+
+	return id, nil
+}
+
+func (dao *encryptedCommentDAO) Update(ctx context.Context, comment *Comment) error {
+	encrypted, err := dao.encrypt(ctx, comment)
+	if err != nil {
+		return err
+	}
+
+	if err := dao.CommentDAO.Update(ctx, encrypted); err != nil {
+		return err
+	}
+
+	copyBack(comment, encrypted)
+
+	return nil
+}
+
+func (dao *encryptedCommentDAO) BulkImport(ctx context.Context, comments []*Comment) (int, error) {
+	encrypted := make([]*Comment, 0, len(comments))
+	for _, comment := range comments {
+		c, err := dao.encrypt(ctx, comment)
+		if err != nil {
+			return 0, err
+		}
+
+		encrypted = append(encrypted, c)
+	}
+
+	return dao.CommentDAO.BulkImport(ctx, encrypted)
+}
+
+func (dao *encryptedCommentDAO) Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error) {
+	return dao.CommentDAO.Export(ctx, batchSize, func(comments []*Comment) error {
+		if err := dao.decrypt(ctx, comments...); err != nil {
+			return err
+		}
+
+		return fn(comments)
+	})
+}
+
+// encrypt returns the copy of the comment with the content and its HTML encrypted, the HTML not rendered is left empty.
+func (dao *encryptedCommentDAO) encrypt(ctx context.Context, comment *Comment) (*Comment, error) {
+	encrypted := *comment
+
+	var err error
+	if encrypted.Content, err = dao.envelope.Encrypt(ctx, comment.Content); err != nil {
+		return nil, err
+	}
+
+	if comment.ContentHTML != "" {
+		if encrypted.ContentHTML, err = dao.envelope.Encrypt(ctx, comment.ContentHTML); err != nil {
+			return nil, err
+		}
+	}
+
+	return &encrypted, nil
+}
+
+// decrypt decrypts the contents of the comments and their HTML in place.
+func (dao *encryptedCommentDAO) decrypt(ctx context.Context, comments ...*Comment) error {
+	for _, comment := range comments {
+		var err error
+		if comment.Content, err = dao.envelope.Decrypt(ctx, comment.Content); err != nil {
+			return err
+		}
+
+		if comment.ContentHTML, err = dao.envelope.Decrypt(ctx, comment.ContentHTML); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyBack copies the fields of the encrypted copy to the comment except the content and its HTML.
+func copyBack(comment, encrypted *Comment) {
+	content, contentHTML := comment.Content, comment.ContentHTML
+	*comment = *encrypted
+	comment.Content, comment.ContentHTML = content, contentHTML
+}
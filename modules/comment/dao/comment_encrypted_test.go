@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cryptokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("encryptedCommentDAO", func() {
+	var (
+		ctx        context.Context
+		baseDAO    CommentDAO
+		commentDAO CommentDAO
+		comment    *Comment
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		kms, err := cryptokit.NewLocalKMS([]string{"key-1=" + base64.StdEncoding.EncodeToString([]byte(strings.Repeat("a", 32)))})
+		Expect(err).NotTo(HaveOccurred())
+		envelope := cryptokit.NewEnvelope(ctx, kms, cryptokit.NewMemoryKeyStore(), &cryptokit.EncryptionConfig{})
+
+		baseDAO = NewMemoryCommentDAO()
+		commentDAO = NewEncryptedCommentDAO(baseDAO, envelope)
+
+		comment = NewFakeComment("")
+		_, err = commentDAO.Create(ctx, comment)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("keeps the content encrypted in the base DAO", func() {
+		Expect(comment.Content).To(Equal("comment test"))
+
+		stored, err := baseDAO.Get(ctx, comment.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cryptokit.IsEncrypted(stored.Content)).To(BeTrue())
+
+		Expect(commentDAO.Get(ctx, comment.ID)).To(HaveField("Content", "comment test"))
+		Expect(commentDAO.ListByVideoID(ctx, comment.VideoID, 10, 0)).To(ConsistOf(HaveField("Content", "comment test")))
+	})
+
+	It("decrypts the updated content", func() {
+		comment.Content = "updated"
+		Expect(commentDAO.Update(ctx, comment)).To(Succeed())
+		Expect(comment.Content).To(Equal("updated"))
+
+		Expect(commentDAO.Get(ctx, comment.ID)).To(HaveField("Content", "updated"))
+	})
+
+	It("reads the content written before the encryption as it is", func() {
+		plain := NewFakeComment(comment.VideoID)
+		_, err := baseDAO.Create(ctx, plain)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(commentDAO.Get(ctx, plain.ID)).To(HaveField("Content", "comment test"))
+	})
+})
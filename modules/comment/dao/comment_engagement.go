@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The engagement score of a comment is the sum of the weights of its engagements, i.e. its creation, its replies and
+// its likes, each decayed by half every EngagementHalfLife since it happened. All the scores decay at the same rate,
+// so the order of the scores never changes by time, and a score is only added to once an engagement happens.
+//
+// The scores are stored as ln(Σ weight * 2^((at - engagementEpoch) / EngagementHalfLife)), i.e. the logarithms of the
+// scores scaled to the epoch instead of decayed to now, so the stored scores are compared as they are, and do not
+// overflow as the engagements get later. Changing the half-life or the epoch invalidates the stored scores.
+const (
+	EngagementHalfLife = 24 * time.Hour
+
+	commentEngagementWeight = 1
+	replyEngagementWeight   = 2
+	likeEngagementWeight    = 1
+)
+
+var engagementEpoch = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// engagementScore returns the stored score of the engagements of the weight at the time.
+func engagementScore(weight float64, at time.Time) float64 {
+	return math.Log(weight) + engagementGrowth(at)
+}
+
+// engagementGrowth returns the logarithm of the scale of an engagement at the time to the epoch.
+func engagementGrowth(at time.Time) float64 {
+	return at.Sub(engagementEpoch).Seconds() / EngagementHalfLife.Seconds() * math.Ln2
+}
+
+// addEngagementScores returns the stored score of the sum of the engagements of the stored scores, i.e. ln(e^a + e^b),
+// which is computed without overflow like the logaddexp function of the migrations.
+func addEngagementScores(a, b float64) float64 {
+	return math.Max(a, b) + math.Log1p(math.Exp(-math.Abs(a-b)))
+}
+
+// commentEngagement is the engagement tracked of a comment, the comments not tracked yet are not ranked.
+type commentEngagement struct {
+	tableName struct{} `pg:"comment_engagements"` //nolint:unused,structcheck
+
+	ID       uuid.UUID
+	TenantID string
+	VideoID  string
+	Likes    int64   `pg:",use_zero"` // the likes added to the score
+	Score    float64 `pg:",use_zero"`
+}
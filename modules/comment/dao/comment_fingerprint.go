@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CommentFingerprintDAO keeps the fingerprints of the comments of the users in the tenant of the context, a
+// fingerprint is claimed by the first comment of it until the window of the DAO passes, so the near-duplicate
+// comments of a user within the window are told by the comment claiming their fingerprint.
+type CommentFingerprintDAO interface {
+	// Claim claims the fingerprint of the user on the video for the comment of the ID unless it is claimed within the
+	// window, and returns the ID of the comment claiming it, which is the ID given if it is claimed by the call
+	Claim(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) (uuid.UUID, error)
+	// Release releases the fingerprint of the user on the video if it is claimed by the comment of the ID, it is a
+	// no-op otherwise
+	Release(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) error
+}
+
+func commentFingerprintKey(tenantID, userID, videoID, fingerprint string) string {
+	return fmt.Sprintf("commentFingerprint:%s:%s:%s:%s", tenantID, userID, videoID, fingerprint)
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// memoryCommentFingerprintDAO keeps the fingerprints in memory, it is meant for running the modules without Redis in
+// local development. The expired claims are not read, and are replaced as the fingerprints are claimed again.
+type memoryCommentFingerprintDAO struct {
+	mu     sync.Mutex
+	claims map[string]*commentFingerprintClaim
+	window time.Duration
+}
+
+type commentFingerprintClaim struct {
+	id        uuid.UUID
+	expiresAt time.Time
+}
+
+var _ CommentFingerprintDAO = (*memoryCommentFingerprintDAO)(nil)
+
+func NewMemoryCommentFingerprintDAO(window time.Duration) *memoryCommentFingerprintDAO {
+	return &memoryCommentFingerprintDAO{
+		claims: make(map[string]*commentFingerprintClaim),
+		window: window,
+	}
+}
+
+func (dao *memoryCommentFingerprintDAO) Claim(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) (uuid.UUID, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := commentFingerprintKey(tenantkit.FromContext(ctx), userID, videoID, fingerprint)
+	if claim, ok := dao.get(key); ok {
+		return claim.id, nil
+	}
+
+	dao.claims[key] = &commentFingerprintClaim{id: id, expiresAt: time.Now().Add(dao.window)}
+
+	return id, nil
+}
+
+func (dao *memoryCommentFingerprintDAO) Release(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := commentFingerprintKey(tenantkit.FromContext(ctx), userID, videoID, fingerprint)
+	if claim, ok := dao.get(key); ok && claim.id == id {
+		delete(dao.claims, key)
+	}
+
+	return nil
+}
+
+// get returns the claim of the key unless it is expired, the lock must be held.
+func (dao *memoryCommentFingerprintDAO) get(key string) (*commentFingerprintClaim, bool) {
+	claim, ok := dao.claims[key]
+	if !ok || !time.Now().Before(claim.expiresAt) {
+		return nil, false
+	}
+
+	return claim, true
+}
@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// redisCommentFingerprintDAO keeps the fingerprints in Redis strings of the IDs of the comments claiming them, which
+// expire by the TTL of Redis.
+type redisCommentFingerprintDAO struct {
+	client *rediskit.RedisClient
+	window time.Duration
+}
+
+var _ CommentFingerprintDAO = (*redisCommentFingerprintDAO)(nil)
+
+// claimCommentFingerprintScript claims the fingerprint unless it is claimed, and returns the ID claiming it.
+var claimCommentFingerprintScript = redis.NewScript(`
+local id = redis.call("GET", KEYS[1])
+if id then
+	return id
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return ARGV[1]
+`)
+
+// releaseCommentFingerprintScript releases the fingerprint if it is claimed by the ID.
+var releaseCommentFingerprintScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func NewRedisCommentFingerprintDAO(client *rediskit.RedisClient, window time.Duration) *redisCommentFingerprintDAO {
+	return &redisCommentFingerprintDAO{
+		client: client,
+		window: window,
+	}
+}
+
+func (dao *redisCommentFingerprintDAO) Claim(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) (uuid.UUID, error) {
+	key := commentFingerprintKey(tenantkit.FromContext(ctx), userID, videoID, fingerprint)
+
+	claimedID, err := claimCommentFingerprintScript.Run(ctx, dao.client, []string{key}, id.String(), dao.window.Milliseconds()).Text()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuid.Parse(claimedID)
+}
+
+func (dao *redisCommentFingerprintDAO) Release(ctx context.Context, userID, videoID, fingerprint string, id uuid.UUID) error {
+	key := commentFingerprintKey(tenantkit.FromContext(ctx), userID, videoID, fingerprint)
+
+	return releaseCommentFingerprintScript.Run(ctx, dao.client, []string{key}, id.String()).Err()
+}
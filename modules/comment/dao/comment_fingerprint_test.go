@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = DescribeTable("CommentFingerprintDAO", func(newFingerprintDAO func(window time.Duration) CommentFingerprintDAO) {
+	var (
+		fingerprintDAO CommentFingerprintDAO
+		ctx            context.Context
+		userID         string
+		videoID        string
+	)
+
+	fingerprintDAO = newFingerprintDAO(time.Hour)
+	ctx = context.Background()
+	userID = uuid.NewString()
+	videoID = primitive.NewObjectID().Hex()
+	first, second := uuid.New(), uuid.New()
+
+	By("claiming the fingerprint")
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "fingerprint", first)).To(Equal(first))
+
+	By("claiming the fingerprint claimed")
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "fingerprint", second)).To(Equal(first))
+
+	By("keeping the fingerprints of the other videos, users and tenants apart")
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "another fingerprint", second)).To(Equal(second))
+	Expect(fingerprintDAO.Claim(ctx, userID, primitive.NewObjectID().Hex(), "fingerprint", second)).To(Equal(second))
+	Expect(fingerprintDAO.Claim(ctx, uuid.NewString(), videoID, "fingerprint", second)).To(Equal(second))
+	Expect(fingerprintDAO.Claim(tenantkit.WithTenantID(ctx, "fingerprint-tenant"), userID, videoID, "fingerprint", second)).To(Equal(second))
+
+	By("releasing the fingerprint of another ID")
+	Expect(fingerprintDAO.Release(ctx, userID, videoID, "fingerprint", second)).To(Succeed())
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "fingerprint", second)).To(Equal(first))
+
+	By("releasing the fingerprint of its ID")
+	Expect(fingerprintDAO.Release(ctx, userID, videoID, "fingerprint", first)).To(Succeed())
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "fingerprint", second)).To(Equal(second))
+
+	By("claiming the fingerprint again once the window passes")
+	fingerprintDAO = newFingerprintDAO(10 * time.Millisecond)
+	Expect(fingerprintDAO.Claim(ctx, userID, videoID, "expiring", first)).To(Equal(first))
+	Eventually(func() (uuid.UUID, error) {
+		return fingerprintDAO.Claim(ctx, userID, videoID, "expiring", second)
+	}).Should(Equal(second))
+},
+	Entry("redis", func(window time.Duration) CommentFingerprintDAO {
+		return NewRedisCommentFingerprintDAO(redisClient, window)
+	}),
+	Entry("memory", func(window time.Duration) CommentFingerprintDAO { return NewMemoryCommentFingerprintDAO(window) }),
+)
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
+)
+
+// hedgedCommentDAO lists the comments of the videos from the read replicas with hedging, the read is sent to
+// the next replica if the previous one is slower than the latency quantile. The other operations are sent to
+// the base DAO on the primary, since the replicas lag behind and only the comment lists tolerate stale results.
+type hedgedCommentDAO struct {
+	CommentDAO
+
+	replicas []CommentDAO
+	hedger   *hedgekit.Hedger
+	next     uint32
+}
+
+var _ CommentDAO = (*hedgedCommentDAO)(nil)
+
+// hedgedCommentAttempts is the number of the replicas a read is sent to at most
+const hedgedCommentAttempts = 2
+
+// NewHedgedCommentDAO returns the DAO hedging the reads across the replicas, the reads are sent to
+// the base DAO if there is no replica. The reads are not hedged if there is a single replica, since
+// there is no other replica to hedge to.
+func NewHedgedCommentDAO(baseDAO CommentDAO, replicas []CommentDAO, hedger *hedgekit.Hedger) *hedgedCommentDAO {
+	if len(replicas) == 0 {
+		replicas = []CommentDAO{baseDAO}
+	}
+
+	return &hedgedCommentDAO{
+		CommentDAO: baseDAO,
+		replicas:   replicas,
+		hedger:     hedger,
+	}
+}
+
+func (dao *hedgedCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	// the reads start from the replicas in turn, so the load is spread across them
+	start := int(atomic.AddUint32(&dao.next, 1))
+
+	attempts := hedgedCommentAttempts
+	if len(dao.replicas) < attempts {
+		attempts = len(dao.replicas)
+	}
+
+	fns := make([]hedgekit.Func, 0, attempts)
+	for i := 0; i < attempts; i++ {
+		replica := dao.replicas[(start+i)%len(dao.replicas)]
+
+		fns = append(fns, func(ctx context.Context) (interface{}, error) {
+			return replica.ListByVideoID(ctx, videoID, limit, offset)
+		})
+	}
+
+	comments, err := dao.hedger.Do(ctx, fns...)
+	if err != nil {
+		return nil, err
+	}
+
+	return comments.([]*Comment), nil
+}
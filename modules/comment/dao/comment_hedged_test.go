@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+// slowCommentDAO delays the reads of the base DAO, like a replica under load
+type slowCommentDAO struct {
+	CommentDAO
+	latency time.Duration
+	reads   int32
+}
+
+func (dao *slowCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	atomic.AddInt32(&dao.reads, 1)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(dao.latency):
+	}
+
+	return dao.CommentDAO.ListByVideoID(ctx, videoID, limit, offset)
+}
+
+var _ = Describe("hedgedCommentDAO", func() {
+	var (
+		ctx        context.Context
+		primary    CommentDAO
+		hedger     *hedgekit.Hedger
+		commentDAO CommentDAO
+		comment    *Comment
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		primary = NewMemoryCommentDAO()
+		replicas := []CommentDAO{
+			&slowCommentDAO{CommentDAO: primary, latency: time.Second},
+			primary,
+		}
+		hedger = hedgekit.NewHedger(ctx, "comment", &hedgekit.HedgeConfig{
+			Quantile:     0.95,
+			InitialDelay: 10 * time.Millisecond,
+			WindowSize:   100,
+		}, nonrecording.NewNoopMeterProvider().Meter(""))
+
+		commentDAO = NewHedgedCommentDAO(primary, replicas, hedger)
+
+		comment = NewFakeComment("")
+		_, err := commentDAO.Create(ctx, comment)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists the comments without waiting for the slow replica", func() {
+		// one of the replicas is slow, so every read either starts from or is hedged to the fast one
+		for i := 0; i < 2; i++ {
+			start := time.Now()
+
+			comments, err := commentDAO.ListByVideoID(ctx, comment.VideoID, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+			Expect(comments[0].ID).To(Equal(comment.ID))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		}
+	})
+
+	When("there is a single replica", func() {
+		It("lists the comments from the replica without hedging", func() {
+			replica := &slowCommentDAO{CommentDAO: primary, latency: 50 * time.Millisecond}
+			commentDAO = NewHedgedCommentDAO(primary, []CommentDAO{replica}, hedger)
+
+			comments, err := commentDAO.ListByVideoID(ctx, comment.VideoID, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+			Expect(atomic.LoadInt32(&replica.reads)).To(BeEquivalentTo(1))
+		})
+	})
+})
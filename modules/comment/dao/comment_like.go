@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/counterkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// commentLike is the like of a user on a comment, a user likes a comment once.
+type commentLike struct {
+	tableName struct{} `pg:"comment_likes"` //nolint:unused,structcheck
+
+	CommentID        uuid.UUID
+	CommentCreatedAt time.Time // the creation time of the comment, which the likes are partitioned by
+	UserID           string
+	TenantID         string
+}
+
+// CommentLikeCounterDAO counts the likes of the comments in the tenant of the context. The counts are the source of
+// truth of the likes, which are copied to the comments by CommentDAO.SetLikes eventually.
+type CommentLikeCounterDAO interface {
+	// Incr adds the delta to the likes of the comment
+	Incr(ctx context.Context, id uuid.UUID, delta int64) error
+	// Get returns the likes of the comment, zero if it is never liked
+	Get(ctx context.Context, id uuid.UUID) (int64, error)
+}
+
+// CommentLikeCounterName is the name of the counters of the likes of the comments, see counterkit.Counters.
+const CommentLikeCounterName = "comment_likes"
+
+// ErrInvalidCommentLikeCounterID is returned by the snapshot func for a counter not counted by the DAO.
+var ErrInvalidCommentLikeCounterID = errors.New("invalid comment like counter ID")
+
+// commentLikeCounterID returns the ID of the counter of the likes of the comment in the tenant, the tenant IDs do not
+// contain a slash.
+func commentLikeCounterID(tenantID string, id uuid.UUID) string {
+	return tenantID + "/" + id.String()
+}
+
+func parseCommentLikeCounterID(counterID string) (string, uuid.UUID, error) {
+	i := strings.LastIndex(counterID, "/")
+	if i < 0 {
+		return "", uuid.Nil, fmt.Errorf("%w: %q", ErrInvalidCommentLikeCounterID, counterID)
+	}
+
+	id, err := uuid.Parse(counterID[i+1:])
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %q", ErrInvalidCommentLikeCounterID, counterID)
+	}
+
+	return counterID[:i], id, nil
+}
+
+// CommentLikeSnapshotFunc returns the snapshot func of the counters of the likes, which copies the likes snapshotted
+// to the comments, see counterkit.WithSnapshotFunc.
+func CommentLikeSnapshotFunc(commentDAO CommentDAO) counterkit.SnapshotFunc {
+	return func(ctx context.Context, counterID string, total int64) error {
+		tenantID, id, err := parseCommentLikeCounterID(counterID)
+		if err != nil {
+			return err
+		}
+
+		return commentDAO.SetLikes(tenantkit.WithTenantID(ctx, tenantID), id, total)
+	}
+}
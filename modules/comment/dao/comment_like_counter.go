@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/counterkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// counterCommentLikeCounterDAO counts the likes by the counters of counterkit, whose snapshots copy the likes to the
+// comments by the snapshot func of CommentLikeSnapshotFunc.
+type counterCommentLikeCounterDAO struct {
+	counters *counterkit.Counters
+}
+
+var _ CommentLikeCounterDAO = (*counterCommentLikeCounterDAO)(nil)
+
+func NewCounterCommentLikeCounterDAO(counters *counterkit.Counters) *counterCommentLikeCounterDAO {
+	return &counterCommentLikeCounterDAO{
+		counters: counters,
+	}
+}
+
+func (dao *counterCommentLikeCounterDAO) Incr(ctx context.Context, id uuid.UUID, delta int64) error {
+	return dao.counters.Incr(ctx, commentLikeCounterID(tenantkit.FromContext(ctx), id), delta)
+}
+
+func (dao *counterCommentLikeCounterDAO) Get(ctx context.Context, id uuid.UUID) (int64, error) {
+	return dao.counters.Get(ctx, commentLikeCounterID(tenantkit.FromContext(ctx), id))
+}
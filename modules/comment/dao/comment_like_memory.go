@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// memoryCommentLikeCounterDAO counts the likes in memory, it is meant for running the modules without Redis in local
+// development. There are no snapshots, the likes are copied to the comments as they are counted.
+type memoryCommentLikeCounterDAO struct {
+	mu         sync.Mutex
+	counts     map[string]int64
+	commentDAO CommentDAO
+}
+
+var _ CommentLikeCounterDAO = (*memoryCommentLikeCounterDAO)(nil)
+
+func NewMemoryCommentLikeCounterDAO(commentDAO CommentDAO) *memoryCommentLikeCounterDAO {
+	return &memoryCommentLikeCounterDAO{
+		counts:     make(map[string]int64),
+		commentDAO: commentDAO,
+	}
+}
+
+func (dao *memoryCommentLikeCounterDAO) Incr(ctx context.Context, id uuid.UUID, delta int64) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	counterID := commentLikeCounterID(tenantkit.FromContext(ctx), id)
+	dao.counts[counterID] += delta
+
+	return dao.commentDAO.SetLikes(ctx, id, dao.counts[counterID])
+}
+
+func (dao *memoryCommentLikeCounterDAO) Get(ctx context.Context, id uuid.UUID) (int64, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	return dao.counts[commentLikeCounterID(tenantkit.FromContext(ctx), id)], nil
+}
@@ -0,0 +1,671 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// memoryCommentDAO keeps the comments and their history in memory, it is meant for running
+// the modules without PostgreSQL in local development, and the comments are lost on exit.
+type memoryCommentDAO struct {
+	mu       sync.RWMutex
+	comments map[uuid.UUID]*Comment
+	// histories are the versions of the comments, like the comment_history table
+	histories []*commentHistory
+	// engagements are the engagements tracked of the comments, like the comment_engagements table
+	engagements map[uuid.UUID]*commentEngagement
+	// embeddings are the embeddings of the contents of the comments, like the comment_embeddings table
+	embeddings map[uuid.UUID]*commentEmbedding
+	// likes are the users liking the comments, like the comment_likes table
+	likes map[uuid.UUID]map[string]struct{}
+}
+
+var _ CommentDAO = (*memoryCommentDAO)(nil)
+
+func NewMemoryCommentDAO() *memoryCommentDAO {
+	return &memoryCommentDAO{
+		comments:    make(map[uuid.UUID]*Comment),
+		engagements: make(map[uuid.UUID]*commentEngagement),
+		embeddings:  make(map[uuid.UUID]*commentEmbedding),
+		likes:       make(map[uuid.UUID]map[string]struct{}),
+	}
+}
+
+func (dao *memoryCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID == tenantID && comment.VideoID == videoID && comment.Status == CommentStatusPublished {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	sortByUpdatedAt(comments)
+
+	return paginateComments(comments, limit, offset), nil
+}
+
+func (dao *memoryCommentDAO) ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, history := range dao.histories {
+		if history.TenantID == tenantID && history.VideoID == videoID && history.Status == CommentStatusPublished && history.validAt(asOf) {
+			comments = append(comments, copyComment(&history.Comment))
+		}
+	}
+	sortByUpdatedAt(comments)
+
+	return paginateComments(comments, limit, offset), nil
+}
+
+func (dao *memoryCommentDAO) ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID == tenantID && comment.VideoID == videoID && comment.Status == CommentStatusPublished && matchSentiment(comment, filter) {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	sortByUpdatedAt(comments)
+	sortBySentiment(comments, order)
+
+	return paginateComments(comments, limit, offset), nil
+}
+
+func (dao *memoryCommentDAO) ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID == tenantID && comment.VideoID == videoID && comment.Status == CommentStatusPublished {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		if scoreI, scoreJ := comments[i].QualityScore(), comments[j].QualityScore(); scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+
+		return bytes.Compare(comments[i].ID[:], comments[j].ID[:]) < 0
+	})
+
+	return paginateComments(comments, limit, offset), nil
+}
+
+func (dao *memoryCommentDAO) ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID != tenantID || comment.VideoID != videoID || comment.ParentID != parentID || comment.Status != CommentStatusPublished {
+			continue
+		}
+		if after != nil && !isAfter(comment, after) {
+			continue
+		}
+
+		comments = append(comments, copyComment(comment))
+	}
+	sortByCreatedAt(comments)
+
+	return paginateComments(comments, limit, 0), nil
+}
+
+func (dao *memoryCommentDAO) EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error {
+	if batchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	// fn is called without the lock, so that it can modify the comments
+	dao.mu.RLock()
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID == tenantID && comment.VideoID == videoID {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	dao.mu.RUnlock()
+
+	sortByCreatedAt(comments)
+
+	return eachBatch(comments, batchSize, fn)
+}
+
+func (dao *memoryCommentDAO) ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for id, engagement := range dao.engagements {
+		comment, ok := dao.comments[id]
+		if ok && engagement.TenantID == tenantID && comment.VideoID == videoID && comment.Status == CommentStatusPublished {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		if scoreI, scoreJ := dao.engagements[comments[i].ID].Score, dao.engagements[comments[j].ID].Score; scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+
+		return bytes.Compare(comments[i].ID[:], comments[j].ID[:]) < 0
+	})
+
+	return paginateComments(comments, limit, offset), nil
+}
+
+func (dao *memoryCommentDAO) ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	distances := make(map[uuid.UUID]float64)
+	for id, stored := range dao.embeddings {
+		comment, ok := dao.comments[id]
+		if !ok || stored.TenantID != tenantID || comment.VideoID != videoID || comment.Status != CommentStatusPublished || len(stored.Embedding) != len(embedding) {
+			continue
+		}
+
+		comments = append(comments, copyComment(comment))
+		distances[id] = cosineDistance(stored.Embedding, embedding)
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		if distanceI, distanceJ := distances[comments[i].ID], distances[comments[j].ID]; distanceI != distanceJ {
+			return distanceI < distanceJ
+		}
+
+		return bytes.Compare(comments[i].ID[:], comments[j].ID[:]) < 0
+	})
+
+	return paginateComments(comments, limit, 0), nil
+}
+
+func (dao *memoryCommentDAO) ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID != tenantID || comment.Status != CommentStatusPending {
+			continue
+		}
+		if after != nil && !isPendingAfter(comment, after) {
+			continue
+		}
+
+		comments = append(comments, copyComment(comment))
+	}
+	sortByCreatedAt(comments)
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Toxicity > comments[j].Toxicity
+	})
+
+	return paginateComments(comments, limit, 0), nil
+}
+
+func (dao *memoryCommentDAO) Get(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	comment, ok := dao.comments[id]
+	if !ok || comment.TenantID != tenantkit.FromContext(ctx) {
+		return nil, ErrCommentNotFound
+	}
+
+	return copyComment(comment), nil
+}
+
+func (dao *memoryCommentDAO) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	for _, history := range dao.histories {
+		if history.ID == id && history.TenantID == tenantID && history.validAt(asOf) {
+			return copyComment(&history.Comment), nil
+		}
+	}
+
+	return nil, ErrCommentNotFound
+}
+
+func (dao *memoryCommentDAO) Create(ctx context.Context, comment *Comment) (uuid.UUID, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	comment.TenantID = tenantkit.FromContext(ctx)
+
+	if _, ok := dao.comments[comment.ID]; ok {
+		return uuid.Nil, ErrCommentAlreadyExists
+	}
+
+	dao.insert(comment, time.Now())
+
+	return comment.ID, nil
+}
+
+func (dao *memoryCommentDAO) Update(ctx context.Context, comment *Comment) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	stored, ok := dao.comments[comment.ID]
+	if !ok || stored.TenantID != tenantkit.FromContext(ctx) {
+		return ErrCommentNotFound
+	}
+
+	now := time.Now()
+	dao.closeHistory(stored.ID, now)
+
+	stored.Content = comment.Content
+	stored.ContentHTML = comment.ContentHTML
+	stored.Sentiment = comment.Sentiment
+	stored.Quality = comment.Quality
+	stored.UpdatedAt = now
+	dao.histories = append(dao.histories, &commentHistory{Comment: *stored, ValidFrom: now})
+
+	// the updated comment is returned like RETURNING *
+	*comment = *stored
+
+	return nil
+}
+
+func (dao *memoryCommentDAO) Hold(ctx context.Context, id uuid.UUID, toxicity float64) error {
+	return dao.setStatus(ctx, id, func(comment *Comment) {
+		comment.Status = CommentStatusPending
+		comment.Toxicity = toxicity
+	})
+}
+
+func (dao *memoryCommentDAO) Approve(ctx context.Context, id uuid.UUID) error {
+	return dao.setStatus(ctx, id, func(comment *Comment) {
+		comment.Status = CommentStatusPublished
+	})
+}
+
+// setStatus changes the moderation status of the comment by fn, along with a new version of the history like
+// the trigger of the comments table.
+func (dao *memoryCommentDAO) setStatus(ctx context.Context, id uuid.UUID, fn func(comment *Comment)) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	stored, ok := dao.comments[id]
+	if !ok || stored.TenantID != tenantkit.FromContext(ctx) {
+		return ErrCommentNotFound
+	}
+
+	now := time.Now()
+	dao.closeHistory(stored.ID, now)
+
+	fn(stored)
+	dao.histories = append(dao.histories, &commentHistory{Comment: *stored, ValidFrom: now})
+
+	return nil
+}
+
+// Like records the like of the user, the likes of the comment are set by SetLikes.
+func (dao *memoryCommentDAO) Like(ctx context.Context, id uuid.UUID, userID string) (bool, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	comment, ok := dao.comments[id]
+	if !ok || comment.TenantID != tenantkit.FromContext(ctx) {
+		return false, ErrCommentNotFound
+	}
+
+	users, ok := dao.likes[id]
+	if !ok {
+		users = make(map[string]struct{})
+		dao.likes[id] = users
+	}
+
+	if _, ok := users[userID]; ok {
+		return false, nil
+	}
+
+	users[userID] = struct{}{}
+
+	return true, nil
+}
+
+// SetLikes sets the likes without a new version of the history, like the trigger of the comments table.
+func (dao *memoryCommentDAO) SetLikes(ctx context.Context, id uuid.UUID, likes int64) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	if comment, ok := dao.comments[id]; ok && comment.TenantID == tenantkit.FromContext(ctx) {
+		comment.Likes = likes
+	}
+
+	return nil
+}
+
+// Collapse adds the duplicate without a new version of the history, like the trigger of the comments table.
+func (dao *memoryCommentDAO) Collapse(ctx context.Context, id uuid.UUID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	comment, ok := dao.comments[id]
+	if !ok || comment.TenantID != tenantkit.FromContext(ctx) {
+		return ErrCommentNotFound
+	}
+
+	comment.CollapsedCount++
+
+	return nil
+}
+
+func (dao *memoryCommentDAO) TrackEngagement(ctx context.Context, comment *Comment, at time.Time) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	engagement, ok := dao.engagements[comment.ID]
+	if !ok {
+		engagement = &commentEngagement{
+			ID:       comment.ID,
+			TenantID: tenantID,
+			VideoID:  comment.VideoID,
+			Score:    engagementScore(commentEngagementWeight, comment.CreatedAt),
+		}
+		dao.engagements[comment.ID] = engagement
+
+		if parent, ok := dao.engagements[comment.ParentID]; ok && comment.ParentID != uuid.Nil && parent.TenantID == tenantID {
+			parent.Score = addEngagementScores(parent.Score, engagementScore(replyEngagementWeight, comment.CreatedAt))
+		}
+	}
+
+	if engagement.TenantID == tenantID && engagement.Likes < comment.Likes {
+		engagement.Score = addEngagementScores(engagement.Score, engagementScore(float64(likeEngagementWeight*(comment.Likes-engagement.Likes)), at))
+		engagement.Likes = comment.Likes
+	}
+
+	return nil
+}
+
+func (dao *memoryCommentDAO) GetEmbeddingUpdatedAt(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	embedding, ok := dao.embeddings[id]
+	if !ok || embedding.TenantID != tenantkit.FromContext(ctx) {
+		return time.Time{}, nil
+	}
+
+	return embedding.UpdatedAt, nil
+}
+
+func (dao *memoryCommentDAO) UpdateEmbedding(ctx context.Context, comment *Comment, embedding []float32) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	dao.embeddings[comment.ID] = &commentEmbedding{
+		ID:        comment.ID,
+		TenantID:  tenantkit.FromContext(ctx),
+		VideoID:   comment.VideoID,
+		Embedding: append([]float32(nil), embedding...),
+		UpdatedAt: comment.UpdatedAt,
+	}
+
+	return nil
+}
+
+func (dao *memoryCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	comment, ok := dao.comments[id]
+	if !ok || comment.TenantID != tenantkit.FromContext(ctx) {
+		return ErrCommentNotFound
+	}
+
+	dao.delete(id, time.Now())
+
+	return nil
+}
+
+func (dao *memoryCommentDAO) DeleteByVideoID(ctx context.Context, videoID string) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+	now := time.Now()
+
+	for id, comment := range dao.comments {
+		if comment.TenantID == tenantID && comment.VideoID == videoID {
+			dao.delete(id, now)
+		}
+	}
+
+	return nil
+}
+
+// BulkImport imports either all the comments or none of them, like the COPY of PostgreSQL.
+func (dao *memoryCommentDAO) BulkImport(ctx context.Context, comments []*Comment) (int, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+	now := time.Now()
+
+	ids := make(map[uuid.UUID]struct{}, len(comments))
+	for _, comment := range comments {
+		comment.TenantID = tenantID
+		if comment.ID == uuid.Nil {
+			comment.ID = uuid.New()
+		}
+
+		if _, ok := dao.comments[comment.ID]; ok {
+			return 0, ErrCommentAlreadyExists
+		}
+		if _, ok := ids[comment.ID]; ok {
+			return 0, ErrCommentAlreadyExists
+		}
+		ids[comment.ID] = struct{}{}
+	}
+
+	for _, comment := range comments {
+		dao.insert(comment, now)
+	}
+
+	return len(comments), nil
+}
+
+// Export calls fn with the comments of the tenant of the context batch by batch in the order of creation,
+// all the batches are read from the snapshot taken at the returned time.
+func (dao *memoryCommentDAO) Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error) {
+	if batchSize <= 0 {
+		return time.Time{}, ErrInvalidBatchSize
+	}
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	dao.mu.RLock()
+	snapshotTime := time.Now()
+
+	var comments []*Comment
+	for _, comment := range dao.comments {
+		if comment.TenantID == tenantID {
+			comments = append(comments, copyComment(comment))
+		}
+	}
+	dao.mu.RUnlock()
+
+	sortByCreatedAt(comments)
+
+	if err := eachBatch(comments, batchSize, fn); err != nil {
+		return time.Time{}, err
+	}
+
+	return snapshotTime, nil
+}
+
+// insert stores a copy of the comment with the ID and timestamps filled like the defaults of the table,
+// it must be called with the lock held.
+func (dao *memoryCommentDAO) insert(comment *Comment, now time.Time) {
+	if comment.ID == uuid.Nil {
+		comment.ID = uuid.New()
+	}
+	if comment.CreatedAt.IsZero() {
+		comment.CreatedAt = now
+	}
+	if comment.UpdatedAt.IsZero() {
+		comment.UpdatedAt = comment.CreatedAt
+	}
+
+	dao.comments[comment.ID] = copyComment(comment)
+	dao.histories = append(dao.histories, &commentHistory{Comment: *comment, ValidFrom: now})
+}
+
+// delete deletes the comment and ends its current version, it must be called with the lock held.
+func (dao *memoryCommentDAO) delete(id uuid.UUID, now time.Time) {
+	delete(dao.comments, id)
+	dao.closeHistory(id, now)
+}
+
+// closeHistory ends the current version of the comment, it must be called with the lock held.
+func (dao *memoryCommentDAO) closeHistory(id uuid.UUID, now time.Time) {
+	for _, history := range dao.histories {
+		if history.ID == id && history.ValidTo.IsZero() {
+			history.ValidTo = now
+		}
+	}
+}
+
+// validAt reports whether the version is valid at the time, a zero ValidTo is the current version.
+func (h *commentHistory) validAt(t time.Time) bool {
+	return !h.ValidFrom.After(t) && (h.ValidTo.IsZero() || h.ValidTo.After(t))
+}
+
+func copyComment(comment *Comment) *Comment {
+	c := *comment
+	return &c
+}
+
+func isAfter(comment *Comment, cursor *Cursor) bool {
+	if !comment.CreatedAt.Equal(cursor.CreatedAt) {
+		return comment.CreatedAt.After(cursor.CreatedAt)
+	}
+
+	return bytes.Compare(comment.ID[:], cursor.ID[:]) > 0
+}
+
+func isPendingAfter(comment *Comment, cursor *PendingCursor) bool {
+	if comment.Toxicity != cursor.Toxicity {
+		return comment.Toxicity < cursor.Toxicity
+	}
+
+	return isAfter(comment, &Cursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID})
+}
+
+// sortByUpdatedAt sorts the comments like ORDER BY updated_at ASC, the ties are broken by ID to be stable.
+func sortByUpdatedAt(comments []*Comment) {
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].UpdatedAt.Equal(comments[j].UpdatedAt) {
+			return comments[i].UpdatedAt.Before(comments[j].UpdatedAt)
+		}
+
+		return bytes.Compare(comments[i].ID[:], comments[j].ID[:]) < 0
+	})
+}
+
+// matchSentiment returns whether the sentiment score of the comment is selected by the filter.
+func matchSentiment(comment *Comment, filter SentimentFilter) bool {
+	switch filter {
+	case SentimentFilterPositive:
+		return comment.Sentiment > 0
+	case SentimentFilterNeutral:
+		return comment.Sentiment == 0
+	case SentimentFilterNegative:
+		return comment.Sentiment < 0
+	default:
+		return true
+	}
+}
+
+// sortBySentiment stably sorts the comments, which are sorted by update, like ORDER BY sentiment DESC or ASC,
+// updated_at ASC, id ASC.
+func sortBySentiment(comments []*Comment, order SentimentOrder) {
+	switch order {
+	case SentimentOrderDesc:
+		sort.SliceStable(comments, func(i, j int) bool {
+			return comments[i].Sentiment > comments[j].Sentiment
+		})
+	case SentimentOrderAsc:
+		sort.SliceStable(comments, func(i, j int) bool {
+			return comments[i].Sentiment < comments[j].Sentiment
+		})
+	}
+}
+
+// sortByCreatedAt sorts the comments like ORDER BY created_at ASC, id ASC.
+func sortByCreatedAt(comments []*Comment) {
+	sort.Slice(comments, func(i, j int) bool {
+		if !comments[i].CreatedAt.Equal(comments[j].CreatedAt) {
+			return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+		}
+
+		return bytes.Compare(comments[i].ID[:], comments[j].ID[:]) < 0
+	})
+}
+
+// eachBatch calls fn with the comments batchSize by batchSize, the last batch may be shorter.
+func eachBatch(comments []*Comment, batchSize int, fn func(comments []*Comment) error) error {
+	for start := 0; start < len(comments); start += batchSize {
+		end := start + batchSize
+		if end > len(comments) {
+			end = len(comments)
+		}
+
+		if err := fn(comments[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paginateComments returns the page of the comments, all the comments after the offset are returned if limit is zero.
+func paginateComments(comments []*Comment, limit, offset int) []*Comment {
+	if offset >= len(comments) {
+		return nil
+	}
+
+	comments = comments[offset:]
+	if limit > 0 && limit < len(comments) {
+		comments = comments[:limit]
+	}
+
+	return comments
+}
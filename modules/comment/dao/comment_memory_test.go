@@ -0,0 +1,178 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("memoryCommentDAO", func() {
+	var (
+		commentDAO *memoryCommentDAO
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = NewMemoryCommentDAO()
+		ctx = context.Background()
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	create := func(comment *Comment) *Comment {
+		_, err := commentDAO.Create(ctx, comment)
+		Expect(err).NotTo(HaveOccurred())
+
+		return comment
+	}
+
+	Describe("ListByVideoID", func() {
+		It("lists the comments of the video in the order of update", func() {
+			first := create(NewFakeComment(videoID))
+			second := create(NewFakeComment(videoID))
+			create(NewFakeComment(""))
+
+			Expect(commentDAO.Update(ctx, &Comment{ID: first.ID, Content: "updated"})).To(Succeed())
+
+			comments, err := commentDAO.ListByVideoID(ctx, videoID, 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(2))
+			Expect(comments[0].ID).To(Equal(second.ID))
+			Expect(comments[1].ID).To(Equal(first.ID))
+			Expect(comments[1].Content).To(Equal("updated"))
+		})
+
+		It("paginates the comments", func() {
+			for i := 0; i < 3; i++ {
+				create(NewFakeComment(videoID))
+			}
+
+			comments, err := commentDAO.ListByVideoID(ctx, videoID, 2, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+		})
+
+		It("does not list the comments of another tenant", func() {
+			create(NewFakeComment(videoID))
+
+			comments, err := commentDAO.ListByVideoID(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(BeNil())
+		})
+	})
+
+	Describe("EachByVideoID", func() {
+		It("calls fn with the comments of the video in the order of creation", func() {
+			var created []*Comment
+			for i := 0; i < 3; i++ {
+				comment := NewFakeComment(videoID)
+				comment.CreatedAt = time.Now().Add(time.Duration(i) * time.Second)
+				created = append(created, create(comment))
+			}
+			create(NewFakeComment(""))
+
+			var batches [][]*Comment
+			Expect(commentDAO.EachByVideoID(ctx, videoID, 2, func(comments []*Comment) error {
+				batches = append(batches, comments)
+				return nil
+			})).To(Succeed())
+
+			Expect(batches).To(HaveLen(2))
+			Expect(batches[0]).To(Equal(created[:2]))
+			Expect(batches[1]).To(Equal(created[2:]))
+		})
+
+		It("stops at the first error of fn", func() {
+			for i := 0; i < 3; i++ {
+				create(NewFakeComment(videoID))
+			}
+
+			calls := 0
+			err := commentDAO.EachByVideoID(ctx, videoID, 1, func(comments []*Comment) error {
+				calls++
+				return ErrCommentNotFound
+			})
+			Expect(err).To(MatchError(ErrCommentNotFound))
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	Describe("GetAsOf", func() {
+		It("gets the comment as it was at the time", func() {
+			comment := create(NewFakeComment(videoID))
+			before := time.Now()
+
+			Expect(commentDAO.Update(ctx, &Comment{ID: comment.ID, Content: "updated"})).To(Succeed())
+			Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+
+			resp, err := commentDAO.GetAsOf(ctx, comment.ID, before)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Content).To(Equal(comment.Content))
+
+			_, err = commentDAO.GetAsOf(ctx, comment.ID, time.Now())
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	Describe("BulkImport", func() {
+		It("imports none of the comments if any of them exists", func() {
+			existing := create(NewFakeComment(videoID))
+
+			n, err := commentDAO.BulkImport(ctx, []*Comment{NewFakeComment(videoID), {ID: existing.ID, VideoID: videoID}})
+			Expect(err).To(MatchError(ErrCommentAlreadyExists))
+			Expect(n).To(BeZero())
+
+			comments, err := commentDAO.ListByVideoID(ctx, videoID, 0, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+		})
+	})
+
+	Describe("ListByParentID", func() {
+		It("lists the replies after the cursor", func() {
+			parent := create(NewFakeComment(videoID))
+
+			var replies []*Comment
+			for i := 0; i < 3; i++ {
+				reply := NewFakeComment(videoID)
+				reply.ParentID = parent.ID
+				reply.CreatedAt = parent.CreatedAt.Add(time.Duration(i+1) * time.Second)
+				replies = append(replies, create(reply))
+			}
+
+			comments, err := commentDAO.ListByParentID(ctx, videoID, parent.ID, replies[0].Cursor(), 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+			Expect(comments[0].ID).To(Equal(replies[1].ID))
+
+			comments, err = commentDAO.ListByParentID(ctx, videoID, uuid.Nil, nil, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(comments).To(HaveLen(1))
+			Expect(comments[0].ID).To(Equal(parent.ID))
+		})
+	})
+})
+
+var _ = Describe("memoryCommentPubSub", func() {
+	It("delivers the published comments to the subscribers of the video", func() {
+		pubSub := NewMemoryCommentPubSub()
+		comment := NewFakeComment("")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		comments, err := pubSub.Subscribe(ctx, comment.VideoID)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pubSub.Publish(ctx, comment)).To(Succeed())
+		Eventually(comments).Should(Receive(Equal(comment)))
+
+		cancel()
+		Eventually(comments).Should(BeClosed())
+	})
+})
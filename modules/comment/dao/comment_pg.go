@@ -1,33 +1,160 @@
 package dao
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
 	"github.com/google/uuid"
 )
 
+// pgCommentDAO scopes every query to the tenant of the context.
 type pgCommentDAO struct {
-	client *pgkit.PGClient
+	client    *pgkit.PGClient
+	stmtCache *pgkit.StmtCache
 }
 
 var _ CommentDAO = (*pgCommentDAO)(nil)
 
-func NewPGCommentDAO(pgClient *pgkit.PGClient) *pgCommentDAO {
+func NewPGCommentDAO(pgClient *pgkit.PGClient, stmtCache *pgkit.StmtCache) *pgCommentDAO {
 	return &pgCommentDAO{
-		client: pgClient,
+		client:    pgClient,
+		stmtCache: stmtCache,
 	}
 }
 
+// listByVideoIDQuery is the hottest query, so it is prepared once and reused,
+// a zero limit becomes LIMIT NULL which means no limit, and status 0 is CommentStatusPublished.
+const listByVideoIDQuery = `SELECT id, tenant_id, video_id, parent_id, user_id, content, content_html, sentiment, quality, status, toxicity, likes, collapsed_count, created_at, updated_at FROM comments
+	WHERE tenant_id = $1 AND video_id = $2 AND status = 0 ORDER BY updated_at ASC LIMIT NULLIF($3, 0) OFFSET $4`
+
 func (dao *pgCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
 	var comments []*Comment
+
+	if _, err := dao.stmtCache.QueryContext(ctx, &comments, listByVideoIDQuery, tenantkit.FromContext(ctx), videoID, limit, offset); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// commentHistory is a version of a comment, which is valid from ValidFrom until ValidTo,
+// the history is maintained by the trigger of the comments table.
+type commentHistory struct {
+	tableName struct{} `pg:"comment_history"` //nolint:unused,structcheck
+
+	Comment
+
+	ValidFrom time.Time
+	ValidTo   time.Time
+}
+
+// whereValidAt selects the versions valid at the time, the timestamps are stored in UTC.
+func whereValidAt(q *orm.Query, asOf time.Time) *orm.Query {
+	asOf = asOf.UTC()
+
+	return q.Where("valid_from <= ?", asOf).WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+		return q.WhereOr("valid_to IS NULL").WhereOr("valid_to > ?", asOf), nil
+	})
+}
+
+func (dao *pgCommentDAO) ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error) {
+	var histories []*commentHistory
+
+	query := dao.client.ModelContext(ctx, &histories).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("video_id = ?", videoID).
+		Where("status = ?", CommentStatusPublished)
+	query = whereValidAt(query, asOf)
+	query = pgkit.Paginate(pgkit.OrderBy(query, pgkit.Asc("updated_at")), pgkit.Page{Limit: limit, Offset: offset})
+
+	if err := query.Select(); err != nil {
+		return nil, err
+	}
+
+	comments := make([]*Comment, 0, len(histories))
+	for _, history := range histories {
+		comment := history.Comment
+		comments = append(comments, &comment)
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error) {
+	var comments []*Comment
+
 	query := dao.client.ModelContext(ctx, &comments).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
 		Where("video_id = ?", videoID).
-		Limit(limit).
-		Offset(offset).
-		Order("updated_at ASC")
+		Where("status = ?", CommentStatusPublished)
+	switch filter {
+	case SentimentFilterPositive:
+		query = query.Where("sentiment > 0")
+	case SentimentFilterNeutral:
+		query = query.Where("sentiment = 0")
+	case SentimentFilterNegative:
+		query = query.Where("sentiment < 0")
+	}
+
+	var orders []pgkit.Order
+	switch order {
+	case SentimentOrderDesc:
+		orders = append(orders, pgkit.Desc("sentiment"))
+	case SentimentOrderAsc:
+		orders = append(orders, pgkit.Asc("sentiment"))
+	}
+	orders = append(orders, pgkit.Asc("updated_at"), pgkit.Asc("id"))
+
+	if err := pgkit.Paginate(pgkit.OrderBy(query, orders...), pgkit.Page{Limit: limit, Offset: offset}).Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// ListByQuality sorts the comments of the video by the scores at query time, since the likes change the scores.
+func (dao *pgCommentDAO) ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("video_id = ?", videoID).
+		Where("status = ?", CommentStatusPublished).
+		OrderExpr("quality + ? * ln(1 + likes) DESC", QualityLikeWeight).
+		Order("id ASC")
+
+	if err := pgkit.Paginate(query, pgkit.Page{Limit: limit, Offset: offset}).Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("video_id = ?", videoID).
+		Where("status = ?", CommentStatusPublished).
+		Order("created_at ASC", "id ASC").
+		Limit(limit)
+	if parentID == uuid.Nil {
+		query = query.Where("parent_id IS NULL")
+	} else {
+		query = query.Where("parent_id = ?", parentID)
+	}
+	if after != nil {
+		query = query.Where("(created_at, id) > (?, ?)", after.CreatedAt.UTC(), after.ID)
+	}
 
 	if err := query.Select(); err != nil {
 		return nil, err
@@ -36,16 +163,165 @@ func (dao *pgCommentDAO) ListByVideoID(ctx context.Context, videoID string, limi
 	return comments, nil
 }
 
+// EachByVideoID pages through the comments of the video by the (created_at, id) keyset, so the cost of
+// each batch does not grow with the position like OFFSET. The batches are not read from the same snapshot.
+func (dao *pgCommentDAO) EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error {
+	if batchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	var after *Cursor
+	for {
+		comments, err := dao.listByVideoIDAfter(ctx, videoID, after, batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(comments) == 0 {
+			return nil
+		}
+
+		if err := fn(comments); err != nil {
+			return err
+		}
+
+		if len(comments) < batchSize {
+			return nil
+		}
+
+		after = comments[len(comments)-1].Cursor()
+	}
+}
+
+func (dao *pgCommentDAO) listByVideoIDAfter(ctx context.Context, videoID string, after *Cursor, limit int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("video_id = ?", videoID).
+		Order("created_at ASC", "id ASC").
+		Limit(limit)
+	if after != nil {
+		query = query.Where("(created_at, id) > (?, ?)", after.CreatedAt.UTC(), after.ID)
+	}
+
+	if err := query.Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Join("JOIN comment_engagements AS engagement ON engagement.tenant_id = comment.tenant_id AND engagement.id = comment.id").
+		Where("comment.tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("comment.video_id = ?", videoID).
+		Where("comment.status = ?", CommentStatusPublished).
+		Order("engagement.score DESC", "comment.id ASC")
+
+	if err := pgkit.Paginate(query, pgkit.Page{Limit: limit, Offset: offset}).Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Join("JOIN comment_embeddings AS embedding ON embedding.tenant_id = comment.tenant_id AND embedding.id = comment.id").
+		Where("comment.tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("comment.video_id = ?", videoID).
+		Where("comment.status = ?", CommentStatusPublished).
+		Where("vector_dims(embedding.embedding) = ?", len(embedding)).
+		OrderExpr("embedding.embedding <=> ?::vector", vectorLiteral(embedding)).
+		Order("comment.id ASC")
+
+	if err := pgkit.Paginate(query, pgkit.Page{Limit: limit}).Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error) {
+	var comments []*Comment
+
+	query := dao.client.ModelContext(ctx, &comments).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("status = ?", CommentStatusPending)
+	query = pgkit.OrderBy(query, pgkit.Desc("toxicity"), pgkit.Asc("created_at"), pgkit.Asc("id"))
+	if after != nil {
+		// the toxicity is descending while the rest ascend, so the keyset is not compared as a single row
+		query = query.Where("(toxicity < ? OR (toxicity = ? AND (created_at, id) > (?, ?)))",
+			after.Toxicity, after.Toxicity, after.CreatedAt.UTC(), after.ID)
+	}
+
+	if err := pgkit.Paginate(query, pgkit.Page{Limit: limit}).Select(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (dao *pgCommentDAO) Get(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	comment := &Comment{ID: id}
+
+	if err := dao.client.ModelContext(ctx, comment).WherePK().Where("tenant_id = ?", tenantkit.FromContext(ctx)).Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (dao *pgCommentDAO) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error) {
+	var history commentHistory
+
+	query := dao.client.ModelContext(ctx, &history).
+		Where("id = ?", id).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx))
+
+	if err := whereValidAt(query, asOf).Limit(1).Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+
+		return nil, err
+	}
+
+	return &history.Comment, nil
+}
+
 func (dao *pgCommentDAO) Create(ctx context.Context, comment *Comment) (uuid.UUID, error) {
+	comment.TenantID = tenantkit.FromContext(ctx)
+
 	if _, err := dao.client.ModelContext(ctx, comment).Insert(); err != nil {
+		if pgkit.IsUniqueViolation(err) {
+			return uuid.Nil, ErrCommentAlreadyExists
+		}
+
 		return uuid.Nil, err
 	}
 
 	return comment.ID, nil
 }
 
+// Update updates the content of the comment along with its update time, which orders the comments of the video.
 func (dao *pgCommentDAO) Update(ctx context.Context, comment *Comment) error {
-	if _, err := dao.client.ModelContext(ctx, comment).Column("content").WherePK().Returning("*").Update(); err != nil {
+	if _, err := dao.client.ModelContext(ctx, comment).
+		Set("content = ?content, content_html = ?content_html, sentiment = ?sentiment, quality = ?quality, updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Returning("*").
+		Update(); err != nil {
 		if errors.Is(err, pg.ErrNoRows) {
 			return ErrCommentNotFound
 		}
@@ -56,8 +332,161 @@ func (dao *pgCommentDAO) Update(ctx context.Context, comment *Comment) error {
 	return nil
 }
 
+func (dao *pgCommentDAO) Hold(ctx context.Context, id uuid.UUID, toxicity float64) error {
+	return dao.setStatus(ctx, &Comment{ID: id, Status: CommentStatusPending, Toxicity: toxicity}, "status = ?status, toxicity = ?toxicity")
+}
+
+func (dao *pgCommentDAO) Approve(ctx context.Context, id uuid.UUID) error {
+	return dao.setStatus(ctx, &Comment{ID: id, Status: CommentStatusPublished}, "status = ?status")
+}
+
+// setStatus sets the columns of the moderation status of the comment, the update time is kept, so the comment
+// is listed at the same position once approved.
+func (dao *pgCommentDAO) setStatus(ctx context.Context, comment *Comment, set string) error {
+	res, err := dao.client.ModelContext(ctx, comment).Set(set).WherePK().Where("tenant_id = ?", tenantkit.FromContext(ctx)).Update()
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrCommentNotFound
+	}
+
+	return nil
+}
+
+// Like inserts the like of the user into the comment_likes table, which keeps a like of a user per comment by its
+// primary key, and is partitioned by the creation time of the comment so the likes are dropped with the comments.
+func (dao *pgCommentDAO) Like(ctx context.Context, id uuid.UUID, userID string) (bool, error) {
+	tenantID := tenantkit.FromContext(ctx)
+
+	comment := &Comment{ID: id}
+	if err := dao.client.ModelContext(ctx, comment).Column("created_at").WherePK().Where("tenant_id = ?", tenantID).Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return false, ErrCommentNotFound
+		}
+
+		return false, err
+	}
+
+	res, err := dao.client.ModelContext(ctx, &commentLike{
+		CommentID:        id,
+		CommentCreatedAt: comment.CreatedAt,
+		UserID:           userID,
+		TenantID:         tenantID,
+	}).OnConflict("DO NOTHING").Insert()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// SetLikes skips the comment whose likes are unchanged, so the snapshots of the counters do not publish the changes
+// of the comments in vain.
+func (dao *pgCommentDAO) SetLikes(ctx context.Context, id uuid.UUID, likes int64) error {
+	if _, err := dao.client.ModelContext(ctx, &Comment{ID: id}).
+		Set("likes = ?", likes).
+		WherePK().
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("likes IS DISTINCT FROM ?", likes).
+		Update(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dao *pgCommentDAO) Collapse(ctx context.Context, id uuid.UUID) error {
+	res, err := dao.client.ModelContext(ctx, &Comment{ID: id}).Set("collapsed_count = collapsed_count + 1").WherePK().Where("tenant_id = ?", tenantkit.FromContext(ctx)).Update()
+	if err != nil {
+		return err
+	}
+
+	if res.RowsAffected() == 0 {
+		return ErrCommentNotFound
+	}
+
+	return nil
+}
+
+// TrackEngagement tracks the engagements in a transaction, the comment is tracked once by the insert of its
+// engagement, which adds the reply to its parent as well, and the likes are tracked by the difference from the
+// likes tracked. The scores are added by the logaddexp function of the migrations.
+func (dao *pgCommentDAO) TrackEngagement(ctx context.Context, comment *Comment, at time.Time) error {
+	tenantID := tenantkit.FromContext(ctx)
+
+	return dao.client.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		engagement := &commentEngagement{
+			ID:       comment.ID,
+			TenantID: tenantID,
+			VideoID:  comment.VideoID,
+			Score:    engagementScore(commentEngagementWeight, comment.CreatedAt),
+		}
+
+		res, err := tx.ModelContext(ctx, engagement).OnConflict("DO NOTHING").Insert()
+		if err != nil {
+			return err
+		}
+
+		if res.RowsAffected() > 0 && comment.ParentID != uuid.Nil {
+			if _, err := tx.ModelContext(ctx, (*commentEngagement)(nil)).
+				Set("score = logaddexp(score, ?)", engagementScore(replyEngagementWeight, comment.CreatedAt)).
+				Where("tenant_id = ?", tenantID).
+				Where("id = ?", comment.ParentID).
+				Update(); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ModelContext(ctx, (*commentEngagement)(nil)).
+			Set("score = logaddexp(score, ln(?::double precision * (? - likes)) + ?)", float64(likeEngagementWeight), comment.Likes, engagementGrowth(at)).
+			Set("likes = ?", comment.Likes).
+			Where("tenant_id = ?", tenantID).
+			Where("id = ?", comment.ID).
+			Where("likes < ?", comment.Likes).
+			Update(); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (dao *pgCommentDAO) GetEmbeddingUpdatedAt(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	var updatedAt time.Time
+
+	if err := dao.client.ModelContext(ctx, (*commentEmbedding)(nil)).
+		Column("updated_at").
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("id = ?", id).
+		Select(&updatedAt); err != nil && !errors.Is(err, pg.ErrNoRows) {
+		return time.Time{}, err
+	}
+
+	return updatedAt, nil
+}
+
+func (dao *pgCommentDAO) UpdateEmbedding(ctx context.Context, comment *Comment, embedding []float32) error {
+	model := &commentEmbedding{
+		ID:        comment.ID,
+		TenantID:  tenantkit.FromContext(ctx),
+		VideoID:   comment.VideoID,
+		UpdatedAt: comment.UpdatedAt,
+	}
+
+	_, err := dao.client.ModelContext(ctx, model).
+		Value("embedding", "?::vector", vectorLiteral(embedding)).
+		OnConflict("(tenant_id, id) DO UPDATE").
+		Set("embedding = EXCLUDED.embedding").
+		Set("updated_at = EXCLUDED.updated_at").
+		Insert()
+
+	return err
+}
+
 func (dao *pgCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
-	if res, err := dao.client.ModelContext(ctx, &Comment{ID: id}).WherePK().Delete(); err != nil {
+	if res, err := dao.client.ModelContext(ctx, &Comment{ID: id}).WherePK().Where("tenant_id = ?", tenantkit.FromContext(ctx)).Delete(); err != nil {
 		return err
 	} else if res.RowsAffected() == 0 {
 		return ErrCommentNotFound
@@ -68,9 +497,143 @@ func (dao *pgCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
 
 // delete all comments when the video deleted
 func (dao *pgCommentDAO) DeleteByVideoID(ctx context.Context, videoID string) error {
-	if _, err := dao.client.ModelContext(ctx, (*Comment)(nil)).Where("video_id = ?", videoID).Delete(); err != nil {
+	if _, err := dao.client.ModelContext(ctx, (*Comment)(nil)).Where("tenant_id = ?", tenantkit.FromContext(ctx)).Where("video_id = ?", videoID).Delete(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+const commentCopyTimeLayout = "2006-01-02 15:04:05.999999"
+
+// BulkImport imports the comments with the COPY protocol in a single statement,
+// either all the comments are imported or none of them.
+// The ID and timestamps are generated if not set, the comments are imported into the tenant of the context.
+func (dao *pgCommentDAO) BulkImport(ctx context.Context, comments []*Comment) (int, error) {
+	if len(comments) == 0 {
+		return 0, nil
+	}
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	now := time.Now()
+	for _, comment := range comments {
+		comment.TenantID = tenantID
+		if comment.ID == uuid.Nil {
+			comment.ID = uuid.New()
+		}
+		if comment.CreatedAt.IsZero() {
+			comment.CreatedAt = now
+		}
+		if comment.UpdatedAt.IsZero() {
+			comment.UpdatedAt = comment.CreatedAt
+		}
+
+		if err := w.Write([]string{
+			comment.ID.String(),
+			comment.TenantID,
+			comment.VideoID,
+			// an unquoted empty field is NULL in the CSV format, except for the columns of FORCE_NOT_NULL
+			comment.parentID(),
+			comment.UserID,
+			comment.Content,
+			comment.ContentHTML,
+			strconv.FormatFloat(comment.Sentiment, 'g', -1, 64),
+			strconv.FormatFloat(comment.Quality, 'g', -1, 64),
+			strconv.Itoa(int(comment.Status)),
+			strconv.FormatFloat(comment.Toxicity, 'g', -1, 64),
+			strconv.FormatInt(comment.Likes, 10),
+			strconv.FormatInt(comment.CollapsedCount, 10),
+			comment.CreatedAt.UTC().Format(commentCopyTimeLayout),
+			comment.UpdatedAt.UTC().Format(commentCopyTimeLayout),
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return 0, err
+	}
+
+	query := "COPY comments (id, tenant_id, video_id, parent_id, user_id, content, content_html, sentiment, quality, status, toxicity, likes, collapsed_count, created_at, updated_at) FROM STDIN WITH (FORMAT csv, FORCE_NOT_NULL (tenant_id, video_id, user_id, content))"
+
+	res, err := dao.client.WithContext(ctx).CopyFrom(&buf, query)
+	if err != nil {
+		if pgkit.IsUniqueViolation(err) {
+			return 0, ErrCommentAlreadyExists
+		}
+
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// Export calls fn with the comments of the tenant of the context batch by batch in the order of creation.
+// All the batches are read from the same snapshot, which is taken at the returned time.
+func (dao *pgCommentDAO) Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error) {
+	if batchSize <= 0 {
+		return time.Time{}, ErrInvalidBatchSize
+	}
+
+	tx, err := dao.client.BeginContext(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// the transaction is read only, so it is always rolled back
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY"); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := pgkit.SetLocalStatementTimeout(ctx, tx); err != nil {
+		return time.Time{}, err
+	}
+
+	var snapshotTime time.Time
+	if _, err := tx.QueryOneContext(ctx, pg.Scan(&snapshotTime), "SELECT transaction_timestamp()"); err != nil {
+		return time.Time{}, err
+	}
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var last *Comment
+	for {
+		var comments []*Comment
+
+		query := tx.ModelContext(ctx, &comments).
+			Where("tenant_id = ?", tenantID).
+			Order("created_at ASC", "id ASC").
+			Limit(batchSize)
+		if last != nil {
+			query = query.Where("(created_at, id) > (?, ?)", last.CreatedAt, last.ID)
+		}
+
+		if err := query.Select(); err != nil {
+			return time.Time{}, err
+		}
+
+		if len(comments) == 0 {
+			break
+		}
+
+		if err := fn(comments); err != nil {
+			return time.Time{}, err
+		}
+
+		if len(comments) < batchSize {
+			break
+		}
+
+		last = comments[len(comments)-1]
+	}
+
+	return snapshotTime, nil
+}
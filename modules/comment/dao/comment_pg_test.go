@@ -2,7 +2,9 @@ package dao
 
 import (
 	"context"
+	"time"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-pg/pg/v10"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -17,7 +19,7 @@ var _ = Describe("PGCommentDAO", func() {
 	var ctx context.Context
 
 	BeforeEach(func() {
-		commentDAO = NewPGCommentDAO(pgClient)
+		commentDAO = NewPGCommentDAO(pgClient, stmtCache)
 		ctx = context.Background()
 	})
 
@@ -65,6 +67,18 @@ var _ = Describe("PGCommentDAO", func() {
 			})
 		})
 
+		When("comments belong to another tenant", func() {
+			BeforeEach(func() {
+				videoID = comments[0].VideoID
+				ctx = tenantkit.WithTenantID(ctx, "another-tenant")
+			})
+
+			It("returns empty list with no error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("success", func() {
 			BeforeEach(func() { videoID = comments[0].VideoID })
 
@@ -97,6 +111,173 @@ var _ = Describe("PGCommentDAO", func() {
 		})
 	})
 
+	Describe("ListByParentID", func() {
+		var (
+			parent   *Comment
+			replies  []*Comment
+			videoID  string
+			parentID uuid.UUID
+			after    *Cursor
+			limit    int
+
+			resp []*Comment
+			err  error
+		)
+
+		BeforeEach(func() {
+			parent = NewFakeComment("")
+			insertComment(parent)
+
+			createdAt := time.Now().UTC().Truncate(time.Microsecond)
+
+			replies = make([]*Comment, 3)
+			for i := range replies {
+				replies[i] = NewFakeComment(parent.VideoID)
+				replies[i].ParentID = parent.ID
+				replies[i].CreatedAt = createdAt.Add(time.Duration(i) * time.Second)
+				insertReply(replies[i])
+			}
+
+			videoID = parent.VideoID
+			parentID = parent.ID
+			after = nil
+			limit = 10
+		})
+
+		AfterEach(func() {
+			deleteComment(parent.ID)
+			for _, reply := range replies {
+				deleteComment(reply.ID)
+			}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = commentDAO.ListByParentID(ctx, videoID, parentID, after, limit)
+		})
+
+		When("parent is nil", func() {
+			BeforeEach(func() { parentID = uuid.Nil })
+
+			It("returns the top-level comments with no error", func() {
+				Expect(resp).To(HaveLen(1))
+				Expect(resp[0]).To(matchComment(parent))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("comments belong to another tenant", func() {
+			BeforeEach(func() { ctx = tenantkit.WithTenantID(ctx, "another-tenant") })
+
+			It("returns empty list with no error", func() {
+				Expect(resp).To(BeEmpty())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("no cursor", func() {
+			It("returns the replies in the order of creation with no error", func() {
+				Expect(resp).To(HaveLen(3))
+				for i := range resp {
+					Expect(resp[i]).To(matchComment(replies[i]))
+					Expect(resp[i].ParentID).To(Equal(parent.ID))
+				}
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("limit = 2", func() {
+			BeforeEach(func() { limit = 2 })
+
+			It("returns the first two replies with no error", func() {
+				Expect(resp).To(HaveLen(2))
+				Expect(resp[0]).To(matchComment(replies[0]))
+				Expect(resp[1]).To(matchComment(replies[1]))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("cursor is the first reply", func() {
+			BeforeEach(func() { after = replies[0].Cursor() })
+
+			It("returns the replies after the cursor with no error", func() {
+				Expect(resp).To(HaveLen(2))
+				Expect(resp[0]).To(matchComment(replies[1]))
+				Expect(resp[1]).To(matchComment(replies[2]))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("EachByVideoID", func() {
+		var (
+			comments  []*Comment
+			videoID   string
+			batchSize int
+
+			batches [][]*Comment
+			err     error
+		)
+
+		BeforeEach(func() {
+			videoID = primitive.NewObjectID().Hex()
+
+			comments = []*Comment{NewFakeComment(videoID), NewFakeComment(videoID), NewFakeComment(videoID)}
+			for _, comment := range comments {
+				insertComment(comment)
+			}
+
+			batchSize = 2
+			batches = nil
+		})
+
+		AfterEach(func() {
+			for _, comment := range comments {
+				deleteComment(comment.ID)
+			}
+		})
+
+		JustBeforeEach(func() {
+			err = commentDAO.EachByVideoID(ctx, videoID, batchSize, func(comments []*Comment) error {
+				batches = append(batches, comments)
+				return nil
+			})
+		})
+
+		When("batch size is invalid", func() {
+			BeforeEach(func() { batchSize = 0 })
+
+			It("returns invalid batch size error", func() {
+				Expect(err).To(MatchError(ErrInvalidBatchSize))
+			})
+		})
+
+		When("comments belong to another tenant", func() {
+			BeforeEach(func() { ctx = tenantkit.WithTenantID(ctx, "another-tenant") })
+
+			It("does not call fn", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batches).To(BeEmpty())
+			})
+		})
+
+		When("success", func() {
+			It("calls fn with the comments of the video batch by batch", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batches).To(HaveLen(2))
+				Expect(batches[0]).To(HaveLen(2))
+				Expect(batches[1]).To(HaveLen(1))
+
+				var ids []uuid.UUID
+				for _, batch := range batches {
+					for _, comment := range batch {
+						ids = append(ids, comment.ID)
+					}
+				}
+				Expect(ids).To(ConsistOf(comments[0].ID, comments[1].ID, comments[2].ID))
+			})
+		})
+	})
+
 	Describe("Create", func() {
 		var (
 			comment *Comment
@@ -133,6 +314,19 @@ var _ = Describe("PGCommentDAO", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		When("context has tenant ID", func() {
+			BeforeEach(func() { ctx = tenantkit.WithTenantID(ctx, "another-tenant") })
+
+			It("inserts the comment into the tenant", func() {
+				var tenantID string
+
+				_, err := pgClient.QueryOne(pg.Scan(&tenantID), "SELECT tenant_id FROM comments WHERE id = ?", comment.ID)
+
+				Expect(tenantID).To(Equal("another-tenant"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
 	})
 
 	Describe("Update", func() {
@@ -166,6 +360,14 @@ var _ = Describe("PGCommentDAO", func() {
 			})
 		})
 
+		When("comment belongs to another tenant", func() {
+			BeforeEach(func() { ctx = tenantkit.WithTenantID(ctx, "another-tenant") })
+
+			It("returns comment not found error", func() {
+				Expect(err).To(MatchError(ErrCommentNotFound))
+			})
+		})
+
 		When("success", func() {
 			var content string
 
@@ -219,6 +421,21 @@ var _ = Describe("PGCommentDAO", func() {
 			})
 		})
 
+		When("comment belongs to another tenant", func() {
+			BeforeEach(func() {
+				id = comment.ID
+				ctx = tenantkit.WithTenantID(ctx, "another-tenant")
+			})
+
+			AfterEach(func() {
+				deleteComment(comment.ID)
+			})
+
+			It("returns comment not found error", func() {
+				Expect(err).To(MatchError(ErrCommentNotFound))
+			})
+		})
+
 		When("success", func() {
 			BeforeEach(func() { id = comment.ID })
 
@@ -280,12 +497,287 @@ var _ = Describe("PGCommentDAO", func() {
 			})
 		})
 	})
+
+	Describe("BulkImport", func() {
+		var (
+			comments []*Comment
+
+			resp int
+			err  error
+		)
+
+		BeforeEach(func() {
+			fakeVideoID := primitive.NewObjectID().Hex()
+
+			comments = []*Comment{
+				NewFakeComment(fakeVideoID),
+				NewFakeComment(fakeVideoID),
+			}
+			comments[0].Content = "comment, with \"quotes\"\nand newline"
+			comments[1].ID = uuid.Nil
+			comments[1].CreatedAt = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		})
+
+		AfterEach(func() {
+			for _, comment := range comments {
+				deleteComment(comment.ID)
+			}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = commentDAO.BulkImport(ctx, comments)
+		})
+
+		When("duplicate comment", func() {
+			BeforeEach(func() {
+				comments[1].ID = comments[0].ID
+				comments[0].CreatedAt = comments[1].CreatedAt
+			})
+
+			It("imports none of the comments", func() {
+				var count int
+
+				_, qerr := pgClient.QueryOne(pg.Scan(&count), "SELECT COUNT(*) FROM comments WHERE video_id = ?", comments[0].VideoID)
+
+				Expect(count).To(BeZero())
+				Expect(qerr).NotTo(HaveOccurred())
+				Expect(resp).To(BeZero())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("success", func() {
+			It("returns the number of imported comments with no error", func() {
+				Expect(resp).To(Equal(2))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("imports the comments", func() {
+				for _, comment := range comments {
+					var getComment Comment
+
+					_, err := pgClient.QueryOne(&getComment, "SELECT * FROM comments WHERE id = ?", comment.ID)
+
+					Expect(&getComment).To(matchComment(comment))
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+
+			It("keeps the given created time", func() {
+				var getComment Comment
+
+				_, err := pgClient.QueryOne(&getComment, "SELECT * FROM comments WHERE id = ?", comments[1].ID)
+
+				Expect(getComment.CreatedAt).To(BeTemporally("==", comments[1].CreatedAt))
+				Expect(getComment.UpdatedAt).To(BeTemporally("==", comments[1].CreatedAt))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("empty content", func() {
+			BeforeEach(func() {
+				comments[0].Content = ""
+			})
+
+			It("imports the content as an empty string", func() {
+				var content *string
+
+				_, qerr := pgClient.QueryOne(pg.Scan(&content), "SELECT content FROM comments WHERE id = ?", comments[0].ID)
+
+				Expect(content).NotTo(BeNil())
+				Expect(*content).To(BeEmpty())
+				Expect(qerr).NotTo(HaveOccurred())
+				Expect(resp).To(Equal(2))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Export", func() {
+		var (
+			comments  []*Comment
+			batchSize int
+
+			batches [][]*Comment
+			resp    time.Time
+			err     error
+		)
+
+		BeforeEach(func() {
+			// export every comment of the tenant, so use a tenant of the test only
+			tenantID := "export-" + primitive.NewObjectID().Hex()
+			ctx = tenantkit.WithTenantID(ctx, tenantID)
+
+			comments = []*Comment{NewFakeComment(""), NewFakeComment(""), NewFakeComment("")}
+			for _, comment := range comments {
+				comment.TenantID = tenantID
+				insertComment(comment)
+			}
+
+			batchSize = 2
+			batches = nil
+		})
+
+		AfterEach(func() {
+			for _, comment := range comments {
+				deleteComment(comment.ID)
+			}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = commentDAO.Export(ctx, batchSize, func(comments []*Comment) error {
+				batches = append(batches, comments)
+				return nil
+			})
+		})
+
+		When("batch size is invalid", func() {
+			BeforeEach(func() { batchSize = 0 })
+
+			It("returns invalid batch size error", func() {
+				Expect(err).To(MatchError(ErrInvalidBatchSize))
+			})
+		})
+
+		When("success", func() {
+			It("exports the comments of the tenant batch by batch", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batches).To(HaveLen(2))
+				Expect(batches[0]).To(HaveLen(2))
+				Expect(batches[1]).To(HaveLen(1))
+
+				var ids []uuid.UUID
+				for _, batch := range batches {
+					for _, comment := range batch {
+						ids = append(ids, comment.ID)
+					}
+				}
+				Expect(ids).To(ConsistOf(comments[0].ID, comments[1].ID, comments[2].ID))
+			})
+
+			It("returns the snapshot time", func() {
+				Expect(resp).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+		})
+	})
+
+	Describe("Get", func() {
+		var (
+			comment *Comment
+			id      uuid.UUID
+
+			resp *Comment
+			err  error
+		)
+
+		BeforeEach(func() {
+			comment = NewFakeComment("")
+			insertComment(comment)
+		})
+
+		AfterEach(func() {
+			deleteComment(comment.ID)
+		})
+
+		JustBeforeEach(func() {
+			resp, err = commentDAO.Get(ctx, id)
+		})
+
+		When("comment not found", func() {
+			BeforeEach(func() { id = uuid.New() })
+
+			It("returns comment not found error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrCommentNotFound))
+			})
+		})
+
+		When("success", func() {
+			BeforeEach(func() { id = comment.ID })
+
+			It("returns the comment with no error", func() {
+				Expect(resp).To(matchComment(comment))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("History", func() {
+		var (
+			comment *Comment
+
+			createdAt time.Time
+			updatedAt time.Time
+			deletedAt time.Time
+		)
+
+		BeforeEach(func() {
+			comment = NewFakeComment("")
+			comment.ID = uuid.Nil
+
+			_, err := commentDAO.Create(ctx, comment)
+			Expect(err).NotTo(HaveOccurred())
+			createdAt = localTimestamp()
+
+			updated := *comment
+			updated.Content = "comment history test"
+			Expect(commentDAO.Update(ctx, &updated)).To(Succeed())
+			updatedAt = localTimestamp()
+
+			Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+			deletedAt = localTimestamp()
+		})
+
+		Describe("GetAsOf", func() {
+			It("returns the comment as it was at the time", func() {
+				resp, err := commentDAO.GetAsOf(ctx, comment.ID, createdAt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Content).To(Equal(comment.Content))
+
+				resp, err = commentDAO.GetAsOf(ctx, comment.ID, updatedAt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Content).To(Equal("comment history test"))
+			})
+
+			It("returns comment not found error after the comment is deleted", func() {
+				resp, err := commentDAO.GetAsOf(ctx, comment.ID, deletedAt)
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrCommentNotFound))
+			})
+
+			It("returns comment not found error in another tenant", func() {
+				resp, err := commentDAO.GetAsOf(tenantkit.WithTenantID(ctx, "another-tenant"), comment.ID, createdAt)
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrCommentNotFound))
+			})
+		})
+
+		Describe("ListByVideoIDAsOf", func() {
+			It("returns the comments as they were at the time", func() {
+				resp, err := commentDAO.ListByVideoIDAsOf(ctx, comment.VideoID, updatedAt, 0, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(HaveLen(1))
+				Expect(resp[0].ID).To(Equal(comment.ID))
+				Expect(resp[0].Content).To(Equal("comment history test"))
+
+				resp, err = commentDAO.ListByVideoIDAsOf(ctx, comment.VideoID, deletedAt, 0, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeEmpty())
+			})
+		})
+	})
 })
 
 func insertComment(comment *Comment) {
-	query := "INSERT INTO comments (id, video_id, content) VALUES (?, ?, ?);"
+	query := "INSERT INTO comments (id, tenant_id, video_id, content) VALUES (?, ?, ?, ?);"
+
+	pgExec(query, comment.ID, comment.TenantID, comment.VideoID, comment.Content)
+}
+
+func insertReply(comment *Comment) {
+	query := "INSERT INTO comments (id, tenant_id, video_id, parent_id, content, created_at) VALUES (?, ?, ?, ?, ?, ?);"
 
-	pgExec(query, comment.ID, comment.VideoID, comment.Content)
+	pgExec(query, comment.ID, comment.TenantID, comment.VideoID, comment.ParentID, comment.Content, comment.CreatedAt)
 }
 
 func deleteComment(id uuid.UUID) {
@@ -296,8 +788,22 @@ func deleteComment(id uuid.UUID) {
 
 func matchComment(comment *Comment) types.GomegaMatcher {
 	return PointTo(MatchFields(IgnoreExtras, Fields{
-		"ID":      Equal(comment.ID),
-		"VideoID": Equal(comment.VideoID),
-		"Content": Equal(comment.Content),
+		"ID":             Equal(comment.ID),
+		"TenantID":       Equal(comment.TenantID),
+		"VideoID":        Equal(comment.VideoID),
+		"Content":        Equal(comment.Content),
+		"Sentiment":      Equal(comment.Sentiment),
+		"Likes":          Equal(comment.Likes),
+		"CollapsedCount": Equal(comment.CollapsedCount),
 	}))
 }
+
+// localTimestamp returns the current time of the database, which the history of the comments uses.
+func localTimestamp() time.Time {
+	var now time.Time
+
+	_, err := pgClient.QueryOne(pg.Scan(&now), "SELECT LOCALTIMESTAMP")
+	Expect(err).NotTo(HaveOccurred())
+
+	return now
+}
@@ -0,0 +1,19 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+)
+
+// CommentPubSub fans out the new comments of the videos to the subscribers on every replica.
+type CommentPubSub interface {
+	// Publish publishes the comment to the subscribers of its video in the tenant of the context
+	Publish(ctx context.Context, comment *Comment) error
+	// Subscribe subscribes to the comments of the video in the tenant of the context,
+	// the channel is closed after the context is done.
+	Subscribe(ctx context.Context, videoID string) (<-chan *Comment, error)
+}
+
+func commentChannel(tenantID, videoID string) string {
+	return fmt.Sprintf("comments:%s:%s", tenantID, videoID)
+}
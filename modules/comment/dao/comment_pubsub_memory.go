@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryCommentPubSub fans out the comments to the subscribers in the same process, it is meant for
+// running the modules without Redis in local development. Like Redis Pub/Sub, the comments published
+// while a subscriber is not connected are not delivered to it, and the ones overflowing the buffer of
+// a slow subscriber are dropped.
+type memoryCommentPubSub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *Comment]struct{}
+}
+
+var _ CommentPubSub = (*memoryCommentPubSub)(nil)
+
+func NewMemoryCommentPubSub() *memoryCommentPubSub {
+	return &memoryCommentPubSub{
+		subscribers: make(map[string]map[chan *Comment]struct{}),
+	}
+}
+
+func (ps *memoryCommentPubSub) Publish(ctx context.Context, comment *Comment) error {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	for ch := range ps.subscribers[commentChannel(tenantkit.FromContext(ctx), comment.VideoID)] {
+		select {
+		case ch <- copyComment(comment):
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (ps *memoryCommentPubSub) Subscribe(ctx context.Context, videoID string) (<-chan *Comment, error) {
+	channel := commentChannel(tenantkit.FromContext(ctx), videoID)
+	comments := make(chan *Comment, commentSubscriptionBufferSize)
+
+	ps.mu.Lock()
+	if ps.subscribers[channel] == nil {
+		ps.subscribers[channel] = make(map[chan *Comment]struct{})
+	}
+	ps.subscribers[channel][comments] = struct{}{}
+	ps.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		ps.mu.Lock()
+		defer ps.mu.Unlock()
+
+		delete(ps.subscribers[channel], comments)
+		if len(ps.subscribers[channel]) == 0 {
+			delete(ps.subscribers, channel)
+		}
+		close(comments)
+	}()
+
+	return comments, nil
+}
@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// redisCommentPubSub publishes the comments to a Redis Pub/Sub channel per video,
+// the comments published while a subscriber is not connected are not delivered to it.
+type redisCommentPubSub struct {
+	client *rediskit.RedisClient
+}
+
+var _ CommentPubSub = (*redisCommentPubSub)(nil)
+
+// commentSubscriptionBufferSize is the number of comments buffered for a slow subscriber
+const commentSubscriptionBufferSize = 64
+
+func NewRedisCommentPubSub(client *rediskit.RedisClient) *redisCommentPubSub {
+	return &redisCommentPubSub{
+		client: client,
+	}
+}
+
+func (ps *redisCommentPubSub) Publish(ctx context.Context, comment *Comment) error {
+	payload, err := json.Marshal(comment)
+	if err != nil {
+		return err
+	}
+
+	return ps.client.Publish(ctx, commentChannel(tenantkit.FromContext(ctx), comment.VideoID), payload).Err()
+}
+
+func (ps *redisCommentPubSub) Subscribe(ctx context.Context, videoID string) (<-chan *Comment, error) {
+	sub := ps.client.Subscribe(ctx, commentChannel(tenantkit.FromContext(ctx), videoID))
+
+	// wait for the confirmation so that no comment published after Subscribe returns is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+
+	logger := logkit.FromContext(ctx).With(zap.String("video_id", videoID))
+	comments := make(chan *Comment, commentSubscriptionBufferSize)
+
+	go func() {
+		defer close(comments)
+		defer func() {
+			if err := sub.Close(); err != nil {
+				logger.Error("failed to close comment subscription", zap.Error(err))
+			}
+		}()
+
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+
+				var comment Comment
+				if err := json.Unmarshal([]byte(msg.Payload), &comment); err != nil {
+					logger.Error("failed to unmarshal published comment", zap.Error(err))
+					continue
+				}
+
+				select {
+				case comments <- &comment:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return comments, nil
+}
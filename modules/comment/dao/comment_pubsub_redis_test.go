@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CommentRedisPubSub", func() {
+	var (
+		pubsub *redisCommentPubSub
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(logkit.NewNopLogger().WithContext(context.Background()))
+		pubsub = NewRedisCommentPubSub(redisClient)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Subscribe", func() {
+		var (
+			comment  *Comment
+			comments <-chan *Comment
+		)
+
+		BeforeEach(func() {
+			comment = NewFakeComment("")
+
+			var err error
+			comments, err = pubsub.Subscribe(ctx, comment.VideoID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("comment is published to the video", func() {
+			It("receives the comment", func() {
+				Expect(pubsub.Publish(ctx, comment)).To(Succeed())
+
+				var received *Comment
+				Eventually(comments).Should(Receive(&received))
+				Expect(received).To(matchComment(comment))
+			})
+		})
+
+		When("comment is published to another video", func() {
+			It("does not receive the comment", func() {
+				Expect(pubsub.Publish(ctx, NewFakeComment(""))).To(Succeed())
+				Consistently(comments, 100*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+
+		When("comment is published in another tenant", func() {
+			It("does not receive the comment", func() {
+				Expect(pubsub.Publish(tenantkit.WithTenantID(ctx, "another-tenant"), comment)).To(Succeed())
+				Consistently(comments, 100*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+
+		When("context is done", func() {
+			It("closes the channel", func() {
+				cancel()
+				Eventually(comments).Should(BeClosed())
+			})
+		})
+	})
+})
@@ -2,14 +2,17 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-redis/cache/v8"
 	"github.com/google/uuid"
 )
 
 type redisCommentDAO struct {
+	client  *rediskit.RedisClient
 	cache   *cache.Cache
 	baseDAO CommentDAO
 }
@@ -24,6 +27,7 @@ const (
 
 func NewRedisCommentDAO(client *rediskit.RedisClient, baseDAO CommentDAO) *redisCommentDAO {
 	return &redisCommentDAO{
+		client: client,
 		cache: cache.New(&cache.Options{
 			Redis:      client,
 			LocalCache: cache.NewTinyLFU(commentDAOLocalCacheSize, commentDAOLocalCacheDuration),
@@ -36,7 +40,7 @@ func (dao *redisCommentDAO) ListByVideoID(ctx context.Context, videoID string, l
 	var comment []*Comment
 
 	if err := dao.cache.Once(&cache.Item{
-		Key:   listCommentKey(videoID, limit, offset),
+		Key:   listCommentKey(tenantkit.FromContext(ctx), videoID, limit, offset),
 		Value: &comment,
 		TTL:   commentDAORedisCacheDuration,
 		Do: func(*cache.Item) (interface{}, error) {
@@ -48,8 +52,63 @@ func (dao *redisCommentDAO) ListByVideoID(ctx context.Context, videoID string, l
 	return comment, nil
 }
 
+// DeleteListCache deletes the cached comment lists of the video of every limit and offset
+// in the tenant of the context.
+//
+// Note that the local cache of other processes is not deleted and expires on its own.
+func (dao *redisCommentDAO) DeleteListCache(ctx context.Context, videoID string) error {
+	iter := dao.client.Scan(ctx, 0, listCommentKeyPattern(tenantkit.FromContext(ctx), videoID), 0).Iterator()
+	for iter.Next(ctx) {
+		if err := dao.cache.Delete(ctx, iter.Val()); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+			return err
+		}
+	}
+
+	return iter.Err()
+}
+
 // The following operations are not cachable, just pass down to baseDAO
 
+func (dao *redisCommentDAO) ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error) {
+	return dao.baseDAO.ListByVideoIDAsOf(ctx, videoID, asOf, limit, offset)
+}
+
+func (dao *redisCommentDAO) ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error) {
+	return dao.baseDAO.ListBySentiment(ctx, videoID, filter, order, limit, offset)
+}
+
+func (dao *redisCommentDAO) ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	return dao.baseDAO.ListByQuality(ctx, videoID, limit, offset)
+}
+
+func (dao *redisCommentDAO) ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error) {
+	return dao.baseDAO.ListByParentID(ctx, videoID, parentID, after, limit)
+}
+
+func (dao *redisCommentDAO) EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error {
+	return dao.baseDAO.EachByVideoID(ctx, videoID, batchSize, fn)
+}
+
+func (dao *redisCommentDAO) ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	return dao.baseDAO.ListTop(ctx, videoID, limit, offset)
+}
+
+func (dao *redisCommentDAO) ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error) {
+	return dao.baseDAO.ListSimilar(ctx, videoID, embedding, limit)
+}
+
+func (dao *redisCommentDAO) ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error) {
+	return dao.baseDAO.ListPending(ctx, after, limit)
+}
+
+func (dao *redisCommentDAO) Get(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	return dao.baseDAO.Get(ctx, id)
+}
+
+func (dao *redisCommentDAO) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error) {
+	return dao.baseDAO.GetAsOf(ctx, id, asOf)
+}
+
 func (dao *redisCommentDAO) Create(ctx context.Context, comment *Comment) (uuid.UUID, error) {
 	return dao.baseDAO.Create(ctx, comment)
 }
@@ -58,6 +117,38 @@ func (dao *redisCommentDAO) Update(ctx context.Context, comment *Comment) error
 	return dao.baseDAO.Update(ctx, comment)
 }
 
+func (dao *redisCommentDAO) Hold(ctx context.Context, id uuid.UUID, toxicity float64) error {
+	return dao.baseDAO.Hold(ctx, id, toxicity)
+}
+
+func (dao *redisCommentDAO) Approve(ctx context.Context, id uuid.UUID) error {
+	return dao.baseDAO.Approve(ctx, id)
+}
+
+func (dao *redisCommentDAO) Like(ctx context.Context, id uuid.UUID, userID string) (bool, error) {
+	return dao.baseDAO.Like(ctx, id, userID)
+}
+
+func (dao *redisCommentDAO) SetLikes(ctx context.Context, id uuid.UUID, likes int64) error {
+	return dao.baseDAO.SetLikes(ctx, id, likes)
+}
+
+func (dao *redisCommentDAO) Collapse(ctx context.Context, id uuid.UUID) error {
+	return dao.baseDAO.Collapse(ctx, id)
+}
+
+func (dao *redisCommentDAO) TrackEngagement(ctx context.Context, comment *Comment, at time.Time) error {
+	return dao.baseDAO.TrackEngagement(ctx, comment, at)
+}
+
+func (dao *redisCommentDAO) GetEmbeddingUpdatedAt(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	return dao.baseDAO.GetEmbeddingUpdatedAt(ctx, id)
+}
+
+func (dao *redisCommentDAO) UpdateEmbedding(ctx context.Context, comment *Comment, embedding []float32) error {
+	return dao.baseDAO.UpdateEmbedding(ctx, comment, embedding)
+}
+
 func (dao *redisCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
 	return dao.baseDAO.Delete(ctx, id)
 }
@@ -65,3 +156,11 @@ func (dao *redisCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
 func (dao *redisCommentDAO) DeleteByVideoID(ctx context.Context, videoID string) error {
 	return dao.baseDAO.DeleteByVideoID(ctx, videoID)
 }
+
+func (dao *redisCommentDAO) BulkImport(ctx context.Context, comments []*Comment) (int, error) {
+	return dao.baseDAO.BulkImport(ctx, comments)
+}
+
+func (dao *redisCommentDAO) Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error) {
+	return dao.baseDAO.Export(ctx, batchSize, fn)
+}
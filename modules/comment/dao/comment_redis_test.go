@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-redis/cache/v8"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -16,7 +17,7 @@ var _ = Describe("CommentRedisDAO", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		pgCommentDAO = NewPGCommentDAO(pgClient)
+		pgCommentDAO = NewPGCommentDAO(pgClient, stmtCache)
 		redisCommentDAO = NewRedisCommentDAO(redisClient, pgCommentDAO)
 	})
 
@@ -114,7 +115,7 @@ var _ = Describe("CommentRedisDAO", func() {
 
 				It("insert the comments to cache", func() {
 					var getComments []*Comment
-					Expect(redisCommentDAO.cache.Get(ctx, listCommentKey(videoID, limit, offset), &getComments)).NotTo(HaveOccurred())
+					Expect(redisCommentDAO.cache.Get(ctx, listCommentKey(tenantkit.DefaultTenantID, videoID, limit, offset), &getComments)).NotTo(HaveOccurred())
 					for i := range getComments {
 						Expect(getComments[i]).To(matchComment(comments[i]))
 					}
@@ -122,17 +123,44 @@ var _ = Describe("CommentRedisDAO", func() {
 			})
 		})
 	})
+
+	Describe("DeleteListCache", func() {
+		var (
+			comments []*Comment
+			videoID  string
+
+			err error
+		)
+
+		BeforeEach(func() {
+			videoID = primitive.NewObjectID().Hex()
+			comments = []*Comment{NewFakeComment(videoID)}
+
+			insertCommentsInRedis(ctx, redisCommentDAO, comments, videoID, 1, 0)
+			insertCommentsInRedis(ctx, redisCommentDAO, comments, videoID, 10, 0)
+		})
+
+		JustBeforeEach(func() {
+			err = redisCommentDAO.DeleteListCache(ctx, videoID)
+		})
+
+		It("deletes the comment lists of every limit and offset", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(redisCommentDAO.cache.Exists(ctx, listCommentKey(tenantkit.DefaultTenantID, videoID, 1, 0))).To(BeFalse())
+			Expect(redisCommentDAO.cache.Exists(ctx, listCommentKey(tenantkit.DefaultTenantID, videoID, 10, 0))).To(BeFalse())
+		})
+	})
 })
 
 func insertCommentsInRedis(ctx context.Context, commentDAO *redisCommentDAO, comments []*Comment, videoID string, limit, offset int) {
 	Expect(commentDAO.cache.Set(&cache.Item{
 		Ctx:   ctx,
-		Key:   listCommentKey(videoID, limit, offset),
+		Key:   listCommentKey(tenantkit.DefaultTenantID, videoID, limit, offset),
 		Value: comments,
 		TTL:   commentDAORedisCacheDuration,
 	})).NotTo(HaveOccurred())
 }
 
 func deleteCommentsInRedis(ctx context.Context, commentDAO *redisCommentDAO, videoID string, limit, offset int) {
-	Expect(commentDAO.cache.Delete(ctx, listCommentKey(videoID, limit, offset))).NotTo(HaveOccurred())
+	Expect(commentDAO.cache.Delete(ctx, listCommentKey(tenantkit.DefaultTenantID, videoID, limit, offset))).NotTo(HaveOccurred())
 }
@@ -0,0 +1,274 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// regionalCommentDAO routes the operations to the DAO of the region the tenant of the context is pinned to,
+// the tenants not pinned are served by the home DAO. Every operation is scoped to a tenant, so an operation
+// never spans the regions.
+type regionalCommentDAO struct {
+	home    CommentDAO
+	regions map[string]CommentDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ CommentDAO = (*regionalCommentDAO)(nil)
+
+var (
+	ErrRegionNotConfigured = errors.New("region not configured")
+)
+
+func NewRegionalCommentDAO(home CommentDAO, regions map[string]CommentDAO, conf *tenantkit.RegionConfig) *regionalCommentDAO {
+	return &regionalCommentDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalCommentDAO) ListByVideoID(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByVideoID(ctx, videoID, limit, offset)
+}
+
+func (dao *regionalCommentDAO) ListByVideoIDAsOf(ctx context.Context, videoID string, asOf time.Time, limit, offset int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByVideoIDAsOf(ctx, videoID, asOf, limit, offset)
+}
+
+func (dao *regionalCommentDAO) ListBySentiment(ctx context.Context, videoID string, filter SentimentFilter, order SentimentOrder, limit, offset int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListBySentiment(ctx, videoID, filter, order, limit, offset)
+}
+
+func (dao *regionalCommentDAO) ListByQuality(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByQuality(ctx, videoID, limit, offset)
+}
+
+func (dao *regionalCommentDAO) ListByParentID(ctx context.Context, videoID string, parentID uuid.UUID, after *Cursor, limit int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByParentID(ctx, videoID, parentID, after, limit)
+}
+
+func (dao *regionalCommentDAO) EachByVideoID(ctx context.Context, videoID string, batchSize int, fn func(comments []*Comment) error) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.EachByVideoID(ctx, videoID, batchSize, fn)
+}
+
+func (dao *regionalCommentDAO) ListTop(ctx context.Context, videoID string, limit, offset int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListTop(ctx, videoID, limit, offset)
+}
+
+func (dao *regionalCommentDAO) ListSimilar(ctx context.Context, videoID string, embedding []float32, limit int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListSimilar(ctx, videoID, embedding, limit)
+}
+
+func (dao *regionalCommentDAO) ListPending(ctx context.Context, after *PendingCursor, limit int) ([]*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListPending(ctx, after, limit)
+}
+
+func (dao *regionalCommentDAO) Get(ctx context.Context, id uuid.UUID) (*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, id)
+}
+
+func (dao *regionalCommentDAO) GetAsOf(ctx context.Context, id uuid.UUID, asOf time.Time) (*Comment, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.GetAsOf(ctx, id, asOf)
+}
+
+func (dao *regionalCommentDAO) Create(ctx context.Context, comment *Comment) (uuid.UUID, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return regionDAO.Create(ctx, comment)
+}
+
+func (dao *regionalCommentDAO) Update(ctx context.Context, comment *Comment) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Update(ctx, comment)
+}
+
+func (dao *regionalCommentDAO) Hold(ctx context.Context, id uuid.UUID, toxicity float64) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Hold(ctx, id, toxicity)
+}
+
+func (dao *regionalCommentDAO) Approve(ctx context.Context, id uuid.UUID) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Approve(ctx, id)
+}
+
+func (dao *regionalCommentDAO) Like(ctx context.Context, id uuid.UUID, userID string) (bool, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return regionDAO.Like(ctx, id, userID)
+}
+
+func (dao *regionalCommentDAO) SetLikes(ctx context.Context, id uuid.UUID, likes int64) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.SetLikes(ctx, id, likes)
+}
+
+func (dao *regionalCommentDAO) Collapse(ctx context.Context, id uuid.UUID) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Collapse(ctx, id)
+}
+
+func (dao *regionalCommentDAO) TrackEngagement(ctx context.Context, comment *Comment, at time.Time) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.TrackEngagement(ctx, comment, at)
+}
+
+func (dao *regionalCommentDAO) GetEmbeddingUpdatedAt(ctx context.Context, id uuid.UUID) (time.Time, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return regionDAO.GetEmbeddingUpdatedAt(ctx, id)
+}
+
+func (dao *regionalCommentDAO) UpdateEmbedding(ctx context.Context, comment *Comment, embedding []float32) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.UpdateEmbedding(ctx, comment, embedding)
+}
+
+func (dao *regionalCommentDAO) Delete(ctx context.Context, id uuid.UUID) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Delete(ctx, id)
+}
+
+func (dao *regionalCommentDAO) DeleteByVideoID(ctx context.Context, videoID string) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.DeleteByVideoID(ctx, videoID)
+}
+
+func (dao *regionalCommentDAO) BulkImport(ctx context.Context, comments []*Comment) (int, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return regionDAO.BulkImport(ctx, comments)
+}
+
+func (dao *regionalCommentDAO) Export(ctx context.Context, batchSize int, fn func(comments []*Comment) error) (time.Time, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return regionDAO.Export(ctx, batchSize, fn)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, a tenant pinned to a region without
+// a DAO is rejected instead of falling back to the home DAO, so its data never leaves the region.
+func (dao *regionalCommentDAO) regionOf(ctx context.Context) (CommentDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
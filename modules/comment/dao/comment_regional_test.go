@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regionalCommentDAO", func() {
+	var (
+		homeDAO    CommentDAO
+		euDAO      CommentDAO
+		commentDAO CommentDAO
+		comment    *Comment
+	)
+
+	BeforeEach(func() {
+		homeDAO = NewMemoryCommentDAO()
+		euDAO = NewMemoryCommentDAO()
+		commentDAO = NewRegionalCommentDAO(homeDAO, map[string]CommentDAO{"eu": euDAO}, &tenantkit.RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu": "eu",
+				"course-ap": "ap",
+			},
+		})
+		comment = NewFakeComment("")
+	})
+
+	When("the tenant is pinned to a region", func() {
+		It("keeps the comments in the region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-eu")
+
+			id, err := commentDAO.Create(ctx, comment)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(euDAO.Get(ctx, id)).To(Equal(comment))
+			_, err = homeDAO.Get(ctx, id)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+
+			Expect(commentDAO.ListByVideoID(ctx, comment.VideoID, 10, 0)).To(ConsistOf(comment))
+		})
+	})
+
+	When("the tenant is not pinned", func() {
+		It("keeps the comments in the home region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-tw")
+
+			id, err := commentDAO.Create(ctx, comment)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(homeDAO.Get(ctx, id)).To(Equal(comment))
+			_, err = euDAO.Get(ctx, id)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+		})
+	})
+
+	When("the region of the tenant is not configured", func() {
+		It("returns ErrRegionNotConfigured", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-ap")
+
+			_, err := commentDAO.Create(ctx, comment)
+			Expect(err).To(MatchError(ErrRegionNotConfigured))
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+)
+
+// CommentVelocity is how fast a user posts within the window of the DAO.
+type CommentVelocity struct {
+	Comments        int64 // the comments of the user within the window
+	DuplicateVideos int64 // the videos the user posted the content of the fingerprint on within the window
+}
+
+// CommentVelocityDAO tracks the comments of the users in the tenant of the context within a sliding window, which
+// slides by the creation time of the comments rather than the time they are tracked, so the comments tracked late
+// are counted as they were posted. Tracking a comment again is a no-op, so the replayed comments are not counted
+// twice.
+type CommentVelocityDAO interface {
+	// Track tracks the comment of its user with the fingerprint of its content, and returns the velocity of the user
+	// including the comment
+	Track(ctx context.Context, comment *Comment, fingerprint string) (*CommentVelocity, error)
+}
+
+func commentVelocityKey(tenantID, userID string) string {
+	return fmt.Sprintf("commentVelocity:%s:%s", tenantID, userID)
+}
+
+func commentVelocityFingerprintKey(tenantID, userID, fingerprint string) string {
+	return fmt.Sprintf("commentVelocity:%s:%s:%s", tenantID, userID, fingerprint)
+}
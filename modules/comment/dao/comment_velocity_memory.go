@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryCommentVelocityDAO keeps the velocities in memory, it is meant for running the modules without Redis in local
+// development. The members out of the window are trimmed as the user posts, the users who stop posting are kept.
+type memoryCommentVelocityDAO struct {
+	mu      sync.Mutex
+	members map[string]map[string]time.Time
+	window  time.Duration
+}
+
+var _ CommentVelocityDAO = (*memoryCommentVelocityDAO)(nil)
+
+func NewMemoryCommentVelocityDAO(window time.Duration) *memoryCommentVelocityDAO {
+	return &memoryCommentVelocityDAO{
+		members: make(map[string]map[string]time.Time),
+		window:  window,
+	}
+}
+
+func (dao *memoryCommentVelocityDAO) Track(ctx context.Context, comment *Comment, fingerprint string) (*CommentVelocity, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+	since := comment.CreatedAt.Add(-dao.window)
+
+	return &CommentVelocity{
+		Comments:        dao.add(commentVelocityKey(tenantID, comment.UserID), comment.ID.String(), comment.CreatedAt, since),
+		DuplicateVideos: dao.add(commentVelocityFingerprintKey(tenantID, comment.UserID, fingerprint), comment.VideoID, comment.CreatedAt, since),
+	}, nil
+}
+
+// add adds the member to the set of the key, trims the members before since, and returns the size of the set, the lock
+// must be held.
+func (dao *memoryCommentVelocityDAO) add(key, member string, at, since time.Time) int64 {
+	members, ok := dao.members[key]
+	if !ok {
+		members = make(map[string]time.Time)
+		dao.members[key] = members
+	}
+
+	members[member] = at
+	for m, t := range members {
+		if t.Before(since) {
+			delete(members, m)
+		}
+	}
+
+	return int64(len(members))
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/redis/v8"
+)
+
+// redisCommentVelocityDAO keeps the IDs of the comments of a user, and the IDs of the videos of a fingerprint of the
+// user, in Redis sorted sets scored by the creation time of the comments. The members out of the window are trimmed
+// as the user posts, and the sets expire by the TTL of Redis once the user stops posting.
+type redisCommentVelocityDAO struct {
+	client *rediskit.RedisClient
+	window time.Duration
+}
+
+var _ CommentVelocityDAO = (*redisCommentVelocityDAO)(nil)
+
+func NewRedisCommentVelocityDAO(client *rediskit.RedisClient, window time.Duration) *redisCommentVelocityDAO {
+	return &redisCommentVelocityDAO{
+		client: client,
+		window: window,
+	}
+}
+
+func (dao *redisCommentVelocityDAO) Track(ctx context.Context, comment *Comment, fingerprint string) (*CommentVelocity, error) {
+	tenantID := tenantkit.FromContext(ctx)
+	score := float64(comment.CreatedAt.UnixMilli())
+	since := strconv.FormatInt(comment.CreatedAt.Add(-dao.window).UnixMilli(), 10)
+
+	var comments, duplicateVideos *redis.IntCmd
+	if _, err := dao.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		key := commentVelocityKey(tenantID, comment.UserID)
+		pipe.ZAdd(ctx, key, &redis.Z{Score: score, Member: comment.ID.String()})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+since)
+		comments = pipe.ZCard(ctx, key)
+		pipe.PExpire(ctx, key, dao.window)
+
+		fingerprintKey := commentVelocityFingerprintKey(tenantID, comment.UserID, fingerprint)
+		pipe.ZAdd(ctx, fingerprintKey, &redis.Z{Score: score, Member: comment.VideoID})
+		pipe.ZRemRangeByScore(ctx, fingerprintKey, "-inf", "("+since)
+		duplicateVideos = pipe.ZCard(ctx, fingerprintKey)
+		pipe.PExpire(ctx, fingerprintKey, dao.window)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CommentVelocity{
+		Comments:        comments.Val(),
+		DuplicateVideos: duplicateVideos.Val(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = DescribeTable("CommentVelocityDAO", func(newVelocityDAO func(window time.Duration) CommentVelocityDAO) {
+	var (
+		velocityDAO CommentVelocityDAO
+		ctx         context.Context
+		userID      string
+		now         time.Time
+	)
+
+	velocityDAO = newVelocityDAO(time.Minute)
+	ctx = context.Background()
+	userID = uuid.NewString()
+	now = time.Now()
+
+	newComment := func(videoID string, createdAt time.Time) *Comment {
+		return &Comment{ID: uuid.New(), VideoID: videoID, UserID: userID, CreatedAt: createdAt}
+	}
+
+	By("tracking the comments of the user")
+	videoID := primitive.NewObjectID().Hex()
+	first := newComment(videoID, now)
+	Expect(velocityDAO.Track(ctx, first, "fingerprint")).To(Equal(&CommentVelocity{Comments: 1, DuplicateVideos: 1}))
+	Expect(velocityDAO.Track(ctx, newComment(videoID, now), "fingerprint")).To(Equal(&CommentVelocity{Comments: 2, DuplicateVideos: 1}))
+	Expect(velocityDAO.Track(ctx, newComment(primitive.NewObjectID().Hex(), now), "fingerprint")).To(Equal(&CommentVelocity{Comments: 3, DuplicateVideos: 2}))
+	Expect(velocityDAO.Track(ctx, newComment(videoID, now), "another fingerprint")).To(Equal(&CommentVelocity{Comments: 4, DuplicateVideos: 1}))
+
+	By("tracking the comment tracked")
+	Expect(velocityDAO.Track(ctx, first, "fingerprint")).To(Equal(&CommentVelocity{Comments: 4, DuplicateVideos: 2}))
+
+	By("keeping the comments of the other users and tenants apart")
+	another := newComment(videoID, now)
+	another.UserID = uuid.NewString()
+	Expect(velocityDAO.Track(ctx, another, "fingerprint")).To(Equal(&CommentVelocity{Comments: 1, DuplicateVideos: 1}))
+	Expect(velocityDAO.Track(tenantkit.WithTenantID(ctx, "velocity-tenant"), newComment(videoID, now), "fingerprint")).To(Equal(&CommentVelocity{Comments: 1, DuplicateVideos: 1}))
+
+	By("sliding the window by the creation time of the comments")
+	Expect(velocityDAO.Track(ctx, newComment(primitive.NewObjectID().Hex(), now.Add(time.Minute+time.Second)), "fingerprint")).To(Equal(&CommentVelocity{Comments: 1, DuplicateVideos: 1}))
+},
+	Entry("redis", func(window time.Duration) CommentVelocityDAO {
+		return NewRedisCommentVelocityDAO(redisClient, window)
+	}),
+	Entry("memory", func(window time.Duration) CommentVelocityDAO { return NewMemoryCommentVelocityDAO(window) }),
+)
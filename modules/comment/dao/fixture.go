@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// NewCommentFixtures returns the n comments of the video generated by the generator in the order of creation,
+// the first one created within a day after the time, e.g. the upload of the video, and the others within hours
+// after the one before. About a third of the comments reply to an earlier comment, so the comments form threads.
+func NewCommentFixtures(g *fixturekit.Generator, videoID string, after time.Time, n int) []*Comment {
+	comments := make([]*Comment, 0, n)
+
+	createdAt := g.Time(after, 24*time.Hour)
+	for i := 0; i < n; i++ {
+		comment := &Comment{
+			ID:        g.UUID(),
+			TenantID:  tenantkit.DefaultTenantID,
+			VideoID:   videoID,
+			Content:   g.Markdown(),
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		comment.RenderContent()
+
+		if i > 0 && g.Chance(0.3) {
+			comment.ParentID = comments[g.Intn(len(comments))].ID
+		}
+
+		// some of the comments are edited later
+		if g.Chance(0.1) {
+			comment.UpdatedAt = g.Time(createdAt, 7*24*time.Hour)
+		}
+
+		comments = append(comments, comment)
+		createdAt = g.Time(createdAt.Add(time.Second), 6*time.Hour)
+	}
+
+	return comments
+}
+
+// NewCommentFixture returns a top-level comment of the video generated by the generator.
+func NewCommentFixture(g *fixturekit.Generator, videoID string, after time.Time) *Comment {
+	return NewCommentFixtures(g, videoID, after, 1)[0]
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewCommentFixtures", func() {
+	const videoID = "61b89b3bb4a4d0f8a5e3c1a0"
+
+	It("generates the same comments from the same seed", func() {
+		Expect(NewCommentFixtures(fixturekit.NewGenerator(1), videoID, fixturekit.Epoch, 10)).
+			To(Equal(NewCommentFixtures(fixturekit.NewGenerator(1), videoID, fixturekit.Epoch, 10)))
+	})
+
+	It("generates the threads of the comments replying to the earlier ones", func() {
+		comments := NewCommentFixtures(fixturekit.NewGenerator(1), videoID, fixturekit.Epoch, 100)
+		Expect(comments).To(HaveLen(100))
+
+		created := make(map[uuid.UUID]*Comment)
+		replies := 0
+		for _, comment := range comments {
+			Expect(comment.VideoID).To(Equal(videoID))
+			Expect(comment.CreatedAt).To(BeTemporally(">=", fixturekit.Epoch))
+			Expect(comment.UpdatedAt).To(BeTemporally(">=", comment.CreatedAt))
+			Expect(comment.ContentHTML).NotTo(BeEmpty())
+
+			if comment.ParentID != uuid.Nil {
+				Expect(created).To(HaveKey(comment.ParentID))
+				Expect(comment.CreatedAt).To(BeTemporally(">", created[comment.ParentID].CreatedAt))
+				replies++
+			}
+			created[comment.ID] = comment
+		}
+		Expect(replies).To(BeNumerically(">", 0))
+		Expect(replies).To(BeNumerically("<", len(comments)))
+	})
+
+	It("imports the comments into the DAO", func() {
+		ctx := context.Background()
+		commentDAO := NewMemoryCommentDAO()
+		comments := NewCommentFixtures(fixturekit.NewGenerator(1), videoID, fixturekit.Epoch, 10)
+
+		Expect(commentDAO.BulkImport(ctx, comments)).To(Equal(10))
+		Expect(commentDAO.ListByVideoID(ctx, videoID, 0, 0)).To(HaveLen(10))
+	})
+})
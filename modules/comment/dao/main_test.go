@@ -11,6 +11,7 @@ import (
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
 )
 
 func TestDAO(t *testing.T) {
@@ -20,10 +21,25 @@ func TestDAO(t *testing.T) {
 
 var (
 	pgClient    *pgkit.PGClient
+	stmtCache   *pgkit.StmtCache
 	redisClient *rediskit.RedisClient
 )
 
 var _ = BeforeSuite(func() {
+	var err error
+	pgClient, stmtCache, redisClient, err = newTestClients()
+	Expect(err).NotTo(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	Expect(stmtCache.Close()).NotTo(HaveOccurred())
+	Expect(pgClient.Close()).NotTo(HaveOccurred())
+	Expect(redisClient.Close()).NotTo(HaveOccurred())
+})
+
+// newTestClients migrates the test database and connects to it, the benchmarks connect by it as well,
+// since the suite does not run for them.
+func newTestClients() (*pgkit.PGClient, *pgkit.StmtCache, *rediskit.RedisClient, error) {
 	pgConf := &pgkit.PGConfig{
 		URL: "postgres://postgres@postgres:5432/postgres?sslmode=disable",
 	}
@@ -46,19 +62,19 @@ var _ = BeforeSuite(func() {
 
 	migration := migrationkit.NewMigration(ctx, migrationConf)
 	defer func() {
-		Expect(migration.Close()).NotTo(HaveOccurred())
+		_ = migration.Close()
 	}()
 
-	Expect(migration.Up()).NotTo(HaveOccurred())
+	if err := migration.Up(); err != nil {
+		return nil, nil, nil, err
+	}
 
-	pgClient = pgkit.NewPGClient(ctx, pgConf)
-	redisClient = rediskit.NewRedisClient(ctx, redisConf)
-})
+	pgClient := pgkit.NewPGClient(ctx, pgConf)
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, pgConf, nonrecording.NewNoopMeterProvider().Meter(""))
+	redisClient := rediskit.NewRedisClient(ctx, redisConf)
 
-var _ = AfterSuite(func() {
-	Expect(pgClient.Close()).NotTo(HaveOccurred())
-	Expect(redisClient.Close()).NotTo(HaveOccurred())
-})
+	return pgClient, stmtCache, redisClient, nil
+}
 
 var pgExec = func(query string, params ...interface{}) {
 	_, err := pgClient.Exec(query, params...)
@@ -0,0 +1,73 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// CommentPartition is a monthly partition of the comments table,
+// holding comments created in [Month, Month + 1 month). The history
+// and the likes of the comments are partitioned by the same months, so
+// they are created and dropped along with the partition.
+type CommentPartition struct {
+	Name  string
+	Month time.Time
+}
+
+type CommentPartitionDAO interface {
+	ListPartitions(ctx context.Context) ([]*CommentPartition, error)
+	CreatePartition(ctx context.Context, month time.Time) (*CommentPartition, error)
+	DropPartitionsBefore(ctx context.Context, before time.Time) ([]*CommentPartition, error)
+}
+
+var (
+	ErrInvalidPartitionName = errors.New("invalid partition name")
+)
+
+const (
+	commentPartitionPrefix        = "comments_p"
+	commentHistoryPartitionPrefix = "comment_history_p"
+	commentLikesPartitionPrefix   = "comment_likes_p"
+	commentPartitionMonthLayout   = "200601"
+)
+
+func NewCommentPartition(month time.Time) *CommentPartition {
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return &CommentPartition{
+		Name:  commentPartitionPrefix + month.Format(commentPartitionMonthLayout),
+		Month: month,
+	}
+}
+
+func parseCommentPartition(name string) (*CommentPartition, error) {
+	if len(name) != len(commentPartitionPrefix)+len(commentPartitionMonthLayout) || name[:len(commentPartitionPrefix)] != commentPartitionPrefix {
+		return nil, ErrInvalidPartitionName
+	}
+
+	month, err := time.Parse(commentPartitionMonthLayout, name[len(commentPartitionPrefix):])
+	if err != nil {
+		return nil, ErrInvalidPartitionName
+	}
+
+	return &CommentPartition{
+		Name:  name,
+		Month: month,
+	}, nil
+}
+
+// HistoryName returns the name of the partition of the comment_history table of the same month.
+func (p *CommentPartition) HistoryName() string {
+	return commentHistoryPartitionPrefix + p.Month.Format(commentPartitionMonthLayout)
+}
+
+// LikesName returns the name of the partition of the comment_likes table of the same month.
+func (p *CommentPartition) LikesName() string {
+	return commentLikesPartitionPrefix + p.Month.Format(commentPartitionMonthLayout)
+}
+
+// End returns the exclusive upper bound of the partition.
+func (p *CommentPartition) End() time.Time {
+	return p.Month.AddDate(0, 1, 0)
+}
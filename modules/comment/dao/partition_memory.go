@@ -0,0 +1,111 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// memoryCommentPartitionDAO keeps the partitions of the comments of the memory comment DAO in memory, the comments
+// created in the month of a dropped partition are dropped along with it, and so are their history and their likes
+// like the partitions of the comment_history and the comment_likes tables.
+type memoryCommentPartitionDAO struct {
+	mu         sync.Mutex
+	partitions map[string]*CommentPartition
+	commentDAO *memoryCommentDAO
+}
+
+var _ CommentPartitionDAO = (*memoryCommentPartitionDAO)(nil)
+
+func NewMemoryCommentPartitionDAO(commentDAO *memoryCommentDAO) *memoryCommentPartitionDAO {
+	return &memoryCommentPartitionDAO{
+		partitions: make(map[string]*CommentPartition),
+		commentDAO: commentDAO,
+	}
+}
+
+func (dao *memoryCommentPartitionDAO) ListPartitions(ctx context.Context) ([]*CommentPartition, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	return dao.list(), nil
+}
+
+// CreatePartition creates the partition of the month if not exists.
+func (dao *memoryCommentPartitionDAO) CreatePartition(ctx context.Context, month time.Time) (*CommentPartition, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	partition := NewCommentPartition(month)
+	if _, ok := dao.partitions[partition.Name]; !ok {
+		dao.partitions[partition.Name] = partition
+	}
+
+	return copyCommentPartition(partition), nil
+}
+
+func (dao *memoryCommentPartitionDAO) DropPartitionsBefore(ctx context.Context, before time.Time) ([]*CommentPartition, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	var dropped []*CommentPartition
+	for _, partition := range dao.list() {
+		if partition.End().After(before) {
+			break
+		}
+
+		delete(dao.partitions, partition.Name)
+		dao.commentDAO.dropCreatedBetween(partition.Month, partition.End())
+
+		dropped = append(dropped, partition)
+	}
+
+	return dropped, nil
+}
+
+// list returns copies of the partitions ordered by month, it must be called with the lock held.
+func (dao *memoryCommentPartitionDAO) list() []*CommentPartition {
+	partitions := make([]*CommentPartition, 0, len(dao.partitions))
+	for _, partition := range dao.partitions {
+		partitions = append(partitions, copyCommentPartition(partition))
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Month.Before(partitions[j].Month)
+	})
+
+	return partitions
+}
+
+// dropCreatedBetween drops the comments of every tenant created in [start, end) along with their history and
+// their likes like dropping their partitions.
+func (dao *memoryCommentDAO) dropCreatedBetween(start, end time.Time) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	createdBetween := func(comment *Comment) bool {
+		return !comment.CreatedAt.Before(start) && comment.CreatedAt.Before(end)
+	}
+
+	for id, comment := range dao.comments {
+		if createdBetween(comment) {
+			delete(dao.comments, id)
+			delete(dao.likes, id)
+		}
+	}
+
+	histories := dao.histories[:0]
+	for _, history := range dao.histories {
+		if !createdBetween(&history.Comment) {
+			histories = append(histories, history)
+		}
+	}
+	dao.histories = histories
+}
+
+func copyCommentPartition(partition *CommentPartition) *CommentPartition {
+	p := *partition
+
+	return &p
+}
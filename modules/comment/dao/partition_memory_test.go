@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("memoryCommentPartitionDAO", func() {
+	var (
+		commentDAO   *memoryCommentDAO
+		partitionDAO *memoryCommentPartitionDAO
+		ctx          context.Context
+	)
+
+	BeforeEach(func() {
+		commentDAO = NewMemoryCommentDAO()
+		partitionDAO = NewMemoryCommentPartitionDAO(commentDAO)
+		ctx = context.Background()
+	})
+
+	Describe("CreatePartition", func() {
+		It("creates the partition of the month once", func() {
+			month := time.Date(2000, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+			for i := 0; i < 2; i++ {
+				partition, err := partitionDAO.CreatePartition(ctx, month)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(partition).To(matchCommentPartition("comments_p200003", time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC)))
+			}
+
+			Expect(partitionDAO.ListPartitions(ctx)).To(HaveLen(1))
+		})
+	})
+
+	Describe("ListPartitions", func() {
+		It("lists the partitions ordered by month", func() {
+			for _, month := range []time.Month{time.March, time.January, time.February} {
+				_, err := partitionDAO.CreatePartition(ctx, time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			partitions, err := partitionDAO.ListPartitions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(partitions).To(HaveLen(3))
+			Expect(partitions[0].Name).To(Equal("comments_p200001"))
+			Expect(partitions[1].Name).To(Equal("comments_p200002"))
+			Expect(partitions[2].Name).To(Equal("comments_p200003"))
+		})
+	})
+
+	Describe("DropPartitionsBefore", func() {
+		It("drops the partitions and the comments created before the time along with their history and likes", func() {
+			var comments []*Comment
+			for _, month := range []time.Month{time.January, time.February} {
+				_, err := partitionDAO.CreatePartition(ctx, time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+				Expect(err).NotTo(HaveOccurred())
+
+				comment := NewFakeComment("")
+				comment.CreatedAt = time.Date(2000, month, 10, 0, 0, 0, 0, time.UTC)
+				_, err = commentDAO.Create(ctx, comment)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(commentDAO.Like(ctx, comment.ID, "user")).To(BeTrue())
+				comments = append(comments, comment)
+			}
+
+			dropped, err := partitionDAO.DropPartitionsBefore(ctx, time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dropped).To(HaveLen(1))
+			Expect(dropped[0].Name).To(Equal("comments_p200001"))
+
+			_, err = commentDAO.Get(ctx, comments[0].ID)
+			Expect(err).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.Get(ctx, comments[1].ID)).To(matchComment(comments[1]))
+
+			_, err = commentDAO.GetAsOf(ctx, comments[0].ID, time.Now())
+			Expect(err).To(MatchError(ErrCommentNotFound))
+			Expect(commentDAO.GetAsOf(ctx, comments[1].ID, time.Now())).To(matchComment(comments[1]))
+
+			Expect(commentDAO.likes).NotTo(HaveKey(comments[0].ID))
+			Expect(commentDAO.likes).To(HaveKey(comments[1].ID))
+
+			Expect(partitionDAO.ListPartitions(ctx)).To(HaveLen(1))
+		})
+	})
+})
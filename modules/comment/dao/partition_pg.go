@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/go-pg/pg/v10"
+)
+
+type pgCommentPartitionDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ CommentPartitionDAO = (*pgCommentPartitionDAO)(nil)
+
+func NewPGCommentPartitionDAO(pgClient *pgkit.PGClient) *pgCommentPartitionDAO {
+	return &pgCommentPartitionDAO{
+		client: pgClient,
+	}
+}
+
+// ListPartitions lists the monthly partitions of the comments table ordered by month,
+// the default partition is excluded.
+func (dao *pgCommentPartitionDAO) ListPartitions(ctx context.Context) ([]*CommentPartition, error) {
+	var names []string
+	query := "SELECT inhrelid::regclass::text FROM pg_inherits WHERE inhparent = 'comments'::regclass"
+
+	if _, err := dao.client.QueryContext(ctx, &names, query); err != nil {
+		return nil, err
+	}
+
+	partitions := make([]*CommentPartition, 0, len(names))
+	for _, name := range names {
+		partition, err := parseCommentPartition(name)
+		if errors.Is(err, ErrInvalidPartitionName) {
+			continue
+		}
+
+		partitions = append(partitions, partition)
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Month.Before(partitions[j].Month)
+	})
+
+	return partitions, nil
+}
+
+// CreatePartition creates the partition of the month if not exists, along with the partitions of the history
+// and the likes.
+//
+// It fails if the default partition already holds comments of the month,
+// so partitions should be created ahead of time.
+func (dao *pgCommentPartitionDAO) CreatePartition(ctx context.Context, month time.Time) (*CommentPartition, error) {
+	partition := NewCommentPartition(month)
+
+	if err := dao.client.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		query := "CREATE TABLE IF NOT EXISTS ? PARTITION OF ? FOR VALUES FROM (?) TO (?)"
+
+		for _, table := range []struct{ name, parent string }{
+			{name: partition.Name, parent: "comments"},
+			{name: partition.HistoryName(), parent: "comment_history"},
+			{name: partition.LikesName(), parent: "comment_likes"},
+		} {
+			if _, err := tx.ExecContext(ctx, query, pg.Ident(table.name), pg.Ident(table.parent), partition.Month, partition.End()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return partition, nil
+}
+
+// DropPartitionsBefore drops the partitions whose comments are all created before the given time,
+// along with the partitions of their history and their likes, and returns the dropped partitions.
+func (dao *pgCommentPartitionDAO) DropPartitionsBefore(ctx context.Context, before time.Time) ([]*CommentPartition, error) {
+	partitions, err := dao.ListPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dropped []*CommentPartition
+	for _, partition := range partitions {
+		if partition.End().After(before) {
+			break
+		}
+
+		if _, err := dao.client.ExecContext(ctx, "DROP TABLE IF EXISTS ?, ?, ?", pg.Ident(partition.Name), pg.Ident(partition.HistoryName()), pg.Ident(partition.LikesName())); err != nil {
+			return dropped, err
+		}
+
+		dropped = append(dropped, partition)
+	}
+
+	return dropped, nil
+}
@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("PGCommentPartitionDAO", func() {
+	var partitionDAO *pgCommentPartitionDAO
+	var ctx context.Context
+
+	BeforeEach(func() {
+		partitionDAO = NewPGCommentPartitionDAO(pgClient)
+		ctx = context.Background()
+	})
+
+	Describe("CreatePartition", func() {
+		var (
+			month time.Time
+
+			resp *CommentPartition
+			err  error
+		)
+
+		BeforeEach(func() {
+			month = time.Date(2000, time.March, 15, 12, 0, 0, 0, time.UTC)
+		})
+
+		AfterEach(func() {
+			dropCommentPartition("comments_p200003")
+		})
+
+		JustBeforeEach(func() {
+			resp, err = partitionDAO.CreatePartition(ctx, month)
+		})
+
+		When("success", func() {
+			It("returns the partition of the month with no error", func() {
+				Expect(resp).To(matchCommentPartition("comments_p200003", time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC)))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("routes comments created in the month to the partition", func() {
+				comment := NewFakeComment("")
+				pgExec("INSERT INTO comments (id, video_id, content, created_at) VALUES (?, ?, ?, ?);", comment.ID, comment.VideoID, comment.Content, month)
+
+				var partitionName string
+				_, err := pgClient.QueryOne(pg.Scan(&partitionName), "SELECT tableoid::regclass::text FROM comments WHERE id = ?", comment.ID)
+
+				Expect(partitionName).To(Equal("comments_p200003"))
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = pgClient.QueryOne(pg.Scan(&partitionName), "SELECT tableoid::regclass::text FROM comment_history WHERE id = ?", comment.ID)
+
+				Expect(partitionName).To(Equal("comment_history_p200003"))
+				Expect(err).NotTo(HaveOccurred())
+
+				pgExec("INSERT INTO comment_likes (comment_id, comment_created_at, user_id, tenant_id) VALUES (?, ?, ?, ?);", comment.ID, month, "user-1", comment.TenantID)
+
+				_, err = pgClient.QueryOne(pg.Scan(&partitionName), "SELECT tableoid::regclass::text FROM comment_likes WHERE comment_id = ?", comment.ID)
+
+				Expect(partitionName).To(Equal("comment_likes_p200003"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("partition already exists", func() {
+			BeforeEach(func() {
+				_, err := partitionDAO.CreatePartition(ctx, month)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DropPartitionsBefore", func() {
+		var (
+			before time.Time
+
+			resp []*CommentPartition
+			err  error
+		)
+
+		BeforeEach(func() {
+			for _, month := range []time.Month{time.January, time.February} {
+				_, err := partitionDAO.CreatePartition(ctx, time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC))
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		AfterEach(func() {
+			dropCommentPartition("comments_p200001")
+			dropCommentPartition("comments_p200002")
+		})
+
+		JustBeforeEach(func() {
+			resp, err = partitionDAO.DropPartitionsBefore(ctx, before)
+		})
+
+		When("before is in the middle of a partition", func() {
+			BeforeEach(func() { before = time.Date(2000, time.February, 15, 0, 0, 0, 0, time.UTC) })
+
+			It("returns the dropped partitions with no error", func() {
+				Expect(resp).To(ConsistOf(
+					matchCommentPartition("comments_p200001", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("keeps the partition containing before", func() {
+				partitions, err := partitionDAO.ListPartitions(ctx)
+
+				Expect(partitions).To(ContainElement(matchCommentPartition("comments_p200002", time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC))))
+				Expect(partitions).NotTo(ContainElement(matchCommentPartition("comments_p200001", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the partition holds comments", func() {
+			var comment *Comment
+
+			BeforeEach(func() {
+				before = time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+				comment = NewFakeComment("")
+				pgExec("INSERT INTO comments (id, video_id, content, created_at) VALUES (?, ?, ?, ?);", comment.ID, comment.VideoID, comment.Content, time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC))
+				pgExec("UPDATE comments SET content = ? WHERE id = ?;", "updated", comment.ID)
+				pgExec("INSERT INTO comment_likes (comment_id, comment_created_at, user_id, tenant_id) VALUES (?, ?, ?, ?);", comment.ID, time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC), "user-1", comment.TenantID)
+			})
+
+			It("drops the history of the comments as well", func() {
+				var count int
+				_, err := pgClient.QueryOne(pg.Scan(&count), "SELECT count(*) FROM comment_history WHERE id = ?", comment.ID)
+
+				Expect(count).To(BeZero())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("drops the likes of the comments as well", func() {
+				var count int
+				_, err := pgClient.QueryOne(pg.Scan(&count), "SELECT count(*) FROM comment_likes WHERE comment_id = ?", comment.ID)
+
+				Expect(count).To(BeZero())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("before is the end of a partition", func() {
+			BeforeEach(func() { before = time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC) })
+
+			It("drops the partition as well", func() {
+				Expect(resp).To(HaveLen(2))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
+
+// dropCommentPartition drops the partition of the comments along with the partitions of their history and their likes.
+func dropCommentPartition(name string) {
+	pgExec("DROP TABLE IF EXISTS " + name + ", " +
+		strings.Replace(name, commentPartitionPrefix, commentHistoryPartitionPrefix, 1) + ", " +
+		strings.Replace(name, commentPartitionPrefix, commentLikesPartitionPrefix, 1) + ";")
+}
+
+func matchCommentPartition(name string, month time.Time) types.GomegaMatcher {
+	return PointTo(MatchAllFields(Fields{
+		"Name":  Equal(name),
+		"Month": BeTemporally("==", month),
+	}))
+}
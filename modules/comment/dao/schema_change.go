@@ -0,0 +1,18 @@
+package dao
+
+import "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
+
+// CommentSchemaChanges are the online schema changes of the comments table in progress, keyed by the name.
+// A change is added along with the migration adding its new column, and removed along with the one dropping
+// the old column once it is complete, e.g.
+//
+//	"comments.content_v2": {
+//		Name:     "comments.content_v2",
+//		Table:    "comments",
+//		Set:      "content_v2 = content",
+//		Pending:  "content_v2 IS NULL",
+//		Mismatch: "content_v2 IS DISTINCT FROM content",
+//	}
+//
+// The DAOs decide which of the columns to write and read by the stage of the change in SchemaChangeFlags.
+var CommentSchemaChanges = map[string]*migrationkit.SchemaChange{}
@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserBlock is a user blocked by a user of a tenant, the comments of the blocked user are hidden from the user.
+type UserBlock struct {
+	TenantID      string
+	UserID        string
+	BlockedUserID string
+	CreatedAt     time.Time
+}
+
+func (b *UserBlock) ToProto() *pb.UserBlock {
+	return &pb.UserBlock{
+		UserId:    b.BlockedUserID,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
+
+// UserBlockDAO keeps the blocks of the users of the tenant of the context.
+type UserBlockDAO interface {
+	// Block blocks the blocked user of the block for its user, and sets the creation time of it
+	Block(ctx context.Context, block *UserBlock) error
+	Unblock(ctx context.Context, userID, blockedUserID string) error
+	// List lists the blocks of the user in the order of creation
+	List(ctx context.Context, userID string) ([]*UserBlock, error)
+}
+
+var (
+	ErrUserBlockNotFound      = errors.New("user block not found")
+	ErrUserBlockAlreadyExists = errors.New("user block already exists")
+)
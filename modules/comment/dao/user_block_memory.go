@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryUserBlockDAO keeps the blocks in memory, it is meant for running the modules without PostgreSQL in local
+// development.
+type memoryUserBlockDAO struct {
+	mu     sync.RWMutex
+	blocks map[userBlockKey]*UserBlock
+}
+
+var _ UserBlockDAO = (*memoryUserBlockDAO)(nil)
+
+type userBlockKey struct {
+	tenantID      string
+	userID        string
+	blockedUserID string
+}
+
+func NewMemoryUserBlockDAO() *memoryUserBlockDAO {
+	return &memoryUserBlockDAO{
+		blocks: make(map[userBlockKey]*UserBlock),
+	}
+}
+
+func (dao *memoryUserBlockDAO) Block(ctx context.Context, block *UserBlock) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	block.TenantID = tenantkit.FromContext(ctx)
+
+	key := userBlockKey{tenantID: block.TenantID, userID: block.UserID, blockedUserID: block.BlockedUserID}
+	if _, ok := dao.blocks[key]; ok {
+		return ErrUserBlockAlreadyExists
+	}
+
+	if block.CreatedAt.IsZero() {
+		block.CreatedAt = time.Now()
+	}
+
+	b := *block
+	dao.blocks[key] = &b
+
+	return nil
+}
+
+func (dao *memoryUserBlockDAO) Unblock(ctx context.Context, userID, blockedUserID string) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := userBlockKey{tenantID: tenantkit.FromContext(ctx), userID: userID, blockedUserID: blockedUserID}
+	if _, ok := dao.blocks[key]; !ok {
+		return ErrUserBlockNotFound
+	}
+
+	delete(dao.blocks, key)
+
+	return nil
+}
+
+func (dao *memoryUserBlockDAO) List(ctx context.Context, userID string) ([]*UserBlock, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+
+	var blocks []*UserBlock
+	for key, block := range dao.blocks {
+		if key.tenantID == tenantID && key.userID == userID {
+			b := *block
+			blocks = append(blocks, &b)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		if !blocks[i].CreatedAt.Equal(blocks[j].CreatedAt) {
+			return blocks[i].CreatedAt.Before(blocks[j].CreatedAt)
+		}
+
+		return blocks[i].BlockedUserID < blocks[j].BlockedUserID
+	})
+
+	return blocks, nil
+}
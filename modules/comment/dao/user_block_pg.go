@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// pgUserBlockDAO scopes every query to the tenant of the context.
+type pgUserBlockDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ UserBlockDAO = (*pgUserBlockDAO)(nil)
+
+func NewPGUserBlockDAO(pgClient *pgkit.PGClient) *pgUserBlockDAO {
+	return &pgUserBlockDAO{
+		client: pgClient,
+	}
+}
+
+func (dao *pgUserBlockDAO) Block(ctx context.Context, block *UserBlock) error {
+	block.TenantID = tenantkit.FromContext(ctx)
+
+	// the creation time is set by the database if it is empty
+	if _, err := dao.client.ModelContext(ctx, block).Returning("*").Insert(); err != nil {
+		if pgkit.IsUniqueViolation(err) {
+			return ErrUserBlockAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (dao *pgUserBlockDAO) Unblock(ctx context.Context, userID, blockedUserID string) error {
+	if res, err := dao.client.ModelContext(ctx, (*UserBlock)(nil)).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("user_id = ?", userID).
+		Where("blocked_user_id = ?", blockedUserID).
+		Delete(); err != nil {
+		return err
+	} else if res.RowsAffected() == 0 {
+		return ErrUserBlockNotFound
+	}
+
+	return nil
+}
+
+func (dao *pgUserBlockDAO) List(ctx context.Context, userID string) ([]*UserBlock, error) {
+	var blocks []*UserBlock
+
+	query := dao.client.ModelContext(ctx, &blocks).Where("tenant_id = ?", tenantkit.FromContext(ctx)).Where("user_id = ?", userID)
+	if err := pgkit.OrderBy(query, pgkit.Asc("created_at"), pgkit.Asc("blocked_user_id")).Select(); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
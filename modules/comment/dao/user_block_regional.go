@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// regionalUserBlockDAO routes the blocks to the DAO of the region the tenant of the context is pinned to like
+// regionalCommentDAO, so the blocks are kept along with the comments they hide.
+type regionalUserBlockDAO struct {
+	home    UserBlockDAO
+	regions map[string]UserBlockDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ UserBlockDAO = (*regionalUserBlockDAO)(nil)
+
+func NewRegionalUserBlockDAO(home UserBlockDAO, regions map[string]UserBlockDAO, conf *tenantkit.RegionConfig) *regionalUserBlockDAO {
+	return &regionalUserBlockDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalUserBlockDAO) Block(ctx context.Context, block *UserBlock) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Block(ctx, block)
+}
+
+func (dao *regionalUserBlockDAO) Unblock(ctx context.Context, userID, blockedUserID string) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Unblock(ctx, userID, blockedUserID)
+}
+
+func (dao *regionalUserBlockDAO) List(ctx context.Context, userID string) ([]*UserBlock, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.List(ctx, userID)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalCommentDAO.
+func (dao *regionalUserBlockDAO) regionOf(ctx context.Context) (UserBlockDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
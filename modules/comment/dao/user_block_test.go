@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("UserBlockDAO", func(newUserBlockDAO func() UserBlockDAO) {
+	userBlockDAO := newUserBlockDAO()
+
+	// every entry has its own tenant, so the blocks of the other entries are not listed
+	tenantID := "block-" + uuid.NewString()[:8]
+	ctx := tenantkit.WithTenantID(context.Background(), tenantID)
+
+	By("blocking the users")
+	block := &UserBlock{UserID: "alice", BlockedUserID: "bob"}
+	Expect(userBlockDAO.Block(ctx, block)).To(Succeed())
+	Expect(block.TenantID).To(Equal(tenantID))
+	Expect(block.CreatedAt).NotTo(BeZero())
+	Expect(userBlockDAO.Block(ctx, &UserBlock{UserID: "alice", BlockedUserID: "carol"})).To(Succeed())
+
+	By("blocking a user blocked already")
+	Expect(userBlockDAO.Block(ctx, &UserBlock{UserID: "alice", BlockedUserID: "bob"})).To(MatchError(ErrUserBlockAlreadyExists))
+
+	By("listing the blocks of the user only")
+	Expect(userBlockDAO.Block(ctx, &UserBlock{UserID: "bob", BlockedUserID: "alice"})).To(Succeed())
+	blocks, err := userBlockDAO.List(ctx, "alice")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(blocks).To(HaveLen(2))
+	Expect(blocks[0].BlockedUserID).To(Equal("bob"))
+	Expect(blocks[1].BlockedUserID).To(Equal("carol"))
+
+	By("listing the blocks in the tenant only")
+	Expect(userBlockDAO.List(tenantkit.WithTenantID(ctx, tenantID+"-another"), "alice")).To(BeEmpty())
+
+	By("unblocking the users")
+	Expect(userBlockDAO.Unblock(ctx, "alice", "bob")).To(Succeed())
+	Expect(userBlockDAO.Unblock(ctx, "alice", "carol")).To(Succeed())
+	Expect(userBlockDAO.Unblock(ctx, "bob", "alice")).To(Succeed())
+	Expect(userBlockDAO.List(ctx, "alice")).To(BeEmpty())
+
+	By("unblocking a user not blocked")
+	Expect(userBlockDAO.Unblock(ctx, "alice", "bob")).To(MatchError(ErrUserBlockNotFound))
+},
+	Entry("pg", func() UserBlockDAO { return NewPGUserBlockDAO(pgClient) }),
+	Entry("memory", func() UserBlockDAO { return NewMemoryUserBlockDAO() }),
+)
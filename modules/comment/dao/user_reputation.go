@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ReputationLikeReceived is the score added to the author of a comment by a new like of the comment
+	ReputationLikeReceived = 1
+	// ReputationCommentRejected is the score added to the author of a comment held for moderation once the comment is
+	// deleted rather than approved
+	ReputationCommentRejected = -10
+)
+
+// UserReputation is the reputation score of a user of a tenant, a user never scored has a zero score.
+type UserReputation struct {
+	TenantID  string
+	UserID    string
+	Score     int64 `pg:",use_zero"`
+	UpdatedAt time.Time
+}
+
+func (r *UserReputation) ToProto() *pb.UserReputation {
+	reputation := &pb.UserReputation{
+		UserId: r.UserID,
+		Score:  r.Score,
+	}
+
+	if !r.UpdatedAt.IsZero() {
+		reputation.UpdatedAt = timestamppb.New(r.UpdatedAt)
+	}
+
+	return reputation
+}
+
+// UserReputationDAO keeps the reputation scores of the users of the tenant of the context.
+type UserReputationDAO interface {
+	// Get returns the reputation of the user, which has a zero score and no update time if the user is never scored
+	Get(ctx context.Context, userID string) (*UserReputation, error)
+	// Add adds the delta to the score of the user
+	Add(ctx context.Context, userID string, delta int64) error
+}
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryUserReputationDAO keeps the reputations in memory, it is meant for running the modules without PostgreSQL in
+// local development.
+type memoryUserReputationDAO struct {
+	mu          sync.RWMutex
+	reputations map[userReputationKey]*UserReputation
+}
+
+var _ UserReputationDAO = (*memoryUserReputationDAO)(nil)
+
+type userReputationKey struct {
+	tenantID string
+	userID   string
+}
+
+func NewMemoryUserReputationDAO() *memoryUserReputationDAO {
+	return &memoryUserReputationDAO{
+		reputations: make(map[userReputationKey]*UserReputation),
+	}
+}
+
+func (dao *memoryUserReputationDAO) Get(ctx context.Context, userID string) (*UserReputation, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	key := userReputationKey{tenantID: tenantkit.FromContext(ctx), userID: userID}
+	if reputation, ok := dao.reputations[key]; ok {
+		r := *reputation
+		return &r, nil
+	}
+
+	return &UserReputation{TenantID: key.tenantID, UserID: userID}, nil
+}
+
+func (dao *memoryUserReputationDAO) Add(ctx context.Context, userID string, delta int64) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := userReputationKey{tenantID: tenantkit.FromContext(ctx), userID: userID}
+
+	reputation, ok := dao.reputations[key]
+	if !ok {
+		reputation = &UserReputation{TenantID: key.tenantID, UserID: userID}
+		dao.reputations[key] = reputation
+	}
+
+	reputation.Score += delta
+	reputation.UpdatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-pg/pg/v10"
+)
+
+// pgUserReputationDAO scopes every query to the tenant of the context.
+type pgUserReputationDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ UserReputationDAO = (*pgUserReputationDAO)(nil)
+
+func NewPGUserReputationDAO(pgClient *pgkit.PGClient) *pgUserReputationDAO {
+	return &pgUserReputationDAO{
+		client: pgClient,
+	}
+}
+
+func (dao *pgUserReputationDAO) Get(ctx context.Context, userID string) (*UserReputation, error) {
+	reputation := &UserReputation{
+		TenantID: tenantkit.FromContext(ctx),
+		UserID:   userID,
+	}
+
+	query := dao.client.ModelContext(ctx, reputation).
+		Where("tenant_id = ?", reputation.TenantID).
+		Where("user_id = ?", userID)
+	if err := query.Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return reputation, nil
+		}
+
+		return nil, err
+	}
+
+	return reputation, nil
+}
+
+// Add inserts the delta as the score of a user never scored, so the concurrent deltas of a user are added up by the
+// upsert rather than read and written back.
+func (dao *pgUserReputationDAO) Add(ctx context.Context, userID string, delta int64) error {
+	reputation := &UserReputation{
+		TenantID:  tenantkit.FromContext(ctx),
+		UserID:    userID,
+		Score:     delta,
+		UpdatedAt: time.Now(),
+	}
+
+	_, err := dao.client.ModelContext(ctx, reputation).
+		OnConflict("(tenant_id, user_id) DO UPDATE").
+		Set("score = user_reputation.score + EXCLUDED.score").
+		Set("updated_at = EXCLUDED.updated_at").
+		Insert()
+
+	return err
+}
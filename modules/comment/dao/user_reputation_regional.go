@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// regionalUserReputationDAO routes the reputations to the DAO of the region the tenant of the context is pinned to
+// like regionalCommentDAO, so the reputations are kept along with the comments they are scored by.
+type regionalUserReputationDAO struct {
+	home    UserReputationDAO
+	regions map[string]UserReputationDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ UserReputationDAO = (*regionalUserReputationDAO)(nil)
+
+func NewRegionalUserReputationDAO(home UserReputationDAO, regions map[string]UserReputationDAO, conf *tenantkit.RegionConfig) *regionalUserReputationDAO {
+	return &regionalUserReputationDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalUserReputationDAO) Get(ctx context.Context, userID string) (*UserReputation, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, userID)
+}
+
+func (dao *regionalUserReputationDAO) Add(ctx context.Context, userID string, delta int64) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Add(ctx, userID, delta)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalCommentDAO.
+func (dao *regionalUserReputationDAO) regionOf(ctx context.Context) (UserReputationDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("UserReputationDAO", func(newUserReputationDAO func() UserReputationDAO) {
+	userReputationDAO := newUserReputationDAO()
+
+	// every entry has its own tenant, so the scores of the other entries are not added up
+	tenantID := "reputation-" + uuid.NewString()[:8]
+	ctx := tenantkit.WithTenantID(context.Background(), tenantID)
+
+	By("getting the reputation of a user never scored")
+	reputation, err := userReputationDAO.Get(ctx, "alice")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reputation.UserID).To(Equal("alice"))
+	Expect(reputation.Score).To(BeZero())
+	Expect(reputation.UpdatedAt).To(BeZero())
+
+	By("adding up the scores of the user")
+	Expect(userReputationDAO.Add(ctx, "alice", 3)).To(Succeed())
+	Expect(userReputationDAO.Add(ctx, "alice", -10)).To(Succeed())
+	reputation, err = userReputationDAO.Get(ctx, "alice")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reputation.Score).To(BeEquivalentTo(-7))
+	Expect(reputation.UpdatedAt).NotTo(BeZero())
+
+	By("keeping the scores of the other users and tenants apart")
+	Expect(userReputationDAO.Add(ctx, "bob", 1)).To(Succeed())
+	reputation, err = userReputationDAO.Get(ctx, "bob")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reputation.Score).To(BeEquivalentTo(1))
+	reputation, err = userReputationDAO.Get(tenantkit.WithTenantID(ctx, tenantID+"-another"), "alice")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reputation.Score).To(BeZero())
+},
+	Entry("pg", func() UserReputationDAO { return NewPGUserReputationDAO(pgClient) }),
+	Entry("memory", func() UserReputationDAO { return NewMemoryUserReputationDAO() }),
+)
@@ -0,0 +1,15 @@
+ALTER TABLE comments RENAME TO comments_partitioned;
+
+CREATE TABLE comments (
+	id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	video_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO comments (id, video_id, content, created_at, updated_at)
+	SELECT id, video_id, content, created_at, updated_at FROM comments_partitioned;
+
+-- drops all the partitions as well
+DROP TABLE comments_partitioned;
@@ -0,0 +1,38 @@
+ALTER TABLE comments RENAME TO comments_unpartitioned;
+
+-- the partition key must be part of the primary key
+CREATE TABLE comments (
+	id uuid NOT NULL DEFAULT gen_random_uuid(),
+	video_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+-- catches comments created when the monthly partition is not created in time
+CREATE TABLE comments_default PARTITION OF comments DEFAULT;
+
+-- monthly partitions covering the existing comments, named comments_pYYYYMM
+DO $$
+DECLARE
+	partition_month TIMESTAMP;
+BEGIN
+	FOR partition_month IN
+		SELECT generate_series(
+			date_trunc('month', COALESCE(MIN(created_at), LOCALTIMESTAMP)),
+			date_trunc('month', LOCALTIMESTAMP),
+			INTERVAL '1 month'
+		) FROM comments_unpartitioned
+	LOOP
+		EXECUTE format(
+			'CREATE TABLE %I PARTITION OF comments FOR VALUES FROM (%L) TO (%L)',
+			'comments_p' || to_char(partition_month, 'YYYYMM'), partition_month, partition_month + INTERVAL '1 month'
+		);
+	END LOOP;
+END $$;
+
+INSERT INTO comments (id, video_id, content, created_at, updated_at)
+	SELECT id, video_id, content, created_at, updated_at FROM comments_unpartitioned;
+
+DROP TABLE comments_unpartitioned;
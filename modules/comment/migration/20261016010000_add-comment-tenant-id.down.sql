@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS comments_tenant_id_video_id_updated_at_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS tenant_id;
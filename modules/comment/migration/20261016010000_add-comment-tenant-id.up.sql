@@ -0,0 +1,4 @@
+-- existing comments belong to the default tenant
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS comments_tenant_id_video_id_updated_at_idx ON comments (tenant_id, video_id, updated_at);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS comments_history ON comments;
+
+DROP FUNCTION IF EXISTS record_comment_history();
+
+DROP TABLE IF EXISTS comment_history;
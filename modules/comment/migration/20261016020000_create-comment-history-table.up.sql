@@ -0,0 +1,37 @@
+-- every version of the comments, a version is valid in [valid_from, valid_to),
+-- valid_to is NULL for the current version and is set once the comment is updated or deleted
+CREATE TABLE IF NOT EXISTS comment_history (
+	id uuid NOT NULL,
+	tenant_id TEXT NOT NULL,
+	video_id TEXT NOT NULL,
+	content TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	valid_from TIMESTAMP NOT NULL,
+	valid_to TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS comment_history_tenant_id_video_id_valid_from_idx ON comment_history (tenant_id, video_id, valid_from);
+CREATE INDEX IF NOT EXISTS comment_history_id_valid_from_idx ON comment_history (id, valid_from);
+
+-- the history of the existing comments starts from their last update
+INSERT INTO comment_history (id, tenant_id, video_id, content, created_at, updated_at, valid_from)
+	SELECT id, tenant_id, video_id, content, created_at, updated_at, updated_at FROM comments;
+
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, content, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.content, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER comments_history AFTER INSERT OR UPDATE OR DELETE ON comments
+	FOR EACH ROW EXECUTE FUNCTION record_comment_history();
@@ -0,0 +1,19 @@
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, content, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.content, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS comments_tenant_id_video_id_parent_id_created_at_id_idx;
+
+ALTER TABLE comment_history DROP COLUMN IF EXISTS parent_id;
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_id;
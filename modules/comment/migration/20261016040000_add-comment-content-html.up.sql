@@ -0,0 +1,18 @@
+-- the sanitized HTML rendered from the markdown content, NULL if it is not rendered yet
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS content_html text;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS content_html text;
+
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
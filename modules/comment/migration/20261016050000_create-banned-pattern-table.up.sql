@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS banned_patterns (
+	id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+	tenant_id TEXT NOT NULL,
+	kind TEXT NOT NULL,
+	pattern TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS banned_patterns_tenant_id_kind_pattern_idx ON banned_patterns (tenant_id, kind, pattern);
@@ -0,0 +1,21 @@
+-- the sentiment score of the content from -1 to 1, zero for the comments not scored
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS sentiment double precision NOT NULL DEFAULT 0;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS sentiment double precision NOT NULL DEFAULT 0;
+
+-- the comments of a video are listed by their sentiment scores for the creators
+CREATE INDEX IF NOT EXISTS comments_tenant_id_video_id_sentiment_idx ON comments (tenant_id, video_id, sentiment);
+
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,20 @@
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS comments_tenant_id_toxicity_pending_idx;
+ALTER TABLE comment_history DROP COLUMN IF EXISTS toxicity;
+ALTER TABLE comments DROP COLUMN IF EXISTS toxicity;
+ALTER TABLE comment_history DROP COLUMN IF EXISTS status;
+ALTER TABLE comments DROP COLUMN IF EXISTS status;
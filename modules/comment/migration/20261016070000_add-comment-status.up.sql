@@ -0,0 +1,24 @@
+-- the moderation status of the comment, 0 for the published comments and 1 for the comments held for moderation
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS status smallint NOT NULL DEFAULT 0;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS status smallint NOT NULL DEFAULT 0;
+-- the toxicity score of the content from 0 to 1, zero for the comments not held
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS toxicity double precision NOT NULL DEFAULT 0;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS toxicity double precision NOT NULL DEFAULT 0;
+
+-- the moderation queue lists the few comments held, so the index covers them only
+CREATE INDEX IF NOT EXISTS comments_tenant_id_toxicity_pending_idx ON comments (tenant_id, toxicity DESC) WHERE status = 1;
+
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, status, toxicity, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.status, NEW.toxicity, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,40 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS likes bigint NOT NULL DEFAULT 0;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS likes bigint NOT NULL DEFAULT 0;
+
+-- the engagements tracked of the comments by the trending consumer, the score is the logarithm of the engagements
+-- scaled to an epoch, see dao.EngagementHalfLife, and the likes are the likes added to the score
+CREATE TABLE IF NOT EXISTS comment_engagements (
+	tenant_id text NOT NULL,
+	id uuid NOT NULL,
+	video_id text NOT NULL,
+	likes bigint NOT NULL DEFAULT 0,
+	score double precision NOT NULL,
+	PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS comment_engagements_tenant_id_video_id_score_idx ON comment_engagements (tenant_id, video_id, score DESC);
+
+-- logaddexp returns ln(e^a + e^b) without overflow, the exponent is bounded, since exp raises an error on underflow
+CREATE OR REPLACE FUNCTION logaddexp(a double precision, b double precision) RETURNS double precision AS $$
+	SELECT GREATEST(a, b) + ln(1 + exp(GREATEST(-abs(a - b), -700)));
+$$ LANGUAGE sql IMMUTABLE;
+
+-- the likes only change without a new version, so the history does not grow by the likes
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'UPDATE' AND to_jsonb(NEW) - 'likes' = to_jsonb(OLD) - 'likes' THEN
+		RETURN NULL;
+	END IF;
+
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, status, toxicity, likes, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.status, NEW.toxicity, NEW.likes, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
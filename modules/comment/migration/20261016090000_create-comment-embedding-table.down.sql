@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS comment_embeddings;
+DROP EXTENSION IF EXISTS vector;
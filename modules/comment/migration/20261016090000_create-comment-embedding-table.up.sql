@@ -0,0 +1,15 @@
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- the embeddings of the contents of the comments by the embedder consumer, along with the update times of the
+-- comments embedded. The dimensions depend on the embedding model, so the embeddings are not indexed for the nearest
+-- neighbors, and a search scans the embeddings of a video
+CREATE TABLE IF NOT EXISTS comment_embeddings (
+	tenant_id text NOT NULL,
+	id uuid NOT NULL,
+	video_id text NOT NULL,
+	embedding vector NOT NULL,
+	updated_at timestamp NOT NULL,
+	PRIMARY KEY (tenant_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS comment_embeddings_tenant_id_video_id_idx ON comment_embeddings (tenant_id, video_id);
@@ -0,0 +1,23 @@
+-- the quality score of the content from 0 to 1 by its length and the variety of its words, zero for the comments not
+-- scored, the likes are added to it at query time, see dao.Comment.QualityScore
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS quality double precision NOT NULL DEFAULT 0;
+ALTER TABLE comment_history ADD COLUMN IF NOT EXISTS quality double precision NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'UPDATE' AND to_jsonb(NEW) - 'likes' - 'collapsed_count' = to_jsonb(OLD) - 'likes' - 'collapsed_count' THEN
+		RETURN NULL;
+	END IF;
+
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, quality, status, toxicity, likes, collapsed_count, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.quality, NEW.status, NEW.toxicity, NEW.likes, NEW.collapsed_count, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
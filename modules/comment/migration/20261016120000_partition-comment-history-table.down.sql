@@ -0,0 +1,30 @@
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'UPDATE' AND to_jsonb(NEW) - 'likes' - 'collapsed_count' = to_jsonb(OLD) - 'likes' - 'collapsed_count' THEN
+		RETURN NULL;
+	END IF;
+
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, quality, status, toxicity, likes, collapsed_count, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.quality, NEW.status, NEW.toxicity, NEW.likes, NEW.collapsed_count, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE comment_history RENAME TO comment_history_partitioned;
+
+CREATE TABLE comment_history (LIKE comment_history_partitioned INCLUDING DEFAULTS);
+
+INSERT INTO comment_history SELECT * FROM comment_history_partitioned;
+
+-- drops all the partitions as well
+DROP TABLE comment_history_partitioned;
+
+CREATE INDEX IF NOT EXISTS comment_history_tenant_id_video_id_valid_from_idx ON comment_history (tenant_id, video_id, valid_from);
+CREATE INDEX IF NOT EXISTS comment_history_id_valid_from_idx ON comment_history (id, valid_from);
@@ -0,0 +1,59 @@
+-- the history is partitioned by the creation time of the comments like the comments table, so the history
+-- of the comments of a dropped partition is dropped along with it, the partitions are named comment_history_pYYYYMM
+ALTER TABLE comment_history RENAME TO comment_history_unpartitioned;
+
+CREATE TABLE comment_history (LIKE comment_history_unpartitioned INCLUDING DEFAULTS) PARTITION BY RANGE (created_at);
+
+CREATE TABLE comment_history_default PARTITION OF comment_history DEFAULT;
+
+-- monthly partitions of the months of the partitions of the comments
+DO $$
+DECLARE
+	partition_month TIMESTAMP;
+BEGIN
+	FOR partition_month IN
+		SELECT to_timestamp(substr(inhrelid::regclass::text, length('comments_p') + 1), 'YYYYMM')::timestamp
+			FROM pg_inherits
+			WHERE inhparent = 'comments'::regclass AND inhrelid::regclass::text ~ '^comments_p[0-9]{6}$'
+	LOOP
+		EXECUTE format(
+			'CREATE TABLE %I PARTITION OF comment_history FOR VALUES FROM (%L) TO (%L)',
+			'comment_history_p' || to_char(partition_month, 'YYYYMM'), partition_month, partition_month + INTERVAL '1 month'
+		);
+	END LOOP;
+END $$;
+
+-- the history of the comments of the partitions already dropped is left out, they are the comments
+-- whose current versions are never ended since dropping a partition fires no triggers
+INSERT INTO comment_history
+	SELECT * FROM comment_history_unpartitioned history
+	WHERE NOT EXISTS (
+		SELECT 1 FROM comment_history_unpartitioned dropped
+		WHERE dropped.id = history.id AND dropped.valid_to IS NULL
+			AND NOT EXISTS (SELECT 1 FROM comments WHERE comments.id = dropped.id)
+	);
+
+DROP TABLE comment_history_unpartitioned;
+
+CREATE INDEX IF NOT EXISTS comment_history_tenant_id_video_id_valid_from_idx ON comment_history (tenant_id, video_id, valid_from);
+CREATE INDEX IF NOT EXISTS comment_history_id_valid_from_idx ON comment_history (id, valid_from);
+
+-- the versions are ended within the partition of the comment
+CREATE OR REPLACE FUNCTION record_comment_history() RETURNS TRIGGER AS $$
+BEGIN
+	IF TG_OP = 'UPDATE' AND to_jsonb(NEW) - 'likes' - 'collapsed_count' = to_jsonb(OLD) - 'likes' - 'collapsed_count' THEN
+		RETURN NULL;
+	END IF;
+
+	IF TG_OP IN ('UPDATE', 'DELETE') THEN
+		UPDATE comment_history SET valid_to = LOCALTIMESTAMP WHERE id = OLD.id AND created_at = OLD.created_at AND valid_to IS NULL;
+	END IF;
+
+	IF TG_OP IN ('INSERT', 'UPDATE') THEN
+		INSERT INTO comment_history (id, tenant_id, video_id, parent_id, content, content_html, sentiment, quality, status, toxicity, likes, collapsed_count, created_at, updated_at, valid_from)
+			VALUES (NEW.id, NEW.tenant_id, NEW.video_id, NEW.parent_id, NEW.content, NEW.content_html, NEW.sentiment, NEW.quality, NEW.status, NEW.toxicity, NEW.likes, NEW.collapsed_count, NEW.created_at, NEW.updated_at, LOCALTIMESTAMP);
+	END IF;
+
+	RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
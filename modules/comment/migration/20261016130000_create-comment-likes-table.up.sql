@@ -0,0 +1,30 @@
+-- the likes of the comments, one for each user, the likes are counted by the like counters, see
+-- dao.CommentLikeCounterDAO. They are partitioned by the creation time of the comments like the comments
+-- table, so the likes of a dropped partition are dropped along with it, the partitions are named comment_likes_pYYYYMM
+CREATE TABLE IF NOT EXISTS comment_likes (
+	comment_id uuid NOT NULL,
+	comment_created_at timestamp NOT NULL,
+	user_id text NOT NULL,
+	tenant_id text NOT NULL,
+	created_at timestamp NOT NULL DEFAULT LOCALTIMESTAMP,
+	PRIMARY KEY (comment_id, user_id, comment_created_at)
+) PARTITION BY RANGE (comment_created_at);
+
+CREATE TABLE IF NOT EXISTS comment_likes_default PARTITION OF comment_likes DEFAULT;
+
+-- monthly partitions of the months of the partitions of the comments
+DO $$
+DECLARE
+	partition_month TIMESTAMP;
+BEGIN
+	FOR partition_month IN
+		SELECT to_timestamp(substr(inhrelid::regclass::text, length('comments_p') + 1), 'YYYYMM')::timestamp
+			FROM pg_inherits
+			WHERE inhparent = 'comments'::regclass AND inhrelid::regclass::text ~ '^comments_p[0-9]{6}$'
+	LOOP
+		EXECUTE format(
+			'CREATE TABLE IF NOT EXISTS %I PARTITION OF comment_likes FOR VALUES FROM (%L) TO (%L)',
+			'comment_likes_p' || to_char(partition_month, 'YYYYMM'), partition_month, partition_month + INTERVAL '1 month'
+		);
+	END LOOP;
+END $$;
@@ -0,0 +1,9 @@
+-- the users blocked by the users of the tenants, the comments of the blocked users are hidden from the users blocking
+-- them, see dao.UserBlockDAO
+CREATE TABLE IF NOT EXISTS user_blocks (
+	tenant_id text NOT NULL,
+	user_id text NOT NULL,
+	blocked_user_id text NOT NULL,
+	created_at timestamp NOT NULL DEFAULT LOCALTIMESTAMP,
+	PRIMARY KEY (tenant_id, user_id, blocked_user_id)
+);
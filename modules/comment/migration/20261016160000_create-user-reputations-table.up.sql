@@ -0,0 +1,9 @@
+-- the reputation scores of the users of the tenants, which are added up by the events of their comments, see
+-- dao.UserReputationDAO
+CREATE TABLE IF NOT EXISTS user_reputations (
+	tenant_id text NOT NULL,
+	user_id text NOT NULL,
+	score bigint NOT NULL DEFAULT 0,
+	updated_at timestamp NOT NULL DEFAULT LOCALTIMESTAMP,
+	PRIMARY KEY (tenant_id, user_id)
+);
@@ -1,3 +1,3 @@
 package daomock
 
-//go:generate mockgen -destination=mock.go -package=$GOPACKAGE github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao CommentDAO
+//go:generate mockgen -destination=mock.go -package=$GOPACKAGE github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao CommentDAO,CommentPubSub
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao (interfaces: CommentDAO)
+// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao (interfaces: CommentDAO,CommentPubSub)
 
 // Package daomock is a generated GoMock package.
 package daomock
@@ -7,6 +7,7 @@ package daomock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,49 @@ func (m *MockCommentDAO) EXPECT() *MockCommentDAOMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockCommentDAO) Approve(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockCommentDAOMockRecorder) Approve(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockCommentDAO)(nil).Approve), arg0, arg1)
+}
+
+// BulkImport mocks base method.
+func (m *MockCommentDAO) BulkImport(arg0 context.Context, arg1 []*dao.Comment) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkImport", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkImport indicates an expected call of BulkImport.
+func (mr *MockCommentDAOMockRecorder) BulkImport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkImport", reflect.TypeOf((*MockCommentDAO)(nil).BulkImport), arg0, arg1)
+}
+
+// Collapse mocks base method.
+func (m *MockCommentDAO) Collapse(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Collapse", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Collapse indicates an expected call of Collapse.
+func (mr *MockCommentDAOMockRecorder) Collapse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collapse", reflect.TypeOf((*MockCommentDAO)(nil).Collapse), arg0, arg1)
+}
+
 // Create mocks base method.
 func (m *MockCommentDAO) Create(arg0 context.Context, arg1 *dao.Comment) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +123,154 @@ func (mr *MockCommentDAOMockRecorder) DeleteByVideoID(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByVideoID", reflect.TypeOf((*MockCommentDAO)(nil).DeleteByVideoID), arg0, arg1)
 }
 
+// EachByVideoID mocks base method.
+func (m *MockCommentDAO) EachByVideoID(arg0 context.Context, arg1 string, arg2 int, arg3 func([]*dao.Comment) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EachByVideoID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EachByVideoID indicates an expected call of EachByVideoID.
+func (mr *MockCommentDAOMockRecorder) EachByVideoID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EachByVideoID", reflect.TypeOf((*MockCommentDAO)(nil).EachByVideoID), arg0, arg1, arg2, arg3)
+}
+
+// Export mocks base method.
+func (m *MockCommentDAO) Export(arg0 context.Context, arg1 int, arg2 func([]*dao.Comment) error) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockCommentDAOMockRecorder) Export(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockCommentDAO)(nil).Export), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockCommentDAO) Get(arg0 context.Context, arg1 uuid.UUID) (*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCommentDAOMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentDAO)(nil).Get), arg0, arg1)
+}
+
+// GetAsOf mocks base method.
+func (m *MockCommentDAO) GetAsOf(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) (*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAsOf", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAsOf indicates an expected call of GetAsOf.
+func (mr *MockCommentDAOMockRecorder) GetAsOf(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAsOf", reflect.TypeOf((*MockCommentDAO)(nil).GetAsOf), arg0, arg1, arg2)
+}
+
+// GetEmbeddingUpdatedAt mocks base method.
+func (m *MockCommentDAO) GetEmbeddingUpdatedAt(arg0 context.Context, arg1 uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmbeddingUpdatedAt", arg0, arg1)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmbeddingUpdatedAt indicates an expected call of GetEmbeddingUpdatedAt.
+func (mr *MockCommentDAOMockRecorder) GetEmbeddingUpdatedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmbeddingUpdatedAt", reflect.TypeOf((*MockCommentDAO)(nil).GetEmbeddingUpdatedAt), arg0, arg1)
+}
+
+// Hold mocks base method.
+func (m *MockCommentDAO) Hold(arg0 context.Context, arg1 uuid.UUID, arg2 float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockCommentDAOMockRecorder) Hold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockCommentDAO)(nil).Hold), arg0, arg1, arg2)
+}
+
+// Like mocks base method.
+func (m *MockCommentDAO) Like(arg0 context.Context, arg1 uuid.UUID, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Like", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Like indicates an expected call of Like.
+func (mr *MockCommentDAOMockRecorder) Like(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Like", reflect.TypeOf((*MockCommentDAO)(nil).Like), arg0, arg1, arg2)
+}
+
+// ListByParentID mocks base method.
+func (m *MockCommentDAO) ListByParentID(arg0 context.Context, arg1 string, arg2 uuid.UUID, arg3 *dao.Cursor, arg4 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByParentID", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByParentID indicates an expected call of ListByParentID.
+func (mr *MockCommentDAOMockRecorder) ListByParentID(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByParentID", reflect.TypeOf((*MockCommentDAO)(nil).ListByParentID), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListByQuality mocks base method.
+func (m *MockCommentDAO) ListByQuality(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByQuality", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByQuality indicates an expected call of ListByQuality.
+func (mr *MockCommentDAOMockRecorder) ListByQuality(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByQuality", reflect.TypeOf((*MockCommentDAO)(nil).ListByQuality), arg0, arg1, arg2, arg3)
+}
+
+// ListBySentiment mocks base method.
+func (m *MockCommentDAO) ListBySentiment(arg0 context.Context, arg1 string, arg2 dao.SentimentFilter, arg3 dao.SentimentOrder, arg4, arg5 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySentiment", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySentiment indicates an expected call of ListBySentiment.
+func (mr *MockCommentDAOMockRecorder) ListBySentiment(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySentiment", reflect.TypeOf((*MockCommentDAO)(nil).ListBySentiment), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // ListByVideoID mocks base method.
 func (m *MockCommentDAO) ListByVideoID(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*dao.Comment, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +286,94 @@ func (mr *MockCommentDAOMockRecorder) ListByVideoID(arg0, arg1, arg2, arg3 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVideoID", reflect.TypeOf((*MockCommentDAO)(nil).ListByVideoID), arg0, arg1, arg2, arg3)
 }
 
+// ListByVideoIDAsOf mocks base method.
+func (m *MockCommentDAO) ListByVideoIDAsOf(arg0 context.Context, arg1 string, arg2 time.Time, arg3, arg4 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVideoIDAsOf", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVideoIDAsOf indicates an expected call of ListByVideoIDAsOf.
+func (mr *MockCommentDAOMockRecorder) ListByVideoIDAsOf(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVideoIDAsOf", reflect.TypeOf((*MockCommentDAO)(nil).ListByVideoIDAsOf), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ListPending mocks base method.
+func (m *MockCommentDAO) ListPending(arg0 context.Context, arg1 *dao.PendingCursor, arg2 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockCommentDAOMockRecorder) ListPending(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockCommentDAO)(nil).ListPending), arg0, arg1, arg2)
+}
+
+// ListSimilar mocks base method.
+func (m *MockCommentDAO) ListSimilar(arg0 context.Context, arg1 string, arg2 []float32, arg3 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSimilar", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSimilar indicates an expected call of ListSimilar.
+func (mr *MockCommentDAOMockRecorder) ListSimilar(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSimilar", reflect.TypeOf((*MockCommentDAO)(nil).ListSimilar), arg0, arg1, arg2, arg3)
+}
+
+// ListTop mocks base method.
+func (m *MockCommentDAO) ListTop(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTop", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTop indicates an expected call of ListTop.
+func (mr *MockCommentDAOMockRecorder) ListTop(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTop", reflect.TypeOf((*MockCommentDAO)(nil).ListTop), arg0, arg1, arg2, arg3)
+}
+
+// SetLikes mocks base method.
+func (m *MockCommentDAO) SetLikes(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLikes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLikes indicates an expected call of SetLikes.
+func (mr *MockCommentDAOMockRecorder) SetLikes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLikes", reflect.TypeOf((*MockCommentDAO)(nil).SetLikes), arg0, arg1, arg2)
+}
+
+// TrackEngagement mocks base method.
+func (m *MockCommentDAO) TrackEngagement(arg0 context.Context, arg1 *dao.Comment, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackEngagement", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackEngagement indicates an expected call of TrackEngagement.
+func (mr *MockCommentDAOMockRecorder) TrackEngagement(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackEngagement", reflect.TypeOf((*MockCommentDAO)(nil).TrackEngagement), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockCommentDAO) Update(arg0 context.Context, arg1 *dao.Comment) error {
 	m.ctrl.T.Helper()
@@ -107,3 +387,69 @@ func (mr *MockCommentDAOMockRecorder) Update(arg0, arg1 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentDAO)(nil).Update), arg0, arg1)
 }
+
+// UpdateEmbedding mocks base method.
+func (m *MockCommentDAO) UpdateEmbedding(arg0 context.Context, arg1 *dao.Comment, arg2 []float32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmbedding", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmbedding indicates an expected call of UpdateEmbedding.
+func (mr *MockCommentDAOMockRecorder) UpdateEmbedding(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmbedding", reflect.TypeOf((*MockCommentDAO)(nil).UpdateEmbedding), arg0, arg1, arg2)
+}
+
+// MockCommentPubSub is a mock of CommentPubSub interface.
+type MockCommentPubSub struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentPubSubMockRecorder
+}
+
+// MockCommentPubSubMockRecorder is the mock recorder for MockCommentPubSub.
+type MockCommentPubSubMockRecorder struct {
+	mock *MockCommentPubSub
+}
+
+// NewMockCommentPubSub creates a new mock instance.
+func NewMockCommentPubSub(ctrl *gomock.Controller) *MockCommentPubSub {
+	mock := &MockCommentPubSub{ctrl: ctrl}
+	mock.recorder = &MockCommentPubSubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentPubSub) EXPECT() *MockCommentPubSubMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockCommentPubSub) Publish(arg0 context.Context, arg1 *dao.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockCommentPubSubMockRecorder) Publish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockCommentPubSub)(nil).Publish), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockCommentPubSub) Subscribe(arg0 context.Context, arg1 string) (<-chan *dao.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *dao.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockCommentPubSubMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockCommentPubSub)(nil).Subscribe), arg0, arg1)
+}
@@ -1,3 +1,3 @@
 package pbmock
 
-//go:generate mockgen -destination=mock.go -package=$GOPACKAGE github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb CommentClient
+//go:generate mockgen -destination=mock.go -package=$GOPACKAGE github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1 Comment_BackupCommentServer,Comment_BulkImportCommentServer,Comment_RestoreCommentServer,Comment_StreamCommentsServer,CommentClient
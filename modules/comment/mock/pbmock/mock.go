@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb (interfaces: CommentClient)
+// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1 (interfaces: Comment_BackupCommentServer,Comment_BulkImportCommentServer,Comment_RestoreCommentServer,Comment_StreamCommentsServer,CommentClient)
 
 // Package pbmock is a generated GoMock package.
 package pbmock
@@ -8,11 +8,518 @@ import (
 	context "context"
 	reflect "reflect"
 
-	pb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	pb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
+// MockComment_BackupCommentServer is a mock of Comment_BackupCommentServer interface.
+type MockComment_BackupCommentServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComment_BackupCommentServerMockRecorder
+}
+
+// MockComment_BackupCommentServerMockRecorder is the mock recorder for MockComment_BackupCommentServer.
+type MockComment_BackupCommentServerMockRecorder struct {
+	mock *MockComment_BackupCommentServer
+}
+
+// NewMockComment_BackupCommentServer creates a new mock instance.
+func NewMockComment_BackupCommentServer(ctrl *gomock.Controller) *MockComment_BackupCommentServer {
+	mock := &MockComment_BackupCommentServer{ctrl: ctrl}
+	mock.recorder = &MockComment_BackupCommentServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment_BackupCommentServer) EXPECT() *MockComment_BackupCommentServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockComment_BackupCommentServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockComment_BackupCommentServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockComment_BackupCommentServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockComment_BackupCommentServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockComment_BackupCommentServer) Send(arg0 *pb.BackupCommentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockComment_BackupCommentServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockComment_BackupCommentServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockComment_BackupCommentServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockComment_BackupCommentServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockComment_BackupCommentServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockComment_BackupCommentServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockComment_BackupCommentServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockComment_BackupCommentServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockComment_BackupCommentServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockComment_BackupCommentServer)(nil).SetTrailer), arg0)
+}
+
+// MockComment_BulkImportCommentServer is a mock of Comment_BulkImportCommentServer interface.
+type MockComment_BulkImportCommentServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComment_BulkImportCommentServerMockRecorder
+}
+
+// MockComment_BulkImportCommentServerMockRecorder is the mock recorder for MockComment_BulkImportCommentServer.
+type MockComment_BulkImportCommentServerMockRecorder struct {
+	mock *MockComment_BulkImportCommentServer
+}
+
+// NewMockComment_BulkImportCommentServer creates a new mock instance.
+func NewMockComment_BulkImportCommentServer(ctrl *gomock.Controller) *MockComment_BulkImportCommentServer {
+	mock := &MockComment_BulkImportCommentServer{ctrl: ctrl}
+	mock.recorder = &MockComment_BulkImportCommentServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment_BulkImportCommentServer) EXPECT() *MockComment_BulkImportCommentServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockComment_BulkImportCommentServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockComment_BulkImportCommentServer) Recv() (*pb.BulkImportCommentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.BulkImportCommentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockComment_BulkImportCommentServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockComment_BulkImportCommentServer) Send(arg0 *pb.BulkImportCommentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockComment_BulkImportCommentServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockComment_BulkImportCommentServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockComment_BulkImportCommentServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockComment_BulkImportCommentServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockComment_BulkImportCommentServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockComment_BulkImportCommentServer)(nil).SetTrailer), arg0)
+}
+
+// MockComment_RestoreCommentServer is a mock of Comment_RestoreCommentServer interface.
+type MockComment_RestoreCommentServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComment_RestoreCommentServerMockRecorder
+}
+
+// MockComment_RestoreCommentServerMockRecorder is the mock recorder for MockComment_RestoreCommentServer.
+type MockComment_RestoreCommentServerMockRecorder struct {
+	mock *MockComment_RestoreCommentServer
+}
+
+// NewMockComment_RestoreCommentServer creates a new mock instance.
+func NewMockComment_RestoreCommentServer(ctrl *gomock.Controller) *MockComment_RestoreCommentServer {
+	mock := &MockComment_RestoreCommentServer{ctrl: ctrl}
+	mock.recorder = &MockComment_RestoreCommentServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment_RestoreCommentServer) EXPECT() *MockComment_RestoreCommentServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockComment_RestoreCommentServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockComment_RestoreCommentServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockComment_RestoreCommentServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockComment_RestoreCommentServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockComment_RestoreCommentServer) Send(arg0 *pb.RestoreCommentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockComment_RestoreCommentServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockComment_RestoreCommentServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockComment_RestoreCommentServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockComment_RestoreCommentServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockComment_RestoreCommentServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockComment_RestoreCommentServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockComment_RestoreCommentServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockComment_RestoreCommentServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockComment_RestoreCommentServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockComment_RestoreCommentServer)(nil).SetTrailer), arg0)
+}
+
+// MockComment_StreamCommentsServer is a mock of Comment_StreamCommentsServer interface.
+type MockComment_StreamCommentsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComment_StreamCommentsServerMockRecorder
+}
+
+// MockComment_StreamCommentsServerMockRecorder is the mock recorder for MockComment_StreamCommentsServer.
+type MockComment_StreamCommentsServerMockRecorder struct {
+	mock *MockComment_StreamCommentsServer
+}
+
+// NewMockComment_StreamCommentsServer creates a new mock instance.
+func NewMockComment_StreamCommentsServer(ctrl *gomock.Controller) *MockComment_StreamCommentsServer {
+	mock := &MockComment_StreamCommentsServer{ctrl: ctrl}
+	mock.recorder = &MockComment_StreamCommentsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment_StreamCommentsServer) EXPECT() *MockComment_StreamCommentsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockComment_StreamCommentsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockComment_StreamCommentsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockComment_StreamCommentsServer) Recv() (*pb.StreamCommentsRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*pb.StreamCommentsRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockComment_StreamCommentsServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockComment_StreamCommentsServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockComment_StreamCommentsServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockComment_StreamCommentsServer) Send(arg0 *pb.StreamCommentsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockComment_StreamCommentsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockComment_StreamCommentsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockComment_StreamCommentsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockComment_StreamCommentsServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockComment_StreamCommentsServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockComment_StreamCommentsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockComment_StreamCommentsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockComment_StreamCommentsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockComment_StreamCommentsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockComment_StreamCommentsServer)(nil).SetTrailer), arg0)
+}
+
 // MockCommentClient is a mock of CommentClient interface.
 type MockCommentClient struct {
 	ctrl     *gomock.Controller
@@ -36,6 +543,106 @@ func (m *MockCommentClient) EXPECT() *MockCommentClientMockRecorder {
 	return m.recorder
 }
 
+// ApproveComment mocks base method.
+func (m *MockCommentClient) ApproveComment(arg0 context.Context, arg1 *pb.ApproveCommentRequest, arg2 ...grpc.CallOption) (*pb.ApproveCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveComment", varargs...)
+	ret0, _ := ret[0].(*pb.ApproveCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveComment indicates an expected call of ApproveComment.
+func (mr *MockCommentClientMockRecorder) ApproveComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveComment", reflect.TypeOf((*MockCommentClient)(nil).ApproveComment), varargs...)
+}
+
+// BackupComment mocks base method.
+func (m *MockCommentClient) BackupComment(arg0 context.Context, arg1 *pb.BackupCommentRequest, arg2 ...grpc.CallOption) (pb.Comment_BackupCommentClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackupComment", varargs...)
+	ret0, _ := ret[0].(pb.Comment_BackupCommentClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackupComment indicates an expected call of BackupComment.
+func (mr *MockCommentClientMockRecorder) BackupComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupComment", reflect.TypeOf((*MockCommentClient)(nil).BackupComment), varargs...)
+}
+
+// BlockUser mocks base method.
+func (m *MockCommentClient) BlockUser(arg0 context.Context, arg1 *pb.BlockUserRequest, arg2 ...grpc.CallOption) (*pb.BlockUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockUser", varargs...)
+	ret0, _ := ret[0].(*pb.BlockUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockCommentClientMockRecorder) BlockUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockCommentClient)(nil).BlockUser), varargs...)
+}
+
+// BulkImportComment mocks base method.
+func (m *MockCommentClient) BulkImportComment(arg0 context.Context, arg1 ...grpc.CallOption) (pb.Comment_BulkImportCommentClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BulkImportComment", varargs...)
+	ret0, _ := ret[0].(pb.Comment_BulkImportCommentClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkImportComment indicates an expected call of BulkImportComment.
+func (mr *MockCommentClientMockRecorder) BulkImportComment(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkImportComment", reflect.TypeOf((*MockCommentClient)(nil).BulkImportComment), varargs...)
+}
+
+// CreateBannedPattern mocks base method.
+func (m *MockCommentClient) CreateBannedPattern(arg0 context.Context, arg1 *pb.CreateBannedPatternRequest, arg2 ...grpc.CallOption) (*pb.CreateBannedPatternResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBannedPattern", varargs...)
+	ret0, _ := ret[0].(*pb.CreateBannedPatternResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBannedPattern indicates an expected call of CreateBannedPattern.
+func (mr *MockCommentClientMockRecorder) CreateBannedPattern(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBannedPattern", reflect.TypeOf((*MockCommentClient)(nil).CreateBannedPattern), varargs...)
+}
+
 // CreateComment mocks base method.
 func (m *MockCommentClient) CreateComment(arg0 context.Context, arg1 *pb.CreateCommentRequest, arg2 ...grpc.CallOption) (*pb.CreateCommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +663,26 @@ func (mr *MockCommentClientMockRecorder) CreateComment(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentClient)(nil).CreateComment), varargs...)
 }
 
+// DeleteBannedPattern mocks base method.
+func (m *MockCommentClient) DeleteBannedPattern(arg0 context.Context, arg1 *pb.DeleteBannedPatternRequest, arg2 ...grpc.CallOption) (*pb.DeleteBannedPatternResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteBannedPattern", varargs...)
+	ret0, _ := ret[0].(*pb.DeleteBannedPatternResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBannedPattern indicates an expected call of DeleteBannedPattern.
+func (mr *MockCommentClientMockRecorder) DeleteBannedPattern(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBannedPattern", reflect.TypeOf((*MockCommentClient)(nil).DeleteBannedPattern), varargs...)
+}
+
 // DeleteComment mocks base method.
 func (m *MockCommentClient) DeleteComment(arg0 context.Context, arg1 *pb.DeleteCommentRequest, arg2 ...grpc.CallOption) (*pb.DeleteCommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +723,86 @@ func (mr *MockCommentClientMockRecorder) DeleteCommentByVideoID(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentByVideoID", reflect.TypeOf((*MockCommentClient)(nil).DeleteCommentByVideoID), varargs...)
 }
 
+// EvaluateBannedPatterns mocks base method.
+func (m *MockCommentClient) EvaluateBannedPatterns(arg0 context.Context, arg1 *pb.EvaluateBannedPatternsRequest, arg2 ...grpc.CallOption) (*pb.EvaluateBannedPatternsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvaluateBannedPatterns", varargs...)
+	ret0, _ := ret[0].(*pb.EvaluateBannedPatternsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateBannedPatterns indicates an expected call of EvaluateBannedPatterns.
+func (mr *MockCommentClientMockRecorder) EvaluateBannedPatterns(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateBannedPatterns", reflect.TypeOf((*MockCommentClient)(nil).EvaluateBannedPatterns), varargs...)
+}
+
+// GetComment mocks base method.
+func (m *MockCommentClient) GetComment(arg0 context.Context, arg1 *pb.GetCommentRequest, arg2 ...grpc.CallOption) (*pb.GetCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetComment", varargs...)
+	ret0, _ := ret[0].(*pb.GetCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockCommentClientMockRecorder) GetComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockCommentClient)(nil).GetComment), varargs...)
+}
+
+// GetCommentDraft mocks base method.
+func (m *MockCommentClient) GetCommentDraft(arg0 context.Context, arg1 *pb.GetCommentDraftRequest, arg2 ...grpc.CallOption) (*pb.GetCommentDraftResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommentDraft", varargs...)
+	ret0, _ := ret[0].(*pb.GetCommentDraftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentDraft indicates an expected call of GetCommentDraft.
+func (mr *MockCommentClientMockRecorder) GetCommentDraft(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentDraft", reflect.TypeOf((*MockCommentClient)(nil).GetCommentDraft), varargs...)
+}
+
+// GetUserReputation mocks base method.
+func (m *MockCommentClient) GetUserReputation(arg0 context.Context, arg1 *pb.GetUserReputationRequest, arg2 ...grpc.CallOption) (*pb.GetUserReputationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserReputation", varargs...)
+	ret0, _ := ret[0].(*pb.GetUserReputationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserReputation indicates an expected call of GetUserReputation.
+func (mr *MockCommentClientMockRecorder) GetUserReputation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReputation", reflect.TypeOf((*MockCommentClient)(nil).GetUserReputation), varargs...)
+}
+
 // Healthz mocks base method.
 func (m *MockCommentClient) Healthz(arg0 context.Context, arg1 *pb.HealthzRequest, arg2 ...grpc.CallOption) (*pb.HealthzResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +823,66 @@ func (mr *MockCommentClientMockRecorder) Healthz(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthz", reflect.TypeOf((*MockCommentClient)(nil).Healthz), varargs...)
 }
 
+// LikeComment mocks base method.
+func (m *MockCommentClient) LikeComment(arg0 context.Context, arg1 *pb.LikeCommentRequest, arg2 ...grpc.CallOption) (*pb.LikeCommentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LikeComment", varargs...)
+	ret0, _ := ret[0].(*pb.LikeCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LikeComment indicates an expected call of LikeComment.
+func (mr *MockCommentClientMockRecorder) LikeComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeComment", reflect.TypeOf((*MockCommentClient)(nil).LikeComment), varargs...)
+}
+
+// ListBannedPatterns mocks base method.
+func (m *MockCommentClient) ListBannedPatterns(arg0 context.Context, arg1 *pb.ListBannedPatternsRequest, arg2 ...grpc.CallOption) (*pb.ListBannedPatternsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBannedPatterns", varargs...)
+	ret0, _ := ret[0].(*pb.ListBannedPatternsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBannedPatterns indicates an expected call of ListBannedPatterns.
+func (mr *MockCommentClientMockRecorder) ListBannedPatterns(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBannedPatterns", reflect.TypeOf((*MockCommentClient)(nil).ListBannedPatterns), varargs...)
+}
+
+// ListBlockedUsers mocks base method.
+func (m *MockCommentClient) ListBlockedUsers(arg0 context.Context, arg1 *pb.ListBlockedUsersRequest, arg2 ...grpc.CallOption) (*pb.ListBlockedUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBlockedUsers", varargs...)
+	ret0, _ := ret[0].(*pb.ListBlockedUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedUsers indicates an expected call of ListBlockedUsers.
+func (mr *MockCommentClientMockRecorder) ListBlockedUsers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedUsers", reflect.TypeOf((*MockCommentClient)(nil).ListBlockedUsers), varargs...)
+}
+
 // ListComment mocks base method.
 func (m *MockCommentClient) ListComment(arg0 context.Context, arg1 *pb.ListCommentRequest, arg2 ...grpc.CallOption) (*pb.ListCommentResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +903,166 @@ func (mr *MockCommentClientMockRecorder) ListComment(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComment", reflect.TypeOf((*MockCommentClient)(nil).ListComment), varargs...)
 }
 
+// ListPendingComments mocks base method.
+func (m *MockCommentClient) ListPendingComments(arg0 context.Context, arg1 *pb.ListPendingCommentsRequest, arg2 ...grpc.CallOption) (*pb.ListPendingCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPendingComments", varargs...)
+	ret0, _ := ret[0].(*pb.ListPendingCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingComments indicates an expected call of ListPendingComments.
+func (mr *MockCommentClientMockRecorder) ListPendingComments(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingComments", reflect.TypeOf((*MockCommentClient)(nil).ListPendingComments), varargs...)
+}
+
+// ListTopComments mocks base method.
+func (m *MockCommentClient) ListTopComments(arg0 context.Context, arg1 *pb.ListTopCommentsRequest, arg2 ...grpc.CallOption) (*pb.ListTopCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTopComments", varargs...)
+	ret0, _ := ret[0].(*pb.ListTopCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopComments indicates an expected call of ListTopComments.
+func (mr *MockCommentClientMockRecorder) ListTopComments(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopComments", reflect.TypeOf((*MockCommentClient)(nil).ListTopComments), varargs...)
+}
+
+// RestoreComment mocks base method.
+func (m *MockCommentClient) RestoreComment(arg0 context.Context, arg1 *pb.RestoreCommentRequest, arg2 ...grpc.CallOption) (pb.Comment_RestoreCommentClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreComment", varargs...)
+	ret0, _ := ret[0].(pb.Comment_RestoreCommentClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreComment indicates an expected call of RestoreComment.
+func (mr *MockCommentClientMockRecorder) RestoreComment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreComment", reflect.TypeOf((*MockCommentClient)(nil).RestoreComment), varargs...)
+}
+
+// SaveCommentDraft mocks base method.
+func (m *MockCommentClient) SaveCommentDraft(arg0 context.Context, arg1 *pb.SaveCommentDraftRequest, arg2 ...grpc.CallOption) (*pb.SaveCommentDraftResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveCommentDraft", varargs...)
+	ret0, _ := ret[0].(*pb.SaveCommentDraftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCommentDraft indicates an expected call of SaveCommentDraft.
+func (mr *MockCommentClientMockRecorder) SaveCommentDraft(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCommentDraft", reflect.TypeOf((*MockCommentClient)(nil).SaveCommentDraft), varargs...)
+}
+
+// SemanticSearch mocks base method.
+func (m *MockCommentClient) SemanticSearch(arg0 context.Context, arg1 *pb.SemanticSearchRequest, arg2 ...grpc.CallOption) (*pb.SemanticSearchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SemanticSearch", varargs...)
+	ret0, _ := ret[0].(*pb.SemanticSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SemanticSearch indicates an expected call of SemanticSearch.
+func (mr *MockCommentClientMockRecorder) SemanticSearch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SemanticSearch", reflect.TypeOf((*MockCommentClient)(nil).SemanticSearch), varargs...)
+}
+
+// StreamComments mocks base method.
+func (m *MockCommentClient) StreamComments(arg0 context.Context, arg1 ...grpc.CallOption) (pb.Comment_StreamCommentsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamComments", varargs...)
+	ret0, _ := ret[0].(pb.Comment_StreamCommentsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamComments indicates an expected call of StreamComments.
+func (mr *MockCommentClientMockRecorder) StreamComments(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamComments", reflect.TypeOf((*MockCommentClient)(nil).StreamComments), varargs...)
+}
+
+// SummarizeComments mocks base method.
+func (m *MockCommentClient) SummarizeComments(arg0 context.Context, arg1 *pb.SummarizeCommentsRequest, arg2 ...grpc.CallOption) (*pb.SummarizeCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SummarizeComments", varargs...)
+	ret0, _ := ret[0].(*pb.SummarizeCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeComments indicates an expected call of SummarizeComments.
+func (mr *MockCommentClientMockRecorder) SummarizeComments(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeComments", reflect.TypeOf((*MockCommentClient)(nil).SummarizeComments), varargs...)
+}
+
+// UnblockUser mocks base method.
+func (m *MockCommentClient) UnblockUser(arg0 context.Context, arg1 *pb.UnblockUserRequest, arg2 ...grpc.CallOption) (*pb.UnblockUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnblockUser", varargs...)
+	ret0, _ := ret[0].(*pb.UnblockUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockCommentClientMockRecorder) UnblockUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockCommentClient)(nil).UnblockUser), varargs...)
+}
+
 // UpdateComment mocks base method.
 func (m *MockCommentClient) UpdateComment(arg0 context.Context, arg1 *pb.UpdateCommentRequest, arg2 ...grpc.CallOption) (*pb.UpdateCommentResponse, error) {
 	m.ctrl.T.Helper()
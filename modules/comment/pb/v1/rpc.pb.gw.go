@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
-// source: modules/comment/pb/rpc.proto
+// source: modules/comment/pb/v1/rpc.proto
 
 /*
 Package pb is a reverse proxy.
@@ -0,0 +1,1248 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.3
+// source: modules/comment/pb/v1/rpc.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CommentClient is the client API for Comment service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentClient interface {
+	Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error)
+	ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error)
+	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error)
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	DeleteCommentByVideoID(ctx context.Context, in *DeleteCommentByVideoIDRequest, opts ...grpc.CallOption) (*DeleteCommentByVideoIDResponse, error)
+	// BulkImportComment imports each received batch of comments with COPY
+	// and responds the progress after each batch, a failed batch does not
+	// stop the import.
+	BulkImportComment(ctx context.Context, opts ...grpc.CallOption) (Comment_BulkImportCommentClient, error)
+	// BackupComment exports the comments of the tenant from a consistent
+	// snapshot to the object storage as newline-delimited JSON, and responds
+	// the progress after each batch.
+	BackupComment(ctx context.Context, in *BackupCommentRequest, opts ...grpc.CallOption) (Comment_BackupCommentClient, error)
+	// RestoreComment imports the comments of a backup into the tenant, and
+	// responds the progress after each batch. The comments keep their IDs,
+	// so the backup is restored into a fresh environment.
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (Comment_RestoreCommentClient, error)
+	// StreamComments subscribes to the comments of a video with the first
+	// request, then creates a comment for each following request, and pushes
+	// the comments created by others on any replica in real time.
+	StreamComments(ctx context.Context, opts ...grpc.CallOption) (Comment_StreamCommentsClient, error)
+	// ListBannedPatterns lists the banned words and patterns of the tenant,
+	// the comments matching any of them are not created or updated.
+	ListBannedPatterns(ctx context.Context, in *ListBannedPatternsRequest, opts ...grpc.CallOption) (*ListBannedPatternsResponse, error)
+	// CreateBannedPattern bans a word or pattern in the tenant, it takes
+	// effect on every replica in seconds.
+	CreateBannedPattern(ctx context.Context, in *CreateBannedPatternRequest, opts ...grpc.CallOption) (*CreateBannedPatternResponse, error)
+	DeleteBannedPattern(ctx context.Context, in *DeleteBannedPatternRequest, opts ...grpc.CallOption) (*DeleteBannedPatternResponse, error)
+	// EvaluateBannedPatterns evaluates the content against the banned patterns
+	// of the tenant and the candidates without creating anything, and responds
+	// which of them match.
+	EvaluateBannedPatterns(ctx context.Context, in *EvaluateBannedPatternsRequest, opts ...grpc.CallOption) (*EvaluateBannedPatternsResponse, error)
+	// SummarizeComments summarizes the comments of a video along with their
+	// sentiment. The summary is cached, and refreshed once the number of the
+	// comments changes.
+	SummarizeComments(ctx context.Context, in *SummarizeCommentsRequest, opts ...grpc.CallOption) (*SummarizeCommentsResponse, error)
+	// ListPendingComments lists the comments of the tenant held for moderation,
+	// the most toxic ones first.
+	ListPendingComments(ctx context.Context, in *ListPendingCommentsRequest, opts ...grpc.CallOption) (*ListPendingCommentsResponse, error)
+	// ApproveComment publishes a comment held for moderation, a comment is
+	// rejected by deleting it.
+	ApproveComment(ctx context.Context, in *ApproveCommentRequest, opts ...grpc.CallOption) (*ApproveCommentResponse, error)
+	// ListTopComments lists the trending comments of a video, ranked by their
+	// engagement, i.e. the likes and the replies, decayed by age. The ranking
+	// is maintained by the trending consumer, so a new comment is listed once
+	// it is ranked.
+	ListTopComments(ctx context.Context, in *ListTopCommentsRequest, opts ...grpc.CallOption) (*ListTopCommentsResponse, error)
+	// LikeComment adds a like to a comment.
+	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
+	// SemanticSearch searches the comments of a video by the meaning of the
+	// query, the nearest comments by their embeddings first, which contain all
+	// the keywords if any. The comments are embedded by the embedder consumer,
+	// so a new comment is searched once it is embedded.
+	SemanticSearch(ctx context.Context, in *SemanticSearchRequest, opts ...grpc.CallOption) (*SemanticSearchResponse, error)
+	// SaveCommentDraft saves the draft of a comment of the user on a video,
+	// which expires unless it is saved again. The user is of the X-User-Id
+	// header.
+	SaveCommentDraft(ctx context.Context, in *SaveCommentDraftRequest, opts ...grpc.CallOption) (*SaveCommentDraftResponse, error)
+	// GetCommentDraft gets the draft of a comment of the user on a video, it
+	// is promoted to a comment by CreateComment with the ID of the draft.
+	GetCommentDraft(ctx context.Context, in *GetCommentDraftRequest, opts ...grpc.CallOption) (*GetCommentDraftResponse, error)
+	// BlockUser blocks a user for the user of the X-User-Id header, the
+	// comments of the blocked user are hidden from the user by ListComment.
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	// GetUserReputation returns the reputation score of a user, which is added
+	// up by the likes of the comments of the user and by the moderation of them.
+	GetUserReputation(ctx context.Context, in *GetUserReputationRequest, opts ...grpc.CallOption) (*GetUserReputationResponse, error)
+}
+
+type commentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentClient(cc grpc.ClientConnInterface) CommentClient {
+	return &commentClient{cc}
+}
+
+func (c *commentClient) Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error) {
+	out := new(HealthzResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/Healthz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error) {
+	out := new(ListCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error) {
+	out := new(GetCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/GetComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) DeleteCommentByVideoID(ctx context.Context, in *DeleteCommentByVideoIDRequest, opts ...grpc.CallOption) (*DeleteCommentByVideoIDResponse, error) {
+	out := new(DeleteCommentByVideoIDResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/DeleteCommentByVideoID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) BulkImportComment(ctx context.Context, opts ...grpc.CallOption) (Comment_BulkImportCommentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Comment_ServiceDesc.Streams[0], "/comment.pb.Comment/BulkImportComment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentBulkImportCommentClient{stream}
+	return x, nil
+}
+
+type Comment_BulkImportCommentClient interface {
+	Send(*BulkImportCommentRequest) error
+	Recv() (*BulkImportCommentResponse, error)
+	grpc.ClientStream
+}
+
+type commentBulkImportCommentClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentBulkImportCommentClient) Send(m *BulkImportCommentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *commentBulkImportCommentClient) Recv() (*BulkImportCommentResponse, error) {
+	m := new(BulkImportCommentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentClient) BackupComment(ctx context.Context, in *BackupCommentRequest, opts ...grpc.CallOption) (Comment_BackupCommentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Comment_ServiceDesc.Streams[1], "/comment.pb.Comment/BackupComment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentBackupCommentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Comment_BackupCommentClient interface {
+	Recv() (*BackupCommentResponse, error)
+	grpc.ClientStream
+}
+
+type commentBackupCommentClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentBackupCommentClient) Recv() (*BackupCommentResponse, error) {
+	m := new(BackupCommentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (Comment_RestoreCommentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Comment_ServiceDesc.Streams[2], "/comment.pb.Comment/RestoreComment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentRestoreCommentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Comment_RestoreCommentClient interface {
+	Recv() (*RestoreCommentResponse, error)
+	grpc.ClientStream
+}
+
+type commentRestoreCommentClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentRestoreCommentClient) Recv() (*RestoreCommentResponse, error) {
+	m := new(RestoreCommentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentClient) StreamComments(ctx context.Context, opts ...grpc.CallOption) (Comment_StreamCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Comment_ServiceDesc.Streams[3], "/comment.pb.Comment/StreamComments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentStreamCommentsClient{stream}
+	return x, nil
+}
+
+type Comment_StreamCommentsClient interface {
+	Send(*StreamCommentsRequest) error
+	Recv() (*StreamCommentsResponse, error)
+	grpc.ClientStream
+}
+
+type commentStreamCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentStreamCommentsClient) Send(m *StreamCommentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *commentStreamCommentsClient) Recv() (*StreamCommentsResponse, error) {
+	m := new(StreamCommentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentClient) ListBannedPatterns(ctx context.Context, in *ListBannedPatternsRequest, opts ...grpc.CallOption) (*ListBannedPatternsResponse, error) {
+	out := new(ListBannedPatternsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListBannedPatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) CreateBannedPattern(ctx context.Context, in *CreateBannedPatternRequest, opts ...grpc.CallOption) (*CreateBannedPatternResponse, error) {
+	out := new(CreateBannedPatternResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/CreateBannedPattern", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) DeleteBannedPattern(ctx context.Context, in *DeleteBannedPatternRequest, opts ...grpc.CallOption) (*DeleteBannedPatternResponse, error) {
+	out := new(DeleteBannedPatternResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/DeleteBannedPattern", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) EvaluateBannedPatterns(ctx context.Context, in *EvaluateBannedPatternsRequest, opts ...grpc.CallOption) (*EvaluateBannedPatternsResponse, error) {
+	out := new(EvaluateBannedPatternsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/EvaluateBannedPatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) SummarizeComments(ctx context.Context, in *SummarizeCommentsRequest, opts ...grpc.CallOption) (*SummarizeCommentsResponse, error) {
+	out := new(SummarizeCommentsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/SummarizeComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListPendingComments(ctx context.Context, in *ListPendingCommentsRequest, opts ...grpc.CallOption) (*ListPendingCommentsResponse, error) {
+	out := new(ListPendingCommentsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListPendingComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ApproveComment(ctx context.Context, in *ApproveCommentRequest, opts ...grpc.CallOption) (*ApproveCommentResponse, error) {
+	out := new(ApproveCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ApproveComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListTopComments(ctx context.Context, in *ListTopCommentsRequest, opts ...grpc.CallOption) (*ListTopCommentsResponse, error) {
+	out := new(ListTopCommentsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListTopComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
+	out := new(LikeCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/LikeComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) SemanticSearch(ctx context.Context, in *SemanticSearchRequest, opts ...grpc.CallOption) (*SemanticSearchResponse, error) {
+	out := new(SemanticSearchResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/SemanticSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) SaveCommentDraft(ctx context.Context, in *SaveCommentDraftRequest, opts ...grpc.CallOption) (*SaveCommentDraftResponse, error) {
+	out := new(SaveCommentDraftResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/SaveCommentDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) GetCommentDraft(ctx context.Context, in *GetCommentDraftRequest, opts ...grpc.CallOption) (*GetCommentDraftResponse, error) {
+	out := new(GetCommentDraftResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/GetCommentDraft", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/BlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/UnblockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListBlockedUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentClient) GetUserReputation(ctx context.Context, in *GetUserReputationRequest, opts ...grpc.CallOption) (*GetUserReputationResponse, error) {
+	out := new(GetUserReputationResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.Comment/GetUserReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentServer is the server API for Comment service.
+// All implementations must embed UnimplementedCommentServer
+// for forward compatibility
+type CommentServer interface {
+	Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error)
+	ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error)
+	GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error)
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	DeleteCommentByVideoID(context.Context, *DeleteCommentByVideoIDRequest) (*DeleteCommentByVideoIDResponse, error)
+	// BulkImportComment imports each received batch of comments with COPY
+	// and responds the progress after each batch, a failed batch does not
+	// stop the import.
+	BulkImportComment(Comment_BulkImportCommentServer) error
+	// BackupComment exports the comments of the tenant from a consistent
+	// snapshot to the object storage as newline-delimited JSON, and responds
+	// the progress after each batch.
+	BackupComment(*BackupCommentRequest, Comment_BackupCommentServer) error
+	// RestoreComment imports the comments of a backup into the tenant, and
+	// responds the progress after each batch. The comments keep their IDs,
+	// so the backup is restored into a fresh environment.
+	RestoreComment(*RestoreCommentRequest, Comment_RestoreCommentServer) error
+	// StreamComments subscribes to the comments of a video with the first
+	// request, then creates a comment for each following request, and pushes
+	// the comments created by others on any replica in real time.
+	StreamComments(Comment_StreamCommentsServer) error
+	// ListBannedPatterns lists the banned words and patterns of the tenant,
+	// the comments matching any of them are not created or updated.
+	ListBannedPatterns(context.Context, *ListBannedPatternsRequest) (*ListBannedPatternsResponse, error)
+	// CreateBannedPattern bans a word or pattern in the tenant, it takes
+	// effect on every replica in seconds.
+	CreateBannedPattern(context.Context, *CreateBannedPatternRequest) (*CreateBannedPatternResponse, error)
+	DeleteBannedPattern(context.Context, *DeleteBannedPatternRequest) (*DeleteBannedPatternResponse, error)
+	// EvaluateBannedPatterns evaluates the content against the banned patterns
+	// of the tenant and the candidates without creating anything, and responds
+	// which of them match.
+	EvaluateBannedPatterns(context.Context, *EvaluateBannedPatternsRequest) (*EvaluateBannedPatternsResponse, error)
+	// SummarizeComments summarizes the comments of a video along with their
+	// sentiment. The summary is cached, and refreshed once the number of the
+	// comments changes.
+	SummarizeComments(context.Context, *SummarizeCommentsRequest) (*SummarizeCommentsResponse, error)
+	// ListPendingComments lists the comments of the tenant held for moderation,
+	// the most toxic ones first.
+	ListPendingComments(context.Context, *ListPendingCommentsRequest) (*ListPendingCommentsResponse, error)
+	// ApproveComment publishes a comment held for moderation, a comment is
+	// rejected by deleting it.
+	ApproveComment(context.Context, *ApproveCommentRequest) (*ApproveCommentResponse, error)
+	// ListTopComments lists the trending comments of a video, ranked by their
+	// engagement, i.e. the likes and the replies, decayed by age. The ranking
+	// is maintained by the trending consumer, so a new comment is listed once
+	// it is ranked.
+	ListTopComments(context.Context, *ListTopCommentsRequest) (*ListTopCommentsResponse, error)
+	// LikeComment adds a like to a comment.
+	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
+	// SemanticSearch searches the comments of a video by the meaning of the
+	// query, the nearest comments by their embeddings first, which contain all
+	// the keywords if any. The comments are embedded by the embedder consumer,
+	// so a new comment is searched once it is embedded.
+	SemanticSearch(context.Context, *SemanticSearchRequest) (*SemanticSearchResponse, error)
+	// SaveCommentDraft saves the draft of a comment of the user on a video,
+	// which expires unless it is saved again. The user is of the X-User-Id
+	// header.
+	SaveCommentDraft(context.Context, *SaveCommentDraftRequest) (*SaveCommentDraftResponse, error)
+	// GetCommentDraft gets the draft of a comment of the user on a video, it
+	// is promoted to a comment by CreateComment with the ID of the draft.
+	GetCommentDraft(context.Context, *GetCommentDraftRequest) (*GetCommentDraftResponse, error)
+	// BlockUser blocks a user for the user of the X-User-Id header, the
+	// comments of the blocked user are hidden from the user by ListComment.
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	// GetUserReputation returns the reputation score of a user, which is added
+	// up by the likes of the comments of the user and by the moderation of them.
+	GetUserReputation(context.Context, *GetUserReputationRequest) (*GetUserReputationResponse, error)
+	mustEmbedUnimplementedCommentServer()
+}
+
+// UnimplementedCommentServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentServer struct {
+}
+
+func (UnimplementedCommentServer) Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedCommentServer) ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComment not implemented")
+}
+func (UnimplementedCommentServer) GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (UnimplementedCommentServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCommentServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedCommentServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCommentServer) DeleteCommentByVideoID(context.Context, *DeleteCommentByVideoIDRequest) (*DeleteCommentByVideoIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentByVideoID not implemented")
+}
+func (UnimplementedCommentServer) BulkImportComment(Comment_BulkImportCommentServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkImportComment not implemented")
+}
+func (UnimplementedCommentServer) BackupComment(*BackupCommentRequest, Comment_BackupCommentServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupComment not implemented")
+}
+func (UnimplementedCommentServer) RestoreComment(*RestoreCommentRequest, Comment_RestoreCommentServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedCommentServer) StreamComments(Comment_StreamCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamComments not implemented")
+}
+func (UnimplementedCommentServer) ListBannedPatterns(context.Context, *ListBannedPatternsRequest) (*ListBannedPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBannedPatterns not implemented")
+}
+func (UnimplementedCommentServer) CreateBannedPattern(context.Context, *CreateBannedPatternRequest) (*CreateBannedPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBannedPattern not implemented")
+}
+func (UnimplementedCommentServer) DeleteBannedPattern(context.Context, *DeleteBannedPatternRequest) (*DeleteBannedPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBannedPattern not implemented")
+}
+func (UnimplementedCommentServer) EvaluateBannedPatterns(context.Context, *EvaluateBannedPatternsRequest) (*EvaluateBannedPatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateBannedPatterns not implemented")
+}
+func (UnimplementedCommentServer) SummarizeComments(context.Context, *SummarizeCommentsRequest) (*SummarizeCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SummarizeComments not implemented")
+}
+func (UnimplementedCommentServer) ListPendingComments(context.Context, *ListPendingCommentsRequest) (*ListPendingCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingComments not implemented")
+}
+func (UnimplementedCommentServer) ApproveComment(context.Context, *ApproveCommentRequest) (*ApproveCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveComment not implemented")
+}
+func (UnimplementedCommentServer) ListTopComments(context.Context, *ListTopCommentsRequest) (*ListTopCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopComments not implemented")
+}
+func (UnimplementedCommentServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedCommentServer) SemanticSearch(context.Context, *SemanticSearchRequest) (*SemanticSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SemanticSearch not implemented")
+}
+func (UnimplementedCommentServer) SaveCommentDraft(context.Context, *SaveCommentDraftRequest) (*SaveCommentDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCommentDraft not implemented")
+}
+func (UnimplementedCommentServer) GetCommentDraft(context.Context, *GetCommentDraftRequest) (*GetCommentDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentDraft not implemented")
+}
+func (UnimplementedCommentServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedCommentServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedCommentServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedCommentServer) GetUserReputation(context.Context, *GetUserReputationRequest) (*GetUserReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReputation not implemented")
+}
+func (UnimplementedCommentServer) mustEmbedUnimplementedCommentServer() {}
+
+// UnsafeCommentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentServer will
+// result in compilation errors.
+type UnsafeCommentServer interface {
+	mustEmbedUnimplementedCommentServer()
+}
+
+func RegisterCommentServer(s grpc.ServiceRegistrar, srv CommentServer) {
+	s.RegisterService(&Comment_ServiceDesc, srv)
+}
+
+func _Comment_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthzRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/Healthz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).Healthz(ctx, req.(*HealthzRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ListComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListComment(ctx, req.(*ListCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/GetComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetComment(ctx, req.(*GetCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/CreateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/UpdateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_DeleteCommentByVideoID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentByVideoIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).DeleteCommentByVideoID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/DeleteCommentByVideoID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).DeleteCommentByVideoID(ctx, req.(*DeleteCommentByVideoIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_BulkImportComment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CommentServer).BulkImportComment(&commentBulkImportCommentServer{stream})
+}
+
+type Comment_BulkImportCommentServer interface {
+	Send(*BulkImportCommentResponse) error
+	Recv() (*BulkImportCommentRequest, error)
+	grpc.ServerStream
+}
+
+type commentBulkImportCommentServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentBulkImportCommentServer) Send(m *BulkImportCommentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *commentBulkImportCommentServer) Recv() (*BulkImportCommentRequest, error) {
+	m := new(BulkImportCommentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Comment_BackupComment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupCommentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServer).BackupComment(m, &commentBackupCommentServer{stream})
+}
+
+type Comment_BackupCommentServer interface {
+	Send(*BackupCommentResponse) error
+	grpc.ServerStream
+}
+
+type commentBackupCommentServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentBackupCommentServer) Send(m *BackupCommentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Comment_RestoreComment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RestoreCommentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServer).RestoreComment(m, &commentRestoreCommentServer{stream})
+}
+
+type Comment_RestoreCommentServer interface {
+	Send(*RestoreCommentResponse) error
+	grpc.ServerStream
+}
+
+type commentRestoreCommentServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentRestoreCommentServer) Send(m *RestoreCommentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Comment_StreamComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CommentServer).StreamComments(&commentStreamCommentsServer{stream})
+}
+
+type Comment_StreamCommentsServer interface {
+	Send(*StreamCommentsResponse) error
+	Recv() (*StreamCommentsRequest, error)
+	grpc.ServerStream
+}
+
+type commentStreamCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentStreamCommentsServer) Send(m *StreamCommentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *commentStreamCommentsServer) Recv() (*StreamCommentsRequest, error) {
+	m := new(StreamCommentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Comment_ListBannedPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannedPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListBannedPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ListBannedPatterns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListBannedPatterns(ctx, req.(*ListBannedPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_CreateBannedPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBannedPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).CreateBannedPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/CreateBannedPattern",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).CreateBannedPattern(ctx, req.(*CreateBannedPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_DeleteBannedPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBannedPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).DeleteBannedPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/DeleteBannedPattern",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).DeleteBannedPattern(ctx, req.(*DeleteBannedPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_EvaluateBannedPatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateBannedPatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).EvaluateBannedPatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/EvaluateBannedPatterns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).EvaluateBannedPatterns(ctx, req.(*EvaluateBannedPatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_SummarizeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SummarizeCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).SummarizeComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/SummarizeComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).SummarizeComments(ctx, req.(*SummarizeCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListPendingComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListPendingComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ListPendingComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListPendingComments(ctx, req.(*ListPendingCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ApproveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ApproveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ApproveComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ApproveComment(ctx, req.(*ApproveCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListTopComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListTopComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ListTopComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListTopComments(ctx, req.(*ListTopCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/LikeComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_SemanticSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SemanticSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).SemanticSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/SemanticSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).SemanticSearch(ctx, req.(*SemanticSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_SaveCommentDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCommentDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).SaveCommentDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/SaveCommentDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).SaveCommentDraft(ctx, req.(*SaveCommentDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_GetCommentDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetCommentDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/GetCommentDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetCommentDraft(ctx, req.(*GetCommentDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/BlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/UnblockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/ListBlockedUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comment_GetUserReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServer).GetUserReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/comment.pb.Comment/GetUserReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServer).GetUserReputation(ctx, req.(*GetUserReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Comment_ServiceDesc is the grpc.ServiceDesc for Comment service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Comment_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comment.pb.Comment",
+	HandlerType: (*CommentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Healthz",
+			Handler:    _Comment_Healthz_Handler,
+		},
+		{
+			MethodName: "ListComment",
+			Handler:    _Comment_ListComment_Handler,
+		},
+		{
+			MethodName: "GetComment",
+			Handler:    _Comment_GetComment_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Comment_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Comment_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Comment_DeleteComment_Handler,
+		},
+		{
+			MethodName: "DeleteCommentByVideoID",
+			Handler:    _Comment_DeleteCommentByVideoID_Handler,
+		},
+		{
+			MethodName: "ListBannedPatterns",
+			Handler:    _Comment_ListBannedPatterns_Handler,
+		},
+		{
+			MethodName: "CreateBannedPattern",
+			Handler:    _Comment_CreateBannedPattern_Handler,
+		},
+		{
+			MethodName: "DeleteBannedPattern",
+			Handler:    _Comment_DeleteBannedPattern_Handler,
+		},
+		{
+			MethodName: "EvaluateBannedPatterns",
+			Handler:    _Comment_EvaluateBannedPatterns_Handler,
+		},
+		{
+			MethodName: "SummarizeComments",
+			Handler:    _Comment_SummarizeComments_Handler,
+		},
+		{
+			MethodName: "ListPendingComments",
+			Handler:    _Comment_ListPendingComments_Handler,
+		},
+		{
+			MethodName: "ApproveComment",
+			Handler:    _Comment_ApproveComment_Handler,
+		},
+		{
+			MethodName: "ListTopComments",
+			Handler:    _Comment_ListTopComments_Handler,
+		},
+		{
+			MethodName: "LikeComment",
+			Handler:    _Comment_LikeComment_Handler,
+		},
+		{
+			MethodName: "SemanticSearch",
+			Handler:    _Comment_SemanticSearch_Handler,
+		},
+		{
+			MethodName: "SaveCommentDraft",
+			Handler:    _Comment_SaveCommentDraft_Handler,
+		},
+		{
+			MethodName: "GetCommentDraft",
+			Handler:    _Comment_GetCommentDraft_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Comment_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Comment_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _Comment_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "GetUserReputation",
+			Handler:    _Comment_GetUserReputation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkImportComment",
+			Handler:       _Comment_BulkImportComment_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BackupComment",
+			Handler:       _Comment_BackupComment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreComment",
+			Handler:       _Comment_RestoreComment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamComments",
+			Handler:       _Comment_StreamComments_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "modules/comment/pb/v1/rpc.proto",
+}
@@ -2,9 +2,9 @@
 // versions:
 // - protoc-gen-go-grpc v1.2.0
 // - protoc             v3.19.3
-// source: modules/comment/pb/rpc.proto
+// source: modules/comment/pb/v2/rpc.proto
 
-package pb
+package pbv2
 
 import (
 	context "context"
@@ -22,12 +22,11 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CommentClient interface {
-	Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error)
-	ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
-	DeleteCommentByVideoID(ctx context.Context, in *DeleteCommentByVideoIDRequest, opts ...grpc.CallOption) (*DeleteCommentByVideoIDResponse, error)
 }
 
 type commentClient struct {
@@ -38,18 +37,18 @@ func NewCommentClient(cc grpc.ClientConnInterface) CommentClient {
 	return &commentClient{cc}
 }
 
-func (c *commentClient) Healthz(ctx context.Context, in *HealthzRequest, opts ...grpc.CallOption) (*HealthzResponse, error) {
-	out := new(HealthzResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/Healthz", in, out, opts...)
+func (c *commentClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.v2.Comment/ListComments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *commentClient) ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error) {
-	out := new(ListCommentResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/ListComment", in, out, opts...)
+func (c *commentClient) GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*GetCommentResponse, error) {
+	out := new(GetCommentResponse)
+	err := c.cc.Invoke(ctx, "/comment.pb.v2.Comment/GetComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +57,7 @@ func (c *commentClient) ListComment(ctx context.Context, in *ListCommentRequest,
 
 func (c *commentClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
 	out := new(CreateCommentResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/CreateComment", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/comment.pb.v2.Comment/CreateComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +66,7 @@ func (c *commentClient) CreateComment(ctx context.Context, in *CreateCommentRequ
 
 func (c *commentClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
 	out := new(UpdateCommentResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/UpdateComment", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/comment.pb.v2.Comment/UpdateComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,16 +75,7 @@ func (c *commentClient) UpdateComment(ctx context.Context, in *UpdateCommentRequ
 
 func (c *commentClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
 	out := new(DeleteCommentResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/DeleteComment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *commentClient) DeleteCommentByVideoID(ctx context.Context, in *DeleteCommentByVideoIDRequest, opts ...grpc.CallOption) (*DeleteCommentByVideoIDResponse, error) {
-	out := new(DeleteCommentByVideoIDResponse)
-	err := c.cc.Invoke(ctx, "/comment.pb.Comment/DeleteCommentByVideoID", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/comment.pb.v2.Comment/DeleteComment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -96,12 +86,11 @@ func (c *commentClient) DeleteCommentByVideoID(ctx context.Context, in *DeleteCo
 // All implementations must embed UnimplementedCommentServer
 // for forward compatibility
 type CommentServer interface {
-	Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error)
-	ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error)
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
-	DeleteCommentByVideoID(context.Context, *DeleteCommentByVideoIDRequest) (*DeleteCommentByVideoIDResponse, error)
 	mustEmbedUnimplementedCommentServer()
 }
 
@@ -109,11 +98,11 @@ type CommentServer interface {
 type UnimplementedCommentServer struct {
 }
 
-func (UnimplementedCommentServer) Healthz(context.Context, *HealthzRequest) (*HealthzResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+func (UnimplementedCommentServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
 }
-func (UnimplementedCommentServer) ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListComment not implemented")
+func (UnimplementedCommentServer) GetComment(context.Context, *GetCommentRequest) (*GetCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
 }
 func (UnimplementedCommentServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
@@ -124,9 +113,6 @@ func (UnimplementedCommentServer) UpdateComment(context.Context, *UpdateCommentR
 func (UnimplementedCommentServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
-func (UnimplementedCommentServer) DeleteCommentByVideoID(context.Context, *DeleteCommentByVideoIDRequest) (*DeleteCommentByVideoIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCommentByVideoID not implemented")
-}
 func (UnimplementedCommentServer) mustEmbedUnimplementedCommentServer() {}
 
 // UnsafeCommentServer may be embedded to opt out of forward compatibility for this service.
@@ -140,38 +126,38 @@ func RegisterCommentServer(s grpc.ServiceRegistrar, srv CommentServer) {
 	s.RegisterService(&Comment_ServiceDesc, srv)
 }
 
-func _Comment_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthzRequest)
+func _Comment_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServer).Healthz(ctx, in)
+		return srv.(CommentServer).ListComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.pb.Comment/Healthz",
+		FullMethod: "/comment.pb.v2.Comment/ListComments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServer).Healthz(ctx, req.(*HealthzRequest))
+		return srv.(CommentServer).ListComments(ctx, req.(*ListCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Comment_ListComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommentRequest)
+func _Comment_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServer).ListComment(ctx, in)
+		return srv.(CommentServer).GetComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.pb.Comment/ListComment",
+		FullMethod: "/comment.pb.v2.Comment/GetComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServer).ListComment(ctx, req.(*ListCommentRequest))
+		return srv.(CommentServer).GetComment(ctx, req.(*GetCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -186,7 +172,7 @@ func _Comment_CreateComment_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.pb.Comment/CreateComment",
+		FullMethod: "/comment.pb.v2.Comment/CreateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CommentServer).CreateComment(ctx, req.(*CreateCommentRequest))
@@ -204,7 +190,7 @@ func _Comment_UpdateComment_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.pb.Comment/UpdateComment",
+		FullMethod: "/comment.pb.v2.Comment/UpdateComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CommentServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
@@ -222,7 +208,7 @@ func _Comment_DeleteComment_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/comment.pb.Comment/DeleteComment",
+		FullMethod: "/comment.pb.v2.Comment/DeleteComment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CommentServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
@@ -230,38 +216,20 @@ func _Comment_DeleteComment_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Comment_DeleteCommentByVideoID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentByVideoIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(CommentServer).DeleteCommentByVideoID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/comment.pb.Comment/DeleteCommentByVideoID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServer).DeleteCommentByVideoID(ctx, req.(*DeleteCommentByVideoIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
 // Comment_ServiceDesc is the grpc.ServiceDesc for Comment service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Comment_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "comment.pb.Comment",
+	ServiceName: "comment.pb.v2.Comment",
 	HandlerType: (*CommentServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Healthz",
-			Handler:    _Comment_Healthz_Handler,
+			MethodName: "ListComments",
+			Handler:    _Comment_ListComments_Handler,
 		},
 		{
-			MethodName: "ListComment",
-			Handler:    _Comment_ListComment_Handler,
+			MethodName: "GetComment",
+			Handler:    _Comment_GetComment_Handler,
 		},
 		{
 			MethodName: "CreateComment",
@@ -275,11 +243,7 @@ var Comment_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _Comment_DeleteComment_Handler,
 		},
-		{
-			MethodName: "DeleteCommentByVideoID",
-			Handler:    _Comment_DeleteCommentByVideoID_Handler,
-		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "modules/comment/pb/rpc.proto",
+	Metadata: "modules/comment/pb/v2/rpc.proto",
 }
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// AbuseReasonBurst is the reason of holding the comments of a user posting too many comments within the window
+	AbuseReasonBurst = "burst"
+	// AbuseReasonDuplicate is the reason of holding the comments of a user posting the same content on too many
+	// videos within the window
+	AbuseReasonDuplicate = "duplicate"
+)
+
+type AbuseConfig struct {
+	Window             time.Duration `long:"window" env:"WINDOW" description:"the sliding window the comments of a user are counted within" default:"10m"`
+	BurstThreshold     int64         `long:"burst_threshold" env:"BURST_THRESHOLD" description:"the comments of a user are held for moderation once the user posts as many comments within the window" default:"20"`
+	DuplicateThreshold int64         `long:"duplicate_threshold" env:"DUPLICATE_THRESHOLD" description:"the comments of a user are held for moderation once the user posts the same content on as many videos within the window" default:"3"`
+}
+
+// AbuseDetector holds the comments of the users posting in abnormal patterns for moderation once they are created,
+// i.e. bursts of comments and the same content across the videos. It handles the change events of the comments
+// table like ToxicityModerator, so the held comments join the moderator queue of ListPendingComments, and the
+// comments are listed until they are held.
+type AbuseDetector struct {
+	commentDAO  dao.CommentDAO
+	velocityDAO dao.CommentVelocityDAO
+	conf        *AbuseConfig
+}
+
+var _ cdckit.Handler = (*AbuseDetector)(nil)
+
+func NewAbuseDetector(commentDAO dao.CommentDAO, velocityDAO dao.CommentVelocityDAO, conf *AbuseConfig) *AbuseDetector {
+	return &AbuseDetector{
+		commentDAO:  commentDAO,
+		velocityDAO: velocityDAO,
+		conf:        conf,
+	}
+}
+
+// HandleChange tracks the created comment of its author. The comments of no author are not tracked, since the
+// users are told apart by the X-User-Id header only. Tracking and holding a comment again is harmless, so the
+// event is handled again from the last checkpoint on any failure.
+func (d *AbuseDetector) HandleChange(ctx context.Context, event *cdckit.ChangeEvent) error {
+	if event.Op != cdckit.OperationCreate {
+		return nil
+	}
+
+	var row struct {
+		ID       uuid.UUID `json:"id"`
+		TenantID string    `json:"tenant_id"`
+	}
+	if err := json.Unmarshal(event.Row(), &row); err != nil {
+		return err
+	}
+
+	ctx = tenantkit.WithTenantID(ctx, row.TenantID)
+
+	// the comment is read by the DAO instead of the row, so the content is decrypted if it is encrypted
+	comment, err := d.commentDAO.Get(ctx, row.ID)
+	if errors.Is(err, dao.ErrCommentNotFound) {
+		// the comment is deleted before it is tracked
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID == "" {
+		return nil
+	}
+
+	velocity, err := d.velocityDAO.Track(ctx, comment, contentFingerprint(comment.Content))
+	if err != nil {
+		return err
+	}
+
+	var reason string
+	switch {
+	case velocity.Comments >= d.conf.BurstThreshold:
+		reason = AbuseReasonBurst
+	case velocity.DuplicateVideos >= d.conf.DuplicateThreshold:
+		reason = AbuseReasonDuplicate
+	default:
+		return nil
+	}
+
+	if comment.Status == dao.CommentStatusPending {
+		return nil
+	}
+
+	logkit.FromContext(ctx).Info("hold abusive comment for moderation",
+		zap.String("tenant_id", row.TenantID),
+		zap.String("comment_id", comment.ID.String()),
+		zap.String("user_id", comment.UserID),
+		zap.String("reason", reason),
+		zap.Int64("comments", velocity.Comments),
+		zap.Int64("duplicate_videos", velocity.DuplicateVideos),
+	)
+
+	// the toxicity score is kept, so the held comments are still listed in the order of moderation
+	if err := d.commentDAO.Hold(ctx, comment.ID, comment.Toxicity); err != nil && !errors.Is(err, dao.ErrCommentNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// contentFingerprint returns the hash of the normalized content, unlike commentFingerprint it is not of the thread,
+// so the same content is told across the videos.
+func contentFingerprint(content string) string {
+	hash := sha256.Sum256([]byte(normalizeContent(content)))
+
+	return hex.EncodeToString(hash[:])
+}
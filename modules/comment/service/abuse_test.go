@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("AbuseDetector", func() {
+	var (
+		commentDAO dao.CommentDAO
+		svc        *service
+		detector   *AbuseDetector
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		commentDAO = dao.NewMemoryCommentDAO()
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil,
+			WithPageTokens(pagekit.NewCodec(ctx, &pagekit.Config{Secret: "fake secret of the page tokens", TTL: time.Hour})),
+		)
+		detector = NewAbuseDetector(commentDAO, dao.NewMemoryCommentVelocityDAO(time.Hour), &AbuseConfig{
+			Window:             time.Hour,
+			BurstThreshold:     3,
+			DuplicateThreshold: 2,
+		})
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	// createComment creates the comment of the user and detects it by the change event of its creation
+	createComment := func(userID, videoID, content string) *dao.Comment {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		Expect(svc.createComment(logkit.WithUserID(ctx, userID), comment)).To(Succeed())
+
+		row, err := json.Marshal(map[string]string{"id": comment.ID.String(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(detector.HandleChange(ctx, &cdckit.ChangeEvent{Op: cdckit.OperationCreate, After: row})).To(Succeed())
+
+		return comment
+	}
+
+	pendingComments := func() []string {
+		pending, err := svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(pending.GetComments()))
+		for _, comment := range pending.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("holds the comments of a burst for moderation", func() {
+		createComment("alice", videoID, "first")
+		createComment("alice", videoID, "second")
+		createComment("bob", videoID, "third")
+		Expect(pendingComments()).To(BeEmpty())
+
+		createComment("alice", videoID, "fourth")
+		Expect(pendingComments()).To(Equal([]string{"fourth"}))
+	})
+
+	It("holds the same content posted across the videos for moderation", func() {
+		createComment("alice", videoID, "Buy followers!")
+		createComment("alice", videoID, "buy followers")
+		Expect(pendingComments()).To(BeEmpty())
+
+		createComment("alice", primitive.NewObjectID().Hex(), "BUY FOLLOWERS")
+		Expect(pendingComments()).To(Equal([]string{"BUY FOLLOWERS"}))
+	})
+
+	It("does not count the replayed events twice", func() {
+		comment := createComment("alice", videoID, "first")
+
+		row, err := json.Marshal(map[string]string{"id": comment.ID.String(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			Expect(detector.HandleChange(ctx, &cdckit.ChangeEvent{Op: cdckit.OperationCreate, After: row})).To(Succeed())
+		}
+
+		Expect(pendingComments()).To(BeEmpty())
+	})
+
+	It("ignores the comments of no author", func() {
+		for i := 0; i < 3; i++ {
+			createComment("", primitive.NewObjectID().Hex(), "anonymous")
+		}
+
+		Expect(pendingComments()).To(BeEmpty())
+	})
+
+	It("ignores the comment deleted before it is tracked", func() {
+		row, err := json.Marshal(map[string]string{"id": uuid.NewString(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(detector.HandleChange(ctx, &cdckit.ChangeEvent{Op: cdckit.OperationCreate, After: row})).To(Succeed())
+	})
+})
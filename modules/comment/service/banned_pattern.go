@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// bannedPatternCacheTTL is how long the patterns of a tenant are cached, the patterns are reloaded once changed
+// on any replica, so the TTL only bounds how long a change missed by the subscription takes effect.
+const bannedPatternCacheTTL = time.Minute
+
+// BannedPatternFilter blocks the comment contents matching the banned patterns of their tenants. The compiled
+// patterns of each tenant are cached, and reloaded once the patterns are changed on any replica.
+type BannedPatternFilter struct {
+	bannedPatternDAO    dao.BannedPatternDAO
+	bannedPatternPubSub dao.BannedPatternPubSub
+
+	mu      sync.Mutex
+	entries map[string]*bannedPatternEntry
+	// versions are bumped by the changes of the tenants, so a load racing with a change is not cached
+	versions map[string]uint64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type bannedPatternEntry struct {
+	matchers []*bannedPatternMatcher
+	loadedAt time.Time
+}
+
+type bannedPatternMatcher struct {
+	pattern *dao.BannedPattern
+	re      *regexp.Regexp
+}
+
+// BannedPatternMatch is a banned pattern matching the content along with the text matched.
+type BannedPatternMatch struct {
+	Pattern *dao.BannedPattern
+	Text    string
+}
+
+func NewBannedPatternFilter(ctx context.Context, bannedPatternDAO dao.BannedPatternDAO, bannedPatternPubSub dao.BannedPatternPubSub) *BannedPatternFilter {
+	logger := logkit.FromContext(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	tenantIDs, err := bannedPatternPubSub.Subscribe(ctx)
+	if err != nil {
+		cancel()
+		logger.Fatal("failed to subscribe banned pattern changes", zap.Error(err))
+	}
+
+	f := &BannedPatternFilter{
+		bannedPatternDAO:    bannedPatternDAO,
+		bannedPatternPubSub: bannedPatternPubSub,
+		entries:             make(map[string]*bannedPatternEntry),
+		versions:            make(map[string]uint64),
+		cancel:              cancel,
+		done:                make(chan struct{}),
+	}
+
+	go func() {
+		defer close(f.done)
+
+		for tenantID := range tenantIDs {
+			f.invalidate(tenantID)
+		}
+	}()
+
+	return f
+}
+
+// Close stops receiving the changes of the patterns.
+func (f *BannedPatternFilter) Close() error {
+	f.cancel()
+	<-f.done
+
+	return nil
+}
+
+// Check returns ErrContentBlocked if the content matches any banned pattern of the tenant of the context.
+func (f *BannedPatternFilter) Check(ctx context.Context, content string) error {
+	matchers, err := f.matchers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, matcher := range matchers {
+		if matcher.re.MatchString(content) {
+			return ErrContentBlocked
+		}
+	}
+
+	return nil
+}
+
+// Evaluate returns the banned patterns of the tenant of the context and the candidates matching the content,
+// and whether the content is blocked, i.e. it matches the banned patterns other than the candidates.
+func (f *BannedPatternFilter) Evaluate(ctx context.Context, content string, candidates []*dao.BannedPattern) ([]*BannedPatternMatch, bool, error) {
+	matchers, err := f.matchers(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var matches []*BannedPatternMatch
+	var blocked bool
+
+	for _, matcher := range matchers {
+		if loc := matcher.re.FindStringIndex(content); loc != nil {
+			matches = append(matches, &BannedPatternMatch{Pattern: matcher.pattern, Text: content[loc[0]:loc[1]]})
+			blocked = true
+		}
+	}
+
+	for _, candidate := range candidates {
+		re, err := compileBannedPattern(candidate)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if loc := re.FindStringIndex(content); loc != nil {
+			matches = append(matches, &BannedPatternMatch{Pattern: candidate, Text: content[loc[0]:loc[1]]})
+		}
+	}
+
+	return matches, blocked, nil
+}
+
+// Changed reloads the patterns of the tenant of the context on every replica.
+func (f *BannedPatternFilter) Changed(ctx context.Context) {
+	f.invalidate(tenantkit.FromContext(ctx))
+
+	// the other replicas reload the patterns once their caches expire if the change is not published
+	if err := f.bannedPatternPubSub.Publish(ctx); err != nil {
+		logkit.FromContext(ctx).Error("failed to publish banned pattern change", zap.Error(err))
+	}
+}
+
+func (f *BannedPatternFilter) invalidate(tenantID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.entries, tenantID)
+	f.versions[tenantID]++
+}
+
+// matchers returns the compiled patterns of the tenant of the context, which are loaded if not cached or expired.
+func (f *BannedPatternFilter) matchers(ctx context.Context) ([]*bannedPatternMatcher, error) {
+	tenantID := tenantkit.FromContext(ctx)
+
+	f.mu.Lock()
+	entry, ok := f.entries[tenantID]
+	version := f.versions[tenantID]
+	f.mu.Unlock()
+
+	if ok && time.Since(entry.loadedAt) < bannedPatternCacheTTL {
+		return entry.matchers, nil
+	}
+
+	patterns, err := f.bannedPatternDAO.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matchers := make([]*bannedPatternMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := compileBannedPattern(pattern)
+		if err != nil {
+			// the patterns are validated on creation, an invalid one is skipped instead of blocking every comment
+			logkit.FromContext(ctx).Error("failed to compile banned pattern", zap.String("banned_pattern_id", pattern.ID.String()), zap.Error(err))
+			continue
+		}
+
+		matchers = append(matchers, &bannedPatternMatcher{pattern: pattern, re: re})
+	}
+
+	f.mu.Lock()
+	if f.versions[tenantID] == version {
+		f.entries[tenantID] = &bannedPatternEntry{matchers: matchers, loadedAt: time.Now()}
+	}
+	f.mu.Unlock()
+
+	return matchers, nil
+}
+
+// compileBannedPattern compiles the pattern into a regular expression, a word matches case-insensitively as
+// a whole word, with any spaces between the words of a phrase. The regular expressions are of the RE2 syntax,
+// which runs in linear time, so a pattern never blows up the filter.
+func compileBannedPattern(pattern *dao.BannedPattern) (*regexp.Regexp, error) {
+	switch pattern.Kind {
+	case dao.BannedPatternKindWord:
+		words := strings.Fields(pattern.Pattern)
+		if len(words) == 0 {
+			return nil, ErrInvalidBannedPattern
+		}
+
+		for i, word := range words {
+			words[i] = regexp.QuoteMeta(word)
+		}
+
+		expr := strings.Join(words, `\s+`)
+
+		// the boundaries only apply to the word characters, e.g. the CJK words have no spaces around them
+		trimmed := strings.TrimSpace(pattern.Pattern)
+		if first, _ := utf8.DecodeRuneInString(trimmed); isWordRune(first) {
+			expr = `\b` + expr
+		}
+		if last, _ := utf8.DecodeLastRuneInString(trimmed); isWordRune(last) {
+			expr += `\b`
+		}
+
+		return regexp.Compile("(?i)" + expr)
+	case dao.BannedPatternKindRegex:
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return nil, ErrInvalidBannedPattern
+		}
+
+		return re, nil
+	default:
+		return nil, ErrInvalidBannedPattern
+	}
+}
+
+// isWordRune reports whether the rune is a word character of \b, i.e. an ASCII letter, digit or underscore.
+func isWordRune(r rune) bool {
+	return r < utf8.RuneSelf && (r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r))
+}
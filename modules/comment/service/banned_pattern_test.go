@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/daomock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BannedPatternFilter", func() {
+	var (
+		bannedPatternDAO    dao.BannedPatternDAO
+		bannedPatternPubSub dao.BannedPatternPubSub
+		filter              *BannedPatternFilter
+		ctx                 context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		bannedPatternDAO = dao.NewMemoryBannedPatternDAO()
+		bannedPatternPubSub = dao.NewMemoryBannedPatternPubSub()
+		filter = NewBannedPatternFilter(ctx, bannedPatternDAO, bannedPatternPubSub)
+	})
+
+	AfterEach(func() {
+		Expect(filter.Close()).To(Succeed())
+	})
+
+	createPattern := func(ctx context.Context, kind, pattern string) {
+		_, err := bannedPatternDAO.Create(ctx, &dao.BannedPattern{Kind: kind, Pattern: pattern})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	DescribeTable("Check",
+		func(kind, pattern, content string, blocked bool) {
+			createPattern(ctx, kind, pattern)
+
+			if blocked {
+				Expect(filter.Check(ctx, content)).To(MatchError(ErrContentBlocked))
+			} else {
+				Expect(filter.Check(ctx, content)).To(Succeed())
+			}
+		},
+		Entry("word", dao.BannedPatternKindWord, "spam", "buy SPAM now", true),
+		Entry("word in another word", dao.BannedPatternKindWord, "spam", "spammer", false),
+		Entry("phrase with any spaces", dao.BannedPatternKindWord, "buy now", "buy   now!", true),
+		Entry("word of metacharacters", dao.BannedPatternKindWord, "a.b", "axb", false),
+		Entry("CJK word", dao.BannedPatternKindWord, "垃圾", "這是垃圾留言", true),
+		Entry("regex", dao.BannedPatternKindRegex, `https?://bit\.ly/\S+`, "see http://bit.ly/abc", true),
+		Entry("regex not matched", dao.BannedPatternKindRegex, `^\d+$`, "123 go", false),
+	)
+
+	When("the patterns are in another tenant", func() {
+		It("does not block the content", func() {
+			createPattern(tenantkit.WithTenantID(ctx, "another-tenant"), dao.BannedPatternKindWord, "spam")
+
+			Expect(filter.Check(ctx, "spam")).To(Succeed())
+		})
+	})
+
+	When("the patterns are changed on another replica", func() {
+		It("reloads the patterns", func() {
+			Expect(filter.Check(ctx, "spam")).To(Succeed())
+
+			createPattern(ctx, dao.BannedPatternKindWord, "spam")
+			Expect(bannedPatternPubSub.Publish(ctx)).To(Succeed())
+
+			Eventually(func() error {
+				return filter.Check(ctx, "spam")
+			}).Should(MatchError(ErrContentBlocked))
+		})
+	})
+
+	Describe("Evaluate", func() {
+		BeforeEach(func() {
+			createPattern(ctx, dao.BannedPatternKindWord, "spam")
+		})
+
+		It("returns the patterns and the candidates matched", func() {
+			candidates := []*dao.BannedPattern{
+				{Kind: dao.BannedPatternKindRegex, Pattern: `\d{3}-\d{4}`},
+				{Kind: dao.BannedPatternKindWord, Pattern: "scam"},
+			}
+
+			matches, blocked, err := filter.Evaluate(ctx, "Spam me at 555-1234", candidates)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocked).To(BeTrue())
+			Expect(matches).To(HaveLen(2))
+			Expect(matches[0].Pattern.Pattern).To(Equal("spam"))
+			Expect(matches[0].Text).To(Equal("Spam"))
+			Expect(matches[1].Pattern).To(Equal(candidates[0]))
+			Expect(matches[1].Text).To(Equal("555-1234"))
+		})
+
+		When("only the candidates match", func() {
+			It("does not block the content", func() {
+				matches, blocked, err := filter.Evaluate(ctx, "a scam", []*dao.BannedPattern{{Kind: dao.BannedPatternKindWord, Pattern: "scam"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(blocked).To(BeFalse())
+				Expect(matches).To(HaveLen(1))
+			})
+		})
+
+		When("a candidate is invalid", func() {
+			It("returns ErrInvalidBannedPattern", func() {
+				_, _, err := filter.Evaluate(ctx, "content", []*dao.BannedPattern{{Kind: dao.BannedPatternKindRegex, Pattern: "("}})
+				Expect(err).To(MatchError(ErrInvalidBannedPattern))
+			})
+		})
+	})
+})
+
+var _ = Describe("Service banned patterns", func() {
+	var (
+		controller       *gomock.Controller
+		commentDAO       *daomock.MockCommentDAO
+		bannedPatternDAO dao.BannedPatternDAO
+		filter           *BannedPatternFilter
+		svc              *service
+		ctx              context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		controller = gomock.NewController(GinkgoT())
+		commentDAO = daomock.NewMockCommentDAO(controller)
+		bannedPatternDAO = dao.NewMemoryBannedPatternDAO()
+		filter = NewBannedPatternFilter(ctx, bannedPatternDAO, dao.NewMemoryBannedPatternPubSub())
+		svc = NewService(commentDAO, nil, nil, nil, WithBannedPatterns(bannedPatternDAO, filter))
+	})
+
+	AfterEach(func() {
+		Expect(filter.Close()).To(Succeed())
+		controller.Finish()
+	})
+
+	When("banned patterns are disabled", func() {
+		It("returns ErrBannedPatternsDisabled", func() {
+			svc = NewService(commentDAO, nil, nil, nil)
+
+			_, err := svc.ListBannedPatterns(ctx, &pb.ListBannedPatternsRequest{})
+			Expect(err).To(MatchError(ErrBannedPatternsDisabled))
+		})
+	})
+
+	Describe("CreateBannedPattern", func() {
+		When("the regex is invalid", func() {
+			It("returns ErrInvalidBannedPattern", func() {
+				_, err := svc.CreateBannedPattern(ctx, &pb.CreateBannedPatternRequest{
+					Kind:    pb.BannedPatternKind_BANNED_PATTERN_KIND_REGEX,
+					Pattern: "(unclosed",
+				})
+				Expect(err).To(MatchError(ErrInvalidBannedPattern))
+			})
+		})
+
+		When("success", func() {
+			It("blocks the comments matching the pattern at once", func() {
+				resp, err := svc.CreateBannedPattern(ctx, &pb.CreateBannedPatternRequest{
+					Kind:    pb.BannedPatternKind_BANNED_PATTERN_KIND_WORD,
+					Pattern: "spam",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetPattern().GetId()).NotTo(BeEmpty())
+
+				listResp, err := svc.ListBannedPatterns(ctx, &pb.ListBannedPatternsRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(listResp.GetPatterns()).To(HaveLen(1))
+
+				_, err = svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: uuid.NewString(), Content: "more spam"})
+				Expect(err).To(MatchError(ErrContentBlocked))
+			})
+		})
+	})
+
+	Describe("DeleteBannedPattern", func() {
+		When("the pattern is deleted", func() {
+			It("does not block the comments matching the pattern anymore", func() {
+				resp, err := svc.CreateBannedPattern(ctx, &pb.CreateBannedPatternRequest{
+					Kind:    pb.BannedPatternKind_BANNED_PATTERN_KIND_WORD,
+					Pattern: "spam",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = svc.DeleteBannedPattern(ctx, &pb.DeleteBannedPatternRequest{Id: resp.GetPattern().GetId()})
+				Expect(err).NotTo(HaveOccurred())
+
+				commentDAO.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				_, err = svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: uuid.NewString(), Content: "more spam"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the pattern is not found", func() {
+			It("returns ErrBannedPatternNotFound", func() {
+				_, err := svc.DeleteBannedPattern(ctx, &pb.DeleteBannedPatternRequest{Id: uuid.NewString()})
+				Expect(err).To(MatchError(dao.ErrBannedPatternNotFound))
+			})
+		})
+	})
+
+	Describe("EvaluateBannedPatterns", func() {
+		It("responds the matches without creating the candidates", func() {
+			resp, err := svc.EvaluateBannedPatterns(ctx, &pb.EvaluateBannedPatternsRequest{
+				Content: "a scam",
+				Candidates: []*pb.CreateBannedPatternRequest{
+					{Kind: pb.BannedPatternKind_BANNED_PATTERN_KIND_WORD, Pattern: "scam"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetBlocked()).To(BeFalse())
+			Expect(resp.GetMatches()).To(HaveLen(1))
+			Expect(resp.GetMatches()[0].GetPattern().GetId()).To(BeEmpty())
+			Expect(resp.GetMatches()[0].GetText()).To(Equal("scam"))
+
+			Expect(bannedPatternDAO.List(ctx)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+)
+
+// WithUserBlocks lets the users block the other users by the DAO, the comments of the blocked users are hidden from
+// the users blocking them. It is a no-op if the DAO is nil.
+func WithUserBlocks(userBlockDAO dao.UserBlockDAO) ServiceOption {
+	return func(s *service) {
+		if userBlockDAO != nil {
+			s.userBlockDAO = userBlockDAO
+		}
+	}
+}
+
+func (s *service) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	userID, err := s.userBlockUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == userID {
+		return nil, ErrBlockSelf
+	}
+
+	block := &dao.UserBlock{
+		UserID:        userID,
+		BlockedUserID: req.GetUserId(),
+	}
+	if err := s.userBlockDAO.Block(ctx, block); err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockUserResponse{Block: block.ToProto()}, nil
+}
+
+func (s *service) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	userID, err := s.userBlockUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userBlockDAO.Unblock(ctx, userID, req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.UnblockUserResponse{}, nil
+}
+
+func (s *service) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	userID, err := s.userBlockUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.userBlockDAO.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbBlocks := make([]*pb.UserBlock, 0, len(blocks))
+	for _, block := range blocks {
+		pbBlocks = append(pbBlocks, block.ToProto())
+	}
+
+	return &pb.ListBlockedUsersResponse{Blocks: pbBlocks}, nil
+}
+
+// userBlockUserID returns the user blocking the others, i.e. the user of the X-User-Id header.
+func (s *service) userBlockUserID(ctx context.Context) (string, error) {
+	if s.userBlockDAO == nil {
+		return "", ErrUserBlocksDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return "", ErrUserIDRequired
+	}
+
+	return userID, nil
+}
+
+// blockedUserIDs returns the users blocked by the user of the request, it is empty if the blocks are disabled or the
+// request has no user.
+func (s *service) blockedUserIDs(ctx context.Context) (map[string]struct{}, error) {
+	userID := logkit.UserIDFromContext(ctx)
+	if s.userBlockDAO == nil || userID == "" {
+		return nil, nil
+	}
+
+	blocks, err := s.userBlockDAO.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[string]struct{}, len(blocks))
+	for _, block := range blocks {
+		blocked[block.BlockedUserID] = struct{}{}
+	}
+
+	return blocked, nil
+}
+
+// hideBlockedComments returns the comments without the comments of the users blocked by the user of the request. The
+// pages are not refilled, so a page may have fewer comments than its size.
+func (s *service) hideBlockedComments(ctx context.Context, comments []*dao.Comment) ([]*dao.Comment, error) {
+	blocked, err := s.blockedUserIDs(ctx)
+	if err != nil || len(blocked) == 0 {
+		return comments, err
+	}
+
+	visible := make([]*dao.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if _, ok := blocked[comment.UserID]; !ok {
+			visible = append(visible, comment)
+		}
+	}
+
+	return visible, nil
+}
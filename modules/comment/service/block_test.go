@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("UserBlock", func() {
+	var (
+		controller  *gomock.Controller
+		videoClient *videopbmock.MockVideoClient
+		svc         *service
+		ctx         context.Context
+		videoID     string
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		videoClient = videopbmock.NewMockVideoClient(controller)
+		videoClient.EXPECT().GetVideo(gomock.Any(), gomock.Any()).Return(&videopb.GetVideoResponse{}, nil).AnyTimes()
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil,
+			WithUserBlocks(dao.NewMemoryUserBlockDAO()),
+		)
+		ctx = logkit.WithUserID(logkit.NewNopLogger().WithContext(context.Background()), "alice")
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	createComment := func(userID, content string) {
+		_, err := svc.CreateComment(logkit.WithUserID(ctx, userID), &pb.CreateCommentRequest{VideoId: videoID, Content: content})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	listComments := func() []string {
+		resp, err := svc.ListComment(ctx, &pb.ListCommentRequest{VideoId: videoID, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+
+		var contents []string
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("hides the comments of the blocked users from the user only", func() {
+		createComment("bob", "comment of bob")
+		createComment("carol", "comment of carol")
+
+		resp, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: "bob"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetBlock().GetUserId()).To(Equal("bob"))
+
+		Expect(listComments()).To(ConsistOf("comment of carol"))
+
+		ctx = logkit.WithUserID(ctx, "carol")
+		Expect(listComments()).To(ConsistOf("comment of bob", "comment of carol"))
+	})
+
+	It("shows the comments of the users unblocked again", func() {
+		createComment("bob", "comment of bob")
+
+		_, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: "bob"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listComments()).To(BeEmpty())
+
+		_, err = svc.UnblockUser(ctx, &pb.UnblockUserRequest{UserId: "bob"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listComments()).To(ConsistOf("comment of bob"))
+	})
+
+	It("lists the blocked users", func() {
+		for _, userID := range []string{"bob", "carol"} {
+			_, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: userID})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		resp, err := svc.ListBlockedUsers(ctx, &pb.ListBlockedUsersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetBlocks()).To(HaveLen(2))
+	})
+
+	It("records the author of the comments", func() {
+		createComment("bob", "comment of bob")
+
+		resp, err := svc.ListComment(ctx, &pb.ListCommentRequest{VideoId: videoID, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComments()).To(HaveLen(1))
+		Expect(resp.GetComments()[0].GetUserId()).To(Equal("bob"))
+	})
+
+	It("returns ErrBlockSelf if the users block themselves", func() {
+		_, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: "alice"})
+		Expect(err).To(MatchError(ErrBlockSelf))
+	})
+
+	It("returns ErrUserIDRequired without the user", func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		_, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: "bob"})
+		Expect(err).To(MatchError(ErrUserIDRequired))
+	})
+
+	It("returns ErrUserBlocksDisabled without the DAO", func() {
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil)
+
+		_, err := svc.BlockUser(ctx, &pb.BlockUserRequest{UserId: "bob"})
+		Expect(err).To(MatchError(ErrUserBlocksDisabled))
+	})
+})
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/contractkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialContractServer serves the server on an in-memory listener and returns the connection to it.
+func dialContractServer(ctx context.Context, serve func(lis net.Listener) error, stop func()) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = serve(lis)
+	}()
+	DeferCleanup(stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	return conn
+}
+
+var _ = Describe("Contract", func() {
+	var (
+		ctx        context.Context
+		commentDAO dao.CommentDAO
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		commentDAO = dao.NewMemoryCommentDAO()
+	})
+
+	Describe("the comment contract of the video service", func() {
+		var (
+			contract *contractkit.Contract
+			conn     *grpc.ClientConn
+		)
+
+		BeforeEach(func() {
+			var err error
+			contract, err = contractkit.Load("../../video/contract/comment.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			server := serverkit.NewGrpcServer(ctx, &serverkit.GrpcServerConfig{}, serverkit.WithErrorMappings(ErrorMappings()...))
+			pb.RegisterCommentServer(server, NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil))
+			conn = dialContractServer(ctx, server.Serve, server.Stop)
+		})
+
+		It("is honored by the comment service", func() {
+			Expect(contractkit.Verify(ctx, conn, contract, map[string]contractkit.StateFunc{
+				"video 62a1f0c2e4b0a1b2c3d4e5f6 has comments": func(ctx context.Context) error {
+					_, err := commentDAO.Create(ctx, dao.NewFakeComment("62a1f0c2e4b0a1b2c3d4e5f6"))
+					return err
+				},
+			})).To(Succeed())
+		})
+	})
+
+	Describe("the video contract of the comment service", func() {
+		var svc *service
+
+		BeforeEach(func() {
+			contract, err := contractkit.Load("../contract/video.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			server := contractkit.NewStubServer(contract)
+			videoClient := videopb.NewVideoClient(dialContractServer(ctx, server.Serve, server.Stop))
+			svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), videoClient, nil)
+		})
+
+		DescribeTable("covers the calls of CreateComment",
+			func(videoID string, code codes.Code) {
+				_, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{VideoId: videoID, Content: "content"})
+				Expect(status.Code(err)).To(Equal(code))
+			},
+			Entry("existing video", "62a1f0c2e4b0a1b2c3d4e5f6", codes.OK),
+			Entry("missing video", "62a1f0c2e4b0a1b2c3d4e5f7", codes.NotFound),
+			Entry("malformed video ID", "not a video ID", codes.InvalidArgument),
+		)
+	})
+})
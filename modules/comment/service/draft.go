@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// WithCommentDrafts serves the drafts of the comments of the users by the DAO. It is a no-op if the DAO is nil.
+func WithCommentDrafts(commentDraftDAO dao.CommentDraftDAO) ServiceOption {
+	return func(s *service) {
+		if commentDraftDAO != nil {
+			s.commentDraftDAO = commentDraftDAO
+		}
+	}
+}
+
+func (s *service) SaveCommentDraft(ctx context.Context, req *pb.SaveCommentDraftRequest) (*pb.SaveCommentDraftResponse, error) {
+	userID, err := s.commentDraftUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &dao.CommentDraft{
+		VideoID: req.GetVideoId(),
+		Content: req.GetContent(),
+	}
+	if err := s.commentDraftDAO.Save(ctx, userID, draft); err != nil {
+		return nil, err
+	}
+
+	return &pb.SaveCommentDraftResponse{Draft: draft.ToProto()}, nil
+}
+
+func (s *service) GetCommentDraft(ctx context.Context, req *pb.GetCommentDraftRequest) (*pb.GetCommentDraftResponse, error) {
+	userID, err := s.commentDraftUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	draft, err := s.commentDraftDAO.Get(ctx, userID, req.GetVideoId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCommentDraftResponse{Draft: draft.ToProto()}, nil
+}
+
+// commentDraftUserID returns the user of the drafts, i.e. the user of the X-User-Id header.
+func (s *service) commentDraftUserID(ctx context.Context) (string, error) {
+	if s.commentDraftDAO == nil {
+		return "", ErrCommentDraftsDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return "", ErrUserIDRequired
+	}
+
+	return userID, nil
+}
+
+// deleteCommentDraft deletes the draft promoted to a comment unless the draft is saved again since, so the edits of
+// another page are kept. The failure is only logged since the comment is created, and the draft expires anyway.
+func (s *service) deleteCommentDraft(ctx context.Context, videoID, draftID string) {
+	userID, err := s.commentDraftUserID(ctx)
+	if err != nil {
+		return
+	}
+
+	id, err := uuid.Parse(draftID)
+	if err != nil {
+		return
+	}
+
+	if err := s.commentDraftDAO.Delete(ctx, userID, videoID, id); err != nil {
+		logkit.FromContext(ctx).Warn("failed to delete comment draft", zap.Error(err))
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("CommentDraft", func() {
+	var (
+		controller  *gomock.Controller
+		videoClient *videopbmock.MockVideoClient
+		svc         *service
+		ctx         context.Context
+		videoID     string
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		videoClient = videopbmock.NewMockVideoClient(controller)
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil,
+			WithCommentDrafts(dao.NewMemoryCommentDraftDAO(time.Hour)),
+		)
+		ctx = logkit.WithUserID(logkit.NewNopLogger().WithContext(context.Background()), "user")
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	saveDraft := func(content string) *pb.CommentDraft {
+		resp, err := svc.SaveCommentDraft(ctx, &pb.SaveCommentDraftRequest{VideoId: videoID, Content: content})
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp.GetDraft()
+	}
+
+	getDraft := func() (*pb.CommentDraft, error) {
+		resp, err := svc.GetCommentDraft(ctx, &pb.GetCommentDraftRequest{VideoId: videoID})
+		return resp.GetDraft(), err
+	}
+
+	createComment := func(draftID string) {
+		videoClient.EXPECT().GetVideo(ctx, &videopb.GetVideoRequest{Id: videoID}).Return(&videopb.GetVideoResponse{}, nil)
+
+		_, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{VideoId: videoID, Content: "comment", DraftId: draftID})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("gets the draft saved by the user", func() {
+		saved := saveDraft("draft")
+		Expect(saved.GetExpiresAt().AsTime()).To(BeTemporally("~", saved.GetUpdatedAt().AsTime().Add(time.Hour)))
+
+		draft, err := getDraft()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(draft.GetId()).To(Equal(saved.GetId()))
+		Expect(draft.GetContent()).To(Equal("draft"))
+	})
+
+	It("returns ErrCommentDraftNotFound if the user has no draft on the video", func() {
+		saveDraft("draft")
+		ctx = logkit.WithUserID(ctx, "another user")
+
+		_, err := getDraft()
+		Expect(err).To(MatchError(dao.ErrCommentDraftNotFound))
+	})
+
+	It("deletes the draft promoted to a comment", func() {
+		draft := saveDraft("draft")
+		createComment(draft.GetId())
+
+		_, err := getDraft()
+		Expect(err).To(MatchError(dao.ErrCommentDraftNotFound))
+	})
+
+	It("keeps the draft saved again since it is promoted", func() {
+		draft := saveDraft("draft")
+		saveDraft("saved again")
+
+		createComment(draft.GetId())
+
+		saved, err := getDraft()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved.GetContent()).To(Equal("saved again"))
+	})
+
+	It("returns ErrUserIDRequired without the user", func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		_, err := getDraft()
+		Expect(err).To(MatchError(ErrUserIDRequired))
+	})
+
+	It("returns ErrCommentDraftsDisabled without the DAO", func() {
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil)
+
+		_, err := getDraft()
+		Expect(err).To(MatchError(ErrCommentDraftsDisabled))
+	})
+})
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DuplicateActionReject rejects the near-duplicate comments by ErrDuplicateComment
+	DuplicateActionReject = "reject"
+	// DuplicateActionCollapse collapses the near-duplicate comments into the earlier comments, which are returned as
+	// created with their collapsed counts added
+	DuplicateActionCollapse = "collapse"
+)
+
+type DuplicateConfig struct {
+	Window time.Duration `long:"window" env:"WINDOW" description:"the time a comment of a user is compared with the later comments of the user on the same video, the duplicates are not detected if zero" default:"0s"`
+	Action string        `long:"action" env:"ACTION" description:"the action on the near-duplicate comments" choice:"reject" choice:"collapse" default:"reject"`
+}
+
+// WithDuplicateDetection detects the near-duplicate comments of the users by the fingerprints of the DAO, which are
+// kept for the duplicate window, and takes the action on them. It is a no-op if the DAO is nil.
+func WithDuplicateDetection(commentFingerprintDAO dao.CommentFingerprintDAO, action string) ServiceOption {
+	return func(s *service) {
+		if commentFingerprintDAO != nil {
+			s.commentFingerprintDAO = commentFingerprintDAO
+			s.duplicateAction = action
+		}
+	}
+}
+
+// claimFingerprint claims the fingerprint of the comment for it under a new ID, and returns the release of the claim
+// if the comment is not created. If the fingerprint is claimed by an earlier comment of the user, the comment is
+// rejected, or the earlier comment is returned with the comment collapsed into it. The earlier comment may be deleted
+// or not created yet, so the comment is rejected if it is not found either. The users are told apart only behind
+// a service verified by mTLS, the comments of the other peers are compared with the other comments of the same peer,
+// and the comments of an anonymous caller are never duplicates.
+func (s *service) claimFingerprint(ctx context.Context, comment *dao.Comment) (*dao.Comment, func(), error) {
+	noop := func() {}
+
+	userID := duplicateUserID(ctx)
+	if s.commentFingerprintDAO == nil || userID == "" {
+		return nil, noop, nil
+	}
+
+	comment.ID = uuid.New()
+	fingerprint := commentFingerprint(comment)
+
+	claimedID, err := s.commentFingerprintDAO.Claim(ctx, userID, comment.VideoID, fingerprint, comment.ID)
+	if err != nil {
+		return nil, noop, err
+	}
+
+	if claimedID == comment.ID {
+		return nil, func() {
+			if err := s.commentFingerprintDAO.Release(ctx, userID, comment.VideoID, fingerprint, comment.ID); err != nil {
+				logkit.FromContext(ctx).Error("failed to release comment fingerprint", zap.Error(err))
+			}
+		}, nil
+	}
+
+	if s.duplicateAction != DuplicateActionCollapse {
+		return nil, noop, ErrDuplicateComment
+	}
+
+	err = s.commentDAO.Collapse(ctx, claimedID)
+	if errors.Is(err, dao.ErrCommentNotFound) {
+		return nil, noop, ErrDuplicateComment
+	}
+	if err != nil {
+		return nil, noop, err
+	}
+
+	duplicate, err := s.commentDAO.Get(ctx, claimedID)
+	if err != nil {
+		return nil, noop, err
+	}
+
+	return duplicate, noop, nil
+}
+
+// duplicateUserID returns the principal of the caller, see grpckit.Principal, so the callers can't skip the detection
+// by changing the unverified user ID on every comment. It is empty for an anonymous caller.
+func duplicateUserID(ctx context.Context) string {
+	if principal := grpckit.Principal(ctx); principal != grpckit.AnonymousPrincipal {
+		return principal
+	}
+
+	return ""
+}
+
+// commentFingerprint returns the hash of the thread and the normalized content of the comment, the content is
+// normalized by lowering the cases and dropping the spaces and the punctuations, so "Great video!!" and
+// "great  video" are near-duplicates.
+func commentFingerprint(comment *dao.Comment) string {
+	hash := sha256.New()
+	hash.Write(comment.ParentID[:])
+	hash.Write([]byte(normalizeContent(comment.Content)))
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// normalizeContent lowers the cases of the content and drops the spaces and the punctuations.
+func normalizeContent(content string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			return -1
+		}
+
+		return unicode.ToLower(r)
+	}, content)
+}
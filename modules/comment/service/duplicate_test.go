@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/pbmock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+var _ = Describe("DuplicateDetection", func() {
+	var (
+		commentDAO dao.CommentDAO
+		action     string
+		svc        *service
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		action = DuplicateActionReject
+		ctx = logkit.WithUserID(servicePeerContext(logkit.NewNopLogger().WithContext(context.Background())), "user")
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	JustBeforeEach(func() {
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil, WithDuplicateDetection(dao.NewMemoryCommentFingerprintDAO(time.Hour), action))
+	})
+
+	createComment := func(ctx context.Context, comment *dao.Comment) error {
+		if comment.VideoID == "" {
+			comment.VideoID = videoID
+		}
+
+		return svc.createComment(ctx, comment)
+	}
+
+	It("rejects the near-duplicate comments of the user", func() {
+		Expect(createComment(ctx, &dao.Comment{Content: "Great video!!"})).To(Succeed())
+
+		Expect(createComment(ctx, &dao.Comment{Content: "great  video"})).To(MatchError(ErrDuplicateComment))
+		Expect(createComment(ctx, &dao.Comment{Content: "Great video, again"})).To(Succeed())
+	})
+
+	It("keeps the comments of the other users, videos and threads apart", func() {
+		comment := &dao.Comment{Content: "Great video"}
+		Expect(createComment(ctx, comment)).To(Succeed())
+
+		Expect(createComment(logkit.WithUserID(ctx, "another user"), &dao.Comment{Content: "Great video"})).To(Succeed())
+		Expect(createComment(ctx, &dao.Comment{VideoID: primitive.NewObjectID().Hex(), Content: "Great video"})).To(Succeed())
+		Expect(createComment(ctx, &dao.Comment{ParentID: comment.ID, Content: "Great video"})).To(Succeed())
+	})
+
+	It("keys the users of the peers not verified by mTLS on the peers", func() {
+		ctx := peer.NewContext(logkit.NewNopLogger().WithContext(context.Background()), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}})
+
+		Expect(createComment(logkit.WithUserID(ctx, "user"), &dao.Comment{Content: "Great video"})).To(Succeed())
+
+		Expect(createComment(logkit.WithUserID(ctx, "another user"), &dao.Comment{Content: "Great video"})).To(MatchError(ErrDuplicateComment))
+	})
+
+	It("rejects the near-duplicate comments streamed", func() {
+		stream := pbmock.NewMockComment_StreamCommentsServer(gomock.NewController(GinkgoT()))
+		gomock.InOrder(
+			stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{Data: &pb.StreamCommentsRequest_Content{Content: "Great video!!"}}, nil),
+			stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{Data: &pb.StreamCommentsRequest_Content{Content: "great  video"}}, nil),
+		)
+
+		Expect(svc.receiveComments(ctx, stream, videoID, &sync.Map{})).To(MatchError(ErrDuplicateComment))
+	})
+
+	It("detects the duplicates of the peer without the user", func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		peerCtx := func(ip string) context.Context {
+			return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+		}
+
+		Expect(createComment(peerCtx("10.0.0.1"), &dao.Comment{Content: "Great video"})).To(Succeed())
+
+		Expect(createComment(peerCtx("10.0.0.1"), &dao.Comment{Content: "Great video"})).To(MatchError(ErrDuplicateComment))
+		Expect(createComment(peerCtx("10.0.0.2"), &dao.Comment{Content: "Great video"})).To(Succeed())
+	})
+
+	It("never detects the duplicates of an anonymous caller", func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+
+		Expect(createComment(ctx, &dao.Comment{Content: "Great video"})).To(Succeed())
+		Expect(createComment(ctx, &dao.Comment{Content: "Great video"})).To(Succeed())
+	})
+
+	When("the duplicates are collapsed", func() {
+		BeforeEach(func() {
+			action = DuplicateActionCollapse
+		})
+
+		It("returns the earlier comment with the duplicates collapsed into it", func() {
+			comment := &dao.Comment{Content: "Great video!!"}
+			Expect(createComment(ctx, comment)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				duplicate := &dao.Comment{Content: "great video"}
+				Expect(createComment(ctx, duplicate)).To(Succeed())
+				Expect(duplicate.ID).To(Equal(comment.ID))
+				Expect(duplicate.Content).To(Equal("Great video!!"))
+			}
+
+			// list without the user, so the video is not checked for restricted mode
+			resp, err := svc.ListComment(logkit.NewNopLogger().WithContext(context.Background()), &pb.ListCommentRequest{VideoId: videoID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetComments()).To(HaveLen(1))
+			Expect(resp.GetComments()[0].GetCollapsedCount()).To(Equal(int64(2)))
+		})
+
+		It("rejects the duplicates of the earlier comment deleted", func() {
+			comment := &dao.Comment{Content: "Great video"}
+			Expect(createComment(ctx, comment)).To(Succeed())
+			Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+
+			Expect(createComment(ctx, &dao.Comment{Content: "Great video"})).To(MatchError(ErrDuplicateComment))
+		})
+	})
+
+	It("hashes the thread and the normalized content", func() {
+		parentID := uuid.New()
+
+		Expect(commentFingerprint(&dao.Comment{Content: "Great, VIDEO!"})).To(Equal(commentFingerprint(&dao.Comment{Content: "great video"})))
+		Expect(commentFingerprint(&dao.Comment{Content: "great video"})).NotTo(Equal(commentFingerprint(&dao.Comment{Content: "great videos"})))
+		Expect(commentFingerprint(&dao.Comment{ParentID: parentID, Content: "great video"})).NotTo(Equal(commentFingerprint(&dao.Comment{Content: "great video"})))
+	})
+})
+
+// servicePeerContext returns the context of a request from a service verified by mTLS, which tells its users apart.
+func servicePeerContext(ctx context.Context) context.Context {
+	id, err := url.Parse("spiffe://nthu-distributed-system/video-gateway")
+	Expect(err).NotTo(HaveOccurred())
+
+	return peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.10"), Port: 50000},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{id}}}},
+		},
+	})
+}
@@ -1,11 +1,66 @@
 package service
 
 import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
+// errorDomain is the domain of the ErrorInfo of the comment service errors
+const errorDomain = "comment.nthu-distributed-system"
+
 var (
-	ErrInvalidUUID     = status.Errorf(codes.InvalidArgument, "invalid UUID")
-	ErrCommentNotFound = status.Errorf(codes.NotFound, "comment not found")
+	ErrInvalidUUID          = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_UUID", "id", "invalid UUID")
+	ErrCommentNotFound      = grpckit.NewError(codes.NotFound, errorDomain, "COMMENT_NOT_FOUND", "comment not found")
+	ErrCommentAlreadyExists = grpckit.NewError(codes.AlreadyExists, errorDomain, "COMMENT_ALREADY_EXISTS", "comment already exists")
+	ErrEmptyVideoID         = grpckit.NewInvalidArgumentError(errorDomain, "EMPTY_VIDEO_ID", "video_id", "empty video ID")
+	ErrEmptyBackupName      = grpckit.NewInvalidArgumentError(errorDomain, "EMPTY_BACKUP_NAME", "name", "empty backup name")
+	ErrBackupNotFound       = grpckit.NewError(codes.NotFound, errorDomain, "BACKUP_NOT_FOUND", "backup not found")
+	ErrNotSubscribed        = grpckit.NewInvalidArgumentError(errorDomain, "NOT_SUBSCRIBED", "video_id", "subscribe to a video first")
+	ErrAlreadySubscribed    = grpckit.NewInvalidArgumentError(errorDomain, "ALREADY_SUBSCRIBED", "video_id", "already subscribed to a video")
+	ErrInvalidParent        = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_PARENT", "parent_id", "parent comment not found in the video")
+	ErrInvalidPageToken     = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_PAGE_TOKEN", "page_token", "invalid page token")
+	ErrExpiredPageToken     = grpckit.NewInvalidArgumentError(errorDomain, "EXPIRED_PAGE_TOKEN", "page_token", "expired page token, list from the first page again")
+	ErrSentimentAsOf        = grpckit.NewInvalidArgumentError(errorDomain, "SENTIMENT_AS_OF", "as_of", "the comments as of a time cannot be filtered or sorted by sentiment")
+	ErrPageTokensDisabled   = grpckit.NewError(codes.FailedPrecondition, errorDomain, "PAGE_TOKENS_DISABLED", "page tokens are disabled")
+
+	ErrContentBlocked             = grpckit.NewInvalidArgumentError(errorDomain, "CONTENT_BLOCKED", "content", "content contains banned words")
+	ErrInvalidBannedPattern       = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_BANNED_PATTERN", "pattern", "invalid banned pattern")
+	ErrBannedPatternNotFound      = grpckit.NewError(codes.NotFound, errorDomain, "BANNED_PATTERN_NOT_FOUND", "banned pattern not found")
+	ErrBannedPatternAlreadyExists = grpckit.NewError(codes.AlreadyExists, errorDomain, "BANNED_PATTERN_ALREADY_EXISTS", "banned pattern already exists")
+	ErrBannedPatternsDisabled     = grpckit.NewError(codes.FailedPrecondition, errorDomain, "BANNED_PATTERNS_DISABLED", "banned patterns are disabled")
+
+	ErrCommentDraftNotFound  = grpckit.NewError(codes.NotFound, errorDomain, "COMMENT_DRAFT_NOT_FOUND", "comment draft not found")
+	ErrCommentDraftsDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "COMMENT_DRAFTS_DISABLED", "comment drafts are disabled")
+	ErrUserIDRequired        = grpckit.NewError(codes.Unauthenticated, errorDomain, "USER_ID_REQUIRED", "the X-User-Id header is required")
+
+	ErrSemanticSearchDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "SEMANTIC_SEARCH_DISABLED", "semantic search is disabled")
+
+	ErrDuplicateComment = grpckit.NewError(codes.AlreadyExists, errorDomain, "DUPLICATE_COMMENT", "a near-duplicate comment is created recently")
+
+	ErrLikesDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "LIKES_DISABLED", "likes are disabled")
+
+	ErrUserBlockNotFound      = grpckit.NewError(codes.NotFound, errorDomain, "USER_BLOCK_NOT_FOUND", "user not blocked")
+	ErrUserBlockAlreadyExists = grpckit.NewError(codes.AlreadyExists, errorDomain, "USER_BLOCK_ALREADY_EXISTS", "user already blocked")
+	ErrBlockSelf              = grpckit.NewInvalidArgumentError(errorDomain, "BLOCK_SELF", "user_id", "users cannot block themselves")
+	ErrUserBlocksDisabled     = grpckit.NewError(codes.FailedPrecondition, errorDomain, "USER_BLOCKS_DISABLED", "user blocks are disabled")
+
+	ErrLinkReputationRequired  = grpckit.NewError(codes.PermissionDenied, errorDomain, "LINK_REPUTATION_REQUIRED", "the reputation of the user is too low to post links")
+	ErrUserReputationsDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "USER_REPUTATIONS_DISABLED", "user reputations are disabled")
 )
+
+// ErrorMappings maps the errors returned by the handlers to the service errors,
+// it is used by the error interceptors of the gRPC server.
+func ErrorMappings() []grpckit.ErrorMapping {
+	return []grpckit.ErrorMapping{
+		{Err: dao.ErrCommentNotFound, Status: ErrCommentNotFound},
+		{Err: dao.ErrCommentAlreadyExists, Status: ErrCommentAlreadyExists},
+		{Err: storagekit.ErrObjectNotFound, Status: ErrBackupNotFound},
+		{Err: dao.ErrBannedPatternNotFound, Status: ErrBannedPatternNotFound},
+		{Err: dao.ErrBannedPatternAlreadyExists, Status: ErrBannedPatternAlreadyExists},
+		{Err: dao.ErrCommentDraftNotFound, Status: ErrCommentDraftNotFound},
+		{Err: dao.ErrUserBlockNotFound, Status: ErrUserBlockNotFound},
+		{Err: dao.ErrUserBlockAlreadyExists, Status: ErrUserBlockAlreadyExists},
+	}
+}
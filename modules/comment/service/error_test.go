@@ -0,0 +1,31 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorMappings", func() {
+	DescribeTable("maps the error to the service error",
+		func(err error, expected error) {
+			Expect(grpckit.MapError(err, ErrorMappings()...)).To(MatchError(expected))
+		},
+		Entry("comment not found", dao.ErrCommentNotFound, ErrCommentNotFound),
+		Entry("comment already exists", fmt.Errorf("comment 1: %w", dao.ErrCommentAlreadyExists), ErrCommentAlreadyExists),
+		Entry("backup not found", storagekit.ErrObjectNotFound, ErrBackupNotFound),
+		Entry("banned pattern not found", dao.ErrBannedPatternNotFound, ErrBannedPatternNotFound),
+		Entry("user block already exists", dao.ErrUserBlockAlreadyExists, ErrUserBlockAlreadyExists),
+		Entry("service error", ErrInvalidUUID, ErrInvalidUUID),
+	)
+
+	It("returns unknown error as is", func() {
+		err := errors.New("unknown")
+		Expect(grpckit.MapError(err, ErrorMappings()...)).To(Equal(err))
+	})
+})
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// the flows run the service against the in-memory DAOs, so they check the state the calls leave behind
+// instead of the calls the service makes to the DAOs
+var _ = Describe("Service flows", func() {
+	var (
+		controller  *gomock.Controller
+		videoClient *videopbmock.MockVideoClient
+		svc         *service
+		ctx         context.Context
+		videoID     string
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		videoClient = videopbmock.NewMockVideoClient(controller)
+		videoClient.EXPECT().GetVideo(gomock.Any(), gomock.Any()).Return(&videopb.GetVideoResponse{}, nil).AnyTimes()
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	list := func(ctx context.Context) []*pb.CommentInfo {
+		resp, err := svc.ListComment(ctx, &pb.ListCommentRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp.GetComments()
+	}
+
+	It("creates, updates and deletes the comments of a video", func() {
+		first, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{VideoId: videoID, Content: "first"})
+		Expect(err).NotTo(HaveOccurred())
+		second, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{VideoId: videoID, Content: "second"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: first.GetId(), Content: "first, updated"})
+		Expect(err).NotTo(HaveOccurred())
+
+		comments := list(ctx)
+		Expect(comments).To(HaveLen(2))
+		Expect(comments[0].GetId()).To(Equal(second.GetId()))
+		Expect(comments[1].GetContent()).To(Equal("first, updated"))
+
+		_, err = svc.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: second.GetId()})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.GetComment(ctx, &pb.GetCommentRequest{Id: second.GetId()})
+		Expect(err).To(MatchError(dao.ErrCommentNotFound))
+
+		_, err = svc.DeleteCommentByVideoID(ctx, &pb.DeleteCommentByVideoIDRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list(ctx)).To(BeEmpty())
+	})
+
+	It("keeps the comments of the tenants apart", func() {
+		_, err := svc.CreateComment(ctx, &pb.CreateCommentRequest{VideoId: videoID, Content: "comment"})
+		Expect(err).NotTo(HaveOccurred())
+
+		anotherCtx := tenantkit.WithTenantID(ctx, "another-tenant")
+		Expect(list(anotherCtx)).To(BeEmpty())
+
+		_, err = svc.DeleteCommentByVideoID(anotherCtx, &pb.DeleteCommentByVideoIDRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list(ctx)).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/contractkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/goldenkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The REST responses of the gateway are what the mobile clients parse, so the field names, the enum encoding
+// and the error shapes are pinned by the golden files of testdata/gateway. A change of the protos changing them
+// fails here, and a change on purpose is recorded by GOLDEN_UPDATE=1.
+var _ = Describe("Gateway", func() {
+	// the video exists in the video contract of the comment service
+	const videoID = "62a1f0c2e4b0a1b2c3d4e5f6"
+
+	// the fixtures are the same in every spec, so the routes of the entries refer to them, the last one is a reply
+	newFixtures := func() []*dao.Comment {
+		return dao.NewCommentFixtures(fixturekit.NewGenerator(1), videoID, fixturekit.Epoch, 5)
+	}
+	commentID := newFixtures()[0].ID.String()
+
+	var (
+		ctx context.Context
+		mux *runtime.ServeMux
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		commentDAO := dao.NewMemoryCommentDAO()
+		_, err := commentDAO.BulkImport(ctx, newFixtures())
+		Expect(err).NotTo(HaveOccurred())
+
+		contract, err := contractkit.Load("../contract/video.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		videoServer := contractkit.NewStubServer(contract)
+		videoClient := videopb.NewVideoClient(dialContractServer(ctx, videoServer.Serve, videoServer.Stop))
+
+		svc := NewService(commentDAO, dao.NewMemoryCommentPubSub(), videoClient, nil)
+		server := serverkit.NewGrpcServer(ctx, &serverkit.GrpcServerConfig{}, serverkit.WithErrorMappings(ErrorMappings()...))
+		pb.RegisterCommentServer(server, svc)
+		pbv2.RegisterCommentServer(server, NewServiceV2(svc, pagekit.NewCodec(ctx, &pagekit.Config{Secret: "secret of the golden files", TTL: time.Hour})))
+		conn := dialContractServer(ctx, server.Serve, server.Stop)
+
+		mux = grpckit.NewGatewayMux(tenantkit.HeaderMatcher)
+		Expect(pb.RegisterCommentHandler(ctx, mux, conn)).To(Succeed())
+		Expect(pbv2.RegisterCommentHandler(ctx, mux, conn)).To(Succeed())
+	})
+
+	DescribeTable("serves the responses of the golden files",
+		func(golden, method, target, body string, redactedFields ...string) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+			Expect(goldenkit.CompareResponse("testdata/gateway/"+golden+".json", rec.Result(), redactedFields...)).To(Succeed())
+		},
+		Entry("list comments", "list_comments", http.MethodGet, "/v1/comments/"+videoID, ""),
+		Entry("list comments in HTML", "list_comments_html", http.MethodGet, "/v1/comments/"+videoID+"?limit=1&render_format=RENDER_FORMAT_HTML", ""),
+		Entry("create a comment", "create_comment", http.MethodPost, "/v1/comments", `{"videoId":"`+videoID+`","content":"**first**"}`, "id"),
+		Entry("create an empty comment", "create_comment_empty", http.MethodPost, "/v1/comments", `{"videoId":"`+videoID+`","content":""}`),
+		Entry("delete a missing comment", "delete_comment_not_found", http.MethodDelete, "/v1/comments/00000000-0000-4000-8000-000000000000", ""),
+		Entry("list comments v2", "list_comments_v2", http.MethodGet, "/v2/videos/"+videoID+"/comments?page_size=2", "", "nextPageToken"),
+		Entry("get a comment v2", "get_comment_v2", http.MethodGet, "/v2/comments/"+commentID, ""),
+		Entry("get a comment of a malformed ID v2", "get_comment_v2_malformed_id", http.MethodGet, "/v2/comments/not-a-uuid", ""),
+		Entry("unknown route", "unknown_route", http.MethodGet, "/v1/unknown", ""),
+	)
+})
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+// ListPendingComments pages the pending comments by their keyset rather than an offset, since the comments moderated
+// leave the list between the pages, which would shift the offsets of the rest.
+func (s *service) ListPendingComments(ctx context.Context, req *pb.ListPendingCommentsRequest) (*pb.ListPendingCommentsResponse, error) {
+	if s.pageTokens == nil {
+		return nil, ErrPageTokensDisabled
+	}
+
+	filter := pendingPageTokenFilter(tenantkit.FromContext(ctx))
+
+	after, err := s.decodePendingPageToken(req.GetPageToken(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// list one more comment to know whether there is a next page
+	comments, err := s.commentDAO.ListPending(ctx, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPendingCommentsResponse{}
+
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+
+		if resp.NextPageToken, err = s.encodePendingPageToken(filter, comments[pageSize-1].PendingCursor()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Comments = make([]*pb.CommentInfo, 0, len(comments))
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, comment.ToProto())
+	}
+
+	return resp, nil
+}
+
+// ApproveComment publishes the comment, which is listed at the position of its update time again. Approving
+// a published comment is a no-op.
+func (s *service) ApproveComment(ctx context.Context, req *pb.ApproveCommentRequest) (*pb.ApproveCommentResponse, error) {
+	commentID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidUUID
+	}
+
+	if err := s.commentDAO.Approve(ctx, commentID); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.commentDAO.Get(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveCommentResponse{Comment: comment.ToProto()}, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/google/uuid"
+)
+
+// pageToken is the cursor of the next page, which is opaque to the clients.
+type pageToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// pageTokenFilter binds the page tokens to the thread listed,
+// so the token of a thread cannot be replayed to list another one.
+func pageTokenFilter(videoID string, parentID uuid.UUID) string {
+	return videoID + "/" + parentID.String()
+}
+
+func (s *serviceV2) encodePageToken(videoID string, parentID uuid.UUID, cursor *dao.Cursor) (string, error) {
+	return s.pageTokens.Encode(pageTokenFilter(videoID, parentID), &pageToken{
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID,
+	})
+}
+
+// decodePageToken returns nil for the empty token of the first page.
+func (s *serviceV2) decodePageToken(token, videoID string, parentID uuid.UUID) (*dao.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var t pageToken
+	if err := s.pageTokens.Decode(token, pageTokenFilter(videoID, parentID), &t); err != nil {
+		if errors.Is(err, pagekit.ErrExpiredToken) {
+			return nil, ErrExpiredPageToken
+		}
+
+		return nil, ErrInvalidPageToken
+	}
+
+	if t.ID == uuid.Nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &dao.Cursor{
+		CreatedAt: t.CreatedAt,
+		ID:        t.ID,
+	}, nil
+}
+
+// pendingPageToken is the cursor of the next page of the pending comments, which are listed the most toxic first.
+type pendingPageToken struct {
+	Toxicity  float64   `json:"toxicity"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// pendingPageTokenFilter binds the page tokens of the pending comments to the tenant listed.
+func pendingPageTokenFilter(tenantID string) string {
+	return "pending/" + tenantID
+}
+
+func (s *service) encodePendingPageToken(filter string, cursor *dao.PendingCursor) (string, error) {
+	return s.pageTokens.Encode(filter, &pendingPageToken{
+		Toxicity:  cursor.Toxicity,
+		CreatedAt: cursor.CreatedAt,
+		ID:        cursor.ID,
+	})
+}
+
+// decodePendingPageToken returns nil for the empty token of the first page.
+func (s *service) decodePendingPageToken(token, filter string) (*dao.PendingCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	var t pendingPageToken
+	if err := s.pageTokens.Decode(token, filter, &t); err != nil {
+		if errors.Is(err, pagekit.ErrExpiredToken) {
+			return nil, ErrExpiredPageToken
+		}
+
+		return nil, ErrInvalidPageToken
+	}
+
+	if t.ID == uuid.Nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &dao.PendingCursor{
+		Toxicity:  t.Toxicity,
+		CreatedAt: t.CreatedAt,
+		ID:        t.ID,
+	}, nil
+}
+
+// offsetPageToken is the cursor of the next page of a ranked list. The ranks change as the comments are engaged, so
+// there is no key to resume from, and the lists are paged by the offsets in the tokens instead.
+type offsetPageToken struct {
+	Offset int `json:"offset"`
+}
+
+// topPageTokenFilter binds the page tokens of the top comments to the video listed.
+func topPageTokenFilter(videoID string) string {
+	return "top/" + videoID
+}
+
+func (s *service) encodeOffsetPageToken(filter string, offset int) (string, error) {
+	return s.pageTokens.Encode(filter, &offsetPageToken{Offset: offset})
+}
+
+// decodeOffsetPageToken returns zero for the empty token of the first page.
+func (s *service) decodeOffsetPageToken(token, filter string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var t offsetPageToken
+	if err := s.pageTokens.Decode(token, filter, &t); err != nil {
+		if errors.Is(err, pagekit.ErrExpiredToken) {
+			return 0, ErrExpiredPageToken
+		}
+
+		return 0, ErrInvalidPageToken
+	}
+
+	if t.Offset <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return t.Offset, nil
+}
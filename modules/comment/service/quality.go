@@ -0,0 +1,32 @@
+package service
+
+import (
+	"math"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+)
+
+// qualityWords is the number of the words of a content earning 1 - 1/e of the quality by length, the longer contents
+// earn less and less of the rest
+const qualityWords = 20
+
+// scoreQuality sets the quality score of the content of the comment to be stored along with it, the score is by the
+// length of the content in words times the share of the distinct words, so the short contents and the contents
+// repeating the same words score low. The likes are added to the score as the comments are listed by quality.
+func scoreQuality(comment *dao.Comment) {
+	words := commentWords(comment.Content)
+	if len(words) == 0 {
+		comment.Quality = 0
+		return
+	}
+
+	distinct := make(map[string]bool, len(words))
+	for _, word := range words {
+		distinct[word] = true
+	}
+
+	length := 1 - math.Exp(-float64(len(words))/qualityWords)
+	variety := float64(len(distinct)) / float64(len(words))
+
+	comment.Quality = length * variety
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+const (
+	// RankingRecent lists the comments in the order of update, it is the ranking of the users out of the experiment
+	RankingRecent = "recent"
+	// RankingQuality lists the comments in the order of their quality scores, see dao.Comment.QualityScore
+	RankingQuality = "quality"
+)
+
+var ErrInvalidRankingExperiment = errors.New("invalid ranking experiment")
+
+type RankingConfig struct {
+	Experiment map[string]int `long:"experiment" env:"EXPERIMENT" env-delim:"," description:"the percentages of the users whose comments are ranked by each strategy, e.g. quality:10, the others are ranked by recent"`
+}
+
+// Validate reports whether the percentages are valid.
+func (c *RankingConfig) Validate() error {
+	total := 0
+	for name, percentage := range c.Experiment {
+		if percentage < 0 {
+			return fmt.Errorf("%w: negative percentage of %q", ErrInvalidRankingExperiment, name)
+		}
+
+		total += percentage
+	}
+
+	if total > 100 {
+		return fmt.Errorf("%w: the percentages sum to %d", ErrInvalidRankingExperiment, total)
+	}
+
+	return nil
+}
+
+// RankingStrategy lists the comments of a video in an order of its own. The ListComment requests of the default order
+// are ranked by the strategy of the ranking experiment assigned to their users.
+type RankingStrategy interface {
+	Rank(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error)
+}
+
+// RankingStrategyFunc is a RankingStrategy of a function.
+type RankingStrategyFunc func(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error)
+
+func (f RankingStrategyFunc) Rank(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error) {
+	return f(ctx, commentDAO, videoID, limit, offset)
+}
+
+// defaultRankingStrategies are the strategies registered to every service.
+func defaultRankingStrategies() map[string]RankingStrategy {
+	return map[string]RankingStrategy{
+		RankingRecent: RankingStrategyFunc(func(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error) {
+			return commentDAO.ListByVideoID(ctx, videoID, limit, offset)
+		}),
+		RankingQuality: RankingStrategyFunc(func(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error) {
+			return commentDAO.ListByQuality(ctx, videoID, limit, offset)
+		}),
+	}
+}
+
+// WithRankingStrategy registers the strategy under the name, so the ranking experiment assigns the users to it by the
+// name. It is a no-op if the strategy is nil.
+func WithRankingStrategy(name string, strategy RankingStrategy) ServiceOption {
+	return func(s *service) {
+		if strategy != nil {
+			s.rankingStrategies[name] = strategy
+		}
+	}
+}
+
+// WithRankingExperiment ranks the comments of the users by the strategies assigned by the experiment. It is a no-op if
+// the experiment is nil, and the comments are ranked by recent then.
+func WithRankingExperiment(experiment *RankingExperiment) ServiceOption {
+	return func(s *service) {
+		if experiment != nil {
+			s.rankingExperiment = experiment
+		}
+	}
+}
+
+// rankingArm is the strategy of the users of the buckets below the bound.
+type rankingArm struct {
+	name  string
+	bound uint32
+}
+
+// RankingExperiment assigns the users to the ranking strategies by the percentages of the config. A user is assigned
+// by the hash of the user ID, so a user is ranked by the same strategy on every replica as long as the percentages do
+// not change, and the requests without a user are ranked by recent.
+type RankingExperiment struct {
+	mu   sync.RWMutex
+	arms []rankingArm
+}
+
+func NewRankingExperiment(ctx context.Context, conf *RankingConfig) *RankingExperiment {
+	logger := logkit.FromContext(ctx)
+
+	experiment := &RankingExperiment{}
+	if err := experiment.SetConfig(conf); err != nil {
+		logger.Fatal("failed to parse ranking experiment", zap.Error(err))
+	}
+
+	return experiment
+}
+
+// SetConfig replaces the percentages at runtime, the users are assigned again by the new ones.
+func (e *RankingExperiment) SetConfig(conf *RankingConfig) error {
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(conf.Experiment))
+	for name := range conf.Experiment {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	arms := make([]rankingArm, 0, len(names))
+	bound := uint32(0)
+	for _, name := range names {
+		bound += uint32(conf.Experiment[name])
+		arms = append(arms, rankingArm{name: name, bound: bound})
+	}
+
+	e.mu.Lock()
+	e.arms = arms
+	e.mu.Unlock()
+
+	return nil
+}
+
+// Assign returns the name of the strategy of the user of the context.
+func (e *RankingExperiment) Assign(ctx context.Context) string {
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return RankingRecent
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(userID))
+	bucket := hash.Sum32() % 100
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	for _, arm := range e.arms {
+		if bucket < arm.bound {
+			return arm.name
+		}
+	}
+
+	return RankingRecent
+}
+
+// rankComments lists the comments of the video by the strategy of the user of the context, and returns the name of
+// the strategy along with them. The strategies not registered fall back to recent, so an experiment of a strategy not
+// deployed yet does not break the lists.
+func (s *service) rankComments(ctx context.Context, videoID string, limit, offset int) (string, []*dao.Comment, error) {
+	ranking := RankingRecent
+	if s.rankingExperiment != nil {
+		ranking = s.rankingExperiment.Assign(ctx)
+	}
+
+	strategy, ok := s.rankingStrategies[ranking]
+	if !ok {
+		logkit.FromContext(ctx).Warn("ranking strategy not registered", zap.String("ranking", ranking))
+		ranking, strategy = RankingRecent, s.rankingStrategies[RankingRecent]
+	}
+
+	comments, err := strategy.Rank(ctx, s.commentDAO, videoID, limit, offset)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return ranking, comments, nil
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("scoreQuality", func() {
+	quality := func(content string) float64 {
+		comment := &dao.Comment{Content: content}
+		scoreQuality(comment)
+
+		return comment.Quality
+	}
+
+	It("scores the longer contents higher up to 1", func() {
+		Expect(quality("")).To(BeZero())
+		Expect(quality("nice")).To(BeNumerically("<", quality("nice shot, the lighting of the ending is great")))
+		Expect(quality(strings.Repeat("word ", 1000) + "the end")).To(BeNumerically("<=", 1))
+	})
+
+	It("scores the contents repeating the same words lower", func() {
+		Expect(quality("lol lol lol lol lol lol")).To(BeNumerically("<", quality("the ending made me laugh out loud")))
+	})
+})
+
+var _ = Describe("RankingExperiment", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+	})
+
+	// assigned counts the users assigned to each strategy by the experiment
+	assigned := func(experiment *RankingExperiment) map[string]int {
+		counts := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			counts[experiment.Assign(logkit.WithUserID(ctx, fmt.Sprintf("user-%d", i)))]++
+		}
+
+		return counts
+	}
+
+	It("assigns the users to the strategies by the percentages", func() {
+		experiment := NewRankingExperiment(ctx, &RankingConfig{Experiment: map[string]int{RankingQuality: 30}})
+
+		counts := assigned(experiment)
+		Expect(counts[RankingQuality]).To(BeNumerically("~", 300, 60))
+		Expect(counts[RankingRecent]).To(BeNumerically("~", 700, 60))
+	})
+
+	It("assigns a user to the same strategy every time", func() {
+		experiment := NewRankingExperiment(ctx, &RankingConfig{Experiment: map[string]int{RankingQuality: 50}})
+		userCtx := logkit.WithUserID(ctx, "user")
+
+		ranking := experiment.Assign(userCtx)
+		for i := 0; i < 10; i++ {
+			Expect(experiment.Assign(userCtx)).To(Equal(ranking))
+		}
+	})
+
+	It("ranks the requests without a user by recent", func() {
+		experiment := NewRankingExperiment(ctx, &RankingConfig{Experiment: map[string]int{RankingQuality: 100}})
+
+		Expect(experiment.Assign(ctx)).To(Equal(RankingRecent))
+	})
+
+	It("assigns the users by the percentages set at runtime", func() {
+		experiment := NewRankingExperiment(ctx, &RankingConfig{})
+		Expect(assigned(experiment)).To(Equal(map[string]int{RankingRecent: 1000}))
+
+		Expect(experiment.SetConfig(&RankingConfig{Experiment: map[string]int{RankingQuality: 100}})).To(Succeed())
+		Expect(assigned(experiment)).To(Equal(map[string]int{RankingQuality: 1000}))
+	})
+
+	DescribeTable("returns ErrInvalidRankingExperiment for the invalid percentages", func(percentages map[string]int) {
+		experiment := NewRankingExperiment(ctx, &RankingConfig{})
+
+		Expect(experiment.SetConfig(&RankingConfig{Experiment: percentages})).To(MatchError(ErrInvalidRankingExperiment))
+	},
+		Entry("negative percentage", map[string]int{RankingQuality: -1}),
+		Entry("over 100 in total", map[string]int{RankingQuality: 60, "another": 50}),
+	)
+})
+
+var _ = Describe("ListComment ranking", func() {
+	var (
+		controller  *gomock.Controller
+		videoClient *videopbmock.MockVideoClient
+		opts        []ServiceOption
+		svc         *service
+		ctx         context.Context
+		videoID     string
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		videoClient = videopbmock.NewMockVideoClient(controller)
+		videoClient.EXPECT().GetVideo(gomock.Any(), gomock.Any()).Return(&videopb.GetVideoResponse{}, nil).AnyTimes()
+		opts = nil
+		ctx = logkit.WithUserID(logkit.NewNopLogger().WithContext(context.Background()), "user")
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	JustBeforeEach(func() {
+		svc = NewService(dao.NewMemoryCommentDAO(), dao.NewMemoryCommentPubSub(), videoClient, nil, opts...)
+
+		for _, content := range []string{"nice", "the lighting of the ending is great", "wow wow wow"} {
+			Expect(svc.createComment(ctx, &dao.Comment{VideoID: videoID, Content: content})).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	// experiment ranks all the users by the strategy
+	experiment := func(ranking string) ServiceOption {
+		return WithRankingExperiment(NewRankingExperiment(ctx, &RankingConfig{Experiment: map[string]int{ranking: 100}}))
+	}
+
+	// list lists the comments of the video, and returns the ranking along with the contents
+	list := func(ctx context.Context, req *pb.ListCommentRequest) (string, []string) {
+		req.VideoId = videoID
+		resp, err := svc.ListComment(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(resp.GetComments()))
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return resp.GetRanking(), contents
+	}
+
+	It("ranks the comments by recent without the experiment", func() {
+		ranking, contents := list(ctx, &pb.ListCommentRequest{})
+		Expect(ranking).To(Equal(RankingRecent))
+		Expect(contents).To(Equal([]string{"nice", "the lighting of the ending is great", "wow wow wow"}))
+	})
+
+	When("the users are ranked by quality", func() {
+		BeforeEach(func() {
+			opts = append(opts, experiment(RankingQuality))
+		})
+
+		It("ranks the comments of the users by quality", func() {
+			ranking, contents := list(ctx, &pb.ListCommentRequest{})
+			Expect(ranking).To(Equal(RankingQuality))
+			Expect(contents).To(Equal([]string{"the lighting of the ending is great", "nice", "wow wow wow"}))
+		})
+
+		It("ranks the comments by recent without the user", func() {
+			ranking, _ := list(logkit.WithUserID(ctx, ""), &pb.ListCommentRequest{})
+			Expect(ranking).To(Equal(RankingRecent))
+		})
+
+		It("does not rank the comments of the other orders", func() {
+			ranking, _ := list(ctx, &pb.ListCommentRequest{Order: pb.CommentOrder_COMMENT_ORDER_SENTIMENT_DESC})
+			Expect(ranking).To(BeEmpty())
+		})
+	})
+
+	When("the users are ranked by a strategy registered", func() {
+		BeforeEach(func() {
+			opts = append(opts, experiment("reversed"), WithRankingStrategy("reversed", RankingStrategyFunc(
+				func(ctx context.Context, commentDAO dao.CommentDAO, videoID string, limit, offset int) ([]*dao.Comment, error) {
+					comments, err := commentDAO.ListByVideoID(ctx, videoID, limit, offset)
+					for i, j := 0, len(comments)-1; i < j; i, j = i+1, j-1 {
+						comments[i], comments[j] = comments[j], comments[i]
+					}
+
+					return comments, err
+				},
+			)))
+		})
+
+		It("ranks the comments by the strategy", func() {
+			ranking, contents := list(ctx, &pb.ListCommentRequest{})
+			Expect(ranking).To(Equal("reversed"))
+			Expect(contents).To(Equal([]string{"wow wow wow", "the lighting of the ending is great", "nice"}))
+		})
+	})
+
+	When("the users are ranked by a strategy not registered", func() {
+		BeforeEach(func() {
+			opts = append(opts, experiment("unknown"))
+		})
+
+		It("ranks the comments by recent", func() {
+			ranking, _ := list(ctx, &pb.ListCommentRequest{})
+			Expect(ranking).To(Equal(RankingRecent))
+		})
+	})
+})
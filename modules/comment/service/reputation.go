@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+type ReputationConfig struct {
+	LinkThreshold int64 `long:"link_threshold" env:"LINK_THRESHOLD" description:"the reputation score a user needs to post links in the comments, the comments of no author have a zero score" default:"0"`
+}
+
+// webLinkPattern matches the web links of a content, both the markdown links and the bare URLs.
+var webLinkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S`)
+
+// WithUserReputations scores the authors of the comments by the DAO, a like of a comment adds
+// dao.ReputationLikeReceived to its author, and a comment held for moderation adds dao.ReputationCommentRejected
+// once it is deleted. The users scored below the link threshold cannot post web links. It is a no-op if the DAO is
+// nil.
+func WithUserReputations(userReputationDAO dao.UserReputationDAO, linkThreshold int64) ServiceOption {
+	return func(s *service) {
+		if userReputationDAO != nil {
+			s.userReputationDAO = userReputationDAO
+			s.linkReputationThreshold = linkThreshold
+		}
+	}
+}
+
+func (s *service) GetUserReputation(ctx context.Context, req *pb.GetUserReputationRequest) (*pb.GetUserReputationResponse, error) {
+	if s.userReputationDAO == nil {
+		return nil, ErrUserReputationsDisabled
+	}
+
+	reputation, err := s.userReputationDAO.Get(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserReputationResponse{Reputation: reputation.ToProto()}, nil
+}
+
+// checkLinkReputation returns ErrLinkReputationRequired if the content has web links and the user of the context is
+// scored below the link threshold.
+func (s *service) checkLinkReputation(ctx context.Context, content string) error {
+	if s.userReputationDAO == nil || !webLinkPattern.MatchString(content) {
+		return nil
+	}
+
+	var score int64
+	if userID := logkit.UserIDFromContext(ctx); userID != "" {
+		reputation, err := s.userReputationDAO.Get(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		score = reputation.Score
+	}
+
+	if score < s.linkReputationThreshold {
+		return ErrLinkReputationRequired
+	}
+
+	return nil
+}
+
+// addReputation adds the delta to the score of the author of the comment, the comments of no author are skipped.
+// The failure is only logged since the event scored has happened.
+func (s *service) addReputation(ctx context.Context, comment *dao.Comment, delta int64) {
+	if s.userReputationDAO == nil || comment.UserID == "" {
+		return
+	}
+
+	if err := s.userReputationDAO.Add(ctx, comment.UserID, delta); err != nil {
+		logkit.FromContext(ctx).Error("failed to add user reputation",
+			zap.String("user_id", comment.UserID),
+			zap.String("comment_id", comment.ID.String()),
+			zap.Int64("delta", delta),
+			zap.Error(err),
+		)
+	}
+}
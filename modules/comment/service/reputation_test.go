@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("UserReputation", func() {
+	var (
+		commentDAO dao.CommentDAO
+		svc        *service
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil,
+			WithLikeCounter(dao.NewMemoryCommentLikeCounterDAO(commentDAO)),
+			WithUserReputations(dao.NewMemoryUserReputationDAO(), 2),
+		)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	createComment := func(userID, content string) (*dao.Comment, error) {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		return comment, svc.createComment(logkit.WithUserID(ctx, userID), comment)
+	}
+
+	score := func(userID string) int64 {
+		resp, err := svc.GetUserReputation(ctx, &pb.GetUserReputationRequest{UserId: userID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetReputation().GetUserId()).To(Equal(userID))
+
+		return resp.GetReputation().GetScore()
+	}
+
+	It("scores the authors by the likes of their comments", func() {
+		comment, err := createComment("alice", "first")
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, ip := range []string{"10.0.0.1", "10.0.0.1", "10.0.0.2"} {
+			_, err := svc.LikeComment(callerContext(ctx, ip), &pb.LikeCommentRequest{Id: comment.ID.String()})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(score("alice")).To(BeEquivalentTo(2 * dao.ReputationLikeReceived))
+		Expect(score("bob")).To(BeZero())
+	})
+
+	It("scores the authors down once their held comments are deleted", func() {
+		held, err := createComment("alice", "held")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commentDAO.Hold(ctx, held.ID, 0.9)).To(Succeed())
+		published, err := createComment("alice", "published")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: held.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = svc.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: published.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(score("alice")).To(BeEquivalentTo(dao.ReputationCommentRejected))
+	})
+
+	It("lets the users scored above the link threshold post links only", func() {
+		_, err := createComment("alice", "see [my site](https://example.com)")
+		Expect(err).To(MatchError(ErrLinkReputationRequired))
+		_, err = createComment("", "see www.example.com")
+		Expect(err).To(MatchError(ErrLinkReputationRequired))
+
+		comment, err := createComment("alice", "no links")
+		Expect(err).NotTo(HaveOccurred())
+		for _, ip := range []string{"10.0.0.1", "10.0.0.2"} {
+			_, err := svc.LikeComment(callerContext(ctx, ip), &pb.LikeCommentRequest{Id: comment.ID.String()})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		_, err = createComment("alice", "see [my site](https://example.com)")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.UpdateComment(logkit.WithUserID(ctx, "bob"), &pb.UpdateCommentRequest{Id: comment.ID.String(), Content: "see http://example.com"})
+		Expect(err).To(MatchError(ErrLinkReputationRequired))
+	})
+
+	It("returns ErrUserReputationsDisabled without the DAO", func() {
+		_, err := NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil).GetUserReputation(ctx, &pb.GetUserReputationRequest{UserId: "alice"})
+		Expect(err).To(MatchError(ErrUserReputationsDisabled))
+	})
+})
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+)
+
+// the scopes of the comment methods, granted to the SPIFFE IDs of the callers by the authz grants
+const (
+	ScopeRead  = "comment.read"
+	ScopeWrite = "comment.write"
+	ScopeAdmin = "comment.admin"
+)
+
+// MethodScopes returns the scopes of the methods of both API versions declared by the authz.scope option in
+// rpc.proto, it is used by the authorization interceptors of the gRPC server. A new method is denied until its
+// scope is declared there.
+func MethodScopes() grpckit.MethodScopes {
+	return grpckit.ScopesOf(pb.File_modules_comment_pb_v1_rpc_proto, pbv2.File_modules_comment_pb_v2_rpc_proto)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("MethodScopes", func() {
+	It("declares the scopes of every method", func() {
+		server := grpc.NewServer()
+		server.RegisterService(&pb.Comment_ServiceDesc, nil)
+		server.RegisterService(&pbv2.Comment_ServiceDesc, nil)
+
+		Expect(MethodScopes().Check(server.GetServiceInfo())).To(Succeed())
+	})
+
+	It("declares the scopes by the authz.scope option", func() {
+		scopes := MethodScopes()
+		Expect(scopes).To(HaveKeyWithValue("/comment.pb.Comment/Healthz", grpckit.ScopePublic))
+		Expect(scopes).To(HaveKeyWithValue("/comment.pb.Comment/GetComment", ScopeRead))
+		Expect(scopes).To(HaveKeyWithValue("/comment.pb.Comment/StreamComments", ScopeWrite))
+		Expect(scopes).To(HaveKeyWithValue("/comment.pb.Comment/BulkImportComment", ScopeAdmin))
+		Expect(scopes).To(HaveKeyWithValue("/comment.pb.v2.Comment/DeleteComment", ScopeWrite))
+	})
+})
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultSemanticSearchLimit is the number of the comments found if the limit is unset
+	DefaultSemanticSearchLimit = 20
+
+	// semanticSearchOversampling is how many times the limit of the nearest comments are searched for the keywords,
+	// which are filtered after the contents are decrypted, so fewer comments than the limit may be found
+	semanticSearchOversampling = 5
+)
+
+type EmbeddingConfig struct {
+	URL     string        `long:"url" env:"URL" description:"the URL of the embedding model server embedding the comments, semantic search is disabled if empty"`
+	Timeout time.Duration `long:"timeout" env:"TIMEOUT" description:"the timeout of a request to the model server" default:"5s"`
+}
+
+var ErrInvalidEmbedding = errors.New("invalid embedding")
+
+// Embedder embeds a text into a vector, the texts of similar meanings are near by the cosine distance.
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+}
+
+// HTTPEmbedder embeds the texts by a model server, which responds {"embedding": [0.12, -0.4, ...]} to the POST of
+// {"text": "..."}.
+type HTTPEmbedder struct {
+	url    string
+	client *http.Client
+}
+
+var _ Embedder = (*HTTPEmbedder)(nil)
+
+func NewHTTPEmbedder(conf *EmbeddingConfig) *HTTPEmbedder {
+	return &HTTPEmbedder{
+		url:    conf.URL,
+		client: &http.Client{Timeout: conf.Timeout},
+	}
+}
+
+func (e *HTTPEmbedder) Embed(ctx context.Context, text string) ([]float32, error) {
+	reqBody, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding model server: %s", resp.Status)
+	}
+
+	var respBody struct {
+		Embedding []float32 `json:"embedding"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return nil, fmt.Errorf("embedding model server: %w", err)
+	}
+
+	if len(respBody.Embedding) == 0 {
+		return nil, ErrInvalidEmbedding
+	}
+
+	return respBody.Embedding, nil
+}
+
+// WithSemanticSearch serves SemanticSearch by embedding the queries by the embedder, which must be the model of the
+// embedder consumer. It is a no-op if the embedder is nil.
+func WithSemanticSearch(embedder Embedder) ServiceOption {
+	return func(s *service) {
+		if embedder != nil {
+			s.embedder = embedder
+		}
+	}
+}
+
+// SemanticSearch lists the comments of the video nearest to the query, then keeps the ones containing all the
+// keywords. The comments are searched after the video, so the comments of the videos hidden from the user are not.
+func (s *service) SemanticSearch(ctx context.Context, req *pb.SemanticSearchRequest) (*pb.SemanticSearchResponse, error) {
+	if s.embedder == nil {
+		return nil, ErrSemanticSearchDisabled
+	}
+
+	if err := s.checkVideoVisible(ctx, req.GetVideoId()); err != nil {
+		return nil, err
+	}
+
+	embedding, err := s.embedder.Embed(ctx, req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = DefaultSemanticSearchLimit
+	}
+
+	candidates := limit
+	if len(req.GetKeywords()) > 0 {
+		candidates *= semanticSearchOversampling
+	}
+
+	comments, err := s.commentDAO.ListSimilar(ctx, req.GetVideoId(), embedding, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	pbComments := make([]*pb.CommentInfo, 0, limit)
+	for _, comment := range comments {
+		if len(pbComments) == limit {
+			break
+		}
+
+		if containsKeywords(comment.Content, req.GetKeywords()) {
+			pbComments = append(pbComments, comment.ToProto())
+		}
+	}
+
+	return &pb.SemanticSearchResponse{Comments: pbComments}, nil
+}
+
+// containsKeywords reports whether the content contains all the keywords case-insensitively.
+func containsKeywords(content string, keywords []string) bool {
+	content = strings.ToLower(content)
+	for _, keyword := range keywords {
+		if !strings.Contains(content, strings.ToLower(keyword)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CommentEmbedder embeds the contents of the comments by the change events of the comments table, so the comments are
+// embedded without slowing down their creation, and are searched once they are embedded.
+type CommentEmbedder struct {
+	commentDAO dao.CommentDAO
+	embedder   Embedder
+}
+
+var _ cdckit.Handler = (*CommentEmbedder)(nil)
+
+func NewCommentEmbedder(commentDAO dao.CommentDAO, embedder Embedder) *CommentEmbedder {
+	return &CommentEmbedder{
+		commentDAO: commentDAO,
+		embedder:   embedder,
+	}
+}
+
+// HandleChange embeds the created or updated comment. The comment is read by the DAO instead of the row, so the
+// content is decrypted if it is encrypted, and it is skipped if it is embedded since its last update, e.g. the likes
+// and the moderation do not change the update time. The embeddings of the deleted comments are left, since the
+// searches join the comments.
+func (e *CommentEmbedder) HandleChange(ctx context.Context, event *cdckit.ChangeEvent) error {
+	if event.Op == cdckit.OperationDelete {
+		return nil
+	}
+
+	var row struct {
+		ID       uuid.UUID `json:"id"`
+		TenantID string    `json:"tenant_id"`
+	}
+	if err := json.Unmarshal(event.Row(), &row); err != nil {
+		return err
+	}
+
+	ctx = tenantkit.WithTenantID(ctx, row.TenantID)
+
+	comment, err := e.commentDAO.Get(ctx, row.ID)
+	if errors.Is(err, dao.ErrCommentNotFound) {
+		// the comment is deleted before it is embedded
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	embeddedAt, err := e.commentDAO.GetEmbeddingUpdatedAt(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+	if !embeddedAt.IsZero() && !embeddedAt.Before(comment.UpdatedAt) {
+		return nil
+	}
+
+	embedding, err := e.embedder.Embed(ctx, comment.Content)
+	if err != nil {
+		return err
+	}
+
+	return e.commentDAO.UpdateEmbedding(ctx, comment, embedding)
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeEmbedder embeds the texts by the embeddings of them, and counts the texts embedded.
+type fakeEmbedder struct {
+	embeddings map[string][]float32
+	embedded   []string
+}
+
+func (e *fakeEmbedder) Embed(_ context.Context, text string) ([]float32, error) {
+	embedding, ok := e.embeddings[text]
+	if !ok {
+		return nil, errors.New("model server unavailable")
+	}
+
+	e.embedded = append(e.embedded, text)
+
+	return embedding, nil
+}
+
+var _ = Describe("HTTPEmbedder", func() {
+	var (
+		server   *httptest.Server
+		response string
+	)
+
+	BeforeEach(func() {
+		response = `{"embedding": [0.5, -1, 2]}`
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Text string `json:"text"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Text == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			fmt.Fprint(w, response)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	embed := func(text string) ([]float32, error) {
+		embedder := NewHTTPEmbedder(&EmbeddingConfig{URL: server.URL, Timeout: time.Second})
+		return embedder.Embed(context.Background(), text)
+	}
+
+	It("embeds the text by the model server", func() {
+		Expect(embed("great music")).To(Equal([]float32{0.5, -1, 2}))
+	})
+
+	It("returns the error of the model server", func() {
+		_, err := embed("")
+		Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+	})
+
+	It("returns ErrInvalidEmbedding if the embedding is empty", func() {
+		response = `{"embedding": []}`
+
+		_, err := embed("great music")
+		Expect(err).To(MatchError(ErrInvalidEmbedding))
+	})
+})
+
+var _ = Describe("SemanticSearch", func() {
+	var (
+		commentDAO dao.CommentDAO
+		embedder   *fakeEmbedder
+		svc        *service
+		handler    *CommentEmbedder
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		embedder = &fakeEmbedder{embeddings: map[string][]float32{
+			"songs":                {1, 0, 0},
+			"The music is great":   {0.9, 0.1, 0},
+			"Loud music, no words": {0.7, 0, 0.3},
+			"Nice camera work":     {0, 1, 0},
+			"The music is awesome": {0.95, 0.05, 0},
+		}}
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil, WithSemanticSearch(embedder), WithLikeCounter(dao.NewMemoryCommentLikeCounterDAO(commentDAO)))
+		handler = NewCommentEmbedder(commentDAO, embedder)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	// handleChange embeds the comment by the change event of the operation on it
+	handleChange := func(op cdckit.Operation, comment *dao.Comment) error {
+		row, err := json.Marshal(map[string]string{"id": comment.ID.String(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+
+		return handler.HandleChange(ctx, &cdckit.ChangeEvent{Op: op, After: row})
+	}
+
+	createComment := func(content string) *dao.Comment {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		Expect(svc.createComment(ctx, comment)).To(Succeed())
+
+		return comment
+	}
+
+	search := func(req *pb.SemanticSearchRequest) []string {
+		req.VideoId = videoID
+		resp, err := svc.SemanticSearch(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(resp.GetComments()))
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("lists the embedded comments nearest to the query first", func() {
+		for _, content := range []string{"Nice camera work", "Loud music, no words", "The music is great"} {
+			Expect(handleChange(cdckit.OperationCreate, createComment(content))).To(Succeed())
+		}
+		createComment("not embedded yet")
+
+		Expect(search(&pb.SemanticSearchRequest{Query: "songs"})).To(Equal([]string{"The music is great", "Loud music, no words", "Nice camera work"}))
+		Expect(search(&pb.SemanticSearchRequest{Query: "songs", Limit: 1})).To(Equal([]string{"The music is great"}))
+	})
+
+	It("keeps the comments containing all the keywords case-insensitively", func() {
+		for _, content := range []string{"Nice camera work", "Loud music, no words", "The music is great"} {
+			Expect(handleChange(cdckit.OperationCreate, createComment(content))).To(Succeed())
+		}
+
+		Expect(search(&pb.SemanticSearchRequest{Query: "songs", Keywords: []string{"MUSIC", "words"}})).To(Equal([]string{"Loud music, no words"}))
+		Expect(search(&pb.SemanticSearchRequest{Query: "songs", Keywords: []string{"drums"}})).To(BeEmpty())
+	})
+
+	It("embeds the comment again only if its content is updated", func() {
+		comment := createComment("The music is great")
+		Expect(handleChange(cdckit.OperationCreate, comment)).To(Succeed())
+
+		_, err := svc.LikeComment(ctx, &pb.LikeCommentRequest{Id: comment.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handleChange(cdckit.OperationUpdate, comment)).To(Succeed())
+		Expect(embedder.embedded).To(HaveLen(1))
+
+		_, err = svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: comment.ID.String(), Content: "The music is awesome"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handleChange(cdckit.OperationUpdate, comment)).To(Succeed())
+		Expect(embedder.embedded).To(Equal([]string{"The music is great", "The music is awesome"}))
+
+		Expect(search(&pb.SemanticSearchRequest{Query: "songs"})).To(Equal([]string{"The music is awesome"}))
+	})
+
+	It("returns the error of the embedder, so the change is handled again", func() {
+		Expect(handleChange(cdckit.OperationCreate, createComment("unavailable"))).NotTo(Succeed())
+	})
+
+	It("ignores the comments deleted", func() {
+		comment := createComment("The music is great")
+		Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+
+		Expect(handleChange(cdckit.OperationCreate, comment)).To(Succeed())
+		Expect(handleChange(cdckit.OperationDelete, comment)).To(Succeed())
+		Expect(embedder.embedded).To(BeEmpty())
+	})
+
+	When("semantic search is disabled", func() {
+		BeforeEach(func() {
+			svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil)
+		})
+
+		It("returns semantic search disabled error", func() {
+			_, err := svc.SemanticSearch(ctx, &pb.SemanticSearchRequest{VideoId: videoID, Query: "songs"})
+			Expect(err).To(MatchError(ErrSemanticSearchDisabled))
+		})
+	})
+})
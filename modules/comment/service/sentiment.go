@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+// SentimentAnalyzer scores the sentiment of a comment content from -1, the most negative, to 1, the most
+// positive. The heuristic analyzer is the default, and an analyzer of a model is plugged in by
+// WithSentimentAnalyzer.
+type SentimentAnalyzer interface {
+	Analyze(ctx context.Context, content string) (float64, error)
+}
+
+// WithSentimentAnalyzer scores the comments by the analyzer instead of the heuristic one. It is a no-op if the
+// analyzer is nil.
+func WithSentimentAnalyzer(analyzer SentimentAnalyzer) ServiceOption {
+	return func(s *service) {
+		if analyzer != nil {
+			s.sentimentAnalyzer = analyzer
+		}
+	}
+}
+
+// scoreSentiment sets the sentiment score of the comment to be stored along with it, the failure is only
+// logged and the comment is stored as neutral, since a comment is never refused for its score.
+func (s *service) scoreSentiment(ctx context.Context, comment *dao.Comment) {
+	score, err := s.sentimentAnalyzer.Analyze(ctx, comment.Content)
+	if err != nil {
+		logkit.FromContext(ctx).Warn("failed to analyze sentiment", zap.Error(err))
+		score = 0
+	}
+
+	comment.Sentiment = score
+}
+
+// sentimentFilterFromProto maps the sentiment filter of the request to the one of the DAO.
+func sentimentFilterFromProto(filter pb.SentimentFilter) dao.SentimentFilter {
+	switch filter {
+	case pb.SentimentFilter_SENTIMENT_FILTER_POSITIVE:
+		return dao.SentimentFilterPositive
+	case pb.SentimentFilter_SENTIMENT_FILTER_NEUTRAL:
+		return dao.SentimentFilterNeutral
+	case pb.SentimentFilter_SENTIMENT_FILTER_NEGATIVE:
+		return dao.SentimentFilterNegative
+	default:
+		return dao.SentimentFilterAll
+	}
+}
+
+// sentimentOrderFromProto maps the order of the request to the sentiment order of the DAO.
+func sentimentOrderFromProto(order pb.CommentOrder) dao.SentimentOrder {
+	switch order {
+	case pb.CommentOrder_COMMENT_ORDER_SENTIMENT_DESC:
+		return dao.SentimentOrderDesc
+	case pb.CommentOrder_COMMENT_ORDER_SENTIMENT_ASC:
+		return dao.SentimentOrderAsc
+	default:
+		return dao.SentimentOrderNone
+	}
+}
+
+var (
+	positiveWords = wordSet("good", "great", "awesome", "amazing", "nice", "love", "loved", "like", "liked", "best",
+		"beautiful", "funny", "cool", "perfect", "classic", "smooth", "clean", "fun", "wow", "underrated", "excellent",
+		"enjoyed", "thanks", "helpful", "brilliant")
+	negativeWords = wordSet("bad", "boring", "worst", "hate", "hated", "awful", "terrible", "poor", "annoying",
+		"loud", "ugly", "waste", "meh", "wrong", "broken", "lag", "laggy", "overrated", "disappointing", "cringe")
+	negations = wordSet("not", "never", "no", "isn't", "wasn't", "don't", "didn't", "doesn't")
+)
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
+
+// HeuristicSentimentAnalyzer scores the comments locally by word lists, the score is the positive words minus
+// the negative ones over all of them, a negation flipping the word after it, and zero without any of them.
+type HeuristicSentimentAnalyzer struct{}
+
+func NewHeuristicSentimentAnalyzer() *HeuristicSentimentAnalyzer {
+	return &HeuristicSentimentAnalyzer{}
+}
+
+func (h *HeuristicSentimentAnalyzer) Analyze(_ context.Context, content string) (float64, error) {
+	words := commentWords(content)
+
+	n := 0
+	for _, word := range words {
+		if positiveWords[word] || negativeWords[word] {
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	return float64(sentimentScore(words)) / float64(n), nil
+}
+
+// commentWords returns the lowercase words of the markdown content, the markup is dropped as the separators.
+func commentWords(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+}
+
+// sentimentScore returns the number of the positive words minus the negative ones.
+func sentimentScore(words []string) int {
+	score := 0
+	negated := false
+
+	for _, word := range words {
+		sign := 1
+		if negated {
+			sign = -1
+		}
+
+		switch {
+		case positiveWords[word]:
+			score += sign
+		case negativeWords[word]:
+			score -= sign
+		}
+
+		negated = negations[word]
+	}
+
+	return score
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// failingSentimentAnalyzer fails to score every content, like a model server which is down.
+type failingSentimentAnalyzer struct{}
+
+func (failingSentimentAnalyzer) Analyze(context.Context, string) (float64, error) {
+	return 0, errors.New("model server unavailable")
+}
+
+var _ = Describe("HeuristicSentimentAnalyzer", func() {
+	DescribeTable("scores the sentiment of the content",
+		func(content string, expected float64) {
+			score, err := NewHeuristicSentimentAnalyzer().Analyze(context.Background(), content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(score).To(Equal(expected))
+		},
+		Entry("positive", "**Great** video, love it!", 1.0),
+		Entry("negative", "So boring and too loud", -1.0),
+		Entry("neutral", "Watched it on the train", 0.0),
+		Entry("negated", "not good at all", -1.0),
+		Entry("balanced", "good intro but boring ending", 0.0),
+		Entry("mostly positive", "great music, nice camera, bad ending", 1.0/3),
+	)
+})
+
+var _ = Describe("Sentiment", func() {
+	var (
+		commentDAO dao.CommentDAO
+		svc        *service
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	createComment := func(content string) *dao.Comment {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		Expect(svc.createComment(ctx, comment)).To(Succeed())
+
+		return comment
+	}
+
+	listComments := func(filter pb.SentimentFilter, order pb.CommentOrder) []string {
+		resp, err := svc.ListComment(ctx, &pb.ListCommentRequest{VideoId: videoID, Sentiment: filter, Order: order})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(resp.GetComments()))
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("scores the comments on creation and lists them by the scores", func() {
+		createComment("boring")
+		createComment("watched twice")
+		createComment("great video")
+		createComment("great music, bad ending")
+
+		Expect(listComments(pb.SentimentFilter_SENTIMENT_FILTER_ALL, pb.CommentOrder_COMMENT_ORDER_SENTIMENT_DESC)).
+			To(Equal([]string{"great video", "watched twice", "great music, bad ending", "boring"}))
+		Expect(listComments(pb.SentimentFilter_SENTIMENT_FILTER_NEGATIVE, pb.CommentOrder_COMMENT_ORDER_UPDATED_AT)).
+			To(Equal([]string{"boring"}))
+	})
+
+	It("scores the comments again on update", func() {
+		comment := createComment("great video")
+
+		resp, err := svc.UpdateComment(ctx, &pb.UpdateCommentRequest{Id: comment.ID.String(), Content: "boring video"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComment().GetSentiment()).To(Equal(-1.0))
+
+		Expect(listComments(pb.SentimentFilter_SENTIMENT_FILTER_POSITIVE, pb.CommentOrder_COMMENT_ORDER_UPDATED_AT)).To(BeEmpty())
+	})
+
+	When("the analyzer fails", func() {
+		BeforeEach(func() {
+			svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil, WithSentimentAnalyzer(failingSentimentAnalyzer{}))
+		})
+
+		It("creates the comment as neutral", func() {
+			comment := createComment("great video")
+			Expect(comment.Sentiment).To(BeZero())
+
+			Expect(listComments(pb.SentimentFilter_SENTIMENT_FILTER_NEUTRAL, pb.CommentOrder_COMMENT_ORDER_UPDATED_AT)).
+				To(Equal([]string{"great video"}))
+		})
+	})
+})
@@ -1,27 +1,100 @@
 package service
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"sync"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
 	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type service struct {
 	pb.UnimplementedCommentServer
 
-	commentDAO  dao.CommentDAO
-	videoClient videopb.VideoClient
+	commentDAO    dao.CommentDAO
+	commentPubSub dao.CommentPubSub
+	videoClient   videopb.VideoClient
+	storage       storagekit.Storage
+
+	bannedPatternDAO    dao.BannedPatternDAO
+	bannedPatternFilter *BannedPatternFilter
+
+	sentimentAnalyzer SentimentAnalyzer
+	summarizer        Summarizer
+	summaries         *summaryCache
+
+	commentDraftDAO dao.CommentDraftDAO
+
+	userBlockDAO dao.UserBlockDAO
+
+	userReputationDAO       dao.UserReputationDAO
+	linkReputationThreshold int64
+
+	commentLikeCounterDAO dao.CommentLikeCounterDAO
+
+	embedder Embedder
+
+	commentFingerprintDAO dao.CommentFingerprintDAO
+	duplicateAction       string
+
+	rankingStrategies map[string]RankingStrategy
+	rankingExperiment *RankingExperiment
+
+	pageTokens *pagekit.Codec
+}
+
+type ServiceOption func(s *service)
+
+// WithBannedPatterns blocks the comments matching the banned patterns of their tenants from being created or
+// updated, and serves the admin methods managing the patterns. It is a no-op if the filter is nil.
+func WithBannedPatterns(bannedPatternDAO dao.BannedPatternDAO, filter *BannedPatternFilter) ServiceOption {
+	return func(s *service) {
+		if filter != nil {
+			s.bannedPatternDAO = bannedPatternDAO
+			s.bannedPatternFilter = filter
+		}
+	}
+}
+
+// WithPageTokens pages the ranked lists, e.g. ListTopComments, by the page tokens of the codec, the lists are
+// disabled without it. It is a no-op if the codec is nil.
+func WithPageTokens(pageTokens *pagekit.Codec) ServiceOption {
+	return func(s *service) {
+		if pageTokens != nil {
+			s.pageTokens = pageTokens
+		}
+	}
 }
 
-func NewService(commentDAO dao.CommentDAO, videoClient videopb.VideoClient) *service {
-	return &service{
-		commentDAO:  commentDAO,
-		videoClient: videoClient,
+func NewService(commentDAO dao.CommentDAO, commentPubSub dao.CommentPubSub, videoClient videopb.VideoClient, storage storagekit.Storage, opts ...ServiceOption) *service {
+	s := &service{
+		commentDAO:        commentDAO,
+		commentPubSub:     commentPubSub,
+		videoClient:       videoClient,
+		storage:           storage,
+		sentimentAnalyzer: NewHeuristicSentimentAnalyzer(),
+		summarizer:        NewHeuristicSummarizer(),
+		summaries:         newSummaryCache(),
+		rankingStrategies: defaultRankingStrategies(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 func (s *service) Healthz(ctx context.Context, req *pb.HealthzRequest) (*pb.HealthzResponse, error) {
@@ -29,17 +102,69 @@ func (s *service) Healthz(ctx context.Context, req *pb.HealthzRequest) (*pb.Heal
 }
 
 func (s *service) ListComment(ctx context.Context, req *pb.ListCommentRequest) (*pb.ListCommentResponse, error) {
-	comments, err := s.commentDAO.ListByVideoID(ctx, req.GetVideoId(), int(req.GetLimit()), int(req.GetOffset()))
+	if err := s.checkVideoVisible(ctx, req.GetVideoId()); err != nil {
+		return nil, err
+	}
+
+	var comments []*dao.Comment
+	var ranking string
+	var err error
+
+	bySentiment := req.GetSentiment() != pb.SentimentFilter_SENTIMENT_FILTER_ALL || req.GetOrder() != pb.CommentOrder_COMMENT_ORDER_UPDATED_AT
+
+	switch {
+	case req.GetAsOf() != nil && bySentiment:
+		return nil, ErrSentimentAsOf
+	case req.GetAsOf() != nil:
+		comments, err = s.commentDAO.ListByVideoIDAsOf(ctx, req.GetVideoId(), req.GetAsOf().AsTime(), int(req.GetLimit()), int(req.GetOffset()))
+	case bySentiment:
+		comments, err = s.commentDAO.ListBySentiment(ctx, req.GetVideoId(), sentimentFilterFromProto(req.GetSentiment()), sentimentOrderFromProto(req.GetOrder()), int(req.GetLimit()), int(req.GetOffset()))
+	default:
+		ranking, comments, err = s.rankComments(ctx, req.GetVideoId(), int(req.GetLimit()), int(req.GetOffset()))
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if comments, err = s.hideBlockedComments(ctx, comments); err != nil {
+		return nil, err
+	}
+
 	pbComments := make([]*pb.CommentInfo, 0, len(comments))
 	for _, comment := range comments {
-		pbComments = append(pbComments, comment.ToProto())
+		pbComment := comment.ToProto()
+		if req.GetRenderFormat() == pb.RenderFormat_RENDER_FORMAT_HTML {
+			pbComment.ContentHtml = comment.HTML()
+		}
+
+		pbComments = append(pbComments, pbComment)
+	}
+
+	return &pb.ListCommentResponse{Comments: pbComments, Ranking: ranking}, nil
+}
+
+func (s *service) GetComment(ctx context.Context, req *pb.GetCommentRequest) (*pb.GetCommentResponse, error) {
+	commentID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidUUID
+	}
+
+	var comment *dao.Comment
+
+	if req.GetAsOf() != nil {
+		comment, err = s.commentDAO.GetAsOf(ctx, commentID, req.GetAsOf().AsTime())
+	} else {
+		comment, err = s.commentDAO.Get(ctx, commentID)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.ListCommentResponse{Comments: pbComments}, nil
+	if err := s.checkVideoVisible(ctx, comment.VideoID); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCommentResponse{Comment: comment.ToProto()}, nil
 }
 
 func (s *service) CreateComment(ctx context.Context, req *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
@@ -53,13 +178,101 @@ func (s *service) CreateComment(ctx context.Context, req *pb.CreateCommentReques
 		VideoID: req.GetVideoId(),
 		Content: req.GetContent(),
 	}
+	if err := s.createComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	if req.GetDraftId() != "" {
+		s.deleteCommentDraft(ctx, req.GetVideoId(), req.GetDraftId())
+	}
+
+	return &pb.CreateCommentResponse{Id: comment.ID.String()}, nil
+}
+
+// createComment creates the comment and publishes it to the live subscribers of the video. The comment becomes the
+// earlier comment instead if it is collapsed into it as a near-duplicate.
+func (s *service) createComment(ctx context.Context, comment *dao.Comment) error {
+	inserted, err := s.insertComment(ctx, comment)
+	if err != nil {
+		return err
+	}
+
+	if inserted {
+		s.publishComment(ctx, comment)
+	}
+
+	return nil
+}
+
+// insertComment creates the comment through the checks of every comment created, e.g. the banned patterns and the
+// duplicates, without publishing it. It reports false if the comment becomes the earlier comment it is collapsed into,
+// which is published already.
+func (s *service) insertComment(ctx context.Context, comment *dao.Comment) (bool, error) {
+	if err := s.checkContent(ctx, comment.Content); err != nil {
+		return false, err
+	}
+
+	// the author is the user of the X-User-Id header like the user of the drafts
+	comment.UserID = logkit.UserIDFromContext(ctx)
+
+	if err := s.checkLinkReputation(ctx, comment.Content); err != nil {
+		return false, err
+	}
+
+	duplicate, release, err := s.claimFingerprint(ctx, comment)
+	if err != nil {
+		return false, err
+	}
+	if duplicate != nil {
+		*comment = *duplicate
+		return false, nil
+	}
+
+	comment.RenderContent()
+	s.scoreSentiment(ctx, comment)
+	scoreQuality(comment)
 
 	commentID, err := s.commentDAO.Create(ctx, comment)
 	if err != nil {
-		return nil, err
+		release()
+		return false, err
+	}
+
+	comment.ID = commentID
+
+	return true, nil
+}
+
+// checkContent returns ErrContentBlocked if the content matches the banned patterns of the tenant of the context.
+func (s *service) checkContent(ctx context.Context, content string) error {
+	if s.bannedPatternFilter == nil {
+		return nil
+	}
+
+	return s.bannedPatternFilter.Check(ctx, content)
+}
+
+// checkVideoVisible checks the video is visible to the user of the request, so the comments of the videos hidden
+// from the user in restricted mode are hidden as well. The requests without a user ID are never restricted, so
+// they skip the check.
+func (s *service) checkVideoVisible(ctx context.Context, videoID string) error {
+	if logkit.UserIDFromContext(ctx) == "" {
+		return nil
 	}
 
-	return &pb.CreateCommentResponse{Id: commentID.String()}, nil
+	_, err := s.videoClient.GetVideo(ctx, &videopb.GetVideoRequest{
+		Id: videoID,
+	})
+
+	return err
+}
+
+// publishComment publishes the comment to the live subscribers of its video,
+// the failure is only logged since the comment has been created.
+func (s *service) publishComment(ctx context.Context, comment *dao.Comment) {
+	if err := s.commentPubSub.Publish(ctx, comment); err != nil {
+		logkit.FromContext(ctx).Error("failed to publish comment", zap.String("comment_id", comment.ID.String()), zap.Error(err))
+	}
 }
 
 func (s *service) UpdateComment(ctx context.Context, req *pb.UpdateCommentRequest) (*pb.UpdateCommentResponse, error) {
@@ -68,15 +281,23 @@ func (s *service) UpdateComment(ctx context.Context, req *pb.UpdateCommentReques
 		return nil, ErrInvalidUUID
 	}
 
+	if err := s.checkContent(ctx, req.GetContent()); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkLinkReputation(ctx, req.GetContent()); err != nil {
+		return nil, err
+	}
+
 	comment := &dao.Comment{
 		ID:      commentID,
 		Content: req.GetContent(),
 	}
-	if err := s.commentDAO.Update(ctx, comment); err != nil {
-		if errors.Is(err, dao.ErrCommentNotFound) {
-			return nil, ErrCommentNotFound
-		}
+	comment.RenderContent()
+	s.scoreSentiment(ctx, comment)
+	scoreQuality(comment)
 
+	if err := s.commentDAO.Update(ctx, comment); err != nil {
 		return nil, err
 	}
 
@@ -91,14 +312,23 @@ func (s *service) DeleteComment(ctx context.Context, req *pb.DeleteCommentReques
 		return nil, ErrInvalidUUID
 	}
 
-	if err := s.commentDAO.Delete(ctx, commentID); err != nil {
-		if errors.Is(err, dao.ErrCommentNotFound) {
-			return nil, ErrCommentNotFound
+	// the comment is read before it is deleted only to score its author
+	var comment *dao.Comment
+	if s.userReputationDAO != nil {
+		if comment, err = s.commentDAO.Get(ctx, commentID); err != nil {
+			return nil, err
 		}
+	}
 
+	if err := s.commentDAO.Delete(ctx, commentID); err != nil {
 		return nil, err
 	}
 
+	// deleting a comment held for moderation rejects it
+	if comment != nil && comment.Status == dao.CommentStatusPending {
+		s.addReputation(ctx, comment, dao.ReputationCommentRejected)
+	}
+
 	return &pb.DeleteCommentResponse{}, nil
 }
 
@@ -109,3 +339,450 @@ func (s *service) DeleteCommentByVideoID(ctx context.Context, req *pb.DeleteComm
 
 	return &pb.DeleteCommentByVideoIDResponse{}, nil
 }
+
+func (s *service) BulkImportComment(stream pb.Comment_BulkImportCommentServer) error {
+	ctx := stream.Context()
+
+	var totalImportedCount, totalFailedCount int64
+
+	for batch := int32(0); ; batch++ {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		resp := &pb.BulkImportCommentResponse{Batch: batch}
+
+		// a failed batch is reported and skipped, the import goes on with the next batch
+		if count, err := s.bulkImportComments(ctx, req.GetComments()); err != nil {
+			totalFailedCount += int64(len(req.GetComments()))
+			resp.Error = err.Error()
+		} else {
+			totalImportedCount += int64(count)
+			resp.ImportedCount = int64(count)
+		}
+
+		resp.TotalImportedCount = totalImportedCount
+		resp.TotalFailedCount = totalFailedCount
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *service) bulkImportComments(ctx context.Context, pbComments []*pb.CommentInfo) (int, error) {
+	comments := make([]*dao.Comment, 0, len(pbComments))
+	for i, pbComment := range pbComments {
+		comment, err := commentFromProto(pbComment)
+		if err != nil {
+			return 0, fmt.Errorf("comment %d: %w", i, err)
+		}
+
+		comments = append(comments, comment)
+	}
+
+	count, err := s.commentDAO.BulkImport(ctx, comments)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, s.countImportedLikes(ctx, comments)
+}
+
+func commentFromProto(pbComment *pb.CommentInfo) (*dao.Comment, error) {
+	if pbComment.GetVideoId() == "" {
+		return nil, ErrEmptyVideoID
+	}
+
+	comment := &dao.Comment{
+		VideoID:        pbComment.GetVideoId(),
+		Content:        pbComment.GetContent(),
+		Sentiment:      pbComment.GetSentiment(),
+		Status:         dao.CommentStatus(pbComment.GetStatus()),
+		Toxicity:       pbComment.GetToxicity(),
+		Likes:          pbComment.GetLikes(),
+		CollapsedCount: pbComment.GetCollapsedCount(),
+		UserID:         pbComment.GetUserId(),
+	}
+	comment.RenderContent()
+	scoreQuality(comment)
+
+	if id := pbComment.GetId(); id != "" {
+		commentID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, ErrInvalidUUID
+		}
+
+		comment.ID = commentID
+	}
+
+	if parentID := pbComment.GetParentId(); parentID != "" {
+		commentParentID, err := uuid.Parse(parentID)
+		if err != nil {
+			return nil, ErrInvalidParent
+		}
+
+		comment.ParentID = commentParentID
+	}
+
+	if pbComment.GetCreatedAt() != nil {
+		comment.CreatedAt = pbComment.GetCreatedAt().AsTime()
+	}
+	if pbComment.GetUpdatedAt() != nil {
+		comment.UpdatedAt = pbComment.GetUpdatedAt().AsTime()
+	}
+
+	return comment, nil
+}
+
+const (
+	defaultBackupBatchSize = 1000
+
+	// maxBackupLineSize limits the size of a comment in a backup
+	maxBackupLineSize = 1 << 20
+)
+
+func (s *service) BackupComment(req *pb.BackupCommentRequest, stream pb.Comment_BackupCommentServer) error {
+	ctx := stream.Context()
+
+	if req.GetName() == "" {
+		return ErrEmptyBackupName
+	}
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultBackupBatchSize
+	}
+
+	// the comments are uploaded while being exported, so the backup size is unknown
+	pr, pw := io.Pipe()
+
+	uploaded := make(chan error, 1)
+	go func() {
+		err := s.storage.PutObject(ctx, req.GetName(), pr, -1, storagekit.PutObjectOptions{
+			ContentType: "application/x-ndjson",
+		})
+		_ = pr.CloseWithError(err)
+		uploaded <- err
+	}()
+
+	var exportedCount int64
+
+	snapshotTime, err := s.commentDAO.Export(ctx, batchSize, func(comments []*dao.Comment) error {
+		for _, comment := range comments {
+			line, err := protojson.Marshal(comment.ToProto())
+			if err != nil {
+				return err
+			}
+
+			if _, err := pw.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+
+		exportedCount += int64(len(comments))
+
+		return stream.Send(&pb.BackupCommentResponse{ExportedCount: exportedCount})
+	})
+	if err != nil {
+		// abort the upload so that a partial backup is never stored
+		_ = pw.CloseWithError(err)
+		<-uploaded
+
+		return err
+	}
+
+	_ = pw.Close()
+	if err := <-uploaded; err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.BackupCommentResponse{
+		ExportedCount: exportedCount,
+		SnapshotTime:  timestamppb.New(snapshotTime),
+		Done:          true,
+	})
+}
+
+func (s *service) RestoreComment(req *pb.RestoreCommentRequest, stream pb.Comment_RestoreCommentServer) error {
+	ctx := stream.Context()
+
+	if req.GetName() == "" {
+		return ErrEmptyBackupName
+	}
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = defaultBackupBatchSize
+	}
+
+	reader, err := s.storage.GetObject(ctx, req.GetName())
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var restoredCount int64
+
+	restore := func(comments []*dao.Comment) error {
+		count, err := s.commentDAO.BulkImport(ctx, comments)
+		if err != nil {
+			return err
+		}
+
+		if err := s.countImportedLikes(ctx, comments); err != nil {
+			return err
+		}
+
+		restoredCount += int64(count)
+
+		return stream.Send(&pb.RestoreCommentResponse{RestoredCount: restoredCount})
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBackupLineSize)
+
+	comments := make([]*dao.Comment, 0, batchSize)
+	for line := 1; scanner.Scan(); line++ {
+		var pbComment pb.CommentInfo
+		if err := protojson.Unmarshal(scanner.Bytes(), &pbComment); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		comment, err := commentFromProto(&pbComment)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		comments = append(comments, comment)
+		if len(comments) < batchSize {
+			continue
+		}
+
+		if err := restore(comments); err != nil {
+			return err
+		}
+
+		comments = make([]*dao.Comment, 0, batchSize)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(comments) > 0 {
+		if err := restore(comments); err != nil {
+			return err
+		}
+	}
+
+	return stream.Send(&pb.RestoreCommentResponse{
+		RestoredCount: restoredCount,
+		Done:          true,
+	})
+}
+
+func (s *service) StreamComments(stream pb.Comment_StreamCommentsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	videoID := req.GetVideoId()
+	if videoID == "" {
+		return ErrNotSubscribed
+	}
+
+	if _, err := s.videoClient.GetVideo(ctx, &videopb.GetVideoRequest{
+		Id: videoID,
+	}); err != nil {
+		return err
+	}
+
+	// the blocks are loaded once, the users blocked since are hidden from the next subscriptions
+	blocked, err := s.blockedUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	comments, err := s.commentPubSub.Subscribe(ctx, videoID)
+	if err != nil {
+		return err
+	}
+
+	// the comments created by the stream are not pushed back to it
+	var created sync.Map
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.receiveComments(ctx, stream, videoID, &created)
+	}()
+
+	for {
+		select {
+		case err := <-errCh:
+			if err != nil {
+				return err
+			}
+
+			// the client stops sending comments but keeps receiving until it cancels the stream
+			errCh = nil
+		case comment, ok := <-comments:
+			if !ok {
+				return ctx.Err()
+			}
+
+			if _, ok := created.Load(comment.ID); ok {
+				created.Delete(comment.ID)
+				continue
+			}
+
+			if _, ok := blocked[comment.UserID]; ok {
+				continue
+			}
+
+			if err := stream.Send(&pb.StreamCommentsResponse{
+				Comment: comment.ToProto(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// receiveComments creates a comment on the video for each request until the client closes the sending side.
+func (s *service) receiveComments(ctx context.Context, stream pb.Comment_StreamCommentsServer, videoID string, created *sync.Map) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if req.GetVideoId() != "" {
+			return ErrAlreadySubscribed
+		}
+
+		comment := &dao.Comment{
+			VideoID: videoID,
+			Content: req.GetContent(),
+		}
+
+		inserted, err := s.insertComment(ctx, comment)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			continue
+		}
+
+		// mark the comment before publishing it, so that it is never pushed back
+		created.Store(comment.ID, struct{}{})
+
+		s.publishComment(ctx, comment)
+	}
+}
+
+func (s *service) ListBannedPatterns(ctx context.Context, req *pb.ListBannedPatternsRequest) (*pb.ListBannedPatternsResponse, error) {
+	if s.bannedPatternFilter == nil {
+		return nil, ErrBannedPatternsDisabled
+	}
+
+	patterns, err := s.bannedPatternDAO.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPatterns := make([]*pb.BannedPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		pbPatterns = append(pbPatterns, pattern.ToProto())
+	}
+
+	return &pb.ListBannedPatternsResponse{Patterns: pbPatterns}, nil
+}
+
+func (s *service) CreateBannedPattern(ctx context.Context, req *pb.CreateBannedPatternRequest) (*pb.CreateBannedPatternResponse, error) {
+	if s.bannedPatternFilter == nil {
+		return nil, ErrBannedPatternsDisabled
+	}
+
+	pattern := bannedPatternFromProto(req)
+
+	// the pattern is compiled before it is created, so the filter never loads an invalid one
+	if _, err := compileBannedPattern(pattern); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.bannedPatternDAO.Create(ctx, pattern); err != nil {
+		return nil, err
+	}
+
+	s.bannedPatternFilter.Changed(ctx)
+
+	return &pb.CreateBannedPatternResponse{Pattern: pattern.ToProto()}, nil
+}
+
+func (s *service) DeleteBannedPattern(ctx context.Context, req *pb.DeleteBannedPatternRequest) (*pb.DeleteBannedPatternResponse, error) {
+	if s.bannedPatternFilter == nil {
+		return nil, ErrBannedPatternsDisabled
+	}
+
+	patternID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidUUID
+	}
+
+	if err := s.bannedPatternDAO.Delete(ctx, patternID); err != nil {
+		return nil, err
+	}
+
+	s.bannedPatternFilter.Changed(ctx)
+
+	return &pb.DeleteBannedPatternResponse{}, nil
+}
+
+func (s *service) EvaluateBannedPatterns(ctx context.Context, req *pb.EvaluateBannedPatternsRequest) (*pb.EvaluateBannedPatternsResponse, error) {
+	if s.bannedPatternFilter == nil {
+		return nil, ErrBannedPatternsDisabled
+	}
+
+	candidates := make([]*dao.BannedPattern, 0, len(req.GetCandidates()))
+	for _, candidate := range req.GetCandidates() {
+		candidates = append(candidates, bannedPatternFromProto(candidate))
+	}
+
+	matches, blocked, err := s.bannedPatternFilter.Evaluate(ctx, req.GetContent(), candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.EvaluateBannedPatternsResponse{
+		Blocked: blocked,
+		Matches: make([]*pb.BannedPatternMatch, 0, len(matches)),
+	}
+	for _, match := range matches {
+		resp.Matches = append(resp.Matches, &pb.BannedPatternMatch{
+			Pattern: match.Pattern.ToProto(),
+			Text:    match.Text,
+		})
+	}
+
+	return resp, nil
+}
+
+func bannedPatternFromProto(req *pb.CreateBannedPatternRequest) *dao.BannedPattern {
+	return &dao.BannedPattern{
+		Kind:    dao.BannedPatternKindFromProto(req.GetKind()),
+		Pattern: req.GetPattern(),
+	}
+}
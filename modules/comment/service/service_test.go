@@ -3,17 +3,27 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/daomock"
-	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/pbmock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
 	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
 	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/markdownkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestService(t *testing.T) {
@@ -24,23 +34,31 @@ func TestService(t *testing.T) {
 var (
 	errDAOUnknown          = errors.New("unknown DAO error")
 	errVideoServiceUnknown = errors.New("unknown video service error")
+	errStreamUnknown       = errors.New("unknown stream error")
 )
 
 var _ = Describe("Service", func() {
 	var (
-		controller  *gomock.Controller
-		commentDAO  *daomock.MockCommentDAO
-		videoClient *videopbmock.MockVideoClient
-		svc         *service
-		ctx         context.Context
+		controller    *gomock.Controller
+		commentDAO    *daomock.MockCommentDAO
+		commentPubSub *daomock.MockCommentPubSub
+		videoClient   *videopbmock.MockVideoClient
+		storage       *storagekit.LocalStorage
+		svc           *service
+		ctx           context.Context
 	)
 
 	BeforeEach(func() {
 		controller = gomock.NewController(GinkgoT())
 		commentDAO = daomock.NewMockCommentDAO(controller)
+		commentPubSub = daomock.NewMockCommentPubSub(controller)
 		videoClient = videopbmock.NewMockVideoClient(controller)
-		svc = NewService(commentDAO, videoClient)
-		ctx = context.Background()
+		storage = storagekit.NewLocalStorage(logkit.NewNopLogger().WithContext(context.Background()), &storagekit.LocalConfig{
+			Dir:    GinkgoT().TempDir(),
+			Bucket: "backups",
+		})
+		svc = NewService(commentDAO, commentPubSub, videoClient, storage)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
 	})
 
 	AfterEach(func() {
@@ -62,6 +80,20 @@ var _ = Describe("Service", func() {
 			resp, err = svc.ListComment(ctx, req)
 		})
 
+		When("the video is hidden from the user", func() {
+			BeforeEach(func() {
+				ctx = logkit.WithUserID(ctx, "user")
+				videoClient.EXPECT().GetVideo(ctx, &videopb.GetVideoRequest{
+					Id: req.GetVideoId(),
+				}).Return(nil, errVideoServiceUnknown)
+			})
+
+			It("returns the error without the comments", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(errVideoServiceUnknown))
+			})
+		})
+
 		When("DAO error", func() {
 			BeforeEach(func() {
 				commentDAO.EXPECT().ListByVideoID(ctx, req.GetVideoId(), int(req.GetLimit()), int(req.GetOffset())).Return(nil, errDAOUnknown)
@@ -87,7 +119,176 @@ var _ = Describe("Service", func() {
 						comments[0].ToProto(),
 						comments[1].ToProto(),
 					},
+					Ranking: RankingRecent,
+				}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("as of a time", func() {
+			var (
+				asOf     time.Time
+				comments []*dao.Comment
+			)
+
+			BeforeEach(func() {
+				asOf = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+				req.AsOf = timestamppb.New(asOf)
+
+				comments = []*dao.Comment{dao.NewFakeComment("")}
+				commentDAO.EXPECT().ListByVideoIDAsOf(ctx, req.GetVideoId(), asOf, int(req.GetLimit()), int(req.GetOffset())).Return(comments, nil)
+			})
+
+			It("returns the comments at the time with no error", func() {
+				Expect(resp).To(Equal(&pb.ListCommentResponse{
+					Comments: []*pb.CommentInfo{comments[0].ToProto()},
+				}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("filtered and sorted by sentiment", func() {
+			var comments []*dao.Comment
+
+			BeforeEach(func() {
+				req.Sentiment = pb.SentimentFilter_SENTIMENT_FILTER_NEGATIVE
+				req.Order = pb.CommentOrder_COMMENT_ORDER_SENTIMENT_ASC
+
+				comments = []*dao.Comment{dao.NewFakeComment("")}
+				comments[0].Sentiment = -0.5
+				commentDAO.EXPECT().ListBySentiment(ctx, req.GetVideoId(), dao.SentimentFilterNegative, dao.SentimentOrderAsc, int(req.GetLimit()), int(req.GetOffset())).Return(comments, nil)
+			})
+
+			It("returns the comments of the sentiment with no error", func() {
+				Expect(resp).To(Equal(&pb.ListCommentResponse{
+					Comments: []*pb.CommentInfo{comments[0].ToProto()},
 				}))
+				Expect(resp.GetComments()[0].GetSentiment()).To(Equal(-0.5))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("as of a time and sorted by sentiment", func() {
+			BeforeEach(func() {
+				req.AsOf = timestamppb.Now()
+				req.Order = pb.CommentOrder_COMMENT_ORDER_SENTIMENT_DESC
+			})
+
+			It("returns ErrSentimentAsOf", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrSentimentAsOf))
+			})
+		})
+
+		When("rendered as HTML", func() {
+			var comments []*dao.Comment
+
+			BeforeEach(func() {
+				req.RenderFormat = pb.RenderFormat_RENDER_FORMAT_HTML
+
+				stored := dao.NewFakeComment("")
+				stored.RenderContent()
+				// the comments created before the contents were rendered are rendered on the fly
+				notRendered := dao.NewFakeComment("")
+				notRendered.Content = "**not** rendered"
+
+				comments = []*dao.Comment{stored, notRendered}
+				commentDAO.EXPECT().ListByVideoID(ctx, req.GetVideoId(), int(req.GetLimit()), int(req.GetOffset())).Return(comments, nil)
+			})
+
+			It("returns the comments along with the HTML with no error", func() {
+				Expect(resp.GetComments()).To(HaveLen(2))
+				Expect(resp.GetComments()[0].GetContent()).To(Equal("comment test"))
+				Expect(resp.GetComments()[0].GetContentHtml()).To(Equal("<p>comment test</p>"))
+				Expect(resp.GetComments()[1].GetContentHtml()).To(Equal("<p><strong>not</strong> rendered</p>"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetComment", func() {
+		var (
+			req  *pb.GetCommentRequest
+			id   uuid.UUID
+			resp *pb.GetCommentResponse
+			err  error
+		)
+
+		BeforeEach(func() {
+			id = uuid.New()
+			req = &pb.GetCommentRequest{Id: id.String()}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = svc.GetComment(ctx, req)
+		})
+
+		When("comment ID is invalid", func() {
+			BeforeEach(func() { req.Id = "invalid uuid" })
+
+			It("returns invalid UUID error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidUUID))
+			})
+		})
+
+		When("comment not found", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Get(ctx, id).Return(nil, dao.ErrCommentNotFound)
+			})
+
+			It("returns comment not found error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(dao.ErrCommentNotFound))
+			})
+		})
+
+		When("success", func() {
+			var comment *dao.Comment
+
+			BeforeEach(func() {
+				comment = dao.NewFakeComment("")
+				commentDAO.EXPECT().Get(ctx, id).Return(comment, nil)
+			})
+
+			It("returns the comment with no error", func() {
+				Expect(resp).To(Equal(&pb.GetCommentResponse{Comment: comment.ToProto()}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the video of the comment is hidden from the user", func() {
+			BeforeEach(func() {
+				ctx = logkit.WithUserID(ctx, "user")
+				comment := dao.NewFakeComment("fake id")
+				commentDAO.EXPECT().Get(ctx, id).Return(comment, nil)
+				videoClient.EXPECT().GetVideo(ctx, &videopb.GetVideoRequest{
+					Id: comment.VideoID,
+				}).Return(nil, errVideoServiceUnknown)
+			})
+
+			It("returns the error without the comment", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(errVideoServiceUnknown))
+			})
+		})
+
+		When("as of a time", func() {
+			var (
+				asOf    time.Time
+				comment *dao.Comment
+			)
+
+			BeforeEach(func() {
+				asOf = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+				req.AsOf = timestamppb.New(asOf)
+
+				comment = dao.NewFakeComment("")
+				commentDAO.EXPECT().GetAsOf(ctx, id, asOf).Return(comment, nil)
+			})
+
+			It("returns the comment at the time with no error", func() {
+				Expect(resp).To(Equal(&pb.GetCommentResponse{Comment: comment.ToProto()}))
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -107,9 +308,11 @@ var _ = Describe("Service", func() {
 				Content: "fake conetent",
 			}
 			comment = &dao.Comment{
-				VideoID: req.GetVideoId(),
-				Content: req.GetContent(),
+				VideoID:     req.GetVideoId(),
+				Content:     req.GetContent(),
+				ContentHTML: markdownkit.Render(req.GetContent()),
 			}
+			scoreQuality(comment)
 		})
 
 		JustBeforeEach(func() {
@@ -153,6 +356,13 @@ var _ = Describe("Service", func() {
 				BeforeEach(func() {
 					id = uuid.New()
 					commentDAO.EXPECT().Create(ctx, comment).Return(id, nil)
+					commentPubSub.EXPECT().Publish(ctx, &dao.Comment{
+						ID:          id,
+						VideoID:     req.GetVideoId(),
+						Content:     req.GetContent(),
+						ContentHTML: markdownkit.Render(req.GetContent()),
+						Quality:     comment.Quality,
+					}).Return(nil)
 				})
 
 				It("returns no error", func() {
@@ -162,6 +372,29 @@ var _ = Describe("Service", func() {
 					Expect(err).NotTo(HaveOccurred())
 				})
 			})
+
+			When("publish error", func() {
+				var id uuid.UUID
+
+				BeforeEach(func() {
+					id = uuid.New()
+					commentDAO.EXPECT().Create(ctx, comment).Return(id, nil)
+					commentPubSub.EXPECT().Publish(ctx, &dao.Comment{
+						ID:          id,
+						VideoID:     req.GetVideoId(),
+						Content:     req.GetContent(),
+						ContentHTML: markdownkit.Render(req.GetContent()),
+						Quality:     comment.Quality,
+					}).Return(errDAOUnknown)
+				})
+
+				It("returns no error since the comment is created", func() {
+					Expect(resp).To(Equal(&pb.CreateCommentResponse{
+						Id: id.String(),
+					}))
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
 		})
 	})
 
@@ -179,9 +412,11 @@ var _ = Describe("Service", func() {
 				Content: "fake content",
 			}
 			comment = &dao.Comment{
-				ID:      uuid.MustParse(req.GetId()),
-				Content: req.GetContent(),
+				ID:          uuid.MustParse(req.GetId()),
+				Content:     req.GetContent(),
+				ContentHTML: markdownkit.Render(req.GetContent()),
 			}
+			scoreQuality(comment)
 		})
 
 		JustBeforeEach(func() {
@@ -201,12 +436,12 @@ var _ = Describe("Service", func() {
 
 		When("comment not found", func() {
 			BeforeEach(func() {
-				commentDAO.EXPECT().Update(ctx, comment).Return(ErrCommentNotFound)
+				commentDAO.EXPECT().Update(ctx, comment).Return(dao.ErrCommentNotFound)
 			})
 
 			It("return comment not found error", func() {
 				Expect(resp).To(BeNil())
-				Expect(err).To(MatchError(ErrCommentNotFound))
+				Expect(err).To(MatchError(dao.ErrCommentNotFound))
 			})
 		})
 
@@ -255,12 +490,12 @@ var _ = Describe("Service", func() {
 
 		When("comment not found", func() {
 			BeforeEach(func() {
-				commentDAO.EXPECT().Delete(ctx, id).Return(ErrCommentNotFound)
+				commentDAO.EXPECT().Delete(ctx, id).Return(dao.ErrCommentNotFound)
 			})
 
 			It("return comment not found error", func() {
 				Expect(resp).To(BeNil())
-				Expect(err).To(MatchError(ErrCommentNotFound))
+				Expect(err).To(MatchError(dao.ErrCommentNotFound))
 			})
 		})
 
@@ -315,4 +550,402 @@ var _ = Describe("Service", func() {
 			})
 		})
 	})
+
+	Describe("BulkImportComment", func() {
+		var (
+			stream    *pbmock.MockComment_BulkImportCommentServer
+			responses []*pb.BulkImportCommentResponse
+			err       error
+		)
+
+		BeforeEach(func() {
+			stream = pbmock.NewMockComment_BulkImportCommentServer(controller)
+			stream.EXPECT().Context().Return(ctx)
+			stream.EXPECT().Send(gomock.Any()).AnyTimes().DoAndReturn(func(resp *pb.BulkImportCommentResponse) error {
+				responses = append(responses, resp)
+				return nil
+			})
+
+			responses = nil
+		})
+
+		JustBeforeEach(func() {
+			err = svc.BulkImportComment(stream)
+		})
+
+		When("stream receive error", func() {
+			BeforeEach(func() {
+				stream.EXPECT().Recv().Return(nil, errStreamUnknown)
+			})
+
+			It("returns the error", func() {
+				Expect(responses).To(BeEmpty())
+				Expect(err).To(MatchError(errStreamUnknown))
+			})
+		})
+
+		When("some batches fail", func() {
+			BeforeEach(func() {
+				gomock.InOrder(
+					stream.EXPECT().Recv().Return(&pb.BulkImportCommentRequest{
+						Comments: []*pb.CommentInfo{{VideoId: "fake id 1"}, {VideoId: "fake id 1"}},
+					}, nil),
+					commentDAO.EXPECT().BulkImport(ctx, gomock.Len(2)).Return(2, nil),
+					stream.EXPECT().Recv().Return(&pb.BulkImportCommentRequest{
+						Comments: []*pb.CommentInfo{{VideoId: "fake id 2"}},
+					}, nil),
+					commentDAO.EXPECT().BulkImport(ctx, gomock.Len(1)).Return(0, errDAOUnknown),
+					stream.EXPECT().Recv().Return(&pb.BulkImportCommentRequest{
+						Comments: []*pb.CommentInfo{{VideoId: "fake id 3"}, {Id: "invalid uuid", VideoId: "fake id 3"}},
+					}, nil),
+					stream.EXPECT().Recv().Return(&pb.BulkImportCommentRequest{
+						Comments: []*pb.CommentInfo{{VideoId: "fake id 4"}},
+					}, nil),
+					commentDAO.EXPECT().BulkImport(ctx, gomock.Len(1)).Return(1, nil),
+					stream.EXPECT().Recv().Return(nil, io.EOF),
+				)
+			})
+
+			It("reports the progress of every batch and keeps importing", func() {
+				Expect(responses).To(HaveLen(4))
+				Expect(responses[0]).To(Equal(&pb.BulkImportCommentResponse{
+					Batch: 0, ImportedCount: 2, TotalImportedCount: 2, TotalFailedCount: 0,
+				}))
+				Expect(responses[1]).To(Equal(&pb.BulkImportCommentResponse{
+					Batch: 1, ImportedCount: 0, TotalImportedCount: 2, TotalFailedCount: 1, Error: errDAOUnknown.Error(),
+				}))
+				Expect(responses[2].GetTotalFailedCount()).To(Equal(int64(3)))
+				Expect(responses[2].GetError()).To(ContainSubstring("comment 1"))
+				Expect(responses[3]).To(Equal(&pb.BulkImportCommentResponse{
+					Batch: 3, ImportedCount: 1, TotalImportedCount: 3, TotalFailedCount: 3,
+				}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("BackupComment", func() {
+		var (
+			req       *pb.BackupCommentRequest
+			stream    *pbmock.MockComment_BackupCommentServer
+			responses []*pb.BackupCommentResponse
+			err       error
+		)
+
+		BeforeEach(func() {
+			req = &pb.BackupCommentRequest{Name: "comments.ndjson", BatchSize: 2}
+			stream = pbmock.NewMockComment_BackupCommentServer(controller)
+			stream.EXPECT().Context().Return(ctx)
+			stream.EXPECT().Send(gomock.Any()).AnyTimes().DoAndReturn(func(resp *pb.BackupCommentResponse) error {
+				responses = append(responses, resp)
+				return nil
+			})
+
+			responses = nil
+		})
+
+		JustBeforeEach(func() {
+			err = svc.BackupComment(req, stream)
+		})
+
+		When("backup name is empty", func() {
+			BeforeEach(func() { req.Name = "" })
+
+			It("returns empty backup name error", func() {
+				Expect(responses).To(BeEmpty())
+				Expect(err).To(MatchError(ErrEmptyBackupName))
+			})
+		})
+
+		When("DAO error", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Export(ctx, 2, gomock.Any()).Return(time.Time{}, errDAOUnknown)
+			})
+
+			It("returns the error without storing the backup", func() {
+				Expect(err).To(MatchError(errDAOUnknown))
+
+				_, err := storage.GetObject(ctx, req.GetName())
+				Expect(err).To(MatchError(storagekit.ErrObjectNotFound))
+			})
+		})
+
+		When("success", func() {
+			var (
+				comments     []*dao.Comment
+				snapshotTime time.Time
+			)
+
+			BeforeEach(func() {
+				comments = []*dao.Comment{dao.NewFakeComment(""), dao.NewFakeComment(""), dao.NewFakeComment("")}
+				snapshotTime = time.Now()
+
+				commentDAO.EXPECT().Export(ctx, 2, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, fn func([]*dao.Comment) error) (time.Time, error) {
+					if err := fn(comments[:2]); err != nil {
+						return time.Time{}, err
+					}
+					if err := fn(comments[2:]); err != nil {
+						return time.Time{}, err
+					}
+
+					return snapshotTime, nil
+				})
+			})
+
+			It("reports the progress of every batch", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(responses).To(HaveLen(3))
+				Expect(responses[0].GetExportedCount()).To(Equal(int64(2)))
+				Expect(responses[1].GetExportedCount()).To(Equal(int64(3)))
+				Expect(responses[2]).To(Equal(&pb.BackupCommentResponse{
+					ExportedCount: 3,
+					SnapshotTime:  timestamppb.New(snapshotTime),
+					Done:          true,
+				}))
+			})
+
+			It("uploads the comments as newline-delimited JSON", func() {
+				reader, err := storage.GetObject(ctx, req.GetName())
+				Expect(err).NotTo(HaveOccurred())
+				defer reader.Close()
+
+				content, err := io.ReadAll(reader)
+				Expect(err).NotTo(HaveOccurred())
+
+				lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+				Expect(lines).To(HaveLen(3))
+				for i, line := range lines {
+					var comment pb.CommentInfo
+					Expect(protojson.Unmarshal([]byte(line), &comment)).To(Succeed())
+					Expect(proto.Equal(&comment, comments[i].ToProto())).To(BeTrue())
+				}
+			})
+		})
+	})
+
+	Describe("RestoreComment", func() {
+		var (
+			req       *pb.RestoreCommentRequest
+			stream    *pbmock.MockComment_RestoreCommentServer
+			responses []*pb.RestoreCommentResponse
+			err       error
+		)
+
+		BeforeEach(func() {
+			req = &pb.RestoreCommentRequest{Name: "comments.ndjson", BatchSize: 2}
+			stream = pbmock.NewMockComment_RestoreCommentServer(controller)
+			stream.EXPECT().Context().Return(ctx)
+			stream.EXPECT().Send(gomock.Any()).AnyTimes().DoAndReturn(func(resp *pb.RestoreCommentResponse) error {
+				responses = append(responses, resp)
+				return nil
+			})
+
+			responses = nil
+		})
+
+		JustBeforeEach(func() {
+			err = svc.RestoreComment(req, stream)
+		})
+
+		When("backup not found", func() {
+			It("returns backup not found error", func() {
+				Expect(responses).To(BeEmpty())
+				Expect(err).To(MatchError(storagekit.ErrObjectNotFound))
+			})
+		})
+
+		When("backup is malformed", func() {
+			BeforeEach(func() {
+				putBackup(ctx, storage, req.GetName(), `{"videoId": "fake id"}`, `not json`)
+			})
+
+			It("returns the error of the line", func() {
+				Expect(err).To(MatchError(ContainSubstring("line 2")))
+			})
+		})
+
+		When("success", func() {
+			var comments []*dao.Comment
+
+			BeforeEach(func() {
+				comments = []*dao.Comment{dao.NewFakeComment(""), dao.NewFakeComment(""), dao.NewFakeComment("")}
+
+				lines := make([]string, 0, len(comments))
+				for _, comment := range comments {
+					line, err := protojson.Marshal(comment.ToProto())
+					Expect(err).NotTo(HaveOccurred())
+
+					lines = append(lines, string(line))
+				}
+				putBackup(ctx, storage, req.GetName(), lines...)
+
+				gomock.InOrder(
+					commentDAO.EXPECT().BulkImport(ctx, gomock.Len(2)).Return(2, nil),
+					commentDAO.EXPECT().BulkImport(ctx, gomock.Len(1)).DoAndReturn(func(_ context.Context, restored []*dao.Comment) (int, error) {
+						Expect(restored[0].ID).To(Equal(comments[2].ID))
+						Expect(restored[0].VideoID).To(Equal(comments[2].VideoID))
+						return 1, nil
+					}),
+				)
+			})
+
+			It("restores the comments batch by batch", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(responses).To(Equal([]*pb.RestoreCommentResponse{
+					{RestoredCount: 2},
+					{RestoredCount: 3},
+					{RestoredCount: 3, Done: true},
+				}))
+			})
+		})
+	})
+
+	Describe("StreamComments", func() {
+		var (
+			stream    *pbmock.MockComment_StreamCommentsServer
+			comments  chan *dao.Comment
+			responses []*pb.StreamCommentsResponse
+			err       error
+		)
+
+		BeforeEach(func() {
+			stream = pbmock.NewMockComment_StreamCommentsServer(controller)
+			stream.EXPECT().Context().Return(ctx).AnyTimes()
+
+			comments = make(chan *dao.Comment, 2)
+			responses = nil
+		})
+
+		JustBeforeEach(func() {
+			err = svc.StreamComments(stream)
+		})
+
+		When("stream receive error", func() {
+			BeforeEach(func() {
+				stream.EXPECT().Recv().Return(nil, errStreamUnknown)
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(errStreamUnknown))
+			})
+		})
+
+		When("first request is not a subscription", func() {
+			BeforeEach(func() {
+				stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{
+					Data: &pb.StreamCommentsRequest_Content{Content: "fake content"},
+				}, nil)
+			})
+
+			It("returns not subscribed error", func() {
+				Expect(err).To(MatchError(ErrNotSubscribed))
+			})
+		})
+
+		Context("subscribe to a video", func() {
+			BeforeEach(func() {
+				stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{
+					Data: &pb.StreamCommentsRequest_VideoId{VideoId: "fake id"},
+				}, nil)
+			})
+
+			When("get video error", func() {
+				BeforeEach(func() {
+					videoClient.EXPECT().GetVideo(gomock.Any(), &videopb.GetVideoRequest{
+						Id: "fake id",
+					}).Return(nil, errVideoServiceUnknown)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(MatchError(errVideoServiceUnknown))
+				})
+			})
+
+			Context("get video no error", func() {
+				BeforeEach(func() {
+					videoClient.EXPECT().GetVideo(gomock.Any(), &videopb.GetVideoRequest{
+						Id: "fake id",
+					}).Return(&videopb.GetVideoResponse{}, nil)
+				})
+
+				When("subscribe error", func() {
+					BeforeEach(func() {
+						commentPubSub.EXPECT().Subscribe(gomock.Any(), "fake id").Return(nil, errDAOUnknown)
+					})
+
+					It("returns the error", func() {
+						Expect(err).To(MatchError(errDAOUnknown))
+					})
+				})
+
+				When("subscribe again", func() {
+					BeforeEach(func() {
+						commentPubSub.EXPECT().Subscribe(gomock.Any(), "fake id").Return(comments, nil)
+						stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{
+							Data: &pb.StreamCommentsRequest_VideoId{VideoId: "fake id 2"},
+						}, nil)
+					})
+
+					It("returns already subscribed error", func() {
+						Expect(err).To(MatchError(ErrAlreadySubscribed))
+					})
+				})
+
+				When("success", func() {
+					var (
+						ownComment   *dao.Comment
+						otherComment *dao.Comment
+					)
+
+					BeforeEach(func() {
+						ownComment = dao.NewFakeComment("fake id")
+						otherComment = dao.NewFakeComment("fake id")
+
+						commentPubSub.EXPECT().Subscribe(gomock.Any(), "fake id").Return(comments, nil)
+						stream.EXPECT().Recv().Return(&pb.StreamCommentsRequest{
+							Data: &pb.StreamCommentsRequest_Content{Content: "own content"},
+						}, nil)
+						stream.EXPECT().Recv().Return(nil, io.EOF).MaxTimes(1)
+
+						created := &dao.Comment{
+							VideoID:     "fake id",
+							Content:     "own content",
+							ContentHTML: "<p>own content</p>",
+						}
+						scoreQuality(created)
+
+						commentDAO.EXPECT().Create(gomock.Any(), created).DoAndReturn(func(_ context.Context, comment *dao.Comment) (uuid.UUID, error) {
+							comment.ID = ownComment.ID
+							return comment.ID, nil
+						})
+
+						// the own comment is published back to the stream along with the comments of others
+						commentPubSub.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, comment *dao.Comment) error {
+							comments <- ownComment
+							comments <- otherComment
+							return nil
+						})
+
+						stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *pb.StreamCommentsResponse) error {
+							responses = append(responses, resp)
+							close(comments)
+							return nil
+						})
+					})
+
+					It("pushes the comments of others only", func() {
+						Expect(err).NotTo(HaveOccurred())
+						Expect(responses).To(Equal([]*pb.StreamCommentsResponse{
+							{Comment: otherComment.ToProto()},
+						}))
+					})
+				})
+			})
+		})
+	})
 })
+
+func putBackup(ctx context.Context, storage storagekit.Storage, name string, lines ...string) {
+	content := strings.Join(lines, "\n") + "\n"
+	Expect(storage.PutObject(ctx, name, strings.NewReader(content), int64(len(content)), storagekit.PutObjectOptions{})).To(Succeed())
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/google/uuid"
+)
+
+// defaultPageSize is the page size of the comment lists if not specified
+const defaultPageSize = 20
+
+// serviceV2 serves the v2 API on top of the v1 service, the methods unchanged
+// in v2 are delegated to v1 through the shim, so both versions share the same behavior.
+type serviceV2 struct {
+	pbv2.UnimplementedCommentServer
+
+	v1         *service
+	pageTokens *pagekit.Codec
+}
+
+func NewServiceV2(v1 *service, pageTokens *pagekit.Codec) *serviceV2 {
+	return &serviceV2{
+		v1:         v1,
+		pageTokens: pageTokens,
+	}
+}
+
+func (s *serviceV2) ListComments(ctx context.Context, req *pbv2.ListCommentsRequest) (*pbv2.ListCommentsResponse, error) {
+	if err := s.v1.checkVideoVisible(ctx, req.GetVideoId()); err != nil {
+		return nil, err
+	}
+
+	var parentID uuid.UUID
+	if id := req.GetParentId(); id != "" {
+		var err error
+		if parentID, err = uuid.Parse(id); err != nil {
+			return nil, ErrInvalidParent
+		}
+	}
+
+	after, err := s.decodePageToken(req.GetPageToken(), req.GetVideoId(), parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// list one more comment to know whether there is a next page
+	comments, err := s.v1.commentDAO.ListByParentID(ctx, req.GetVideoId(), parentID, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbv2.ListCommentsResponse{}
+
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+
+		if resp.NextPageToken, err = s.encodePageToken(req.GetVideoId(), parentID, comments[pageSize-1].Cursor()); err != nil {
+			return nil, err
+		}
+	}
+
+	// the comments are hidden after the page token is encoded, so the next page starts after the hidden comments
+	if comments, err = s.v1.hideBlockedComments(ctx, comments); err != nil {
+		return nil, err
+	}
+
+	resp.Comments = make([]*pbv2.CommentInfo, 0, len(comments))
+	for _, comment := range comments {
+		pbComment := comment.ToProtoV2()
+		if req.GetRenderFormat() == pbv2.RenderFormat_RENDER_FORMAT_HTML {
+			pbComment.ContentHtml = comment.HTML()
+		}
+
+		resp.Comments = append(resp.Comments, pbComment)
+	}
+
+	return resp, nil
+}
+
+func (s *serviceV2) CreateComment(ctx context.Context, req *pbv2.CreateCommentRequest) (*pbv2.CreateCommentResponse, error) {
+	if _, err := s.v1.videoClient.GetVideo(ctx, &videopb.GetVideoRequest{
+		Id: req.GetVideoId(),
+	}); err != nil {
+		return nil, err
+	}
+
+	comment := &dao.Comment{
+		VideoID: req.GetVideoId(),
+		Content: req.GetContent(),
+	}
+
+	if id := req.GetParentId(); id != "" {
+		parentID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, ErrInvalidParent
+		}
+
+		parent, err := s.v1.commentDAO.Get(ctx, parentID)
+		if errors.Is(err, dao.ErrCommentNotFound) {
+			return nil, ErrInvalidParent
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// a reply is always in the thread of the same video
+		if parent.VideoID != req.GetVideoId() {
+			return nil, ErrInvalidParent
+		}
+
+		comment.ParentID = parentID
+	}
+
+	if err := s.v1.createComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return &pbv2.CreateCommentResponse{Comment: comment.ToProtoV2()}, nil
+}
+
+func (s *serviceV2) GetComment(ctx context.Context, req *pbv2.GetCommentRequest) (*pbv2.GetCommentResponse, error) {
+	resp, err := s.v1.GetComment(ctx, &pb.GetCommentRequest{Id: req.GetId()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.GetCommentResponse{Comment: commentInfoToV2(resp.GetComment())}, nil
+}
+
+func (s *serviceV2) UpdateComment(ctx context.Context, req *pbv2.UpdateCommentRequest) (*pbv2.UpdateCommentResponse, error) {
+	resp, err := s.v1.UpdateComment(ctx, &pb.UpdateCommentRequest{
+		Id:      req.GetId(),
+		Content: req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbv2.UpdateCommentResponse{Comment: commentInfoToV2(resp.GetComment())}, nil
+}
+
+func (s *serviceV2) DeleteComment(ctx context.Context, req *pbv2.DeleteCommentRequest) (*pbv2.DeleteCommentResponse, error) {
+	if _, err := s.v1.DeleteComment(ctx, &pb.DeleteCommentRequest{Id: req.GetId()}); err != nil {
+		return nil, err
+	}
+
+	return &pbv2.DeleteCommentResponse{}, nil
+}
+
+// commentInfoToV2 is the shim converting the comments of the v1 responses into v2.
+func commentInfoToV2(comment *pb.CommentInfo) *pbv2.CommentInfo {
+	if comment == nil {
+		return nil
+	}
+
+	return &pbv2.CommentInfo{
+		Id:        comment.GetId(),
+		VideoId:   comment.GetVideoId(),
+		ParentId:  comment.GetParentId(),
+		Content:   comment.GetContent(),
+		CreatedAt: comment.GetCreatedAt(),
+		UpdatedAt: comment.GetUpdatedAt(),
+	}
+}
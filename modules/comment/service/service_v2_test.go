@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/daomock"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	videopbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/markdownkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceV2", func() {
+	var (
+		controller    *gomock.Controller
+		commentDAO    *daomock.MockCommentDAO
+		commentPubSub *daomock.MockCommentPubSub
+		videoClient   *videopbmock.MockVideoClient
+		svc           *serviceV2
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		commentDAO = daomock.NewMockCommentDAO(controller)
+		commentPubSub = daomock.NewMockCommentPubSub(controller)
+		videoClient = videopbmock.NewMockVideoClient(controller)
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		svc = NewServiceV2(NewService(commentDAO, commentPubSub, videoClient, nil), pagekit.NewCodec(ctx, &pagekit.Config{
+			Secret: "fake secret of the page tokens",
+			TTL:    time.Hour,
+		}))
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	Describe("ListComments", func() {
+		var (
+			req  *pbv2.ListCommentsRequest
+			resp *pbv2.ListCommentsResponse
+			err  error
+		)
+
+		BeforeEach(func() {
+			req = &pbv2.ListCommentsRequest{VideoId: "fake id", PageSize: 2}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = svc.ListComments(ctx, req)
+		})
+
+		When("page token is invalid", func() {
+			BeforeEach(func() { req.PageToken = "invalid token" })
+
+			It("returns invalid page token error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidPageToken))
+			})
+		})
+
+		When("page token is of another thread", func() {
+			BeforeEach(func() {
+				token, err := svc.encodePageToken("other id", uuid.Nil, &dao.Cursor{CreatedAt: time.Now(), ID: uuid.New()})
+				Expect(err).NotTo(HaveOccurred())
+
+				req.PageToken = token
+			})
+
+			It("returns invalid page token error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidPageToken))
+			})
+		})
+
+		When("DAO error", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().ListByParentID(ctx, req.GetVideoId(), uuid.Nil, nil, 3).Return(nil, errDAOUnknown)
+			})
+
+			It("returns the error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(errDAOUnknown))
+			})
+		})
+
+		When("last page", func() {
+			var comments []*dao.Comment
+
+			BeforeEach(func() {
+				comments = []*dao.Comment{dao.NewFakeComment("")}
+				commentDAO.EXPECT().ListByParentID(ctx, req.GetVideoId(), uuid.Nil, nil, 3).Return(comments, nil)
+			})
+
+			It("returns the comments without next page token", func() {
+				Expect(resp).To(Equal(&pbv2.ListCommentsResponse{
+					Comments: []*pbv2.CommentInfo{comments[0].ToProtoV2()},
+				}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("more pages", func() {
+			var comments []*dao.Comment
+
+			BeforeEach(func() {
+				comments = []*dao.Comment{dao.NewFakeComment(""), dao.NewFakeComment(""), dao.NewFakeComment("")}
+				for i, comment := range comments {
+					comment.CreatedAt = time.Date(2022, time.May, 1, 0, 0, i, 0, time.UTC)
+				}
+
+				commentDAO.EXPECT().ListByParentID(ctx, req.GetVideoId(), uuid.Nil, nil, 3).Return(comments, nil)
+			})
+
+			It("returns a page of comments with the token of the next page", func() {
+				Expect(resp.GetComments()).To(Equal([]*pbv2.CommentInfo{comments[0].ToProtoV2(), comments[1].ToProtoV2()}))
+				Expect(err).NotTo(HaveOccurred())
+
+				cursor, err := svc.decodePageToken(resp.GetNextPageToken(), req.GetVideoId(), uuid.Nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cursor).To(Equal(comments[1].Cursor()))
+			})
+		})
+
+		When("listing the replies of the next page", func() {
+			var (
+				parentID uuid.UUID
+				cursor   *dao.Cursor
+			)
+
+			BeforeEach(func() {
+				parentID = uuid.New()
+				cursor = &dao.Cursor{
+					CreatedAt: time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC),
+					ID:        uuid.New(),
+				}
+
+				token, err := svc.encodePageToken(req.GetVideoId(), parentID, cursor)
+				Expect(err).NotTo(HaveOccurred())
+
+				req.ParentId = parentID.String()
+				req.PageToken = token
+
+				commentDAO.EXPECT().ListByParentID(ctx, req.GetVideoId(), parentID, cursor, 3).Return(nil, nil)
+			})
+
+			It("lists the replies after the cursor", func() {
+				Expect(resp.GetComments()).To(BeEmpty())
+				Expect(resp.GetNextPageToken()).To(BeEmpty())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CreateComment", func() {
+		var (
+			req    *pbv2.CreateCommentRequest
+			parent *dao.Comment
+			resp   *pbv2.CreateCommentResponse
+			err    error
+		)
+
+		BeforeEach(func() {
+			parent = dao.NewFakeComment("")
+			req = &pbv2.CreateCommentRequest{
+				VideoId:  parent.VideoID,
+				ParentId: parent.ID.String(),
+				Content:  "fake content",
+			}
+
+			videoClient.EXPECT().GetVideo(ctx, &videopb.GetVideoRequest{
+				Id: req.GetVideoId(),
+			}).Return(&videopb.GetVideoResponse{}, nil)
+		})
+
+		JustBeforeEach(func() {
+			resp, err = svc.CreateComment(ctx, req)
+		})
+
+		When("parent not found", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Get(ctx, parent.ID).Return(nil, dao.ErrCommentNotFound)
+			})
+
+			It("returns invalid parent error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidParent))
+			})
+		})
+
+		When("parent belongs to another video", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Get(ctx, parent.ID).Return(dao.NewFakeComment(""), nil)
+			})
+
+			It("returns invalid parent error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidParent))
+			})
+		})
+
+		When("success", func() {
+			var (
+				id      uuid.UUID
+				comment *dao.Comment
+			)
+
+			BeforeEach(func() {
+				id = uuid.New()
+				comment = &dao.Comment{
+					VideoID:     req.GetVideoId(),
+					ParentID:    parent.ID,
+					Content:     req.GetContent(),
+					ContentHTML: markdownkit.Render(req.GetContent()),
+				}
+				scoreQuality(comment)
+
+				commentDAO.EXPECT().Get(ctx, parent.ID).Return(parent, nil)
+				commentDAO.EXPECT().Create(ctx, comment).Return(id, nil)
+				commentPubSub.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+			})
+
+			It("returns the reply with no error", func() {
+				Expect(resp.GetComment().GetId()).To(Equal(id.String()))
+				Expect(resp.GetComment().GetParentId()).To(Equal(parent.ID.String()))
+				Expect(resp.GetComment().GetContent()).To(Equal(req.GetContent()))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetComment", func() {
+		var (
+			req     *pbv2.GetCommentRequest
+			comment *dao.Comment
+			resp    *pbv2.GetCommentResponse
+			err     error
+		)
+
+		BeforeEach(func() {
+			comment = dao.NewFakeComment("")
+			comment.ParentID = uuid.New()
+			req = &pbv2.GetCommentRequest{Id: comment.ID.String()}
+		})
+
+		JustBeforeEach(func() {
+			resp, err = svc.GetComment(ctx, req)
+		})
+
+		When("comment not found", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Get(ctx, comment.ID).Return(nil, dao.ErrCommentNotFound)
+			})
+
+			It("returns comment not found error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(dao.ErrCommentNotFound))
+			})
+		})
+
+		When("success", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Get(ctx, comment.ID).Return(comment, nil)
+			})
+
+			It("returns the comment of v2 with no error", func() {
+				Expect(resp).To(Equal(&pbv2.GetCommentResponse{Comment: comment.ToProtoV2()}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("DeleteComment", func() {
+		var (
+			req *pbv2.DeleteCommentRequest
+			id  uuid.UUID
+			err error
+		)
+
+		BeforeEach(func() {
+			id = uuid.New()
+			req = &pbv2.DeleteCommentRequest{Id: id.String()}
+		})
+
+		JustBeforeEach(func() {
+			_, err = svc.DeleteComment(ctx, req)
+		})
+
+		When("success", func() {
+			BeforeEach(func() {
+				commentDAO.EXPECT().Delete(ctx, id).Return(nil)
+			})
+
+			It("deletes the comment through v1", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
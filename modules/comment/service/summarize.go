@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// summaryBatchSize is the batch size the comments are read to be summarized by
+	summaryBatchSize = 500
+	// summaryMaxComments is the most comments summarized, the latest ones are summarized beyond it
+	summaryMaxComments = 1000
+	// summaryCacheTTL is how long a summary is cached at most, the summary is refreshed once the number of the
+	// comments changes, so the TTL only bounds how long the edits of the comments take to show
+	summaryCacheTTL = time.Hour
+	// summaryCacheSize is the most summaries cached, the oldest one is evicted beyond it
+	summaryCacheSize = 10000
+	// summaryTopics is the most topics mentioned by the heuristic summary
+	summaryTopics = 3
+)
+
+// Summarizer summarizes the comments of a video. The heuristic summarizer is the default, and a summarizer of
+// a model is plugged in by WithSummarizer.
+type Summarizer interface {
+	Summarize(ctx context.Context, comments []*dao.Comment) (*Summary, error)
+}
+
+// Summary is a few sentences of what the comments are about, along with the numbers of the comments of each
+// sentiment.
+type Summary struct {
+	Text     string
+	Positive int
+	Neutral  int
+	Negative int
+}
+
+// WithSummarizer summarizes the comments by the summarizer instead of the heuristic one. It is a no-op if the
+// summarizer is nil.
+func WithSummarizer(summarizer Summarizer) ServiceOption {
+	return func(s *service) {
+		if summarizer != nil {
+			s.summarizer = summarizer
+		}
+	}
+}
+
+func (s *service) SummarizeComments(ctx context.Context, req *pb.SummarizeCommentsRequest) (*pb.SummarizeCommentsResponse, error) {
+	var comments []*dao.Comment
+	count := 0
+
+	if err := s.commentDAO.EachByVideoID(ctx, req.GetVideoId(), summaryBatchSize, func(batch []*dao.Comment) error {
+		// the comments held for moderation are not summarized, as they are not listed
+		for _, comment := range batch {
+			if comment.Status == dao.CommentStatusPublished {
+				count++
+				comments = append(comments, comment)
+			}
+		}
+
+		if len(comments) > summaryMaxComments {
+			comments = append([]*dao.Comment(nil), comments[len(comments)-summaryMaxComments:]...)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// the summarizer, which may call a model, runs only when the comments are added or deleted since the last one
+	key := tenantkit.FromContext(ctx) + ":" + req.GetVideoId()
+	entry := s.summaries.get(key, count)
+	if entry == nil {
+		summary, err := s.summarizer.Summarize(ctx, comments)
+		if err != nil {
+			return nil, err
+		}
+
+		entry = &summaryEntry{summary: summary, count: count, summarizedAt: time.Now()}
+		s.summaries.put(key, entry)
+	}
+
+	return &pb.SummarizeCommentsResponse{
+		Summary: entry.summary.Text,
+		Sentiment: &pb.CommentSentiment{
+			Positive: uint32(entry.summary.Positive),
+			Neutral:  uint32(entry.summary.Neutral),
+			Negative: uint32(entry.summary.Negative),
+		},
+		CommentCount: uint32(entry.count),
+		SummarizedAt: timestamppb.New(entry.summarizedAt),
+	}, nil
+}
+
+// summaryCache caches the summaries of the videos of the tenants along with the numbers of the comments summarized.
+type summaryCache struct {
+	mu      sync.Mutex
+	entries map[string]*summaryEntry
+}
+
+type summaryEntry struct {
+	summary      *Summary
+	count        int
+	summarizedAt time.Time
+}
+
+func newSummaryCache() *summaryCache {
+	return &summaryCache{entries: make(map[string]*summaryEntry)}
+}
+
+// get returns the summary of the key if it is of the number of the comments and not expired, nil otherwise.
+func (c *summaryCache) get(key string, count int) *summaryEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.count != count || time.Since(entry.summarizedAt) > summaryCacheTTL {
+		return nil
+	}
+
+	return entry
+}
+
+func (c *summaryCache) put(key string, entry *summaryEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= summaryCacheSize {
+		oldestKey := ""
+		for k, e := range c.entries {
+			if oldestKey == "" || e.summarizedAt.Before(c.entries[oldestKey].summarizedAt) {
+				oldestKey = k
+			}
+		}
+		delete(c.entries, oldestKey)
+	}
+
+	c.entries[key] = entry
+}
+
+// stopWords are the common words which are not the topics of the comments
+var stopWords = wordSet("about", "after", "again", "also", "always", "because", "been", "before", "being", "could",
+	"every", "finally", "from", "have", "here", "honestly", "into", "just", "more", "most", "much", "only",
+	"really", "should", "some", "still", "than", "that", "their", "them", "then", "there", "these", "they",
+	"this", "those", "totally", "very", "what", "when", "where", "which", "while", "with", "without", "would",
+	"your", "https", "http", "example")
+
+// HeuristicSummarizer summarizes the comments locally by word lists, the sentiment of a comment is of its
+// positive and negative words, a negation flipping the word after it, and the topics are the words mentioned
+// by the most comments.
+type HeuristicSummarizer struct{}
+
+func NewHeuristicSummarizer() *HeuristicSummarizer {
+	return &HeuristicSummarizer{}
+}
+
+func (h *HeuristicSummarizer) Summarize(_ context.Context, comments []*dao.Comment) (*Summary, error) {
+	summary := &Summary{}
+	mentions := make(map[string]int)
+
+	for _, comment := range comments {
+		words := commentWords(comment.Content)
+
+		switch score := sentimentScore(words); {
+		case score > 0:
+			summary.Positive++
+		case score < 0:
+			summary.Negative++
+		default:
+			summary.Neutral++
+		}
+
+		mentioned := make(map[string]bool)
+		for _, word := range words {
+			if len([]rune(word)) < 4 || stopWords[word] || positiveWords[word] || negativeWords[word] || mentioned[word] {
+				continue
+			}
+
+			mentioned[word] = true
+			mentions[word]++
+		}
+	}
+
+	summary.Text = summaryText(summary, topics(mentions))
+
+	return summary, nil
+}
+
+// topics returns the words mentioned by the most comments, and by more than one comment, in the order of the
+// mentions then the words.
+func topics(mentions map[string]int) []string {
+	words := make([]string, 0, len(mentions))
+	for word, n := range mentions {
+		if n > 1 {
+			words = append(words, word)
+		}
+	}
+
+	sort.Slice(words, func(i, j int) bool {
+		if mentions[words[i]] != mentions[words[j]] {
+			return mentions[words[i]] > mentions[words[j]]
+		}
+		return words[i] < words[j]
+	})
+
+	if len(words) > summaryTopics {
+		words = words[:summaryTopics]
+	}
+
+	return words
+}
+
+// summaryText returns the tone of the comments along with their topics, the number of the comments is not in the
+// text, as the latest comments are summarized only beyond summaryMaxComments.
+func summaryText(summary *Summary, topics []string) string {
+	if summary.Positive+summary.Neutral+summary.Negative == 0 {
+		return "No comments yet."
+	}
+
+	var text string
+	switch {
+	case summary.Neutral > summary.Positive+summary.Negative:
+		text = "The comments are mostly neutral."
+	case summary.Positive >= 2*summary.Negative:
+		text = "The comments are mostly positive."
+	case summary.Negative >= 2*summary.Positive:
+		text = "The comments are mostly negative."
+	default:
+		text = "The comments are mixed."
+	}
+
+	if len(topics) > 0 {
+		text += " The viewers talk about " + joinWords(topics) + "."
+	}
+
+	return text
+}
+
+// joinWords joins the words as a list of English, e.g. a, b and c.
+func joinWords(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
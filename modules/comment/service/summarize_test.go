@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// countingSummarizer counts the calls of the heuristic summarizer, so the specs see when the cache is refreshed.
+type countingSummarizer struct {
+	HeuristicSummarizer
+
+	calls int
+}
+
+func (c *countingSummarizer) Summarize(ctx context.Context, comments []*dao.Comment) (*Summary, error) {
+	c.calls++
+
+	return c.HeuristicSummarizer.Summarize(ctx, comments)
+}
+
+var _ = Describe("HeuristicSummarizer", func() {
+	summarize := func(contents ...string) *Summary {
+		comments := make([]*dao.Comment, 0, len(contents))
+		for _, content := range contents {
+			comments = append(comments, &dao.Comment{Content: content})
+		}
+
+		summary, err := NewHeuristicSummarizer().Summarize(context.Background(), comments)
+		Expect(err).NotTo(HaveOccurred())
+
+		return summary
+	}
+
+	DescribeTable("counts the sentiment of the comments",
+		func(content string, positive, neutral, negative int) {
+			summary := summarize(content)
+			Expect(summary.Positive).To(Equal(positive))
+			Expect(summary.Neutral).To(Equal(neutral))
+			Expect(summary.Negative).To(Equal(negative))
+		},
+		Entry("positive", "**Great** video, love it!", 1, 0, 0),
+		Entry("negative", "So boring and too loud", 0, 0, 1),
+		Entry("neutral", "Watched it on the train", 0, 1, 0),
+		Entry("negated", "not good at all", 0, 0, 1),
+		Entry("balanced", "good intro but boring ending", 0, 1, 0),
+	)
+
+	It("summarizes the tone and the topics of the comments", func() {
+		summary := summarize(
+			"The music is great",
+			"Love the music and the camera work",
+			"camera shake is bad but the music is awesome",
+			"Watched on mobile",
+		)
+		Expect(summary.Text).To(Equal("The comments are mostly positive. The viewers talk about music and camera."))
+	})
+
+	When("there are no comments", func() {
+		It("says so", func() {
+			Expect(summarize().Text).To(Equal("No comments yet."))
+		})
+	})
+})
+
+var _ = Describe("SummarizeComments", func() {
+	var (
+		commentDAO dao.CommentDAO
+		summarizer *countingSummarizer
+		svc        *service
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		summarizer = &countingSummarizer{}
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil, WithSummarizer(summarizer))
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	createComment := func(ctx context.Context, content string) *dao.Comment {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		_, err := commentDAO.Create(ctx, comment)
+		Expect(err).NotTo(HaveOccurred())
+
+		return comment
+	}
+
+	summarize := func(ctx context.Context) *pb.SummarizeCommentsResponse {
+		resp, err := svc.SummarizeComments(ctx, &pb.SummarizeCommentsRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp
+	}
+
+	It("summarizes the comments of the video", func() {
+		createComment(ctx, "great video")
+		createComment(ctx, "boring")
+		createComment(ctx, "great ending")
+
+		resp := summarize(ctx)
+		Expect(resp.GetCommentCount()).To(BeEquivalentTo(3))
+		Expect(resp.GetSentiment().GetPositive()).To(BeEquivalentTo(2))
+		Expect(resp.GetSentiment().GetNegative()).To(BeEquivalentTo(1))
+		Expect(resp.GetSummary()).To(Equal("The comments are mostly positive."))
+		Expect(resp.GetSummarizedAt().IsValid()).To(BeTrue())
+	})
+
+	It("refreshes the cached summary once the number of the comments changes", func() {
+		createComment(ctx, "great video")
+
+		first := summarize(ctx)
+		Expect(summarize(ctx).GetSummarizedAt().AsTime()).To(Equal(first.GetSummarizedAt().AsTime()))
+		Expect(summarizer.calls).To(Equal(1))
+
+		comment := createComment(ctx, "boring")
+		Expect(summarize(ctx).GetCommentCount()).To(BeEquivalentTo(2))
+		Expect(summarizer.calls).To(Equal(2))
+
+		Expect(commentDAO.Delete(ctx, comment.ID)).To(Succeed())
+		Expect(summarize(ctx).GetCommentCount()).To(BeEquivalentTo(1))
+		Expect(summarizer.calls).To(Equal(3))
+	})
+
+	It("caches the summaries of the tenants apart", func() {
+		createComment(ctx, "great video")
+		Expect(summarize(ctx).GetCommentCount()).To(BeEquivalentTo(1))
+
+		resp := summarize(tenantkit.WithTenantID(ctx, "another-tenant"))
+		Expect(resp.GetCommentCount()).To(BeZero())
+		Expect(resp.GetSummary()).To(Equal("No comments yet."))
+	})
+})
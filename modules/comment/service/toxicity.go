@@ -0,0 +1,151 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ToxicityConfig struct {
+	URL       string        `long:"url" env:"URL" description:"the URL of the toxicity model server scoring the comments"`
+	Threshold float64       `long:"threshold" env:"THRESHOLD" description:"the comments scored above the threshold, from 0 to 1, are held for moderation" default:"0.8"`
+	Timeout   time.Duration `long:"timeout" env:"TIMEOUT" description:"the timeout of a request to the model server" default:"5s"`
+}
+
+var ErrInvalidToxicityScore = errors.New("invalid toxicity score")
+
+// ToxicityScorer scores how toxic a comment content is from 0, not toxic at all, to 1.
+type ToxicityScorer interface {
+	Score(ctx context.Context, content string) (float64, error)
+}
+
+// HTTPToxicityScorer scores the contents by a model server, which responds {"score": 0.97} to the POST of
+// {"text": "..."}.
+type HTTPToxicityScorer struct {
+	url    string
+	client *http.Client
+}
+
+var _ ToxicityScorer = (*HTTPToxicityScorer)(nil)
+
+func NewHTTPToxicityScorer(conf *ToxicityConfig) *HTTPToxicityScorer {
+	return &HTTPToxicityScorer{
+		url:    conf.URL,
+		client: &http.Client{Timeout: conf.Timeout},
+	}
+}
+
+func (s *HTTPToxicityScorer) Score(ctx context.Context, content string) (float64, error) {
+	reqBody, err := json.Marshal(map[string]string{"text": content})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(reqBody))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("toxicity model server: %s", resp.Status)
+	}
+
+	var respBody struct {
+		Score *float64 `json:"score"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return 0, fmt.Errorf("toxicity model server: %w", err)
+	}
+
+	if respBody.Score == nil || *respBody.Score < 0 || *respBody.Score > 1 {
+		return 0, ErrInvalidToxicityScore
+	}
+
+	return *respBody.Score, nil
+}
+
+// ToxicityModerator holds the comments scored above the threshold for moderation once they are created. It handles
+// the change events of the comments table, so the comments are scored without slowing down their creation, and
+// are listed until they are held.
+type ToxicityModerator struct {
+	commentDAO dao.CommentDAO
+	scorer     ToxicityScorer
+	threshold  float64
+}
+
+var _ cdckit.Handler = (*ToxicityModerator)(nil)
+
+func NewToxicityModerator(commentDAO dao.CommentDAO, scorer ToxicityScorer, threshold float64) *ToxicityModerator {
+	return &ToxicityModerator{
+		commentDAO: commentDAO,
+		scorer:     scorer,
+		threshold:  threshold,
+	}
+}
+
+// HandleChange scores the created comment. The failure of the scorer is returned, so the event is handled again
+// from the last checkpoint, and holding a comment again is harmless.
+func (m *ToxicityModerator) HandleChange(ctx context.Context, event *cdckit.ChangeEvent) error {
+	if event.Op != cdckit.OperationCreate {
+		return nil
+	}
+
+	var row struct {
+		ID       uuid.UUID `json:"id"`
+		TenantID string    `json:"tenant_id"`
+	}
+	if err := json.Unmarshal(event.Row(), &row); err != nil {
+		return err
+	}
+
+	ctx = tenantkit.WithTenantID(ctx, row.TenantID)
+
+	// the comment is read by the DAO instead of the row, so the content is decrypted if it is encrypted
+	comment, err := m.commentDAO.Get(ctx, row.ID)
+	if errors.Is(err, dao.ErrCommentNotFound) {
+		// the comment is deleted before it is scored
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	score, err := m.scorer.Score(ctx, comment.Content)
+	if err != nil {
+		return err
+	}
+
+	if score <= m.threshold {
+		return nil
+	}
+
+	logkit.FromContext(ctx).Info("hold toxic comment for moderation",
+		zap.String("tenant_id", row.TenantID),
+		zap.String("comment_id", comment.ID.String()),
+		zap.Float64("toxicity", score),
+	)
+
+	if err := m.commentDAO.Hold(ctx, comment.ID, score); err != nil && !errors.Is(err, dao.ErrCommentNotFound) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fakeToxicityScorer scores the contents by the scores of them, the others are not toxic at all.
+type fakeToxicityScorer map[string]float64
+
+func (s fakeToxicityScorer) Score(_ context.Context, content string) (float64, error) {
+	if content == "unavailable" {
+		return 0, errors.New("model server unavailable")
+	}
+
+	return s[content], nil
+}
+
+var _ = Describe("HTTPToxicityScorer", func() {
+	var (
+		server   *httptest.Server
+		response string
+	)
+
+	BeforeEach(func() {
+		response = `{"score": 0.97}`
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Text string `json:"text"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Text == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			fmt.Fprint(w, response)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	score := func(content string) (float64, error) {
+		scorer := NewHTTPToxicityScorer(&ToxicityConfig{URL: server.URL, Timeout: time.Second})
+		return scorer.Score(context.Background(), content)
+	}
+
+	It("scores the content by the model server", func() {
+		Expect(score("you are awful")).To(Equal(0.97))
+	})
+
+	It("returns the error of the model server", func() {
+		_, err := score("")
+		Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+	})
+
+	DescribeTable("returns ErrInvalidToxicityScore if the score is invalid",
+		func(invalid string) {
+			response = invalid
+
+			_, err := score("you are awful")
+			Expect(err).To(MatchError(ErrInvalidToxicityScore))
+		},
+		Entry("missing", `{}`),
+		Entry("negative", `{"score": -0.1}`),
+		Entry("above one", `{"score": 1.5}`),
+	)
+})
+
+var _ = Describe("Moderation", func() {
+	var (
+		commentDAO dao.CommentDAO
+		svc        *service
+		moderator  *ToxicityModerator
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		commentDAO = dao.NewMemoryCommentDAO()
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil,
+			WithPageTokens(pagekit.NewCodec(ctx, &pagekit.Config{Secret: "fake secret of the page tokens", TTL: time.Hour})),
+		)
+		moderator = NewToxicityModerator(commentDAO, fakeToxicityScorer{"toxic": 0.95, "hateful": 0.9, "rude": 0.6}, 0.8)
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	// createComment creates the comment and moderates it by the change event of its creation
+	createComment := func(content string) (*dao.Comment, error) {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		Expect(svc.createComment(ctx, comment)).To(Succeed())
+
+		row, err := json.Marshal(map[string]string{"id": comment.ID.String(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+
+		return comment, moderator.HandleChange(ctx, &cdckit.ChangeEvent{Op: cdckit.OperationCreate, After: row})
+	}
+
+	listComments := func() []string {
+		resp, err := svc.ListComment(ctx, &pb.ListCommentRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(resp.GetComments()))
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("holds the comments scored above the threshold until they are approved", func() {
+		toxic, err := createComment("toxic")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = createComment("rude")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(listComments()).To(Equal([]string{"rude"}))
+
+		pending, err := svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending.GetComments()).To(HaveLen(1))
+		Expect(pending.GetComments()[0].GetStatus()).To(Equal(pb.CommentStatus_COMMENT_STATUS_PENDING))
+		Expect(pending.GetComments()[0].GetToxicity()).To(Equal(0.95))
+
+		approved, err := svc.ApproveComment(ctx, &pb.ApproveCommentRequest{Id: toxic.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(approved.GetComment().GetStatus()).To(Equal(pb.CommentStatus_COMMENT_STATUS_PUBLISHED))
+
+		Expect(listComments()).To(Equal([]string{"toxic", "rude"}))
+
+		pending, err = svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending.GetComments()).To(BeEmpty())
+	})
+
+	It("pages the pending comments by the page tokens, the most toxic first", func() {
+		_, err := createComment("hateful")
+		Expect(err).NotTo(HaveOccurred())
+		toxic, err := createComment("toxic")
+		Expect(err).NotTo(HaveOccurred())
+
+		pending, err := svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{PageSize: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending.GetComments()).To(HaveLen(1))
+		Expect(pending.GetComments()[0].GetContent()).To(Equal("toxic"))
+		Expect(pending.GetNextPageToken()).NotTo(BeEmpty())
+
+		// the comments moderated between the pages do not shift the next page
+		_, err = svc.ApproveComment(ctx, &pb.ApproveCommentRequest{Id: toxic.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+
+		pending, err = svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{PageSize: 1, PageToken: pending.GetNextPageToken()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending.GetComments()).To(HaveLen(1))
+		Expect(pending.GetComments()[0].GetContent()).To(Equal("hateful"))
+		Expect(pending.GetNextPageToken()).To(BeEmpty())
+	})
+
+	It("returns ErrInvalidPageToken if the page token is of another tenant", func() {
+		_, err := createComment("hateful")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = createComment("toxic")
+		Expect(err).NotTo(HaveOccurred())
+
+		pending, err := svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{PageSize: 1})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.ListPendingComments(tenantkit.WithTenantID(ctx, "another-tenant"), &pb.ListPendingCommentsRequest{PageToken: pending.GetNextPageToken()})
+		Expect(err).To(MatchError(ErrInvalidPageToken))
+	})
+
+	It("returns ErrPageTokensDisabled if the page tokens are not configured", func() {
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil)
+
+		_, err := svc.ListPendingComments(ctx, &pb.ListPendingCommentsRequest{})
+		Expect(err).To(MatchError(ErrPageTokensDisabled))
+	})
+
+	It("publishes the comment until the scorer recovers", func() {
+		_, err := createComment("unavailable")
+		Expect(err).To(HaveOccurred())
+
+		Expect(listComments()).To(Equal([]string{"unavailable"}))
+	})
+
+	It("ignores the comment deleted before it is scored", func() {
+		row, err := json.Marshal(map[string]string{"id": uuid.NewString(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(moderator.HandleChange(ctx, &cdckit.ChangeEvent{Op: cdckit.OperationCreate, After: row})).To(Succeed())
+	})
+
+	It("returns ErrCommentNotFound if the approved comment does not exist", func() {
+		_, err := svc.ApproveComment(ctx, &pb.ApproveCommentRequest{Id: uuid.NewString()})
+		Expect(err).To(MatchError(dao.ErrCommentNotFound))
+	})
+})
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+)
+
+func (s *service) ListTopComments(ctx context.Context, req *pb.ListTopCommentsRequest) (*pb.ListTopCommentsResponse, error) {
+	if s.pageTokens == nil {
+		return nil, ErrPageTokensDisabled
+	}
+
+	if err := s.checkVideoVisible(ctx, req.GetVideoId()); err != nil {
+		return nil, err
+	}
+
+	filter := topPageTokenFilter(req.GetVideoId())
+
+	offset, err := s.decodeOffsetPageToken(req.GetPageToken(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// list one more comment to know whether there is a next page
+	comments, err := s.commentDAO.ListTop(ctx, req.GetVideoId(), pageSize+1, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListTopCommentsResponse{}
+
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+
+		if resp.NextPageToken, err = s.encodeOffsetPageToken(filter, offset+pageSize); err != nil {
+			return nil, err
+		}
+	}
+
+	if comments, err = s.hideBlockedComments(ctx, comments); err != nil {
+		return nil, err
+	}
+
+	resp.Comments = make([]*pb.CommentInfo, 0, len(comments))
+	for _, comment := range comments {
+		resp.Comments = append(resp.Comments, comment.ToProto())
+	}
+
+	return resp, nil
+}
+
+// WithLikeCounter counts the likes of the comments by the DAO, whose counts are the source of truth of the likes. It is
+// a no-op if the DAO is nil.
+func WithLikeCounter(commentLikeCounterDAO dao.CommentLikeCounterDAO) ServiceOption {
+	return func(s *service) {
+		if commentLikeCounterDAO != nil {
+			s.commentLikeCounterDAO = commentLikeCounterDAO
+		}
+	}
+}
+
+// LikeComment adds a like of the caller to the comment once, the like is counted by the like counter, whose counts are
+// copied to the comments and added to their engagement scores by the trending consumer eventually. The caller is the
+// principal of grpckit.Principal, so the likes are not inflated by the user IDs sent by a client.
+func (s *service) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentResponse, error) {
+	if s.commentLikeCounterDAO == nil {
+		return nil, ErrLikesDisabled
+	}
+
+	commentID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidUUID
+	}
+
+	liked, err := s.commentDAO.Like(ctx, commentID, grpckit.Principal(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if liked {
+		if err := s.commentLikeCounterDAO.Incr(ctx, commentID, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	comment, err := s.commentDAO.Get(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if liked {
+		s.addReputation(ctx, comment, dao.ReputationLikeReceived)
+	}
+
+	likes, err := s.commentLikeCounterDAO.Get(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	comment.Likes = likes
+
+	return &pb.LikeCommentResponse{Comment: comment.ToProto()}, nil
+}
+
+// countImportedLikes adds the likes of the comments imported to the like counter, so the likes imported are not lost
+// once the counts are copied to the comments.
+func (s *service) countImportedLikes(ctx context.Context, comments []*dao.Comment) error {
+	if s.commentLikeCounterDAO == nil {
+		return nil
+	}
+
+	for _, comment := range comments {
+		if comment.Likes == 0 {
+			continue
+		}
+
+		if err := s.commentLikeCounterDAO.Incr(ctx, comment.ID, comment.Likes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EngagementTracker tracks the engagements of the comments by the change events of the comments table, so the
+// engagement scores are added to once an engagement happens, instead of being computed by ListTopComments.
+type EngagementTracker struct {
+	commentDAO dao.CommentDAO
+}
+
+var _ cdckit.Handler = (*EngagementTracker)(nil)
+
+func NewEngagementTracker(commentDAO dao.CommentDAO) *EngagementTracker {
+	return &EngagementTracker{
+		commentDAO: commentDAO,
+	}
+}
+
+// HandleChange tracks the engagements of the created or updated comment at the time of the change. The comment is
+// read by the DAO instead of the row, so the likes are of the comment now, and tracking them again is harmless.
+func (t *EngagementTracker) HandleChange(ctx context.Context, event *cdckit.ChangeEvent) error {
+	if event.Op == cdckit.OperationDelete {
+		return nil
+	}
+
+	var row struct {
+		ID       uuid.UUID `json:"id"`
+		TenantID string    `json:"tenant_id"`
+	}
+	if err := json.Unmarshal(event.Row(), &row); err != nil {
+		return err
+	}
+
+	ctx = tenantkit.WithTenantID(ctx, row.TenantID)
+
+	comment, err := t.commentDAO.Get(ctx, row.ID)
+	if errors.Is(err, dao.ErrCommentNotFound) {
+		// the comment is deleted before it is tracked
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	at := time.Now()
+	if event.Source.TsMs > 0 {
+		at = time.UnixMilli(event.Source.TsMs)
+	}
+
+	return t.commentDAO.TrackEngagement(ctx, comment, at)
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/cdckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/peer"
+)
+
+// callerContext returns the context of a call from the peer of the IP, which is the principal liking the comments.
+func callerContext(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50051}})
+}
+
+var _ = Describe("Trending", func() {
+	var (
+		commentDAO dao.CommentDAO
+		svc        *service
+		tracker    *EngagementTracker
+		ctx        context.Context
+		videoID    string
+	)
+
+	BeforeEach(func() {
+		commentDAO = dao.NewMemoryCommentDAO()
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		svc = NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil,
+			WithLikeCounter(dao.NewMemoryCommentLikeCounterDAO(commentDAO)),
+			WithPageTokens(pagekit.NewCodec(ctx, &pagekit.Config{Secret: "fake secret of the page tokens", TTL: time.Hour})),
+		)
+		tracker = NewEngagementTracker(commentDAO)
+		videoID = primitive.NewObjectID().Hex()
+	})
+
+	// handleChange tracks the engagements of the comment by a change event of it
+	handleChange := func(op cdckit.Operation, id uuid.UUID) error {
+		row, err := json.Marshal(map[string]string{"id": id.String(), "tenant_id": tenantkit.FromContext(ctx)})
+		Expect(err).NotTo(HaveOccurred())
+
+		return tracker.HandleChange(ctx, &cdckit.ChangeEvent{Op: op, After: row})
+	}
+
+	createComment := func(content string) *dao.Comment {
+		comment := &dao.Comment{VideoID: videoID, Content: content}
+		Expect(svc.createComment(ctx, comment)).To(Succeed())
+		Expect(handleChange(cdckit.OperationCreate, comment.ID)).To(Succeed())
+
+		return comment
+	}
+
+	likeComment := func(comment *dao.Comment, ip string) {
+		resp, err := svc.LikeComment(callerContext(ctx, ip), &pb.LikeCommentRequest{Id: comment.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComment().GetId()).To(Equal(comment.ID.String()))
+		Expect(handleChange(cdckit.OperationUpdate, comment.ID)).To(Succeed())
+	}
+
+	listTopComments := func() []string {
+		resp, err := svc.ListTopComments(ctx, &pb.ListTopCommentsRequest{VideoId: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents := make([]string, 0, len(resp.GetComments()))
+		for _, comment := range resp.GetComments() {
+			contents = append(contents, comment.GetContent())
+		}
+
+		return contents
+	}
+
+	It("lists the comments in the order of the engagements tracked by the change events", func() {
+		first := createComment("first")
+		createComment("second")
+		liked := createComment("liked")
+
+		Expect(listTopComments()).To(Equal([]string{"liked", "second", "first"}))
+
+		likeComment(liked, "10.0.0.1")
+		likeComment(first, "10.0.0.1")
+		likeComment(first, "10.0.0.2")
+
+		Expect(listTopComments()).To(Equal([]string{"first", "liked", "second"}))
+	})
+
+	It("returns the liked comment with its likes", func() {
+		comment := createComment("liked")
+
+		resp, err := svc.LikeComment(ctx, &pb.LikeCommentRequest{Id: comment.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComment().GetLikes()).To(Equal(int64(1)))
+	})
+
+	It("counts the likes of a caller once", func() {
+		comment := createComment("liked")
+
+		for i := 0; i < 3; i++ {
+			resp, err := svc.LikeComment(callerContext(ctx, "10.0.0.1"), &pb.LikeCommentRequest{Id: comment.ID.String()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetComment().GetLikes()).To(Equal(int64(1)))
+		}
+
+		resp, err := svc.LikeComment(callerContext(ctx, "10.0.0.2"), &pb.LikeCommentRequest{Id: comment.ID.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComment().GetLikes()).To(Equal(int64(2)))
+	})
+
+	It("counts the likes of the imported comments", func() {
+		id := uuid.NewString()
+		count, err := svc.bulkImportComments(ctx, []*pb.CommentInfo{{Id: id, VideoId: videoID, Content: "imported", Likes: 5}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+
+		resp, err := svc.LikeComment(ctx, &pb.LikeCommentRequest{Id: id})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComment().GetLikes()).To(Equal(int64(6)))
+	})
+
+	It("returns ErrLikesDisabled without the like counter", func() {
+		comment := createComment("liked")
+
+		_, err := NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil).LikeComment(ctx, &pb.LikeCommentRequest{Id: comment.ID.String()})
+		Expect(err).To(MatchError(ErrLikesDisabled))
+	})
+
+	It("pages the comments by the page tokens", func() {
+		third := createComment("third")
+		second := createComment("second")
+		first := createComment("first")
+		likeComment(first, "10.0.0.1")
+		likeComment(first, "10.0.0.2")
+		likeComment(second, "10.0.0.1")
+
+		resp, err := svc.ListTopComments(ctx, &pb.ListTopCommentsRequest{VideoId: videoID, PageSize: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComments()).To(HaveLen(2))
+		Expect(resp.GetComments()[0].GetId()).To(Equal(first.ID.String()))
+		Expect(resp.GetComments()[1].GetId()).To(Equal(second.ID.String()))
+		Expect(resp.GetNextPageToken()).NotTo(BeEmpty())
+
+		resp, err = svc.ListTopComments(ctx, &pb.ListTopCommentsRequest{VideoId: videoID, PageSize: 2, PageToken: resp.GetNextPageToken()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComments()).To(HaveLen(1))
+		Expect(resp.GetComments()[0].GetId()).To(Equal(third.ID.String()))
+		Expect(resp.GetNextPageToken()).To(BeEmpty())
+	})
+
+	It("returns ErrInvalidPageToken if the page token is of another video", func() {
+		token, err := svc.encodeOffsetPageToken(topPageTokenFilter(primitive.NewObjectID().Hex()), 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.ListTopComments(ctx, &pb.ListTopCommentsRequest{VideoId: videoID, PageToken: token})
+		Expect(err).To(MatchError(ErrInvalidPageToken))
+	})
+
+	It("returns ErrPageTokensDisabled without the page tokens", func() {
+		_, err := NewService(commentDAO, dao.NewMemoryCommentPubSub(), nil, nil).ListTopComments(ctx, &pb.ListTopCommentsRequest{VideoId: videoID})
+		Expect(err).To(MatchError(ErrPageTokensDisabled))
+	})
+
+	It("does not list the comments not tracked yet", func() {
+		Expect(svc.createComment(ctx, &dao.Comment{VideoID: videoID, Content: "untracked"})).To(Succeed())
+
+		Expect(listTopComments()).To(BeEmpty())
+	})
+
+	It("ignores the comment deleted before it is tracked", func() {
+		Expect(handleChange(cdckit.OperationCreate, uuid.New())).To(Succeed())
+	})
+
+	It("returns ErrCommentNotFound if the liked comment does not exist", func() {
+		_, err := svc.LikeComment(ctx, &pb.LikeCommentRequest{Id: uuid.NewString()})
+		Expect(err).To(MatchError(dao.ErrCommentNotFound))
+	})
+})
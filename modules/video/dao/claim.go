@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ClaimPolicy is the action applied to a video claimed while the claim is active.
+type ClaimPolicy string
+
+const (
+	ClaimPolicyNone ClaimPolicy = ""
+	// ClaimPolicyBlock hides the video from every user
+	ClaimPolicyBlock ClaimPolicy = "block"
+	// ClaimPolicyMonetize keeps the video up, with its revenue taken by the claimant
+	ClaimPolicyMonetize ClaimPolicy = "monetize"
+)
+
+func (p ClaimPolicy) String() string {
+	return string(p)
+}
+
+type ClaimStatus string
+
+const (
+	ClaimStatusActive   ClaimStatus = "active"
+	ClaimStatusDisputed ClaimStatus = "disputed"
+)
+
+func (s ClaimStatus) String() string {
+	return string(s)
+}
+
+// Claim is a copyright claim on a video as a copy of a reference video of the claimant.
+type Claim struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	TenantID         string             `bson:"tenant_id,omitempty"`
+	VideoID          primitive.ObjectID `bson:"video_id,omitempty"`
+	ReferenceVideoID primitive.ObjectID `bson:"reference_video_id,omitempty"`
+	ClaimantID       string             `bson:"claimant_id,omitempty"`
+	Policy           ClaimPolicy        `bson:"policy,omitempty"`
+	Status           ClaimStatus        `bson:"status,omitempty"`
+	Reason           string             `bson:"reason,omitempty"`
+	DisputeReason    string             `bson:"dispute_reason,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at,omitempty"`
+	UpdatedAt        time.Time          `bson:"updated_at,omitempty"`
+}
+
+func (c *Claim) ToProto() *pb.Claim {
+	return &pb.Claim{
+		Id:               c.ID.Hex(),
+		VideoId:          c.VideoID.Hex(),
+		ReferenceVideoId: c.ReferenceVideoID.Hex(),
+		ClaimantId:       c.ClaimantID,
+		Policy:           c.Policy.String(),
+		Status:           c.Status.String(),
+		Reason:           c.Reason,
+		DisputeReason:    c.DisputeReason,
+		CreatedAt:        timestamppb.New(c.CreatedAt),
+		UpdatedAt:        timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// ClaimDAO keeps the claims of the tenant of the context, where a video has one active claim at most.
+type ClaimDAO interface {
+	Get(ctx context.Context, id primitive.ObjectID) (*Claim, error)
+	// ListByVideoID lists the claims of the video created after the claim of the ID in the order of creation, from the
+	// first one if the ID is zero, a non-positive limit means no limit
+	ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Claim, error)
+	// ListByClaimantID lists the claims submitted by the user like ListByVideoID
+	ListByClaimantID(ctx context.Context, claimantID string, after primitive.ObjectID, limit int64) ([]*Claim, error)
+	// Create creates the active claim, it returns ErrVideoAlreadyClaimed if the video has an active claim
+	Create(ctx context.Context, claim *Claim) error
+	// Dispute disputes the active claim for the reason at the time, it returns ErrClaimNotActive if the claim is
+	// disputed
+	Dispute(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error
+}
+
+var (
+	ErrClaimNotFound       = errors.New("claim not found")
+	ErrClaimNotActive      = errors.New("claim not active")
+	ErrVideoAlreadyClaimed = errors.New("video already claimed")
+)
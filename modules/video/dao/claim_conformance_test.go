@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("ClaimDAO conformance", func() {
+	Describe("mongoClaimDAO", func() {
+		itBehavesLikeClaimDAO(func() ClaimDAO {
+			collection := mongoClient.Database().Collection("claims")
+			Expect(CreateClaimIndexes(context.Background(), collection)).To(Succeed())
+
+			return NewMongoClaimDAO(collection)
+		})
+	})
+
+	Describe("memoryClaimDAO", func() {
+		itBehavesLikeClaimDAO(func() ClaimDAO {
+			return NewMemoryClaimDAO()
+		})
+	})
+})
+
+func newFakeClaim(videoID primitive.ObjectID, claimantID string) *Claim {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	return &Claim{
+		VideoID:          videoID,
+		ReferenceVideoID: primitive.NewObjectID(),
+		ClaimantID:       claimantID,
+		Policy:           ClaimPolicyBlock,
+		Reason:           "a copy of my video",
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// itBehavesLikeClaimDAO specifies the semantics every ClaimDAO shares. Every spec runs in a tenant of its own, and
+// deletes its claims after.
+func itBehavesLikeClaimDAO(newClaimDAO func() ClaimDAO) {
+	var (
+		claimDAO ClaimDAO
+		ctx      context.Context
+		videoID  primitive.ObjectID
+	)
+
+	BeforeEach(func() {
+		claimDAO = newClaimDAO()
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("conformance-%d", time.Now().UnixNano()))
+		videoID = primitive.NewObjectID()
+
+		if dao, ok := claimDAO.(*mongoClaimDAO); ok {
+			DeferCleanup(func() {
+				_, err := dao.collection.DeleteMany(context.Background(), bson.M{"tenant_id": tenantkit.FromContext(ctx)})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		}
+	})
+
+	create := func(claim *Claim) *Claim {
+		Expect(claimDAO.Create(ctx, claim)).To(Succeed())
+		Expect(claim.ID).NotTo(Equal(primitive.NilObjectID))
+
+		return claim
+	}
+
+	Describe("Create", func() {
+		It("creates the active claim", func() {
+			claim := create(newFakeClaim(videoID, "alice"))
+
+			Expect(claim.Status).To(Equal(ClaimStatusActive))
+			Expect(claimDAO.Get(ctx, claim.ID)).To(Equal(claim))
+		})
+
+		It("refuses the second active claim on the video", func() {
+			create(newFakeClaim(videoID, "alice"))
+
+			Expect(claimDAO.Create(ctx, newFakeClaim(videoID, "bob"))).To(MatchError(ErrVideoAlreadyClaimed))
+		})
+
+		It("creates the claim on the video after the active claim is disputed", func() {
+			claim := create(newFakeClaim(videoID, "alice"))
+			Expect(claimDAO.Dispute(ctx, claim.ID, "my own video", time.Now())).To(Succeed())
+
+			create(newFakeClaim(videoID, "bob"))
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns claim not found error for the claim of another tenant", func() {
+			claim := create(newFakeClaim(videoID, "alice"))
+
+			otherCtx := tenantkit.WithTenantID(context.Background(), fmt.Sprintf("conformance-%d", time.Now().UnixNano()))
+			_, err := claimDAO.Get(otherCtx, claim.ID)
+			Expect(err).To(MatchError(ErrClaimNotFound))
+		})
+	})
+
+	Describe("ListByVideoID and ListByClaimantID", func() {
+		It("lists the claims in the order of creation", func() {
+			first := create(newFakeClaim(videoID, "alice"))
+			Expect(claimDAO.Dispute(ctx, first.ID, "my own video", time.Now())).To(Succeed())
+			second := create(newFakeClaim(videoID, "bob"))
+			third := create(newFakeClaim(primitive.NewObjectID(), "alice"))
+
+			claims, err := claimDAO.ListByVideoID(ctx, videoID, primitive.NilObjectID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(HaveLen(2))
+			Expect(claims[0].ID).To(Equal(first.ID))
+			Expect(claims[1].ID).To(Equal(second.ID))
+
+			claims, err = claimDAO.ListByClaimantID(ctx, "alice", primitive.NilObjectID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(HaveLen(2))
+			Expect(claims[0].ID).To(Equal(first.ID))
+			Expect(claims[1].ID).To(Equal(third.ID))
+		})
+
+		It("lists a page of the claims after the claim", func() {
+			first := create(newFakeClaim(videoID, "alice"))
+			Expect(claimDAO.Dispute(ctx, first.ID, "my own video", time.Now())).To(Succeed())
+			second := create(newFakeClaim(videoID, "bob"))
+			third := create(newFakeClaim(primitive.NewObjectID(), "alice"))
+			fourth := create(newFakeClaim(primitive.NewObjectID(), "alice"))
+
+			claims, err := claimDAO.ListByVideoID(ctx, videoID, primitive.NilObjectID, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(HaveLen(1))
+			Expect(claims[0].ID).To(Equal(first.ID))
+
+			claims, err = claimDAO.ListByVideoID(ctx, videoID, first.ID, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(HaveLen(1))
+			Expect(claims[0].ID).To(Equal(second.ID))
+
+			claims, err = claimDAO.ListByClaimantID(ctx, "alice", first.ID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(HaveLen(2))
+			Expect(claims[0].ID).To(Equal(third.ID))
+			Expect(claims[1].ID).To(Equal(fourth.ID))
+		})
+	})
+
+	Describe("Dispute", func() {
+		It("disputes the active claim once", func() {
+			claim := create(newFakeClaim(videoID, "alice"))
+			disputedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+			Expect(claimDAO.Dispute(ctx, claim.ID, "my own video", disputedAt)).To(Succeed())
+
+			disputed, err := claimDAO.Get(ctx, claim.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disputed.Status).To(Equal(ClaimStatusDisputed))
+			Expect(disputed.DisputeReason).To(Equal("my own video"))
+			Expect(disputed.UpdatedAt).To(BeTemporally("==", disputedAt))
+
+			Expect(claimDAO.Dispute(ctx, claim.ID, "my own video", time.Now())).To(MatchError(ErrClaimNotActive))
+		})
+
+		It("returns claim not found error for the missing claim", func() {
+			Expect(claimDAO.Dispute(ctx, primitive.NewObjectID(), "my own video", time.Now())).To(MatchError(ErrClaimNotFound))
+		})
+	})
+}
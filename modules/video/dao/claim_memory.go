@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryClaimDAO keeps the claims in memory, it is meant for running the modules without MongoDB in local
+// development.
+type memoryClaimDAO struct {
+	mu     sync.RWMutex
+	claims map[primitive.ObjectID]*Claim
+}
+
+var _ ClaimDAO = (*memoryClaimDAO)(nil)
+
+func NewMemoryClaimDAO() *memoryClaimDAO {
+	return &memoryClaimDAO{
+		claims: make(map[primitive.ObjectID]*Claim),
+	}
+}
+
+func (dao *memoryClaimDAO) Get(ctx context.Context, id primitive.ObjectID) (*Claim, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	claim, ok := dao.claims[id]
+	if !ok || !claimInTenant(ctx, claim) {
+		return nil, ErrClaimNotFound
+	}
+
+	copied := *claim
+	return &copied, nil
+}
+
+func (dao *memoryClaimDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	return dao.list(ctx, after, limit, func(claim *Claim) bool {
+		return claim.VideoID == videoID
+	}), nil
+}
+
+func (dao *memoryClaimDAO) ListByClaimantID(ctx context.Context, claimantID string, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	return dao.list(ctx, after, limit, func(claim *Claim) bool {
+		return claim.ClaimantID == claimantID
+	}), nil
+}
+
+func (dao *memoryClaimDAO) Create(ctx context.Context, claim *Claim) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	claim.TenantID = tenantkit.FromContext(ctx)
+	for _, c := range dao.claims {
+		if c.TenantID == claim.TenantID && c.VideoID == claim.VideoID && c.Status == ClaimStatusActive {
+			return ErrVideoAlreadyClaimed
+		}
+	}
+
+	claim.ID = primitive.NewObjectID()
+	claim.Status = ClaimStatusActive
+
+	copied := *claim
+	dao.claims[claim.ID] = &copied
+
+	return nil
+}
+
+func (dao *memoryClaimDAO) Dispute(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	claim, ok := dao.claims[id]
+	if !ok || !claimInTenant(ctx, claim) {
+		return ErrClaimNotFound
+	}
+	if claim.Status != ClaimStatusActive {
+		return ErrClaimNotActive
+	}
+
+	claim.Status = ClaimStatusDisputed
+	claim.DisputeReason = reason
+	claim.UpdatedAt = at
+
+	return nil
+}
+
+// list lists the claims of the tenant of the context matching the predicate in the order of creation.
+func (dao *memoryClaimDAO) list(ctx context.Context, after primitive.ObjectID, limit int64, match func(claim *Claim) bool) []*Claim {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	claims := make([]*Claim, 0)
+	for _, claim := range dao.claims {
+		if claimInTenant(ctx, claim) && bytes.Compare(claim.ID[:], after[:]) > 0 && match(claim) {
+			copied := *claim
+			claims = append(claims, &copied)
+		}
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		return bytes.Compare(claims[i].ID[:], claims[j].ID[:]) < 0
+	})
+
+	if limit > 0 && int64(len(claims)) > limit {
+		claims = claims[:limit]
+	}
+
+	return claims
+}
+
+// claimInTenant reports whether the claim belongs to the tenant of the context.
+func claimInTenant(ctx context.Context, claim *Claim) bool {
+	return claim.TenantID == tenantkit.FromContext(ctx)
+}
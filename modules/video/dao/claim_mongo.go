@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoClaimDAO scopes every query to the tenant of the context.
+type mongoClaimDAO struct {
+	collection *mongo.Collection
+}
+
+var _ ClaimDAO = (*mongoClaimDAO)(nil)
+
+func NewMongoClaimDAO(collection *mongo.Collection) *mongoClaimDAO {
+	return &mongoClaimDAO{
+		collection: collection,
+	}
+}
+
+// CreateClaimIndexes creates the indexes of the claim collection if not exist, where the unique index keeps one
+// active claim per video.
+func CreateClaimIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "tenant_id", Value: 1}, {Key: "video_id", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"status": ClaimStatusActive}),
+		},
+		{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "video_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "claimant_id", Value: 1}, {Key: "_id", Value: 1}}},
+	})
+
+	return err
+}
+
+func (dao *mongoClaimDAO) Get(ctx context.Context, id primitive.ObjectID) (*Claim, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
+	var claim Claim
+	if err := dao.collection.FindOne(ctx, filter).Decode(&claim); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrClaimNotFound
+		}
+		return nil, err
+	}
+
+	return &claim, nil
+}
+
+func (dao *mongoClaimDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	filter := tenantFilter(ctx)
+	filter["video_id"] = videoID
+
+	return dao.find(ctx, filter, after, limit)
+}
+
+func (dao *mongoClaimDAO) ListByClaimantID(ctx context.Context, claimantID string, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	filter := tenantFilter(ctx)
+	filter["claimant_id"] = claimantID
+
+	return dao.find(ctx, filter, after, limit)
+}
+
+func (dao *mongoClaimDAO) Create(ctx context.Context, claim *Claim) error {
+	claim.TenantID = tenantkit.FromContext(ctx)
+	claim.Status = ClaimStatusActive
+
+	result, err := dao.collection.InsertOne(ctx, claim)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrVideoAlreadyClaimed
+		}
+		return err
+	}
+
+	claim.ID = result.InsertedID.(primitive.ObjectID)
+
+	return nil
+}
+
+func (dao *mongoClaimDAO) Dispute(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+	filter["status"] = ClaimStatusActive
+
+	update := bson.M{"$set": bson.M{"status": ClaimStatusDisputed, "dispute_reason": reason, "updated_at": at}}
+
+	result, err := dao.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// the claim is either disputed or not found
+	if _, err := dao.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return ErrClaimNotActive
+}
+
+// find lists the claims of the filter created after the claim of the ID, from the first one if the ID is zero.
+func (dao *mongoClaimDAO) find(ctx context.Context, filter bson.M, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	if !after.IsZero() {
+		filter["_id"] = bson.M{"$gt": after}
+	}
+
+	// the IDs are in the order of creation
+	cursor, err := dao.collection.Find(ctx, filter, mongokit.FindOptions(mongokit.Page{Limit: limit}, mongokit.Asc("_id")))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	claims := make([]*Claim, 0)
+	for cursor.Next(ctx) {
+		var claim Claim
+		if err := cursor.Decode(&claim); err != nil {
+			return nil, err
+		}
+
+		claims = append(claims, &claim)
+	}
+
+	return claims, cursor.Err()
+}
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// regionalClaimDAO routes the claims to the DAO of the region the tenant of the context is pinned to like
+// regionalVideoDAO, so the claims are kept along with the videos they claim.
+type regionalClaimDAO struct {
+	home    ClaimDAO
+	regions map[string]ClaimDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ ClaimDAO = (*regionalClaimDAO)(nil)
+
+func NewRegionalClaimDAO(home ClaimDAO, regions map[string]ClaimDAO, conf *tenantkit.RegionConfig) *regionalClaimDAO {
+	return &regionalClaimDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalClaimDAO) Get(ctx context.Context, id primitive.ObjectID) (*Claim, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, id)
+}
+
+func (dao *regionalClaimDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByVideoID(ctx, videoID, after, limit)
+}
+
+func (dao *regionalClaimDAO) ListByClaimantID(ctx context.Context, claimantID string, after primitive.ObjectID, limit int64) ([]*Claim, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByClaimantID(ctx, claimantID, after, limit)
+}
+
+func (dao *regionalClaimDAO) Create(ctx context.Context, claim *Claim) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Create(ctx, claim)
+}
+
+func (dao *regionalClaimDAO) Dispute(ctx context.Context, id primitive.ObjectID, reason string, at time.Time) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Dispute(ctx, id, reason, at)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalVideoDAO.
+func (dao *regionalClaimDAO) regionOf(ctx context.Context) (ClaimDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// fixtureResolutions are the resolutions of the fixture videos, with the bytes per second they are encoded at
+var fixtureResolutions = []struct {
+	width, height uint32
+	bytesPerSec   float64
+}{
+	{640, 360, 125_000},
+	{1280, 720, 625_000},
+	{1920, 1080, 1_000_000},
+	{3840, 2160, 5_000_000},
+}
+
+// fixtureVariants are the variants the videos are encoded into, the ones no higher than the video are encoded
+var fixtureVariants = []struct {
+	name   string
+	height uint32
+}{
+	{"1080p", 1080},
+	{"720p", 720},
+	{"360p", 360},
+}
+
+// NewVideoFixture returns a video generated by the generator, created within 30 days after fixturekit.Epoch.
+// Most of the videos are encoded into their variants, the others are still uploaded, encoding or failed.
+func NewVideoFixture(g *fixturekit.Generator) *Video {
+	createdAt := g.Time(fixturekit.Epoch, 30*24*time.Hour)
+	id := g.ObjectID(createdAt)
+	resolution := fixtureResolutions[g.Intn(len(fixtureResolutions))]
+	// the durations are within [5s, 10m], in milliseconds
+	duration := float64(g.Between(5_000, 600_000)) / 1000
+
+	video := &Video{
+		ID:        id,
+		TenantID:  tenantkit.DefaultTenantID,
+		Width:     resolution.width,
+		Height:    resolution.height,
+		Size:      uint64(resolution.bytesPerSec * duration),
+		Duration:  duration,
+		URL:       fmt.Sprintf("https://storage.example.com/videos/%s.mp4", id.Hex()),
+		Status:    VideoStatusSuccess,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+
+	if !g.Chance(0.85) {
+		video.Status = VideoStatus(g.Pick(string(VideoStatusUploaded), string(VideoStatusEncoding), string(VideoStatusFailed)))
+		return video
+	}
+
+	video.Variants = make(map[string]string)
+	for _, variant := range fixtureVariants {
+		if variant.height <= video.Height {
+			video.Variants[variant.name] = fmt.Sprintf("https://storage.example.com/videos/%s-%s.mp4", id.Hex(), variant.name)
+		}
+	}
+	// the videos are encoded within an hour after the upload
+	video.UpdatedAt = g.Time(createdAt, time.Hour)
+
+	return video
+}
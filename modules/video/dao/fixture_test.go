@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewVideoFixture", func() {
+	It("generates the same videos from the same seed", func() {
+		Expect(NewVideoFixture(fixturekit.NewGenerator(1))).To(Equal(NewVideoFixture(fixturekit.NewGenerator(1))))
+	})
+
+	It("generates the variants no higher than the encoded videos", func() {
+		g := fixturekit.NewGenerator(1)
+		for i := 0; i < 100; i++ {
+			video := NewVideoFixture(g)
+
+			Expect(video.ID.Timestamp()).To(BeTemporally("==", video.CreatedAt.Truncate(time.Second)))
+			Expect(video.CreatedAt).To(BeTemporally(">=", fixturekit.Epoch))
+			Expect(video.UpdatedAt).To(BeTemporally(">=", video.CreatedAt))
+			Expect(video.Size).To(BeNumerically(">", 0))
+
+			if video.Status != VideoStatusSuccess {
+				Expect(video.Variants).To(BeEmpty())
+				continue
+			}
+
+			Expect(video.Variants).NotTo(BeEmpty())
+			if video.Height < 720 {
+				Expect(video.Variants).NotTo(HaveKey("720p"))
+			}
+			Expect(video.UpdatedAt).To(BeTemporally("<", video.CreatedAt.Add(time.Hour)))
+		}
+	})
+})
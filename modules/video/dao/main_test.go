@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,9 +22,26 @@ func TestDAO(t *testing.T) {
 var (
 	mongoClient *mongokit.MongoClient
 	redisClient *rediskit.RedisClient
+	pgClient    *pgkit.PGClient
 )
 
 var _ = BeforeSuite(func() {
+	mongoClient, redisClient = newTestClients()
+
+	var err error
+	pgClient, err = newTestPGClient()
+	Expect(err).NotTo(HaveOccurred())
+})
+
+var _ = AfterSuite(func() {
+	Expect(mongoClient.Close()).NotTo(HaveOccurred())
+	Expect(redisClient.Close()).NotTo(HaveOccurred())
+	Expect(pgClient.Close()).NotTo(HaveOccurred())
+})
+
+// newTestClients connects to the test databases, the benchmarks connect by it as well, since the suite does not run
+// for them.
+func newTestClients() (*mongokit.MongoClient, *rediskit.RedisClient) {
 	mongoConf := &mongokit.MongoConfig{
 		URL:      "mongodb://mongo:27017",
 		Database: "video",
@@ -44,11 +63,36 @@ var _ = BeforeSuite(func() {
 		Development: true,
 	}).WithContext(context.Background())
 
-	mongoClient = mongokit.NewMongoClient(ctx, mongoConf)
-	redisClient = rediskit.NewRedisClient(ctx, redisConf)
-})
+	return mongokit.NewMongoClient(ctx, mongoConf), rediskit.NewRedisClient(ctx, redisConf)
+}
 
-var _ = AfterSuite(func() {
-	Expect(mongoClient.Close()).NotTo(HaveOccurred())
-	Expect(redisClient.Close()).NotTo(HaveOccurred())
-})
+// newTestPGClient migrates the test database and connects to it. The database is shared with the comment module,
+// so the video migration keeps its version in a table of its own.
+func newTestPGClient() (*pgkit.PGClient, error) {
+	pgConf := &pgkit.PGConfig{
+		URL: "postgres://postgres@postgres:5432/postgres?sslmode=disable",
+	}
+	if url := os.Getenv("POSTGRES_URL"); url != "" {
+		pgConf.URL = url
+	}
+
+	migrationConf := &migrationkit.MigrationConfig{
+		Source: "file://../migration",
+		URL:    pgConf.URL + "&x-migrations-table=video_schema_migrations",
+	}
+
+	ctx := logkit.NewLogger(&logkit.LoggerConfig{
+		Development: true,
+	}).WithContext(context.Background())
+
+	migration := migrationkit.NewMigration(ctx, migrationConf)
+	defer func() {
+		_ = migration.Close()
+	}()
+
+	if err := migration.Up(); err != nil {
+		return nil, err
+	}
+
+	return pgkit.NewPGClient(ctx, pgConf), nil
+}
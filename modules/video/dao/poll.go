@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Poll is a poll attached to a video. The votes of an open poll are counted by PollVoteDAO, and stored along with
+// the poll once it is closed.
+type Poll struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	TenantID string             `bson:"tenant_id,omitempty"`
+	VideoID  primitive.ObjectID `bson:"video_id,omitempty"`
+	Question string             `bson:"question,omitempty"`
+	Options  []string           `bson:"options,omitempty"`
+	// Votes are the votes of the options in order, which are final once the poll is closed
+	Votes     []int64   `bson:"votes,omitempty"`
+	ClosesAt  time.Time `bson:"closes_at,omitempty"`
+	Closed    bool      `bson:"closed"`
+	CreatedAt time.Time `bson:"created_at,omitempty"`
+}
+
+func (p *Poll) ToProto() *pb.Poll {
+	options := make([]*pb.PollOption, 0, len(p.Options))
+	for i, text := range p.Options {
+		option := &pb.PollOption{Text: text}
+		if i < len(p.Votes) {
+			option.Votes = p.Votes[i]
+		}
+
+		options = append(options, option)
+	}
+
+	return &pb.Poll{
+		Id:        p.ID.Hex(),
+		VideoId:   p.VideoID.Hex(),
+		Question:  p.Question,
+		Options:   options,
+		ClosesAt:  timestamppb.New(p.ClosesAt),
+		Closed:    p.Closed,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
+
+// PollDAO keeps the polls of the tenant of the context.
+type PollDAO interface {
+	Get(ctx context.Context, id primitive.ObjectID) (*Poll, error)
+	// ListByVideoID lists the polls of the video created after the poll of the ID in the order of creation, from the
+	// first one if the ID is zero, a non-positive limit means no limit
+	ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Poll, error)
+	Create(ctx context.Context, poll *Poll) error
+	// Close closes the open poll at the time with its final votes, it returns ErrPollClosed if the poll is closed
+	Close(ctx context.Context, id primitive.ObjectID, closesAt time.Time, votes []int64) error
+	// ListDue lists the open polls of every tenant due to close at the time, the ones due first first
+	ListDue(ctx context.Context, at time.Time, limit int64) ([]*Poll, error)
+}
+
+// PollVoteDAO counts the votes of the open polls of the tenant of the context, where every user votes once on
+// a poll. The votes are kept until PollVoteRetention after the poll is due to close.
+type PollVoteDAO interface {
+	// Vote votes for the option of the poll by the voter, it returns ErrAlreadyVoted if the voter has voted on the
+	// poll, and ErrPollClosed if the votes of the poll are closed
+	Vote(ctx context.Context, poll *Poll, voter string, option int) error
+	// Votes returns the votes of the options of the poll in order
+	Votes(ctx context.Context, poll *Poll) ([]int64, error)
+	// Close stops the votes of the poll and returns its final votes, closing the votes again returns them as well
+	Close(ctx context.Context, poll *Poll) ([]int64, error)
+}
+
+var (
+	ErrPollNotFound = errors.New("poll not found")
+	ErrPollClosed   = errors.New("poll closed")
+	ErrAlreadyVoted = errors.New("already voted")
+)
+
+// PollVoteRetention is the time the votes of a poll are kept after it is due to close, so a poll closed late
+// still has its votes.
+const PollVoteRetention = 24 * time.Hour
+
+func pollVotersKey(tenantID string, id primitive.ObjectID) string {
+	return fmt.Sprintf("pollVoters:%s:%s", tenantID, id.Hex())
+}
+
+func pollVotesKey(tenantID string, id primitive.ObjectID) string {
+	return fmt.Sprintf("pollVotes:%s:%s", tenantID, id.Hex())
+}
@@ -0,0 +1,234 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("PollDAO conformance", func() {
+	Describe("mongoPollDAO", func() {
+		itBehavesLikePollDAO(func() PollDAO {
+			return NewMongoPollDAO(mongoClient.Database().Collection("polls"))
+		})
+	})
+
+	Describe("memoryPollDAO", func() {
+		itBehavesLikePollDAO(func() PollDAO {
+			return NewMemoryPollDAO()
+		})
+	})
+})
+
+var _ = Describe("PollVoteDAO conformance", func() {
+	Describe("redisPollVoteDAO", func() {
+		itBehavesLikePollVoteDAO(func() PollVoteDAO {
+			return NewRedisPollVoteDAO(redisClient)
+		})
+	})
+
+	Describe("memoryPollVoteDAO", func() {
+		itBehavesLikePollVoteDAO(func() PollVoteDAO {
+			return NewMemoryPollVoteDAO()
+		})
+	})
+})
+
+func newFakePoll(videoID primitive.ObjectID, closesAt time.Time) *Poll {
+	return &Poll{
+		VideoID:   videoID,
+		Question:  "Which one is next?",
+		Options:   []string{"first", "second", "third"},
+		Votes:     []int64{0, 0, 0},
+		ClosesAt:  closesAt.UTC().Truncate(time.Millisecond),
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+// itBehavesLikePollDAO specifies the semantics every PollDAO shares. Every spec runs in a tenant of its own, and
+// deletes its polls after, except ListDue, which lists the polls of every tenant.
+func itBehavesLikePollDAO(newPollDAO func() PollDAO) {
+	var (
+		pollDAO PollDAO
+		ctx     context.Context
+		videoID primitive.ObjectID
+		dueAt   time.Time
+	)
+
+	BeforeEach(func() {
+		pollDAO = newPollDAO()
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("conformance-%d", time.Now().UnixNano()))
+		videoID = primitive.NewObjectID()
+		dueAt = time.Now().Add(-time.Hour)
+
+		if dao, ok := pollDAO.(*mongoPollDAO); ok {
+			DeferCleanup(func() {
+				_, err := dao.collection.DeleteMany(context.Background(), bson.M{"tenant_id": tenantkit.FromContext(ctx)})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		}
+	})
+
+	create := func(poll *Poll) *Poll {
+		Expect(pollDAO.Create(ctx, poll)).To(Succeed())
+		Expect(poll.ID).NotTo(Equal(primitive.NilObjectID))
+
+		return poll
+	}
+
+	Describe("Get", func() {
+		It("gets the created poll", func() {
+			poll := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+
+			Expect(pollDAO.Get(ctx, poll.ID)).To(Equal(poll))
+		})
+
+		It("returns ErrPollNotFound if the poll does not exist", func() {
+			_, err := pollDAO.Get(ctx, primitive.NewObjectID())
+			Expect(err).To(MatchError(ErrPollNotFound))
+		})
+
+		It("returns ErrPollNotFound if the poll belongs to another tenant", func() {
+			poll := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+
+			_, err := pollDAO.Get(tenantkit.WithTenantID(ctx, "another-tenant"), poll.ID)
+			Expect(err).To(MatchError(ErrPollNotFound))
+		})
+	})
+
+	Describe("ListByVideoID", func() {
+		It("lists the polls of the video in the order of creation", func() {
+			first := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+			second := create(newFakePoll(videoID, time.Now().Add(time.Minute)))
+			create(newFakePoll(primitive.NewObjectID(), time.Now().Add(time.Hour)))
+
+			Expect(pollDAO.ListByVideoID(ctx, videoID, primitive.NilObjectID, 0)).To(Equal([]*Poll{first, second}))
+		})
+
+		It("lists a page of the polls after the poll", func() {
+			first := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+			second := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+			third := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+
+			Expect(pollDAO.ListByVideoID(ctx, videoID, primitive.NilObjectID, 2)).To(Equal([]*Poll{first, second}))
+			Expect(pollDAO.ListByVideoID(ctx, videoID, second.ID, 2)).To(Equal([]*Poll{third}))
+		})
+
+		It("lists no poll of another tenant", func() {
+			create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+
+			Expect(pollDAO.ListByVideoID(tenantkit.WithTenantID(ctx, "another-tenant"), videoID, primitive.NilObjectID, 0)).To(BeEmpty())
+		})
+	})
+
+	Describe("Close", func() {
+		It("stores the final votes and the time closed at", func() {
+			poll := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+			closesAt := time.Now().UTC().Truncate(time.Millisecond)
+
+			Expect(pollDAO.Close(ctx, poll.ID, closesAt, []int64{1, 2, 3})).To(Succeed())
+
+			closed, err := pollDAO.Get(ctx, poll.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(closed.Closed).To(BeTrue())
+			Expect(closed.ClosesAt).To(BeTemporally("==", closesAt))
+			Expect(closed.Votes).To(Equal([]int64{1, 2, 3}))
+		})
+
+		It("returns ErrPollClosed if the poll is closed", func() {
+			poll := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+			Expect(pollDAO.Close(ctx, poll.ID, time.Now(), []int64{1, 2, 3})).To(Succeed())
+
+			Expect(pollDAO.Close(ctx, poll.ID, time.Now(), []int64{4, 5, 6})).To(MatchError(ErrPollClosed))
+
+			closed, err := pollDAO.Get(ctx, poll.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(closed.Votes).To(Equal([]int64{1, 2, 3}))
+		})
+
+		It("returns ErrPollNotFound if the poll belongs to another tenant", func() {
+			poll := create(newFakePoll(videoID, time.Now().Add(time.Hour)))
+
+			err := pollDAO.Close(tenantkit.WithTenantID(ctx, "another-tenant"), poll.ID, time.Now(), []int64{1, 2, 3})
+			Expect(err).To(MatchError(ErrPollNotFound))
+		})
+	})
+
+	Describe("ListDue", func() {
+		It("lists the open polls due by the time", func() {
+			later := create(newFakePoll(videoID, dueAt.Add(-time.Second)))
+			earlier := create(newFakePoll(videoID, dueAt.Add(-time.Minute)))
+			create(newFakePoll(videoID, dueAt.Add(time.Second)))
+			closed := create(newFakePoll(videoID, dueAt.Add(-time.Hour)))
+			Expect(pollDAO.Close(ctx, closed.ID, closed.ClosesAt, closed.Votes)).To(Succeed())
+
+			polls, err := pollDAO.ListDue(ctx, dueAt, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(polls).To(ContainElements(earlier, later))
+			Expect(polls).NotTo(ContainElement(HaveField("ID", closed.ID)))
+			Expect(polls).NotTo(ContainElement(HaveField("ClosesAt", BeTemporally(">", dueAt))))
+		})
+
+		It("lists the polls of every tenant", func() {
+			poll := create(newFakePoll(videoID, dueAt.Add(-time.Second)))
+
+			polls, err := pollDAO.ListDue(tenantkit.WithTenantID(ctx, "another-tenant"), dueAt, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(polls).To(ContainElement(poll))
+		})
+	})
+}
+
+// itBehavesLikePollVoteDAO specifies the semantics every PollVoteDAO shares. Every spec votes for a poll of its own.
+func itBehavesLikePollVoteDAO(newPollVoteDAO func() PollVoteDAO) {
+	var (
+		pollVoteDAO PollVoteDAO
+		ctx         context.Context
+		poll        *Poll
+	)
+
+	BeforeEach(func() {
+		pollVoteDAO = newPollVoteDAO()
+		ctx = context.Background()
+
+		poll = newFakePoll(primitive.NewObjectID(), time.Now().Add(time.Hour))
+		poll.ID = primitive.NewObjectID()
+	})
+
+	It("counts the votes of the options", func() {
+		Expect(pollVoteDAO.Vote(ctx, poll, "alice", 0)).To(Succeed())
+		Expect(pollVoteDAO.Vote(ctx, poll, "bob", 2)).To(Succeed())
+		Expect(pollVoteDAO.Vote(ctx, poll, "carol", 2)).To(Succeed())
+
+		Expect(pollVoteDAO.Votes(ctx, poll)).To(Equal([]int64{1, 0, 2}))
+	})
+
+	It("returns ErrAlreadyVoted if the user has voted", func() {
+		Expect(pollVoteDAO.Vote(ctx, poll, "alice", 0)).To(Succeed())
+
+		Expect(pollVoteDAO.Vote(ctx, poll, "alice", 1)).To(MatchError(ErrAlreadyVoted))
+		Expect(pollVoteDAO.Votes(ctx, poll)).To(Equal([]int64{1, 0, 0}))
+	})
+
+	It("counts the votes of each tenant apart", func() {
+		Expect(pollVoteDAO.Vote(ctx, poll, "alice", 0)).To(Succeed())
+
+		Expect(pollVoteDAO.Votes(tenantkit.WithTenantID(ctx, "another-tenant"), poll)).To(Equal([]int64{0, 0, 0}))
+	})
+
+	It("returns the final votes on close and refuses the votes after", func() {
+		Expect(pollVoteDAO.Vote(ctx, poll, "alice", 1)).To(Succeed())
+
+		Expect(pollVoteDAO.Close(ctx, poll)).To(Equal([]int64{0, 1, 0}))
+		Expect(pollVoteDAO.Vote(ctx, poll, "bob", 1)).To(MatchError(ErrPollClosed))
+
+		// closing again returns the same votes
+		Expect(pollVoteDAO.Close(ctx, poll)).To(Equal([]int64{0, 1, 0}))
+	})
+}
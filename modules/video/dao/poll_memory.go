@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryPollDAO keeps the polls in memory, it is meant for running the modules without MongoDB in local development.
+type memoryPollDAO struct {
+	mu    sync.RWMutex
+	polls map[primitive.ObjectID]*Poll
+}
+
+var _ PollDAO = (*memoryPollDAO)(nil)
+
+func NewMemoryPollDAO() *memoryPollDAO {
+	return &memoryPollDAO{
+		polls: make(map[primitive.ObjectID]*Poll),
+	}
+}
+
+func (dao *memoryPollDAO) Get(ctx context.Context, id primitive.ObjectID) (*Poll, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	poll, ok := dao.polls[id]
+	if !ok || !pollInTenant(ctx, poll) {
+		return nil, ErrPollNotFound
+	}
+
+	return copyPoll(poll), nil
+}
+
+func (dao *memoryPollDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Poll, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	polls := make([]*Poll, 0)
+	for _, poll := range dao.polls {
+		if pollInTenant(ctx, poll) && poll.VideoID == videoID && bytes.Compare(poll.ID[:], after[:]) > 0 {
+			polls = append(polls, copyPoll(poll))
+		}
+	}
+
+	sort.Slice(polls, func(i, j int) bool {
+		return bytes.Compare(polls[i].ID[:], polls[j].ID[:]) < 0
+	})
+
+	if limit > 0 && int64(len(polls)) > limit {
+		polls = polls[:limit]
+	}
+
+	return polls, nil
+}
+
+func (dao *memoryPollDAO) Create(ctx context.Context, poll *Poll) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	poll.TenantID = tenantkit.FromContext(ctx)
+	poll.ID = primitive.NewObjectID()
+	dao.polls[poll.ID] = copyPoll(poll)
+
+	return nil
+}
+
+func (dao *memoryPollDAO) Close(ctx context.Context, id primitive.ObjectID, closesAt time.Time, votes []int64) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	poll, ok := dao.polls[id]
+	if !ok || !pollInTenant(ctx, poll) {
+		return ErrPollNotFound
+	}
+	if poll.Closed {
+		return ErrPollClosed
+	}
+
+	poll.Closed = true
+	poll.ClosesAt = closesAt
+	poll.Votes = append([]int64(nil), votes...)
+
+	return nil
+}
+
+func (dao *memoryPollDAO) ListDue(ctx context.Context, at time.Time, limit int64) ([]*Poll, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	polls := make([]*Poll, 0)
+	for _, poll := range dao.polls {
+		if !poll.Closed && !poll.ClosesAt.After(at) {
+			polls = append(polls, copyPoll(poll))
+		}
+	}
+
+	sort.Slice(polls, func(i, j int) bool {
+		return polls[i].ClosesAt.Before(polls[j].ClosesAt)
+	})
+
+	if limit > 0 && limit < int64(len(polls)) {
+		polls = polls[:limit]
+	}
+
+	return polls, nil
+}
+
+// pollInTenant reports whether the poll belongs to the tenant of the context.
+func pollInTenant(ctx context.Context, poll *Poll) bool {
+	return poll.TenantID == tenantkit.FromContext(ctx)
+}
+
+// copyPoll copies the poll along with its options and votes.
+func copyPoll(poll *Poll) *Poll {
+	p := *poll
+	p.Options = append([]string(nil), poll.Options...)
+	p.Votes = append([]int64(nil), poll.Votes...)
+
+	return &p
+}
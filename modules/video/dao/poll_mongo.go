@@ -0,0 +1,124 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mongoPollDAO scopes every query to the tenant of the context except ListDue.
+type mongoPollDAO struct {
+	collection *mongo.Collection
+}
+
+var _ PollDAO = (*mongoPollDAO)(nil)
+
+func NewMongoPollDAO(collection *mongo.Collection) *mongoPollDAO {
+	return &mongoPollDAO{
+		collection: collection,
+	}
+}
+
+// CreatePollIndexes creates the indexes of the poll collection if not exist.
+func CreatePollIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "video_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "closed", Value: 1}, {Key: "closes_at", Value: 1}}},
+	})
+
+	return err
+}
+
+func (dao *mongoPollDAO) Get(ctx context.Context, id primitive.ObjectID) (*Poll, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
+	var poll Poll
+	if err := dao.collection.FindOne(ctx, filter).Decode(&poll); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPollNotFound
+		}
+		return nil, err
+	}
+
+	return &poll, nil
+}
+
+func (dao *mongoPollDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Poll, error) {
+	filter := tenantFilter(ctx)
+	filter["video_id"] = videoID
+	if !after.IsZero() {
+		filter["_id"] = bson.M{"$gt": after}
+	}
+
+	// the IDs are in the order of creation
+	return dao.find(ctx, filter, mongokit.Page{Limit: limit}, mongokit.Asc("_id"))
+}
+
+func (dao *mongoPollDAO) Create(ctx context.Context, poll *Poll) error {
+	poll.TenantID = tenantkit.FromContext(ctx)
+
+	result, err := dao.collection.InsertOne(ctx, poll)
+	if err != nil {
+		return err
+	}
+
+	poll.ID = result.InsertedID.(primitive.ObjectID)
+
+	return nil
+}
+
+func (dao *mongoPollDAO) Close(ctx context.Context, id primitive.ObjectID, closesAt time.Time, votes []int64) error {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+	filter["closed"] = false
+
+	update := bson.M{"$set": bson.M{"closed": true, "closes_at": closesAt, "votes": votes}}
+
+	result, err := dao.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// the poll is either closed or not found
+	if _, err := dao.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return ErrPollClosed
+}
+
+func (dao *mongoPollDAO) ListDue(ctx context.Context, at time.Time, limit int64) ([]*Poll, error) {
+	filter := bson.M{"closed": false, "closes_at": bson.M{"$lte": at}}
+
+	return dao.find(ctx, filter, mongokit.Page{Limit: limit}, mongokit.Asc("closes_at"))
+}
+
+func (dao *mongoPollDAO) find(ctx context.Context, filter bson.M, page mongokit.Page, orders ...mongokit.Order) ([]*Poll, error) {
+	cursor, err := dao.collection.Find(ctx, filter, mongokit.FindOptions(page, orders...))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	polls := make([]*Poll, 0)
+	for cursor.Next(ctx) {
+		var poll Poll
+		if err := cursor.Decode(&poll); err != nil {
+			return nil, err
+		}
+
+		polls = append(polls, &poll)
+	}
+
+	return polls, cursor.Err()
+}
@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// regionalPollDAO routes the polls to the DAO of the region the tenant of the context is pinned to like
+// regionalVideoDAO, except ListDue, which lists the polls of every tenant across the regions.
+type regionalPollDAO struct {
+	home    PollDAO
+	regions map[string]PollDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ PollDAO = (*regionalPollDAO)(nil)
+
+func NewRegionalPollDAO(home PollDAO, regions map[string]PollDAO, conf *tenantkit.RegionConfig) *regionalPollDAO {
+	return &regionalPollDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalPollDAO) Get(ctx context.Context, id primitive.ObjectID) (*Poll, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, id)
+}
+
+func (dao *regionalPollDAO) ListByVideoID(ctx context.Context, videoID, after primitive.ObjectID, limit int64) ([]*Poll, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.ListByVideoID(ctx, videoID, after, limit)
+}
+
+func (dao *regionalPollDAO) Create(ctx context.Context, poll *Poll) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Create(ctx, poll)
+}
+
+func (dao *regionalPollDAO) Close(ctx context.Context, id primitive.ObjectID, closesAt time.Time, votes []int64) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Close(ctx, id, closesAt, votes)
+}
+
+// ListDue lists the polls due of the home region and of every region up to the limit each, and merges them
+// into the ones due first up to the limit.
+func (dao *regionalPollDAO) ListDue(ctx context.Context, at time.Time, limit int64) ([]*Poll, error) {
+	polls, err := dao.home.ListDue(ctx, at, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, regionDAO := range dao.regions {
+		regionPolls, err := regionDAO.ListDue(ctx, at, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		polls = append(polls, regionPolls...)
+	}
+
+	sort.SliceStable(polls, func(i, j int) bool {
+		return polls[i].ClosesAt.Before(polls[j].ClosesAt)
+	})
+
+	if limit > 0 && limit < int64(len(polls)) {
+		polls = polls[:limit]
+	}
+
+	return polls, nil
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalVideoDAO.
+func (dao *regionalPollDAO) regionOf(ctx context.Context) (PollDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
+
+// regionalPollVoteDAO routes the votes to the DAO of the region the tenant of the context is pinned to, so the
+// voters of the pinned tenants never leave their regions.
+type regionalPollVoteDAO struct {
+	home    PollVoteDAO
+	regions map[string]PollVoteDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ PollVoteDAO = (*regionalPollVoteDAO)(nil)
+
+func NewRegionalPollVoteDAO(home PollVoteDAO, regions map[string]PollVoteDAO, conf *tenantkit.RegionConfig) *regionalPollVoteDAO {
+	return &regionalPollVoteDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalPollVoteDAO) Vote(ctx context.Context, poll *Poll, voter string, option int) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Vote(ctx, poll, voter, option)
+}
+
+func (dao *regionalPollVoteDAO) Votes(ctx context.Context, poll *Poll) ([]int64, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Votes(ctx, poll)
+}
+
+func (dao *regionalPollVoteDAO) Close(ctx context.Context, poll *Poll) ([]int64, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Close(ctx, poll)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalVideoDAO.
+func (dao *regionalPollVoteDAO) regionOf(ctx context.Context) (PollVoteDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
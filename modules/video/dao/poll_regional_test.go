@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("regionalPollDAO", func() {
+	var (
+		homeDAO PollDAO
+		euDAO   PollDAO
+		pollDAO PollDAO
+		euCtx   context.Context
+		homeCtx context.Context
+		dueAt   time.Time
+	)
+
+	BeforeEach(func() {
+		homeDAO = NewMemoryPollDAO()
+		euDAO = NewMemoryPollDAO()
+		pollDAO = NewRegionalPollDAO(homeDAO, map[string]PollDAO{"eu": euDAO}, &tenantkit.RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu": "eu",
+				"course-ap": "ap",
+			},
+		})
+		euCtx = tenantkit.WithTenantID(context.Background(), "course-eu")
+		homeCtx = tenantkit.WithTenantID(context.Background(), "course-tw")
+		dueAt = time.Now()
+	})
+
+	When("the tenant is pinned to a region", func() {
+		It("keeps the polls in the region", func() {
+			poll := newFakePoll(primitive.NewObjectID(), dueAt)
+
+			Expect(pollDAO.Create(euCtx, poll)).To(Succeed())
+
+			Expect(euDAO.Get(euCtx, poll.ID)).To(Equal(poll))
+			_, err := homeDAO.Get(euCtx, poll.ID)
+			Expect(err).To(MatchError(ErrPollNotFound))
+		})
+	})
+
+	When("the region of the tenant is not configured", func() {
+		It("returns ErrRegionNotConfigured", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-ap")
+
+			Expect(pollDAO.Create(ctx, newFakePoll(primitive.NewObjectID(), dueAt))).To(MatchError(ErrRegionNotConfigured))
+		})
+	})
+
+	Describe("ListDue", func() {
+		It("lists the polls due across the regions, the ones due first first", func() {
+			first := newFakePoll(primitive.NewObjectID(), dueAt.Add(-time.Hour))
+			second := newFakePoll(primitive.NewObjectID(), dueAt.Add(-time.Minute))
+			third := newFakePoll(primitive.NewObjectID(), dueAt.Add(-time.Second))
+			Expect(pollDAO.Create(homeCtx, second)).To(Succeed())
+			Expect(pollDAO.Create(euCtx, first)).To(Succeed())
+			Expect(pollDAO.Create(euCtx, third)).To(Succeed())
+
+			Expect(pollDAO.ListDue(homeCtx, dueAt, 0)).To(Equal([]*Poll{first, second, third}))
+			Expect(pollDAO.ListDue(homeCtx, dueAt, 2)).To(Equal([]*Poll{first, second}))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryPollVoteDAO counts the votes in memory, it is meant for running the modules without Redis in local
+// development. The votes do not expire.
+type memoryPollVoteDAO struct {
+	mu    sync.Mutex
+	polls map[string]*memoryPollVotes
+}
+
+type memoryPollVotes struct {
+	voters map[string]int
+	votes  map[int]int64
+	closed bool
+}
+
+var _ PollVoteDAO = (*memoryPollVoteDAO)(nil)
+
+func NewMemoryPollVoteDAO() *memoryPollVoteDAO {
+	return &memoryPollVoteDAO{
+		polls: make(map[string]*memoryPollVotes),
+	}
+}
+
+func (dao *memoryPollVoteDAO) Vote(ctx context.Context, poll *Poll, voter string, option int) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	votes := dao.get(ctx, poll.ID)
+	if votes.closed {
+		return ErrPollClosed
+	}
+	if _, ok := votes.voters[voter]; ok {
+		return ErrAlreadyVoted
+	}
+
+	votes.voters[voter] = option
+	votes.votes[option]++
+
+	return nil
+}
+
+func (dao *memoryPollVoteDAO) Votes(ctx context.Context, poll *Poll) ([]int64, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	return dao.get(ctx, poll.ID).of(poll), nil
+}
+
+func (dao *memoryPollVoteDAO) Close(ctx context.Context, poll *Poll) ([]int64, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	votes := dao.get(ctx, poll.ID)
+	votes.closed = true
+
+	return votes.of(poll), nil
+}
+
+// get returns the votes of the poll in the tenant of the context, the lock must be held.
+func (dao *memoryPollVoteDAO) get(ctx context.Context, id primitive.ObjectID) *memoryPollVotes {
+	key := pollVotesKey(tenantkit.FromContext(ctx), id)
+
+	votes, ok := dao.polls[key]
+	if !ok {
+		votes = &memoryPollVotes{
+			voters: make(map[string]int),
+			votes:  make(map[int]int64),
+		}
+		dao.polls[key] = votes
+	}
+
+	return votes
+}
+
+// of returns the votes of the options of the poll in order.
+func (v *memoryPollVotes) of(poll *Poll) []int64 {
+	votes := make([]int64, len(poll.Options))
+	for i := range votes {
+		votes[i] = v.votes[i]
+	}
+
+	return votes
+}
@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/redis/v8"
+)
+
+// redisPollVoteDAO keeps the option voted by each voter of a poll in a hash, and the votes of the options in another
+// one, which has the closed field once the votes are closed. Both expire PollVoteRetention after the poll is due.
+type redisPollVoteDAO struct {
+	client *rediskit.RedisClient
+}
+
+var _ PollVoteDAO = (*redisPollVoteDAO)(nil)
+
+// pollVotesClosedField is the field of the votes hash set once the votes are closed
+const pollVotesClosedField = "closed"
+
+// votePollScript votes for the option by the voter unless the voter has voted or the votes are closed, it returns
+// 1 if voted, 0 if the voter has voted, and -1 if the votes are closed.
+var votePollScript = redis.NewScript(`
+if redis.call("HEXISTS", KEYS[2], "` + pollVotesClosedField + `") == 1 then
+	return -1
+end
+if redis.call("HSETNX", KEYS[1], ARGV[1], ARGV[2]) == 0 then
+	return 0
+end
+redis.call("HINCRBY", KEYS[2], ARGV[2], 1)
+redis.call("PEXPIREAT", KEYS[1], ARGV[3])
+redis.call("PEXPIREAT", KEYS[2], ARGV[3])
+return 1
+`)
+
+// closePollScript closes the votes and returns the votes of the options of the arguments.
+var closePollScript = redis.NewScript(`
+redis.call("HSET", KEYS[1], "` + pollVotesClosedField + `", 1)
+redis.call("PEXPIREAT", KEYS[1], ARGV[1])
+return redis.call("HMGET", KEYS[1], unpack(ARGV, 2))
+`)
+
+func NewRedisPollVoteDAO(client *rediskit.RedisClient) *redisPollVoteDAO {
+	return &redisPollVoteDAO{
+		client: client,
+	}
+}
+
+func (dao *redisPollVoteDAO) Vote(ctx context.Context, poll *Poll, voter string, option int) error {
+	tenantID := tenantkit.FromContext(ctx)
+	keys := []string{pollVotersKey(tenantID, poll.ID), pollVotesKey(tenantID, poll.ID)}
+
+	voted, err := votePollScript.Run(ctx, dao.client, keys, voter, option, pollVotesExpireAt(poll)).Int()
+	if err != nil {
+		return err
+	}
+
+	switch voted {
+	case 0:
+		return ErrAlreadyVoted
+	case -1:
+		return ErrPollClosed
+	default:
+		return nil
+	}
+}
+
+func (dao *redisPollVoteDAO) Votes(ctx context.Context, poll *Poll) ([]int64, error) {
+	values, err := dao.client.HMGet(ctx, pollVotesKey(tenantkit.FromContext(ctx), poll.ID), pollOptionFields(poll)...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePollVotes(values)
+}
+
+func (dao *redisPollVoteDAO) Close(ctx context.Context, poll *Poll) ([]int64, error) {
+	args := append([]interface{}{pollVotesExpireAt(poll)}, toInterfaces(pollOptionFields(poll))...)
+
+	values, err := closePollScript.Run(ctx, dao.client, []string{pollVotesKey(tenantkit.FromContext(ctx), poll.ID)}, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePollVotes(values)
+}
+
+// pollVotesExpireAt returns the time in milliseconds the votes of the poll expire at.
+func pollVotesExpireAt(poll *Poll) int64 {
+	return poll.ClosesAt.Add(PollVoteRetention).UnixMilli()
+}
+
+// pollOptionFields returns the fields of the votes hash of the options of the poll, i.e. the indexes of the options.
+func pollOptionFields(poll *Poll) []string {
+	fields := make([]string, 0, len(poll.Options))
+	for i := range poll.Options {
+		fields = append(fields, strconv.Itoa(i))
+	}
+
+	return fields
+}
+
+// parsePollVotes parses the votes of the options of HMGET, the options without votes are missing.
+func parsePollVotes(values []interface{}) ([]int64, error) {
+	votes := make([]int64, len(values))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+
+		vote, err := strconv.ParseInt(value.(string), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		votes[i] = vote
+	}
+
+	return votes, nil
+}
+
+func toInterfaces(values []string) []interface{} {
+	interfaces := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		interfaces = append(interfaces, value)
+	}
+
+	return interfaces
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Thumbnail is a thumbnail candidate of a video, which is chosen by its weight relative to the other candidates.
+type Thumbnail struct {
+	ID     primitive.ObjectID `bson:"id"`
+	URL    string             `bson:"url"`
+	Weight uint32             `bson:"weight"`
+}
+
+func (t *Thumbnail) ToProto() *pb.Thumbnail {
+	return &pb.Thumbnail{
+		Id:     t.ID.Hex(),
+		Url:    t.URL,
+		Weight: t.Weight,
+	}
+}
+
+type ThumbnailEvent string
+
+const (
+	ThumbnailEventImpression ThumbnailEvent = "impression"
+	ThumbnailEventClick      ThumbnailEvent = "click"
+)
+
+// ThumbnailStats is the number of the events of a thumbnail candidate.
+type ThumbnailStats struct {
+	Impressions int64
+	Clicks      int64
+}
+
+// CTR returns the clicks per impression, or zero without impressions.
+func (s *ThumbnailStats) CTR() float64 {
+	if s.Impressions == 0 {
+		return 0
+	}
+
+	return float64(s.Clicks) / float64(s.Impressions)
+}
+
+// ThumbnailStatsDAO counts the events of the thumbnail candidates of the videos of the tenant of the context.
+type ThumbnailStatsDAO interface {
+	Record(ctx context.Context, videoID, thumbnailID primitive.ObjectID, event ThumbnailEvent) error
+	// Stats returns the stats of the thumbnail candidates of the video by their IDs, the candidates without events
+	// are missing
+	Stats(ctx context.Context, videoID primitive.ObjectID) (map[primitive.ObjectID]*ThumbnailStats, error)
+}
+
+var ErrInvalidThumbnailEvent = errors.New("invalid thumbnail event")
+
+func thumbnailStatsKey(tenantID string, videoID primitive.ObjectID) string {
+	return fmt.Sprintf("thumbnailStats:%s:%s", tenantID, videoID.Hex())
+}
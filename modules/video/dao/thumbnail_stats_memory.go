@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryThumbnailStatsDAO counts the events in memory, it is meant for running the modules without Redis in local
+// development.
+type memoryThumbnailStatsDAO struct {
+	mu     sync.Mutex
+	videos map[string]map[primitive.ObjectID]*ThumbnailStats
+}
+
+var _ ThumbnailStatsDAO = (*memoryThumbnailStatsDAO)(nil)
+
+func NewMemoryThumbnailStatsDAO() *memoryThumbnailStatsDAO {
+	return &memoryThumbnailStatsDAO{
+		videos: make(map[string]map[primitive.ObjectID]*ThumbnailStats),
+	}
+}
+
+func (dao *memoryThumbnailStatsDAO) Record(ctx context.Context, videoID, thumbnailID primitive.ObjectID, event ThumbnailEvent) error {
+	if event != ThumbnailEventImpression && event != ThumbnailEventClick {
+		return ErrInvalidThumbnailEvent
+	}
+
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := thumbnailStatsKey(tenantkit.FromContext(ctx), videoID)
+	if _, ok := dao.videos[key]; !ok {
+		dao.videos[key] = make(map[primitive.ObjectID]*ThumbnailStats)
+	}
+
+	stats, ok := dao.videos[key][thumbnailID]
+	if !ok {
+		stats = &ThumbnailStats{}
+		dao.videos[key][thumbnailID] = stats
+	}
+
+	if event == ThumbnailEventClick {
+		stats.Clicks++
+	} else {
+		stats.Impressions++
+	}
+
+	return nil
+}
+
+func (dao *memoryThumbnailStatsDAO) Stats(ctx context.Context, videoID primitive.ObjectID) (map[primitive.ObjectID]*ThumbnailStats, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	stats := make(map[primitive.ObjectID]*ThumbnailStats)
+	for thumbnailID, s := range dao.videos[thumbnailStatsKey(tenantkit.FromContext(ctx), videoID)] {
+		copied := *s
+		stats[thumbnailID] = &copied
+	}
+
+	return stats, nil
+}
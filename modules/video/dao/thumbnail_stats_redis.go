@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// redisThumbnailStatsDAO counts the events of the thumbnail candidates of a video in a hash, whose fields are the
+// thumbnail IDs and the events joined by colons, e.g. 62a0...:click.
+type redisThumbnailStatsDAO struct {
+	client *rediskit.RedisClient
+}
+
+var _ ThumbnailStatsDAO = (*redisThumbnailStatsDAO)(nil)
+
+func NewRedisThumbnailStatsDAO(client *rediskit.RedisClient) *redisThumbnailStatsDAO {
+	return &redisThumbnailStatsDAO{
+		client: client,
+	}
+}
+
+func (dao *redisThumbnailStatsDAO) Record(ctx context.Context, videoID, thumbnailID primitive.ObjectID, event ThumbnailEvent) error {
+	if event != ThumbnailEventImpression && event != ThumbnailEventClick {
+		return ErrInvalidThumbnailEvent
+	}
+
+	key := thumbnailStatsKey(tenantkit.FromContext(ctx), videoID)
+
+	return dao.client.HIncrBy(ctx, key, thumbnailID.Hex()+":"+string(event), 1).Err()
+}
+
+func (dao *redisThumbnailStatsDAO) Stats(ctx context.Context, videoID primitive.ObjectID) (map[primitive.ObjectID]*ThumbnailStats, error) {
+	fields, err := dao.client.HGetAll(ctx, thumbnailStatsKey(tenantkit.FromContext(ctx), videoID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[primitive.ObjectID]*ThumbnailStats)
+	for field, value := range fields {
+		hex, event, ok := cutField(field)
+		if !ok {
+			continue
+		}
+
+		thumbnailID, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			continue
+		}
+
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := stats[thumbnailID]
+		if !ok {
+			s = &ThumbnailStats{}
+			stats[thumbnailID] = s
+		}
+
+		switch ThumbnailEvent(event) {
+		case ThumbnailEventImpression:
+			s.Impressions = count
+		case ThumbnailEventClick:
+			s.Clicks = count
+		}
+	}
+
+	return stats, nil
+}
+
+// cutField cuts the field of the stats hash into the thumbnail ID and the event.
+func cutField(field string) (string, string, bool) {
+	i := strings.LastIndex(field, ":")
+	if i < 0 {
+		return "", "", false
+	}
+
+	return field[:i], field[i+1:], true
+}
@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = DescribeTable("ThumbnailStatsDAO",
+	func(newThumbnailStatsDAO func() ThumbnailStatsDAO) {
+		thumbnailStatsDAO := newThumbnailStatsDAO()
+		ctx := context.Background()
+		videoID := primitive.NewObjectID()
+		first, second := primitive.NewObjectID(), primitive.NewObjectID()
+
+		DeferCleanup(func() {
+			redisClient.Del(ctx, thumbnailStatsKey(tenantkit.DefaultTenantID, videoID))
+		})
+
+		for _, event := range []ThumbnailEvent{ThumbnailEventImpression, ThumbnailEventImpression, ThumbnailEventClick} {
+			Expect(thumbnailStatsDAO.Record(ctx, videoID, first, event)).To(Succeed())
+		}
+		Expect(thumbnailStatsDAO.Record(ctx, videoID, second, ThumbnailEventImpression)).To(Succeed())
+		Expect(thumbnailStatsDAO.Record(ctx, videoID, second, "view")).To(MatchError(ErrInvalidThumbnailEvent))
+
+		stats, err := thumbnailStatsDAO.Stats(ctx, videoID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(Equal(map[primitive.ObjectID]*ThumbnailStats{
+			first:  {Impressions: 2, Clicks: 1},
+			second: {Impressions: 1},
+		}))
+		Expect(stats[first].CTR()).To(Equal(0.5))
+
+		Expect(thumbnailStatsDAO.Stats(tenantkit.WithTenantID(ctx, "another-tenant"), videoID)).To(BeEmpty())
+	},
+	Entry("redisThumbnailStatsDAO", func() ThumbnailStatsDAO { return NewRedisThumbnailStatsDAO(redisClient) }),
+	Entry("memoryThumbnailStatsDAO", func() ThumbnailStatsDAO { return NewMemoryThumbnailStatsDAO() }),
+)
@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+)
+
+// UserSettings are the settings of a user of the video module.
+type UserSettings struct {
+	tableName struct{} `pg:"user_settings"` //nolint:unused,structcheck
+
+	TenantID string
+	UserID   string
+	// RestrictedMode hides the videos restricted in restricted mode and their comments from the user
+	RestrictedMode bool `pg:",use_zero"`
+	UpdatedAt      time.Time
+}
+
+func (s *UserSettings) ToProto() *pb.UserSettings {
+	return &pb.UserSettings{
+		RestrictedMode: s.RestrictedMode,
+	}
+}
+
+// UserSettingsDAO keeps the settings of the users of the tenant of the context.
+type UserSettingsDAO interface {
+	// Get gets the settings of the user, the default settings are returned if the user has not updated them
+	Get(ctx context.Context, userID string) (*UserSettings, error)
+	// Update creates or replaces the settings of the user
+	Update(ctx context.Context, settings *UserSettings) error
+}
+
+func userSettingsKey(tenantID, userID string) string {
+	return fmt.Sprintf("userSettings:%s:%s", tenantID, userID)
+}
@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("UserSettingsDAO conformance", func() {
+	Describe("pgUserSettingsDAO", func() {
+		itBehavesLikeUserSettingsDAO(func() UserSettingsDAO {
+			return NewPGUserSettingsDAO(pgClient)
+		})
+	})
+
+	Describe("redisUserSettingsDAO", func() {
+		itBehavesLikeUserSettingsDAO(func() UserSettingsDAO {
+			return NewRedisUserSettingsDAO(redisClient, NewPGUserSettingsDAO(pgClient))
+		})
+	})
+
+	Describe("memoryUserSettingsDAO", func() {
+		itBehavesLikeUserSettingsDAO(func() UserSettingsDAO {
+			return NewMemoryUserSettingsDAO()
+		})
+	})
+})
+
+// itBehavesLikeUserSettingsDAO specifies the semantics every UserSettingsDAO shares. Every spec runs in a tenant of
+// its own.
+func itBehavesLikeUserSettingsDAO(newUserSettingsDAO func() UserSettingsDAO) {
+	var (
+		userSettingsDAO UserSettingsDAO
+		ctx             context.Context
+		userID          string
+	)
+
+	BeforeEach(func() {
+		userSettingsDAO = newUserSettingsDAO()
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+		userID = "alice"
+	})
+
+	AfterEach(func() {
+		Expect(redisClient.Del(ctx, userSettingsKey(tenantkit.FromContext(ctx), userID)).Err()).NotTo(HaveOccurred())
+		_, err := pgClient.ExecContext(ctx, "DELETE FROM user_settings WHERE tenant_id = ?", tenantkit.FromContext(ctx))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Get", func() {
+		It("returns the default settings for the user never updated", func() {
+			settings, err := userSettingsDAO.Get(ctx, userID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.UserID).To(Equal(userID))
+			Expect(settings.RestrictedMode).To(BeFalse())
+		})
+	})
+
+	Describe("Update", func() {
+		It("returns the settings updated last on Get", func() {
+			for _, restrictedMode := range []bool{true, false, true} {
+				Expect(userSettingsDAO.Update(ctx, &UserSettings{UserID: userID, RestrictedMode: restrictedMode, UpdatedAt: time.Now()})).To(Succeed())
+
+				settings, err := userSettingsDAO.Get(ctx, userID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(settings.RestrictedMode).To(Equal(restrictedMode))
+			}
+		})
+
+		It("keeps the settings of the users and the tenants apart", func() {
+			Expect(userSettingsDAO.Update(ctx, &UserSettings{UserID: userID, RestrictedMode: true, UpdatedAt: time.Now()})).To(Succeed())
+
+			settings, err := userSettingsDAO.Get(ctx, "bob")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.RestrictedMode).To(BeFalse())
+			Expect(redisClient.Del(ctx, userSettingsKey(tenantkit.FromContext(ctx), "bob")).Err()).NotTo(HaveOccurred())
+
+			otherCtx := tenantkit.WithTenantID(context.Background(), fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+			settings, err = userSettingsDAO.Get(otherCtx, userID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.RestrictedMode).To(BeFalse())
+			Expect(redisClient.Del(otherCtx, userSettingsKey(tenantkit.FromContext(otherCtx), userID)).Err()).NotTo(HaveOccurred())
+		})
+	})
+}
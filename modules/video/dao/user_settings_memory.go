@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryUserSettingsDAO keeps the settings in memory, it is meant for running the modules without Postgres in
+// local development.
+type memoryUserSettingsDAO struct {
+	mu    sync.RWMutex
+	users map[string]*UserSettings
+}
+
+var _ UserSettingsDAO = (*memoryUserSettingsDAO)(nil)
+
+func NewMemoryUserSettingsDAO() *memoryUserSettingsDAO {
+	return &memoryUserSettingsDAO{
+		users: make(map[string]*UserSettings),
+	}
+}
+
+func (dao *memoryUserSettingsDAO) Get(ctx context.Context, userID string) (*UserSettings, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	tenantID := tenantkit.FromContext(ctx)
+	if settings, ok := dao.users[userSettingsKey(tenantID, userID)]; ok {
+		copied := *settings
+		return &copied, nil
+	}
+
+	return &UserSettings{TenantID: tenantID, UserID: userID}, nil
+}
+
+func (dao *memoryUserSettingsDAO) Update(ctx context.Context, settings *UserSettings) error {
+	settings.TenantID = tenantkit.FromContext(ctx)
+
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	copied := *settings
+	dao.users[userSettingsKey(settings.TenantID, settings.UserID)] = &copied
+
+	return nil
+}
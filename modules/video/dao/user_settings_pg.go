@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-pg/pg/v10"
+)
+
+// pgUserSettingsDAO scopes every query to the tenant of the context.
+type pgUserSettingsDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ UserSettingsDAO = (*pgUserSettingsDAO)(nil)
+
+func NewPGUserSettingsDAO(pgClient *pgkit.PGClient) *pgUserSettingsDAO {
+	return &pgUserSettingsDAO{
+		client: pgClient,
+	}
+}
+
+func (dao *pgUserSettingsDAO) Get(ctx context.Context, userID string) (*UserSettings, error) {
+	settings := &UserSettings{
+		TenantID: tenantkit.FromContext(ctx),
+		UserID:   userID,
+	}
+
+	query := dao.client.ModelContext(ctx, settings).
+		Where("tenant_id = ?", settings.TenantID).
+		Where("user_id = ?", userID)
+	if err := query.Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return settings, nil
+		}
+
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+func (dao *pgUserSettingsDAO) Update(ctx context.Context, settings *UserSettings) error {
+	settings.TenantID = tenantkit.FromContext(ctx)
+
+	_, err := dao.client.ModelContext(ctx, settings).
+		OnConflict("(tenant_id, user_id) DO UPDATE").
+		Set("restricted_mode = EXCLUDED.restricted_mode").
+		Set("updated_at = EXCLUDED.updated_at").
+		Insert()
+
+	return err
+}
@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/cache/v8"
+)
+
+// redisUserSettingsDAO caches the settings of the users, since they are read by every request of the users.
+type redisUserSettingsDAO struct {
+	cache   *cache.Cache
+	baseDAO UserSettingsDAO
+}
+
+var _ UserSettingsDAO = (*redisUserSettingsDAO)(nil)
+
+const (
+	userSettingsDAOLocalCacheSize     = 1024
+	userSettingsDAOLocalCacheDuration = 10 * time.Second
+	userSettingsDAORedisCacheDuration = 10 * time.Minute
+)
+
+func NewRedisUserSettingsDAO(client *rediskit.RedisClient, baseDAO UserSettingsDAO) *redisUserSettingsDAO {
+	return &redisUserSettingsDAO{
+		cache: cache.New(&cache.Options{
+			Redis:      client,
+			LocalCache: cache.NewTinyLFU(userSettingsDAOLocalCacheSize, userSettingsDAOLocalCacheDuration),
+		}),
+		baseDAO: baseDAO,
+	}
+}
+
+func (dao *redisUserSettingsDAO) Get(ctx context.Context, userID string) (*UserSettings, error) {
+	var settings UserSettings
+
+	if err := dao.cache.Once(&cache.Item{
+		Key:   userSettingsKey(tenantkit.FromContext(ctx), userID),
+		Value: &settings,
+		TTL:   userSettingsDAORedisCacheDuration,
+		Do: func(*cache.Item) (interface{}, error) {
+			return dao.baseDAO.Get(ctx, userID)
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// Update updates the settings and deletes the cached ones, the local caches of the other replicas keep the
+// settings until they expire.
+func (dao *redisUserSettingsDAO) Update(ctx context.Context, settings *UserSettings) error {
+	if err := dao.baseDAO.Update(ctx, settings); err != nil {
+		return err
+	}
+
+	if err := dao.cache.Delete(ctx, userSettingsKey(tenantkit.FromContext(ctx), settings.UserID)); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// regionalUserSettingsDAO routes the settings to the DAO of the region the tenant of the context is pinned to
+// like regionalVideoDAO.
+type regionalUserSettingsDAO struct {
+	home    UserSettingsDAO
+	regions map[string]UserSettingsDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ UserSettingsDAO = (*regionalUserSettingsDAO)(nil)
+
+func NewRegionalUserSettingsDAO(home UserSettingsDAO, regions map[string]UserSettingsDAO, conf *tenantkit.RegionConfig) *regionalUserSettingsDAO {
+	return &regionalUserSettingsDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalUserSettingsDAO) Get(ctx context.Context, userID string) (*UserSettings, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, userID)
+}
+
+func (dao *regionalUserSettingsDAO) Update(ctx context.Context, settings *UserSettings) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Update(ctx, settings)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalVideoDAO.
+func (dao *regionalUserSettingsDAO) regionOf(ctx context.Context) (UserSettingsDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,55 +25,169 @@ func (s VideoStatus) String() string {
 	return string(s)
 }
 
+type PremiereState string
+
+const (
+	PremiereStateNone      PremiereState = ""
+	PremiereStateScheduled PremiereState = "scheduled"
+	PremiereStateLive      PremiereState = "live"
+	PremiereStateEnded     PremiereState = "ended"
+)
+
+func (s PremiereState) String() string {
+	return string(s)
+}
+
+// AgeRating is the audience a video is rated for, the videos not rated are for the general audience.
+type AgeRating string
+
+const (
+	AgeRatingNone    AgeRating = ""
+	AgeRatingGeneral AgeRating = "general"
+	AgeRatingTeen    AgeRating = "teen"
+	AgeRatingMature  AgeRating = "mature"
+)
+
+func (r AgeRating) String() string {
+	return string(r)
+}
+
+// RestrictedInRestrictedMode reports whether the videos of the rating are hidden from the users in restricted mode.
+func (r AgeRating) RestrictedInRestrictedMode() bool {
+	return r == AgeRatingMature
+}
+
 type Video struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Width     uint32             `bson:"width,omitempty"`
-	Height    uint32             `bson:"height,omitempty"`
-	Size      uint64             `bson:"size,omitempty"`
-	Duration  float64            `bson:"duration,omitempty"`
-	URL       string             `bson:"url,omitempty"`
-	Status    VideoStatus        `bson:"status,omitempty"`
-	Variants  map[string]string  `bson:"variants,omitempty"`
-	CreatedAt time.Time          `bson:"created_at,omitempty"`
-	UpdatedAt time.Time          `bson:"updated_at,omitempty"`
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	TenantID   string             `bson:"tenant_id,omitempty"`
+	Width      uint32             `bson:"width,omitempty"`
+	Height     uint32             `bson:"height,omitempty"`
+	Size       uint64             `bson:"size,omitempty"`
+	Duration   float64            `bson:"duration,omitempty"`
+	URL        string             `bson:"url,omitempty"`
+	Status     VideoStatus        `bson:"status,omitempty"`
+	Variants   map[string]string  `bson:"variants,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at,omitempty"`
+	UpdatedAt  time.Time          `bson:"updated_at,omitempty"`
+	PremiereAt time.Time          `bson:"premiere_at,omitempty"`
+	Thumbnails []*Thumbnail       `bson:"thumbnails,omitempty"`
+	AgeRating  AgeRating          `bson:"age_rating,omitempty"`
+	// Fingerprint identifies the content of the video file, it is computed while the video is transcoded
+	Fingerprint string `bson:"fingerprint,omitempty"`
+	// ClaimPolicy and ClaimantID are of the active copyright claim on the video, empty if not claimed
+	ClaimPolicy ClaimPolicy `bson:"claim_policy,omitempty"`
+	ClaimantID  string      `bson:"claimant_id,omitempty"`
 }
 
 func (v *Video) ToProto() *pb.VideoInfo {
-	return &pb.VideoInfo{
-		Id:        v.ID.Hex(),
-		Width:     v.Width,
-		Height:    v.Height,
-		Size:      v.Size,
-		Duration:  v.Duration,
-		Url:       v.URL,
-		Status:    v.Status.String(),
-		Variants:  v.Variants,
-		CreatedAt: timestamppb.New(v.CreatedAt),
-		UpdatedAt: timestamppb.New(v.UpdatedAt),
+	info := &pb.VideoInfo{
+		Id:          v.ID.Hex(),
+		Width:       v.Width,
+		Height:      v.Height,
+		Size:        v.Size,
+		Duration:    v.Duration,
+		Url:         v.URL,
+		Status:      v.Status.String(),
+		Variants:    v.Variants,
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+		UpdatedAt:   timestamppb.New(v.UpdatedAt),
+		AgeRating:   v.AgeRating.String(),
+		ClaimPolicy: v.ClaimPolicy.String(),
+		ClaimantId:  v.ClaimantID,
+	}
+
+	if !v.PremiereAt.IsZero() {
+		info.PremiereAt = timestamppb.New(v.PremiereAt)
+	}
+
+	return info
+}
+
+// PremiereState returns the state of the premiere of the video at the time, the premiere is live for the duration
+// of the video since the premiere time.
+func (v *Video) PremiereState(at time.Time) PremiereState {
+	switch {
+	case v.PremiereAt.IsZero():
+		return PremiereStateNone
+	case at.Before(v.PremiereAt):
+		return PremiereStateScheduled
+	case at.Before(v.premiereEndsAt()):
+		return PremiereStateLive
+	default:
+		return PremiereStateEnded
+	}
+}
+
+// PremierePosition returns the seconds of the video played by the time in the premiere.
+func (v *Video) PremierePosition(at time.Time) float64 {
+	switch v.PremiereState(at) {
+	case PremiereStateScheduled:
+		return 0
+	case PremiereStateLive:
+		return at.Sub(v.PremiereAt).Seconds()
+	default:
+		return v.Duration
 	}
 }
 
+// Blocked reports whether the video is blocked by a copyright claim.
+func (v *Video) Blocked() bool {
+	return v.ClaimPolicy == ClaimPolicyBlock
+}
+
+func (v *Video) premiereEndsAt() time.Time {
+	return v.PremiereAt.Add(time.Duration(v.Duration * float64(time.Second)))
+}
+
 type VideoDAO interface {
 	Get(ctx context.Context, id primitive.ObjectID) (*Video, error)
 	List(ctx context.Context, limit, skip int64) ([]*Video, error)
+	// Each calls fn with the videos batch by batch in the order of ID, the videos are read batch by batch
+	// instead of all at once, and the iteration stops at the first error of fn
+	Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error
 	Create(ctx context.Context, video *Video) error
 	Update(ctx context.Context, video *Video) error
 	UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error
+	// AddThumbnail adds the thumbnail candidate to the video and returns the video updated, it returns
+	// ErrTooManyThumbnails if the video has limit candidates already
+	AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error)
+	// SetPremiereAt schedules the premiere of the video, or cancels it if premiereAt is zero, and returns the video
+	// updated, it returns ErrPremiereStarted if the premiere has started by now
+	SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error)
+	// SetAgeRating sets the age rating of the video, or unrates it if the rating is empty, and returns the video
+	// updated
+	SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error)
+	// SetClaim applies the policy of the active claim of the claimant to the video, or lifts it if the policy is
+	// empty, and returns the video updated
+	SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error)
+	SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error
 	Delete(ctx context.Context, id primitive.ObjectID) error
 }
 
 var (
-	ErrVideoNotFound = errors.New("video not found")
+	ErrVideoNotFound      = errors.New("video not found")
+	ErrVideoAlreadyExists = errors.New("video already exists")
+	ErrInvalidBatchSize   = errors.New("invalid batch size")
+	ErrTooManyThumbnails  = errors.New("too many thumbnails")
+	ErrPremiereStarted    = errors.New("premiere started")
 )
 
-func getVideoKey(id primitive.ObjectID) string {
-	return "getVideo:" + id.Hex()
+func getVideoKey(tenantID string, id primitive.ObjectID) string {
+	return fmt.Sprintf("getVideo:%s:%s", tenantID, id.Hex())
 }
 
-func listVideoKey(limit, skip int64) string {
-	return fmt.Sprintf("listVideo:%d:%d", limit, skip)
+func listVideoKey(tenantID string, limit, skip int64) string {
+	return fmt.Sprintf("listVideo:%s:%d:%d", tenantID, limit, skip)
 }
 
+// VideoCacheTag is the gateway cache tag of the video
+func VideoCacheTag(id primitive.ObjectID) string {
+	return "video:" + id.Hex()
+}
+
+// VideoListCacheTag is the gateway cache tag of the video lists
+const VideoListCacheTag = "videos"
+
 // NewFakeVideo returns a fake video instance with random
 // id that is useful for testing
 func NewFakeVideo() *Video {
@@ -83,6 +198,7 @@ func NewFakeVideo() *Video {
 
 	return &Video{
 		ID:       id,
+		TenantID: tenantkit.DefaultTenantID,
 		Width:    800,
 		Height:   600,
 		Size:     144000,
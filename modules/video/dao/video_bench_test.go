@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"testing"
+)
+
+// benchmarkVideoDAOs runs the benchmark against every implementation of VideoDAO on the hot paths of the service.
+func benchmarkVideoDAOs(b *testing.B, benchmark func(b *testing.B, videoDAO VideoDAO)) {
+	mongoClient, redisClient := newTestClients()
+	b.Cleanup(func() {
+		_ = mongoClient.Close()
+		_ = redisClient.Close()
+	})
+
+	collection := mongoClient.Database().Collection("videos")
+
+	b.Run("mongo", func(b *testing.B) {
+		benchmark(b, NewMongoVideoDAO(collection))
+	})
+
+	b.Run("redis", func(b *testing.B) {
+		benchmark(b, NewRedisVideoDAO(redisClient, NewMongoVideoDAO(collection)))
+	})
+
+	b.Run("memory", func(b *testing.B) {
+		benchmark(b, NewMemoryVideoDAO())
+	})
+}
+
+func BenchmarkVideoDAO_Get(b *testing.B) {
+	benchmarkVideoDAOs(b, func(b *testing.B, videoDAO VideoDAO) {
+		ctx := context.Background()
+
+		video := NewFakeVideo()
+		if err := videoDAO.Create(ctx, video); err != nil {
+			b.Fatal(err)
+		}
+		b.Cleanup(func() {
+			_ = videoDAO.Delete(ctx, video.ID)
+		})
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := videoDAO.Get(ctx, video.ID); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
+
+func BenchmarkVideoDAO_List(b *testing.B) {
+	benchmarkVideoDAOs(b, func(b *testing.B, videoDAO VideoDAO) {
+		ctx := context.Background()
+
+		for i := 0; i < 10; i++ {
+			video := NewFakeVideo()
+			if err := videoDAO.Create(ctx, video); err != nil {
+				b.Fatal(err)
+			}
+			b.Cleanup(func() {
+				_ = videoDAO.Delete(ctx, video.ID)
+			})
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := videoDAO.List(ctx, 10, 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+}
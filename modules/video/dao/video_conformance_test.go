@@ -0,0 +1,447 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("VideoDAO conformance", func() {
+	Describe("mongoVideoDAO", func() {
+		itBehavesLikeVideoDAO(func() VideoDAO {
+			return NewMongoVideoDAO(mongoClient.Database().Collection("videos"))
+		})
+	})
+
+	Describe("redisVideoDAO", func() {
+		itBehavesLikeVideoDAO(func() VideoDAO {
+			return NewRedisVideoDAO(redisClient, NewMongoVideoDAO(mongoClient.Database().Collection("videos")))
+		})
+	})
+
+	Describe("memoryVideoDAO", func() {
+		itBehavesLikeVideoDAO(func() VideoDAO {
+			return NewMemoryVideoDAO()
+		})
+	})
+})
+
+// itBehavesLikeVideoDAO specifies the semantics every VideoDAO shares. Every spec runs in a tenant of its own,
+// and lists the videos once after the writes, so the specs run against the shared databases and the cached lists.
+func itBehavesLikeVideoDAO(newVideoDAO func() VideoDAO) {
+	var (
+		videoDAO VideoDAO
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		videoDAO = newVideoDAO()
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("conformance-%d", time.Now().UnixNano()))
+
+		DeferCleanup(func() {
+			Expect(videoDAO.Each(ctx, 100, func(videos []*Video) error {
+				for _, video := range videos {
+					if err := videoDAO.Delete(ctx, video.ID); err != nil {
+						return err
+					}
+				}
+				return nil
+			})).To(Succeed())
+		})
+	})
+
+	create := func(video *Video) *Video {
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+
+		return video
+	}
+
+	Describe("Get", func() {
+		It("gets the created video", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Get(ctx, video.ID)).To(matchVideo(video))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			_, err := videoDAO.Get(ctx, primitive.NewObjectID())
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+
+		It("returns ErrVideoNotFound if the video belongs to another tenant", func() {
+			video := create(NewFakeVideo())
+
+			_, err := videoDAO.Get(tenantkit.WithTenantID(ctx, "another-tenant"), video.ID)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("Create", func() {
+		It("returns ErrVideoAlreadyExists if the video exists", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Create(ctx, video)).To(MatchError(ErrVideoAlreadyExists))
+		})
+	})
+
+	Describe("Update", func() {
+		It("updates the video", func() {
+			video := create(NewFakeVideo())
+			video.Size = 1234
+
+			Expect(videoDAO.Update(ctx, video)).To(Succeed())
+			Expect(videoDAO.Get(ctx, video.ID)).To(matchVideo(video))
+		})
+
+		It("succeeds if the video is not changed", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Update(ctx, video)).To(Succeed())
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			Expect(videoDAO.Update(ctx, NewFakeVideo())).To(MatchError(ErrVideoNotFound))
+		})
+
+		It("returns ErrVideoNotFound if the video belongs to another tenant", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Update(tenantkit.WithTenantID(ctx, "another-tenant"), video)).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("UpdateVariant", func() {
+		It("sets the variant of the video", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.UpdateVariant(ctx, video.ID, "480p", "480p.mp4")).To(Succeed())
+
+			video.Variants["480p"] = "480p.mp4"
+			Expect(videoDAO.Get(ctx, video.ID)).To(matchVideo(video))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			Expect(videoDAO.UpdateVariant(ctx, primitive.NewObjectID(), "480p", "480p.mp4")).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("SetFingerprint", func() {
+		It("sets the fingerprint of the video", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.SetFingerprint(ctx, video.ID, "fingerprint")).To(Succeed())
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Fingerprint).To(Equal("fingerprint"))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			Expect(videoDAO.SetFingerprint(ctx, primitive.NewObjectID(), "fingerprint")).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("AddThumbnail", func() {
+		It("adds the thumbnail to the video", func() {
+			video := create(NewFakeVideo())
+			thumbnail := &Thumbnail{ID: primitive.NewObjectID(), URL: "thumbnail.png", Weight: 1}
+
+			updated, err := videoDAO.AddThumbnail(ctx, video.ID, thumbnail, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Thumbnails).To(HaveLen(1))
+			Expect(updated.Thumbnails[0].ID).To(Equal(thumbnail.ID))
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Thumbnails).To(HaveLen(1))
+		})
+
+		It("returns ErrTooManyThumbnails if the video has the limit of the thumbnails", func() {
+			video := create(NewFakeVideo())
+
+			for i := 0; i < 2; i++ {
+				_, err := videoDAO.AddThumbnail(ctx, video.ID, &Thumbnail{ID: primitive.NewObjectID(), Weight: 1}, 2)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := videoDAO.AddThumbnail(ctx, video.ID, &Thumbnail{ID: primitive.NewObjectID(), Weight: 1}, 2)
+			Expect(err).To(MatchError(ErrTooManyThumbnails))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			_, err := videoDAO.AddThumbnail(ctx, primitive.NewObjectID(), &Thumbnail{ID: primitive.NewObjectID()}, 2)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("SetPremiereAt", func() {
+		It("schedules the premiere of the video", func() {
+			video := create(NewFakeVideo())
+			premiereAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+			updated, err := videoDAO.SetPremiereAt(ctx, video.ID, premiereAt, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.PremiereAt).To(BeTemporally("==", premiereAt))
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.PremiereAt).To(BeTemporally("==", premiereAt))
+		})
+
+		It("cancels the premiere scheduled", func() {
+			video := create(NewFakeVideo())
+
+			_, err := videoDAO.SetPremiereAt(ctx, video.ID, time.Now().Add(time.Hour), time.Now())
+			Expect(err).NotTo(HaveOccurred())
+
+			updated, err := videoDAO.SetPremiereAt(ctx, video.ID, time.Time{}, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.PremiereAt.IsZero()).To(BeTrue())
+		})
+
+		It("returns ErrPremiereStarted if the premiere has started", func() {
+			video := create(NewFakeVideo())
+			now := time.Now()
+
+			_, err := videoDAO.SetPremiereAt(ctx, video.ID, now.Add(time.Minute), now)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = videoDAO.SetPremiereAt(ctx, video.ID, time.Time{}, now.Add(time.Hour))
+			Expect(err).To(MatchError(ErrPremiereStarted))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			_, err := videoDAO.SetPremiereAt(ctx, primitive.NewObjectID(), time.Time{}, time.Now())
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("SetAgeRating", func() {
+		It("sets the age rating of the video", func() {
+			video := create(NewFakeVideo())
+
+			updated, err := videoDAO.SetAgeRating(ctx, video.ID, AgeRatingMature)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.AgeRating).To(Equal(AgeRatingMature))
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.AgeRating).To(Equal(AgeRatingMature))
+		})
+
+		It("unrates the video if the rating is empty", func() {
+			video := create(NewFakeVideo())
+
+			_, err := videoDAO.SetAgeRating(ctx, video.ID, AgeRatingTeen)
+			Expect(err).NotTo(HaveOccurred())
+
+			updated, err := videoDAO.SetAgeRating(ctx, video.ID, AgeRatingNone)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.AgeRating).To(Equal(AgeRatingNone))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			_, err := videoDAO.SetAgeRating(ctx, primitive.NewObjectID(), AgeRatingMature)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("SetClaim", func() {
+		It("applies the policy of the claim to the video", func() {
+			video := create(NewFakeVideo())
+
+			updated, err := videoDAO.SetClaim(ctx, video.ID, ClaimPolicyBlock, "claimant")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.ClaimPolicy).To(Equal(ClaimPolicyBlock))
+			Expect(updated.ClaimantID).To(Equal("claimant"))
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Blocked()).To(BeTrue())
+		})
+
+		It("lifts the policy if the policy is empty", func() {
+			video := create(NewFakeVideo())
+
+			_, err := videoDAO.SetClaim(ctx, video.ID, ClaimPolicyBlock, "claimant")
+			Expect(err).NotTo(HaveOccurred())
+
+			updated, err := videoDAO.SetClaim(ctx, video.ID, ClaimPolicyNone, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.ClaimPolicy).To(Equal(ClaimPolicyNone))
+			Expect(updated.ClaimantID).To(BeEmpty())
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			_, err := videoDAO.SetClaim(ctx, primitive.NewObjectID(), ClaimPolicyBlock, "claimant")
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the video", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Delete(ctx, video.ID)).To(Succeed())
+
+			_, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+
+		It("returns ErrVideoNotFound if the video does not exist", func() {
+			Expect(videoDAO.Delete(ctx, primitive.NewObjectID())).To(MatchError(ErrVideoNotFound))
+		})
+
+		It("returns ErrVideoNotFound if the video belongs to another tenant", func() {
+			video := create(NewFakeVideo())
+
+			Expect(videoDAO.Delete(tenantkit.WithTenantID(ctx, "another-tenant"), video.ID)).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	Describe("List", func() {
+		// expectList lists the videos of the tenant and expects them to be the videos in order
+		expectList := func(limit, skip int64, expected ...*Video) {
+			videos, err := videoDAO.List(ctx, limit, skip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(videos).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(videos[i]).To(matchVideo(expected[i]))
+			}
+		}
+
+		It("lists the videos of the tenant in the order of ID", func() {
+			var videos []*Video
+			for i := 0; i < 3; i++ {
+				videos = append(videos, create(NewFakeVideo()))
+			}
+
+			anotherCtx := tenantkit.WithTenantID(ctx, "another-tenant")
+			another := NewFakeVideo()
+			Expect(videoDAO.Create(anotherCtx, another)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(videoDAO.Delete(anotherCtx, another.ID)).To(Succeed())
+			})
+
+			expectList(0, 0, videos...)
+		})
+
+		It("paginates the videos by the limit and the skip", func() {
+			var videos []*Video
+			for i := 0; i < 4; i++ {
+				videos = append(videos, create(NewFakeVideo()))
+			}
+
+			expectList(2, 1, videos[1], videos[2])
+			expectList(2, 3, videos[3])
+			expectList(2, 4)
+		})
+	})
+
+	Describe("Each", func() {
+		It("calls fn with the videos batch by batch in the order of ID", func() {
+			var videos []*Video
+			for i := 0; i < 3; i++ {
+				videos = append(videos, create(NewFakeVideo()))
+			}
+
+			var batches []int
+			var each []*Video
+			Expect(videoDAO.Each(ctx, 2, func(batch []*Video) error {
+				batches = append(batches, len(batch))
+				each = append(each, batch...)
+				return nil
+			})).To(Succeed())
+
+			Expect(batches).To(Equal([]int{2, 1}))
+			for i := range videos {
+				Expect(each[i]).To(matchVideo(videos[i]))
+			}
+		})
+
+		It("returns ErrInvalidBatchSize if the batch size is not positive", func() {
+			Expect(videoDAO.Each(ctx, 0, func([]*Video) error { return nil })).To(MatchError(ErrInvalidBatchSize))
+		})
+	})
+
+	Describe("concurrency", func() {
+		const concurrency = 16
+
+		It("creates the videos created concurrently", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < concurrency; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					Expect(videoDAO.Create(ctx, NewFakeVideo())).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			Expect(videoDAO.List(ctx, 0, 0)).To(HaveLen(concurrency))
+		})
+
+		It("keeps the variants set concurrently", func() {
+			video := create(NewFakeVideo())
+
+			var wg sync.WaitGroup
+			for i := 0; i < concurrency; i++ {
+				variant := fmt.Sprintf("variant-%d", i)
+				video.Variants[variant] = variant + ".mp4"
+
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					Expect(videoDAO.UpdateVariant(ctx, video.ID, variant, variant+".mp4")).To(Succeed())
+				}()
+			}
+			wg.Wait()
+
+			Expect(videoDAO.Get(ctx, video.ID)).To(matchVideo(video))
+		})
+
+		It("keeps the thumbnails added concurrently within the limit", func() {
+			video := create(NewFakeVideo())
+
+			var (
+				wg    sync.WaitGroup
+				mu    sync.Mutex
+				added int
+			)
+			for i := 0; i < concurrency; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+
+					_, err := videoDAO.AddThumbnail(ctx, video.ID, &Thumbnail{ID: primitive.NewObjectID(), Weight: 1}, 3)
+					if errors.Is(err, ErrTooManyThumbnails) {
+						return
+					}
+					Expect(err).NotTo(HaveOccurred())
+
+					mu.Lock()
+					added++
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+
+			got, err := videoDAO.Get(ctx, video.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got.Thumbnails).To(HaveLen(3))
+			Expect(added).To(Equal(3))
+		})
+	})
+}
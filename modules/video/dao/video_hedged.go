@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// hedgedVideoDAO gets the videos from the read replicas with hedging, the read is sent to the next replica
+// if the previous one is slower than the latency quantile. The other operations are sent to the base DAO
+// on the primary, since the replicas lag behind.
+type hedgedVideoDAO struct {
+	VideoDAO
+
+	replicas []VideoDAO
+	hedger   *hedgekit.Hedger
+	next     uint32
+}
+
+var _ VideoDAO = (*hedgedVideoDAO)(nil)
+
+// hedgedVideoAttempts is the number of the replicas a read is sent to at most
+const hedgedVideoAttempts = 2
+
+// NewHedgedVideoDAO returns the DAO hedging the reads across the replicas, the reads are sent to
+// the base DAO if there is no replica. The reads are not hedged if there is a single replica, since
+// there is no other replica to hedge to.
+func NewHedgedVideoDAO(baseDAO VideoDAO, replicas []VideoDAO, hedger *hedgekit.Hedger) *hedgedVideoDAO {
+	if len(replicas) == 0 {
+		replicas = []VideoDAO{baseDAO}
+	}
+
+	return &hedgedVideoDAO{
+		VideoDAO: baseDAO,
+		replicas: replicas,
+		hedger:   hedger,
+	}
+}
+
+func (dao *hedgedVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	// the reads start from the replicas in turn, so the load is spread across them
+	start := int(atomic.AddUint32(&dao.next, 1))
+
+	attempts := hedgedVideoAttempts
+	if len(dao.replicas) < attempts {
+		attempts = len(dao.replicas)
+	}
+
+	fns := make([]hedgekit.Func, 0, attempts)
+	for i := 0; i < attempts; i++ {
+		replica := dao.replicas[(start+i)%len(dao.replicas)]
+
+		fns = append(fns, func(ctx context.Context) (interface{}, error) {
+			return replica.Get(ctx, id)
+		})
+	}
+
+	video, err := dao.hedger.Do(ctx, fns...)
+	if err != nil {
+		return nil, err
+	}
+
+	return video.(*Video), nil
+}
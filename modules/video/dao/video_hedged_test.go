@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/hedgekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+// slowVideoDAO delays the reads of the base DAO, like a replica under load
+type slowVideoDAO struct {
+	VideoDAO
+	latency time.Duration
+	reads   int32
+}
+
+func (dao *slowVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	atomic.AddInt32(&dao.reads, 1)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(dao.latency):
+	}
+
+	return dao.VideoDAO.Get(ctx, id)
+}
+
+var _ = Describe("hedgedVideoDAO", func() {
+	var (
+		ctx      context.Context
+		primary  VideoDAO
+		hedger   *hedgekit.Hedger
+		videoDAO VideoDAO
+		video    *Video
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		primary = NewMemoryVideoDAO()
+		replicas := []VideoDAO{
+			&slowVideoDAO{VideoDAO: primary, latency: time.Second},
+			primary,
+		}
+		hedger = hedgekit.NewHedger(ctx, "video", &hedgekit.HedgeConfig{
+			Quantile:     0.95,
+			InitialDelay: 10 * time.Millisecond,
+			WindowSize:   100,
+		}, nonrecording.NewNoopMeterProvider().Meter(""))
+
+		videoDAO = NewHedgedVideoDAO(primary, replicas, hedger)
+
+		video = NewFakeVideo()
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	It("gets the video without waiting for the slow replica", func() {
+		// one of the replicas is slow, so every read either starts from or is hedged to the fast one
+		for i := 0; i < 2; i++ {
+			start := time.Now()
+
+			Expect(videoDAO.Get(ctx, video.ID)).To(Equal(video))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		}
+	})
+
+	When("there is a single replica", func() {
+		It("gets the video from the replica without hedging", func() {
+			replica := &slowVideoDAO{VideoDAO: primary, latency: 50 * time.Millisecond}
+			videoDAO = NewHedgedVideoDAO(primary, []VideoDAO{replica}, hedger)
+
+			Expect(videoDAO.Get(ctx, video.ID)).To(Equal(video))
+			Expect(atomic.LoadInt32(&replica.reads)).To(BeEquivalentTo(1))
+		})
+	})
+})
@@ -0,0 +1,279 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// memoryVideoDAO keeps the videos in memory, it is meant for running the modules
+// without MongoDB in local development, and the videos are lost on exit.
+type memoryVideoDAO struct {
+	mu     sync.RWMutex
+	videos map[primitive.ObjectID]*Video
+}
+
+var _ VideoDAO = (*memoryVideoDAO)(nil)
+
+func NewMemoryVideoDAO() *memoryVideoDAO {
+	return &memoryVideoDAO{
+		videos: make(map[primitive.ObjectID]*Video),
+	}
+}
+
+func (dao *memoryVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return nil, ErrVideoNotFound
+	}
+
+	return copyVideo(video), nil
+}
+
+func (dao *memoryVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video, error) {
+	dao.mu.RLock()
+	defer dao.mu.RUnlock()
+
+	videos := make([]*Video, 0)
+	for _, video := range dao.videos {
+		if inTenant(ctx, video) {
+			videos = append(videos, copyVideo(video))
+		}
+	}
+
+	// sort by ID so that the order is stable across pages, like the mongo DAO
+	sort.Slice(videos, func(i, j int) bool {
+		return bytes.Compare(videos[i].ID[:], videos[j].ID[:]) < 0
+	})
+
+	if skip >= int64(len(videos)) {
+		return videos[:0], nil
+	}
+	videos = videos[skip:]
+	if limit > 0 && limit < int64(len(videos)) {
+		videos = videos[:limit]
+	}
+
+	return videos, nil
+}
+
+func (dao *memoryVideoDAO) Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error {
+	if batchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	// fn is called without the lock, so that it can modify the videos
+	videos, err := dao.List(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	for start := int64(0); start < int64(len(videos)); start += batchSize {
+		end := start + batchSize
+		if end > int64(len(videos)) {
+			end = int64(len(videos))
+		}
+
+		if err := fn(videos[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *memoryVideoDAO) Create(ctx context.Context, video *Video) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video.TenantID = tenantkit.FromContext(ctx)
+	if video.ID.IsZero() {
+		video.ID = primitive.NewObjectID()
+	}
+
+	// the IDs are unique across the tenants like the _id of the collection
+	if _, ok := dao.videos[video.ID]; ok {
+		return ErrVideoAlreadyExists
+	}
+
+	dao.videos[video.ID] = copyVideo(video)
+
+	return nil
+}
+
+func (dao *memoryVideoDAO) Update(ctx context.Context, video *Video) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	stored, ok := dao.videos[video.ID]
+	if !ok || !inTenant(ctx, stored) {
+		return ErrVideoNotFound
+	}
+
+	video.TenantID = tenantkit.FromContext(ctx)
+	dao.videos[video.ID] = copyVideo(video)
+
+	return nil
+}
+
+func (dao *memoryVideoDAO) UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return ErrVideoNotFound
+	}
+
+	if video.Variants == nil {
+		video.Variants = make(map[string]string)
+	}
+	video.Variants[variant] = url
+	video.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (dao *memoryVideoDAO) SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return ErrVideoNotFound
+	}
+
+	video.Fingerprint = fingerprint
+	video.UpdatedAt = time.Now()
+
+	return nil
+}
+
+func (dao *memoryVideoDAO) AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return nil, ErrVideoNotFound
+	}
+
+	if len(video.Thumbnails) >= limit {
+		return nil, ErrTooManyThumbnails
+	}
+
+	t := *thumbnail
+	video.Thumbnails = append(video.Thumbnails, &t)
+	video.UpdatedAt = time.Now()
+
+	return copyVideo(video), nil
+}
+
+func (dao *memoryVideoDAO) SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return nil, ErrVideoNotFound
+	}
+
+	if !video.PremiereAt.IsZero() && !now.Before(video.PremiereAt) {
+		return nil, ErrPremiereStarted
+	}
+
+	video.PremiereAt = premiereAt
+	video.UpdatedAt = time.Now()
+
+	return copyVideo(video), nil
+}
+
+func (dao *memoryVideoDAO) SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return nil, ErrVideoNotFound
+	}
+
+	video.AgeRating = rating
+	video.UpdatedAt = time.Now()
+
+	return copyVideo(video), nil
+}
+
+func (dao *memoryVideoDAO) SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return nil, ErrVideoNotFound
+	}
+
+	video.ClaimPolicy = policy
+	video.ClaimantID = claimantID
+	if policy == ClaimPolicyNone {
+		video.ClaimantID = ""
+	}
+	video.UpdatedAt = time.Now()
+
+	return copyVideo(video), nil
+}
+
+func (dao *memoryVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	video, ok := dao.videos[id]
+	if !ok || !inTenant(ctx, video) {
+		return ErrVideoNotFound
+	}
+
+	delete(dao.videos, id)
+
+	return nil
+}
+
+// inTenant reports whether the video belongs to the tenant of the context like tenantFilter,
+// videos without a tenant ID belong to the default tenant.
+func inTenant(ctx context.Context, video *Video) bool {
+	tenantID := tenantkit.FromContext(ctx)
+	if tenantID == tenantkit.DefaultTenantID && video.TenantID == "" {
+		return true
+	}
+
+	return video.TenantID == tenantID
+}
+
+// copyVideo copies the video along with its variants and thumbnails, so the stored video is not modified through
+// the returned one.
+func copyVideo(video *Video) *Video {
+	v := *video
+
+	if video.Variants != nil {
+		v.Variants = make(map[string]string, len(video.Variants))
+		for variant, url := range video.Variants {
+			v.Variants[variant] = url
+		}
+	}
+
+	if video.Thumbnails != nil {
+		v.Thumbnails = make([]*Thumbnail, 0, len(video.Thumbnails))
+		for _, thumbnail := range video.Thumbnails {
+			t := *thumbnail
+			v.Thumbnails = append(v.Thumbnails, &t)
+		}
+	}
+
+	return &v
+}
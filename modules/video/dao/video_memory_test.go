@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("memoryVideoDAO", func() {
+	var (
+		videoDAO *memoryVideoDAO
+		ctx      context.Context
+		video    *Video
+	)
+
+	BeforeEach(func() {
+		videoDAO = NewMemoryVideoDAO()
+		ctx = context.Background()
+
+		video = NewFakeVideo()
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	It("gets the created video", func() {
+		Expect(videoDAO.Get(ctx, video.ID)).To(Equal(video))
+	})
+
+	It("does not get the video of another tenant", func() {
+		_, err := videoDAO.Get(tenantkit.WithTenantID(ctx, "another-tenant"), video.ID)
+		Expect(err).To(MatchError(ErrVideoNotFound))
+	})
+
+	It("lists the videos in the order of ID", func() {
+		another := NewFakeVideo()
+		Expect(videoDAO.Create(ctx, another)).To(Succeed())
+
+		Expect(videoDAO.List(ctx, 0, 0)).To(Equal([]*Video{video, another}))
+		Expect(videoDAO.List(ctx, 1, 1)).To(Equal([]*Video{another}))
+		Expect(videoDAO.List(ctx, 0, 2)).To(BeEmpty())
+	})
+
+	It("iterates the videos batch by batch in the order of ID", func() {
+		another := NewFakeVideo()
+		Expect(videoDAO.Create(ctx, another)).To(Succeed())
+
+		var batches [][]*Video
+		Expect(videoDAO.Each(ctx, 1, func(videos []*Video) error {
+			batches = append(batches, videos)
+			return nil
+		})).To(Succeed())
+		Expect(batches).To(Equal([][]*Video{{video}, {another}}))
+
+		Expect(videoDAO.Each(ctx, 0, nil)).To(MatchError(ErrInvalidBatchSize))
+	})
+
+	It("updates the variant without modifying the returned video", func() {
+		resp, err := videoDAO.Get(ctx, video.ID)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(videoDAO.UpdateVariant(ctx, video.ID, "480", "https://storage.example.com/480p.mp4")).To(Succeed())
+		Expect(resp.Variants).NotTo(HaveKey("480"))
+
+		resp, err = videoDAO.Get(ctx, video.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.Variants).To(HaveKeyWithValue("480", "https://storage.example.com/480p.mp4"))
+	})
+
+	It("returns video not found error for the missing video", func() {
+		id := primitive.NewObjectID()
+
+		Expect(videoDAO.Update(ctx, &Video{ID: id})).To(MatchError(ErrVideoNotFound))
+		Expect(videoDAO.Delete(ctx, id)).To(MatchError(ErrVideoNotFound))
+	})
+})
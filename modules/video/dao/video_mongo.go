@@ -3,13 +3,18 @@ package dao
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// mongoVideoDAO scopes every query to the tenant of the context.
 type mongoVideoDAO struct {
 	collection *mongo.Collection
 }
@@ -22,9 +27,21 @@ func NewMongoVideoDAO(collection *mongo.Collection) *mongoVideoDAO {
 	}
 }
 
+// CreateVideoIndexes creates the indexes of the video collection if not exist.
+func CreateVideoIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "tenant_id", Value: 1}, {Key: "_id", Value: 1}},
+	})
+
+	return err
+}
+
 func (dao *mongoVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
 	var video Video
-	if err := dao.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&video); err != nil {
+	if err := dao.collection.FindOne(ctx, filter).Decode(&video); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, ErrVideoNotFound
 		}
@@ -35,9 +52,10 @@ func (dao *mongoVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Vide
 }
 
 func (dao *mongoVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video, error) {
-	o := options.Find().SetLimit(limit).SetSkip(skip)
+	// sort by ID so that the order is stable across pages and shards
+	o := mongokit.FindOptions(mongokit.Page{Limit: limit, Skip: skip}, mongokit.Asc("_id"))
 
-	cursor, err := dao.collection.Find(ctx, bson.M{}, o)
+	cursor, err := dao.collection.Find(ctx, tenantFilter(ctx), o)
 	if err != nil {
 		return nil, err
 	}
@@ -56,9 +74,56 @@ func (dao *mongoVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video
 	return videos, nil
 }
 
+// Each decodes the videos from a single cursor, which fetches batchSize videos from the server at a time.
+func (dao *mongoVideoDAO) Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error {
+	if batchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	o := mongokit.FindOptions(mongokit.Page{}, mongokit.Asc("_id")).SetBatchSize(int32(batchSize))
+
+	cursor, err := dao.collection.Find(ctx, tenantFilter(ctx), o)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	videos := make([]*Video, 0, batchSize)
+	for cursor.Next(ctx) {
+		var video Video
+		if err := cursor.Decode(&video); err != nil {
+			return err
+		}
+
+		videos = append(videos, &video)
+		if int64(len(videos)) < batchSize {
+			continue
+		}
+
+		if err := fn(videos); err != nil {
+			return err
+		}
+		videos = make([]*Video, 0, batchSize)
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	if len(videos) > 0 {
+		return fn(videos)
+	}
+
+	return nil
+}
+
 func (dao *mongoVideoDAO) Create(ctx context.Context, video *Video) error {
+	video.TenantID = tenantkit.FromContext(ctx)
+
 	result, err := dao.collection.InsertOne(ctx, video)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrVideoAlreadyExists
+		}
 		return err
 	}
 
@@ -68,13 +133,18 @@ func (dao *mongoVideoDAO) Create(ctx context.Context, video *Video) error {
 }
 
 func (dao *mongoVideoDAO) Update(ctx context.Context, video *Video) error {
+	filter := tenantFilter(ctx)
+	filter["_id"] = video.ID
+
+	video.TenantID = tenantkit.FromContext(ctx)
+
 	if result, err := dao.collection.ReplaceOne(
 		ctx,
-		bson.M{"_id": video.ID},
+		filter,
 		video,
 	); err != nil {
 		return err
-	} else if result.ModifiedCount == 0 {
+	} else if result.MatchedCount == 0 {
 		return ErrVideoNotFound
 	}
 
@@ -82,7 +152,8 @@ func (dao *mongoVideoDAO) Update(ctx context.Context, video *Video) error {
 }
 
 func (dao *mongoVideoDAO) UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error {
-	filter := bson.M{"_id": id}
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
 	update := bson.D{{Key: "$set", Value: bson.M{"variants." + variant: url}}}
 	opts := options.Update()
 
@@ -95,8 +166,92 @@ func (dao *mongoVideoDAO) UpdateVariant(ctx context.Context, id primitive.Object
 	return nil
 }
 
+func (dao *mongoVideoDAO) SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+	update := bson.D{{Key: "$set", Value: bson.M{"fingerprint": fingerprint, "updated_at": time.Now()}}}
+	opts := options.Update()
+
+	if result, err := dao.collection.UpdateOne(ctx, filter, update, opts); err != nil {
+		return err
+	} else if result.MatchedCount == 0 {
+		return ErrVideoNotFound
+	}
+
+	return nil
+}
+
+// AddThumbnail pushes the thumbnail only if the video has no candidate at the index of the limit, so the
+// candidates added concurrently never exceed the limit.
+func (dao *mongoVideoDAO) AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+	filter[fmt.Sprintf("thumbnails.%d", limit-1)] = bson.M{"$exists": false}
+	update := bson.D{
+		{Key: "$push", Value: bson.M{"thumbnails": thumbnail}},
+		{Key: "$set", Value: bson.M{"updated_at": time.Now()}},
+	}
+
+	return dao.findOneAndUpdate(ctx, id, filter, update, ErrTooManyThumbnails)
+}
+
+// SetPremiereAt sets the premiere only if the video has no premiere or the premiere is after now, so the premiere
+// started by the time of the update is never changed.
+func (dao *mongoVideoDAO) SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+	filter["$or"] = bson.A{
+		bson.M{"premiere_at": nil},
+		bson.M{"premiere_at": bson.M{"$gt": now}},
+	}
+
+	set := bson.M{"updated_at": time.Now()}
+	update := bson.D{{Key: "$set", Value: set}}
+	if premiereAt.IsZero() {
+		update = append(update, bson.E{Key: "$unset", Value: bson.M{"premiere_at": ""}})
+	} else {
+		set["premiere_at"] = premiereAt
+	}
+
+	return dao.findOneAndUpdate(ctx, id, filter, update, ErrPremiereStarted)
+}
+
+func (dao *mongoVideoDAO) SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
+	set := bson.M{"updated_at": time.Now()}
+	update := bson.D{{Key: "$set", Value: set}}
+	if rating == AgeRatingNone {
+		update = append(update, bson.E{Key: "$unset", Value: bson.M{"age_rating": ""}})
+	} else {
+		set["age_rating"] = rating
+	}
+
+	return dao.findOneAndUpdate(ctx, id, filter, update, nil)
+}
+
+func (dao *mongoVideoDAO) SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error) {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
+	set := bson.M{"updated_at": time.Now()}
+	update := bson.D{{Key: "$set", Value: set}}
+	if policy == ClaimPolicyNone {
+		update = append(update, bson.E{Key: "$unset", Value: bson.M{"claim_policy": "", "claimant_id": ""}})
+	} else {
+		set["claim_policy"] = policy
+		set["claimant_id"] = claimantID
+	}
+
+	return dao.findOneAndUpdate(ctx, id, filter, update, nil)
+}
+
 func (dao *mongoVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
-	if result, err := dao.collection.DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+	filter := tenantFilter(ctx)
+	filter["_id"] = id
+
+	if result, err := dao.collection.DeleteOne(ctx, filter); err != nil {
 		return err
 	} else if result.DeletedCount == 0 {
 		return ErrVideoNotFound
@@ -104,3 +259,41 @@ func (dao *mongoVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) err
 
 	return nil
 }
+
+// findOneAndUpdate updates the video matching the filter and returns the video updated. If no video matches,
+// the video is looked up by its ID alone, so ErrVideoNotFound is told from errUnmatched of the other conditions
+// of the filter.
+func (dao *mongoVideoDAO) findOneAndUpdate(ctx context.Context, id primitive.ObjectID, filter bson.M, update interface{}, errUnmatched error) (*Video, error) {
+	var video Video
+	err := dao.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&video)
+	if err == nil {
+		return &video, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	idFilter := tenantFilter(ctx)
+	idFilter["_id"] = id
+
+	count, err := dao.collection.CountDocuments(ctx, idFilter)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 || errUnmatched == nil {
+		return nil, ErrVideoNotFound
+	}
+
+	return nil, errUnmatched
+}
+
+// tenantFilter matches the videos of the tenant of the context,
+// videos created before multi-tenancy have no tenant ID and belong to the default tenant.
+func tenantFilter(ctx context.Context) bson.M {
+	tenantID := tenantkit.FromContext(ctx)
+	if tenantID == tenantkit.DefaultTenantID {
+		return bson.M{"tenant_id": bson.M{"$in": bson.A{tenantID, nil}}}
+	}
+
+	return bson.M{"tenant_id": tenantID}
+}
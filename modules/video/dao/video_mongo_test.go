@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.mongodb.org/mongo-driver/bson"
@@ -51,6 +52,32 @@ var _ = Describe("mongoVideoDAO", func() {
 			})
 		})
 
+		When("video belongs to another tenant", func() {
+			BeforeEach(func() {
+				id = video.ID
+				ctx = tenantkit.WithTenantID(ctx, "another-tenant")
+			})
+
+			It("returns video not found error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrVideoNotFound))
+			})
+		})
+
+		When("video is created before multi-tenancy", func() {
+			BeforeEach(func() {
+				id = video.ID
+				_, err := videoDAO.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$unset": bson.M{"tenant_id": ""}})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("belongs to the default tenant", func() {
+				Expect(resp).NotTo(BeNil())
+				Expect(resp.ID).To(Equal(video.ID))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		When("success", func() {
 			BeforeEach(func() { id = video.ID })
 
@@ -89,6 +116,15 @@ var _ = Describe("mongoVideoDAO", func() {
 			resp, err = videoDAO.List(ctx, limit, skip)
 		})
 
+		When("videos belong to another tenant", func() {
+			BeforeEach(func() { ctx = tenantkit.WithTenantID(ctx, "another-tenant") })
+
+			It("returns empty list with no error", func() {
+				Expect(resp).To(BeEmpty())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
 		Context("success", func() {
 			When("no limit and offset", func() {
 				It("returns videos with no error", func() {
@@ -117,6 +153,59 @@ var _ = Describe("mongoVideoDAO", func() {
 		})
 	})
 
+	Describe("Each", func() {
+		var (
+			videos    []*Video
+			batchSize int64
+
+			batches [][]*Video
+			err     error
+		)
+
+		BeforeEach(func() {
+			// iterate every video of the tenant, so use a tenant of the test only
+			tenantID := "each-" + primitive.NewObjectID().Hex()
+			ctx = tenantkit.WithTenantID(ctx, tenantID)
+
+			videos = []*Video{NewFakeVideo(), NewFakeVideo(), NewFakeVideo()}
+			for _, video := range videos {
+				video.TenantID = tenantID
+				insertVideo(ctx, videoDAO, video)
+			}
+
+			batchSize = 2
+			batches = nil
+		})
+
+		AfterEach(func() {
+			for _, video := range videos {
+				deleteVideo(ctx, videoDAO, video.ID)
+			}
+		})
+
+		JustBeforeEach(func() {
+			err = videoDAO.Each(ctx, batchSize, func(videos []*Video) error {
+				batches = append(batches, videos)
+				return nil
+			})
+		})
+
+		When("batch size is invalid", func() {
+			BeforeEach(func() { batchSize = 0 })
+
+			It("returns invalid batch size error", func() {
+				Expect(err).To(MatchError(ErrInvalidBatchSize))
+			})
+		})
+
+		When("success", func() {
+			It("calls fn with the videos batch by batch in the order of ID", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(batches).To(Equal([][]*Video{videos[:2], videos[2:]}))
+			})
+		})
+	})
+
 	Describe("Create", func() {
 		var (
 			video *Video
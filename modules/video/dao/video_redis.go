@@ -2,14 +2,20 @@ package dao
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-redis/cache/v8"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
 )
 
 type redisVideoDAO struct {
+	client  *rediskit.RedisClient
 	cache   *cache.Cache
 	baseDAO VideoDAO
 }
@@ -24,6 +30,7 @@ const (
 
 func NewRedisVideoDAO(client *rediskit.RedisClient, baseDAO VideoDAO) *redisVideoDAO {
 	return &redisVideoDAO{
+		client: client,
 		cache: cache.New(&cache.Options{
 			Redis:      client,
 			LocalCache: cache.NewTinyLFU(videoDAOLocalCacheSize, videoDAOLocalCacheDuration),
@@ -36,7 +43,7 @@ func (dao *redisVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Vide
 	var video Video
 
 	if err := dao.cache.Once(&cache.Item{
-		Key:   getVideoKey(id),
+		Key:   getVideoKey(tenantkit.FromContext(ctx), id),
 		Value: &video,
 		TTL:   videoDAORedisCacheDuration,
 		Do: func(*cache.Item) (interface{}, error) {
@@ -53,7 +60,7 @@ func (dao *redisVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video
 	var videos []*Video
 
 	if err := dao.cache.Once(&cache.Item{
-		Key:   listVideoKey(limit, skip),
+		Key:   listVideoKey(tenantkit.FromContext(ctx), limit, skip),
 		Value: &videos,
 		TTL:   videoDAORedisCacheDuration,
 		Do: func(*cache.Item) (interface{}, error) {
@@ -66,20 +73,124 @@ func (dao *redisVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video
 	return videos, nil
 }
 
-// The following operations are not cachable, just pass down to baseDAO.
+// The following operations are not cachable, just pass down to baseDAO,
+// then invalidate the caches of the changed video.
+
+// Each is not cached, since the videos are read only once.
+func (dao *redisVideoDAO) Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error {
+	return dao.baseDAO.Each(ctx, batchSize, fn)
+}
 
 func (dao *redisVideoDAO) Create(ctx context.Context, video *Video) error {
-	return dao.baseDAO.Create(ctx, video)
+	if err := dao.baseDAO.Create(ctx, video); err != nil {
+		return err
+	}
+
+	dao.invalidate(ctx, video.ID, false)
+
+	return nil
 }
 
 func (dao *redisVideoDAO) Update(ctx context.Context, video *Video) error {
-	return dao.baseDAO.Update(ctx, video)
+	if err := dao.baseDAO.Update(ctx, video); err != nil {
+		return err
+	}
+
+	dao.invalidate(ctx, video.ID, true)
+
+	return nil
 }
 
 func (dao *redisVideoDAO) UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error {
-	return dao.baseDAO.UpdateVariant(ctx, id, variant, url)
+	if err := dao.baseDAO.UpdateVariant(ctx, id, variant, url); err != nil {
+		return err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return nil
+}
+
+func (dao *redisVideoDAO) SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error {
+	if err := dao.baseDAO.SetFingerprint(ctx, id, fingerprint); err != nil {
+		return err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return nil
+}
+
+func (dao *redisVideoDAO) AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error) {
+	video, err := dao.baseDAO.AddThumbnail(ctx, id, thumbnail, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return video, nil
+}
+
+func (dao *redisVideoDAO) SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error) {
+	video, err := dao.baseDAO.SetPremiereAt(ctx, id, premiereAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return video, nil
+}
+
+func (dao *redisVideoDAO) SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error) {
+	video, err := dao.baseDAO.SetAgeRating(ctx, id, rating)
+	if err != nil {
+		return nil, err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return video, nil
+}
+
+func (dao *redisVideoDAO) SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error) {
+	video, err := dao.baseDAO.SetClaim(ctx, id, policy, claimantID)
+	if err != nil {
+		return nil, err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return video, nil
 }
 
 func (dao *redisVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
-	return dao.baseDAO.Delete(ctx, id)
+	if err := dao.baseDAO.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	dao.invalidate(ctx, id, true)
+
+	return nil
+}
+
+// invalidate deletes the cached video if it exists and invalidates the responses cached by the gateways,
+// the video lists cached by the DAO are not deleted and expire on their own.
+// The failures are only logged since the video has been changed.
+func (dao *redisVideoDAO) invalidate(ctx context.Context, id primitive.ObjectID, exists bool) {
+	tags := []string{VideoListCacheTag}
+	if exists {
+		if err := dao.cache.Delete(ctx, getVideoKey(tenantkit.FromContext(ctx), id)); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+			logkit.FromContext(ctx).Error("failed to delete cached video", zap.String("id", id.Hex()), zap.Error(err))
+		}
+
+		tags = append(tags, VideoCacheTag(id))
+	}
+
+	for _, tag := range tags {
+		if err := httpkit.PublishCacheInvalidation(ctx, dao.client, tag); err != nil {
+			logkit.FromContext(ctx).Error("failed to publish cache invalidation", zap.String("tag", tag), zap.Error(err))
+		}
+	}
 }
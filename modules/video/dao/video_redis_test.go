@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/go-redis/cache/v8"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -46,7 +47,7 @@ var _ = Describe("VideoRedisDAO", func() {
 			})
 
 			AfterEach(func() {
-				deleteVideoInRedis(ctx, redisVideoDAO, getVideoKey(video.ID))
+				deleteVideoInRedis(ctx, redisVideoDAO, getVideoKey(tenantkit.DefaultTenantID, video.ID))
 			})
 
 			When("success", func() {
@@ -66,7 +67,7 @@ var _ = Describe("VideoRedisDAO", func() {
 
 			AfterEach(func() {
 				deleteVideo(ctx, mongoVideoDAO, video.ID)
-				deleteVideoInRedis(ctx, redisVideoDAO, getVideoKey(video.ID))
+				deleteVideoInRedis(ctx, redisVideoDAO, getVideoKey(tenantkit.DefaultTenantID, video.ID))
 			})
 
 			When("video not found", func() {
@@ -90,7 +91,7 @@ var _ = Describe("VideoRedisDAO", func() {
 					var getVideo Video
 
 					Expect(
-						redisVideoDAO.cache.Get(ctx, getVideoKey(id), &getVideo),
+						redisVideoDAO.cache.Get(ctx, getVideoKey(tenantkit.DefaultTenantID, id), &getVideo),
 					).NotTo(HaveOccurred())
 					Expect(resp).To(matchVideo(video))
 				})
@@ -148,7 +149,7 @@ var _ = Describe("VideoRedisDAO", func() {
 				for _, video := range videos {
 					deleteVideo(ctx, mongoVideoDAO, video.ID)
 				}
-				deleteVideoInRedis(ctx, redisVideoDAO, listVideoKey(limit, skip))
+				deleteVideoInRedis(ctx, redisVideoDAO, listVideoKey(tenantkit.DefaultTenantID, limit, skip))
 			})
 
 			When("videos not found", func() {
@@ -170,7 +171,7 @@ var _ = Describe("VideoRedisDAO", func() {
 
 				It("insert the videos to cache", func() {
 					var getVideos []*Video
-					Expect(redisVideoDAO.cache.Get(ctx, listVideoKey(limit, skip), &getVideos)).NotTo(HaveOccurred())
+					Expect(redisVideoDAO.cache.Get(ctx, listVideoKey(tenantkit.DefaultTenantID, limit, skip), &getVideos)).NotTo(HaveOccurred())
 					for i := range getVideos {
 						Expect(getVideos[i]).To(matchVideo(videos[i]))
 					}
@@ -183,7 +184,7 @@ var _ = Describe("VideoRedisDAO", func() {
 func insertVideoInRedis(ctx context.Context, videoDAO *redisVideoDAO, video *Video) {
 	Expect(videoDAO.cache.Set(&cache.Item{
 		Ctx:   ctx,
-		Key:   getVideoKey(video.ID),
+		Key:   getVideoKey(tenantkit.DefaultTenantID, video.ID),
 		Value: video,
 		TTL:   videoDAORedisCacheDuration,
 	})).NotTo(HaveOccurred())
@@ -196,14 +197,14 @@ func deleteVideoInRedis(ctx context.Context, videoDAO *redisVideoDAO, key string
 func insertVideosInRedis(ctx context.Context, videoDAO *redisVideoDAO, videos []*Video, limit int64, skip int64) {
 	Expect(videoDAO.cache.Set(&cache.Item{
 		Ctx:   ctx,
-		Key:   listVideoKey(limit, skip),
+		Key:   listVideoKey(tenantkit.DefaultTenantID, limit, skip),
 		Value: videos,
 		TTL:   videoDAORedisCacheDuration,
 	})).NotTo(HaveOccurred())
 }
 
 func deleteVideosInRedis(ctx context.Context, videoDAO *redisVideoDAO, limit int64, skip int64) {
-	Expect(videoDAO.cache.Delete(ctx, listVideoKey(limit, skip))).NotTo(HaveOccurred())
+	Expect(videoDAO.cache.Delete(ctx, listVideoKey(tenantkit.DefaultTenantID, limit, skip))).NotTo(HaveOccurred())
 }
 
 func matchVideo(video *Video) types.GomegaMatcher {
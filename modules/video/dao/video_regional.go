@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// regionalVideoDAO routes the operations to the DAO of the region the tenant of the context is pinned to,
+// the tenants not pinned are served by the home DAO. Every operation is scoped to a tenant, so an operation
+// never spans the regions.
+type regionalVideoDAO struct {
+	home    VideoDAO
+	regions map[string]VideoDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ VideoDAO = (*regionalVideoDAO)(nil)
+
+var (
+	ErrRegionNotConfigured = errors.New("region not configured")
+)
+
+func NewRegionalVideoDAO(home VideoDAO, regions map[string]VideoDAO, conf *tenantkit.RegionConfig) *regionalVideoDAO {
+	return &regionalVideoDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, id)
+}
+
+func (dao *regionalVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.List(ctx, limit, skip)
+}
+
+func (dao *regionalVideoDAO) Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Each(ctx, batchSize, fn)
+}
+
+func (dao *regionalVideoDAO) Create(ctx context.Context, video *Video) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Create(ctx, video)
+}
+
+func (dao *regionalVideoDAO) Update(ctx context.Context, video *Video) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Update(ctx, video)
+}
+
+func (dao *regionalVideoDAO) UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.UpdateVariant(ctx, id, variant, url)
+}
+
+func (dao *regionalVideoDAO) SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.SetFingerprint(ctx, id, fingerprint)
+}
+
+func (dao *regionalVideoDAO) AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.AddThumbnail(ctx, id, thumbnail, limit)
+}
+
+func (dao *regionalVideoDAO) SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.SetPremiereAt(ctx, id, premiereAt, now)
+}
+
+func (dao *regionalVideoDAO) SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.SetAgeRating(ctx, id, rating)
+}
+
+func (dao *regionalVideoDAO) SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.SetClaim(ctx, id, policy, claimantID)
+}
+
+func (dao *regionalVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Delete(ctx, id)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, a tenant pinned to a region without
+// a DAO is rejected instead of falling back to the home DAO, so its data never leaves the region.
+func (dao *regionalVideoDAO) regionOf(ctx context.Context) (VideoDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
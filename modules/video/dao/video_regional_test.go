@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regionalVideoDAO", func() {
+	var (
+		homeDAO  VideoDAO
+		euDAO    VideoDAO
+		videoDAO VideoDAO
+		video    *Video
+	)
+
+	BeforeEach(func() {
+		homeDAO = NewMemoryVideoDAO()
+		euDAO = NewMemoryVideoDAO()
+		videoDAO = NewRegionalVideoDAO(homeDAO, map[string]VideoDAO{"eu": euDAO}, &tenantkit.RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu": "eu",
+				"course-ap": "ap",
+			},
+		})
+		video = NewFakeVideo()
+	})
+
+	When("the tenant is pinned to a region", func() {
+		It("keeps the videos in the region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-eu")
+
+			Expect(videoDAO.Create(ctx, video)).To(Succeed())
+
+			Expect(euDAO.Get(ctx, video.ID)).To(Equal(video))
+			_, err := homeDAO.Get(ctx, video.ID)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+
+			Expect(videoDAO.List(ctx, 0, 0)).To(Equal([]*Video{video}))
+		})
+	})
+
+	When("the tenant is not pinned", func() {
+		It("keeps the videos in the home region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-tw")
+
+			Expect(videoDAO.Create(ctx, video)).To(Succeed())
+
+			Expect(homeDAO.Get(ctx, video.ID)).To(Equal(video))
+			_, err := euDAO.Get(ctx, video.ID)
+			Expect(err).To(MatchError(ErrVideoNotFound))
+		})
+	})
+
+	When("the region of the tenant is not configured", func() {
+		It("returns ErrRegionNotConfigured", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-ap")
+
+			Expect(videoDAO.Create(ctx, video)).To(MatchError(ErrRegionNotConfigured))
+		})
+	})
+})
@@ -0,0 +1,201 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VideoShard is a named VideoDAO holding a part of the videos,
+// the name identifies the shard across different shard maps.
+type VideoShard struct {
+	Name string
+	DAO  VideoDAO
+}
+
+// shardedVideoDAO routes the operations to one of the shards by hashing the video ID,
+// the video model has no owner yet so the ID is the only stable shard key.
+type shardedVideoDAO struct {
+	shards []*VideoShard
+}
+
+var _ VideoDAO = (*shardedVideoDAO)(nil)
+
+var (
+	ErrNoVideoShard = errors.New("no video shard")
+)
+
+func NewShardedVideoDAO(shards []*VideoShard) *shardedVideoDAO {
+	return &shardedVideoDAO{
+		shards: shards,
+	}
+}
+
+func (dao *shardedVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*Video, error) {
+	return dao.shardOf(id).DAO.Get(ctx, id)
+}
+
+// List scatters the query to all the shards and gathers the videos ordered by ID,
+// each shard has to return the first limit+skip videos to apply the skip correctly.
+func (dao *shardedVideoDAO) List(ctx context.Context, limit, skip int64) ([]*Video, error) {
+	shardLimit := int64(0)
+	if limit > 0 {
+		shardLimit = limit + skip
+	}
+
+	type result struct {
+		videos []*Video
+		err    error
+	}
+
+	results := make(chan *result, len(dao.shards))
+	for _, shard := range dao.shards {
+		go func(shard *VideoShard) {
+			videos, err := shard.DAO.List(ctx, shardLimit, 0)
+			results <- &result{videos: videos, err: err}
+		}(shard)
+	}
+
+	videos := make([]*Video, 0)
+	var err error
+	for range dao.shards {
+		res := <-results
+		if res.err != nil {
+			err = res.err
+			continue
+		}
+
+		videos = append(videos, res.videos...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(videos, func(i, j int) bool {
+		return videos[i].ID.Hex() < videos[j].ID.Hex()
+	})
+
+	if skip >= int64(len(videos)) {
+		return make([]*Video, 0), nil
+	}
+	videos = videos[skip:]
+
+	if limit > 0 && limit < int64(len(videos)) {
+		videos = videos[:limit]
+	}
+
+	return videos, nil
+}
+
+// Each iterates the shards one by one, so the videos are ordered by ID only within a shard.
+func (dao *shardedVideoDAO) Each(ctx context.Context, batchSize int64, fn func(videos []*Video) error) error {
+	if batchSize <= 0 {
+		return ErrInvalidBatchSize
+	}
+
+	for _, shard := range dao.shards {
+		if err := shard.DAO.Each(ctx, batchSize, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create generates the video ID if not set, since the ID decides the shard.
+func (dao *shardedVideoDAO) Create(ctx context.Context, video *Video) error {
+	if video.ID.IsZero() {
+		video.ID = primitive.NewObjectID()
+	}
+
+	return dao.shardOf(video.ID).DAO.Create(ctx, video)
+}
+
+func (dao *shardedVideoDAO) Update(ctx context.Context, video *Video) error {
+	return dao.shardOf(video.ID).DAO.Update(ctx, video)
+}
+
+func (dao *shardedVideoDAO) UpdateVariant(ctx context.Context, id primitive.ObjectID, variant string, url string) error {
+	return dao.shardOf(id).DAO.UpdateVariant(ctx, id, variant, url)
+}
+
+func (dao *shardedVideoDAO) SetFingerprint(ctx context.Context, id primitive.ObjectID, fingerprint string) error {
+	return dao.shardOf(id).DAO.SetFingerprint(ctx, id, fingerprint)
+}
+
+func (dao *shardedVideoDAO) AddThumbnail(ctx context.Context, id primitive.ObjectID, thumbnail *Thumbnail, limit int) (*Video, error) {
+	return dao.shardOf(id).DAO.AddThumbnail(ctx, id, thumbnail, limit)
+}
+
+func (dao *shardedVideoDAO) SetPremiereAt(ctx context.Context, id primitive.ObjectID, premiereAt, now time.Time) (*Video, error) {
+	return dao.shardOf(id).DAO.SetPremiereAt(ctx, id, premiereAt, now)
+}
+
+func (dao *shardedVideoDAO) SetAgeRating(ctx context.Context, id primitive.ObjectID, rating AgeRating) (*Video, error) {
+	return dao.shardOf(id).DAO.SetAgeRating(ctx, id, rating)
+}
+
+func (dao *shardedVideoDAO) SetClaim(ctx context.Context, id primitive.ObjectID, policy ClaimPolicy, claimantID string) (*Video, error) {
+	return dao.shardOf(id).DAO.SetClaim(ctx, id, policy, claimantID)
+}
+
+func (dao *shardedVideoDAO) Delete(ctx context.Context, id primitive.ObjectID) error {
+	return dao.shardOf(id).DAO.Delete(ctx, id)
+}
+
+func (dao *shardedVideoDAO) shardOf(id primitive.ObjectID) *VideoShard {
+	return dao.shards[videoShardIndex(id, len(dao.shards))]
+}
+
+func videoShardIndex(id primitive.ObjectID, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write(id[:])
+
+	return int(h.Sum32() % uint32(n))
+}
+
+// ReshardVideos moves the videos of the old shards to the shards they belong to in the new shard map,
+// videos staying on the shard with the same name are left untouched. It returns the number of moved videos.
+func ReshardVideos(ctx context.Context, from []*VideoShard, to []*VideoShard, batchSize int64) (int, error) {
+	if len(to) == 0 {
+		return 0, ErrNoVideoShard
+	}
+
+	if batchSize <= 0 {
+		return 0, ErrInvalidBatchSize
+	}
+
+	target := NewShardedVideoDAO(to)
+	moved := 0
+
+	for _, shard := range from {
+		if err := shard.DAO.Each(ctx, batchSize, func(videos []*Video) error {
+			for _, video := range videos {
+				targetShard := target.shardOf(video.ID)
+				if targetShard.Name == shard.Name {
+					continue
+				}
+
+				if err := targetShard.DAO.Create(ctx, video); err != nil {
+					return err
+				}
+
+				if err := shard.DAO.Delete(ctx, video.ID); err != nil {
+					return err
+				}
+
+				moved++
+			}
+
+			return nil
+		}); err != nil {
+			return moved, err
+		}
+	}
+
+	return moved, nil
+}
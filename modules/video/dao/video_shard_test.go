@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var _ = Describe("ShardedVideoDAO", func() {
+	var (
+		shards         []*VideoShard
+		shardedDAO     *shardedVideoDAO
+		ctx            context.Context
+		newVideoShards func(names ...string) []*VideoShard
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		newVideoShards = func(names ...string) []*VideoShard {
+			shards := make([]*VideoShard, 0, len(names))
+			for _, name := range names {
+				shards = append(shards, &VideoShard{
+					Name: name,
+					DAO:  NewMongoVideoDAO(mongoClient.Client.Database(name).Collection("videos")),
+				})
+			}
+
+			return shards
+		}
+
+		shards = newVideoShards("video_shard_0", "video_shard_1", "video_shard_2")
+		shardedDAO = NewShardedVideoDAO(shards)
+	})
+
+	AfterEach(func() {
+		for _, name := range []string{"video_shard_0", "video_shard_1", "video_shard_2"} {
+			_, err := mongoClient.Client.Database(name).Collection("videos").DeleteMany(ctx, bson.M{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	Describe("Create and Get", func() {
+		var video *Video
+
+		BeforeEach(func() {
+			video = NewFakeVideo()
+			Expect(shardedDAO.Create(ctx, video)).To(Succeed())
+		})
+
+		It("stores the video in the shard of its ID only", func() {
+			for i, shard := range shards {
+				_, err := shard.DAO.Get(ctx, video.ID)
+				if i == videoShardIndex(video.ID, len(shards)) {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ErrVideoNotFound))
+				}
+			}
+		})
+
+		It("gets the video from its shard", func() {
+			resp, err := shardedDAO.Get(ctx, video.ID)
+
+			Expect(resp).To(matchVideo(video))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("List", func() {
+		var videos []*Video
+
+		BeforeEach(func() {
+			videos = make([]*Video, 0, 10)
+			for i := 0; i < 10; i++ {
+				video := NewFakeVideo()
+				Expect(shardedDAO.Create(ctx, video)).To(Succeed())
+
+				videos = append(videos, video)
+			}
+		})
+
+		It("gathers the videos of all the shards ordered by ID", func() {
+			resp, err := shardedDAO.List(ctx, 0, 0)
+
+			Expect(resp).To(HaveLen(10))
+			for i := range resp {
+				Expect(resp[i]).To(matchVideo(videos[i]))
+			}
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("applies the limit and skip after gathering", func() {
+			resp, err := shardedDAO.List(ctx, 3, 4)
+
+			Expect(resp).To(HaveLen(3))
+			for i := range resp {
+				Expect(resp[i]).To(matchVideo(videos[i+4]))
+			}
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ReshardVideos", func() {
+		var videos []*Video
+
+		BeforeEach(func() {
+			videos = make([]*Video, 0, 10)
+			for i := 0; i < 10; i++ {
+				video := NewFakeVideo()
+				Expect(shardedDAO.Create(ctx, video)).To(Succeed())
+
+				videos = append(videos, video)
+			}
+		})
+
+		It("moves the videos to the new shard map", func() {
+			to := newVideoShards("video_shard_0", "video_shard_1")
+
+			_, err := ReshardVideos(ctx, shards, to, 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			newDAO := NewShardedVideoDAO(to)
+			for _, video := range videos {
+				resp, err := newDAO.Get(ctx, video.ID)
+
+				Expect(resp).To(matchVideo(video))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			count, err := mongoClient.Client.Database("video_shard_2").Collection("videos").CountDocuments(ctx, bson.M{})
+			Expect(count).To(BeZero())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
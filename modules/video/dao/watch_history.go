@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchProgress is the playback position of a video watched by a user.
+type WatchProgress struct {
+	tableName struct{} `pg:"watch_history"` //nolint:unused,structcheck
+
+	TenantID  string
+	UserID    string
+	VideoID   string
+	Position  float64 `pg:",use_zero"` // the seconds of the video played
+	UpdatedAt time.Time
+	// DeviceID is the device recording the progress, it is only published to the other devices of the user
+	DeviceID string `pg:"-"`
+}
+
+func (p *WatchProgress) ToProto() *pb.WatchProgress {
+	return &pb.WatchProgress{
+		VideoId:   p.VideoID,
+		Position:  p.Position,
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+		DeviceId:  p.DeviceID,
+	}
+}
+
+// WatchHistoryDAO keeps the watch progress of the users of the tenant of the context.
+type WatchHistoryDAO interface {
+	// Record records the progress of the video watched by the user, the progress older than the one recorded
+	// is ignored
+	Record(ctx context.Context, progress *WatchProgress) error
+	// List lists the progress of the videos watched by the user, the ones watched last first
+	List(ctx context.Context, userID string, limit int) ([]*WatchProgress, error)
+	// Get gets the progress of the videos watched by the user among the videos, the ones watched last first, and
+	// the videos not watched are left out
+	Get(ctx context.Context, userID string, videoIDs []string) ([]*WatchProgress, error)
+}
+
+// WatchHistoryFlusher persists the progress recorded in a hot store.
+type WatchHistoryFlusher interface {
+	// Flush persists the progress of the users recorded since their last flush, up to the limit of the users,
+	// and returns the number of the users flushed
+	Flush(ctx context.Context, limit int64) (int, error)
+}
+
+// WatchHistoryHotTTL is the time the progress of a user is kept in the hot store since the user last records
+const WatchHistoryHotTTL = 7 * 24 * time.Hour
+
+func watchHistoryKey(tenantID, userID string) string {
+	return fmt.Sprintf("watchHistory:%s:%s", tenantID, userID)
+}
+
+// watchHistoryDirtyKey is the set of the users recorded since their last flush
+const watchHistoryDirtyKey = "watchHistory:dirty"
+
+// latestWatchProgress merges the progress lists into the latest progress of each video, the ones watched last
+// first, up to the limit.
+func latestWatchProgress(limit int, lists ...[]*WatchProgress) []*WatchProgress {
+	latest := make(map[string]*WatchProgress)
+	for _, list := range lists {
+		for _, progress := range list {
+			if p, ok := latest[progress.VideoID]; !ok || p.UpdatedAt.Before(progress.UpdatedAt) {
+				latest[progress.VideoID] = progress
+			}
+		}
+	}
+
+	merged := make([]*WatchProgress, 0, len(latest))
+	for _, progress := range latest {
+		merged = append(merged, progress)
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		if !merged[i].UpdatedAt.Equal(merged[j].UpdatedAt) {
+			return merged[i].UpdatedAt.After(merged[j].UpdatedAt)
+		}
+		return merged[i].VideoID < merged[j].VideoID
+	})
+
+	if limit > 0 && limit < len(merged) {
+		merged = merged[:limit]
+	}
+
+	return merged
+}
@@ -0,0 +1,174 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("WatchHistoryDAO conformance", func() {
+	Describe("pgWatchHistoryDAO", func() {
+		itBehavesLikeWatchHistoryDAO(func() WatchHistoryDAO {
+			return NewPGWatchHistoryDAO(pgClient)
+		})
+	})
+
+	Describe("redisWatchHistoryDAO", func() {
+		itBehavesLikeWatchHistoryDAO(func() WatchHistoryDAO {
+			return NewRedisWatchHistoryDAO(redisClient, NewPGWatchHistoryDAO(pgClient))
+		})
+	})
+
+	Describe("memoryWatchHistoryDAO", func() {
+		itBehavesLikeWatchHistoryDAO(func() WatchHistoryDAO {
+			return NewMemoryWatchHistoryDAO()
+		})
+	})
+})
+
+var _ = Describe("redisWatchHistoryDAO", func() {
+	var (
+		baseDAO WatchHistoryDAO
+		dao     *redisWatchHistoryDAO
+		ctx     context.Context
+		userID  string
+	)
+
+	BeforeEach(func() {
+		baseDAO = NewPGWatchHistoryDAO(pgClient)
+		dao = NewRedisWatchHistoryDAO(redisClient, baseDAO)
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+		userID = "alice"
+	})
+
+	AfterEach(func() {
+		Expect(redisClient.Del(ctx, watchHistoryKey(tenantkit.FromContext(ctx), userID)).Err()).NotTo(HaveOccurred())
+		_, err := pgClient.ExecContext(ctx, "DELETE FROM watch_history WHERE tenant_id = ?", tenantkit.FromContext(ctx))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Flush", func() {
+		It("persists the latest progress of the dirty users to the base DAO", func() {
+			videoID := primitive.NewObjectID().Hex()
+			for _, position := range []float64{10, 20} {
+				Expect(dao.Record(ctx, newFakeWatchProgress(userID, videoID, position, time.Now()))).To(Succeed())
+			}
+
+			history, err := baseDAO.List(ctx, userID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(BeEmpty())
+
+			// the dirty set is shared by the tenants of the other specs, so the flush may flush them too
+			Eventually(func() ([]*WatchProgress, error) {
+				if _, err := dao.Flush(ctx, 100); err != nil {
+					return nil, err
+				}
+
+				return baseDAO.List(ctx, userID, 0)
+			}).Should(ConsistOf(HaveField("Position", 20.0)))
+		})
+	})
+})
+
+func newFakeWatchProgress(userID, videoID string, position float64, updatedAt time.Time) *WatchProgress {
+	return &WatchProgress{
+		UserID:   userID,
+		VideoID:  videoID,
+		Position: position,
+		// Postgres keeps the timestamps to the microsecond
+		UpdatedAt: updatedAt.UTC().Truncate(time.Microsecond),
+	}
+}
+
+// itBehavesLikeWatchHistoryDAO specifies the semantics every WatchHistoryDAO shares. Every spec runs in a tenant of
+// its own.
+func itBehavesLikeWatchHistoryDAO(newWatchHistoryDAO func() WatchHistoryDAO) {
+	var (
+		watchHistoryDAO WatchHistoryDAO
+		ctx             context.Context
+		userID          string
+	)
+
+	BeforeEach(func() {
+		watchHistoryDAO = newWatchHistoryDAO()
+		ctx = tenantkit.WithTenantID(context.Background(), fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+		userID = "alice"
+	})
+
+	AfterEach(func() {
+		Expect(redisClient.Del(ctx, watchHistoryKey(tenantkit.FromContext(ctx), userID)).Err()).NotTo(HaveOccurred())
+		_, err := pgClient.ExecContext(ctx, "DELETE FROM watch_history WHERE tenant_id = ?", tenantkit.FromContext(ctx))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Record", func() {
+		It("keeps the latest progress of each video", func() {
+			videoID := primitive.NewObjectID().Hex()
+			now := time.Now()
+
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, videoID, 20, now))).To(Succeed())
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, videoID, 10, now.Add(-time.Second)))).To(Succeed())
+
+			history, err := watchHistoryDAO.List(ctx, userID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Position).To(Equal(20.0))
+			Expect(history[0].UpdatedAt).To(BeTemporally("==", now.Truncate(time.Microsecond)))
+		})
+
+		It("keeps the progress of the users apart", func() {
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress("bob", primitive.NewObjectID().Hex(), 10, time.Now()))).To(Succeed())
+			defer func() {
+				Expect(redisClient.Del(ctx, watchHistoryKey(tenantkit.FromContext(ctx), "bob")).Err()).NotTo(HaveOccurred())
+			}()
+
+			history, err := watchHistoryDAO.List(ctx, userID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(BeEmpty())
+		})
+	})
+
+	Describe("List", func() {
+		It("lists the videos watched last first up to the limit", func() {
+			now := time.Now()
+			videoIDs := []string{primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()}
+			for i, videoID := range videoIDs {
+				Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, videoID, 10, now.Add(time.Duration(i)*time.Second)))).To(Succeed())
+			}
+
+			history, err := watchHistoryDAO.List(ctx, userID, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].VideoID).To(Equal(videoIDs[2]))
+			Expect(history[1].VideoID).To(Equal(videoIDs[1]))
+		})
+
+		It("lists none of the other tenants", func() {
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, primitive.NewObjectID().Hex(), 10, time.Now()))).To(Succeed())
+
+			otherCtx := tenantkit.WithTenantID(context.Background(), fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+			history, err := watchHistoryDAO.List(otherCtx, userID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(BeEmpty())
+		})
+	})
+
+	Describe("Get", func() {
+		It("gets the progress of the videos watched among the videos", func() {
+			watched, notWatched := primitive.NewObjectID().Hex(), primitive.NewObjectID().Hex()
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, watched, 10, time.Now()))).To(Succeed())
+			Expect(watchHistoryDAO.Record(ctx, newFakeWatchProgress(userID, primitive.NewObjectID().Hex(), 20, time.Now()))).To(Succeed())
+
+			history, err := watchHistoryDAO.Get(ctx, userID, []string{watched, notWatched})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].VideoID).To(Equal(watched))
+			Expect(history[0].Position).To(Equal(10.0))
+		})
+	})
+}
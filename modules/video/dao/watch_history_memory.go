@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryWatchHistoryDAO keeps the progress in memory, it is meant for running the modules without Postgres and
+// Redis in local development.
+type memoryWatchHistoryDAO struct {
+	mu    sync.Mutex
+	users map[string]map[string]*WatchProgress
+}
+
+var _ WatchHistoryDAO = (*memoryWatchHistoryDAO)(nil)
+
+func NewMemoryWatchHistoryDAO() *memoryWatchHistoryDAO {
+	return &memoryWatchHistoryDAO{
+		users: make(map[string]map[string]*WatchProgress),
+	}
+}
+
+func (dao *memoryWatchHistoryDAO) Record(ctx context.Context, progress *WatchProgress) error {
+	progress.TenantID = tenantkit.FromContext(ctx)
+
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	key := watchHistoryKey(progress.TenantID, progress.UserID)
+	if _, ok := dao.users[key]; !ok {
+		dao.users[key] = make(map[string]*WatchProgress)
+	}
+
+	if p, ok := dao.users[key][progress.VideoID]; ok && !p.UpdatedAt.Before(progress.UpdatedAt) {
+		return nil
+	}
+
+	copied := *progress
+	copied.DeviceID = ""
+	dao.users[key][progress.VideoID] = &copied
+
+	return nil
+}
+
+func (dao *memoryWatchHistoryDAO) Get(ctx context.Context, userID string, videoIDs []string) ([]*WatchProgress, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	videos := dao.users[watchHistoryKey(tenantkit.FromContext(ctx), userID)]
+
+	history := make([]*WatchProgress, 0, len(videoIDs))
+	for _, videoID := range videoIDs {
+		if progress, ok := videos[videoID]; ok {
+			copied := *progress
+			history = append(history, &copied)
+		}
+	}
+
+	return latestWatchProgress(0, history), nil
+}
+
+func (dao *memoryWatchHistoryDAO) List(ctx context.Context, userID string, limit int) ([]*WatchProgress, error) {
+	dao.mu.Lock()
+	defer dao.mu.Unlock()
+
+	videos := dao.users[watchHistoryKey(tenantkit.FromContext(ctx), userID)]
+
+	history := make([]*WatchProgress, 0, len(videos))
+	for _, progress := range videos {
+		copied := *progress
+		history = append(history, &copied)
+	}
+
+	return latestWatchProgress(limit, history), nil
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-pg/pg/v10"
+)
+
+// pgWatchHistoryDAO scopes every query to the tenant of the context.
+type pgWatchHistoryDAO struct {
+	client *pgkit.PGClient
+}
+
+var _ WatchHistoryDAO = (*pgWatchHistoryDAO)(nil)
+
+func NewPGWatchHistoryDAO(pgClient *pgkit.PGClient) *pgWatchHistoryDAO {
+	return &pgWatchHistoryDAO{
+		client: pgClient,
+	}
+}
+
+func (dao *pgWatchHistoryDAO) Record(ctx context.Context, progress *WatchProgress) error {
+	progress.TenantID = tenantkit.FromContext(ctx)
+
+	_, err := dao.client.ModelContext(ctx, progress).
+		OnConflict("(tenant_id, user_id, video_id) DO UPDATE").
+		Set("position = EXCLUDED.position").
+		Set("updated_at = EXCLUDED.updated_at").
+		Where("watch_progress.updated_at < EXCLUDED.updated_at").
+		Insert()
+
+	return err
+}
+
+func (dao *pgWatchHistoryDAO) Get(ctx context.Context, userID string, videoIDs []string) ([]*WatchProgress, error) {
+	var history []*WatchProgress
+
+	query := dao.client.ModelContext(ctx, &history).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("user_id = ?", userID).
+		Where("video_id IN (?)", pg.In(videoIDs))
+	if err := pgkit.OrderBy(query, pgkit.Desc("updated_at"), pgkit.Asc("video_id")).Select(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+func (dao *pgWatchHistoryDAO) List(ctx context.Context, userID string, limit int) ([]*WatchProgress, error) {
+	var history []*WatchProgress
+
+	query := dao.client.ModelContext(ctx, &history).
+		Where("tenant_id = ?", tenantkit.FromContext(ctx)).
+		Where("user_id = ?", userID)
+	query = pgkit.OrderBy(query, pgkit.Desc("updated_at"), pgkit.Asc("video_id"))
+	if err := pgkit.Paginate(query, pgkit.Page{Limit: limit}).Select(); err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
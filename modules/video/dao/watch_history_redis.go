@@ -0,0 +1,213 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/redis/v8"
+)
+
+// redisWatchHistoryDAO records the progress of a user in a hash, whose fields are the video IDs and values are the
+// update times in microseconds and the positions joined by colons, e.g. 1791000000000000:12.5. The user is marked
+// dirty until the progress is flushed to the base DAO, the players record every few seconds, so the base DAO only
+// takes the latest progress of each flush.
+type redisWatchHistoryDAO struct {
+	client  *rediskit.RedisClient
+	baseDAO WatchHistoryDAO
+}
+
+var (
+	_ WatchHistoryDAO     = (*redisWatchHistoryDAO)(nil)
+	_ WatchHistoryFlusher = (*redisWatchHistoryDAO)(nil)
+)
+
+func NewRedisWatchHistoryDAO(client *rediskit.RedisClient, baseDAO WatchHistoryDAO) *redisWatchHistoryDAO {
+	return &redisWatchHistoryDAO{
+		client:  client,
+		baseDAO: baseDAO,
+	}
+}
+
+// recordWatchProgressScript records the progress unless the progress recorded is newer, and marks the user dirty.
+var recordWatchProgressScript = redis.NewScript(`
+local current = redis.call("HGET", KEYS[1], ARGV[1])
+if current and tonumber(string.match(current, "^(%d+):")) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2] .. ":" .. ARGV[3])
+redis.call("PEXPIRE", KEYS[1], ARGV[4])
+redis.call("SADD", KEYS[2], ARGV[5])
+return 1
+`)
+
+// dirtyWatchHistory is the member of the dirty set, the tenant and the user are kept as a JSON array since
+// either may contain colons.
+type dirtyWatchHistory [2]string
+
+func (dao *redisWatchHistoryDAO) Record(ctx context.Context, progress *WatchProgress) error {
+	tenantID := tenantkit.FromContext(ctx)
+	progress.TenantID = tenantID
+
+	member, err := json.Marshal(dirtyWatchHistory{tenantID, progress.UserID})
+	if err != nil {
+		return err
+	}
+
+	return recordWatchProgressScript.Run(ctx, dao.client,
+		[]string{watchHistoryKey(tenantID, progress.UserID), watchHistoryDirtyKey},
+		progress.VideoID,
+		progress.UpdatedAt.UnixMicro(),
+		strconv.FormatFloat(progress.Position, 'f', -1, 64),
+		WatchHistoryHotTTL.Milliseconds(),
+		member,
+	).Err()
+}
+
+func (dao *redisWatchHistoryDAO) List(ctx context.Context, userID string, limit int) ([]*WatchProgress, error) {
+	hot, err := dao.hotHistory(ctx, tenantkit.FromContext(ctx), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	persisted, err := dao.baseDAO.List(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return latestWatchProgress(limit, hot, persisted), nil
+}
+
+func (dao *redisWatchHistoryDAO) Get(ctx context.Context, userID string, videoIDs []string) ([]*WatchProgress, error) {
+	tenantID := tenantkit.FromContext(ctx)
+
+	values, err := dao.client.HMGet(ctx, watchHistoryKey(tenantID, userID), videoIDs...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	hot := make([]*WatchProgress, 0, len(values))
+	for i, value := range values {
+		// the videos not in the hash are nil
+		s, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		progress, err := parseHotWatchProgress(s)
+		if err != nil {
+			return nil, err
+		}
+		if progress == nil {
+			continue
+		}
+
+		progress.TenantID = tenantID
+		progress.UserID = userID
+		progress.VideoID = videoIDs[i]
+		hot = append(hot, progress)
+	}
+
+	persisted, err := dao.baseDAO.Get(ctx, userID, videoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return latestWatchProgress(0, hot, persisted), nil
+}
+
+// Flush persists the hot progress of the dirty users to the base DAO. A user failed to flush is marked dirty
+// again for the next flush.
+func (dao *redisWatchHistoryDAO) Flush(ctx context.Context, limit int64) (int, error) {
+	members, err := dao.client.SPopN(ctx, watchHistoryDirtyKey, limit).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	var flushed int
+	for i, member := range members {
+		var dirty dirtyWatchHistory
+		if err := json.Unmarshal([]byte(member), &dirty); err != nil {
+			// the member is malformed, dropping it is the only way to recover
+			continue
+		}
+
+		if err := dao.flushUser(tenantkit.WithTenantID(ctx, dirty[0]), dirty[0], dirty[1]); err != nil {
+			if addErr := dao.client.SAdd(ctx, watchHistoryDirtyKey, toInterfaces(members[i:])...).Err(); addErr != nil {
+				return flushed, addErr
+			}
+
+			return flushed, err
+		}
+
+		flushed++
+	}
+
+	return flushed, nil
+}
+
+func (dao *redisWatchHistoryDAO) flushUser(ctx context.Context, tenantID, userID string) error {
+	history, err := dao.hotHistory(ctx, tenantID, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, progress := range history {
+		if err := dao.baseDAO.Record(ctx, progress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dao *redisWatchHistoryDAO) hotHistory(ctx context.Context, tenantID, userID string) ([]*WatchProgress, error) {
+	fields, err := dao.client.HGetAll(ctx, watchHistoryKey(tenantID, userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*WatchProgress, 0, len(fields))
+	for videoID, value := range fields {
+		progress, err := parseHotWatchProgress(value)
+		if err != nil {
+			return nil, err
+		}
+		if progress == nil {
+			continue
+		}
+
+		progress.TenantID = tenantID
+		progress.UserID = userID
+		progress.VideoID = videoID
+		history = append(history, progress)
+	}
+
+	return history, nil
+}
+
+// parseHotWatchProgress parses the value of the hash of a user, it returns nil if the value is malformed.
+func parseHotWatchProgress(value string) (*WatchProgress, error) {
+	micros, position, ok := cutField(value)
+	if !ok {
+		return nil, nil
+	}
+
+	updatedAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := strconv.ParseFloat(position, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatchProgress{
+		Position:  p,
+		UpdatedAt: time.UnixMicro(updatedAt).UTC(),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// regionalWatchHistoryDAO routes the watch progress to the DAO of the region the tenant of the context is pinned
+// to like regionalVideoDAO.
+type regionalWatchHistoryDAO struct {
+	home    WatchHistoryDAO
+	regions map[string]WatchHistoryDAO
+	conf    *tenantkit.RegionConfig
+}
+
+var _ WatchHistoryDAO = (*regionalWatchHistoryDAO)(nil)
+
+func NewRegionalWatchHistoryDAO(home WatchHistoryDAO, regions map[string]WatchHistoryDAO, conf *tenantkit.RegionConfig) *regionalWatchHistoryDAO {
+	return &regionalWatchHistoryDAO{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+func (dao *regionalWatchHistoryDAO) Record(ctx context.Context, progress *WatchProgress) error {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionDAO.Record(ctx, progress)
+}
+
+func (dao *regionalWatchHistoryDAO) List(ctx context.Context, userID string, limit int) ([]*WatchProgress, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.List(ctx, userID, limit)
+}
+
+func (dao *regionalWatchHistoryDAO) Get(ctx context.Context, userID string, videoIDs []string) ([]*WatchProgress, error) {
+	regionDAO, err := dao.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionDAO.Get(ctx, userID, videoIDs)
+}
+
+// regionOf returns the DAO of the region of the tenant of the context, see regionalVideoDAO.
+func (dao *regionalWatchHistoryDAO) regionOf(ctx context.Context) (WatchHistoryDAO, error) {
+	region, ok := dao.conf.Region(ctx)
+	if !ok {
+		return dao.home, nil
+	}
+
+	regionDAO, ok := dao.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionDAO, nil
+}
@@ -0,0 +1,23 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+)
+
+// WatchProgressPubSub fans out the progress recorded by a device of a user to the other devices of the user on
+// every replica.
+type WatchProgressPubSub interface {
+	// Publish publishes the progress to the subscribers of its user in the tenant of the context
+	Publish(ctx context.Context, progress *WatchProgress) error
+	// Subscribe subscribes to the progress of the user in the tenant of the context,
+	// the channel is closed after the context is done.
+	Subscribe(ctx context.Context, userID string) (<-chan *WatchProgress, error)
+}
+
+// watchProgressSubscriptionBufferSize is the number of the progress buffered for a slow subscriber
+const watchProgressSubscriptionBufferSize = 16
+
+func watchProgressChannel(tenantID, userID string) string {
+	return fmt.Sprintf("watchProgress:%s:%s", tenantID, userID)
+}
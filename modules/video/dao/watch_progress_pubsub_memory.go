@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+)
+
+// memoryWatchProgressPubSub fans out the progress to the subscribers in the same process, it is meant for running
+// the modules without Redis in local development. Like Redis Pub/Sub, the progress published while a subscriber
+// is not connected is not delivered to it, and the one overflowing the buffer of a slow subscriber is dropped.
+type memoryWatchProgressPubSub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan *WatchProgress]struct{}
+}
+
+var _ WatchProgressPubSub = (*memoryWatchProgressPubSub)(nil)
+
+func NewMemoryWatchProgressPubSub() *memoryWatchProgressPubSub {
+	return &memoryWatchProgressPubSub{
+		subscribers: make(map[string]map[chan *WatchProgress]struct{}),
+	}
+}
+
+func (ps *memoryWatchProgressPubSub) Publish(ctx context.Context, progress *WatchProgress) error {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	for ch := range ps.subscribers[watchProgressChannel(tenantkit.FromContext(ctx), progress.UserID)] {
+		copied := *progress
+
+		select {
+		case ch <- &copied:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (ps *memoryWatchProgressPubSub) Subscribe(ctx context.Context, userID string) (<-chan *WatchProgress, error) {
+	channel := watchProgressChannel(tenantkit.FromContext(ctx), userID)
+	updates := make(chan *WatchProgress, watchProgressSubscriptionBufferSize)
+
+	ps.mu.Lock()
+	if ps.subscribers[channel] == nil {
+		ps.subscribers[channel] = make(map[chan *WatchProgress]struct{})
+	}
+	ps.subscribers[channel][updates] = struct{}{}
+	ps.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		ps.mu.Lock()
+		defer ps.mu.Unlock()
+
+		delete(ps.subscribers[channel], updates)
+		if len(ps.subscribers[channel]) == 0 {
+			delete(ps.subscribers, channel)
+		}
+		close(updates)
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// redisWatchProgressPubSub publishes the progress to a Redis Pub/Sub channel per user, the progress published
+// while a device is not connected is not delivered to it, it resumes by the watch history instead.
+type redisWatchProgressPubSub struct {
+	client *rediskit.RedisClient
+}
+
+var _ WatchProgressPubSub = (*redisWatchProgressPubSub)(nil)
+
+func NewRedisWatchProgressPubSub(client *rediskit.RedisClient) *redisWatchProgressPubSub {
+	return &redisWatchProgressPubSub{
+		client: client,
+	}
+}
+
+func (ps *redisWatchProgressPubSub) Publish(ctx context.Context, progress *WatchProgress) error {
+	payload, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+
+	return ps.client.Publish(ctx, watchProgressChannel(tenantkit.FromContext(ctx), progress.UserID), payload).Err()
+}
+
+func (ps *redisWatchProgressPubSub) Subscribe(ctx context.Context, userID string) (<-chan *WatchProgress, error) {
+	sub := ps.client.Subscribe(ctx, watchProgressChannel(tenantkit.FromContext(ctx), userID))
+
+	// wait for the confirmation so that no progress published after Subscribe returns is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+
+	logger := logkit.FromContext(ctx)
+	updates := make(chan *WatchProgress, watchProgressSubscriptionBufferSize)
+
+	go func() {
+		defer close(updates)
+		defer func() {
+			if err := sub.Close(); err != nil {
+				logger.Error("failed to close watch progress subscription", zap.Error(err))
+			}
+		}()
+
+		msgs := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+
+				var progress WatchProgress
+				if err := json.Unmarshal([]byte(msg.Payload), &progress); err != nil {
+					logger.Error("failed to unmarshal published watch progress", zap.Error(err))
+					continue
+				}
+
+				select {
+				case updates <- &progress:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("WatchProgressPubSub", func() {
+	DescribeTable("delivers the progress to the subscribers of its user only",
+		func(newPubSub func() WatchProgressPubSub) {
+			pubsub := newPubSub()
+
+			ctx, cancel := context.WithCancel(logkit.NewNopLogger().WithContext(context.Background()))
+			defer cancel()
+			ctx = tenantkit.WithTenantID(ctx, fmt.Sprintf("tenant-%s", primitive.NewObjectID().Hex()))
+
+			updates, err := pubsub.Subscribe(ctx, "alice")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pubsub.Publish(ctx, newFakeWatchProgress("bob", primitive.NewObjectID().Hex(), 10, time.Now()))).To(Succeed())
+			Consistently(updates, 100*time.Millisecond).ShouldNot(Receive())
+
+			progress := newFakeWatchProgress("alice", primitive.NewObjectID().Hex(), 20, time.Now())
+			progress.DeviceID = "phone"
+			Expect(pubsub.Publish(ctx, progress)).To(Succeed())
+
+			var received *WatchProgress
+			Eventually(updates).Should(Receive(&received))
+			Expect(received.VideoID).To(Equal(progress.VideoID))
+			Expect(received.DeviceID).To(Equal("phone"))
+			Expect(received.UpdatedAt).To(BeTemporally("==", progress.UpdatedAt))
+
+			cancel()
+			Eventually(updates).Should(BeClosed())
+		},
+		Entry("redisWatchProgressPubSub", func() WatchProgressPubSub { return NewRedisWatchProgressPubSub(redisClient) }),
+		Entry("memoryWatchProgressPubSub", func() WatchProgressPubSub { return NewMemoryWatchProgressPubSub() }),
+	)
+})
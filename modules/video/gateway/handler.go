@@ -9,6 +9,7 @@ import (
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -43,7 +44,10 @@ func (h *handler) HandleUploadVideo(w http.ResponseWriter, req *http.Request, pa
 	}
 	defer f.Close()
 
-	stream, err := h.client.UploadVideo(req.Context())
+	// the route is not generated, so the tenant header is not forwarded by the gateway
+	ctx := tenantkit.WithTenantID(req.Context(), req.Header.Get(tenantkit.HTTPHeader))
+
+	stream, err := h.client.UploadVideo(ctx)
 	if err != nil {
 		h.encodeJSONResponse(w, NewResponseError(http.StatusInternalServerError, "failed to create stream client", err))
 	}
@@ -0,0 +1,57 @@
+package gateway
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"go.uber.org/zap"
+)
+
+// MediaHandler serves the video objects from the storage, it is served behind the verification of the signed URLs,
+// so the objects are reachable only by the URLs handed out by the video service until they expire.
+type MediaHandler struct {
+	storage    storagekit.Storage
+	pathPrefix string
+	logger     *logkit.Logger
+}
+
+// NewMediaHandler returns the handler serving the objects named by the paths after the path prefix.
+func NewMediaHandler(storage storagekit.Storage, pathPrefix string, logger *logkit.Logger) *MediaHandler {
+	return &MediaHandler{
+		storage:    storage,
+		pathPrefix: pathPrefix,
+		logger:     logger,
+	}
+}
+
+func (h *MediaHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	objectName := strings.TrimPrefix(req.URL.Path, h.pathPrefix)
+	if objectName == "" || objectName == req.URL.Path {
+		http.NotFound(w, req)
+		return
+	}
+
+	object, err := h.storage.GetObject(req.Context(), objectName)
+	if errors.Is(err, storagekit.ErrObjectNotFound) || errors.Is(err, storagekit.ErrInvalidObjectName) {
+		http.NotFound(w, req)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to get media object", zap.String("object", objectName), zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer object.Close()
+
+	// the signed URLs expire, so the responses are cached by the browsers only
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Cache-Control", "private")
+
+	if _, err := io.Copy(w, object); err != nil {
+		h.logger.Warn("failed to write media object", zap.String("object", objectName), zap.Error(err))
+	}
+}
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS watch_history (
+	tenant_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	video_id TEXT NOT NULL,
+	position DOUBLE PRECISION NOT NULL DEFAULT 0,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (tenant_id, user_id, video_id)
+);
+
+CREATE INDEX IF NOT EXISTS watch_history_tenant_id_user_id_updated_at_idx ON watch_history (tenant_id, user_id, updated_at DESC);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS user_settings (
+	tenant_id TEXT NOT NULL,
+	user_id TEXT NOT NULL,
+	restricted_mode BOOLEAN NOT NULL DEFAULT FALSE,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (tenant_id, user_id)
+);
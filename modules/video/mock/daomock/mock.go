@@ -7,6 +7,7 @@ package daomock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,21 @@ func (m *MockVideoDAO) EXPECT() *MockVideoDAOMockRecorder {
 	return m.recorder
 }
 
+// AddThumbnail mocks base method.
+func (m *MockVideoDAO) AddThumbnail(arg0 context.Context, arg1 primitive.ObjectID, arg2 *dao.Thumbnail, arg3 int) (*dao.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddThumbnail", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dao.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddThumbnail indicates an expected call of AddThumbnail.
+func (mr *MockVideoDAOMockRecorder) AddThumbnail(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddThumbnail", reflect.TypeOf((*MockVideoDAO)(nil).AddThumbnail), arg0, arg1, arg2, arg3)
+}
+
 // Create mocks base method.
 func (m *MockVideoDAO) Create(arg0 context.Context, arg1 *dao.Video) error {
 	m.ctrl.T.Helper()
@@ -64,6 +80,20 @@ func (mr *MockVideoDAOMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVideoDAO)(nil).Delete), arg0, arg1)
 }
 
+// Each mocks base method.
+func (m *MockVideoDAO) Each(arg0 context.Context, arg1 int64, arg2 func([]*dao.Video) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Each", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Each indicates an expected call of Each.
+func (mr *MockVideoDAOMockRecorder) Each(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Each", reflect.TypeOf((*MockVideoDAO)(nil).Each), arg0, arg1, arg2)
+}
+
 // Get mocks base method.
 func (m *MockVideoDAO) Get(arg0 context.Context, arg1 primitive.ObjectID) (*dao.Video, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +124,65 @@ func (mr *MockVideoDAOMockRecorder) List(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVideoDAO)(nil).List), arg0, arg1, arg2)
 }
 
+// SetAgeRating mocks base method.
+func (m *MockVideoDAO) SetAgeRating(arg0 context.Context, arg1 primitive.ObjectID, arg2 dao.AgeRating) (*dao.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgeRating", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*dao.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAgeRating indicates an expected call of SetAgeRating.
+func (mr *MockVideoDAOMockRecorder) SetAgeRating(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgeRating", reflect.TypeOf((*MockVideoDAO)(nil).SetAgeRating), arg0, arg1, arg2)
+}
+
+// SetClaim mocks base method.
+func (m *MockVideoDAO) SetClaim(arg0 context.Context, arg1 primitive.ObjectID, arg2 dao.ClaimPolicy, arg3 string) (*dao.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClaim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dao.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetClaim indicates an expected call of SetClaim.
+func (mr *MockVideoDAOMockRecorder) SetClaim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClaim", reflect.TypeOf((*MockVideoDAO)(nil).SetClaim), arg0, arg1, arg2, arg3)
+}
+
+// SetFingerprint mocks base method.
+func (m *MockVideoDAO) SetFingerprint(arg0 context.Context, arg1 primitive.ObjectID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFingerprint", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFingerprint indicates an expected call of SetFingerprint.
+func (mr *MockVideoDAOMockRecorder) SetFingerprint(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFingerprint", reflect.TypeOf((*MockVideoDAO)(nil).SetFingerprint), arg0, arg1, arg2)
+}
+
+// SetPremiereAt mocks base method.
+func (m *MockVideoDAO) SetPremiereAt(arg0 context.Context, arg1 primitive.ObjectID, arg2, arg3 time.Time) (*dao.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPremiereAt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*dao.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPremiereAt indicates an expected call of SetPremiereAt.
+func (mr *MockVideoDAOMockRecorder) SetPremiereAt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPremiereAt", reflect.TypeOf((*MockVideoDAO)(nil).SetPremiereAt), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
 func (m *MockVideoDAO) Update(arg0 context.Context, arg1 *dao.Video) error {
 	m.ctrl.T.Helper()
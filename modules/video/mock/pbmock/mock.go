@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb (interfaces: Video_UploadVideoServer,VideoClient)
+// Source: github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb (interfaces: Video_UploadVideoServer,Video_StreamWatchProgressServer,VideoClient)
 
 // Package pbmock is a generated GoMock package.
 package pbmock
@@ -148,6 +148,125 @@ func (mr *MockVideo_UploadVideoServerMockRecorder) SetTrailer(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVideo_UploadVideoServer)(nil).SetTrailer), arg0)
 }
 
+// MockVideo_StreamWatchProgressServer is a mock of Video_StreamWatchProgressServer interface.
+type MockVideo_StreamWatchProgressServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideo_StreamWatchProgressServerMockRecorder
+}
+
+// MockVideo_StreamWatchProgressServerMockRecorder is the mock recorder for MockVideo_StreamWatchProgressServer.
+type MockVideo_StreamWatchProgressServerMockRecorder struct {
+	mock *MockVideo_StreamWatchProgressServer
+}
+
+// NewMockVideo_StreamWatchProgressServer creates a new mock instance.
+func NewMockVideo_StreamWatchProgressServer(ctrl *gomock.Controller) *MockVideo_StreamWatchProgressServer {
+	mock := &MockVideo_StreamWatchProgressServer{ctrl: ctrl}
+	mock.recorder = &MockVideo_StreamWatchProgressServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideo_StreamWatchProgressServer) EXPECT() *MockVideo_StreamWatchProgressServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) Send(arg0 *pb.StreamWatchProgressResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockVideo_StreamWatchProgressServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockVideo_StreamWatchProgressServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVideo_StreamWatchProgressServer)(nil).SetTrailer), arg0)
+}
+
 // MockVideoClient is a mock of VideoClient interface.
 type MockVideoClient struct {
 	ctrl     *gomock.Controller
@@ -171,6 +290,46 @@ func (m *MockVideoClient) EXPECT() *MockVideoClientMockRecorder {
 	return m.recorder
 }
 
+// ClosePoll mocks base method.
+func (m *MockVideoClient) ClosePoll(arg0 context.Context, arg1 *pb.ClosePollRequest, arg2 ...grpc.CallOption) (*pb.ClosePollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClosePoll", varargs...)
+	ret0, _ := ret[0].(*pb.ClosePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClosePoll indicates an expected call of ClosePoll.
+func (mr *MockVideoClientMockRecorder) ClosePoll(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePoll", reflect.TypeOf((*MockVideoClient)(nil).ClosePoll), varargs...)
+}
+
+// CreatePoll mocks base method.
+func (m *MockVideoClient) CreatePoll(arg0 context.Context, arg1 *pb.CreatePollRequest, arg2 ...grpc.CallOption) (*pb.CreatePollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreatePoll", varargs...)
+	ret0, _ := ret[0].(*pb.CreatePollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePoll indicates an expected call of CreatePoll.
+func (mr *MockVideoClientMockRecorder) CreatePoll(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePoll", reflect.TypeOf((*MockVideoClient)(nil).CreatePoll), varargs...)
+}
+
 // DeleteVideo mocks base method.
 func (m *MockVideoClient) DeleteVideo(arg0 context.Context, arg1 *pb.DeleteVideoRequest, arg2 ...grpc.CallOption) (*pb.DeleteVideoResponse, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +350,126 @@ func (mr *MockVideoClientMockRecorder) DeleteVideo(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideo", reflect.TypeOf((*MockVideoClient)(nil).DeleteVideo), varargs...)
 }
 
+// DisputeClaim mocks base method.
+func (m *MockVideoClient) DisputeClaim(arg0 context.Context, arg1 *pb.DisputeClaimRequest, arg2 ...grpc.CallOption) (*pb.DisputeClaimResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisputeClaim", varargs...)
+	ret0, _ := ret[0].(*pb.DisputeClaimResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputeClaim indicates an expected call of DisputeClaim.
+func (mr *MockVideoClientMockRecorder) DisputeClaim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeClaim", reflect.TypeOf((*MockVideoClient)(nil).DisputeClaim), varargs...)
+}
+
+// GetPoll mocks base method.
+func (m *MockVideoClient) GetPoll(arg0 context.Context, arg1 *pb.GetPollRequest, arg2 ...grpc.CallOption) (*pb.GetPollResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPoll", varargs...)
+	ret0, _ := ret[0].(*pb.GetPollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoll indicates an expected call of GetPoll.
+func (mr *MockVideoClientMockRecorder) GetPoll(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoll", reflect.TypeOf((*MockVideoClient)(nil).GetPoll), varargs...)
+}
+
+// GetPremiereClock mocks base method.
+func (m *MockVideoClient) GetPremiereClock(arg0 context.Context, arg1 *pb.GetPremiereClockRequest, arg2 ...grpc.CallOption) (*pb.GetPremiereClockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPremiereClock", varargs...)
+	ret0, _ := ret[0].(*pb.GetPremiereClockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPremiereClock indicates an expected call of GetPremiereClock.
+func (mr *MockVideoClientMockRecorder) GetPremiereClock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPremiereClock", reflect.TypeOf((*MockVideoClient)(nil).GetPremiereClock), varargs...)
+}
+
+// GetResumePositions mocks base method.
+func (m *MockVideoClient) GetResumePositions(arg0 context.Context, arg1 *pb.GetResumePositionsRequest, arg2 ...grpc.CallOption) (*pb.GetResumePositionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResumePositions", varargs...)
+	ret0, _ := ret[0].(*pb.GetResumePositionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResumePositions indicates an expected call of GetResumePositions.
+func (mr *MockVideoClientMockRecorder) GetResumePositions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResumePositions", reflect.TypeOf((*MockVideoClient)(nil).GetResumePositions), varargs...)
+}
+
+// GetThumbnailExperimentResults mocks base method.
+func (m *MockVideoClient) GetThumbnailExperimentResults(arg0 context.Context, arg1 *pb.GetThumbnailExperimentResultsRequest, arg2 ...grpc.CallOption) (*pb.GetThumbnailExperimentResultsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetThumbnailExperimentResults", varargs...)
+	ret0, _ := ret[0].(*pb.GetThumbnailExperimentResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThumbnailExperimentResults indicates an expected call of GetThumbnailExperimentResults.
+func (mr *MockVideoClientMockRecorder) GetThumbnailExperimentResults(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThumbnailExperimentResults", reflect.TypeOf((*MockVideoClient)(nil).GetThumbnailExperimentResults), varargs...)
+}
+
+// GetUserSettings mocks base method.
+func (m *MockVideoClient) GetUserSettings(arg0 context.Context, arg1 *pb.GetUserSettingsRequest, arg2 ...grpc.CallOption) (*pb.GetUserSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserSettings", varargs...)
+	ret0, _ := ret[0].(*pb.GetUserSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSettings indicates an expected call of GetUserSettings.
+func (mr *MockVideoClientMockRecorder) GetUserSettings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSettings", reflect.TypeOf((*MockVideoClient)(nil).GetUserSettings), varargs...)
+}
+
 // GetVideo mocks base method.
 func (m *MockVideoClient) GetVideo(arg0 context.Context, arg1 *pb.GetVideoRequest, arg2 ...grpc.CallOption) (*pb.GetVideoResponse, error) {
 	m.ctrl.T.Helper()
@@ -231,6 +510,46 @@ func (mr *MockVideoClientMockRecorder) Healthz(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Healthz", reflect.TypeOf((*MockVideoClient)(nil).Healthz), varargs...)
 }
 
+// ListClaims mocks base method.
+func (m *MockVideoClient) ListClaims(arg0 context.Context, arg1 *pb.ListClaimsRequest, arg2 ...grpc.CallOption) (*pb.ListClaimsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClaims", varargs...)
+	ret0, _ := ret[0].(*pb.ListClaimsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockVideoClientMockRecorder) ListClaims(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockVideoClient)(nil).ListClaims), varargs...)
+}
+
+// ListPolls mocks base method.
+func (m *MockVideoClient) ListPolls(arg0 context.Context, arg1 *pb.ListPollsRequest, arg2 ...grpc.CallOption) (*pb.ListPollsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPolls", varargs...)
+	ret0, _ := ret[0].(*pb.ListPollsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolls indicates an expected call of ListPolls.
+func (mr *MockVideoClientMockRecorder) ListPolls(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolls", reflect.TypeOf((*MockVideoClient)(nil).ListPolls), varargs...)
+}
+
 // ListVideo mocks base method.
 func (m *MockVideoClient) ListVideo(arg0 context.Context, arg1 *pb.ListVideoRequest, arg2 ...grpc.CallOption) (*pb.ListVideoResponse, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +570,186 @@ func (mr *MockVideoClientMockRecorder) ListVideo(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideo", reflect.TypeOf((*MockVideoClient)(nil).ListVideo), varargs...)
 }
 
+// ListWatchHistory mocks base method.
+func (m *MockVideoClient) ListWatchHistory(arg0 context.Context, arg1 *pb.ListWatchHistoryRequest, arg2 ...grpc.CallOption) (*pb.ListWatchHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWatchHistory", varargs...)
+	ret0, _ := ret[0].(*pb.ListWatchHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWatchHistory indicates an expected call of ListWatchHistory.
+func (mr *MockVideoClientMockRecorder) ListWatchHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWatchHistory", reflect.TypeOf((*MockVideoClient)(nil).ListWatchHistory), varargs...)
+}
+
+// RecordThumbnailEvent mocks base method.
+func (m *MockVideoClient) RecordThumbnailEvent(arg0 context.Context, arg1 *pb.RecordThumbnailEventRequest, arg2 ...grpc.CallOption) (*pb.RecordThumbnailEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordThumbnailEvent", varargs...)
+	ret0, _ := ret[0].(*pb.RecordThumbnailEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordThumbnailEvent indicates an expected call of RecordThumbnailEvent.
+func (mr *MockVideoClientMockRecorder) RecordThumbnailEvent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordThumbnailEvent", reflect.TypeOf((*MockVideoClient)(nil).RecordThumbnailEvent), varargs...)
+}
+
+// RecordWatchProgress mocks base method.
+func (m *MockVideoClient) RecordWatchProgress(arg0 context.Context, arg1 *pb.RecordWatchProgressRequest, arg2 ...grpc.CallOption) (*pb.RecordWatchProgressResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordWatchProgress", varargs...)
+	ret0, _ := ret[0].(*pb.RecordWatchProgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWatchProgress indicates an expected call of RecordWatchProgress.
+func (mr *MockVideoClientMockRecorder) RecordWatchProgress(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWatchProgress", reflect.TypeOf((*MockVideoClient)(nil).RecordWatchProgress), varargs...)
+}
+
+// SchedulePremiere mocks base method.
+func (m *MockVideoClient) SchedulePremiere(arg0 context.Context, arg1 *pb.SchedulePremiereRequest, arg2 ...grpc.CallOption) (*pb.SchedulePremiereResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SchedulePremiere", varargs...)
+	ret0, _ := ret[0].(*pb.SchedulePremiereResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePremiere indicates an expected call of SchedulePremiere.
+func (mr *MockVideoClientMockRecorder) SchedulePremiere(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePremiere", reflect.TypeOf((*MockVideoClient)(nil).SchedulePremiere), varargs...)
+}
+
+// SetAgeRating mocks base method.
+func (m *MockVideoClient) SetAgeRating(arg0 context.Context, arg1 *pb.SetAgeRatingRequest, arg2 ...grpc.CallOption) (*pb.SetAgeRatingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetAgeRating", varargs...)
+	ret0, _ := ret[0].(*pb.SetAgeRatingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAgeRating indicates an expected call of SetAgeRating.
+func (mr *MockVideoClientMockRecorder) SetAgeRating(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgeRating", reflect.TypeOf((*MockVideoClient)(nil).SetAgeRating), varargs...)
+}
+
+// StreamWatchProgress mocks base method.
+func (m *MockVideoClient) StreamWatchProgress(arg0 context.Context, arg1 *pb.StreamWatchProgressRequest, arg2 ...grpc.CallOption) (pb.Video_StreamWatchProgressClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWatchProgress", varargs...)
+	ret0, _ := ret[0].(pb.Video_StreamWatchProgressClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWatchProgress indicates an expected call of StreamWatchProgress.
+func (mr *MockVideoClientMockRecorder) StreamWatchProgress(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWatchProgress", reflect.TypeOf((*MockVideoClient)(nil).StreamWatchProgress), varargs...)
+}
+
+// SubmitClaim mocks base method.
+func (m *MockVideoClient) SubmitClaim(arg0 context.Context, arg1 *pb.SubmitClaimRequest, arg2 ...grpc.CallOption) (*pb.SubmitClaimResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitClaim", varargs...)
+	ret0, _ := ret[0].(*pb.SubmitClaimResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitClaim indicates an expected call of SubmitClaim.
+func (mr *MockVideoClientMockRecorder) SubmitClaim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitClaim", reflect.TypeOf((*MockVideoClient)(nil).SubmitClaim), varargs...)
+}
+
+// UpdateUserSettings mocks base method.
+func (m *MockVideoClient) UpdateUserSettings(arg0 context.Context, arg1 *pb.UpdateUserSettingsRequest, arg2 ...grpc.CallOption) (*pb.UpdateUserSettingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserSettings", varargs...)
+	ret0, _ := ret[0].(*pb.UpdateUserSettingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserSettings indicates an expected call of UpdateUserSettings.
+func (mr *MockVideoClientMockRecorder) UpdateUserSettings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserSettings", reflect.TypeOf((*MockVideoClient)(nil).UpdateUserSettings), varargs...)
+}
+
+// UploadThumbnail mocks base method.
+func (m *MockVideoClient) UploadThumbnail(arg0 context.Context, arg1 *pb.UploadThumbnailRequest, arg2 ...grpc.CallOption) (*pb.UploadThumbnailResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadThumbnail", varargs...)
+	ret0, _ := ret[0].(*pb.UploadThumbnailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadThumbnail indicates an expected call of UploadThumbnail.
+func (mr *MockVideoClientMockRecorder) UploadThumbnail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadThumbnail", reflect.TypeOf((*MockVideoClient)(nil).UploadThumbnail), varargs...)
+}
+
 // UploadVideo mocks base method.
 func (m *MockVideoClient) UploadVideo(arg0 context.Context, arg1 ...grpc.CallOption) (pb.Video_UploadVideoClient, error) {
 	m.ctrl.T.Helper()
@@ -270,3 +769,23 @@ func (mr *MockVideoClientMockRecorder) UploadVideo(arg0 interface{}, arg1 ...int
 	varargs := append([]interface{}{arg0}, arg1...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVideo", reflect.TypeOf((*MockVideoClient)(nil).UploadVideo), varargs...)
 }
+
+// Vote mocks base method.
+func (m *MockVideoClient) Vote(arg0 context.Context, arg1 *pb.VoteRequest, arg2 ...grpc.CallOption) (*pb.VoteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Vote", varargs...)
+	ret0, _ := ret[0].(*pb.VoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Vote indicates an expected call of Vote.
+func (mr *MockVideoClientMockRecorder) Vote(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vote", reflect.TypeOf((*MockVideoClient)(nil).Vote), varargs...)
+}
@@ -0,0 +1,23 @@
+package pb
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/Shopify/sarama"
+	"github.com/justin0u0/protoc-gen-grpc-sarama/pkg/saramakit"
+)
+
+// Event schemas of the video module, bump the version whenever the payload
+// message changes. Every released version is kept as a snapshot under
+// testdata/schemas and the current message must stay compatible with it
+// unless the min compatible version is bumped as well.
+var (
+	HandleVideoCreatedSchema = eventkit.MustNewSchema(&HandleVideoCreatedRequest{}, 2, 1)
+)
+
+// NewHandleVideoCreatedConsumerHandler returns the HandleVideoCreated handler
+// which only handles messages readable by HandleVideoCreatedSchema.
+func NewHandleVideoCreatedConsumerHandler(server VideoStreamServer, logger saramakit.Logger) sarama.ConsumerGroupHandler {
+	handlers := NewVideoStreamHandlers(server, logger)
+
+	return eventkit.NewConsumerGroupHandler(HandleVideoCreatedSchema, handlers.HandleVideoCreatedHandler, logger)
+}
@@ -0,0 +1,93 @@
+package pb
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Run `go test ./modules/video/pb -update` to snapshot newly released schema versions.
+var update = flag.Bool("update", false, "write snapshots of the current event schema versions")
+
+var _ = Describe("Event schemas", func() {
+	for _, schema := range []*eventkit.Schema{
+		HandleVideoCreatedSchema,
+	} {
+		schema := schema
+
+		Describe(string(schema.Name()), func() {
+			BeforeEach(func() {
+				if *update {
+					writeSchemaSnapshot(schema)
+				}
+			})
+
+			It("matches the snapshot of the current version", func() {
+				snapshot := readSchemaSnapshot(schema, schema.Version())
+
+				Expect(proto.Equal(
+					protodesc.ToDescriptorProto(snapshot),
+					protodesc.ToDescriptorProto(schema.Descriptor()),
+				)).To(BeTrue(), "the payload message changes without bumping the schema version")
+			})
+
+			It("is compatible with the snapshots since the min compatible version", func() {
+				for version := schema.MinCompatibleVersion(); version <= schema.Version(); version++ {
+					snapshot := readSchemaSnapshot(schema, version)
+
+					Expect(eventkit.CheckCompatibility(snapshot, schema.Descriptor())).To(Succeed(), "version %d", version)
+				}
+			})
+		})
+	}
+})
+
+func schemaSnapshotPath(schema *eventkit.Schema, version uint32) string {
+	return filepath.Join("testdata", "schemas", string(schema.Name()), fmt.Sprintf("v%d.textproto", version))
+}
+
+func readSchemaSnapshot(schema *eventkit.Schema, version uint32) protoreflect.MessageDescriptor {
+	b, err := os.ReadFile(schemaSnapshotPath(schema, version))
+	Expect(err).NotTo(HaveOccurred(), "snapshot of version %d is missing, run the test with -update", version)
+
+	fdp := &descriptorpb.FileDescriptorProto{}
+	Expect(prototext.Unmarshal(b, fdp)).To(Succeed())
+
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	Expect(err).NotTo(HaveOccurred())
+
+	md := fd.Messages().ByName(schema.Name().Name())
+	Expect(md).NotTo(BeNil())
+
+	return md
+}
+
+// writeSchemaSnapshot snapshots the file of the current schema version,
+// existing snapshots are released and never overwritten.
+func writeSchemaSnapshot(schema *eventkit.Schema) {
+	path := schemaSnapshotPath(schema, schema.Version())
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	fdp := protodesc.ToFileDescriptorProto(schema.Descriptor().ParentFile())
+	fdp.SourceCodeInfo = nil
+
+	b, err := prototext.MarshalOptions{Multiline: true}.Marshal(fdp)
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+	Expect(os.WriteFile(path, b, 0o600)).To(Succeed())
+}
@@ -0,0 +1,13 @@
+package pb
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPB(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test PB")
+}
@@ -7,6 +7,7 @@
 package pb
 
 import (
+	_ "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pb/authz"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -25,74 +26,288 @@ var File_modules_video_pb_rpc_proto protoreflect.FileDescriptor
 var file_modules_video_pb_rpc_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f,
 	0x70, 0x62, 0x2f, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xca, 0x03, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x49,
-	0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12, 0x18, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x09,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x03, 0x12, 0x01, 0x2f, 0x12, 0x61, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x62, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x5b, 0x0a, 0x09,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x62, 0x01, 0x2a, 0x12, 0x4e, 0x0a, 0x0b, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70,
-	0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x66, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70,
-	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x0f, 0x2f,
-	0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x62, 0x01,
-	0x2a, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x4e, 0x54, 0x48, 0x55, 0x2d, 0x4c, 0x53, 0x41, 0x4c, 0x41, 0x42, 0x2f, 0x4e, 0x54, 0x48, 0x55,
-	0x2d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x2d, 0x53, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x1a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x8f, 0x14, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x12, 0x53, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x12, 0x18, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70,
+	0x62, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x13, 0xda, 0xbe, 0x18, 0x06, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x03, 0x12, 0x01, 0x2f, 0x12, 0x6f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x12, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0xda, 0xbe, 0x18, 0x0a, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x62, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x69, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x12, 0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0xda,
+	0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0f, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x62,
+	0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f,
+	0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x28,
+	0x01, 0x12, 0x75, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x12, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0xda,
+	0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x2a, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x62, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65,
+	0x61, 0x64, 0x12, 0x54, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x73, 0x12,
+	0x1a, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x15, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x55, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1a, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x6a, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
+	0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65,
+	0x72, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72,
+	0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e,
+	0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x67,
+	0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x12, 0x20, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x76, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f,
+	0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x90, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x2e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65,
+	0x61, 0x64, 0x12, 0x73, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65,
+	0x61, 0x64, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x74, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x0c, 0x53, 0x65, 0x74,
+	0x41, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x70, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x23, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x12, 0x1c, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xda, 0xbe, 0x18, 0x0a, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x12, 0x5e, 0x0a, 0x0c, 0x44, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1d, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xda, 0xbe, 0x18, 0x0b, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x54, 0x48, 0x55, 0x2d, 0x4c, 0x53, 0x41,
+	0x4c, 0x41, 0x42, 0x2f, 0x4e, 0x54, 0x48, 0x55, 0x2d, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x64, 0x2d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_modules_video_pb_rpc_proto_goTypes = []interface{}{
-	(*HealthzRequest)(nil),      // 0: video.pb.HealthzRequest
-	(*GetVideoRequest)(nil),     // 1: video.pb.GetVideoRequest
-	(*ListVideoRequest)(nil),    // 2: video.pb.ListVideoRequest
-	(*UploadVideoRequest)(nil),  // 3: video.pb.UploadVideoRequest
-	(*DeleteVideoRequest)(nil),  // 4: video.pb.DeleteVideoRequest
-	(*HealthzResponse)(nil),     // 5: video.pb.HealthzResponse
-	(*GetVideoResponse)(nil),    // 6: video.pb.GetVideoResponse
-	(*ListVideoResponse)(nil),   // 7: video.pb.ListVideoResponse
-	(*UploadVideoResponse)(nil), // 8: video.pb.UploadVideoResponse
-	(*DeleteVideoResponse)(nil), // 9: video.pb.DeleteVideoResponse
+	(*HealthzRequest)(nil),                        // 0: video.pb.HealthzRequest
+	(*GetVideoRequest)(nil),                       // 1: video.pb.GetVideoRequest
+	(*ListVideoRequest)(nil),                      // 2: video.pb.ListVideoRequest
+	(*UploadVideoRequest)(nil),                    // 3: video.pb.UploadVideoRequest
+	(*DeleteVideoRequest)(nil),                    // 4: video.pb.DeleteVideoRequest
+	(*CreatePollRequest)(nil),                     // 5: video.pb.CreatePollRequest
+	(*GetPollRequest)(nil),                        // 6: video.pb.GetPollRequest
+	(*ListPollsRequest)(nil),                      // 7: video.pb.ListPollsRequest
+	(*VoteRequest)(nil),                           // 8: video.pb.VoteRequest
+	(*ClosePollRequest)(nil),                      // 9: video.pb.ClosePollRequest
+	(*SchedulePremiereRequest)(nil),               // 10: video.pb.SchedulePremiereRequest
+	(*GetPremiereClockRequest)(nil),               // 11: video.pb.GetPremiereClockRequest
+	(*UploadThumbnailRequest)(nil),                // 12: video.pb.UploadThumbnailRequest
+	(*RecordThumbnailEventRequest)(nil),           // 13: video.pb.RecordThumbnailEventRequest
+	(*GetThumbnailExperimentResultsRequest)(nil),  // 14: video.pb.GetThumbnailExperimentResultsRequest
+	(*RecordWatchProgressRequest)(nil),            // 15: video.pb.RecordWatchProgressRequest
+	(*ListWatchHistoryRequest)(nil),               // 16: video.pb.ListWatchHistoryRequest
+	(*GetResumePositionsRequest)(nil),             // 17: video.pb.GetResumePositionsRequest
+	(*StreamWatchProgressRequest)(nil),            // 18: video.pb.StreamWatchProgressRequest
+	(*SetAgeRatingRequest)(nil),                   // 19: video.pb.SetAgeRatingRequest
+	(*GetUserSettingsRequest)(nil),                // 20: video.pb.GetUserSettingsRequest
+	(*UpdateUserSettingsRequest)(nil),             // 21: video.pb.UpdateUserSettingsRequest
+	(*SubmitClaimRequest)(nil),                    // 22: video.pb.SubmitClaimRequest
+	(*ListClaimsRequest)(nil),                     // 23: video.pb.ListClaimsRequest
+	(*DisputeClaimRequest)(nil),                   // 24: video.pb.DisputeClaimRequest
+	(*HealthzResponse)(nil),                       // 25: video.pb.HealthzResponse
+	(*GetVideoResponse)(nil),                      // 26: video.pb.GetVideoResponse
+	(*ListVideoResponse)(nil),                     // 27: video.pb.ListVideoResponse
+	(*UploadVideoResponse)(nil),                   // 28: video.pb.UploadVideoResponse
+	(*DeleteVideoResponse)(nil),                   // 29: video.pb.DeleteVideoResponse
+	(*CreatePollResponse)(nil),                    // 30: video.pb.CreatePollResponse
+	(*GetPollResponse)(nil),                       // 31: video.pb.GetPollResponse
+	(*ListPollsResponse)(nil),                     // 32: video.pb.ListPollsResponse
+	(*VoteResponse)(nil),                          // 33: video.pb.VoteResponse
+	(*ClosePollResponse)(nil),                     // 34: video.pb.ClosePollResponse
+	(*SchedulePremiereResponse)(nil),              // 35: video.pb.SchedulePremiereResponse
+	(*GetPremiereClockResponse)(nil),              // 36: video.pb.GetPremiereClockResponse
+	(*UploadThumbnailResponse)(nil),               // 37: video.pb.UploadThumbnailResponse
+	(*RecordThumbnailEventResponse)(nil),          // 38: video.pb.RecordThumbnailEventResponse
+	(*GetThumbnailExperimentResultsResponse)(nil), // 39: video.pb.GetThumbnailExperimentResultsResponse
+	(*RecordWatchProgressResponse)(nil),           // 40: video.pb.RecordWatchProgressResponse
+	(*ListWatchHistoryResponse)(nil),              // 41: video.pb.ListWatchHistoryResponse
+	(*GetResumePositionsResponse)(nil),            // 42: video.pb.GetResumePositionsResponse
+	(*StreamWatchProgressResponse)(nil),           // 43: video.pb.StreamWatchProgressResponse
+	(*SetAgeRatingResponse)(nil),                  // 44: video.pb.SetAgeRatingResponse
+	(*GetUserSettingsResponse)(nil),               // 45: video.pb.GetUserSettingsResponse
+	(*UpdateUserSettingsResponse)(nil),            // 46: video.pb.UpdateUserSettingsResponse
+	(*SubmitClaimResponse)(nil),                   // 47: video.pb.SubmitClaimResponse
+	(*ListClaimsResponse)(nil),                    // 48: video.pb.ListClaimsResponse
+	(*DisputeClaimResponse)(nil),                  // 49: video.pb.DisputeClaimResponse
 }
 var file_modules_video_pb_rpc_proto_depIdxs = []int32{
-	0, // 0: video.pb.Video.Healthz:input_type -> video.pb.HealthzRequest
-	1, // 1: video.pb.Video.GetVideo:input_type -> video.pb.GetVideoRequest
-	2, // 2: video.pb.Video.ListVideo:input_type -> video.pb.ListVideoRequest
-	3, // 3: video.pb.Video.UploadVideo:input_type -> video.pb.UploadVideoRequest
-	4, // 4: video.pb.Video.DeleteVideo:input_type -> video.pb.DeleteVideoRequest
-	5, // 5: video.pb.Video.Healthz:output_type -> video.pb.HealthzResponse
-	6, // 6: video.pb.Video.GetVideo:output_type -> video.pb.GetVideoResponse
-	7, // 7: video.pb.Video.ListVideo:output_type -> video.pb.ListVideoResponse
-	8, // 8: video.pb.Video.UploadVideo:output_type -> video.pb.UploadVideoResponse
-	9, // 9: video.pb.Video.DeleteVideo:output_type -> video.pb.DeleteVideoResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: video.pb.Video.Healthz:input_type -> video.pb.HealthzRequest
+	1,  // 1: video.pb.Video.GetVideo:input_type -> video.pb.GetVideoRequest
+	2,  // 2: video.pb.Video.ListVideo:input_type -> video.pb.ListVideoRequest
+	3,  // 3: video.pb.Video.UploadVideo:input_type -> video.pb.UploadVideoRequest
+	4,  // 4: video.pb.Video.DeleteVideo:input_type -> video.pb.DeleteVideoRequest
+	5,  // 5: video.pb.Video.CreatePoll:input_type -> video.pb.CreatePollRequest
+	6,  // 6: video.pb.Video.GetPoll:input_type -> video.pb.GetPollRequest
+	7,  // 7: video.pb.Video.ListPolls:input_type -> video.pb.ListPollsRequest
+	8,  // 8: video.pb.Video.Vote:input_type -> video.pb.VoteRequest
+	9,  // 9: video.pb.Video.ClosePoll:input_type -> video.pb.ClosePollRequest
+	10, // 10: video.pb.Video.SchedulePremiere:input_type -> video.pb.SchedulePremiereRequest
+	11, // 11: video.pb.Video.GetPremiereClock:input_type -> video.pb.GetPremiereClockRequest
+	12, // 12: video.pb.Video.UploadThumbnail:input_type -> video.pb.UploadThumbnailRequest
+	13, // 13: video.pb.Video.RecordThumbnailEvent:input_type -> video.pb.RecordThumbnailEventRequest
+	14, // 14: video.pb.Video.GetThumbnailExperimentResults:input_type -> video.pb.GetThumbnailExperimentResultsRequest
+	15, // 15: video.pb.Video.RecordWatchProgress:input_type -> video.pb.RecordWatchProgressRequest
+	16, // 16: video.pb.Video.ListWatchHistory:input_type -> video.pb.ListWatchHistoryRequest
+	17, // 17: video.pb.Video.GetResumePositions:input_type -> video.pb.GetResumePositionsRequest
+	18, // 18: video.pb.Video.StreamWatchProgress:input_type -> video.pb.StreamWatchProgressRequest
+	19, // 19: video.pb.Video.SetAgeRating:input_type -> video.pb.SetAgeRatingRequest
+	20, // 20: video.pb.Video.GetUserSettings:input_type -> video.pb.GetUserSettingsRequest
+	21, // 21: video.pb.Video.UpdateUserSettings:input_type -> video.pb.UpdateUserSettingsRequest
+	22, // 22: video.pb.Video.SubmitClaim:input_type -> video.pb.SubmitClaimRequest
+	23, // 23: video.pb.Video.ListClaims:input_type -> video.pb.ListClaimsRequest
+	24, // 24: video.pb.Video.DisputeClaim:input_type -> video.pb.DisputeClaimRequest
+	25, // 25: video.pb.Video.Healthz:output_type -> video.pb.HealthzResponse
+	26, // 26: video.pb.Video.GetVideo:output_type -> video.pb.GetVideoResponse
+	27, // 27: video.pb.Video.ListVideo:output_type -> video.pb.ListVideoResponse
+	28, // 28: video.pb.Video.UploadVideo:output_type -> video.pb.UploadVideoResponse
+	29, // 29: video.pb.Video.DeleteVideo:output_type -> video.pb.DeleteVideoResponse
+	30, // 30: video.pb.Video.CreatePoll:output_type -> video.pb.CreatePollResponse
+	31, // 31: video.pb.Video.GetPoll:output_type -> video.pb.GetPollResponse
+	32, // 32: video.pb.Video.ListPolls:output_type -> video.pb.ListPollsResponse
+	33, // 33: video.pb.Video.Vote:output_type -> video.pb.VoteResponse
+	34, // 34: video.pb.Video.ClosePoll:output_type -> video.pb.ClosePollResponse
+	35, // 35: video.pb.Video.SchedulePremiere:output_type -> video.pb.SchedulePremiereResponse
+	36, // 36: video.pb.Video.GetPremiereClock:output_type -> video.pb.GetPremiereClockResponse
+	37, // 37: video.pb.Video.UploadThumbnail:output_type -> video.pb.UploadThumbnailResponse
+	38, // 38: video.pb.Video.RecordThumbnailEvent:output_type -> video.pb.RecordThumbnailEventResponse
+	39, // 39: video.pb.Video.GetThumbnailExperimentResults:output_type -> video.pb.GetThumbnailExperimentResultsResponse
+	40, // 40: video.pb.Video.RecordWatchProgress:output_type -> video.pb.RecordWatchProgressResponse
+	41, // 41: video.pb.Video.ListWatchHistory:output_type -> video.pb.ListWatchHistoryResponse
+	42, // 42: video.pb.Video.GetResumePositions:output_type -> video.pb.GetResumePositionsResponse
+	43, // 43: video.pb.Video.StreamWatchProgress:output_type -> video.pb.StreamWatchProgressResponse
+	44, // 44: video.pb.Video.SetAgeRating:output_type -> video.pb.SetAgeRatingResponse
+	45, // 45: video.pb.Video.GetUserSettings:output_type -> video.pb.GetUserSettingsResponse
+	46, // 46: video.pb.Video.UpdateUserSettings:output_type -> video.pb.UpdateUserSettingsResponse
+	47, // 47: video.pb.Video.SubmitClaim:output_type -> video.pb.SubmitClaimResponse
+	48, // 48: video.pb.Video.ListClaims:output_type -> video.pb.ListClaimsResponse
+	49, // 49: video.pb.Video.DisputeClaim:output_type -> video.pb.DisputeClaimResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_modules_video_pb_rpc_proto_init() }
@@ -27,6 +27,63 @@ type VideoClient interface {
 	ListVideo(ctx context.Context, in *ListVideoRequest, opts ...grpc.CallOption) (*ListVideoResponse, error)
 	UploadVideo(ctx context.Context, opts ...grpc.CallOption) (Video_UploadVideoClient, error)
 	DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoResponse, error)
+	// CreatePoll attaches a poll to a video, which is closed at its closing
+	// time by the API servers.
+	CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error)
+	// GetPoll gets a poll along with its votes so far.
+	GetPoll(ctx context.Context, in *GetPollRequest, opts ...grpc.CallOption) (*GetPollResponse, error)
+	// ListPolls lists the polls of a video in the order of creation.
+	ListPolls(ctx context.Context, in *ListPollsRequest, opts ...grpc.CallOption) (*ListPollsResponse, error)
+	// Vote votes for an option of an open poll, a caller votes once on a
+	// poll, the caller being the verified service and its user, or the peer
+	// address without mTLS.
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// ClosePoll closes a poll before its closing time.
+	ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*ClosePollResponse, error)
+	// SchedulePremiere schedules or cancels the premiere of a video, which
+	// is hidden until it premieres.
+	SchedulePremiere(ctx context.Context, in *SchedulePremiereRequest, opts ...grpc.CallOption) (*SchedulePremiereResponse, error)
+	// GetPremiereClock gets the playback clock of a premiere, the live chat
+	// of a premiere is the StreamComments of the comment API.
+	GetPremiereClock(ctx context.Context, in *GetPremiereClockRequest, opts ...grpc.CallOption) (*GetPremiereClockResponse, error)
+	// UploadThumbnail adds a thumbnail candidate to a video, GetVideo and
+	// ListVideo choose a candidate by the weights of the candidates.
+	UploadThumbnail(ctx context.Context, in *UploadThumbnailRequest, opts ...grpc.CallOption) (*UploadThumbnailResponse, error)
+	// RecordThumbnailEvent records an impression or a click of a thumbnail
+	// candidate.
+	RecordThumbnailEvent(ctx context.Context, in *RecordThumbnailEventRequest, opts ...grpc.CallOption) (*RecordThumbnailEventResponse, error)
+	// GetThumbnailExperimentResults gets the CTR of each thumbnail candidate
+	// of a video.
+	GetThumbnailExperimentResults(ctx context.Context, in *GetThumbnailExperimentResultsRequest, opts ...grpc.CallOption) (*GetThumbnailExperimentResultsResponse, error)
+	// RecordWatchProgress records the playback position of a video watched
+	// by the user, the players record it every few seconds.
+	RecordWatchProgress(ctx context.Context, in *RecordWatchProgressRequest, opts ...grpc.CallOption) (*RecordWatchProgressResponse, error)
+	// ListWatchHistory lists the videos watched by the user with their
+	// playback positions to resume, the ones watched last first.
+	ListWatchHistory(ctx context.Context, in *ListWatchHistoryRequest, opts ...grpc.CallOption) (*ListWatchHistoryResponse, error)
+	// GetResumePositions gets the playback positions of the videos to resume
+	// for the user, e.g. for the videos on a page.
+	GetResumePositions(ctx context.Context, in *GetResumePositionsRequest, opts ...grpc.CallOption) (*GetResumePositionsResponse, error)
+	// StreamWatchProgress streams the progress recorded by the other devices
+	// of the user, so a device follows the pauses on the others.
+	StreamWatchProgress(ctx context.Context, in *StreamWatchProgressRequest, opts ...grpc.CallOption) (Video_StreamWatchProgressClient, error)
+	// SetAgeRating sets the age rating of a video, the mature videos are
+	// hidden from the users in restricted mode.
+	SetAgeRating(ctx context.Context, in *SetAgeRatingRequest, opts ...grpc.CallOption) (*SetAgeRatingResponse, error)
+	// GetUserSettings gets the settings of the user, e.g. restricted mode.
+	GetUserSettings(ctx context.Context, in *GetUserSettingsRequest, opts ...grpc.CallOption) (*GetUserSettingsResponse, error)
+	// UpdateUserSettings updates the settings of the user.
+	UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...grpc.CallOption) (*UpdateUserSettingsResponse, error)
+	// SubmitClaim claims a video as a copy of a reference video of the user,
+	// the fingerprints of both must match, and the policy of the claim is
+	// applied to the video at once.
+	SubmitClaim(ctx context.Context, in *SubmitClaimRequest, opts ...grpc.CallOption) (*SubmitClaimResponse, error)
+	// ListClaims lists the claims of a video or the claims submitted by the
+	// user.
+	ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error)
+	// DisputeClaim disputes an active claim, the policy of the claim is lifted
+	// from the video while the dispute is reviewed.
+	DisputeClaim(ctx context.Context, in *DisputeClaimRequest, opts ...grpc.CallOption) (*DisputeClaimResponse, error)
 }
 
 type videoClient struct {
@@ -107,6 +164,209 @@ func (c *videoClient) DeleteVideo(ctx context.Context, in *DeleteVideoRequest, o
 	return out, nil
 }
 
+func (c *videoClient) CreatePoll(ctx context.Context, in *CreatePollRequest, opts ...grpc.CallOption) (*CreatePollResponse, error) {
+	out := new(CreatePollResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/CreatePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) GetPoll(ctx context.Context, in *GetPollRequest, opts ...grpc.CallOption) (*GetPollResponse, error) {
+	out := new(GetPollResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/GetPoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) ListPolls(ctx context.Context, in *ListPollsRequest, opts ...grpc.CallOption) (*ListPollsResponse, error) {
+	out := new(ListPollsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/ListPolls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) ClosePoll(ctx context.Context, in *ClosePollRequest, opts ...grpc.CallOption) (*ClosePollResponse, error) {
+	out := new(ClosePollResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/ClosePoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) SchedulePremiere(ctx context.Context, in *SchedulePremiereRequest, opts ...grpc.CallOption) (*SchedulePremiereResponse, error) {
+	out := new(SchedulePremiereResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/SchedulePremiere", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) GetPremiereClock(ctx context.Context, in *GetPremiereClockRequest, opts ...grpc.CallOption) (*GetPremiereClockResponse, error) {
+	out := new(GetPremiereClockResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/GetPremiereClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) UploadThumbnail(ctx context.Context, in *UploadThumbnailRequest, opts ...grpc.CallOption) (*UploadThumbnailResponse, error) {
+	out := new(UploadThumbnailResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/UploadThumbnail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) RecordThumbnailEvent(ctx context.Context, in *RecordThumbnailEventRequest, opts ...grpc.CallOption) (*RecordThumbnailEventResponse, error) {
+	out := new(RecordThumbnailEventResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/RecordThumbnailEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) GetThumbnailExperimentResults(ctx context.Context, in *GetThumbnailExperimentResultsRequest, opts ...grpc.CallOption) (*GetThumbnailExperimentResultsResponse, error) {
+	out := new(GetThumbnailExperimentResultsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/GetThumbnailExperimentResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) RecordWatchProgress(ctx context.Context, in *RecordWatchProgressRequest, opts ...grpc.CallOption) (*RecordWatchProgressResponse, error) {
+	out := new(RecordWatchProgressResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/RecordWatchProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) ListWatchHistory(ctx context.Context, in *ListWatchHistoryRequest, opts ...grpc.CallOption) (*ListWatchHistoryResponse, error) {
+	out := new(ListWatchHistoryResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/ListWatchHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) GetResumePositions(ctx context.Context, in *GetResumePositionsRequest, opts ...grpc.CallOption) (*GetResumePositionsResponse, error) {
+	out := new(GetResumePositionsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/GetResumePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) StreamWatchProgress(ctx context.Context, in *StreamWatchProgressRequest, opts ...grpc.CallOption) (Video_StreamWatchProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Video_ServiceDesc.Streams[1], "/video.pb.Video/StreamWatchProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &videoStreamWatchProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Video_StreamWatchProgressClient interface {
+	Recv() (*StreamWatchProgressResponse, error)
+	grpc.ClientStream
+}
+
+type videoStreamWatchProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *videoStreamWatchProgressClient) Recv() (*StreamWatchProgressResponse, error) {
+	m := new(StreamWatchProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *videoClient) SetAgeRating(ctx context.Context, in *SetAgeRatingRequest, opts ...grpc.CallOption) (*SetAgeRatingResponse, error) {
+	out := new(SetAgeRatingResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/SetAgeRating", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) GetUserSettings(ctx context.Context, in *GetUserSettingsRequest, opts ...grpc.CallOption) (*GetUserSettingsResponse, error) {
+	out := new(GetUserSettingsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/GetUserSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) UpdateUserSettings(ctx context.Context, in *UpdateUserSettingsRequest, opts ...grpc.CallOption) (*UpdateUserSettingsResponse, error) {
+	out := new(UpdateUserSettingsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/UpdateUserSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) SubmitClaim(ctx context.Context, in *SubmitClaimRequest, opts ...grpc.CallOption) (*SubmitClaimResponse, error) {
+	out := new(SubmitClaimResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/SubmitClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) ListClaims(ctx context.Context, in *ListClaimsRequest, opts ...grpc.CallOption) (*ListClaimsResponse, error) {
+	out := new(ListClaimsResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/ListClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoClient) DisputeClaim(ctx context.Context, in *DisputeClaimRequest, opts ...grpc.CallOption) (*DisputeClaimResponse, error) {
+	out := new(DisputeClaimResponse)
+	err := c.cc.Invoke(ctx, "/video.pb.Video/DisputeClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServer is the server API for Video service.
 // All implementations must embed UnimplementedVideoServer
 // for forward compatibility
@@ -116,6 +376,63 @@ type VideoServer interface {
 	ListVideo(context.Context, *ListVideoRequest) (*ListVideoResponse, error)
 	UploadVideo(Video_UploadVideoServer) error
 	DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error)
+	// CreatePoll attaches a poll to a video, which is closed at its closing
+	// time by the API servers.
+	CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error)
+	// GetPoll gets a poll along with its votes so far.
+	GetPoll(context.Context, *GetPollRequest) (*GetPollResponse, error)
+	// ListPolls lists the polls of a video in the order of creation.
+	ListPolls(context.Context, *ListPollsRequest) (*ListPollsResponse, error)
+	// Vote votes for an option of an open poll, a caller votes once on a
+	// poll, the caller being the verified service and its user, or the peer
+	// address without mTLS.
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	// ClosePoll closes a poll before its closing time.
+	ClosePoll(context.Context, *ClosePollRequest) (*ClosePollResponse, error)
+	// SchedulePremiere schedules or cancels the premiere of a video, which
+	// is hidden until it premieres.
+	SchedulePremiere(context.Context, *SchedulePremiereRequest) (*SchedulePremiereResponse, error)
+	// GetPremiereClock gets the playback clock of a premiere, the live chat
+	// of a premiere is the StreamComments of the comment API.
+	GetPremiereClock(context.Context, *GetPremiereClockRequest) (*GetPremiereClockResponse, error)
+	// UploadThumbnail adds a thumbnail candidate to a video, GetVideo and
+	// ListVideo choose a candidate by the weights of the candidates.
+	UploadThumbnail(context.Context, *UploadThumbnailRequest) (*UploadThumbnailResponse, error)
+	// RecordThumbnailEvent records an impression or a click of a thumbnail
+	// candidate.
+	RecordThumbnailEvent(context.Context, *RecordThumbnailEventRequest) (*RecordThumbnailEventResponse, error)
+	// GetThumbnailExperimentResults gets the CTR of each thumbnail candidate
+	// of a video.
+	GetThumbnailExperimentResults(context.Context, *GetThumbnailExperimentResultsRequest) (*GetThumbnailExperimentResultsResponse, error)
+	// RecordWatchProgress records the playback position of a video watched
+	// by the user, the players record it every few seconds.
+	RecordWatchProgress(context.Context, *RecordWatchProgressRequest) (*RecordWatchProgressResponse, error)
+	// ListWatchHistory lists the videos watched by the user with their
+	// playback positions to resume, the ones watched last first.
+	ListWatchHistory(context.Context, *ListWatchHistoryRequest) (*ListWatchHistoryResponse, error)
+	// GetResumePositions gets the playback positions of the videos to resume
+	// for the user, e.g. for the videos on a page.
+	GetResumePositions(context.Context, *GetResumePositionsRequest) (*GetResumePositionsResponse, error)
+	// StreamWatchProgress streams the progress recorded by the other devices
+	// of the user, so a device follows the pauses on the others.
+	StreamWatchProgress(*StreamWatchProgressRequest, Video_StreamWatchProgressServer) error
+	// SetAgeRating sets the age rating of a video, the mature videos are
+	// hidden from the users in restricted mode.
+	SetAgeRating(context.Context, *SetAgeRatingRequest) (*SetAgeRatingResponse, error)
+	// GetUserSettings gets the settings of the user, e.g. restricted mode.
+	GetUserSettings(context.Context, *GetUserSettingsRequest) (*GetUserSettingsResponse, error)
+	// UpdateUserSettings updates the settings of the user.
+	UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsResponse, error)
+	// SubmitClaim claims a video as a copy of a reference video of the user,
+	// the fingerprints of both must match, and the policy of the claim is
+	// applied to the video at once.
+	SubmitClaim(context.Context, *SubmitClaimRequest) (*SubmitClaimResponse, error)
+	// ListClaims lists the claims of a video or the claims submitted by the
+	// user.
+	ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error)
+	// DisputeClaim disputes an active claim, the policy of the claim is lifted
+	// from the video while the dispute is reviewed.
+	DisputeClaim(context.Context, *DisputeClaimRequest) (*DisputeClaimResponse, error)
 	mustEmbedUnimplementedVideoServer()
 }
 
@@ -138,6 +455,66 @@ func (UnimplementedVideoServer) UploadVideo(Video_UploadVideoServer) error {
 func (UnimplementedVideoServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideo not implemented")
 }
+func (UnimplementedVideoServer) CreatePoll(context.Context, *CreatePollRequest) (*CreatePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePoll not implemented")
+}
+func (UnimplementedVideoServer) GetPoll(context.Context, *GetPollRequest) (*GetPollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoll not implemented")
+}
+func (UnimplementedVideoServer) ListPolls(context.Context, *ListPollsRequest) (*ListPollsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolls not implemented")
+}
+func (UnimplementedVideoServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (UnimplementedVideoServer) ClosePoll(context.Context, *ClosePollRequest) (*ClosePollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePoll not implemented")
+}
+func (UnimplementedVideoServer) SchedulePremiere(context.Context, *SchedulePremiereRequest) (*SchedulePremiereResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePremiere not implemented")
+}
+func (UnimplementedVideoServer) GetPremiereClock(context.Context, *GetPremiereClockRequest) (*GetPremiereClockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPremiereClock not implemented")
+}
+func (UnimplementedVideoServer) UploadThumbnail(context.Context, *UploadThumbnailRequest) (*UploadThumbnailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadThumbnail not implemented")
+}
+func (UnimplementedVideoServer) RecordThumbnailEvent(context.Context, *RecordThumbnailEventRequest) (*RecordThumbnailEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordThumbnailEvent not implemented")
+}
+func (UnimplementedVideoServer) GetThumbnailExperimentResults(context.Context, *GetThumbnailExperimentResultsRequest) (*GetThumbnailExperimentResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThumbnailExperimentResults not implemented")
+}
+func (UnimplementedVideoServer) RecordWatchProgress(context.Context, *RecordWatchProgressRequest) (*RecordWatchProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWatchProgress not implemented")
+}
+func (UnimplementedVideoServer) ListWatchHistory(context.Context, *ListWatchHistoryRequest) (*ListWatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchHistory not implemented")
+}
+func (UnimplementedVideoServer) GetResumePositions(context.Context, *GetResumePositionsRequest) (*GetResumePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResumePositions not implemented")
+}
+func (UnimplementedVideoServer) StreamWatchProgress(*StreamWatchProgressRequest, Video_StreamWatchProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWatchProgress not implemented")
+}
+func (UnimplementedVideoServer) SetAgeRating(context.Context, *SetAgeRatingRequest) (*SetAgeRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAgeRating not implemented")
+}
+func (UnimplementedVideoServer) GetUserSettings(context.Context, *GetUserSettingsRequest) (*GetUserSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSettings not implemented")
+}
+func (UnimplementedVideoServer) UpdateUserSettings(context.Context, *UpdateUserSettingsRequest) (*UpdateUserSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSettings not implemented")
+}
+func (UnimplementedVideoServer) SubmitClaim(context.Context, *SubmitClaimRequest) (*SubmitClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitClaim not implemented")
+}
+func (UnimplementedVideoServer) ListClaims(context.Context, *ListClaimsRequest) (*ListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaims not implemented")
+}
+func (UnimplementedVideoServer) DisputeClaim(context.Context, *DisputeClaimRequest) (*DisputeClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeClaim not implemented")
+}
 func (UnimplementedVideoServer) mustEmbedUnimplementedVideoServer() {}
 
 // UnsafeVideoServer may be embedded to opt out of forward compatibility for this service.
@@ -249,6 +626,369 @@ func _Video_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Video_CreatePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).CreatePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/CreatePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).CreatePoll(ctx, req.(*CreatePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_GetPoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).GetPoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/GetPoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).GetPoll(ctx, req.(*GetPollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_ListPolls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPollsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).ListPolls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/ListPolls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).ListPolls(ctx, req.(*ListPollsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_ClosePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).ClosePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/ClosePoll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).ClosePoll(ctx, req.(*ClosePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_SchedulePremiere_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePremiereRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).SchedulePremiere(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/SchedulePremiere",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).SchedulePremiere(ctx, req.(*SchedulePremiereRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_GetPremiereClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPremiereClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).GetPremiereClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/GetPremiereClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).GetPremiereClock(ctx, req.(*GetPremiereClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_UploadThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadThumbnailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).UploadThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/UploadThumbnail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).UploadThumbnail(ctx, req.(*UploadThumbnailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_RecordThumbnailEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordThumbnailEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).RecordThumbnailEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/RecordThumbnailEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).RecordThumbnailEvent(ctx, req.(*RecordThumbnailEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_GetThumbnailExperimentResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThumbnailExperimentResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).GetThumbnailExperimentResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/GetThumbnailExperimentResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).GetThumbnailExperimentResults(ctx, req.(*GetThumbnailExperimentResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_RecordWatchProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWatchProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).RecordWatchProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/RecordWatchProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).RecordWatchProgress(ctx, req.(*RecordWatchProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_ListWatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).ListWatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/ListWatchHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).ListWatchHistory(ctx, req.(*ListWatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_GetResumePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).GetResumePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/GetResumePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).GetResumePositions(ctx, req.(*GetResumePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_StreamWatchProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWatchProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VideoServer).StreamWatchProgress(m, &videoStreamWatchProgressServer{stream})
+}
+
+type Video_StreamWatchProgressServer interface {
+	Send(*StreamWatchProgressResponse) error
+	grpc.ServerStream
+}
+
+type videoStreamWatchProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *videoStreamWatchProgressServer) Send(m *StreamWatchProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Video_SetAgeRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAgeRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).SetAgeRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/SetAgeRating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).SetAgeRating(ctx, req.(*SetAgeRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_GetUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).GetUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/GetUserSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).GetUserSettings(ctx, req.(*GetUserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_UpdateUserSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).UpdateUserSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/UpdateUserSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).UpdateUserSettings(ctx, req.(*UpdateUserSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_SubmitClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).SubmitClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/SubmitClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).SubmitClaim(ctx, req.(*SubmitClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_ListClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).ListClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/ListClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).ListClaims(ctx, req.(*ListClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Video_DisputeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServer).DisputeClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/video.pb.Video/DisputeClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServer).DisputeClaim(ctx, req.(*DisputeClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Video_ServiceDesc is the grpc.ServiceDesc for Video service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,6 +1012,82 @@ var Video_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVideo",
 			Handler:    _Video_DeleteVideo_Handler,
 		},
+		{
+			MethodName: "CreatePoll",
+			Handler:    _Video_CreatePoll_Handler,
+		},
+		{
+			MethodName: "GetPoll",
+			Handler:    _Video_GetPoll_Handler,
+		},
+		{
+			MethodName: "ListPolls",
+			Handler:    _Video_ListPolls_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Video_Vote_Handler,
+		},
+		{
+			MethodName: "ClosePoll",
+			Handler:    _Video_ClosePoll_Handler,
+		},
+		{
+			MethodName: "SchedulePremiere",
+			Handler:    _Video_SchedulePremiere_Handler,
+		},
+		{
+			MethodName: "GetPremiereClock",
+			Handler:    _Video_GetPremiereClock_Handler,
+		},
+		{
+			MethodName: "UploadThumbnail",
+			Handler:    _Video_UploadThumbnail_Handler,
+		},
+		{
+			MethodName: "RecordThumbnailEvent",
+			Handler:    _Video_RecordThumbnailEvent_Handler,
+		},
+		{
+			MethodName: "GetThumbnailExperimentResults",
+			Handler:    _Video_GetThumbnailExperimentResults_Handler,
+		},
+		{
+			MethodName: "RecordWatchProgress",
+			Handler:    _Video_RecordWatchProgress_Handler,
+		},
+		{
+			MethodName: "ListWatchHistory",
+			Handler:    _Video_ListWatchHistory_Handler,
+		},
+		{
+			MethodName: "GetResumePositions",
+			Handler:    _Video_GetResumePositions_Handler,
+		},
+		{
+			MethodName: "SetAgeRating",
+			Handler:    _Video_SetAgeRating_Handler,
+		},
+		{
+			MethodName: "GetUserSettings",
+			Handler:    _Video_GetUserSettings_Handler,
+		},
+		{
+			MethodName: "UpdateUserSettings",
+			Handler:    _Video_UpdateUserSettings_Handler,
+		},
+		{
+			MethodName: "SubmitClaim",
+			Handler:    _Video_SubmitClaim_Handler,
+		},
+		{
+			MethodName: "ListClaims",
+			Handler:    _Video_ListClaims_Handler,
+		},
+		{
+			MethodName: "DisputeClaim",
+			Handler:    _Video_DisputeClaim_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -279,6 +1095,11 @@ var Video_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Video_UploadVideo_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamWatchProgress",
+			Handler:       _Video_StreamWatchProgress_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "modules/video/pb/rpc.proto",
 }
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WithClaims keeps the copyright claims by the DAO, and applies the policies of the active claims to the videos.
+// It is a no-op if the DAO is nil.
+func WithClaims(claimDAO dao.ClaimDAO) ServiceOption {
+	return func(s *service) {
+		if claimDAO != nil {
+			s.claimDAO = claimDAO
+		}
+	}
+}
+
+// SubmitClaim claims the video as a copy of the reference video of the user, where the videos must have the same
+// fingerprint, and applies the policy of the claim to the video.
+func (s *service) SubmitClaim(ctx context.Context, req *pb.SubmitClaimRequest) (*pb.SubmitClaimResponse, error) {
+	if s.claimDAO == nil {
+		return nil, ErrClaimsDisabled
+	}
+
+	claimantID := logkit.UserIDFromContext(ctx)
+	if claimantID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	referenceVideoID, err := primitive.ObjectIDFromHex(req.GetReferenceVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	if videoID == referenceVideoID {
+		return nil, ErrInvalidReferenceVideo
+	}
+
+	video, err := s.videoDAO.Get(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := s.videoDAO.Get(ctx, referenceVideoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if video.Fingerprint == "" || reference.Fingerprint == "" {
+		return nil, ErrFingerprintPending
+	}
+	if video.Fingerprint != reference.Fingerprint {
+		return nil, ErrFingerprintMismatch
+	}
+
+	now := time.Now()
+	claim := &dao.Claim{
+		VideoID:          videoID,
+		ReferenceVideoID: referenceVideoID,
+		ClaimantID:       claimantID,
+		Policy:           dao.ClaimPolicy(req.GetPolicy()),
+		Reason:           req.GetReason(),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := s.claimDAO.Create(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	// the claim is active once created, so the policy is applied again on retry if it fails here
+	if _, err := s.videoDAO.SetClaim(ctx, videoID, claim.Policy, claim.ClaimantID); err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitClaimResponse{Claim: claim.ToProto()}, nil
+}
+
+// ListClaims lists the claims of the video, or the claims submitted by the user if the video is unset.
+func (s *service) ListClaims(ctx context.Context, req *pb.ListClaimsRequest) (*pb.ListClaimsResponse, error) {
+	if s.claimDAO == nil {
+		return nil, ErrClaimsDisabled
+	}
+
+	if s.pageTokens == nil {
+		return nil, ErrPageTokensDisabled
+	}
+
+	// list lists a page of the claims after the claim of the ID, the page tokens are bound to the video or the user listed
+	var filter string
+	var list func(after primitive.ObjectID, limit int64) ([]*dao.Claim, error)
+	if req.GetVideoId() != "" {
+		videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+		if err != nil {
+			return nil, ErrInvalidObjectID
+		}
+
+		filter = "claims/video/" + videoID.Hex()
+		list = func(after primitive.ObjectID, limit int64) ([]*dao.Claim, error) {
+			return s.claimDAO.ListByVideoID(ctx, videoID, after, limit)
+		}
+	} else {
+		claimantID := logkit.UserIDFromContext(ctx)
+		if claimantID == "" {
+			return nil, ErrUserIDRequired
+		}
+
+		filter = "claims/claimant/" + claimantID
+		list = func(after primitive.ObjectID, limit int64) ([]*dao.Claim, error) {
+			return s.claimDAO.ListByClaimantID(ctx, claimantID, after, limit)
+		}
+	}
+
+	after, err := s.decodePageToken(req.GetPageToken(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	size := pageSize(req.GetPageSize())
+
+	// list one more claim to know whether there is a next page
+	claims, err := list(after, size+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListClaimsResponse{}
+
+	if int64(len(claims)) > size {
+		claims = claims[:size]
+
+		if resp.NextPageToken, err = s.encodePageToken(filter, claims[size-1].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Claims = make([]*pb.Claim, 0, len(claims))
+	for _, claim := range claims {
+		resp.Claims = append(resp.Claims, claim.ToProto())
+	}
+
+	return resp, nil
+}
+
+// DisputeClaim disputes the active claim and lifts its policy from the video, the dispute is reviewed out of band.
+// The videos have no owners yet, so any user may dispute a claim.
+func (s *service) DisputeClaim(ctx context.Context, req *pb.DisputeClaimRequest) (*pb.DisputeClaimResponse, error) {
+	if s.claimDAO == nil {
+		return nil, ErrClaimsDisabled
+	}
+
+	if logkit.UserIDFromContext(ctx) == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	if err := s.claimDAO.Dispute(ctx, id, req.GetReason(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	claim, err := s.claimDAO.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.videoDAO.SetClaim(ctx, claim.VideoID, dao.ClaimPolicyNone, ""); err != nil {
+		return nil, err
+	}
+
+	return &pb.DisputeClaimResponse{Claim: claim.ToProto()}, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Claims", func() {
+	var (
+		videoDAO  dao.VideoDAO
+		svc       *service
+		ctx       context.Context
+		video     *dao.Video
+		reference *dao.Video
+	)
+
+	BeforeEach(func() {
+		videoDAO = dao.NewMemoryVideoDAO()
+		svc = NewService(videoDAO, nil, nil, nil, WithClaims(dao.NewMemoryClaimDAO()), WithPageTokens(newFakePageTokens()))
+		ctx = logkit.WithUserID(context.Background(), "alice")
+
+		reference = dao.NewFakeVideo()
+		reference.Fingerprint = "fingerprint"
+		Expect(videoDAO.Create(ctx, reference)).To(Succeed())
+
+		video = dao.NewFakeVideo()
+		video.Fingerprint = "fingerprint"
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	submitClaim := func(policy dao.ClaimPolicy) (*pb.SubmitClaimResponse, error) {
+		return svc.SubmitClaim(ctx, &pb.SubmitClaimRequest{
+			VideoId:          video.ID.Hex(),
+			ReferenceVideoId: reference.ID.Hex(),
+			Policy:           policy.String(),
+			Reason:           "a copy of my video",
+		})
+	}
+
+	Describe("SubmitClaim", func() {
+		It("monetizes the video for the claimant", func() {
+			resp, err := submitClaim(dao.ClaimPolicyMonetize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetClaim().GetStatus()).To(Equal(dao.ClaimStatusActive.String()))
+
+			getResp, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getResp.GetVideo().GetClaimPolicy()).To(Equal(dao.ClaimPolicyMonetize.String()))
+			Expect(getResp.GetVideo().GetClaimantId()).To(Equal("alice"))
+		})
+
+		It("blocks the video", func() {
+			_, err := submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).To(MatchError(ErrVideoBlocked))
+
+			resp, err := svc.ListVideo(ctx, &pb.ListVideoRequest{PageSize: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetVideos()).To(ConsistOf(HaveField("Id", reference.ID.Hex())))
+		})
+
+		It("refuses the second active claim on the video", func() {
+			_, err := submitClaim(dao.ClaimPolicyMonetize)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).To(MatchError(dao.ErrVideoAlreadyClaimed))
+		})
+
+		It("refuses the videos not matching the reference video", func() {
+			video.Fingerprint = "another fingerprint"
+			Expect(videoDAO.Update(ctx, video)).To(Succeed())
+
+			_, err := submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).To(MatchError(ErrFingerprintMismatch))
+		})
+
+		It("refuses the videos not fingerprinted yet", func() {
+			video.Fingerprint = ""
+			Expect(videoDAO.Update(ctx, video)).To(Succeed())
+
+			_, err := submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).To(MatchError(ErrFingerprintPending))
+		})
+	})
+
+	Describe("DisputeClaim", func() {
+		It("lifts the policy of the claim from the video", func() {
+			resp, err := submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).NotTo(HaveOccurred())
+
+			disputeResp, err := svc.DisputeClaim(ctx, &pb.DisputeClaimRequest{Id: resp.GetClaim().GetId(), Reason: "my own video"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disputeResp.GetClaim().GetStatus()).To(Equal(dao.ClaimStatusDisputed.String()))
+			Expect(disputeResp.GetClaim().GetDisputeReason()).To(Equal("my own video"))
+
+			getResp, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getResp.GetVideo().GetClaimPolicy()).To(BeEmpty())
+
+			_, err = svc.DisputeClaim(ctx, &pb.DisputeClaimRequest{Id: resp.GetClaim().GetId(), Reason: "my own video"})
+			Expect(err).To(MatchError(dao.ErrClaimNotActive))
+		})
+	})
+
+	Describe("ListClaims", func() {
+		It("lists the claims of the video or of the user", func() {
+			resp, err := submitClaim(dao.ClaimPolicyMonetize)
+			Expect(err).NotTo(HaveOccurred())
+
+			listResp, err := svc.ListClaims(ctx, &pb.ListClaimsRequest{VideoId: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listResp.GetClaims()).To(ConsistOf(HaveField("Id", resp.GetClaim().GetId())))
+
+			listResp, err = svc.ListClaims(ctx, &pb.ListClaimsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listResp.GetClaims()).To(ConsistOf(HaveField("Id", resp.GetClaim().GetId())))
+
+			listResp, err = svc.ListClaims(logkit.WithUserID(context.Background(), "bob"), &pb.ListClaimsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listResp.GetClaims()).To(BeEmpty())
+		})
+
+		It("returns the page of the claims with the token of the next page", func() {
+			resp, err := submitClaim(dao.ClaimPolicyMonetize)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = svc.DisputeClaim(ctx, &pb.DisputeClaimRequest{Id: resp.GetClaim().GetId(), Reason: "my own video"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = submitClaim(dao.ClaimPolicyMonetize)
+			Expect(err).NotTo(HaveOccurred())
+
+			listResp, err := svc.ListClaims(ctx, &pb.ListClaimsRequest{PageSize: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listResp.GetClaims()).To(ConsistOf(HaveField("Id", resp.GetClaim().GetId())))
+			Expect(listResp.GetNextPageToken()).NotTo(BeEmpty())
+
+			_, err = svc.ListClaims(logkit.WithUserID(context.Background(), "bob"), &pb.ListClaimsRequest{PageToken: listResp.GetNextPageToken()})
+			Expect(err).To(MatchError(ErrInvalidPageToken))
+
+			listResp, err = svc.ListClaims(ctx, &pb.ListClaimsRequest{PageSize: 1, PageToken: listResp.GetNextPageToken()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listResp.GetClaims()).To(HaveLen(1))
+			Expect(listResp.GetNextPageToken()).To(BeEmpty())
+		})
+	})
+
+	When("claims are disabled", func() {
+		BeforeEach(func() {
+			svc = NewService(videoDAO, nil, nil, nil)
+		})
+
+		It("returns claims disabled error", func() {
+			_, err := submitClaim(dao.ClaimPolicyBlock)
+			Expect(err).To(MatchError(ErrClaimsDisabled))
+		})
+	})
+})
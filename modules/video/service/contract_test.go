@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/contractkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialContractServer serves the server on an in-memory listener and returns the connection to it.
+func dialContractServer(ctx context.Context, serve func(lis net.Listener) error, stop func()) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = serve(lis)
+	}()
+	DeferCleanup(stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	return conn
+}
+
+var _ = Describe("Contract", func() {
+	var (
+		ctx      context.Context
+		videoDAO dao.VideoDAO
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		videoDAO = dao.NewMemoryVideoDAO()
+	})
+
+	Describe("the video contract of the comment service", func() {
+		var (
+			contract *contractkit.Contract
+			conn     *grpc.ClientConn
+		)
+
+		BeforeEach(func() {
+			var err error
+			contract, err = contractkit.Load("../../comment/contract/video.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			server := serverkit.NewGrpcServer(ctx, &serverkit.GrpcServerConfig{}, serverkit.WithErrorMappings(ErrorMappings()...))
+			pb.RegisterVideoServer(server, NewService(videoDAO, nil, nil, nil))
+			conn = dialContractServer(ctx, server.Serve, server.Stop)
+		})
+
+		It("is honored by the video service", func() {
+			Expect(contractkit.Verify(ctx, conn, contract, map[string]contractkit.StateFunc{
+				"video 62a1f0c2e4b0a1b2c3d4e5f6 exists": func(ctx context.Context) error {
+					video := dao.NewFakeVideo()
+					video.ID, _ = primitive.ObjectIDFromHex("62a1f0c2e4b0a1b2c3d4e5f6")
+					return videoDAO.Create(ctx, video)
+				},
+			})).To(Succeed())
+		})
+	})
+
+	Describe("the comment contract of the video service", func() {
+		var svc *service
+
+		BeforeEach(func() {
+			contract, err := contractkit.Load("../contract/comment.json")
+			Expect(err).NotTo(HaveOccurred())
+
+			server := contractkit.NewStubServer(contract)
+			commentClient := commentpb.NewCommentClient(dialContractServer(ctx, server.Serve, server.Stop))
+			svc = NewService(videoDAO, nil, commentClient, nil)
+		})
+
+		It("covers the calls of DeleteVideo", func() {
+			video := dao.NewFakeVideo()
+			video.ID, _ = primitive.ObjectIDFromHex("62a1f0c2e4b0a1b2c3d4e5f6")
+			Expect(videoDAO.Create(ctx, video)).To(Succeed())
+
+			_, err := svc.DeleteVideo(ctx, &pb.DeleteVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
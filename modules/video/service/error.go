@@ -1,11 +1,73 @@
 package service
 
 import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
+// errorDomain is the domain of the ErrorInfo of the video service errors
+const errorDomain = "video.nthu-distributed-system"
+
 var (
-	ErrInvalidObjectID = status.Errorf(codes.InvalidArgument, "invalid objectID")
-	ErrVideoNotFound   = status.Errorf(codes.NotFound, "video not found")
+	ErrInvalidObjectID    = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_OBJECT_ID", "id", "invalid objectID")
+	ErrVideoNotFound      = grpckit.NewError(codes.NotFound, errorDomain, "VIDEO_NOT_FOUND", "video not found")
+	ErrVideoAlreadyExists = grpckit.NewError(codes.AlreadyExists, errorDomain, "VIDEO_ALREADY_EXISTS", "video already exists")
+	ErrInvalidPageToken   = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_PAGE_TOKEN", "page_token", "invalid page token")
+	ErrExpiredPageToken   = grpckit.NewInvalidArgumentError(errorDomain, "EXPIRED_PAGE_TOKEN", "page_token", "expired page token, list from the first page again")
+	ErrPageTokensDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "PAGE_TOKENS_DISABLED", "page tokens are disabled")
+
+	ErrUploadHeaderMissing  = grpckit.NewInvalidArgumentError(errorDomain, "UPLOAD_HEADER_MISSING", "header", "the upload must start with the header")
+	ErrUploadHeaderRepeated = grpckit.NewInvalidArgumentError(errorDomain, "UPLOAD_HEADER_REPEATED", "header", "the upload must have one header")
+	ErrUploadSizeMismatch   = grpckit.NewInvalidArgumentError(errorDomain, "UPLOAD_SIZE_MISMATCH", "header.size", "the chunks of the upload must add up to the size of the header")
+	ErrInvalidFilename      = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_FILENAME", "header.filename", "the filename must not be empty or contain a path")
+
+	ErrPollNotFound      = grpckit.NewError(codes.NotFound, errorDomain, "POLL_NOT_FOUND", "poll not found")
+	ErrPollClosed        = grpckit.NewError(codes.FailedPrecondition, errorDomain, "POLL_CLOSED", "poll closed")
+	ErrAlreadyVoted      = grpckit.NewError(codes.AlreadyExists, errorDomain, "ALREADY_VOTED", "already voted on the poll")
+	ErrInvalidPollOption = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_POLL_OPTION", "option", "the option is not of the poll")
+	ErrInvalidClosesAt   = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_CLOSES_AT", "closes_at", "the poll must close within 30 days")
+	ErrPollsDisabled     = grpckit.NewError(codes.FailedPrecondition, errorDomain, "POLLS_DISABLED", "polls are disabled")
+	ErrUserIDRequired    = grpckit.NewError(codes.Unauthenticated, errorDomain, "USER_ID_REQUIRED", "the X-User-Id header is required")
+
+	ErrInvalidPremiereAt = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_PREMIERE_AT", "premiere_at", "the video must premiere within 30 days")
+	ErrPremiereStarted   = grpckit.NewError(codes.FailedPrecondition, errorDomain, "PREMIERE_STARTED", "the premiere has started")
+	ErrNotPremiere       = grpckit.NewError(codes.FailedPrecondition, errorDomain, "NOT_PREMIERE", "the video does not premiere")
+
+	ErrThumbnailNotFound            = grpckit.NewError(codes.NotFound, errorDomain, "THUMBNAIL_NOT_FOUND", "thumbnail not found")
+	ErrTooManyThumbnails            = grpckit.NewError(codes.FailedPrecondition, errorDomain, "TOO_MANY_THUMBNAILS", "the video has 5 thumbnail candidates at most")
+	ErrThumbnailExperimentsDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "THUMBNAIL_EXPERIMENTS_DISABLED", "thumbnail experiments are disabled")
+
+	ErrInvalidPosition           = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_POSITION", "position", "the position must not be after the end of the video")
+	ErrWatchHistoryDisabled      = grpckit.NewError(codes.FailedPrecondition, errorDomain, "WATCH_HISTORY_DISABLED", "watch history is disabled")
+	ErrWatchProgressSyncDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "WATCH_PROGRESS_SYNC_DISABLED", "watch progress sync is disabled")
+
+	ErrVideoRestricted      = grpckit.NewError(codes.PermissionDenied, errorDomain, "VIDEO_RESTRICTED", "the video is hidden in restricted mode")
+	ErrUserSettingsDisabled = grpckit.NewError(codes.FailedPrecondition, errorDomain, "USER_SETTINGS_DISABLED", "user settings are disabled")
+
+	ErrClaimNotFound         = grpckit.NewError(codes.NotFound, errorDomain, "CLAIM_NOT_FOUND", "claim not found")
+	ErrClaimNotActive        = grpckit.NewError(codes.FailedPrecondition, errorDomain, "CLAIM_NOT_ACTIVE", "the claim is disputed")
+	ErrVideoAlreadyClaimed   = grpckit.NewError(codes.AlreadyExists, errorDomain, "VIDEO_ALREADY_CLAIMED", "the video has an active claim")
+	ErrInvalidReferenceVideo = grpckit.NewInvalidArgumentError(errorDomain, "INVALID_REFERENCE_VIDEO", "reference_video_id", "the reference video must be another video")
+	ErrFingerprintPending    = grpckit.NewError(codes.FailedPrecondition, errorDomain, "FINGERPRINT_PENDING", "the videos are not fingerprinted yet")
+	ErrFingerprintMismatch   = grpckit.NewError(codes.FailedPrecondition, errorDomain, "FINGERPRINT_MISMATCH", "the video does not match the reference video")
+	ErrVideoBlocked          = grpckit.NewError(codes.PermissionDenied, errorDomain, "VIDEO_BLOCKED", "the video is blocked by a copyright claim")
+	ErrClaimsDisabled        = grpckit.NewError(codes.FailedPrecondition, errorDomain, "CLAIMS_DISABLED", "claims are disabled")
 )
+
+// ErrorMappings maps the errors returned by the handlers to the service errors,
+// it is used by the error interceptors of the gRPC server.
+func ErrorMappings() []grpckit.ErrorMapping {
+	return []grpckit.ErrorMapping{
+		{Err: dao.ErrVideoNotFound, Status: ErrVideoNotFound},
+		{Err: dao.ErrVideoAlreadyExists, Status: ErrVideoAlreadyExists},
+		{Err: dao.ErrTooManyThumbnails, Status: ErrTooManyThumbnails},
+		{Err: dao.ErrPremiereStarted, Status: ErrPremiereStarted},
+		{Err: dao.ErrPollNotFound, Status: ErrPollNotFound},
+		{Err: dao.ErrPollClosed, Status: ErrPollClosed},
+		{Err: dao.ErrAlreadyVoted, Status: ErrAlreadyVoted},
+		{Err: dao.ErrClaimNotFound, Status: ErrClaimNotFound},
+		{Err: dao.ErrClaimNotActive, Status: ErrClaimNotActive},
+		{Err: dao.ErrVideoAlreadyClaimed, Status: ErrVideoAlreadyClaimed},
+	}
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ErrorMappings", func() {
+	DescribeTable("maps the error to the service error",
+		func(err error, expected error) {
+			Expect(grpckit.MapError(err, ErrorMappings()...)).To(MatchError(expected))
+		},
+		Entry("video not found", dao.ErrVideoNotFound, ErrVideoNotFound),
+		Entry("video already exists", dao.ErrVideoAlreadyExists, ErrVideoAlreadyExists),
+		Entry("poll not found", dao.ErrPollNotFound, ErrPollNotFound),
+		Entry("poll closed", dao.ErrPollClosed, ErrPollClosed),
+		Entry("already voted", dao.ErrAlreadyVoted, ErrAlreadyVoted),
+		Entry("claim not found", dao.ErrClaimNotFound, ErrClaimNotFound),
+		Entry("claim not active", dao.ErrClaimNotActive, ErrClaimNotActive),
+		Entry("video already claimed", dao.ErrVideoAlreadyClaimed, ErrVideoAlreadyClaimed),
+		Entry("service error", ErrInvalidObjectID, ErrInvalidObjectID),
+	)
+})
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/contractkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fixturekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/goldenkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The REST responses of the gateway are what the mobile clients parse, so the field names and the error shapes
+// are pinned by the golden files of testdata/gateway. A change of the protos changing them fails here, and
+// a change on purpose is recorded by GOLDEN_UPDATE=1.
+var _ = Describe("Gateway", func() {
+	// the video to delete has comments in the comment contract of the video service
+	const deletedVideoID = "62a1f0c2e4b0a1b2c3d4e5f6"
+
+	// the fixtures are the same in every spec, so the routes of the entries refer to them
+	newFixtures := func() []*dao.Video {
+		g := fixturekit.NewGenerator(1)
+
+		return []*dao.Video{dao.NewVideoFixture(g), dao.NewVideoFixture(g), dao.NewVideoFixture(g)}
+	}
+	videoID := newFixtures()[0].ID.Hex()
+
+	var (
+		ctx context.Context
+		mux *runtime.ServeMux
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		videoDAO := dao.NewMemoryVideoDAO()
+		for _, video := range newFixtures() {
+			Expect(videoDAO.Create(ctx, video)).To(Succeed())
+		}
+
+		deletedVideo := dao.NewFakeVideo()
+		deletedVideo.ID, _ = primitive.ObjectIDFromHex(deletedVideoID)
+		Expect(videoDAO.Create(ctx, deletedVideo)).To(Succeed())
+
+		contract, err := contractkit.Load("../contract/comment.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		commentServer := contractkit.NewStubServer(contract)
+		commentClient := commentpb.NewCommentClient(dialContractServer(ctx, commentServer.Serve, commentServer.Stop))
+
+		server := serverkit.NewGrpcServer(ctx, &serverkit.GrpcServerConfig{}, serverkit.WithErrorMappings(ErrorMappings()...))
+		pb.RegisterVideoServer(server, NewService(videoDAO, nil, commentClient, nil, WithPageTokens(newFakePageTokens())))
+		conn := dialContractServer(ctx, server.Serve, server.Stop)
+
+		mux = grpckit.NewGatewayMux(tenantkit.HeaderMatcher)
+		Expect(pb.RegisterVideoHandler(ctx, mux, conn)).To(Succeed())
+	})
+
+	DescribeTable("serves the responses of the golden files",
+		func(golden, method, target string, redactedFields ...string) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader("")))
+
+			Expect(goldenkit.CompareResponse("testdata/gateway/"+golden+".json", rec.Result(), redactedFields...)).To(Succeed())
+		},
+		Entry("get a video", "get_video", http.MethodGet, "/v1/videos/"+videoID),
+		Entry("list videos", "list_videos", http.MethodGet, "/v1/videos?page_size=2", "nextPageToken"),
+		Entry("get a missing video", "get_video_not_found", http.MethodGet, "/v1/videos/62a1f0c2e4b0a1b2c3d4e5f7"),
+		Entry("get a video of a malformed ID", "get_video_malformed_id", http.MethodGet, "/v1/videos/not-a-video-id"),
+		Entry("delete a video", "delete_video", http.MethodDelete, "/v1/videos/"+deletedVideoID),
+	)
+})
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// defaultPageSize is the page size of the lists if not specified
+const defaultPageSize = 20
+
+// WithPageTokens pages the lists, e.g. ListPolls, by the page tokens of the codec, the lists are disabled without it.
+// It is a no-op if the codec is nil.
+func WithPageTokens(pageTokens *pagekit.Codec) ServiceOption {
+	return func(s *service) {
+		if pageTokens != nil {
+			s.pageTokens = pageTokens
+		}
+	}
+}
+
+// pageToken is the cursor of the next page, which is opaque to the clients. The lists are in the order of creation,
+// so they are paged by the ID of the last item of a page.
+type pageToken struct {
+	ID primitive.ObjectID `json:"id"`
+}
+
+// pageSize returns the page size of the request, or the default one if not specified.
+func pageSize(size int32) int64 {
+	if size <= 0 {
+		return defaultPageSize
+	}
+
+	return int64(size)
+}
+
+func (s *service) encodePageToken(filter string, id primitive.ObjectID) (string, error) {
+	return s.pageTokens.Encode(filter, &pageToken{ID: id})
+}
+
+// decodePageToken returns the zero ID for the empty token of the first page.
+func (s *service) decodePageToken(token, filter string) (primitive.ObjectID, error) {
+	if token == "" {
+		return primitive.NilObjectID, nil
+	}
+
+	var t pageToken
+	if err := s.pageTokens.Decode(token, filter, &t); err != nil {
+		if errors.Is(err, pagekit.ErrExpiredToken) {
+			return primitive.NilObjectID, ErrExpiredPageToken
+		}
+
+		return primitive.NilObjectID, ErrInvalidPageToken
+	}
+
+	if t.ID.IsZero() {
+		return primitive.NilObjectID, ErrInvalidPageToken
+	}
+
+	return t.ID, nil
+}
+
+// videosPageTokenFilter binds the page tokens of the videos to the tenant listed.
+func videosPageTokenFilter(tenantID string) string {
+	return "videos/" + tenantID
+}
+
+// skipPageToken is the cursor of the next page of the videos. The videos are in the order of ID, but they are listed
+// by the number of the videos skipped, which the sharded DAO gathers and the cache of the Redis DAO is keyed by, so the
+// token carries the skip instead of the last ID. It is signed, so it cannot be forged.
+type skipPageToken struct {
+	Skip int64 `json:"skip"`
+}
+
+func (s *service) encodeSkipPageToken(filter string, skip int64) (string, error) {
+	return s.pageTokens.Encode(filter, &skipPageToken{Skip: skip})
+}
+
+// decodeSkipPageToken returns zero for the empty token of the first page.
+func (s *service) decodeSkipPageToken(token, filter string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	var t skipPageToken
+	if err := s.pageTokens.Decode(token, filter, &t); err != nil {
+		if errors.Is(err, pagekit.ErrExpiredToken) {
+			return 0, ErrExpiredPageToken
+		}
+
+		return 0, ErrInvalidPageToken
+	}
+
+	if t.Skip <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return t.Skip, nil
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxPollDuration is the longest time a poll is open since it is created
+	MaxPollDuration = 30 * 24 * time.Hour
+	// DefaultPollCloseSchedule is the schedule the polls due are closed by by default
+	DefaultPollCloseSchedule = "@every 10s"
+)
+
+// WithPolls serves the polls of the videos by the DAOs. It is a no-op if either DAO is nil.
+func WithPolls(pollDAO dao.PollDAO, pollVoteDAO dao.PollVoteDAO) ServiceOption {
+	return func(s *service) {
+		if pollDAO != nil && pollVoteDAO != nil {
+			s.pollDAO = pollDAO
+			s.pollVoteDAO = pollVoteDAO
+		}
+	}
+}
+
+func (s *service) CreatePoll(ctx context.Context, req *pb.CreatePollRequest) (*pb.CreatePollResponse, error) {
+	if s.pollDAO == nil {
+		return nil, ErrPollsDisabled
+	}
+
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	now := time.Now()
+	closesAt := req.GetClosesAt().AsTime()
+	if req.GetClosesAt() == nil || !closesAt.After(now) || closesAt.Sub(now) > MaxPollDuration {
+		return nil, ErrInvalidClosesAt
+	}
+
+	if _, err := s.videoDAO.Get(ctx, videoID); err != nil {
+		return nil, err
+	}
+
+	poll := &dao.Poll{
+		VideoID:   videoID,
+		Question:  req.GetQuestion(),
+		Options:   req.GetOptions(),
+		Votes:     make([]int64, len(req.GetOptions())),
+		ClosesAt:  closesAt,
+		CreatedAt: now,
+	}
+	if err := s.pollDAO.Create(ctx, poll); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePollResponse{Poll: poll.ToProto()}, nil
+}
+
+func (s *service) GetPoll(ctx context.Context, req *pb.GetPollRequest) (*pb.GetPollResponse, error) {
+	poll, err := s.getPoll(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.countVotes(ctx, poll); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPollResponse{Poll: poll.ToProto()}, nil
+}
+
+func (s *service) ListPolls(ctx context.Context, req *pb.ListPollsRequest) (*pb.ListPollsResponse, error) {
+	if s.pollDAO == nil {
+		return nil, ErrPollsDisabled
+	}
+
+	if s.pageTokens == nil {
+		return nil, ErrPageTokensDisabled
+	}
+
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	// the page tokens are bound to the video listed
+	filter := "polls/" + videoID.Hex()
+
+	after, err := s.decodePageToken(req.GetPageToken(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	size := pageSize(req.GetPageSize())
+
+	// list one more poll to know whether there is a next page
+	polls, err := s.pollDAO.ListByVideoID(ctx, videoID, after, size+1)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPollsResponse{}
+
+	if int64(len(polls)) > size {
+		polls = polls[:size]
+
+		if resp.NextPageToken, err = s.encodePageToken(filter, polls[size-1].ID); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Polls = make([]*pb.Poll, 0, len(polls))
+	for _, poll := range polls {
+		if err := s.countVotes(ctx, poll); err != nil {
+			return nil, err
+		}
+
+		resp.Polls = append(resp.Polls, poll.ToProto())
+	}
+
+	return resp, nil
+}
+
+// Vote votes for the option once per caller, the caller being the principal of grpckit.Principal, so the votes are
+// not stuffed by the user IDs sent by a client. The votes after the poll is due are refused here, and the ones after
+// it is closed by the votes DAO, so the final votes of a poll closed early are final.
+func (s *service) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	poll, err := s.getPoll(ctx, req.GetPollId())
+	if err != nil {
+		return nil, err
+	}
+
+	if poll.Closed || !time.Now().Before(poll.ClosesAt) {
+		return nil, ErrPollClosed
+	}
+
+	option := int(req.GetOption())
+	if option >= len(poll.Options) {
+		return nil, ErrInvalidPollOption
+	}
+
+	if err := s.pollVoteDAO.Vote(ctx, poll, grpckit.Principal(ctx), option); err != nil {
+		return nil, err
+	}
+
+	if err := s.countVotes(ctx, poll); err != nil {
+		return nil, err
+	}
+
+	return &pb.VoteResponse{Poll: poll.ToProto()}, nil
+}
+
+func (s *service) ClosePoll(ctx context.Context, req *pb.ClosePollRequest) (*pb.ClosePollResponse, error) {
+	poll, err := s.getPoll(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if poll.Closed {
+		return nil, ErrPollClosed
+	}
+
+	if err := closePoll(ctx, s.pollDAO, s.pollVoteDAO, poll, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &pb.ClosePollResponse{Poll: poll.ToProto()}, nil
+}
+
+func (s *service) getPoll(ctx context.Context, id string) (*dao.Poll, error) {
+	if s.pollDAO == nil {
+		return nil, ErrPollsDisabled
+	}
+
+	pollID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	return s.pollDAO.Get(ctx, pollID)
+}
+
+// countVotes sets the votes of the open poll so far, the votes of a closed poll are stored along with it.
+func (s *service) countVotes(ctx context.Context, poll *dao.Poll) error {
+	if poll.Closed {
+		return nil
+	}
+
+	votes, err := s.pollVoteDAO.Votes(ctx, poll)
+	if err != nil {
+		return err
+	}
+
+	poll.Votes = votes
+
+	return nil
+}
+
+// closePoll closes the votes of the poll and stores the final votes along with it, closing at the time if the poll
+// is not due yet. The votes are closed first, so a poll failed to close is closed again with the same votes.
+func closePoll(ctx context.Context, pollDAO dao.PollDAO, pollVoteDAO dao.PollVoteDAO, poll *dao.Poll, at time.Time) error {
+	votes, err := pollVoteDAO.Close(ctx, poll)
+	if err != nil {
+		return err
+	}
+
+	closesAt := poll.ClosesAt
+	if at.Before(closesAt) {
+		closesAt = at
+	}
+
+	if err := pollDAO.Close(ctx, poll.ID, closesAt, votes); err != nil {
+		return err
+	}
+
+	poll.Votes = votes
+	poll.ClosesAt = closesAt
+	poll.Closed = true
+
+	return nil
+}
+
+// PollCloser closes the polls due, it is run by the scheduler of the jobs, see Schedule.
+type PollCloser struct {
+	pollDAO     dao.PollDAO
+	pollVoteDAO dao.PollVoteDAO
+	logger      *logkit.Logger
+}
+
+// pollCloseBatchSize is the number of the polls closed at most by a run, the rest are closed by the next runs
+const pollCloseBatchSize = 100
+
+func NewPollCloser(ctx context.Context, pollDAO dao.PollDAO, pollVoteDAO dao.PollVoteDAO) *PollCloser {
+	return &PollCloser{
+		pollDAO:     pollDAO,
+		pollVoteDAO: pollVoteDAO,
+		logger:      logkit.FromContext(ctx),
+	}
+}
+
+// Schedule schedules closing the polls due by the cron expression. The scheduler locks each run in Redis, so the
+// polls are closed by one instance at a time, and the ones failed to close are closed by the next run of any instance.
+func (c *PollCloser) Schedule(s *scheduler.Scheduler, expr string) error {
+	return s.Add("video_poll_close", expr, func(ctx context.Context) error {
+		closed, err := c.CloseDue(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.logger.Debug("close polls", zap.Int("closed", closed))
+
+		return nil
+	})
+}
+
+// CloseDue closes the polls of every tenant due now, and returns the number of the polls closed.
+func (c *PollCloser) CloseDue(ctx context.Context) (int, error) {
+	polls, err := c.pollDAO.ListDue(ctx, time.Now(), pollCloseBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, poll := range polls {
+		err := closePoll(tenantkit.WithTenantID(ctx, poll.TenantID), c.pollDAO, c.pollVoteDAO, poll, poll.ClosesAt)
+		if errors.Is(err, dao.ErrPollClosed) {
+			// closed early by ClosePoll
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+
+		closed++
+	}
+
+	return closed, nil
+}
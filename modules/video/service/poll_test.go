@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// callerContext returns the context of a call from the peer of the IP, which is the principal voting on the polls.
+func callerContext(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50051}})
+}
+
+var _ = Describe("Polls", func() {
+	var (
+		pollDAO     dao.PollDAO
+		pollVoteDAO dao.PollVoteDAO
+		svc         *service
+		ctx         context.Context
+		video       *dao.Video
+	)
+
+	BeforeEach(func() {
+		videoDAO := dao.NewMemoryVideoDAO()
+		pollDAO = dao.NewMemoryPollDAO()
+		pollVoteDAO = dao.NewMemoryPollVoteDAO()
+		svc = NewService(videoDAO, nil, nil, nil, WithPolls(pollDAO, pollVoteDAO), WithPageTokens(newFakePageTokens()))
+		ctx = logkit.WithUserID(context.Background(), "alice")
+
+		video = dao.NewFakeVideo()
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	createPoll := func(closesAt time.Time) *pb.Poll {
+		resp, err := svc.CreatePoll(ctx, &pb.CreatePollRequest{
+			VideoId:  video.ID.Hex(),
+			Question: "Which one is next?",
+			Options:  []string{"first", "second"},
+			ClosesAt: timestamppb.New(closesAt),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp.GetPoll()
+	}
+
+	Describe("CreatePoll", func() {
+		It("creates an open poll without votes", func() {
+			poll := createPoll(time.Now().Add(time.Hour))
+
+			Expect(poll.GetClosed()).To(BeFalse())
+			Expect(poll.GetOptions()).To(HaveLen(2))
+			Expect(poll.GetOptions()[0].GetVotes()).To(BeZero())
+
+			resp, err := svc.ListPolls(ctx, &pb.ListPollsRequest{VideoId: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPolls()).To(HaveLen(1))
+			Expect(resp.GetPolls()[0].GetId()).To(Equal(poll.GetId()))
+		})
+
+		It("refuses the poll closing in the past or after MaxPollDuration", func() {
+			for _, closesAt := range []time.Time{time.Now().Add(-time.Minute), time.Now().Add(MaxPollDuration + time.Hour)} {
+				_, err := svc.CreatePoll(ctx, &pb.CreatePollRequest{
+					VideoId:  video.ID.Hex(),
+					Question: "Which one is next?",
+					Options:  []string{"first", "second"},
+					ClosesAt: timestamppb.New(closesAt),
+				})
+				Expect(err).To(MatchError(ErrInvalidClosesAt))
+			}
+		})
+
+		It("returns the video not found error for the missing video", func() {
+			_, err := svc.CreatePoll(ctx, &pb.CreatePollRequest{
+				VideoId:  primitive.NewObjectID().Hex(),
+				Question: "Which one is next?",
+				Options:  []string{"first", "second"},
+				ClosesAt: timestamppb.New(time.Now().Add(time.Hour)),
+			})
+			Expect(err).To(MatchError(dao.ErrVideoNotFound))
+		})
+	})
+
+	Describe("ListPolls", func() {
+		It("pages the polls by the page tokens", func() {
+			first := createPoll(time.Now().Add(time.Hour))
+			second := createPoll(time.Now().Add(time.Hour))
+			third := createPoll(time.Now().Add(time.Hour))
+
+			resp, err := svc.ListPolls(ctx, &pb.ListPollsRequest{VideoId: video.ID.Hex(), PageSize: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPolls()).To(HaveLen(2))
+			Expect(resp.GetPolls()[0].GetId()).To(Equal(first.GetId()))
+			Expect(resp.GetPolls()[1].GetId()).To(Equal(second.GetId()))
+			Expect(resp.GetNextPageToken()).NotTo(BeEmpty())
+
+			resp, err = svc.ListPolls(ctx, &pb.ListPollsRequest{VideoId: video.ID.Hex(), PageSize: 2, PageToken: resp.GetNextPageToken()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPolls()).To(HaveLen(1))
+			Expect(resp.GetPolls()[0].GetId()).To(Equal(third.GetId()))
+			Expect(resp.GetNextPageToken()).To(BeEmpty())
+		})
+
+		It("returns ErrInvalidPageToken if the page token is of another video", func() {
+			token, err := svc.encodePageToken("polls/"+primitive.NewObjectID().Hex(), primitive.NewObjectID())
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = svc.ListPolls(ctx, &pb.ListPollsRequest{VideoId: video.ID.Hex(), PageToken: token})
+			Expect(err).To(MatchError(ErrInvalidPageToken))
+		})
+	})
+
+	Describe("Vote", func() {
+		var poll *pb.Poll
+
+		BeforeEach(func() {
+			poll = createPoll(time.Now().Add(time.Hour))
+		})
+
+		It("counts the vote once per caller", func() {
+			resp, err := svc.Vote(callerContext(ctx, "10.0.0.1"), &pb.VoteRequest{PollId: poll.GetId(), Option: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPoll().GetOptions()[1].GetVotes()).To(BeEquivalentTo(1))
+
+			_, err = svc.Vote(callerContext(ctx, "10.0.0.1"), &pb.VoteRequest{PollId: poll.GetId(), Option: 0})
+			Expect(err).To(MatchError(dao.ErrAlreadyVoted))
+
+			resp, err = svc.Vote(callerContext(ctx, "10.0.0.2"), &pb.VoteRequest{PollId: poll.GetId(), Option: 0})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPoll().GetOptions()[0].GetVotes()).To(BeEquivalentTo(1))
+		})
+
+		It("does not count the votes of a caller by the user IDs it sends", func() {
+			_, err := svc.Vote(callerContext(logkit.WithUserID(ctx, "bob"), "10.0.0.1"), &pb.VoteRequest{PollId: poll.GetId(), Option: 1})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = svc.Vote(callerContext(logkit.WithUserID(ctx, "carol"), "10.0.0.1"), &pb.VoteRequest{PollId: poll.GetId(), Option: 1})
+			Expect(err).To(MatchError(dao.ErrAlreadyVoted))
+		})
+
+		It("refuses the option not of the poll", func() {
+			_, err := svc.Vote(ctx, &pb.VoteRequest{PollId: poll.GetId(), Option: 2})
+			Expect(err).To(MatchError(ErrInvalidPollOption))
+		})
+
+		It("refuses the votes after the poll is closed", func() {
+			_, err := svc.ClosePoll(ctx, &pb.ClosePollRequest{Id: poll.GetId()})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = svc.Vote(ctx, &pb.VoteRequest{PollId: poll.GetId(), Option: 1})
+			Expect(err).To(MatchError(ErrPollClosed))
+		})
+	})
+
+	Describe("ClosePoll", func() {
+		It("stores the final votes and closes the poll early", func() {
+			poll := createPoll(time.Now().Add(time.Hour))
+			_, err := svc.Vote(ctx, &pb.VoteRequest{PollId: poll.GetId(), Option: 0})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := svc.ClosePoll(ctx, &pb.ClosePollRequest{Id: poll.GetId()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPoll().GetClosed()).To(BeTrue())
+			Expect(resp.GetPoll().GetClosesAt().AsTime()).To(BeTemporally("<", poll.GetClosesAt().AsTime()))
+			Expect(resp.GetPoll().GetOptions()[0].GetVotes()).To(BeEquivalentTo(1))
+
+			_, err = svc.ClosePoll(ctx, &pb.ClosePollRequest{Id: poll.GetId()})
+			Expect(err).To(MatchError(ErrPollClosed))
+		})
+	})
+
+	Describe("PollCloser", func() {
+		It("closes the polls due with their votes", func() {
+			// the polls due are created by the DAO, since the service refuses them
+			due := &dao.Poll{
+				VideoID:  video.ID,
+				Question: "Which one is next?",
+				Options:  []string{"first", "second"},
+				Votes:    []int64{0, 0},
+				ClosesAt: time.Now().Add(-time.Minute),
+			}
+			Expect(pollDAO.Create(ctx, due)).To(Succeed())
+			Expect(pollVoteDAO.Vote(ctx, due, "alice", 1)).To(Succeed())
+			open := createPoll(time.Now().Add(time.Hour))
+
+			closer := NewPollCloser(logkit.NewNopLogger().WithContext(ctx), pollDAO, pollVoteDAO)
+			Expect(closer.CloseDue(ctx)).To(Equal(1))
+			Expect(closer.CloseDue(ctx)).To(BeZero())
+
+			resp, err := svc.GetPoll(ctx, &pb.GetPollRequest{Id: due.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPoll().GetClosed()).To(BeTrue())
+			Expect(resp.GetPoll().GetOptions()[1].GetVotes()).To(BeEquivalentTo(1))
+
+			resp, err = svc.GetPoll(ctx, &pb.GetPollRequest{Id: open.GetId()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPoll().GetClosed()).To(BeFalse())
+		})
+	})
+
+	When("polls are disabled", func() {
+		BeforeEach(func() {
+			svc = NewService(dao.NewMemoryVideoDAO(), nil, nil, nil)
+		})
+
+		It("returns polls disabled error", func() {
+			_, err := svc.ListPolls(ctx, &pb.ListPollsRequest{VideoId: video.ID.Hex()})
+			Expect(err).To(MatchError(ErrPollsDisabled))
+
+			_, err = svc.Vote(ctx, &pb.VoteRequest{PollId: primitive.NewObjectID().Hex(), Option: 1})
+			Expect(err).To(MatchError(ErrPollsDisabled))
+		})
+	})
+})
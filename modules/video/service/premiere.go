@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxPremiereLead is the longest time a premiere is scheduled ahead
+const MaxPremiereLead = 30 * 24 * time.Hour
+
+// SchedulePremiere schedules the premiere of the video, or cancels it if the time is unset. The premiere cannot be
+// changed once it starts, since the viewers are watching it by then.
+func (s *service) SchedulePremiere(ctx context.Context, req *pb.SchedulePremiereRequest) (*pb.SchedulePremiereResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	now := time.Now()
+
+	var premiereAt time.Time
+	if req.GetPremiereAt() != nil {
+		premiereAt = req.GetPremiereAt().AsTime()
+		if !premiereAt.After(now) || premiereAt.Sub(now) > MaxPremiereLead {
+			return nil, ErrInvalidPremiereAt
+		}
+	}
+
+	video, err := s.videoDAO.SetPremiereAt(ctx, id, premiereAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SchedulePremiereResponse{Video: s.toProto(video)}, nil
+}
+
+// GetPremiereClock returns the position of the premiere of the video at the time of the server.
+func (s *service) GetPremiereClock(ctx context.Context, req *pb.GetPremiereClockRequest) (*pb.GetPremiereClockResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	state := video.PremiereState(now)
+	if state == dao.PremiereStateNone {
+		return nil, ErrNotPremiere
+	}
+
+	return &pb.GetPremiereClockResponse{
+		State:      state.String(),
+		PremiereAt: timestamppb.New(video.PremiereAt),
+		ServerTime: timestamppb.New(now),
+		Position:   video.PremierePosition(now),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Premieres", func() {
+	var (
+		videoDAO dao.VideoDAO
+		svc      *service
+		ctx      context.Context
+		video    *dao.Video
+	)
+
+	BeforeEach(func() {
+		videoDAO = dao.NewMemoryVideoDAO()
+		svc = NewService(videoDAO, nil, nil, nil)
+		ctx = context.Background()
+
+		video = dao.NewFakeVideo()
+		video.Duration = 60
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	// premiere sets the premiere time of the video directly, since the service only schedules the premieres ahead
+	premiere := func(premiereAt time.Time) {
+		video.PremiereAt = premiereAt
+		Expect(videoDAO.Update(ctx, video)).To(Succeed())
+	}
+
+	Describe("SchedulePremiere", func() {
+		It("hides the URLs of the video until it premieres", func() {
+			premiereAt := time.Now().Add(time.Hour)
+
+			resp, err := svc.SchedulePremiere(ctx, &pb.SchedulePremiereRequest{Id: video.ID.Hex(), PremiereAt: timestamppb.New(premiereAt)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetVideo().GetPremiereAt().AsTime()).To(BeTemporally("==", premiereAt))
+
+			getResp, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getResp.GetVideo().GetUrl()).To(BeEmpty())
+			Expect(getResp.GetVideo().GetVariants()).To(BeEmpty())
+		})
+
+		It("cancels the premiere if the time is unset", func() {
+			premiere(time.Now().Add(time.Hour))
+
+			resp, err := svc.SchedulePremiere(ctx, &pb.SchedulePremiereRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetVideo().GetPremiereAt()).To(BeNil())
+			Expect(resp.GetVideo().GetUrl()).To(Equal(video.URL))
+		})
+
+		It("refuses the time in the past or after MaxPremiereLead", func() {
+			for _, premiereAt := range []time.Time{time.Now().Add(-time.Minute), time.Now().Add(MaxPremiereLead + time.Hour)} {
+				_, err := svc.SchedulePremiere(ctx, &pb.SchedulePremiereRequest{Id: video.ID.Hex(), PremiereAt: timestamppb.New(premiereAt)})
+				Expect(err).To(MatchError(ErrInvalidPremiereAt))
+			}
+		})
+
+		It("refuses to change the premiere started", func() {
+			premiere(time.Now().Add(-time.Second))
+
+			_, err := svc.SchedulePremiere(ctx, &pb.SchedulePremiereRequest{Id: video.ID.Hex()})
+			Expect(err).To(MatchError(dao.ErrPremiereStarted))
+		})
+	})
+
+	Describe("GetPremiereClock", func() {
+		getClock := func() (*pb.GetPremiereClockResponse, error) {
+			return svc.GetPremiereClock(ctx, &pb.GetPremiereClockRequest{Id: video.ID.Hex()})
+		}
+
+		It("starts at zero before the premiere", func() {
+			premiere(time.Now().Add(time.Hour))
+
+			resp, err := getClock()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetState()).To(Equal(dao.PremiereStateScheduled.String()))
+			Expect(resp.GetPosition()).To(BeZero())
+		})
+
+		It("follows the server time during the premiere", func() {
+			premiere(time.Now().Add(-10 * time.Second))
+
+			resp, err := getClock()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetState()).To(Equal(dao.PremiereStateLive.String()))
+			Expect(resp.GetPosition()).To(BeNumerically("~", resp.GetServerTime().AsTime().Sub(video.PremiereAt).Seconds(), 0.001))
+		})
+
+		It("ends as a normal video after the duration of the video", func() {
+			premiere(time.Now().Add(-2 * time.Minute))
+
+			resp, err := getClock()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetState()).To(Equal(dao.PremiereStateEnded.String()))
+			Expect(resp.GetPosition()).To(Equal(video.Duration))
+
+			getResp, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getResp.GetVideo().GetUrl()).To(Equal(video.URL))
+		})
+
+		It("returns not premiere error for the video without premiere", func() {
+			_, err := getClock()
+			Expect(err).To(MatchError(ErrNotPremiere))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// WithUserSettings keeps the settings of the users by the DAO, and hides the videos restricted from the users in
+// restricted mode. It is a no-op if the DAO is nil.
+func WithUserSettings(userSettingsDAO dao.UserSettingsDAO) ServiceOption {
+	return func(s *service) {
+		if userSettingsDAO != nil {
+			s.userSettingsDAO = userSettingsDAO
+		}
+	}
+}
+
+// SetAgeRating sets the age rating of the video, or unrates it if the rating is empty.
+func (s *service) SetAgeRating(ctx context.Context, req *pb.SetAgeRatingRequest) (*pb.SetAgeRatingResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.SetAgeRating(ctx, id, dao.AgeRating(req.GetAgeRating()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetAgeRatingResponse{Video: s.toProto(video)}, nil
+}
+
+func (s *service) GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.GetUserSettingsResponse, error) {
+	if s.userSettingsDAO == nil {
+		return nil, ErrUserSettingsDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	settings, err := s.userSettingsDAO.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserSettingsResponse{Settings: settings.ToProto()}, nil
+}
+
+func (s *service) UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UpdateUserSettingsResponse, error) {
+	if s.userSettingsDAO == nil {
+		return nil, ErrUserSettingsDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	settings := &dao.UserSettings{
+		UserID:         userID,
+		RestrictedMode: req.GetSettings().GetRestrictedMode(),
+		UpdatedAt:      time.Now(),
+	}
+	if err := s.userSettingsDAO.Update(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserSettingsResponse{Settings: settings.ToProto()}, nil
+}
+
+// restrictedMode reports whether the user of the request is in restricted mode, the requests without a user ID
+// are not restricted.
+func (s *service) restrictedMode(ctx context.Context) (bool, error) {
+	if s.userSettingsDAO == nil {
+		return false, nil
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return false, nil
+	}
+
+	settings, err := s.userSettingsDAO.Get(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return settings.RestrictedMode, nil
+}
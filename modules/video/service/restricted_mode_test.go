@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Restricted mode", func() {
+	var (
+		svc     *service
+		ctx     context.Context
+		general *dao.Video
+		mature  *dao.Video
+	)
+
+	BeforeEach(func() {
+		videoDAO := dao.NewMemoryVideoDAO()
+		svc = NewService(videoDAO, nil, nil, nil, WithUserSettings(dao.NewMemoryUserSettingsDAO()), WithPageTokens(newFakePageTokens()))
+		ctx = logkit.WithUserID(context.Background(), "alice")
+
+		general = dao.NewFakeVideo()
+		Expect(videoDAO.Create(ctx, general)).To(Succeed())
+		mature = dao.NewFakeVideo()
+		Expect(videoDAO.Create(ctx, mature)).To(Succeed())
+
+		resp, err := svc.SetAgeRating(ctx, &pb.SetAgeRatingRequest{Id: mature.ID.Hex(), AgeRating: dao.AgeRatingMature.String()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetVideo().GetAgeRating()).To(Equal(dao.AgeRatingMature.String()))
+	})
+
+	restrict := func() {
+		resp, err := svc.UpdateUserSettings(ctx, &pb.UpdateUserSettingsRequest{Settings: &pb.UserSettings{RestrictedMode: true}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetSettings().GetRestrictedMode()).To(BeTrue())
+	}
+
+	It("is off by default", func() {
+		resp, err := svc.GetUserSettings(ctx, &pb.GetUserSettingsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetSettings().GetRestrictedMode()).To(BeFalse())
+
+		_, err = svc.GetVideo(ctx, &pb.GetVideoRequest{Id: mature.ID.Hex()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("hides the mature videos from the user", func() {
+		restrict()
+
+		_, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: mature.ID.Hex()})
+		Expect(err).To(MatchError(ErrVideoRestricted))
+
+		_, err = svc.GetVideo(ctx, &pb.GetVideoRequest{Id: general.ID.Hex()})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := svc.ListVideo(ctx, &pb.ListVideoRequest{PageSize: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetVideos()).To(ConsistOf(HaveField("Id", general.ID.Hex())))
+	})
+
+	It("does not restrict the other users", func() {
+		restrict()
+
+		_, err := svc.GetVideo(logkit.WithUserID(context.Background(), "bob"), &pb.GetVideoRequest{Id: mature.ID.Hex()})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.GetVideo(context.Background(), &pb.GetVideoRequest{Id: mature.ID.Hex()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("user settings are disabled", func() {
+		BeforeEach(func() {
+			svc = NewService(dao.NewMemoryVideoDAO(), nil, nil, nil)
+		})
+
+		It("returns user settings disabled error", func() {
+			_, err := svc.GetUserSettings(ctx, &pb.GetUserSettingsRequest{})
+			Expect(err).To(MatchError(ErrUserSettingsDisabled))
+		})
+	})
+})
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+)
+
+// the scopes of the video methods, granted to the SPIFFE IDs of the callers by the authz grants
+const (
+	ScopeRead  = "video.read"
+	ScopeWrite = "video.write"
+)
+
+// MethodScopes returns the scopes of the methods declared by the authz.scope option in rpc.proto, it is used
+// by the authorization interceptors of the gRPC server. A new method is denied until its scope is declared there.
+func MethodScopes() grpckit.MethodScopes {
+	return grpckit.ScopesOf(pb.File_modules_video_pb_rpc_proto)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("MethodScopes", func() {
+	It("declares the scopes of every method", func() {
+		server := grpc.NewServer()
+		server.RegisterService(&pb.Video_ServiceDesc, nil)
+
+		Expect(MethodScopes().Check(server.GetServiceInfo())).To(Succeed())
+	})
+
+	It("declares the scopes by the authz.scope option", func() {
+		scopes := MethodScopes()
+		Expect(scopes).To(HaveKeyWithValue("/video.pb.Video/Healthz", grpckit.ScopePublic))
+		Expect(scopes).To(HaveKeyWithValue("/video.pb.Video/GetVideo", ScopeRead))
+		Expect(scopes).To(HaveKeyWithValue("/video.pb.Video/UploadVideo", ScopeWrite))
+	})
+})
@@ -2,21 +2,27 @@ package service
 
 import (
 	"bufio"
-	"bytes"
 	"context"
-	"errors"
-	"io"
+	"math/rand"
+	"net/url"
 	"path"
+	"strings"
+	"time"
 
-	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"google.golang.org/protobuf/proto"
 )
 
+// MediaPath is the route of the gateway serving the video objects of the signed URLs, e.g. /v1/media/{object}
+const MediaPath = "/v1/media/"
+
 type service struct {
 	pb.UnimplementedVideoServer
 
@@ -24,15 +30,49 @@ type service struct {
 	storage       storagekit.Storage
 	commentClient commentpb.CommentClient
 	producer      kafkakit.Producer
+	signer        *httpkit.URLSigner
+
+	pollDAO     dao.PollDAO
+	pollVoteDAO dao.PollVoteDAO
+
+	thumbnailStatsDAO dao.ThumbnailStatsDAO
+	// random returns a random number in [0, 1) to choose the thumbnails by
+	random func() float64
+
+	watchHistoryDAO     dao.WatchHistoryDAO
+	watchProgressPubSub dao.WatchProgressPubSub
+
+	userSettingsDAO dao.UserSettingsDAO
+
+	claimDAO dao.ClaimDAO
+
+	pageTokens *pagekit.Codec
+}
+
+type ServiceOption func(s *service)
+
+// WithURLSigner returns the URLs of the videos and their variants as the signed URLs of the media route
+// of the gateway, which expire, instead of the URLs of the storage. It is a no-op if the signer is nil.
+func WithURLSigner(signer *httpkit.URLSigner) ServiceOption {
+	return func(s *service) {
+		s.signer = signer
+	}
 }
 
-func NewService(videoDAO dao.VideoDAO, storage storagekit.Storage, commentClient commentpb.CommentClient, producer kafkakit.Producer) *service {
-	return &service{
+func NewService(videoDAO dao.VideoDAO, storage storagekit.Storage, commentClient commentpb.CommentClient, producer kafkakit.Producer, opts ...ServiceOption) *service {
+	s := &service{
 		videoDAO:      videoDAO,
 		storage:       storage,
 		commentClient: commentClient,
 		producer:      producer,
+		random:        rand.Float64,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *service) Healthz(ctx context.Context, req *pb.HealthzRequest) (*pb.HealthzResponse, error) {
@@ -47,63 +87,130 @@ func (s *service) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*pb.Ge
 
 	video, err := s.videoDAO.Get(ctx, id)
 	if err != nil {
-		if errors.Is(err, dao.ErrVideoNotFound) {
-			return nil, ErrVideoNotFound
-		}
+		return nil, err
+	}
 
+	if video.Blocked() {
+		return nil, ErrVideoBlocked
+	}
+
+	restricted, err := s.restrictedMode(ctx)
+	if err != nil {
 		return nil, err
 	}
+	if restricted && video.AgeRating.RestrictedInRestrictedMode() {
+		return nil, ErrVideoRestricted
+	}
 
-	return &pb.GetVideoResponse{Video: video.ToProto()}, nil
+	return &pb.GetVideoResponse{Video: s.toProto(video)}, nil
 }
 
+// ListVideo lists the videos, the videos blocked by the copyright claims and the videos restricted in restricted mode
+// are left out of the page, so the page may have fewer videos than the page size.
 func (s *service) ListVideo(ctx context.Context, req *pb.ListVideoRequest) (*pb.ListVideoResponse, error) {
-	videos, err := s.videoDAO.List(ctx, req.GetLimit(), req.GetSkip())
+	// the clients before the page tokens page by the deprecated limit and skip, and so do all the clients if the
+	// page tokens are not configured
+	if s.pageTokens == nil || (req.GetPageToken() == "" && (req.GetLimit() != 0 || req.GetSkip() != 0)) {
+		return s.listVideoByOffset(ctx, req)
+	}
+
+	filter := videosPageTokenFilter(tenantkit.FromContext(ctx))
+
+	skip, err := s.decodeSkipPageToken(req.GetPageToken(), filter)
 	if err != nil {
 		return nil, err
 	}
 
-	pbVideos := make([]*pb.VideoInfo, 0, len(videos))
-	for _, video := range videos {
-		pbVideos = append(pbVideos, video.ToProto())
+	limit := pageSize(req.GetPageSize())
+
+	// list one more video to know whether there is a next page
+	videos, err := s.videoDAO.List(ctx, limit+1, skip)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.ListVideoResponse{Videos: pbVideos}, nil
+	resp := &pb.ListVideoResponse{}
+
+	if int64(len(videos)) > limit {
+		videos = videos[:limit]
+
+		if resp.NextPageToken, err = s.encodeSkipPageToken(filter, skip+limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Videos, err = s.listedVideos(ctx, videos); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
-func (s *service) UploadVideo(stream pb.Video_UploadVideoServer) error {
-	ctx := stream.Context()
+// listVideoByOffset lists the videos by the limit and skip, or the first page of the page size if the limit is unset,
+// and returns no next page token. The page tokens are not issued then, so a page token is invalid.
+func (s *service) listVideoByOffset(ctx context.Context, req *pb.ListVideoRequest) (*pb.ListVideoResponse, error) {
+	if req.GetPageToken() != "" {
+		return nil, ErrInvalidPageToken
+	}
 
-	req, err := stream.Recv()
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = pageSize(req.GetPageSize())
+	}
+
+	videos, err := s.videoDAO.List(ctx, limit, req.GetSkip())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	filename := req.GetHeader().GetFilename()
-	size := req.GetHeader().GetSize()
+	pbVideos, err := s.listedVideos(ctx, videos)
+	if err != nil {
+		return nil, err
+	}
 
-	var buf bytes.Buffer
+	return &pb.ListVideoResponse{Videos: pbVideos}, nil
+}
 
-	for {
-		req, err := stream.Recv()
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
+// listedVideos returns the videos listed to the user, leaving out the videos blocked and the videos restricted.
+func (s *service) listedVideos(ctx context.Context, videos []*dao.Video) ([]*pb.VideoInfo, error) {
+	restricted, err := s.restrictedMode(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-			return err
+	pbVideos := make([]*pb.VideoInfo, 0, len(videos))
+	for _, video := range videos {
+		if video.Blocked() || (restricted && video.AgeRating.RestrictedInRestrictedMode()) {
+			continue
 		}
 
-		chunk := req.GetChunkData()
-		if _, err := buf.Write(chunk); err != nil {
-			return err
-		}
+		pbVideos = append(pbVideos, s.toProto(video))
 	}
 
+	return pbVideos, nil
+}
+
+func (s *service) UploadVideo(stream pb.Video_UploadVideoServer) error {
+	ctx := stream.Context()
+
+	header, buf, err := receiveUpload(stream)
+	if err != nil {
+		return err
+	}
+
+	filename := header.GetFilename()
+	size := header.GetSize()
+
 	id := primitive.NewObjectID()
 	objectName := id.Hex() + "-" + filename
 
-	if err := s.storage.PutObject(ctx, objectName, bufio.NewReader(&buf), int64(size), storagekit.PutObjectOptions{
+	// the videos of the tenants pinned to the other regions are kept in the storages of their regions
+	storage, err := storagekit.StorageOf(ctx, s.storage)
+	if err != nil {
+		return err
+	}
+
+	if err := storage.PutObject(ctx, objectName, bufio.NewReader(buf), int64(size), storagekit.PutObjectOptions{
 		ContentType: "application/octet-stream",
 	}); err != nil {
 		return err
@@ -112,7 +219,7 @@ func (s *service) UploadVideo(stream pb.Video_UploadVideoServer) error {
 	video := &dao.Video{
 		ID:     id,
 		Size:   size,
-		URL:    path.Join(s.storage.Endpoint(), s.storage.Bucket(), objectName),
+		URL:    path.Join(storage.Endpoint(), storage.Bucket(), objectName),
 		Status: dao.VideoStatusUploaded,
 	}
 
@@ -120,9 +227,10 @@ func (s *service) UploadVideo(stream pb.Video_UploadVideoServer) error {
 		return err
 	}
 
-	if err := s.produceVideoCreatedEvent(&pb.HandleVideoCreatedRequest{
-		Id:  id.Hex(),
-		Url: path.Join(s.storage.Endpoint(), s.storage.Bucket(), objectName),
+	if err := s.produceVideoCreatedEvent(ctx, &pb.HandleVideoCreatedRequest{
+		Id:       id.Hex(),
+		Url:      path.Join(storage.Endpoint(), storage.Bucket(), objectName),
+		TenantId: tenantkit.FromContext(ctx),
 	}); err != nil {
 		return err
 	}
@@ -143,10 +251,6 @@ func (s *service) DeleteVideo(ctx context.Context, req *pb.DeleteVideoRequest) (
 	}
 
 	if err := s.videoDAO.Delete(ctx, id); err != nil {
-		if errors.Is(err, dao.ErrVideoNotFound) {
-			return nil, ErrVideoNotFound
-		}
-
 		return nil, err
 	}
 
@@ -159,17 +263,63 @@ func (s *service) DeleteVideo(ctx context.Context, req *pb.DeleteVideoRequest) (
 	return &pb.DeleteVideoResponse{}, nil
 }
 
-func (s *service) produceVideoCreatedEvent(req *pb.HandleVideoCreatedRequest) error {
-	valueBytes, err := proto.Marshal(req)
+// toProto returns the video with a thumbnail chosen by the weights and the signed URLs if the URLs are signed,
+// the URLs of the video are hidden until it premieres.
+func (s *service) toProto(video *dao.Video) *pb.VideoInfo {
+	info := video.ToProto()
+	if thumbnail := chooseThumbnail(video.Thumbnails, s.random()); thumbnail != nil {
+		info.ThumbnailId = thumbnail.ID.Hex()
+		info.ThumbnailUrl = thumbnail.URL
+	}
+
+	if video.PremiereState(time.Now()) == dao.PremiereStateScheduled {
+		info.Url = ""
+		info.Variants = nil
+	}
+
+	if s.signer == nil {
+		return info
+	}
+
+	info.Url = s.signURL(info.Url)
+	info.ThumbnailUrl = s.signURL(info.ThumbnailUrl)
+
+	// the variants are copied, since the map is shared with the video of the DAO
+	variants := make(map[string]string, len(info.Variants))
+	for variant, u := range info.Variants {
+		variants[variant] = s.signURL(u)
+	}
+	info.Variants = variants
+
+	return info
+}
+
+// signURL returns the signed URL of the media route serving the object of the storage URL,
+// the URLs not of the storage are returned as is, so are the URLs of the storages of the other regions, since the
+// media route serves the home storage only.
+func (s *service) signURL(storageURL string) string {
+	objectName := strings.TrimPrefix(storageURL, path.Join(s.storage.Endpoint(), s.storage.Bucket())+"/")
+	if objectName == storageURL {
+		return storageURL
+	}
+
+	signed, err := s.signer.Sign(s.signer.BaseURL() + MediaPath + url.PathEscape(objectName))
 	if err != nil {
-		return err
+		return storageURL
 	}
 
-	msgs := []*kafkakit.ProducerMessage{
-		{Value: valueBytes},
+	return signed
+}
+
+func (s *service) produceVideoCreatedEvent(ctx context.Context, req *pb.HandleVideoCreatedRequest) error {
+	msg, err := pb.HandleVideoCreatedSchema.Marshal(nil, req)
+	if err != nil {
+		return err
 	}
 
-	if err := s.producer.SendMessages(msgs); err != nil {
+	msgs := []*kafkakit.ProducerMessage{msg}
+
+	if err := s.producer.SendMessages(ctx, msgs); err != nil {
 		return err
 	}
 
@@ -4,16 +4,23 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	commentpbmock "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/mock/pbmock"
-	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb"
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/daomock"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/httpkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit/mock/kafkamock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit/mock/storagemock"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -30,6 +37,14 @@ var (
 	errDAOUnknown = errors.New("unknown DAO error")
 )
 
+// newFakePageTokens returns the codec of the page tokens of the tests.
+func newFakePageTokens() *pagekit.Codec {
+	return pagekit.NewCodec(logkit.NewNopLogger().WithContext(context.Background()), &pagekit.Config{
+		Secret: "fake secret of the page tokens",
+		TTL:    time.Hour,
+	})
+}
+
 var _ = Describe("Service", func() {
 	var (
 		controller    *gomock.Controller
@@ -90,7 +105,7 @@ var _ = Describe("Service", func() {
 
 			It("returns video not found error", func() {
 				Expect(resp).To(BeNil())
-				Expect(err).To(MatchError(ErrVideoNotFound))
+				Expect(err).To(MatchError(dao.ErrVideoNotFound))
 			})
 		})
 
@@ -109,6 +124,42 @@ var _ = Describe("Service", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		When("the URLs are signed", func() {
+			var (
+				video  *dao.Video
+				signer *httpkit.URLSigner
+			)
+
+			BeforeEach(func() {
+				signer = httpkit.NewURLSigner(logkit.NewNopLogger().WithContext(ctx), &httpkit.SignedURLConfig{
+					Keys:    []string{"key-1=secret"},
+					Expiry:  time.Hour,
+					BaseURL: "https://video.example.com",
+				})
+				svc = NewService(videoDAO, storage, commentClient, producer, WithURLSigner(signer))
+
+				storage.EXPECT().Endpoint().Return("minio:9000").AnyTimes()
+				storage.EXPECT().Bucket().Return("videos").AnyTimes()
+
+				video = dao.NewFakeVideo()
+				video.URL = "minio:9000/videos/" + id.Hex() + "-video.mp4"
+				videoDAO.EXPECT().Get(ctx, id).Return(video, nil)
+			})
+
+			It("returns the signed URLs of the storage objects", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				u, err := url.Parse(resp.GetVideo().GetUrl())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(u.Host).To(Equal("video.example.com"))
+				Expect(u.Path).To(Equal(MediaPath + id.Hex() + "-video.mp4"))
+				Expect(signer.Verify(u)).To(Succeed())
+
+				// the URLs not of the storage are returned as is
+				Expect(resp.GetVideo().GetVariants()).To(Equal(video.Variants))
+			})
+		})
 	})
 
 	Describe("ListVideo", func() {
@@ -119,7 +170,8 @@ var _ = Describe("Service", func() {
 		)
 
 		BeforeEach(func() {
-			req = &pb.ListVideoRequest{Limit: 10, Skip: 0}
+			svc = NewService(videoDAO, storage, commentClient, producer, WithPageTokens(newFakePageTokens()))
+			req = &pb.ListVideoRequest{PageSize: 2}
 		})
 
 		JustBeforeEach(func() {
@@ -128,7 +180,7 @@ var _ = Describe("Service", func() {
 
 		When("DAO error", func() {
 			BeforeEach(func() {
-				videoDAO.EXPECT().List(ctx, req.GetLimit(), req.GetSkip()).Return(nil, errDAOUnknown)
+				videoDAO.EXPECT().List(ctx, int64(3), int64(0)).Return(nil, errDAOUnknown)
 			})
 
 			It("returns the error", func() {
@@ -142,7 +194,7 @@ var _ = Describe("Service", func() {
 
 			BeforeEach(func() {
 				videos = []*dao.Video{dao.NewFakeVideo(), dao.NewFakeVideo()}
-				videoDAO.EXPECT().List(ctx, req.GetLimit(), req.GetSkip()).Return(videos, nil)
+				videoDAO.EXPECT().List(ctx, int64(3), int64(0)).Return(videos, nil)
 			})
 
 			It("returns videos with no error", func() {
@@ -155,10 +207,98 @@ var _ = Describe("Service", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		When("there are more videos", func() {
+			var videos []*dao.Video
+
+			BeforeEach(func() {
+				videos = []*dao.Video{dao.NewFakeVideo(), dao.NewFakeVideo(), dao.NewFakeVideo(), dao.NewFakeVideo()}
+				videoDAO.EXPECT().List(ctx, int64(3), int64(0)).Return(videos[:3], nil)
+				videoDAO.EXPECT().List(ctx, int64(3), int64(2)).Return(videos[2:], nil)
+			})
+
+			It("lists the next page by the page token", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetVideos()).To(Equal([]*pb.VideoInfo{videos[0].ToProto(), videos[1].ToProto()}))
+				Expect(resp.GetNextPageToken()).NotTo(BeEmpty())
+
+				resp, err = svc.ListVideo(ctx, &pb.ListVideoRequest{PageSize: 2, PageToken: resp.GetNextPageToken()})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetVideos()).To(Equal([]*pb.VideoInfo{videos[2].ToProto(), videos[3].ToProto()}))
+				Expect(resp.GetNextPageToken()).To(BeEmpty())
+			})
+		})
+
+		When("the page token is of another tenant", func() {
+			BeforeEach(func() {
+				token, err := svc.encodeSkipPageToken(videosPageTokenFilter("another-tenant"), 2)
+				Expect(err).NotTo(HaveOccurred())
+
+				req.PageToken = token
+			})
+
+			It("returns ErrInvalidPageToken", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidPageToken))
+			})
+		})
+
+		When("the limit and skip are set", func() {
+			var videos []*dao.Video
+
+			BeforeEach(func() {
+				videos = []*dao.Video{dao.NewFakeVideo(), dao.NewFakeVideo()}
+				videoDAO.EXPECT().List(ctx, int64(2), int64(4)).Return(videos, nil)
+
+				req = &pb.ListVideoRequest{Limit: 2, Skip: 4}
+			})
+
+			It("lists the videos by the offset", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(Equal(&pb.ListVideoResponse{
+					Videos: []*pb.VideoInfo{
+						videos[0].ToProto(),
+						videos[1].ToProto(),
+					},
+				}))
+			})
+		})
+
+		When("the page tokens are not configured", func() {
+			var videos []*dao.Video
+
+			BeforeEach(func() {
+				svc = NewService(videoDAO, storage, commentClient, producer)
+
+				videos = []*dao.Video{dao.NewFakeVideo(), dao.NewFakeVideo()}
+				videoDAO.EXPECT().List(ctx, int64(2), int64(0)).Return(videos, nil)
+			})
+
+			It("lists the first page of the page size without a page token", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetVideos()).To(HaveLen(2))
+				Expect(resp.GetNextPageToken()).To(BeEmpty())
+			})
+
+			It("lists the videos by the skip", func() {
+				videoDAO.EXPECT().List(ctx, int64(2), int64(2)).Return(videos, nil)
+
+				resp, err = svc.ListVideo(ctx, &pb.ListVideoRequest{PageSize: 2, Skip: 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetVideos()).To(HaveLen(2))
+			})
+
+			It("returns ErrInvalidPageToken for a page token", func() {
+				resp, err = svc.ListVideo(ctx, &pb.ListVideoRequest{PageSize: 2, PageToken: "token"})
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidPageToken))
+			})
+		})
 	})
 
 	Describe("UploadVideo", func() {
 		var stream *pbmock.MockVideo_UploadVideoServer
+		var sentMsgs []*kafkakit.ProducerMessage
 		var err error
 
 		BeforeEach(func() {
@@ -209,7 +349,10 @@ var _ = Describe("Service", func() {
 
 				videoDAO.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 
-				producer.EXPECT().SendMessages(gomock.Any()).Return(nil)
+				producer.EXPECT().SendMessages(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, msgs []*kafkakit.ProducerMessage) error {
+					sentMsgs = msgs
+					return nil
+				})
 
 				stream.EXPECT().SendAndClose(gomock.Any()).Return(nil)
 			})
@@ -217,6 +360,15 @@ var _ = Describe("Service", func() {
 			It("returns no error", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			It("produces the video created event with schema headers", func() {
+				Expect(sentMsgs).To(HaveLen(1))
+				Expect(sentMsgs[0].Headers).To(Equal(map[string]string{
+					eventkit.HeaderEventType:                 "video.pb.HandleVideoCreatedRequest",
+					eventkit.HeaderEventVersion:              "2",
+					eventkit.HeaderEventMinCompatibleVersion: "1",
+				}))
+			})
 		})
 	})
 
@@ -255,7 +407,7 @@ var _ = Describe("Service", func() {
 
 			It("returns video not found error", func() {
 				Expect(resp).To(BeNil())
-				Expect(err).To(MatchError(ErrVideoNotFound))
+				Expect(err).To(MatchError(dao.ErrVideoNotFound))
 			})
 		})
 
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MaxThumbnails is the maximum number of the thumbnail candidates of a video
+const MaxThumbnails = 5
+
+// WithThumbnailStats records the events of the thumbnail candidates by the DAO for the experiment results.
+// It is a no-op if the DAO is nil.
+func WithThumbnailStats(thumbnailStatsDAO dao.ThumbnailStatsDAO) ServiceOption {
+	return func(s *service) {
+		if thumbnailStatsDAO != nil {
+			s.thumbnailStatsDAO = thumbnailStatsDAO
+		}
+	}
+}
+
+func (s *service) UploadThumbnail(ctx context.Context, req *pb.UploadThumbnailRequest) (*pb.UploadThumbnailResponse, error) {
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.Get(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// fail fast before the upload, the limit is guarded again when the thumbnail is added
+	if len(video.Thumbnails) >= MaxThumbnails {
+		return nil, ErrTooManyThumbnails
+	}
+
+	thumbnailID := primitive.NewObjectID()
+	objectName := videoID.Hex() + "-thumbnail-" + thumbnailID.Hex()
+
+	storage, err := storagekit.StorageOf(ctx, s.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.PutObject(ctx, objectName, bytes.NewReader(req.GetImage()), int64(len(req.GetImage())), storagekit.PutObjectOptions{
+		ContentType: req.GetContentType(),
+	}); err != nil {
+		return nil, err
+	}
+
+	thumbnail := &dao.Thumbnail{
+		ID:     thumbnailID,
+		URL:    path.Join(storage.Endpoint(), storage.Bucket(), objectName),
+		Weight: req.GetWeight(),
+	}
+
+	if _, err := s.videoDAO.AddThumbnail(ctx, videoID, thumbnail, MaxThumbnails); err != nil {
+		// the candidates are filled by the concurrent uploads, the image is left in the storage if it fails to be deleted
+		if errors.Is(err, dao.ErrTooManyThumbnails) {
+			_ = storage.DeleteObject(ctx, objectName)
+		}
+		return nil, err
+	}
+
+	return &pb.UploadThumbnailResponse{Thumbnail: s.thumbnailToProto(thumbnail)}, nil
+}
+
+func (s *service) RecordThumbnailEvent(ctx context.Context, req *pb.RecordThumbnailEventRequest) (*pb.RecordThumbnailEventResponse, error) {
+	if s.thumbnailStatsDAO == nil {
+		return nil, ErrThumbnailExperimentsDisabled
+	}
+
+	video, thumbnailID, err := s.getThumbnailVideo(ctx, req.GetVideoId(), req.GetThumbnailId())
+	if err != nil {
+		return nil, err
+	}
+
+	event := dao.ThumbnailEventImpression
+	if req.GetEvent() == pb.ThumbnailEvent_THUMBNAIL_EVENT_CLICK {
+		event = dao.ThumbnailEventClick
+	}
+
+	if err := s.thumbnailStatsDAO.Record(ctx, video.ID, thumbnailID, event); err != nil {
+		return nil, err
+	}
+
+	return &pb.RecordThumbnailEventResponse{}, nil
+}
+
+func (s *service) GetThumbnailExperimentResults(ctx context.Context, req *pb.GetThumbnailExperimentResultsRequest) (*pb.GetThumbnailExperimentResultsResponse, error) {
+	if s.thumbnailStatsDAO == nil {
+		return nil, ErrThumbnailExperimentsDisabled
+	}
+
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.Get(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.thumbnailStatsDAO.Stats(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.ThumbnailExperimentResult, 0, len(video.Thumbnails))
+	for _, thumbnail := range video.Thumbnails {
+		result := &pb.ThumbnailExperimentResult{Thumbnail: s.thumbnailToProto(thumbnail)}
+		if stat, ok := stats[thumbnail.ID]; ok {
+			result.Impressions = stat.Impressions
+			result.Clicks = stat.Clicks
+			result.Ctr = stat.CTR()
+		}
+
+		results = append(results, result)
+	}
+
+	return &pb.GetThumbnailExperimentResultsResponse{Results: results}, nil
+}
+
+// getThumbnailVideo returns the video and the ID of its thumbnail candidate, the events of the thumbnails not of the
+// video are not recorded.
+func (s *service) getThumbnailVideo(ctx context.Context, videoID, thumbnailID string) (*dao.Video, primitive.ObjectID, error) {
+	vid, err := primitive.ObjectIDFromHex(videoID)
+	if err != nil {
+		return nil, primitive.NilObjectID, ErrInvalidObjectID
+	}
+
+	tid, err := primitive.ObjectIDFromHex(thumbnailID)
+	if err != nil {
+		return nil, primitive.NilObjectID, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.Get(ctx, vid)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	for _, thumbnail := range video.Thumbnails {
+		if thumbnail.ID == tid {
+			return video, tid, nil
+		}
+	}
+
+	return nil, primitive.NilObjectID, ErrThumbnailNotFound
+}
+
+// thumbnailToProto returns the thumbnail with the signed URL if the URLs are signed.
+func (s *service) thumbnailToProto(thumbnail *dao.Thumbnail) *pb.Thumbnail {
+	info := thumbnail.ToProto()
+	if s.signer != nil {
+		info.Url = s.signURL(info.Url)
+	}
+
+	return info
+}
+
+// chooseThumbnail chooses a thumbnail candidate by the random number in [0, 1), where each candidate is chosen by
+// the chance of its weight over the total weight. It returns nil if there is no candidate.
+func chooseThumbnail(thumbnails []*dao.Thumbnail, random float64) *dao.Thumbnail {
+	var total uint64
+	for _, thumbnail := range thumbnails {
+		total += uint64(thumbnail.Weight)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	target := uint64(random * float64(total))
+	for _, thumbnail := range thumbnails {
+		if target < uint64(thumbnail.Weight) {
+			return thumbnail
+		}
+		target -= uint64(thumbnail.Weight)
+	}
+
+	// the random number rounds up to the total
+	return thumbnails[len(thumbnails)-1]
+}
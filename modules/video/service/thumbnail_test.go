@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit/mock/storagemock"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("Thumbnails", func() {
+	var (
+		controller *gomock.Controller
+		videoDAO   dao.VideoDAO
+		storage    *storagemock.MockStorage
+		svc        *service
+		ctx        context.Context
+		video      *dao.Video
+		random     float64
+	)
+
+	BeforeEach(func() {
+		controller = gomock.NewController(GinkgoT())
+		videoDAO = dao.NewMemoryVideoDAO()
+		storage = storagemock.NewMockStorage(controller)
+		storage.EXPECT().Endpoint().Return("minio:9000").AnyTimes()
+		storage.EXPECT().Bucket().Return("videos").AnyTimes()
+		svc = NewService(videoDAO, storage, nil, nil, WithThumbnailStats(dao.NewMemoryThumbnailStatsDAO()))
+		svc.random = func() float64 { return random }
+		ctx = context.Background()
+
+		video = dao.NewFakeVideo()
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		controller.Finish()
+	})
+
+	upload := func(weight uint32) *pb.Thumbnail {
+		storage.EXPECT().PutObject(ctx, gomock.Any(), gomock.Any(), int64(3), storagekit.PutObjectOptions{ContentType: "image/png"}).Return(nil)
+
+		resp, err := svc.UploadThumbnail(ctx, &pb.UploadThumbnailRequest{
+			VideoId:     video.ID.Hex(),
+			Image:       []byte("png"),
+			ContentType: "image/png",
+			Weight:      weight,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		return resp.GetThumbnail()
+	}
+
+	record := func(thumbnail *pb.Thumbnail, event pb.ThumbnailEvent) error {
+		_, err := svc.RecordThumbnailEvent(ctx, &pb.RecordThumbnailEventRequest{
+			VideoId:     video.ID.Hex(),
+			ThumbnailId: thumbnail.GetId(),
+			Event:       event,
+		})
+		return err
+	}
+
+	It("chooses the thumbnails by their weights", func() {
+		first := upload(1)
+		second := upload(3)
+		Expect(second.GetUrl()).To(Equal("minio:9000/videos/" + video.ID.Hex() + "-thumbnail-" + second.GetId()))
+
+		for r, thumbnail := range map[float64]*pb.Thumbnail{0: first, 0.24: first, 0.25: second, 0.99: second} {
+			random = r
+
+			resp, err := svc.GetVideo(ctx, &pb.GetVideoRequest{Id: video.ID.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetVideo().GetThumbnailId()).To(Equal(thumbnail.GetId()))
+			Expect(resp.GetVideo().GetThumbnailUrl()).To(Equal(thumbnail.GetUrl()))
+		}
+	})
+
+	It("refuses the thumbnails over MaxThumbnails", func() {
+		for i := 0; i < MaxThumbnails; i++ {
+			upload(1)
+		}
+
+		_, err := svc.UploadThumbnail(ctx, &pb.UploadThumbnailRequest{
+			VideoId:     video.ID.Hex(),
+			Image:       []byte("png"),
+			ContentType: "image/png",
+			Weight:      1,
+		})
+		Expect(err).To(MatchError(ErrTooManyThumbnails))
+	})
+
+	It("deletes the image of the thumbnail refused after the concurrent uploads", func() {
+		stale := *video
+		svc = NewService(&staleVideoDAO{VideoDAO: videoDAO, video: &stale}, storage, nil, nil)
+
+		for i := 0; i < MaxThumbnails; i++ {
+			upload(1)
+		}
+
+		storage.EXPECT().PutObject(ctx, gomock.Any(), gomock.Any(), int64(3), gomock.Any()).Return(nil)
+		storage.EXPECT().DeleteObject(ctx, gomock.Any()).Return(nil)
+
+		_, err := svc.UploadThumbnail(ctx, &pb.UploadThumbnailRequest{
+			VideoId:     video.ID.Hex(),
+			Image:       []byte("png"),
+			ContentType: "image/png",
+			Weight:      1,
+		})
+		Expect(err).To(MatchError(dao.ErrTooManyThumbnails))
+
+		got, err := videoDAO.Get(ctx, video.ID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Thumbnails).To(HaveLen(MaxThumbnails))
+	})
+
+	It("returns the CTR of each thumbnail", func() {
+		first := upload(1)
+		second := upload(1)
+
+		Expect(record(first, pb.ThumbnailEvent_THUMBNAIL_EVENT_IMPRESSION)).To(Succeed())
+		Expect(record(first, pb.ThumbnailEvent_THUMBNAIL_EVENT_IMPRESSION)).To(Succeed())
+		Expect(record(first, pb.ThumbnailEvent_THUMBNAIL_EVENT_CLICK)).To(Succeed())
+
+		resp, err := svc.GetThumbnailExperimentResults(ctx, &pb.GetThumbnailExperimentResultsRequest{VideoId: video.ID.Hex()})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetResults()).To(HaveLen(2))
+
+		Expect(resp.GetResults()[0].GetThumbnail().GetId()).To(Equal(first.GetId()))
+		Expect(resp.GetResults()[0].GetImpressions()).To(BeEquivalentTo(2))
+		Expect(resp.GetResults()[0].GetClicks()).To(BeEquivalentTo(1))
+		Expect(resp.GetResults()[0].GetCtr()).To(Equal(0.5))
+
+		Expect(resp.GetResults()[1].GetThumbnail().GetId()).To(Equal(second.GetId()))
+		Expect(resp.GetResults()[1].GetCtr()).To(BeZero())
+	})
+
+	It("returns thumbnail not found error for the thumbnail not of the video", func() {
+		Expect(record(&pb.Thumbnail{Id: primitive.NewObjectID().Hex()}, pb.ThumbnailEvent_THUMBNAIL_EVENT_CLICK)).To(MatchError(ErrThumbnailNotFound))
+	})
+})
+
+// staleVideoDAO returns the video read before the concurrent updates, as a replica lagging behind does.
+type staleVideoDAO struct {
+	dao.VideoDAO
+	video *dao.Video
+}
+
+func (d *staleVideoDAO) Get(ctx context.Context, id primitive.ObjectID) (*dao.Video, error) {
+	return d.video, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+)
+
+// receiveUpload receives the video of the upload stream, which is the header followed by the chunks of the video.
+// The upload is rejected as soon as it breaks the order or its chunks exceed the size of the header, so a client
+// cannot make the server buffer more than it declared.
+func receiveUpload(stream pb.Video_UploadVideoServer) (*pb.VideoHeader, *bytes.Buffer, error) {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, ErrUploadHeaderMissing
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := req.GetHeader()
+	if header == nil {
+		return nil, nil, ErrUploadHeaderMissing
+	}
+
+	// the filename is a part of the object name, so it must not escape the bucket
+	if filename := header.GetFilename(); filename == "" || strings.ContainsAny(filename, `/\`) {
+		return nil, nil, ErrInvalidFilename
+	}
+
+	var buf bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if req.GetHeader() != nil {
+			return nil, nil, ErrUploadHeaderRepeated
+		}
+
+		chunk := req.GetChunkData()
+		if uint64(buf.Len())+uint64(len(chunk)) > header.GetSize() {
+			return nil, nil, ErrUploadSizeMismatch
+		}
+		buf.Write(chunk)
+	}
+
+	if uint64(buf.Len()) != header.GetSize() {
+		return nil, nil, ErrUploadSizeMismatch
+	}
+
+	return header, &buf, nil
+}
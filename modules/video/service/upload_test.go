@@ -0,0 +1,142 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fuzzkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+var errFakeStream = errors.New("fake stream error")
+
+// fakeUploadStream receives the requests, then io.EOF, or the error if set.
+type fakeUploadStream struct {
+	pb.Video_UploadVideoServer
+
+	reqs []*pb.UploadVideoRequest
+	err  error
+}
+
+func (s *fakeUploadStream) Recv() (*pb.UploadVideoRequest, error) {
+	if len(s.reqs) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func headerReq(filename string, size uint64) *pb.UploadVideoRequest {
+	return &pb.UploadVideoRequest{Data: &pb.UploadVideoRequest_Header{Header: &pb.VideoHeader{Filename: filename, Size: size}}}
+}
+
+func chunkReq(chunk string) *pb.UploadVideoRequest {
+	return &pb.UploadVideoRequest{Data: &pb.UploadVideoRequest_ChunkData{ChunkData: []byte(chunk)}}
+}
+
+// encodeUpload encodes the requests of an upload as length-delimited messages, the fuzzing mutates the encoded uploads.
+func encodeUpload(reqs ...*pb.UploadVideoRequest) []byte {
+	var b []byte
+	for _, req := range reqs {
+		msg, err := proto.Marshal(req)
+		Expect(err).NotTo(HaveOccurred())
+		b = protowire.AppendBytes(b, msg)
+	}
+
+	return b
+}
+
+// decodeUpload decodes the length-delimited messages into the stream of the upload, the stream fails where
+// a message is malformed as the gRPC streams do.
+func decodeUpload(b []byte) *fakeUploadStream {
+	stream := &fakeUploadStream{}
+	for len(b) > 0 {
+		msg, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			stream.err = errFakeStream
+			break
+		}
+		b = b[n:]
+
+		req := &pb.UploadVideoRequest{}
+		if err := proto.Unmarshal(msg, req); err != nil {
+			stream.err = errFakeStream
+			break
+		}
+		stream.reqs = append(stream.reqs, req)
+	}
+
+	return stream
+}
+
+var _ = Describe("receiveUpload", func() {
+	It("receives the header and the chunks", func() {
+		header, buf, err := receiveUpload(&fakeUploadStream{reqs: []*pb.UploadVideoRequest{
+			headerReq("video.mp4", 6), chunkReq("abc"), chunkReq(""), chunkReq("def"),
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.GetFilename()).To(Equal("video.mp4"))
+		Expect(buf.String()).To(Equal("abcdef"))
+	})
+
+	DescribeTable("rejects the uploads breaking the protocol",
+		func(stream *fakeUploadStream, expected error) {
+			_, _, err := receiveUpload(stream)
+			Expect(err).To(MatchError(expected))
+		},
+		Entry("no message", &fakeUploadStream{}, ErrUploadHeaderMissing),
+		Entry("chunk first", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{chunkReq("abc")}}, ErrUploadHeaderMissing),
+		Entry("repeated header", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{headerReq("video.mp4", 3), headerReq("video.mp4", 3)}}, ErrUploadHeaderRepeated),
+		Entry("path in filename", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{headerReq("../video.mp4", 3)}}, ErrInvalidFilename),
+		Entry("chunks exceeding size", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{headerReq("video.mp4", 2), chunkReq("abc")}}, ErrUploadSizeMismatch),
+		Entry("chunks short of size", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{headerReq("video.mp4", 4), chunkReq("abc")}}, ErrUploadSizeMismatch),
+		Entry("stream error", &fakeUploadStream{reqs: []*pb.UploadVideoRequest{headerReq("video.mp4", 3)}, err: errFakeStream}, errFakeStream),
+	)
+
+	It("receives nothing but the uploads following the protocol when fuzzed", func() {
+		corpus := [][]byte{
+			encodeUpload(headerReq("video.mp4", 6), chunkReq("abc"), chunkReq("def")),
+			encodeUpload(headerReq("empty.mp4", 0)),
+			encodeUpload(headerReq("video.mp4", 3), chunkReq("abc"), headerReq("other.mp4", 3)),
+		}
+		dict := [][]byte{
+			encodeUpload(headerReq("video.mp4", 3)),
+			encodeUpload(chunkReq("abc")),
+			[]byte("/"), []byte(`\`),
+		}
+
+		fuzzkit.Fuzz(corpus, dict, func(input []byte) {
+			stream := decodeUpload(input)
+			reqs := stream.reqs
+
+			header, buf, err := receiveUpload(stream)
+			if err != nil {
+				Expect(err).To(BeElementOf(ErrUploadHeaderMissing, ErrUploadHeaderRepeated, ErrUploadSizeMismatch, ErrInvalidFilename, errFakeStream), "input %x", input)
+				return
+			}
+
+			// the upload received is the header followed by the chunks adding up to its size
+			Expect(reqs[0].GetHeader()).To(BeIdenticalTo(header), "input %x", input)
+			Expect(header.GetFilename()).NotTo(Or(BeEmpty(), ContainSubstring("/"), ContainSubstring(`\`)), "input %x", input)
+
+			var chunks bytes.Buffer
+			for _, req := range reqs[1:] {
+				Expect(req.GetHeader()).To(BeNil(), "input %x", input)
+				chunks.Write(req.GetChunkData())
+			}
+			Expect(buf.String()).To(Equal(chunks.String()), "input %x", input)
+			Expect(uint64(buf.Len())).To(Equal(header.GetSize()), "input %x", input)
+		})
+	})
+})
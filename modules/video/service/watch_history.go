@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultWatchHistoryLimit is the number of the videos listed by ListWatchHistory by default
+	DefaultWatchHistoryLimit = 20
+	// DefaultWatchHistoryFlushSchedule is the schedule the hot watch history is flushed by by default
+	DefaultWatchHistoryFlushSchedule = "@every 30s"
+)
+
+// WithWatchHistory records the watch history of the users by the DAO. It is a no-op if the DAO is nil.
+func WithWatchHistory(watchHistoryDAO dao.WatchHistoryDAO) ServiceOption {
+	return func(s *service) {
+		if watchHistoryDAO != nil {
+			s.watchHistoryDAO = watchHistoryDAO
+		}
+	}
+}
+
+// WithWatchProgressSync streams the progress recorded by a device of a user to the other devices of the user by
+// the pub/sub. It is a no-op if the pub/sub is nil.
+func WithWatchProgressSync(watchProgressPubSub dao.WatchProgressPubSub) ServiceOption {
+	return func(s *service) {
+		if watchProgressPubSub != nil {
+			s.watchProgressPubSub = watchProgressPubSub
+		}
+	}
+}
+
+func (s *service) RecordWatchProgress(ctx context.Context, req *pb.RecordWatchProgressRequest) (*pb.RecordWatchProgressResponse, error) {
+	if s.watchHistoryDAO == nil {
+		return nil, ErrWatchHistoryDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	videoID, err := primitive.ObjectIDFromHex(req.GetVideoId())
+	if err != nil {
+		return nil, ErrInvalidObjectID
+	}
+
+	video, err := s.videoDAO.Get(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the duration is unknown until the video is transcoded
+	if video.Duration > 0 && req.GetPosition() > video.Duration {
+		return nil, ErrInvalidPosition
+	}
+
+	progress := &dao.WatchProgress{
+		UserID:    userID,
+		VideoID:   videoID.Hex(),
+		Position:  req.GetPosition(),
+		UpdatedAt: time.Now(),
+		DeviceID:  req.GetDeviceId(),
+	}
+	if err := s.watchHistoryDAO.Record(ctx, progress); err != nil {
+		return nil, err
+	}
+
+	if s.watchProgressPubSub != nil {
+		// the failure is only logged since the progress has been recorded, the other devices resume by it later
+		if err := s.watchProgressPubSub.Publish(ctx, progress); err != nil {
+			logkit.FromContext(ctx).Error("failed to publish watch progress", zap.String("video_id", progress.VideoID), zap.Error(err))
+		}
+	}
+
+	return &pb.RecordWatchProgressResponse{}, nil
+}
+
+func (s *service) GetResumePositions(ctx context.Context, req *pb.GetResumePositionsRequest) (*pb.GetResumePositionsResponse, error) {
+	if s.watchHistoryDAO == nil {
+		return nil, ErrWatchHistoryDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	positions, err := s.watchHistoryDAO.Get(ctx, userID, req.GetVideoIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetResumePositionsResponse{Positions: watchProgressToProto(positions)}, nil
+}
+
+// StreamWatchProgress streams the progress recorded by the other devices of the user until the client cancels.
+func (s *service) StreamWatchProgress(req *pb.StreamWatchProgressRequest, stream pb.Video_StreamWatchProgressServer) error {
+	if s.watchProgressPubSub == nil {
+		return ErrWatchProgressSyncDisabled
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return ErrUserIDRequired
+	}
+
+	updates, err := s.watchProgressPubSub.Subscribe(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for progress := range updates {
+		if req.GetDeviceId() != "" && progress.DeviceID == req.GetDeviceId() {
+			continue
+		}
+
+		if err := stream.Send(&pb.StreamWatchProgressResponse{Progress: progress.ToProto()}); err != nil {
+			return err
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (s *service) ListWatchHistory(ctx context.Context, req *pb.ListWatchHistoryRequest) (*pb.ListWatchHistoryResponse, error) {
+	if s.watchHistoryDAO == nil {
+		return nil, ErrWatchHistoryDisabled
+	}
+
+	userID := logkit.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = DefaultWatchHistoryLimit
+	}
+
+	history, err := s.watchHistoryDAO.List(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListWatchHistoryResponse{History: watchProgressToProto(history)}, nil
+}
+
+func watchProgressToProto(history []*dao.WatchProgress) []*pb.WatchProgress {
+	pbHistory := make([]*pb.WatchProgress, 0, len(history))
+	for _, progress := range history {
+		pbHistory = append(pbHistory, progress.ToProto())
+	}
+
+	return pbHistory
+}
+
+// WatchHistoryFlusher flushes the hot watch history to the persistent store, it is run by the scheduler of the jobs,
+// see Schedule.
+type WatchHistoryFlusher struct {
+	flusher dao.WatchHistoryFlusher
+	logger  *logkit.Logger
+}
+
+// watchHistoryFlushBatchSize is the number of the users flushed at most by a run, the rest are flushed by the
+// next runs
+const watchHistoryFlushBatchSize = 500
+
+func NewWatchHistoryFlusher(ctx context.Context, flusher dao.WatchHistoryFlusher) *WatchHistoryFlusher {
+	return &WatchHistoryFlusher{
+		flusher: flusher,
+		logger:  logkit.FromContext(ctx),
+	}
+}
+
+// Schedule schedules flushing the watch history by the cron expression. The scheduler locks each run in Redis, so
+// the watch history is flushed by one instance at a time, and the users failed to flush are kept dirty in the hot
+// store until the next run of any instance.
+func (f *WatchHistoryFlusher) Schedule(s *scheduler.Scheduler, expr string) error {
+	return s.Add("video_watch_history_flush", expr, func(ctx context.Context) error {
+		flushed, err := f.Flush(ctx)
+		if err != nil {
+			return err
+		}
+
+		f.logger.Debug("flush watch history", zap.Int("flushed", flushed))
+
+		return nil
+	})
+}
+
+// Flush flushes the watch history of the users recorded since their last flush, and returns the number of the
+// users flushed.
+func (f *WatchHistoryFlusher) Flush(ctx context.Context) (int, error) {
+	return f.flusher.Flush(ctx, watchHistoryFlushBatchSize)
+}
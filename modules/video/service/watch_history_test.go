@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/pbmock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var _ = Describe("Watch history", func() {
+	var (
+		svc   *service
+		ctx   context.Context
+		video *dao.Video
+	)
+
+	BeforeEach(func() {
+		videoDAO := dao.NewMemoryVideoDAO()
+		svc = NewService(videoDAO, nil, nil, nil,
+			WithWatchHistory(dao.NewMemoryWatchHistoryDAO()),
+			WithWatchProgressSync(dao.NewMemoryWatchProgressPubSub()),
+		)
+		ctx = logkit.WithUserID(context.Background(), "alice")
+
+		video = dao.NewFakeVideo()
+		video.Duration = 60
+		Expect(videoDAO.Create(ctx, video)).To(Succeed())
+	})
+
+	record := func(ctx context.Context, position float64) error {
+		_, err := svc.RecordWatchProgress(ctx, &pb.RecordWatchProgressRequest{VideoId: video.ID.Hex(), Position: position})
+		return err
+	}
+
+	Describe("RecordWatchProgress", func() {
+		It("resumes the video at the position recorded last", func() {
+			Expect(record(ctx, 10)).To(Succeed())
+			time.Sleep(time.Millisecond)
+			Expect(record(ctx, 30)).To(Succeed())
+
+			resp, err := svc.ListWatchHistory(ctx, &pb.ListWatchHistoryRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetHistory()).To(HaveLen(1))
+			Expect(resp.GetHistory()[0].GetVideoId()).To(Equal(video.ID.Hex()))
+			Expect(resp.GetHistory()[0].GetPosition()).To(Equal(30.0))
+		})
+
+		It("keeps the history of the users apart", func() {
+			Expect(record(ctx, 10)).To(Succeed())
+
+			resp, err := svc.ListWatchHistory(logkit.WithUserID(context.Background(), "bob"), &pb.ListWatchHistoryRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetHistory()).To(BeEmpty())
+		})
+
+		It("refuses the position after the end of the video", func() {
+			Expect(record(ctx, 61)).To(MatchError(ErrInvalidPosition))
+		})
+
+		It("requires the user ID", func() {
+			Expect(record(context.Background(), 10)).To(MatchError(ErrUserIDRequired))
+		})
+
+		It("returns the video not found error for the missing video", func() {
+			_, err := svc.RecordWatchProgress(ctx, &pb.RecordWatchProgressRequest{VideoId: primitive.NewObjectID().Hex(), Position: 10})
+			Expect(err).To(MatchError(dao.ErrVideoNotFound))
+		})
+	})
+
+	Describe("GetResumePositions", func() {
+		It("returns the positions of the videos watched only", func() {
+			Expect(record(ctx, 10)).To(Succeed())
+
+			resp, err := svc.GetResumePositions(ctx, &pb.GetResumePositionsRequest{VideoIds: []string{video.ID.Hex(), primitive.NewObjectID().Hex()}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetPositions()).To(HaveLen(1))
+			Expect(resp.GetPositions()[0].GetVideoId()).To(Equal(video.ID.Hex()))
+			Expect(resp.GetPositions()[0].GetPosition()).To(Equal(10.0))
+		})
+	})
+
+	Describe("StreamWatchProgress", func() {
+		It("streams the progress recorded by the other devices of the user", func() {
+			streamCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			stream := pbmock.NewMockVideo_StreamWatchProgressServer(gomock.NewController(GinkgoT()))
+			stream.EXPECT().Context().Return(streamCtx).AnyTimes()
+
+			sent := make(chan *pb.WatchProgress, 64)
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *pb.StreamWatchProgressResponse) error {
+				sent <- resp.GetProgress()
+				return nil
+			}).AnyTimes()
+
+			done := make(chan error, 1)
+			go func() {
+				done <- svc.StreamWatchProgress(&pb.StreamWatchProgressRequest{DeviceId: "tv"}, stream)
+			}()
+
+			// the progress recorded before the stream subscribes is not streamed, so it records until one is
+			Eventually(func() int {
+				_, err := svc.RecordWatchProgress(ctx, &pb.RecordWatchProgressRequest{VideoId: video.ID.Hex(), Position: 10, DeviceId: "tv"})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = svc.RecordWatchProgress(ctx, &pb.RecordWatchProgressRequest{VideoId: video.ID.Hex(), Position: 20, DeviceId: "phone"})
+				Expect(err).NotTo(HaveOccurred())
+
+				return len(sent)
+			}).ShouldNot(BeZero())
+
+			progress := <-sent
+			Expect(progress.GetDeviceId()).To(Equal("phone"))
+			Expect(progress.GetPosition()).To(Equal(20.0))
+
+			cancel()
+			Eventually(done).Should(Receive(MatchError(context.Canceled)))
+		})
+	})
+
+	When("watch history is disabled", func() {
+		BeforeEach(func() {
+			svc = NewService(dao.NewMemoryVideoDAO(), nil, nil, nil)
+		})
+
+		It("returns watch history disabled error", func() {
+			Expect(record(ctx, 10)).To(MatchError(ErrWatchHistoryDisabled))
+
+			_, err := svc.ListWatchHistory(ctx, &pb.ListWatchHistoryRequest{})
+			Expect(err).To(MatchError(ErrWatchHistoryDisabled))
+		})
+	})
+})
@@ -2,15 +2,22 @@ package stream
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
 	"github.com/justin0u0/protoc-gen-grpc-sarama/pkg/saramakit"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -19,13 +26,32 @@ type stream struct {
 
 	videoDAO dao.VideoDAO
 	producer kafkakit.Producer
+	storage  storagekit.Storage
 }
 
-func NewStream(videoDAO dao.VideoDAO, producer kafkakit.Producer) *stream {
-	return &stream{
+type StreamOption func(s *stream)
+
+// WithFingerprints fingerprints the video files of the storage while the videos are transcoded, so the videos are
+// matched by their content for the copyright claims. It is a no-op if the storage is nil.
+func WithFingerprints(storage storagekit.Storage) StreamOption {
+	return func(s *stream) {
+		if storage != nil {
+			s.storage = storage
+		}
+	}
+}
+
+func NewStream(videoDAO dao.VideoDAO, producer kafkakit.Producer, opts ...StreamOption) *stream {
+	s := &stream{
 		videoDAO: videoDAO,
 		producer: producer,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *stream) HandleVideoCreated(ctx context.Context, req *pb.HandleVideoCreatedRequest) (*emptypb.Empty, error) {
@@ -34,8 +60,24 @@ func (s *stream) HandleVideoCreated(ctx context.Context, req *pb.HandleVideoCrea
 		return nil, &saramakit.HandlerError{Retry: false, Err: err}
 	}
 
+	// events produced before multi-tenancy have no tenant ID and belong to the default tenant
+	ctx = tenantkit.WithTenantID(ctx, req.GetTenantId())
+
+	// the events are handled again when redelivered or replayed, so the variants done are skipped
+	video, err := s.videoDAO.Get(ctx, id)
+	if errors.Is(err, dao.ErrVideoNotFound) {
+		// the video is deleted after the event, there is nothing to transcode
+		return nil, &saramakit.HandlerError{Retry: false, Err: err}
+	}
+	if err != nil {
+		return nil, &saramakit.HandlerError{Retry: true, Err: err}
+	}
+
 	if req.GetScale() != 0 {
 		variant := strconv.Itoa(int(req.GetScale()))
+		if video.Variants[variant] == req.GetUrl() {
+			return &emptypb.Empty{}, nil
+		}
 
 		if err := s.handleVideoWithVariant(ctx, id, variant, req.GetUrl()); err != nil {
 			return nil, &saramakit.HandlerError{Retry: true, Err: err}
@@ -44,13 +86,24 @@ func (s *stream) HandleVideoCreated(ctx context.Context, req *pb.HandleVideoCrea
 		return &emptypb.Empty{}, nil
 	}
 
-	// fanout create events to each variant
+	if s.storage != nil && video.Fingerprint == "" {
+		if err := s.fingerprintVideo(ctx, video, req.GetUrl()); err != nil {
+			return nil, &saramakit.HandlerError{Retry: true, Err: err}
+		}
+	}
+
+	// fanout create events to each variant not transcoded yet
 	variants := []int32{1080, 720, 480, 320}
 	for _, scale := range variants {
-		if err := s.produceVideoCreatedWithScaleEvent(&pb.HandleVideoCreatedRequest{
-			Id:    req.GetId(),
-			Url:   req.GetUrl(),
-			Scale: scale,
+		if _, ok := video.Variants[strconv.Itoa(int(scale))]; ok {
+			continue
+		}
+
+		if err := s.produceVideoCreatedWithScaleEvent(ctx, &pb.HandleVideoCreatedRequest{
+			Id:       req.GetId(),
+			Url:      req.GetUrl(),
+			Scale:    scale,
+			TenantId: req.GetTenantId(),
 		}); err != nil {
 			return nil, &saramakit.HandlerError{Retry: true, Err: err}
 		}
@@ -70,17 +123,45 @@ func (s *stream) handleVideoWithVariant(ctx context.Context, id primitive.Object
 	return nil
 }
 
-func (s *stream) produceVideoCreatedWithScaleEvent(req *pb.HandleVideoCreatedRequest) error {
-	valueBytes, err := proto.Marshal(req)
+// fingerprintVideo sets the fingerprint of the video to the SHA-256 of its file, so only the identical files match
+// for now. It is done before the variants are transcoded, since the variants are updated concurrently after. The
+// files not of the storage are not fingerprinted.
+func (s *stream) fingerprintVideo(ctx context.Context, video *dao.Video, url string) error {
+	storage, err := storagekit.StorageOf(ctx, s.storage)
+	if err != nil {
+		return err
+	}
+
+	objectName := strings.TrimPrefix(url, path.Join(storage.Endpoint(), storage.Bucket())+"/")
+	if objectName == url {
+		return nil
+	}
+
+	object, err := storage.GetObject(ctx, objectName)
 	if err != nil {
 		return err
 	}
+	defer object.Close()
 
-	msgs := []*kafkakit.ProducerMessage{
-		{Value: valueBytes},
+	hash := sha256.New()
+	if _, err := io.Copy(hash, object); err != nil {
+		return err
 	}
 
-	if err := s.producer.SendMessages(msgs); err != nil {
+	video.Fingerprint = hex.EncodeToString(hash.Sum(nil))
+
+	return s.videoDAO.SetFingerprint(ctx, video.ID, video.Fingerprint)
+}
+
+func (s *stream) produceVideoCreatedWithScaleEvent(ctx context.Context, req *pb.HandleVideoCreatedRequest) error {
+	msg, err := pb.HandleVideoCreatedSchema.Marshal(nil, req)
+	if err != nil {
+		return err
+	}
+
+	msgs := []*kafkakit.ProducerMessage{msg}
+
+	if err := s.producer.SendMessages(ctx, msgs); err != nil {
 		return err
 	}
 
@@ -2,19 +2,30 @@ package stream
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"path"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/mock/daomock"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/eventkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit/mock/kafkamock"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/Shopify/sarama"
 	"github.com/golang/mock/gomock"
 	"github.com/justin0u0/protoc-gen-grpc-sarama/pkg/saramakit"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -64,18 +75,64 @@ var _ = Describe("Stream", func() {
 
 		JustBeforeEach(func() {
 			resp, err = stream.HandleVideoCreated(ctx, &pb.HandleVideoCreatedRequest{
-				Id:    id.Hex(),
-				Url:   url,
-				Scale: scale,
+				Id:       id.Hex(),
+				Url:      url,
+				Scale:    scale,
+				TenantId: "course-a",
+			})
+		})
+
+		When("video is deleted", func() {
+			BeforeEach(func() {
+				videoDAO.EXPECT().Get(gomock.Any(), id).Return(nil, dao.ErrVideoNotFound)
+			})
+
+			It("returns the error without retry", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(Equal(&saramakit.HandlerError{Retry: false, Err: dao.ErrVideoNotFound}))
 			})
 		})
 
 		Context("scale is not presenting", func() {
-			BeforeEach(func() { scale = 0 })
+			var video *dao.Video
+
+			BeforeEach(func() {
+				scale = 0
+				video = &dao.Video{ID: id}
+				videoDAO.EXPECT().Get(gomock.Any(), id).DoAndReturn(func(context.Context, primitive.ObjectID) (*dao.Video, error) {
+					return video, nil
+				})
+			})
+
+			When("some variants are transcoded", func() {
+				var sentMsgs []*kafkakit.ProducerMessage
+
+				BeforeEach(func() {
+					video.Variants = map[string]string{"1080": url, "480": url}
+
+					sentMsgs = nil
+					producer.EXPECT().SendMessages(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, msgs []*kafkakit.ProducerMessage) error {
+						sentMsgs = append(sentMsgs, msgs...)
+						return nil
+					})
+				})
+
+				It("produces the scaled events of the other variants only", func() {
+					Expect(err).NotTo(HaveOccurred())
+
+					scales := make([]int32, 0, len(sentMsgs))
+					for _, msg := range sentMsgs {
+						var req pb.HandleVideoCreatedRequest
+						Expect(proto.Unmarshal(msg.Value, &req)).To(Succeed())
+						scales = append(scales, req.GetScale())
+					}
+					Expect(scales).To(ConsistOf(int32(720), int32(320)))
+				})
+			})
 
 			When("producer send messages error", func() {
 				BeforeEach(func() {
-					producer.EXPECT().SendMessages(gomock.Any()).Return(errSendMessagesUnknown)
+					producer.EXPECT().SendMessages(gomock.Any(), gomock.Any()).Return(errSendMessagesUnknown)
 				})
 
 				It("returns the error", func() {
@@ -85,23 +142,108 @@ var _ = Describe("Stream", func() {
 			})
 
 			When("success", func() {
+				var sentMsgs []*kafkakit.ProducerMessage
+
 				BeforeEach(func() {
-					producer.EXPECT().SendMessages(gomock.Any()).Times(4).Return(nil)
+					sentMsgs = nil
+					producer.EXPECT().SendMessages(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(func(_ context.Context, msgs []*kafkakit.ProducerMessage) error {
+						sentMsgs = append(sentMsgs, msgs...)
+						return nil
+					})
 				})
 
 				It("returns with no error", func() {
 					Expect(resp).To(Equal(&emptypb.Empty{}))
 					Expect(err).NotTo(HaveOccurred())
 				})
+
+				It("produces the scaled events with schema headers", func() {
+					Expect(sentMsgs).To(HaveLen(4))
+					for _, msg := range sentMsgs {
+						Expect(msg.Headers).To(Equal(map[string]string{
+							eventkit.HeaderEventType:                 "video.pb.HandleVideoCreatedRequest",
+							eventkit.HeaderEventVersion:              "2",
+							eventkit.HeaderEventMinCompatibleVersion: "1",
+						}))
+					}
+				})
+
+				It("produces the scaled events of the tenant", func() {
+					Expect(sentMsgs).To(HaveLen(4))
+					for _, msg := range sentMsgs {
+						var req pb.HandleVideoCreatedRequest
+						Expect(proto.Unmarshal(msg.Value, &req)).To(Succeed())
+						Expect(req.GetTenantId()).To(Equal("course-a"))
+					}
+				})
+			})
+
+			When("fingerprints are enabled", func() {
+				BeforeEach(func() {
+					storage := storagekit.NewLocalStorage(logkit.NewNopLogger().WithContext(ctx), &storagekit.LocalConfig{
+						Dir:    GinkgoT().TempDir(),
+						Bucket: "videos",
+					})
+					Expect(storage.PutObject(ctx, "video.mp4", strings.NewReader("video content"), -1, storagekit.PutObjectOptions{})).To(Succeed())
+
+					url = path.Join(storage.Endpoint(), storage.Bucket(), "video.mp4")
+					stream = NewStream(videoDAO, producer, WithFingerprints(storage))
+
+					producer.EXPECT().SendMessages(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+				})
+
+				When("the video is not fingerprinted", func() {
+					BeforeEach(func() {
+						sum := sha256.Sum256([]byte("video content"))
+						videoDAO.EXPECT().SetFingerprint(gomock.Any(), video.ID, hex.EncodeToString(sum[:])).Return(nil)
+					})
+
+					It("fingerprints the video by the SHA-256 of its file", func() {
+						Expect(err).NotTo(HaveOccurred())
+
+						sum := sha256.Sum256([]byte("video content"))
+						Expect(video.Fingerprint).To(Equal(hex.EncodeToString(sum[:])))
+					})
+				})
+
+				When("the video is fingerprinted", func() {
+					BeforeEach(func() {
+						video.Fingerprint = "fingerprint"
+					})
+
+					It("skips the fingerprint", func() {
+						Expect(err).NotTo(HaveOccurred())
+						Expect(video.Fingerprint).To(Equal("fingerprint"))
+					})
+				})
 			})
 		})
 
 		Context("scale is presenting", func() {
-			BeforeEach(func() { scale = 720 })
+			var video *dao.Video
+
+			BeforeEach(func() {
+				scale = 720
+				video = &dao.Video{ID: id}
+				videoDAO.EXPECT().Get(gomock.Any(), id).DoAndReturn(func(context.Context, primitive.ObjectID) (*dao.Video, error) {
+					return video, nil
+				})
+			})
+
+			When("variant is transcoded", func() {
+				BeforeEach(func() {
+					video.Variants = map[string]string{strconv.Itoa(int(scale)): url}
+				})
+
+				It("skips the event without transcoding", func() {
+					Expect(resp).To(Equal(&emptypb.Empty{}))
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
 
 			When("video not found", func() {
 				BeforeEach(func() {
-					videoDAO.EXPECT().UpdateVariant(ctx, id, strconv.Itoa(int(scale)), url).Return(dao.ErrVideoNotFound)
+					videoDAO.EXPECT().UpdateVariant(gomock.Any(), id, strconv.Itoa(int(scale)), url).Return(dao.ErrVideoNotFound)
 				})
 
 				It("returns with no error", func() {
@@ -112,7 +254,7 @@ var _ = Describe("Stream", func() {
 
 			When("success", func() {
 				BeforeEach(func() {
-					videoDAO.EXPECT().UpdateVariant(ctx, id, strconv.Itoa(int(scale)), url).Return(nil)
+					videoDAO.EXPECT().UpdateVariant(gomock.Any(), id, strconv.Itoa(int(scale)), url).Return(nil)
 				})
 
 				It("returns with no error", func() {
@@ -122,4 +264,104 @@ var _ = Describe("Stream", func() {
 			})
 		})
 	})
+
+	Describe("HandleVideoCreated consumer", func() {
+		var (
+			handler sarama.ConsumerGroupHandler
+			sess    *fakeConsumerGroupSession
+			msg     *sarama.ConsumerMessage
+
+			err error
+		)
+
+		BeforeEach(func() {
+			handler = pb.NewHandleVideoCreatedConsumerHandler(stream, logkit.NewSaramaLogger(logkit.NewNopLogger()))
+			sess = &fakeConsumerGroupSession{ctx: ctx}
+
+			value, merr := proto.Marshal(&pb.HandleVideoCreatedRequest{
+				Id:  primitive.NewObjectID().Hex(),
+				Url: "https://www.test.com",
+			})
+			Expect(merr).NotTo(HaveOccurred())
+
+			msg = &sarama.ConsumerMessage{Value: value}
+		})
+
+		JustBeforeEach(func() {
+			messages := make(chan *sarama.ConsumerMessage, 1)
+			messages <- msg
+			close(messages)
+
+			err = handler.ConsumeClaim(sess, &fakeConsumerGroupClaim{messages: messages})
+		})
+
+		When("producer requires a newer consumer", func() {
+			BeforeEach(func() {
+				msg.Headers = []*sarama.RecordHeader{
+					{Key: []byte(eventkit.HeaderEventType), Value: []byte("video.pb.HandleVideoCreatedRequest")},
+					{Key: []byte(eventkit.HeaderEventVersion), Value: []byte("4")},
+					{Key: []byte(eventkit.HeaderEventMinCompatibleVersion), Value: []byte("3")},
+				}
+			})
+
+			It("skips the message without handling it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sess.markedMessages()).To(ConsistOf(msg))
+			})
+		})
+
+		When("message is compatible", func() {
+			BeforeEach(func() {
+				msg.Headers = []*sarama.RecordHeader{
+					{Key: []byte(eventkit.HeaderEventType), Value: []byte("video.pb.HandleVideoCreatedRequest")},
+					{Key: []byte(eventkit.HeaderEventVersion), Value: []byte("1")},
+					{Key: []byte(eventkit.HeaderEventMinCompatibleVersion), Value: []byte("1")},
+				}
+
+				videoDAO.EXPECT().Get(gomock.Any(), gomock.Any()).Return(&dao.Video{}, nil)
+				producer.EXPECT().SendMessages(gomock.Any(), gomock.Any()).Times(4).Return(nil)
+			})
+
+			It("handles the message", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sess.markedMessages()).To(ConsistOf(msg))
+			})
+		})
+	})
 })
+
+type fakeConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+
+	ctx    context.Context
+	mu     sync.Mutex
+	marked []*sarama.ConsumerMessage
+}
+
+func (s *fakeConsumerGroupSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marked = append(s.marked, msg)
+}
+
+func (s *fakeConsumerGroupSession) markedMessages() []*sarama.ConsumerMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.marked
+}
+
+type fakeConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,63 @@
+package adminkit
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"runtime/pprof"
+	"time"
+)
+
+// gcStats is the summary of the garbage collection and the heap of the service.
+type gcStats struct {
+	NumGC         int64           `json:"num_gc"`
+	LastGC        time.Time       `json:"last_gc"`
+	PauseTotal    time.Duration   `json:"pause_total_ns"`
+	RecentPauses  []time.Duration `json:"recent_pauses_ns"`
+	HeapAlloc     uint64          `json:"heap_alloc_bytes"`
+	HeapInuse     uint64          `json:"heap_inuse_bytes"`
+	HeapObjects   uint64          `json:"heap_objects"`
+	NextGC        uint64          `json:"next_gc_bytes"`
+	GCCPUFraction float64         `json:"gc_cpu_fraction"`
+	NumGoroutine  int             `json:"num_goroutine"`
+	GOMAXPROCS    int             `json:"gomaxprocs"`
+}
+
+// serveGCStats responds the GC stats as JSON. The memory stats stop the world briefly,
+// so the endpoint is for debugging rather than monitoring, which the Go collector of the meter is for.
+func serveGCStats(w http.ResponseWriter, _ *http.Request) {
+	var stats debug.GCStats
+	debug.ReadGCStats(&stats)
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	// the pauses are the most recent first
+	recentPauses := stats.Pause
+	if len(recentPauses) > 10 {
+		recentPauses = recentPauses[:10]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&gcStats{
+		NumGC:         stats.NumGC,
+		LastGC:        stats.LastGC,
+		PauseTotal:    stats.PauseTotal,
+		RecentPauses:  recentPauses,
+		HeapAlloc:     mem.HeapAlloc,
+		HeapInuse:     mem.HeapInuse,
+		HeapObjects:   mem.HeapObjects,
+		NextGC:        mem.NextGC,
+		GCCPUFraction: mem.GCCPUFraction,
+		NumGoroutine:  runtime.NumGoroutine(),
+		GOMAXPROCS:    runtime.GOMAXPROCS(0),
+	})
+}
+
+// serveGoroutines dumps the stacks of all goroutines in the format of an unrecovered panic,
+// which is easier to read than the goroutine profile when chasing a leak or a deadlock.
+func serveGoroutines(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_ = pprof.Lookup("goroutine").WriteTo(w, 2)
+}
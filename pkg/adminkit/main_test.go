@@ -0,0 +1,13 @@
+package adminkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAdminKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Admin Kit")
+}
@@ -0,0 +1,150 @@
+package adminkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WarmUpFunc warms up a dependency of the service, such as checking the migration or prefilling the cache,
+// it is retried until it succeeds.
+type WarmUpFunc func(ctx context.Context) error
+
+var errWarmingUp = errors.New("warming up")
+
+// AddWarmUp gates the readiness on the warm-up, which runs in the background and is retried every retry interval
+// until it succeeds. The warm-ups skipped by the config do not gate the readiness, and are not run at all.
+func (s *AdminServer) AddWarmUp(name string, fn WarmUpFunc) {
+	logger := s.logger.With(zap.String("warm_up", name))
+
+	if _, ok := s.skippedWarmUps[name]; ok {
+		logger.Info("skip warm up")
+		return
+	}
+
+	s.mu.Lock()
+	s.warmUps[name] = errWarmingUp
+	s.mu.Unlock()
+
+	go func() {
+		for {
+			err := fn(s.ctx)
+
+			s.mu.Lock()
+			if err == nil {
+				delete(s.warmUps, name)
+			} else {
+				s.warmUps[name] = err
+			}
+			s.mu.Unlock()
+
+			if err == nil {
+				logger.Info("warm up successfully")
+				return
+			}
+
+			logger.Warn("failed to warm up, retrying", zap.Error(err))
+
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.warmUpRetryInterval):
+			}
+		}
+	}()
+}
+
+// OnQuit registers the function to quit the service when /quitquitquit is requested, e.g. Lifecycle.Quit.
+func (s *AdminServer) OnQuit(quit func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.quit = quit
+}
+
+// Ready reports whether all the warm-ups have succeeded and the service is not draining.
+func (s *AdminServer) Ready() bool {
+	return s.notReadyReason() == ""
+}
+
+// notReadyReason returns why the service is not ready, or empty if ready.
+func (s *AdminServer) notReadyReason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.draining {
+		return "draining"
+	}
+
+	reasons := make([]string, 0, len(s.warmUps))
+	for name, err := range s.warmUps {
+		reasons = append(reasons, fmt.Sprintf("%s: %v", name, err))
+	}
+	sort.Strings(reasons)
+
+	return strings.Join(reasons, "\n")
+}
+
+// serveHealthz reports the service is alive as long as the admin server responds.
+func (s *AdminServer) serveHealthz(w http.ResponseWriter, req *http.Request) {
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// serveReadyz reports whether the service is ready to serve, along with the reasons if not.
+func (s *AdminServer) serveReadyz(w http.ResponseWriter, req *http.Request) {
+	if reason := s.notReadyReason(); reason != "" {
+		http.Error(w, reason, http.StatusServiceUnavailable)
+		return
+	}
+
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// servePreStop is the preStop hook of Kubernetes. It marks the service not ready, and responds after the pre-stop delay,
+// so the endpoints stop routing new requests to the instance before it receives SIGTERM and stops accepting them.
+func (s *AdminServer) servePreStop(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	s.logger.Info("receive preStop hook, draining", zap.Duration("pre_stop_delay", s.preStopDelay))
+
+	select {
+	case <-req.Context().Done():
+	case <-time.After(s.preStopDelay):
+	}
+
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// serveQuit quits the service gracefully like SIGTERM.
+func (s *AdminServer) serveQuit(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	quit := s.quit
+	s.draining = true
+	s.mu.Unlock()
+
+	if quit == nil {
+		http.Error(w, "quit is not supported", http.StatusNotImplemented)
+		return
+	}
+
+	s.logger.Info("receive quit request")
+
+	_, _ = w.Write([]byte("quitting\n"))
+
+	// quit after responding, since the admin server is shut down along with the service
+	go quit()
+}
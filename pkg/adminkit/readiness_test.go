@@ -0,0 +1,112 @@
+package adminkit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("readiness", func() {
+	var (
+		server *AdminServer
+		conf   *AdminConfig
+	)
+
+	BeforeEach(func() {
+		conf = &AdminConfig{
+			PreStopDelay:        10 * time.Millisecond,
+			WarmUpRetryInterval: 10 * time.Millisecond,
+		}
+	})
+
+	JustBeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+
+		// the token is empty, so the endpoints are registered without serving
+		server = NewAdminServer(ctx, conf)
+		server.token = "secret"
+	})
+
+	AfterEach(func() {
+		Expect(server.Shutdown(context.Background())).To(Succeed())
+	})
+
+	request := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, http.NoBody)
+		req.Header.Set("Authorization", "Bearer secret")
+
+		resp := httptest.NewRecorder()
+		server.mux.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	It("is alive and ready without warm-ups", func() {
+		Expect(request(http.MethodGet, "/healthz").Code).To(Equal(http.StatusOK))
+		Expect(request(http.MethodGet, "/readyz").Code).To(Equal(http.StatusOK))
+	})
+
+	When("a warm-up has not succeeded", func() {
+		It("is not ready until the warm-up succeeds", func() {
+			errNotMigrated := errors.New("not migrated")
+			attempts := make(chan struct{}, 10)
+
+			server.AddWarmUp("migration", func(context.Context) error {
+				attempts <- struct{}{}
+				if len(attempts) < 3 {
+					return errNotMigrated
+				}
+				return nil
+			})
+
+			resp := request(http.MethodGet, "/readyz")
+			Expect(resp.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(resp.Body.String()).To(ContainSubstring("migration: "))
+
+			Eventually(server.Ready).Should(BeTrue())
+			Expect(request(http.MethodGet, "/readyz").Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("the warm-up is skipped", func() {
+		BeforeEach(func() {
+			conf.SkippedWarmUps = []string{"migration"}
+		})
+
+		It("does not gate the readiness", func() {
+			server.AddWarmUp("migration", func(context.Context) error {
+				return errors.New("not migrated")
+			})
+
+			Expect(server.Ready()).To(BeTrue())
+		})
+	})
+
+	When("the preStop hook is called", func() {
+		It("is not ready after the delay", func() {
+			start := time.Now()
+			Expect(request(http.MethodGet, "/prestop").Code).To(Equal(http.StatusOK))
+			Expect(time.Since(start)).To(BeNumerically(">=", conf.PreStopDelay))
+
+			Expect(request(http.MethodGet, "/readyz").Code).To(Equal(http.StatusServiceUnavailable))
+		})
+	})
+
+	When("quit is requested", func() {
+		It("quits the service", func() {
+			quit := make(chan struct{})
+			server.OnQuit(func() { close(quit) })
+
+			Expect(request(http.MethodGet, "/quitquitquit").Code).To(Equal(http.StatusMethodNotAllowed))
+			Expect(request(http.MethodPost, "/quitquitquit").Code).To(Equal(http.StatusOK))
+			Eventually(quit).Should(BeClosed())
+			Expect(server.Ready()).To(BeFalse())
+		})
+	})
+})
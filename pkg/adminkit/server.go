@@ -0,0 +1,140 @@
+package adminkit
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+type AdminConfig struct {
+	Addr  string `long:"addr" env:"ADDR" description:"the address of the admin server" default:":6060"`
+	Token string `long:"token" env:"TOKEN" description:"the bearer token required by the admin endpoints, the admin server is disabled if empty"`
+
+	PreStopDelay        time.Duration `long:"pre_stop_delay" env:"PRE_STOP_DELAY" description:"how long the preStop hook waits for the instance to be removed from the endpoints after it is not ready" default:"5s"`
+	WarmUpRetryInterval time.Duration `long:"warm_up_retry_interval" env:"WARM_UP_RETRY_INTERVAL" description:"the interval to retry the failed warm-ups" default:"1s"`
+	SkippedWarmUps      []string      `long:"skipped_warm_ups" env:"SKIPPED_WARM_UPS" env-delim:"," description:"the warm-ups not gating the readiness, e.g. migration"`
+}
+
+// defaultWarmUpRetryInterval is the retry interval of the warm-ups if not configured
+const defaultWarmUpRetryInterval = time.Second
+
+// AdminServer serves the endpoints to operate and debug the service, such as pprof, expvar, the GC stats
+// and the goroutine dump, along with the lifecycle endpoints of Kubernetes: the liveness and readiness
+// probes, the preStop hook and /quitquitquit. Every endpoint requires the bearer token, since the profiles
+// expose the internals of the service and profiling costs CPU, so the probes and the hook send it as a header.
+type AdminServer struct {
+	server *http.Server
+	mux    *http.ServeMux
+	token  string
+	logger *logkit.Logger
+
+	// ctx is canceled on shutdown to stop the warm-ups
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	preStopDelay        time.Duration
+	warmUpRetryInterval time.Duration
+	skippedWarmUps      map[string]struct{}
+
+	mu sync.Mutex
+	// warmUps are the last errors of the warm-ups not succeeded yet
+	warmUps  map[string]error
+	draining bool
+	quit     func()
+}
+
+// Handle registers the handler of an admin endpoint, the requests without the token are rejected before the handler.
+func (s *AdminServer) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, s.authorize(handler))
+}
+
+// Shutdown stops the warm-ups and the admin server gracefully until the context is done.
+func (s *AdminServer) Shutdown(ctx context.Context) error {
+	s.cancel()
+
+	if s.server == nil {
+		return nil
+	}
+
+	return s.server.Shutdown(ctx)
+}
+
+func (s *AdminServer) authorize(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// NewAdminServer serves the admin endpoints in the background. If the token is not set, the endpoints
+// are registered but not served, so the binaries register their endpoints regardless.
+func NewAdminServer(ctx context.Context, conf *AdminConfig) *AdminServer {
+	logger := logkit.FromContext(ctx).With(zap.String("addr", conf.Addr))
+
+	s := &AdminServer{
+		mux:                 http.NewServeMux(),
+		token:               conf.Token,
+		logger:              logger,
+		preStopDelay:        conf.PreStopDelay,
+		warmUpRetryInterval: conf.WarmUpRetryInterval,
+		skippedWarmUps:      make(map[string]struct{}, len(conf.SkippedWarmUps)),
+		warmUps:             make(map[string]error),
+	}
+	s.ctx, s.cancel = context.WithCancel(ctx)
+
+	if s.warmUpRetryInterval <= 0 {
+		s.warmUpRetryInterval = defaultWarmUpRetryInterval
+	}
+	for _, name := range conf.SkippedWarmUps {
+		s.skippedWarmUps[name] = struct{}{}
+	}
+
+	s.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
+	s.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
+	s.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
+	s.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
+	s.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+	s.Handle("/debug/vars", expvar.Handler())
+	s.Handle("/debug/gc", http.HandlerFunc(serveGCStats))
+	s.Handle("/debug/goroutines", http.HandlerFunc(serveGoroutines))
+	s.Handle("/healthz", http.HandlerFunc(s.serveHealthz))
+	s.Handle("/readyz", http.HandlerFunc(s.serveReadyz))
+	s.Handle("/prestop", http.HandlerFunc(s.servePreStop))
+	s.Handle("/quitquitquit", http.HandlerFunc(s.serveQuit))
+
+	if conf.Token == "" {
+		logger.Info("admin server is disabled")
+
+		return s
+	}
+
+	s.server = &http.Server{
+		Addr:    conf.Addr,
+		Handler: s.mux,
+	}
+
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("failed to serve admin server", zap.Error(err))
+		}
+	}()
+
+	logger.Info("serve admin server successfully")
+
+	return s
+}
@@ -0,0 +1,86 @@
+package adminkit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AdminServer", func() {
+	var (
+		server *AdminServer
+		path   string
+		token  string
+
+		resp *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+
+		// the token is empty, so the endpoints are registered without serving
+		server = NewAdminServer(ctx, &AdminConfig{})
+		server.token = "secret"
+
+		path = "/debug/gc"
+		token = "secret"
+	})
+
+	JustBeforeEach(func() {
+		req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp = httptest.NewRecorder()
+		server.mux.ServeHTTP(resp, req)
+	})
+
+	When("the token is missing", func() {
+		BeforeEach(func() { token = "" })
+
+		It("rejects the request", func() {
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("the token is wrong", func() {
+		BeforeEach(func() { token = "guess" })
+
+		It("rejects the request", func() {
+			Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("the GC stats are requested", func() {
+		It("responds the GC stats", func() {
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			var stats gcStats
+			Expect(json.Unmarshal(resp.Body.Bytes(), &stats)).To(Succeed())
+			Expect(stats.NumGoroutine).To(BeNumerically(">", 0))
+		})
+	})
+
+	When("the goroutines are requested", func() {
+		BeforeEach(func() { path = "/debug/goroutines" })
+
+		It("dumps the goroutines", func() {
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.String()).To(ContainSubstring("goroutine "))
+		})
+	})
+
+	When("the heap profile is requested", func() {
+		BeforeEach(func() { path = "/debug/pprof/heap" })
+
+		It("responds the profile", func() {
+			Expect(resp.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,249 @@
+package breakerkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+type BreakerConfig struct {
+	FailureThreshold int           `long:"failure_threshold" env:"FAILURE_THRESHOLD" description:"the consecutive failures to open the circuit breaker, the breaker is disabled if zero" default:"5"`
+	OpenTimeout      time.Duration `long:"open_timeout" env:"OPEN_TIMEOUT" description:"the duration the circuit breaker rejects the requests before probing the dependency" default:"10s"`
+	HalfOpenProbes   int           `long:"half_open_probes" env:"HALF_OPEN_PROBES" description:"the concurrent requests probing the dependency when half-open, the breaker closes once they all succeed" default:"1"`
+}
+
+// Validate reports whether the open timeout is positive if the breaker is enabled.
+func (c *BreakerConfig) Validate() error {
+	if c.FailureThreshold > 0 && c.OpenTimeout <= 0 {
+		return fmt.Errorf("open timeout of circuit breaker must be positive: %v", c.OpenTimeout)
+	}
+
+	return nil
+}
+
+// ErrOpen is returned without calling the dependency when the circuit breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+
+	return "unknown"
+}
+
+// DoneFunc reports the result of a request admitted by the circuit breaker.
+type DoneFunc func(err error)
+
+// Breaker is a circuit breaker of a dependency. It opens after the consecutive failures and rejects the requests
+// with ErrOpen, so the callers fail fast instead of piling up on a slow or down dependency. After the open timeout,
+// it lets a few probes through and closes if they all succeed, or opens again on any failure.
+// A nil Breaker is a disabled breaker that admits every request.
+type Breaker struct {
+	logger           *logkit.Logger
+	failureThreshold int
+	openTimeout      time.Duration
+	halfOpenProbes   int
+	isFailure        func(err error) bool
+	now              func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probes   int
+	probed   int
+	// generation is increased on each transition, so the results of the requests admitted in the previous state are ignored
+	generation uint64
+}
+
+type breakerOptions struct {
+	isFailure func(err error) bool
+	now       func() time.Time
+}
+
+type BreakerOption func(opts *breakerOptions)
+
+// WithFailureFunc sets the function reporting whether an error is a failure of the dependency,
+// e.g. a not found error is a result rather than a failure. By default, every error other than
+// the cancellation of the caller is a failure.
+func WithFailureFunc(isFailure func(err error) bool) BreakerOption {
+	return func(opts *breakerOptions) {
+		opts.isFailure = isFailure
+	}
+}
+
+// withNow sets the clock of the breaker for testing.
+func withNow(now func() time.Time) BreakerOption {
+	return func(opts *breakerOptions) {
+		opts.now = now
+	}
+}
+
+// NewBreaker returns the circuit breaker of the named dependency, or nil if the breaker is disabled.
+func NewBreaker(ctx context.Context, name string, conf *BreakerConfig, opts ...BreakerOption) *Breaker {
+	if conf.FailureThreshold <= 0 {
+		return nil
+	}
+
+	o := breakerOptions{
+		isFailure: IsFailure,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	halfOpenProbes := conf.HalfOpenProbes
+	if halfOpenProbes <= 0 {
+		halfOpenProbes = 1
+	}
+
+	return &Breaker{
+		logger:           logkit.FromContext(ctx).With(zap.String("breaker", name)),
+		failureThreshold: conf.FailureThreshold,
+		openTimeout:      conf.OpenTimeout,
+		halfOpenProbes:   halfOpenProbes,
+		isFailure:        o.isFailure,
+		now:              o.now,
+	}
+}
+
+// IsFailure is the default failure function, the cancellation of the caller is not a failure of the dependency.
+func IsFailure(err error) bool {
+	return err != nil && !errors.Is(err, context.Canceled)
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() State {
+	if b == nil {
+		return StateClosed
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.transitHalfOpen()
+
+	return b.state
+}
+
+// Allow admits a request or rejects it with ErrOpen. The done function of an admitted request
+// must be called with the result of the request exactly once.
+func (b *Breaker) Allow() (DoneFunc, error) {
+	if b == nil {
+		return func(error) {}, nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.transitHalfOpen()
+
+	switch b.state {
+	case StateOpen:
+		return nil, ErrOpen
+	case StateHalfOpen:
+		if b.probes >= b.halfOpenProbes {
+			return nil, ErrOpen
+		}
+		b.probes++
+	}
+
+	generation := b.generation
+
+	return func(err error) {
+		b.done(generation, err)
+	}, nil
+}
+
+// Do calls the function if the breaker admits it, and reports the result to the breaker.
+func (b *Breaker) Do(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	done(err)
+
+	return err
+}
+
+func (b *Breaker) done(generation uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	failed := b.isFailure(err)
+
+	switch b.state {
+	case StateClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.transit(StateOpen, err)
+		}
+	case StateHalfOpen:
+		if failed {
+			b.transit(StateOpen, err)
+			return
+		}
+
+		b.probed++
+		if b.probed >= b.halfOpenProbes {
+			b.transit(StateClosed, nil)
+		}
+	}
+}
+
+// transitHalfOpen lets the probes through once the open timeout elapses, it must be called with the lock held.
+func (b *Breaker) transitHalfOpen() {
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.openTimeout {
+		b.transit(StateHalfOpen, nil)
+	}
+}
+
+// transit changes the state of the breaker, it must be called with the lock held.
+func (b *Breaker) transit(state State, err error) {
+	b.logger.Warn("circuit breaker state changed",
+		zap.Stringer("from", b.state),
+		zap.Stringer("to", state),
+		zap.NamedError("cause", err),
+	)
+
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.probes = 0
+	b.probed = 0
+
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+}
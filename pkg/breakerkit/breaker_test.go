@@ -0,0 +1,130 @@
+package breakerkit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Breaker", func() {
+	var (
+		ctx     context.Context
+		breaker *Breaker
+		now     time.Time
+		errFail error
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		now = time.Now()
+		errFail = errors.New("failure")
+
+		breaker = NewBreaker(ctx, "test", &BreakerConfig{
+			FailureThreshold: 2,
+			OpenTimeout:      time.Second,
+			HalfOpenProbes:   1,
+		}, withNow(func() time.Time { return now }))
+	})
+
+	fail := func() {
+		Expect(breaker.Do(func() error { return errFail })).To(MatchError(errFail))
+	}
+
+	When("the failures are less than the threshold", func() {
+		BeforeEach(func() {
+			fail()
+			Expect(breaker.Do(func() error { return nil })).To(Succeed())
+			fail()
+		})
+
+		It("stays closed", func() {
+			Expect(breaker.State()).To(Equal(StateClosed))
+		})
+	})
+
+	When("the failures are not failures of the dependency", func() {
+		BeforeEach(func() {
+			for i := 0; i < 3; i++ {
+				Expect(breaker.Do(func() error { return context.Canceled })).To(MatchError(context.Canceled))
+			}
+		})
+
+		It("stays closed", func() {
+			Expect(breaker.State()).To(Equal(StateClosed))
+		})
+	})
+
+	When("the consecutive failures reach the threshold", func() {
+		BeforeEach(func() {
+			fail()
+			fail()
+		})
+
+		It("opens and rejects the requests", func() {
+			Expect(breaker.State()).To(Equal(StateOpen))
+
+			called := false
+			Expect(breaker.Do(func() error {
+				called = true
+				return nil
+			})).To(MatchError(ErrOpen))
+			Expect(called).To(BeFalse())
+		})
+
+		When("the open timeout elapses", func() {
+			BeforeEach(func() { now = now.Add(time.Second) })
+
+			It("lets a probe through only", func() {
+				Expect(breaker.State()).To(Equal(StateHalfOpen))
+
+				done, err := breaker.Allow()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = breaker.Allow()
+				Expect(err).To(MatchError(ErrOpen))
+
+				done(nil)
+				Expect(breaker.State()).To(Equal(StateClosed))
+			})
+
+			It("opens again if the probe fails", func() {
+				fail()
+				Expect(breaker.State()).To(Equal(StateOpen))
+			})
+		})
+	})
+
+	When("a request admitted before opening finishes", func() {
+		It("ignores the result", func() {
+			done, err := breaker.Allow()
+			Expect(err).NotTo(HaveOccurred())
+
+			fail()
+			fail()
+			now = now.Add(time.Second)
+			Expect(breaker.State()).To(Equal(StateHalfOpen))
+
+			done(nil)
+			Expect(breaker.State()).To(Equal(StateHalfOpen))
+		})
+	})
+
+	When("the breaker is disabled", func() {
+		BeforeEach(func() {
+			breaker = NewBreaker(ctx, "test", &BreakerConfig{})
+		})
+
+		It("admits every request", func() {
+			Expect(breaker).To(BeNil())
+
+			for i := 0; i < 3; i++ {
+				fail()
+			}
+			Expect(breaker.State()).To(Equal(StateClosed))
+		})
+	})
+})
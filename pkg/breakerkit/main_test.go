@@ -0,0 +1,13 @@
+package breakerkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBreakerKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Breaker Kit")
+}
@@ -0,0 +1,79 @@
+package cdckit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+type Handler interface {
+	HandleChange(ctx context.Context, event *ChangeEvent) error
+}
+
+type HandlerFunc func(ctx context.Context, event *ChangeEvent) error
+
+func (f HandlerFunc) HandleChange(ctx context.Context, event *ChangeEvent) error {
+	return f(ctx, event)
+}
+
+// ConsumerGroupHandler feeds the change events to the handler in order and
+// checkpoints by marking the consumed offsets.
+//
+// A failure of the handler ends the session without marking the message,
+// the consumer group then resumes from the last checkpoint so no change is lost.
+// Messages which are not change events are skipped.
+type ConsumerGroupHandler struct {
+	handler Handler
+	logger  *logkit.Logger
+}
+
+var _ sarama.ConsumerGroupHandler = (*ConsumerGroupHandler)(nil)
+
+func NewConsumerGroupHandler(handler Handler, logger *logkit.Logger) *ConsumerGroupHandler {
+	return &ConsumerGroupHandler{
+		handler: handler,
+		logger:  logger,
+	}
+}
+
+func (h *ConsumerGroupHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *ConsumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *ConsumerGroupHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := sess.Context()
+
+	for msg := range claim.Messages() {
+		logger := h.logger.With(
+			zap.String("topic", msg.Topic),
+			zap.Int32("partition", msg.Partition),
+			zap.Int64("offset", msg.Offset),
+		)
+
+		event, err := ParseChangeEvent(msg.Value)
+		if err != nil {
+			if !errors.Is(err, ErrTombstone) {
+				logger.Error("failed to parse change event, skip the message", zap.Error(err))
+			}
+
+			sess.MarkMessage(msg, "")
+			continue
+		}
+
+		if err := h.handler.HandleChange(ctx, event); err != nil {
+			logger.Error("failed to handle change event", zap.Error(err))
+			return err
+		}
+
+		sess.MarkMessage(msg, "")
+	}
+
+	return nil
+}
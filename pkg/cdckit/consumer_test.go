@@ -0,0 +1,102 @@
+package cdckit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConsumerGroupHandler", func() {
+	var (
+		handled  []*ChangeEvent
+		fail     bool
+		sess     *fakeConsumerGroupSession
+		messages []*sarama.ConsumerMessage
+		handler  *ConsumerGroupHandler
+
+		err error
+	)
+
+	BeforeEach(func() {
+		handled, fail = nil, false
+		sess = &fakeConsumerGroupSession{ctx: context.Background()}
+		handler = NewConsumerGroupHandler(HandlerFunc(func(ctx context.Context, event *ChangeEvent) error {
+			if fail {
+				return errHandlerUnknown
+			}
+
+			handled = append(handled, event)
+			return nil
+		}), logkit.NewNopLogger())
+
+		messages = []*sarama.ConsumerMessage{
+			{Offset: 0, Value: []byte(`{"op":"c","after":{"id":"1"}}`)},
+			{Offset: 1, Value: nil},
+			{Offset: 2, Value: []byte("not a JSON")},
+			{Offset: 3, Value: []byte(`{"op":"d","before":{"id":"1"}}`)},
+		}
+	})
+
+	JustBeforeEach(func() {
+		ch := make(chan *sarama.ConsumerMessage, len(messages))
+		for _, msg := range messages {
+			ch <- msg
+		}
+		close(ch)
+
+		err = handler.ConsumeClaim(sess, &fakeConsumerGroupClaim{messages: ch})
+	})
+
+	When("handler error", func() {
+		BeforeEach(func() { fail = true })
+
+		It("returns the error without marking the message", func() {
+			Expect(err).To(MatchError(errHandlerUnknown))
+			Expect(sess.marked).To(BeEmpty())
+		})
+	})
+
+	When("success", func() {
+		It("handles the change events in order", func() {
+			Expect(handled).To(HaveLen(2))
+			Expect(handled[0].Op).To(Equal(OperationCreate))
+			Expect(handled[1].Op).To(Equal(OperationDelete))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks all the messages", func() {
+			Expect(sess.marked).To(Equal([]int64{0, 1, 2, 3}))
+		})
+	})
+})
+
+var errHandlerUnknown = errors.New("unknown handler error")
+
+type fakeConsumerGroupSession struct {
+	sarama.ConsumerGroupSession
+
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeConsumerGroupSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeConsumerGroupSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeConsumerGroupClaim struct {
+	sarama.ConsumerGroupClaim
+
+	messages <-chan *sarama.ConsumerMessage
+}
+
+func (c *fakeConsumerGroupClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,77 @@
+package cdckit
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Operation is the kind of a row change, following the Debezium "op" field.
+type Operation string
+
+const (
+	OperationCreate Operation = "c"
+	OperationUpdate Operation = "u"
+	OperationDelete Operation = "d"
+	OperationRead   Operation = "r" // rows read by the initial snapshot
+)
+
+// Source is the position of the change in the database.
+type Source struct {
+	Table string `json:"table"`
+	LSN   int64  `json:"lsn"`
+	TsMs  int64  `json:"ts_ms"`
+}
+
+// ChangeEvent is a row change captured from the Postgres logical replication
+// by Debezium. Before is empty on create and After is empty on delete.
+type ChangeEvent struct {
+	Op     Operation       `json:"op"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+	Source Source          `json:"source"`
+}
+
+var (
+	ErrTombstone          = errors.New("tombstone event")
+	ErrInvalidChangeEvent = errors.New("invalid change event")
+)
+
+// ParseChangeEvent parses the Debezium message value, with or without the schema envelope.
+// Tombstones following deletes for log compaction are reported with ErrTombstone.
+func ParseChangeEvent(value []byte) (*ChangeEvent, error) {
+	if len(value) == 0 {
+		return nil, ErrTombstone
+	}
+
+	var envelope struct {
+		Payload *ChangeEvent `json:"payload"`
+	}
+	if err := json.Unmarshal(value, &envelope); err != nil {
+		return nil, err
+	}
+
+	event := envelope.Payload
+	if event == nil {
+		event = &ChangeEvent{}
+		if err := json.Unmarshal(value, event); err != nil {
+			return nil, err
+		}
+	}
+
+	switch event.Op {
+	case OperationCreate, OperationUpdate, OperationDelete, OperationRead:
+	default:
+		return nil, ErrInvalidChangeEvent
+	}
+
+	return event, nil
+}
+
+// Row returns the row after the change, or the row before the change if it is deleted.
+func (e *ChangeEvent) Row() json.RawMessage {
+	if e.Op == OperationDelete {
+		return e.Before
+	}
+
+	return e.After
+}
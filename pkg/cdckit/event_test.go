@@ -0,0 +1,73 @@
+package cdckit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChangeEvent", func() {
+	Describe("ParseChangeEvent", func() {
+		var (
+			value []byte
+
+			event *ChangeEvent
+			err   error
+		)
+
+		JustBeforeEach(func() {
+			event, err = ParseChangeEvent(value)
+		})
+
+		When("tombstone", func() {
+			BeforeEach(func() { value = nil })
+
+			It("returns tombstone error", func() {
+				Expect(event).To(BeNil())
+				Expect(err).To(MatchError(ErrTombstone))
+			})
+		})
+
+		When("unknown operation", func() {
+			BeforeEach(func() { value = []byte(`{"op":"t","source":{"table":"comments"}}`) })
+
+			It("returns invalid change event error", func() {
+				Expect(event).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidChangeEvent))
+			})
+		})
+
+		When("not a JSON", func() {
+			BeforeEach(func() { value = []byte("not a JSON") })
+
+			It("returns the error", func() {
+				Expect(event).To(BeNil())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("with schema envelope", func() {
+			BeforeEach(func() {
+				value = []byte(`{"schema":{},"payload":{"op":"u","before":{"id":"1"},"after":{"id":"1","content":"new"},"source":{"table":"comments","lsn":10,"ts_ms":1000}}}`)
+			})
+
+			It("returns the change event with no error", func() {
+				Expect(event.Op).To(Equal(OperationUpdate))
+				Expect(event.Row()).To(MatchJSON(`{"id":"1","content":"new"}`))
+				Expect(event.Source).To(Equal(Source{Table: "comments", LSN: 10, TsMs: 1000}))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("without schema envelope", func() {
+			BeforeEach(func() {
+				value = []byte(`{"op":"d","before":{"id":"1"},"after":null,"source":{"table":"comments"}}`)
+			})
+
+			It("returns the deleted row with no error", func() {
+				Expect(event.Op).To(Equal(OperationDelete))
+				Expect(event.Row()).To(MatchJSON(`{"id":"1"}`))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package cdckit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCDCKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test CDC Kit")
+}
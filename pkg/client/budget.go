@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.uber.org/zap"
+)
+
+const (
+	retryKindRetry = "retry"
+	retryKindHedge = "hedge"
+)
+
+// retryBudget limits the retries and the hedged requests to a ratio of the requests, so that an unavailable
+// server receives at most 1+ratio times the load instead of max attempts times, and the retries never turn
+// an outage into a retry storm. Each request deposits ratio tokens and each extra request withdraws one,
+// the balance starts at and is capped at the burst, so a quiet client can still retry a few requests.
+type retryBudget struct {
+	ratio float64
+	burst float64
+
+	mu      sync.Mutex
+	balance float64
+
+	attrs        []attribute.KeyValue
+	extraCounter syncint64.Counter
+}
+
+// newRetryBudget returns the retry budget of the service, it returns nil if the ratio is not positive,
+// which leaves the retries unlimited.
+func newRetryBudget(ctx context.Context, service string, conf *Config, meter metric.Meter) *retryBudget {
+	if conf.RetryBudgetRatio <= 0 {
+		return nil
+	}
+
+	logger := logkit.FromContext(ctx).With(zap.String("service", service))
+
+	b := &retryBudget{
+		ratio:   conf.RetryBudgetRatio,
+		burst:   conf.RetryBudgetBurst,
+		balance: conf.RetryBudgetBurst,
+		attrs:   []attribute.KeyValue{attribute.String("service", service)},
+	}
+
+	var err error
+	if b.extraCounter, err = meter.SyncInt64().Counter("client_extra_request",
+		instrument.WithDescription("count number of retries and hedged requests by kind and whether the retry budget allows them")); err != nil {
+		logger.Fatal("failed to create extra request counter", zap.Error(err))
+	}
+
+	balanceGauge, err := meter.AsyncFloat64().Gauge("client_retry_budget",
+		instrument.WithDescription("the tokens left in the retry budget, each retry or hedged request takes one"))
+	if err != nil {
+		logger.Fatal("failed to create retry budget gauge", zap.Error(err))
+	}
+
+	if err := meter.RegisterCallback([]instrument.Asynchronous{balanceGauge}, func(ctx context.Context) {
+		balanceGauge.Observe(ctx, b.tokens(), b.attrs...)
+	}); err != nil {
+		logger.Fatal("failed to register retry budget callback", zap.Error(err))
+	}
+
+	return b
+}
+
+// deposit adds the tokens of a request to the budget.
+func (b *retryBudget) deposit() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.balance += b.ratio
+	if b.balance > b.burst {
+		b.balance = b.burst
+	}
+}
+
+// withdraw takes a token for a retry or a hedged request, it reports false if the budget is exhausted.
+func (b *retryBudget) withdraw(ctx context.Context, kind string) bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	allowed := b.balance >= 1
+	if allowed {
+		b.balance--
+	}
+	b.mu.Unlock()
+
+	result := "allowed"
+	if !allowed {
+		result = "throttled"
+	}
+	b.extraCounter.Add(ctx, 1, append(b.attrs, attribute.String("kind", kind), attribute.String("result", result))...)
+
+	return allowed
+}
+
+// tokens returns the tokens left in the budget.
+func (b *retryBudget) tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.balance
+}
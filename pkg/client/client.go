@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/discoverykit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+)
+
+type Config struct {
+	grpckit.GrpcClientConnConfig
+	discoverykit.DiscoveryConfig
+
+	PoolSize       int           `long:"pool_size" env:"POOL_SIZE" default:"1" description:"the number of connections to the server, the requests are spread over them in round robin"`
+	RequestTimeout time.Duration `long:"request_timeout" env:"REQUEST_TIMEOUT" default:"10s" description:"the timeout of the unary requests without a deadline"`
+	MaxAttempts    int           `long:"max_attempts" env:"MAX_ATTEMPTS" default:"3" description:"the maximum number of attempts of the idempotent requests, retries are disabled if less than 2"`
+	InitialBackoff time.Duration `long:"initial_backoff" env:"INITIAL_BACKOFF" default:"100ms" description:"the backoff before the first retry, which doubles on each retry"`
+	MaxBackoff     time.Duration `long:"max_backoff" env:"MAX_BACKOFF" default:"1s" description:"the maximum backoff between retries"`
+	HedgingDelay   time.Duration `long:"hedging_delay" env:"HEDGING_DELAY" default:"100ms" description:"the delay before sending a hedged read, hedging is disabled if zero"`
+
+	RetryBudgetRatio float64 `long:"retry_budget_ratio" env:"RETRY_BUDGET_RATIO" default:"0.1" description:"the retries and hedged requests allowed per request, e.g. 0.1 allows one per 10 requests, unlimited if zero"`
+	RetryBudgetBurst float64 `long:"retry_budget_burst" env:"RETRY_BUDGET_BURST" default:"10" description:"the retries and hedged requests allowed before the requests earn them, and the most saved up"`
+}
+
+// method is an RPC of a service, e.g. comment.pb.Comment and ListComment.
+type method struct {
+	Service string
+	Method  string
+}
+
+func (m method) fullName() string {
+	return "/" + m.Service + "/" + m.Method
+}
+
+// policy is how the client calls the methods of the services.
+type policy struct {
+	// service names the retry budget of the client in the metrics
+	service string
+	// idempotent methods are retried on transient failures
+	idempotent []method
+	// hedged methods are sent again if the first request is slow, they must be idempotent
+	hedged []method
+}
+
+// connPool spreads the requests over the connections in round robin,
+// so that the requests are not limited by the concurrent streams of a single HTTP/2 connection.
+type connPool struct {
+	conns []*grpckit.GrpcClientConn
+	next  uint32
+}
+
+var _ grpc.ClientConnInterface = (*connPool)(nil)
+
+func newConnPool(ctx context.Context, conf *Config, p *policy, meter metric.Meter, opts ...grpc.DialOption) *connPool {
+	// the connections share the budget, since they reach the same servers
+	budget := newRetryBudget(ctx, p.service, conf, meter)
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithDefaultServiceConfig(serviceConfig()),
+		grpc.WithChainUnaryInterceptor(
+			timeoutUnaryClientInterceptor(conf.RequestTimeout),
+			retryUnaryClientInterceptor(conf, budget, p.idempotent...),
+			hedgingUnaryClientInterceptor(conf.HedgingDelay, budget, p.hedged...),
+		),
+	}
+	dialOpts = append(dialOpts, discoverykit.DialOptions(&conf.DiscoveryConfig)...)
+	opts = append(dialOpts, opts...)
+
+	// the server address is resolved to the addresses of all the instances
+	connConf := conf.GrpcClientConnConfig
+	connConf.ServerAddr = discoverykit.Target(&conf.DiscoveryConfig, conf.ServerAddr)
+
+	size := conf.PoolSize
+	if size <= 0 {
+		size = 1
+	}
+
+	pool := &connPool{
+		conns: make([]*grpckit.GrpcClientConn, 0, size),
+	}
+	for i := 0; i < size; i++ {
+		pool.conns = append(pool.conns, grpckit.NewGrpcClientConn(ctx, &connConf, opts...))
+	}
+
+	return pool
+}
+
+func (p *connPool) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return p.pick().Invoke(ctx, method, args, reply, opts...)
+}
+
+func (p *connPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return p.pick().NewStream(ctx, desc, method, opts...)
+}
+
+func (p *connPool) pick() *grpc.ClientConn {
+	n := atomic.AddUint32(&p.next, 1)
+
+	return p.conns[n%uint32(len(p.conns))].ClientConn
+}
+
+// Close closes all the connections and returns the first error.
+func (p *connPool) Close() error {
+	var err error
+
+	for _, conn := range p.conns {
+		if closeErr := conn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	pbv2 "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v2"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+)
+
+// CommentClient is the client of both versions of the comment API.
+type CommentClient struct {
+	pb.CommentClient
+	V2 pbv2.CommentClient
+
+	pool *connPool
+}
+
+func commentPolicy() *policy {
+	v1 := pb.Comment_ServiceDesc.ServiceName
+	v2 := pbv2.Comment_ServiceDesc.ServiceName
+
+	return &policy{
+		service: "comment",
+		idempotent: []method{
+			{Service: v1, Method: "Healthz"},
+			{Service: v1, Method: "ListComment"},
+			{Service: v1, Method: "GetComment"},
+			{Service: v1, Method: "DeleteCommentByVideoID"},
+			{Service: v2, Method: "ListComments"},
+			{Service: v2, Method: "GetComment"},
+		},
+		hedged: []method{
+			{Service: v1, Method: "ListComment"},
+			{Service: v2, Method: "ListComments"},
+		},
+	}
+}
+
+func NewCommentClient(ctx context.Context, conf *Config, meter metric.Meter, opts ...grpc.DialOption) *CommentClient {
+	pool := newConnPool(ctx, conf, commentPolicy(), meter, opts...)
+
+	return &CommentClient{
+		CommentClient: pb.NewCommentClient(pool),
+		V2:            pbv2.NewCommentClient(pool),
+		pool:          pool,
+	}
+}
+
+func (c *CommentClient) Close() error {
+	return c.pool.Close()
+}
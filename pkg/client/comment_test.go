@@ -0,0 +1,221 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeCommentServer handles the nth call of a method by the handler of the method.
+type fakeCommentServer struct {
+	pb.UnimplementedCommentServer
+
+	listCalls   int32
+	getCalls    int32
+	createCalls int32
+
+	listHandler   func(ctx context.Context, n int32) (*pb.ListCommentResponse, error)
+	getHandler    func(ctx context.Context, n int32) (*pb.GetCommentResponse, error)
+	createHandler func(ctx context.Context, n int32) (*pb.CreateCommentResponse, error)
+}
+
+func (s *fakeCommentServer) ListComment(ctx context.Context, _ *pb.ListCommentRequest) (*pb.ListCommentResponse, error) {
+	return s.listHandler(ctx, atomic.AddInt32(&s.listCalls, 1))
+}
+
+func (s *fakeCommentServer) GetComment(ctx context.Context, _ *pb.GetCommentRequest) (*pb.GetCommentResponse, error) {
+	return s.getHandler(ctx, atomic.AddInt32(&s.getCalls, 1))
+}
+
+func (s *fakeCommentServer) CreateComment(ctx context.Context, _ *pb.CreateCommentRequest) (*pb.CreateCommentResponse, error) {
+	return s.createHandler(ctx, atomic.AddInt32(&s.createCalls, 1))
+}
+
+var errUnavailable = status.Error(codes.Unavailable, "unavailable")
+
+var _ = Describe("CommentClient", func() {
+	var (
+		conf       *Config
+		server     *fakeCommentServer
+		grpcServer *grpc.Server
+		lis        *bufconn.Listener
+		dials      int32
+		client     *CommentClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		conf = &Config{
+			GrpcClientConnConfig: grpckit.GrpcClientConnConfig{
+				Timeout:    time.Second,
+				ServerAddr: "bufnet",
+			},
+			PoolSize:       1,
+			RequestTimeout: 5 * time.Second,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+			HedgingDelay:   50 * time.Millisecond,
+
+			RetryBudgetRatio: 0.1,
+			RetryBudgetBurst: 10,
+		}
+		server = &fakeCommentServer{}
+		dials = 0
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+	})
+
+	JustBeforeEach(func() {
+		lis = bufconn.Listen(1 << 20)
+		grpcServer = grpc.NewServer()
+		pb.RegisterCommentServer(grpcServer, server)
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+
+		client = NewCommentClient(ctx, conf, nonrecording.NewNoopMeterProvider().Meter(""), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			return lis.DialContext(ctx)
+		}))
+	})
+
+	AfterEach(func() {
+		Expect(client.Close()).To(Succeed())
+		grpcServer.Stop()
+	})
+
+	Describe("retries", func() {
+		BeforeEach(func() {
+			server.getHandler = func(_ context.Context, n int32) (*pb.GetCommentResponse, error) {
+				if n == 1 {
+					return nil, errUnavailable
+				}
+				return &pb.GetCommentResponse{Comment: &pb.CommentInfo{Id: "id"}}, nil
+			}
+			server.createHandler = func(_ context.Context, _ int32) (*pb.CreateCommentResponse, error) {
+				return nil, errUnavailable
+			}
+		})
+
+		It("retries the idempotent requests", func() {
+			resp, err := client.GetComment(ctx, &pb.GetCommentRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.GetComment().GetId()).To(Equal("id"))
+			Expect(atomic.LoadInt32(&server.getCalls)).To(Equal(int32(2)))
+		})
+
+		It("does not retry the other requests", func() {
+			_, err := client.CreateComment(ctx, &pb.CreateCommentRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+			Expect(atomic.LoadInt32(&server.createCalls)).To(Equal(int32(1)))
+		})
+
+		When("the retry budget is exhausted", func() {
+			BeforeEach(func() { conf.RetryBudgetBurst = 0 })
+
+			It("does not retry the idempotent requests", func() {
+				_, err := client.GetComment(ctx, &pb.GetCommentRequest{})
+				Expect(status.Code(err)).To(Equal(codes.Unavailable))
+				Expect(atomic.LoadInt32(&server.getCalls)).To(Equal(int32(1)))
+			})
+		})
+	})
+
+	Describe("hedging", func() {
+		When("the first request is slow", func() {
+			BeforeEach(func() {
+				server.listHandler = func(ctx context.Context, n int32) (*pb.ListCommentResponse, error) {
+					if n == 1 {
+						<-ctx.Done()
+						return nil, ctx.Err()
+					}
+					return &pb.ListCommentResponse{Comments: []*pb.CommentInfo{{Id: "hedged"}}}, nil
+				}
+			})
+
+			It("returns the response of the hedged request", func() {
+				resp, err := client.ListComment(ctx, &pb.ListCommentRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.GetComments()).To(HaveLen(1))
+				Expect(resp.GetComments()[0].GetId()).To(Equal("hedged"))
+				Expect(atomic.LoadInt32(&server.listCalls)).To(Equal(int32(2)))
+			})
+		})
+
+		When("the first request is fast", func() {
+			BeforeEach(func() {
+				server.listHandler = func(_ context.Context, _ int32) (*pb.ListCommentResponse, error) {
+					return &pb.ListCommentResponse{}, nil
+				}
+			})
+
+			It("does not send the hedged request", func() {
+				_, err := client.ListComment(ctx, &pb.ListCommentRequest{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Consistently(func() int32 {
+					return atomic.LoadInt32(&server.listCalls)
+				}, 2*conf.HedgingDelay).Should(Equal(int32(1)))
+			})
+		})
+
+		When("the first request fails before the delay", func() {
+			BeforeEach(func() {
+				server.listHandler = func(_ context.Context, _ int32) (*pb.ListCommentResponse, error) {
+					return nil, status.Error(codes.InvalidArgument, "invalid")
+				}
+			})
+
+			It("returns the error without the hedged request", func() {
+				_, err := client.ListComment(ctx, &pb.ListCommentRequest{})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(atomic.LoadInt32(&server.listCalls)).To(Equal(int32(1)))
+			})
+		})
+	})
+
+	Describe("timeout", func() {
+		BeforeEach(func() {
+			conf.RequestTimeout = 50 * time.Millisecond
+			server.createHandler = func(ctx context.Context, _ int32) (*pb.CreateCommentResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+		})
+
+		It("sets the timeout of the requests without a deadline", func() {
+			_, err := client.CreateComment(ctx, &pb.CreateCommentRequest{})
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		})
+	})
+
+	Describe("pooling", func() {
+		BeforeEach(func() {
+			conf.PoolSize = 2
+			server.getHandler = func(_ context.Context, _ int32) (*pb.GetCommentResponse, error) {
+				return &pb.GetCommentResponse{}, nil
+			}
+		})
+
+		It("spreads the requests over the connections", func() {
+			for i := 0; i < 4; i++ {
+				_, err := client.GetComment(ctx, &pb.GetCommentRequest{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(atomic.LoadInt32(&dials)).To(Equal(int32(2)))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type hedgedResult struct {
+	reply proto.Message
+	err   error
+}
+
+// hedgingUnaryClientInterceptor sends a hedged request of the methods if the first request
+// does not respond within the delay, the first successful response wins and the other request is canceled.
+// The hedged request is skipped if the retry budget is exhausted, since it adds load like a retry.
+//
+// Note that the call options of the hedged methods must be safe to be used by both requests.
+func hedgingUnaryClientInterceptor(delay time.Duration, budget *retryBudget, methods ...method) grpc.UnaryClientInterceptor {
+	hedged := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		hedged[m.fullName()] = struct{}{}
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		replyMsg, ok := reply.(proto.Message)
+		if _, hedge := hedged[method]; !hedge || !ok || delay <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// each request has its own reply, the winner is merged into the reply
+		results := make(chan hedgedResult, 2)
+		send := func() {
+			r := proto.Clone(replyMsg)
+			results <- hedgedResult{reply: r, err: invoker(ctx, method, req, r, cc, opts...)}
+		}
+
+		go send()
+		pending := 1
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-timer.C:
+				if budget.withdraw(ctx, retryKindHedge) {
+					go send()
+					pending++
+				}
+			case result := <-results:
+				pending--
+
+				if result.err == nil {
+					proto.Merge(replyMsg, result.reply)
+					return nil
+				}
+
+				// the error is returned if no other request may succeed
+				if pending == 0 {
+					return result.err
+				}
+			}
+		}
+	}
+}
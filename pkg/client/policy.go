@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// enables the client side health checking
+	_ "google.golang.org/grpc/health"
+)
+
+// maxAttempts is the limit of the attempts, more attempts are treated as the limit
+const maxAttempts = 5
+
+type serviceConfigJSON struct {
+	LoadBalancingConfig []map[string]struct{}  `json:"loadBalancingConfig,omitempty"`
+	HealthCheckConfig   *healthCheckConfigJSON `json:"healthCheckConfig,omitempty"`
+}
+
+type healthCheckConfigJSON struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// serviceConfig returns the gRPC service config spreading the requests over the healthy instances in round robin,
+// the retries are not configured here but by retryUnaryClientInterceptor, so that they are limited by the budget.
+func serviceConfig() string {
+	sc := serviceConfigJSON{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+		// the instances are health checked by the standard health service of the servers
+		HealthCheckConfig: &healthCheckConfigJSON{},
+	}
+
+	data, err := json.Marshal(&sc)
+	if err != nil {
+		// the config is built from plain values, so it never fails
+		panic(err)
+	}
+
+	return string(data)
+}
+
+// retryUnaryClientInterceptor retries the idempotent methods when the server is unavailable, with exponential
+// backoff and full jitter. Every request deposits into the budget and every retry withdraws from it, so the
+// retries stop once they exceed the ratio of the budget, e.g. when all the instances are down.
+func retryUnaryClientInterceptor(conf *Config, budget *retryBudget, methods ...method) grpc.UnaryClientInterceptor {
+	idempotent := make(map[string]struct{}, len(methods))
+	for _, m := range methods {
+		idempotent[m.fullName()] = struct{}{}
+	}
+
+	attempts := conf.MaxAttempts
+	if attempts > maxAttempts {
+		attempts = maxAttempts
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		budget.deposit()
+
+		if _, ok := idempotent[method]; !ok || attempts < 2 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		backoff := conf.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if status.Code(err) != codes.Unavailable || attempt >= attempts {
+				return err
+			}
+
+			if !budget.withdraw(ctx, retryKindRetry) {
+				return err
+			}
+
+			timer := time.NewTimer(jitter(backoff))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+
+			if backoff *= 2; backoff > conf.MaxBackoff {
+				backoff = conf.MaxBackoff
+			}
+		}
+	}
+}
+
+// jitter returns a random duration up to the backoff, so that the clients failing together do not retry together.
+func jitter(backoff time.Duration) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// timeoutUnaryClientInterceptor sets the timeout of the unary requests without a deadline,
+// the streaming requests are long-lived so they are not limited.
+func timeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
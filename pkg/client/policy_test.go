@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("serviceConfig", func() {
+	It("balances the requests over the healthy instances", func() {
+		var sc serviceConfigJSON
+		Expect(json.Unmarshal([]byte(serviceConfig()), &sc)).To(Succeed())
+
+		Expect(sc.LoadBalancingConfig).To(Equal([]map[string]struct{}{{"round_robin": {}}}))
+		Expect(sc.HealthCheckConfig).To(Equal(&healthCheckConfigJSON{}))
+	})
+})
+
+var _ = Describe("retryUnaryClientInterceptor", func() {
+	const fullMethod = "/comment.pb.Comment/GetComment"
+
+	var (
+		ctx         context.Context
+		conf        *Config
+		budget      *retryBudget
+		idempotent  []method
+		calls       int
+		invokeError error
+		err         error
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		conf = &Config{
+			MaxAttempts:      3,
+			InitialBackoff:   time.Millisecond,
+			MaxBackoff:       10 * time.Millisecond,
+			RetryBudgetRatio: 0.1,
+			RetryBudgetBurst: 10,
+		}
+		idempotent = []method{{Service: "comment.pb.Comment", Method: "GetComment"}}
+		calls = 0
+		invokeError = status.Error(codes.Unavailable, "unavailable")
+	})
+
+	JustBeforeEach(func() {
+		budget = newRetryBudget(ctx, "comment", conf, nonrecording.NewNoopMeterProvider().Meter(""))
+
+		interceptor := retryUnaryClientInterceptor(conf, budget, idempotent...)
+		err = interceptor(ctx, fullMethod, nil, nil, nil, func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			return invokeError
+		})
+	})
+
+	It("retries the idempotent methods up to max attempts", func() {
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		Expect(calls).To(Equal(3))
+		Expect(budget.tokens()).To(Equal(8.0))
+	})
+
+	When("the error is not transient", func() {
+		BeforeEach(func() { invokeError = status.Error(codes.InvalidArgument, "invalid") })
+
+		It("does not retry", func() {
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("the method is not idempotent", func() {
+		BeforeEach(func() { idempotent = nil })
+
+		It("does not retry", func() {
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("max attempts exceeds the limit", func() {
+		BeforeEach(func() {
+			conf.MaxAttempts = 10
+			conf.RetryBudgetRatio = 0
+		})
+
+		It("is limited", func() {
+			Expect(calls).To(Equal(maxAttempts))
+		})
+	})
+
+	When("the budget runs out", func() {
+		BeforeEach(func() { conf.RetryBudgetBurst = 1 })
+
+		It("stops retrying", func() {
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
+
+var _ = Describe("retryBudget", func() {
+	It("earns the retries by the requests up to the burst", func() {
+		budget := newRetryBudget(logkit.NewNopLogger().WithContext(context.Background()), "comment", &Config{RetryBudgetRatio: 0.5, RetryBudgetBurst: 1}, nonrecording.NewNoopMeterProvider().Meter(""))
+
+		Expect(budget.withdraw(context.Background(), retryKindRetry)).To(BeTrue())
+		Expect(budget.withdraw(context.Background(), retryKindRetry)).To(BeFalse())
+
+		budget.deposit()
+		Expect(budget.withdraw(context.Background(), retryKindHedge)).To(BeFalse())
+
+		for i := 0; i < 10; i++ {
+			budget.deposit()
+		}
+		Expect(budget.tokens()).To(Equal(1.0))
+		Expect(budget.withdraw(context.Background(), retryKindHedge)).To(BeTrue())
+	})
+
+	It("is unlimited without the ratio", func() {
+		budget := newRetryBudget(context.Background(), "comment", &Config{}, nonrecording.NewNoopMeterProvider().Meter(""))
+
+		Expect(budget).To(BeNil())
+		Expect(budget.withdraw(context.Background(), retryKindRetry)).To(BeTrue())
+	})
+})
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+)
+
+// VideoClient is the client of the video API.
+type VideoClient struct {
+	pb.VideoClient
+
+	pool *connPool
+}
+
+func videoPolicy() *policy {
+	service := pb.Video_ServiceDesc.ServiceName
+
+	return &policy{
+		service: "video",
+		idempotent: []method{
+			{Service: service, Method: "Healthz"},
+			{Service: service, Method: "GetVideo"},
+			{Service: service, Method: "ListVideo"},
+		},
+	}
+}
+
+func NewVideoClient(ctx context.Context, conf *Config, meter metric.Meter, opts ...grpc.DialOption) *VideoClient {
+	pool := newConnPool(ctx, conf, videoPolicy(), meter, opts...)
+
+	return &VideoClient{
+		VideoClient: pb.NewVideoClient(pool),
+		pool:        pool,
+	}
+}
+
+func (c *VideoClient) Close() error {
+	return c.pool.Close()
+}
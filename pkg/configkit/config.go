@@ -0,0 +1,229 @@
+package configkit
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// FileConfig is embedded in the args of the binaries, so the config file is listed in the help.
+type FileConfig struct {
+	File          string        `long:"config_file" env:"CONFIG_FILE" description:"the config file of KEY=VALUE lines named as the environment variables, which override the file"`
+	WatchInterval time.Duration `long:"config_watch_interval" env:"CONFIG_WATCH_INTERVAL" description:"the interval to check the config file for changes to reload, the config is reloaded on SIGHUP only if zero"`
+}
+
+// Validator is implemented by the configs validating their values beyond the flags, such as the ranges and formats.
+// The configs are validated at startup and before reloading, so an invalid value fails fast instead of at the use.
+type Validator interface {
+	Validate() error
+}
+
+// Config loads the args of a binary from the command line flags, the environment variables, the config file and
+// the defaults, in the order of precedence. The values of the config file are set as the environment variables
+// which are not set by the environment, so the flags parse them as if they were, and the file is a single place
+// to configure a deployment, e.g. a mounted ConfigMap.
+type Config struct {
+	file FileConfig
+	// cmdArgs are the command line arguments
+	cmdArgs []string
+
+	mu sync.Mutex
+	// environ are the keys of the environment variables before loading the file, which take precedence over the file
+	environ map[string]struct{}
+	// fileKeys are the keys of the environment variables set by the file, which are replaced when reloading
+	fileKeys map[string]struct{}
+}
+
+// Load parses and validates the args, and exits if they are invalid like the flags.
+func Load(args interface{}) *Config {
+	c, err := NewConfig(args)
+	if err != nil {
+		log.Fatal("failed to load config: ", err.Error())
+	}
+
+	return c
+}
+
+// NewConfig parses and validates the args.
+func NewConfig(args interface{}) (*Config, error) {
+	return newConfig(args, os.Args[1:])
+}
+
+func newConfig(args interface{}, cmdArgs []string) (*Config, error) {
+	c := &Config{
+		cmdArgs:  cmdArgs,
+		environ:  make(map[string]struct{}),
+		fileKeys: make(map[string]struct{}),
+	}
+
+	for _, kv := range os.Environ() {
+		if i := strings.Index(kv, "="); i > 0 {
+			c.environ[kv[:i]] = struct{}{}
+		}
+	}
+
+	// the config file is parsed first, since it is set by the flags as well
+	if _, err := flags.NewParser(&c.file, flags.IgnoreUnknown).ParseArgs(c.cmdArgs); err != nil {
+		return nil, err
+	}
+
+	if err := c.parse(args, flags.Default); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// File returns the config of the config file.
+func (c *Config) File() FileConfig {
+	return c.file
+}
+
+// Reload reads the config file again, and parses and validates the new args.
+func (c *Config) Reload(args interface{}) error {
+	return c.parse(args, flags.PassDoubleDash)
+}
+
+func (c *Config) parse(args interface{}, options flags.Options) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.loadFile(); err != nil {
+		return err
+	}
+
+	if _, err := flags.NewParser(args, options).ParseArgs(c.cmdArgs); err != nil {
+		return err
+	}
+
+	return Validate(args)
+}
+
+// loadFile sets the values of the config file as the environment variables, it must be called with the lock held.
+func (c *Config) loadFile() error {
+	if c.file.File == "" {
+		return nil
+	}
+
+	values, err := readFile(c.file.File)
+	if err != nil {
+		return err
+	}
+
+	// the keys removed from the file since the last load fall back to the defaults
+	for key := range c.fileKeys {
+		if _, ok := values[key]; !ok {
+			if err := os.Unsetenv(key); err != nil {
+				return err
+			}
+			delete(c.fileKeys, key)
+		}
+	}
+
+	for key, value := range values {
+		if _, ok := c.environ[key]; ok {
+			continue
+		}
+
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+		c.fileKeys[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// readFile reads the KEY=VALUE lines of the file, the empty lines and the lines starting with # are skipped,
+// and the values may be quoted.
+func readFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]string)
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		i := strings.Index(text, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, line)
+		}
+
+		key := strings.TrimSpace(strings.TrimPrefix(text[:i], "export "))
+		value := strings.TrimSpace(text[i+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		values[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Validate calls Validate of the args and of the configs in them which implement Validator, and returns the first error.
+func Validate(args interface{}) error {
+	return validate(reflect.ValueOf(args))
+}
+
+func validate(v reflect.Value) error {
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+
+	if validator, ok := asValidator(v); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+
+		if err := validate(v.Field(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func asValidator(v reflect.Value) (Validator, bool) {
+	if v.Kind() != reflect.Ptr && v.CanAddr() {
+		v = v.Addr()
+	}
+
+	if !v.CanInterface() {
+		return nil, false
+	}
+
+	validator, ok := v.Interface().(Validator)
+
+	return validator, ok
+}
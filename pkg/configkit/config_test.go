@@ -0,0 +1,135 @@
+package configkit
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var errInvalidPort = errors.New("invalid port")
+
+type testServerConfig struct {
+	Port int `long:"port" env:"PORT" default:"8080"`
+}
+
+func (c *testServerConfig) Validate() error {
+	if c.Port <= 0 {
+		return errInvalidPort
+	}
+
+	return nil
+}
+
+type testArgs struct {
+	FileConfig
+	Name    string           `long:"name" env:"CONFIGKIT_TEST_NAME" default:"default"`
+	Timeout time.Duration    `long:"timeout" env:"CONFIGKIT_TEST_TIMEOUT" default:"1s"`
+	Server  testServerConfig `group:"server" namespace:"server" env-namespace:"CONFIGKIT_TEST_SERVER"`
+}
+
+var _ = Describe("Config", func() {
+	var (
+		file    string
+		cmdArgs []string
+		args    *testArgs
+		config  *Config
+		err     error
+	)
+
+	writeFile := func(content string) {
+		Expect(os.WriteFile(file, []byte(content), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "config.env")
+		cmdArgs = []string{"--config_file", file}
+		args = &testArgs{}
+
+		writeFile("# the test config\nCONFIGKIT_TEST_NAME=file\nCONFIGKIT_TEST_TIMEOUT=\"2s\"\n")
+	})
+
+	JustBeforeEach(func() {
+		config, err = newConfig(args, cmdArgs)
+	})
+
+	AfterEach(func() {
+		for _, key := range []string{"CONFIGKIT_TEST_NAME", "CONFIGKIT_TEST_TIMEOUT", "CONFIGKIT_TEST_SERVER_PORT"} {
+			Expect(os.Unsetenv(key)).To(Succeed())
+		}
+	})
+
+	When("the config file is set", func() {
+		It("overrides the defaults by the file", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args.Name).To(Equal("file"))
+			Expect(args.Timeout).To(Equal(2 * time.Second))
+			Expect(args.Server.Port).To(Equal(8080))
+		})
+	})
+
+	When("the environment variable is set", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CONFIGKIT_TEST_NAME", "env")).To(Succeed())
+		})
+
+		It("overrides the file by the environment", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args.Name).To(Equal("env"))
+		})
+
+		It("keeps the environment when reloading", func() {
+			writeFile("CONFIGKIT_TEST_NAME=reloaded\n")
+
+			reloaded := &testArgs{}
+			Expect(config.Reload(reloaded)).To(Succeed())
+			Expect(reloaded.Name).To(Equal("env"))
+		})
+	})
+
+	When("the flag is set", func() {
+		BeforeEach(func() { cmdArgs = append(cmdArgs, "--name", "flag") })
+
+		It("overrides the file and the environment by the flag", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args.Name).To(Equal("flag"))
+		})
+	})
+
+	When("the config is invalid", func() {
+		BeforeEach(func() { writeFile("CONFIGKIT_TEST_SERVER_PORT=0\n") })
+
+		It("returns the error of the validation", func() {
+			Expect(err).To(MatchError(errInvalidPort))
+		})
+	})
+
+	When("the config file is malformed", func() {
+		BeforeEach(func() { writeFile("CONFIGKIT_TEST_NAME\n") })
+
+		It("returns error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Reload", func() {
+		It("reads the changed file", func() {
+			writeFile("CONFIGKIT_TEST_TIMEOUT=3s\n")
+
+			reloaded := &testArgs{}
+			Expect(config.Reload(reloaded)).To(Succeed())
+			Expect(reloaded.Timeout).To(Equal(3 * time.Second))
+			// the name removed from the file falls back to the default
+			Expect(reloaded.Name).To(Equal("default"))
+		})
+
+		It("returns the error of the validation", func() {
+			writeFile("CONFIGKIT_TEST_SERVER_PORT=-1\n")
+
+			Expect(config.Reload(&testArgs{})).To(MatchError(errInvalidPort))
+		})
+	})
+})
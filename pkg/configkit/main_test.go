@@ -0,0 +1,13 @@
+package configkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfigKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Config Kit")
+}
@@ -0,0 +1,144 @@
+package configkit
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+// ReloadFunc applies the safe fields of the reloaded args, such as the log level, the rate limits and the cache TTLs,
+// to the running components. The other fields take effect on restart only.
+type ReloadFunc func(args interface{}) error
+
+type reloadHook struct {
+	name string
+	fn   ReloadFunc
+}
+
+// Reloader reloads the config on SIGHUP, or when the config file changes if watched, and runs the reload hooks
+// with the new args. The args are validated before any hook runs, so an invalid config keeps the current one.
+type Reloader struct {
+	config  *Config
+	newArgs func() interface{}
+	logger  *logkit.Logger
+	signals chan os.Signal
+
+	mu    sync.Mutex
+	hooks []reloadHook
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReloader starts reloading the config in the background, newArgs returns a new zero args of the binary to parse into.
+func NewReloader(ctx context.Context, config *Config, newArgs func() interface{}) *Reloader {
+	r := &Reloader{
+		config:  config,
+		newArgs: newArgs,
+		logger:  logkit.FromContext(ctx).With(zap.String("config_file", config.file.File)),
+		signals: make(chan os.Signal, 1),
+		done:    make(chan struct{}),
+	}
+
+	signal.Notify(r.signals, syscall.SIGHUP)
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	go r.run(ctx)
+
+	return r
+}
+
+// OnReload registers the reload hook of the component, the hooks run in the order of registration.
+func (r *Reloader) OnReload(name string, fn ReloadFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hooks = append(r.hooks, reloadHook{name: name, fn: fn})
+}
+
+// Reload parses the config again and runs the reload hooks. A failing hook does not stop the others,
+// and the first error is returned.
+func (r *Reloader) Reload() error {
+	args := r.newArgs()
+	if err := r.config.Reload(args); err != nil {
+		r.logger.Error("failed to reload config, the current config is kept", zap.Error(err))
+		return err
+	}
+
+	r.mu.Lock()
+	hooks := append([]reloadHook(nil), r.hooks...)
+	r.mu.Unlock()
+
+	var firstErr error
+	for _, hook := range hooks {
+		if err := hook.fn(args); err != nil {
+			r.logger.Error("failed to apply reloaded config", zap.String("hook", hook.name), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	if firstErr == nil {
+		r.logger.Info("reload config successfully")
+	}
+
+	return firstErr
+}
+
+// Close stops reloading the config.
+func (r *Reloader) Close() error {
+	signal.Stop(r.signals)
+	r.cancel()
+	<-r.done
+
+	return nil
+}
+
+func (r *Reloader) run(ctx context.Context) {
+	defer close(r.done)
+
+	var watch <-chan time.Time
+	if r.config.file.File != "" && r.config.file.WatchInterval > 0 {
+		ticker := time.NewTicker(r.config.file.WatchInterval)
+		defer ticker.Stop()
+
+		watch = ticker.C
+	}
+
+	modTime := r.modTime()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.signals:
+			_ = r.Reload()
+		case <-watch:
+			if t := r.modTime(); !t.Equal(modTime) {
+				modTime = t
+				_ = r.Reload()
+			}
+		}
+	}
+}
+
+// modTime returns the modification time of the config file, or zero if it is not found.
+func (r *Reloader) modTime() time.Time {
+	if r.config.file.File == "" {
+		return time.Time{}
+	}
+
+	info, err := os.Stat(r.config.file.File)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
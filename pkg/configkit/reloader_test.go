@@ -0,0 +1,66 @@
+package configkit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		file     string
+		reloader *Reloader
+		names    chan string
+	)
+
+	BeforeEach(func() {
+		file = filepath.Join(GinkgoT().TempDir(), "config.env")
+		Expect(os.WriteFile(file, []byte("CONFIGKIT_TEST_NAME=file\n"), 0o600)).To(Succeed())
+
+		config, err := newConfig(&testArgs{}, []string{"--config_file", file})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		reloader = NewReloader(ctx, config, func() interface{} { return &testArgs{} })
+
+		names = make(chan string, 1)
+		reloader.OnReload("name", func(args interface{}) error {
+			names <- args.(*testArgs).Name
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		Expect(reloader.Close()).To(Succeed())
+		Expect(os.Unsetenv("CONFIGKIT_TEST_NAME")).To(Succeed())
+	})
+
+	It("reloads the config on SIGHUP", func() {
+		Expect(os.WriteFile(file, []byte("CONFIGKIT_TEST_NAME=reloaded\n"), 0o600)).To(Succeed())
+
+		Expect(syscall.Kill(os.Getpid(), syscall.SIGHUP)).To(Succeed())
+		Eventually(names).Should(Receive(Equal("reloaded")))
+	})
+
+	It("returns the first error of the hooks after running all of them", func() {
+		errHook := errors.New("hook")
+		reloader.OnReload("failing", func(interface{}) error { return errHook })
+
+		Expect(reloader.Reload()).To(MatchError(errHook))
+		Expect(names).To(Receive(Equal("file")))
+	})
+
+	It("keeps the current config if the new one is invalid", func() {
+		Expect(os.WriteFile(file, []byte("CONFIGKIT_TEST_SERVER_PORT=0\n"), 0o600)).To(Succeed())
+
+		Expect(reloader.Reload()).To(MatchError(errInvalidPort))
+		Consistently(names).ShouldNot(Receive())
+		Expect(os.Unsetenv("CONFIGKIT_TEST_SERVER_PORT")).To(Succeed())
+	})
+})
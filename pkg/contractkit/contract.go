@@ -0,0 +1,123 @@
+package contractkit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Contract is what a consumer expects of the RPCs of a provider, recorded as the interactions of a golden file.
+// The consumer tests run against a stub replaying the interactions, and the provider tests verify the provider
+// answers them alike, so a change of either side breaking the other fails the tests before deploy.
+type Contract struct {
+	Consumer     string         `json:"consumer"`
+	Provider     string         `json:"provider"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a unary call of the consumer and the response it expects. The request and the response are in
+// the JSON mapping of the messages, and only the fields of the response the consumer depends on are recorded.
+type Interaction struct {
+	Description string `json:"description"`
+	// State is the provider state the interaction needs, e.g. "video exists", set up before verifying it
+	State string `json:"state,omitempty"`
+	// Method is the full name of the method, e.g. /video.pb.Video/GetVideo
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// Code is the status code expected instead of the response, e.g. "NOT_FOUND"
+	Code *codes.Code `json:"code,omitempty"`
+
+	method protoreflect.MethodDescriptor
+}
+
+var (
+	ErrUnknownMethod        = errors.New("unknown method")
+	ErrStreamingUnsupported = errors.New("streaming method unsupported")
+)
+
+// Load reads the contract of the golden file, the methods and the messages of the interactions are resolved from
+// the registered protos, so the contract fails to load once the protos drop a method or a field it depends on.
+func Load(path string) (*Contract, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var contract Contract
+	if err := json.Unmarshal(data, &contract); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for _, interaction := range contract.Interactions {
+		if err := interaction.resolve(); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, interaction.Description, err)
+		}
+	}
+
+	return &contract, nil
+}
+
+func (i *Interaction) resolve() error {
+	name := strings.Replace(strings.TrimPrefix(i.Method, "/"), "/", ".", 1)
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return fmt.Errorf("%w %s", ErrUnknownMethod, i.Method)
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownMethod, i.Method)
+	}
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		return fmt.Errorf("%w %s", ErrStreamingUnsupported, i.Method)
+	}
+	i.method = method
+
+	if _, err := i.request(); err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+
+	if _, err := i.response(); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+
+	return nil
+}
+
+// request returns the request message of the interaction.
+func (i *Interaction) request() (proto.Message, error) {
+	return unmarshal(i.method.Input(), i.Request)
+}
+
+// response returns the response message of the interaction, which has the recorded fields only.
+func (i *Interaction) response() (proto.Message, error) {
+	return unmarshal(i.method.Output(), i.Response)
+}
+
+func unmarshal(desc protoreflect.MessageDescriptor, data json.RawMessage) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if len(data) == 0 {
+		return msg, nil
+	}
+
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
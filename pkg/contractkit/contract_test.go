@@ -0,0 +1,169 @@
+package contractkit
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// serve serves the server on an in-memory listener and returns the connection to it.
+func serve(server *grpc.Server) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	DeferCleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	return conn
+}
+
+var _ = Describe("Load", func() {
+	It("loads the interactions of the contract", func() {
+		contract, err := Load("testdata/health.json")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contract.Consumer).To(Equal("test"))
+		Expect(contract.Provider).To(Equal("health"))
+		Expect(contract.Interactions).To(HaveLen(2))
+		Expect(*contract.Interactions[1].Code).To(Equal(codes.NotFound))
+	})
+
+	When("the protos no longer have a field of the contract", func() {
+		It("returns error", func() {
+			_, err := Load("testdata/unknown_field.json")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the protos no longer have a method of the contract", func() {
+		It("returns ErrUnknownMethod", func() {
+			_, err := Load("testdata/unknown_method.json")
+			Expect(err).To(MatchError(ErrUnknownMethod))
+		})
+	})
+
+	When("the method is streaming", func() {
+		It("returns ErrStreamingUnsupported", func() {
+			_, err := Load("testdata/streaming.json")
+			Expect(err).To(MatchError(ErrStreamingUnsupported))
+		})
+	})
+})
+
+var _ = Describe("Verify", func() {
+	var (
+		ctx          context.Context
+		contract     *Contract
+		healthServer *health.Server
+		conn         *grpc.ClientConn
+		states       map[string]StateFunc
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		contract, err = Load("testdata/health.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		healthServer = health.NewServer()
+		server := grpc.NewServer()
+		healthpb.RegisterHealthServer(server, healthServer)
+		conn = serve(server)
+
+		states = map[string]StateFunc{
+			"service is serving": func(ctx context.Context) error {
+				healthServer.SetServingStatus("video", healthpb.HealthCheckResponse_SERVING)
+				return nil
+			},
+		}
+	})
+
+	When("the provider honors the contract", func() {
+		It("returns no error", func() {
+			Expect(Verify(ctx, conn, contract, states)).To(Succeed())
+		})
+	})
+
+	When("the provider responds differently", func() {
+		It("returns ErrResponseMismatch", func() {
+			states["service is serving"] = func(ctx context.Context) error {
+				healthServer.SetServingStatus("video", healthpb.HealthCheckResponse_NOT_SERVING)
+				return nil
+			}
+
+			Expect(Verify(ctx, conn, contract, states)).To(MatchError(ErrResponseMismatch))
+		})
+	})
+
+	When("the provider fails with another status code", func() {
+		It("returns ErrStatusCodeMismatch", func() {
+			delete(states, "service is serving")
+			contract.Interactions[0].State = ""
+
+			Expect(Verify(ctx, conn, contract, states)).To(MatchError(ErrStatusCodeMismatch))
+		})
+	})
+
+	When("the provider state is unknown", func() {
+		It("returns ErrUnknownState", func() {
+			delete(states, "service is serving")
+
+			Expect(Verify(ctx, conn, contract, states)).To(MatchError(ErrUnknownState))
+		})
+	})
+})
+
+var _ = Describe("NewStubServer", func() {
+	var client healthpb.HealthClient
+
+	BeforeEach(func() {
+		contract, err := Load("testdata/health.json")
+		Expect(err).NotTo(HaveOccurred())
+
+		client = healthpb.NewHealthClient(serve(NewStubServer(contract)))
+	})
+
+	It("responds with the recorded response", func() {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "video"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetStatus()).To(Equal(healthpb.HealthCheckResponse_SERVING))
+	})
+
+	It("fails with the recorded status code", func() {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	When("the request is not recorded", func() {
+		It("fails with Unimplemented", func() {
+			_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "comment"})
+			Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+		})
+	})
+
+	When("the method is not recorded", func() {
+		It("fails with Unimplemented", func() {
+			stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{Service: "video"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package contractkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestContractKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Contract Kit")
+}
@@ -0,0 +1,66 @@
+package contractkit
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewStubServer returns the gRPC server replaying the interactions of the contracts, it answers a request equal to
+// the request of an interaction with the recorded response or status code, and the others with Unimplemented,
+// so a consumer test calling the provider in a way the contracts do not record fails.
+func NewStubServer(contracts ...*Contract) *grpc.Server {
+	interactions := make(map[string][]*Interaction)
+	for _, contract := range contracts {
+		for _, interaction := range contract.Interactions {
+			interactions[interaction.Method] = append(interactions[interaction.Method], interaction)
+		}
+	}
+
+	return grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		method, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Error(codes.Internal, "method not found in stream")
+		}
+
+		candidates := interactions[method]
+		if len(candidates) == 0 {
+			return status.Errorf(codes.Unimplemented, "no interaction of %s in the contracts", method)
+		}
+
+		req, err := candidates[0].request()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		proto.Reset(req)
+
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+
+		for _, interaction := range candidates {
+			expected, err := interaction.request()
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if !proto.Equal(expected, req) {
+				continue
+			}
+
+			if interaction.Code != nil {
+				return status.Errorf(*interaction.Code, "stubbed by the contract: %s", interaction.Description)
+			}
+
+			resp, err := interaction.response()
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			return stream.SendMsg(resp)
+		}
+
+		return status.Errorf(codes.Unimplemented, "no interaction of %s with the request in the contracts", method)
+	}))
+}
@@ -0,0 +1,132 @@
+package contractkit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// StateFunc sets up a provider state of the interactions, e.g. creates the video the interaction gets.
+type StateFunc func(ctx context.Context) error
+
+var (
+	ErrUnknownState       = errors.New("unknown provider state")
+	ErrResponseMismatch   = errors.New("response mismatch")
+	ErrStatusCodeMismatch = errors.New("status code mismatch")
+)
+
+// Verify calls the provider through the connection with the requests of the interactions in order, and returns
+// an error once a response differs from the recorded one. The fields not recorded are ignored, so the provider
+// is free to add fields the consumer does not depend on.
+func Verify(ctx context.Context, conn grpc.ClientConnInterface, contract *Contract, states map[string]StateFunc) error {
+	for _, interaction := range contract.Interactions {
+		if err := verify(ctx, conn, interaction, states); err != nil {
+			return fmt.Errorf("%s: %w", interaction.Description, err)
+		}
+	}
+
+	return nil
+}
+
+func verify(ctx context.Context, conn grpc.ClientConnInterface, interaction *Interaction, states map[string]StateFunc) error {
+	if interaction.State != "" {
+		state, ok := states[interaction.State]
+		if !ok {
+			return fmt.Errorf("%w %q", ErrUnknownState, interaction.State)
+		}
+
+		if err := state(ctx); err != nil {
+			return err
+		}
+	}
+
+	req, err := interaction.request()
+	if err != nil {
+		return err
+	}
+
+	resp := interaction.method.Output()
+	actual, err := unmarshal(resp, nil)
+	if err != nil {
+		return err
+	}
+
+	err = conn.Invoke(ctx, interaction.Method, req, actual)
+
+	if interaction.Code != nil {
+		if code := status.Code(err); code != *interaction.Code {
+			return fmt.Errorf("%w: expected %s, got %s", ErrStatusCodeMismatch, *interaction.Code, code)
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: expected %s, got %s", ErrStatusCodeMismatch, codes.OK, status.Code(err))
+	}
+
+	expected, err := interaction.response()
+	if err != nil {
+		return err
+	}
+
+	return match(expected, actual)
+}
+
+// match reports whether the actual message has the fields of the expected one, the nested messages and maps are matched
+// alike, while the repeated fields and the other values are compared as a whole in the JSON mapping of the messages.
+func match(expected, actual proto.Message) error {
+	expectedFields, err := toFields(expected)
+	if err != nil {
+		return err
+	}
+
+	actualFields, err := toFields(actual)
+	if err != nil {
+		return err
+	}
+
+	return matchFields("", expectedFields, actualFields)
+}
+
+func matchFields(path string, expected, actual map[string]interface{}) error {
+	for name, value := range expected {
+		actualValue := actual[name]
+
+		expectedFields, ok := value.(map[string]interface{})
+		if actualFields, isMap := actualValue.(map[string]interface{}); ok && isMap {
+			if err := matchFields(path+name+".", expectedFields, actualFields); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(actualValue, value) {
+			return fmt.Errorf("%w: field %s%s expected %v, got %v", ErrResponseMismatch, path, name, value, actualValue)
+		}
+	}
+
+	return nil
+}
+
+func toFields(msg proto.Message) (map[string]interface{}, error) {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
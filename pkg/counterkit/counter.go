@@ -0,0 +1,298 @@
+package counterkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/scheduler"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidPending = errors.New("invalid pending counter delta")
+
+type CounterConfig struct {
+	SnapshotSchedule  string `long:"snapshot_schedule" env:"SNAPSHOT_SCHEDULE" description:"the cron expression of the snapshots of the counters from Redis to Postgres, see scheduler.ParseSchedule" default:"@every 30s"`
+	SnapshotBatchSize int64  `long:"snapshot_batch_size" env:"SNAPSHOT_BATCH_SIZE" description:"the number of the changed counters scanned from Redis at a time" default:"500"`
+}
+
+const createCounterTableQuery = `CREATE TABLE IF NOT EXISTS counters (
+	name text NOT NULL,
+	id text NOT NULL,
+	value bigint NOT NULL DEFAULT 0,
+	batch text,
+	updated_at timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY (name, id)
+)`
+
+// incrScript increases the delta of the counter and marks the counter changed.
+var incrScript = redis.NewScript(`
+redis.call("INCRBY", KEYS[1], ARGV[1])
+redis.call("SADD", KEYS[2], ARGV[2])
+`)
+
+// moveScript moves the delta of the counter into the pending hash under a batch token, so the delta survives
+// a crash before it is applied to Postgres. The pending delta is returned as is if there is one already,
+// which is applied first, and false is returned if there is no delta.
+var moveScript = redis.NewScript(`
+local pending = redis.call("HGET", KEYS[2], ARGV[1])
+if pending then
+	return pending
+end
+redis.call("SREM", KEYS[3], ARGV[1])
+local delta = tonumber(redis.call("GET", KEYS[1]) or "0")
+if delta == 0 then
+	return false
+end
+if redis.call("DECRBY", KEYS[1], delta) == 0 then
+	redis.call("DEL", KEYS[1])
+end
+pending = ARGV[2] .. ":" .. delta
+redis.call("HSET", KEYS[2], ARGV[1], pending)
+return pending
+`)
+
+// Counters are the counters of a kind, e.g. the view counts of the videos, shared by the instances. The increments
+// are added to the deltas in Redis, which are cheap under contention, and the deltas are periodically moved into
+// the totals in Postgres by snapshots. A count is the total plus the deltas not snapshotted yet.
+//
+// A snapshot is crash safe: the delta is first moved into a pending entry along with a batch token in Redis,
+// then added to the total in Postgres unless the total has the token already, and the pending entry is removed
+// last. A snapshot interrupted at any step is completed by the next one of any instance without counting twice.
+// The increments since the last snapshot are lost only if Redis loses its data.
+type Counters struct {
+	name        string
+	redisClient *rediskit.RedisClient
+	pgClient    *pgkit.PGClient
+	conf        *CounterConfig
+	onSnapshot  SnapshotFunc
+	logger      *logkit.Logger
+}
+
+// SnapshotFunc is called with the total of each counter snapshotted, e.g. to copy the total to the row counted.
+type SnapshotFunc func(ctx context.Context, id string, total int64) error
+
+type countersOptions struct {
+	onSnapshot SnapshotFunc
+}
+
+type CountersOption func(opts *countersOptions)
+
+// WithSnapshotFunc calls fn with the total of each counter once its delta is added to the total. The pending delta
+// is removed only after fn returns, so fn is called again by the next snapshot if it fails, and it must be idempotent.
+func WithSnapshotFunc(fn SnapshotFunc) CountersOption {
+	return func(opts *countersOptions) {
+		opts.onSnapshot = fn
+	}
+}
+
+func NewCounters(ctx context.Context, name string, redisClient *rediskit.RedisClient, pgClient *pgkit.PGClient, conf *CounterConfig, opts ...CountersOption) *Counters {
+	var o countersOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	logger := logkit.FromContext(ctx).With(zap.String("counter", name))
+
+	if _, err := pgClient.ExecContext(ctx, createCounterTableQuery); err != nil {
+		logger.Fatal("failed to create counter table", zap.Error(err))
+	}
+
+	return &Counters{
+		name:        name,
+		redisClient: redisClient,
+		pgClient:    pgClient,
+		conf:        conf,
+		onSnapshot:  o.onSnapshot,
+		logger:      logger,
+	}
+}
+
+func (c *Counters) deltaKey(id string) string {
+	return fmt.Sprintf("counter:%s:delta:%s", c.name, id)
+}
+
+func (c *Counters) dirtyKey() string {
+	return fmt.Sprintf("counter:%s:dirty", c.name)
+}
+
+func (c *Counters) pendingKey() string {
+	return fmt.Sprintf("counter:%s:pending", c.name)
+}
+
+// Incr adds the delta to the counter, the delta may be negative, e.g. for an unlike.
+func (c *Counters) Incr(ctx context.Context, id string, delta int64) error {
+	return incrScript.Run(ctx, c.redisClient, []string{c.deltaKey(id), c.dirtyKey()}, delta, id).Err()
+}
+
+// Get returns the count of the counter, zero if it is never increased.
+func (c *Counters) Get(ctx context.Context, id string) (int64, error) {
+	counts, err := c.GetMulti(ctx, []string{id})
+	if err != nil {
+		return 0, err
+	}
+
+	return counts[id], nil
+}
+
+// counter is a row of the counters table.
+type counter struct {
+	ID    string
+	Value int64
+	Batch string
+}
+
+// GetMulti returns the counts of the counters, the totals and the deltas are not read atomically,
+// so a count racing a snapshot may be off by the increments of the snapshot for a moment.
+func (c *Counters) GetMulti(ctx context.Context, ids []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	deltaKeys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		deltaKeys = append(deltaKeys, c.deltaKey(id))
+	}
+
+	pipe := c.redisClient.Pipeline()
+	deltasCmd := pipe.MGet(ctx, deltaKeys...)
+	pendingCmd := pipe.HMGet(ctx, c.pendingKey(), ids...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	var rows []*counter
+	if _, err := c.pgClient.QueryContext(ctx, &rows, "SELECT id, value, batch FROM counters WHERE name = ? AND id IN (?)", c.name, pg.In(ids)); err != nil {
+		return nil, err
+	}
+
+	batches := make(map[string]string, len(rows))
+	for _, row := range rows {
+		counts[row.ID] = row.Value
+		batches[row.ID] = row.Batch
+	}
+
+	for i, id := range ids {
+		if s, ok := deltasCmd.Val()[i].(string); ok {
+			delta, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			counts[id] += delta
+		}
+
+		// the pending delta is counted unless the total has it already
+		if s, ok := pendingCmd.Val()[i].(string); ok {
+			batch, delta, err := parsePending(s)
+			if err != nil {
+				return nil, err
+			}
+			if batches[id] != batch {
+				counts[id] += delta
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// Snapshot moves the deltas of the changed counters into the totals in Postgres, the pending deltas left by
+// the interrupted snapshots are applied first. It returns the number of the snapshotted counters.
+func (c *Counters) Snapshot(ctx context.Context) (int, error) {
+	pendingIDs, err := c.redisClient.HKeys(ctx, c.pendingKey()).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	snapshotted := 0
+	for _, id := range pendingIDs {
+		if err := c.snapshot(ctx, id); err != nil {
+			return snapshotted, err
+		}
+		snapshotted++
+	}
+
+	iter := c.redisClient.SScan(ctx, c.dirtyKey(), 0, "", c.conf.SnapshotBatchSize).Iterator()
+	for iter.Next(ctx) {
+		if err := c.snapshot(ctx, iter.Val()); err != nil {
+			return snapshotted, err
+		}
+		snapshotted++
+	}
+
+	return snapshotted, iter.Err()
+}
+
+func (c *Counters) snapshot(ctx context.Context, id string) error {
+	pending, err := moveScript.Run(ctx, c.redisClient, []string{c.deltaKey(id), c.pendingKey(), c.dirtyKey()}, id, uuid.NewString()).Text()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	batch, delta, err := parsePending(pending)
+	if err != nil {
+		return err
+	}
+
+	// the delta of the batch is added only once, the row keeps the token of the last batch added
+	if _, err := c.pgClient.ExecContext(ctx, `INSERT INTO counters (name, id, value, batch) VALUES (?, ?, ?, ?)
+		ON CONFLICT (name, id) DO UPDATE SET value = counters.value + EXCLUDED.value, batch = EXCLUDED.batch, updated_at = now()
+		WHERE counters.batch IS DISTINCT FROM EXCLUDED.batch`, c.name, id, delta, batch); err != nil {
+		return err
+	}
+
+	if c.onSnapshot != nil {
+		var total int64
+		if _, err := c.pgClient.QueryOneContext(ctx, pg.Scan(&total), "SELECT value FROM counters WHERE name = ? AND id = ?", c.name, id); err != nil {
+			return err
+		}
+
+		if err := c.onSnapshot(ctx, id, total); err != nil {
+			return err
+		}
+	}
+
+	return c.redisClient.HDel(ctx, c.pendingKey(), id).Err()
+}
+
+// parsePending parses the pending delta in the format of batch:delta.
+func parsePending(s string) (string, int64, error) {
+	i := strings.LastIndex(s, ":")
+	if i < 0 {
+		return "", 0, fmt.Errorf("%w: %q", ErrInvalidPending, s)
+	}
+
+	delta, err := strconv.ParseInt(s[i+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %q", ErrInvalidPending, s)
+	}
+
+	return s[:i], delta, nil
+}
+
+// Schedule schedules the snapshots of the counters by the snapshot schedule. The scheduler locks each run in Redis,
+// so the snapshots run on one instance at a time, and a failed one is completed by the next run of any instance.
+func (c *Counters) Schedule(s *scheduler.Scheduler) error {
+	return s.Add("counter_"+c.name, c.conf.SnapshotSchedule, func(ctx context.Context) error {
+		snapshotted, err := c.Snapshot(ctx)
+		if err != nil {
+			return err
+		}
+
+		c.logger.Debug("snapshot counters", zap.Int("snapshotted", snapshotted))
+
+		return nil
+	})
+}
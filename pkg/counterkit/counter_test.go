@@ -0,0 +1,153 @@
+package counterkit
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("parsePending", func() {
+	It("parses the batch and the delta", func() {
+		batch, delta, err := parsePending("f47ac10b:-3")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(batch).To(Equal("f47ac10b"))
+		Expect(delta).To(Equal(int64(-3)))
+	})
+
+	It("rejects the invalid pending delta", func() {
+		_, _, err := parsePending("f47ac10b")
+		Expect(err).To(MatchError(ErrInvalidPending))
+	})
+})
+
+var _ = Describe("Counters", func() {
+	var (
+		ctx         context.Context
+		redisClient *rediskit.RedisClient
+		pgClient    *pgkit.PGClient
+		counters    *Counters
+		name        string
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		redisConf := &rediskit.RedisConfig{Addr: "localhost:6379"}
+		if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+			redisConf.Addr = addr
+		}
+		redisClient = rediskit.NewRedisClient(ctx, redisConf)
+
+		pgConf := &pgkit.PGConfig{URL: "postgres://postgres@postgres:5432/postgres?sslmode=disable"}
+		if url := os.Getenv("POSTGRES_URL"); url != "" {
+			pgConf.URL = url
+		}
+		pgClient = pgkit.NewPGClient(ctx, pgConf)
+
+		// the counters are unique to each test
+		name = "test-" + uuid.NewString()
+		counters = NewCounters(ctx, name, redisClient, pgClient, &CounterConfig{SnapshotBatchSize: 10})
+	})
+
+	AfterEach(func() {
+		_, err := pgClient.ExecContext(ctx, "DELETE FROM counters WHERE name = ?", name)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pgClient.Close()).To(Succeed())
+		Expect(redisClient.Close()).To(Succeed())
+	})
+
+	It("counts the increments before and after the snapshot", func() {
+		Expect(counters.Incr(ctx, "video-1", 2)).To(Succeed())
+		Expect(counters.Incr(ctx, "video-1", 1)).To(Succeed())
+		Expect(counters.Incr(ctx, "video-2", -1)).To(Succeed())
+
+		Expect(counters.GetMulti(ctx, []string{"video-1", "video-2", "video-3"})).To(Equal(map[string]int64{"video-1": 3, "video-2": -1}))
+
+		Expect(counters.Snapshot(ctx)).To(Equal(2))
+		Expect(counters.Get(ctx, "video-1")).To(Equal(int64(3)))
+
+		Expect(counters.Incr(ctx, "video-1", 4)).To(Succeed())
+		Expect(counters.Get(ctx, "video-1")).To(Equal(int64(7)))
+
+		Expect(counters.Snapshot(ctx)).To(Equal(1))
+		Expect(counters.Snapshot(ctx)).To(Equal(0))
+		Expect(counters.Get(ctx, "video-1")).To(Equal(int64(7)))
+	})
+
+	When("the snapshot is interrupted after the delta is moved", func() {
+		It("completes the snapshot without counting twice", func() {
+			Expect(counters.Incr(ctx, "video-1", 5)).To(Succeed())
+
+			_, err := moveScript.Run(ctx, redisClient, []string{counters.deltaKey("video-1"), counters.pendingKey(), counters.dirtyKey()}, "video-1", "interrupted").Result()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+
+			Expect(counters.Snapshot(ctx)).To(Equal(1))
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+		})
+	})
+
+	When("the snapshot is interrupted after the delta is added to the total", func() {
+		It("completes the snapshot without counting twice", func() {
+			Expect(counters.Incr(ctx, "video-1", 5)).To(Succeed())
+			Expect(counters.Snapshot(ctx)).To(Equal(1))
+
+			// the pending delta of the last batch is left behind
+			var batch string
+			_, err := pgClient.QueryOneContext(ctx, pg.Scan(&batch), "SELECT batch FROM counters WHERE name = ? AND id = ?", name, "video-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(redisClient.HSet(ctx, counters.pendingKey(), "video-1", batch+":5").Err()).To(Succeed())
+
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+			Expect(counters.Snapshot(ctx)).To(Equal(1))
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+		})
+	})
+
+	When("the snapshot func is set", func() {
+		var (
+			totals map[string]int64
+			failed bool
+		)
+
+		BeforeEach(func() {
+			totals, failed = make(map[string]int64), false
+			counters = NewCounters(ctx, name, redisClient, pgClient, &CounterConfig{SnapshotBatchSize: 10},
+				WithSnapshotFunc(func(ctx context.Context, id string, total int64) error {
+					if failed {
+						return errors.New("fake error")
+					}
+
+					totals[id] = total
+					return nil
+				}),
+			)
+		})
+
+		It("calls the func with the totals, again by the next snapshot if it fails", func() {
+			Expect(counters.Incr(ctx, "video-1", 2)).To(Succeed())
+			Expect(counters.Snapshot(ctx)).To(Equal(1))
+			Expect(totals).To(Equal(map[string]int64{"video-1": 2}))
+
+			failed = true
+			Expect(counters.Incr(ctx, "video-1", 3)).To(Succeed())
+			_, err := counters.Snapshot(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+
+			failed = false
+			Expect(counters.Snapshot(ctx)).To(Equal(1))
+			Expect(totals).To(Equal(map[string]int64{"video-1": 5}))
+			Expect(counters.Get(ctx, "video-1")).To(Equal(int64(5)))
+		})
+	})
+})
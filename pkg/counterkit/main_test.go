@@ -0,0 +1,13 @@
+package counterkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCounterKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Counter Kit")
+}
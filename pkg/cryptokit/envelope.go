@@ -0,0 +1,253 @@
+package cryptokit
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// envelopePrefix is the prefix of the encrypted values, followed by the ID of the data key and the base64 nonce
+// and ciphertext, e.g. enc:v1:2c5ea4c0-4067-11e9-8bad-9b1deb4d3b7d:<base64>
+const envelopePrefix = "enc:v1:"
+
+// dataKeySize is the size of the data keys, which are AES-256 keys
+const dataKeySize = 32
+
+// Envelope encrypts the sensitive values by envelope encryption: the values are encrypted by AES-GCM with the data
+// keys, which are wrapped by the master key of the KMS and kept in the key store, so the KMS is called only once for
+// each data key instead of each value. The data key encrypting the new values is replaced after the rotation, and
+// the data keys are rewrapped when the master key is rotated, which leaves the encrypted values as they are.
+//
+// The values not encrypted are decrypted as they are, so the encryption is enabled on the existing data, which is
+// encrypted as it is written from then on.
+type Envelope struct {
+	kms      KMS
+	store    KeyStore
+	rotation time.Duration
+	logger   *logkit.Logger
+
+	mu      sync.Mutex
+	current *DataKey
+	// aeads are the unwrapped data keys by their IDs
+	aeads sync.Map
+}
+
+// NewEnvelope returns the envelope of the KMS, it returns nil if the KMS is nil, which leaves the values in plaintext.
+func NewEnvelope(ctx context.Context, kms KMS, store KeyStore, conf *EncryptionConfig) *Envelope {
+	if kms == nil {
+		return nil
+	}
+
+	return &Envelope{
+		kms:      kms,
+		store:    store,
+		rotation: conf.DataKeyRotation,
+		logger:   logkit.FromContext(ctx),
+	}
+}
+
+// IsEncrypted reports whether the value is encrypted by an envelope.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// Encrypt encrypts the value by the current data key.
+func (e *Envelope) Encrypt(ctx context.Context, value string) (string, error) {
+	key, err := e.currentKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := e.aead(ctx, key.ID)
+	if err != nil {
+		return "", err
+	}
+
+	// the ID of the data key is authenticated, so a value cannot be decrypted as encrypted by another data key
+	sealed, err := seal(aead, []byte(value), key.ID[:])
+	if err != nil {
+		return "", err
+	}
+
+	return envelopePrefix + key.ID.String() + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the value encrypted by Encrypt, the values not encrypted are returned as they are.
+func (e *Envelope) Decrypt(ctx context.Context, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(value, envelopePrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", ErrInvalidCiphertext
+	}
+
+	id, err := uuid.Parse(parts[0])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+
+	aead, err := e.aead(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(aead, sealed, id[:])
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// currentKey returns the data key encrypting the new values, a new data key is created if there is none or
+// the latest one is older than the rotation. The instances pick up the latest data key of each other on start,
+// and create their own ones after the rotation, which is fine since every data key decrypts.
+func (e *Envelope) currentKey(ctx context.Context) (*DataKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.current != nil && !e.expired(e.current) {
+		return e.current, nil
+	}
+
+	if e.current == nil {
+		latest, err := e.store.Latest(ctx)
+		if err != nil && !errors.Is(err, ErrDataKeyNotFound) {
+			return nil, err
+		}
+
+		if latest != nil && !e.expired(latest) {
+			e.current = latest
+			return latest, nil
+		}
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	wrapped, kmsKeyID, err := e.kms.Wrap(ctx, dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &DataKey{
+		ID:        uuid.New(),
+		Wrapped:   wrapped,
+		KMSKeyID:  kmsKeyID,
+		CreatedAt: time.Now(),
+	}
+	if err := e.store.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	e.aeads.Store(key.ID, aead)
+
+	e.logger.Info("create data key", zap.Stringer("data_key_id", key.ID), zap.String("kms_key_id", kmsKeyID))
+	e.current = key
+
+	return key, nil
+}
+
+func (e *Envelope) expired(key *DataKey) bool {
+	return e.rotation > 0 && time.Since(key.CreatedAt) > e.rotation
+}
+
+// aead returns the data key of the ID, which is unwrapped by the KMS once and cached.
+func (e *Envelope) aead(ctx context.Context, id uuid.UUID) (cipher.AEAD, error) {
+	if aead, ok := e.aeads.Load(id); ok {
+		return aead.(cipher.AEAD), nil
+	}
+
+	key, err := e.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := e.kms.Unwrap(ctx, key.Wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	e.aeads.Store(id, aead)
+
+	return aead, nil
+}
+
+// DataKeys returns the number of the data keys by the IDs of the master keys wrapping them.
+func (e *Envelope) DataKeys(ctx context.Context) (map[string]int, error) {
+	keys, err := e.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int)
+	for _, key := range keys {
+		counts[key.KMSKeyID]++
+	}
+
+	return counts, nil
+}
+
+// Rewrap rewraps the data keys not wrapped by the current master key of the KMS, so the old master keys can be
+// removed from the KMS. It returns the number of the data keys rewrapped, and is safe to run again after failures.
+func (e *Envelope) Rewrap(ctx context.Context) (int, error) {
+	currentKeyID, err := e.kms.CurrentKeyID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	keys, err := e.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rewrapped := 0
+	for _, key := range keys {
+		if key.KMSKeyID == currentKeyID {
+			continue
+		}
+
+		dataKey, err := e.kms.Unwrap(ctx, key.Wrapped)
+		if err != nil {
+			return rewrapped, err
+		}
+
+		if key.Wrapped, key.KMSKeyID, err = e.kms.Wrap(ctx, dataKey); err != nil {
+			return rewrapped, err
+		}
+
+		if err := e.store.Rewrap(ctx, key); err != nil {
+			return rewrapped, err
+		}
+
+		rewrapped++
+	}
+
+	return rewrapped, nil
+}
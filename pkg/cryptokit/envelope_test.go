@@ -0,0 +1,104 @@
+package cryptokit
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Envelope", func() {
+	var (
+		ctx      context.Context
+		store    KeyStore
+		conf     *EncryptionConfig
+		envelope *Envelope
+	)
+
+	masterKey := func(id string, b byte) string {
+		return id + "=" + base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), 32)))
+	}
+
+	newEnvelope := func(keys ...string) *Envelope {
+		kms, err := NewLocalKMS(keys)
+		Expect(err).NotTo(HaveOccurred())
+
+		return NewEnvelope(ctx, kms, store, conf)
+	}
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		store = NewMemoryKeyStore()
+		conf = &EncryptionConfig{DataKeyRotation: time.Hour}
+		envelope = newEnvelope(masterKey("key-1", 'a'))
+	})
+
+	It("is disabled without KMS", func() {
+		Expect(NewEnvelope(ctx, nil, store, conf)).To(BeNil())
+	})
+
+	It("decrypts the encrypted values", func() {
+		encrypted, err := envelope.Encrypt(ctx, "reporter@example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsEncrypted(encrypted)).To(BeTrue())
+		Expect(encrypted).NotTo(ContainSubstring("reporter"))
+
+		Expect(envelope.Decrypt(ctx, encrypted)).To(Equal("reporter@example.com"))
+
+		// the other instances decrypt by the data keys in the store
+		Expect(newEnvelope(masterKey("key-1", 'a')).Decrypt(ctx, encrypted)).To(Equal("reporter@example.com"))
+	})
+
+	It("decrypts the values not encrypted as they are", func() {
+		Expect(envelope.Decrypt(ctx, "plaintext")).To(Equal("plaintext"))
+	})
+
+	It("rejects the altered values", func() {
+		encrypted, err := envelope.Encrypt(ctx, "reporter@example.com")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = envelope.Decrypt(ctx, encrypted[:len(encrypted)-4]+"AAAA")
+		Expect(err).To(MatchError(ErrInvalidCiphertext))
+	})
+
+	It("replaces the data key after the rotation", func() {
+		conf.DataKeyRotation = time.Nanosecond
+		envelope = newEnvelope(masterKey("key-1", 'a'))
+
+		first, err := envelope.Encrypt(ctx, "first")
+		Expect(err).NotTo(HaveOccurred())
+		second, err := envelope.Encrypt(ctx, "second")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(strings.Split(first, ":")[2]).NotTo(Equal(strings.Split(second, ":")[2]))
+		Expect(envelope.Decrypt(ctx, first)).To(Equal("first"))
+		Expect(envelope.DataKeys(ctx)).To(Equal(map[string]int{"key-1": 2}))
+	})
+
+	It("rewraps the data keys by the rotated master key", func() {
+		encrypted, err := envelope.Encrypt(ctx, "reporter@example.com")
+		Expect(err).NotTo(HaveOccurred())
+
+		rotated := newEnvelope(masterKey("key-2", 'b'), masterKey("key-1", 'a'))
+		Expect(rotated.Rewrap(ctx)).To(Equal(1))
+		Expect(rotated.Rewrap(ctx)).To(Equal(0))
+		Expect(rotated.DataKeys(ctx)).To(Equal(map[string]int{"key-2": 1}))
+
+		// the values are decrypted without the old master key once the data keys are rewrapped
+		Expect(newEnvelope(masterKey("key-2", 'b')).Decrypt(ctx, encrypted)).To(Equal("reporter@example.com"))
+	})
+
+	Describe("NewLocalKMS", func() {
+		It("rejects the invalid master keys", func() {
+			_, err := NewLocalKMS(nil)
+			Expect(err).To(MatchError(ErrInvalidMasterKey))
+
+			_, err = NewLocalKMS([]string{"key-1=" + base64.StdEncoding.EncodeToString([]byte("short"))})
+			Expect(err).To(MatchError(ErrInvalidMasterKey))
+		})
+	})
+})
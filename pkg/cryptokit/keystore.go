@@ -0,0 +1,185 @@
+package cryptokit
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/go-pg/pg/v10"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var ErrDataKeyNotFound = errors.New("data key not found")
+
+// DataKey is a data key wrapped by a master key of the KMS, it is a row of the data_keys table.
+type DataKey struct {
+	ID        uuid.UUID
+	Wrapped   string
+	KMSKeyID  string `pg:"kms_key_id"`
+	CreatedAt time.Time
+}
+
+// KeyStore keeps the wrapped data keys.
+type KeyStore interface {
+	Create(ctx context.Context, key *DataKey) error
+	Get(ctx context.Context, id uuid.UUID) (*DataKey, error)
+	// Latest returns the data key created last, it returns ErrDataKeyNotFound if there is none
+	Latest(ctx context.Context) (*DataKey, error)
+	List(ctx context.Context) ([]*DataKey, error)
+	// Rewrap replaces the wrapped data key and the ID of the master key wrapping it
+	Rewrap(ctx context.Context, key *DataKey) error
+}
+
+const createDataKeyTableQuery = `CREATE TABLE IF NOT EXISTS data_keys (
+	id uuid PRIMARY KEY,
+	wrapped text NOT NULL,
+	kms_key_id text NOT NULL,
+	created_at timestamptz NOT NULL DEFAULT now()
+)`
+
+type pgKeyStore struct {
+	client *pgkit.PGClient
+}
+
+var _ KeyStore = (*pgKeyStore)(nil)
+
+func NewPGKeyStore(ctx context.Context, client *pgkit.PGClient) *pgKeyStore {
+	if _, err := client.ExecContext(ctx, createDataKeyTableQuery); err != nil {
+		logkit.FromContext(ctx).Fatal("failed to create data key table", zap.Error(err))
+	}
+
+	return &pgKeyStore{client: client}
+}
+
+func (s *pgKeyStore) Create(ctx context.Context, key *DataKey) error {
+	_, err := s.client.ModelContext(ctx, key).Insert()
+	return err
+}
+
+func (s *pgKeyStore) Get(ctx context.Context, id uuid.UUID) (*DataKey, error) {
+	key := &DataKey{ID: id}
+	if err := s.client.ModelContext(ctx, key).WherePK().Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, ErrDataKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *pgKeyStore) Latest(ctx context.Context) (*DataKey, error) {
+	key := &DataKey{}
+	if err := s.client.ModelContext(ctx, key).Order("created_at DESC").Limit(1).Select(); err != nil {
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, ErrDataKeyNotFound
+		}
+
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *pgKeyStore) List(ctx context.Context) ([]*DataKey, error) {
+	var keys []*DataKey
+	if err := s.client.ModelContext(ctx, &keys).Order("created_at").Select(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (s *pgKeyStore) Rewrap(ctx context.Context, key *DataKey) error {
+	if res, err := s.client.ModelContext(ctx, key).Column("wrapped", "kms_key_id").WherePK().Update(); err != nil {
+		return err
+	} else if res.RowsAffected() == 0 {
+		return ErrDataKeyNotFound
+	}
+
+	return nil
+}
+
+// memoryKeyStore keeps the data keys in memory, it is meant for the tests and local development,
+// and the data encrypted is unreadable once the data keys are lost on exit.
+type memoryKeyStore struct {
+	mu   sync.RWMutex
+	keys map[uuid.UUID]DataKey
+}
+
+var _ KeyStore = (*memoryKeyStore)(nil)
+
+func NewMemoryKeyStore() *memoryKeyStore {
+	return &memoryKeyStore{keys: make(map[uuid.UUID]DataKey)}
+}
+
+func (s *memoryKeyStore) Create(_ context.Context, key *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = time.Now()
+	}
+	s.keys[key.ID] = *key
+
+	return nil
+}
+
+func (s *memoryKeyStore) Get(_ context.Context, id uuid.UUID) (*DataKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, ErrDataKeyNotFound
+	}
+
+	return &key, nil
+}
+
+func (s *memoryKeyStore) Latest(ctx context.Context) (*DataKey, error) {
+	keys, _ := s.List(ctx)
+	if len(keys) == 0 {
+		return nil, ErrDataKeyNotFound
+	}
+
+	return keys[len(keys)-1], nil
+}
+
+func (s *memoryKeyStore) List(context.Context) ([]*DataKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]*DataKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		key := key
+		keys = append(keys, &key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (s *memoryKeyStore) Rewrap(_ context.Context, key *DataKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.keys[key.ID]
+	if !ok {
+		return ErrDataKeyNotFound
+	}
+
+	stored.Wrapped, stored.KMSKeyID = key.Wrapped, key.KMSKeyID
+	s.keys[key.ID] = stored
+
+	return nil
+}
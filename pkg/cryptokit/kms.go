@@ -0,0 +1,56 @@
+package cryptokit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidMasterKey  = errors.New("invalid master key")
+	ErrUnknownMasterKey  = errors.New("unknown master key")
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+type EncryptionConfig struct {
+	KMS             string        `long:"kms" env:"KMS" description:"the KMS wrapping the data keys, the sensitive fields are not encrypted if none" choice:"none" choice:"local" choice:"vault" default:"none"`
+	LocalKeys       []string      `long:"local_keys" env:"LOCAL_KEYS" env-delim:"," description:"the master keys of the local KMS in the format of id=base64 key of 16, 24 or 32 bytes, the first key wraps the data keys and every key unwraps"`
+	VaultAddr       string        `long:"vault_addr" env:"VAULT_ADDR" description:"the address of Vault of the vault KMS" default:"http://localhost:8200"`
+	VaultToken      string        `long:"vault_token" env:"VAULT_TOKEN" description:"the token of Vault of the vault KMS"`
+	VaultKey        string        `long:"vault_key" env:"VAULT_KEY" description:"the name of the transit key of the vault KMS" default:"comment"`
+	DataKeyRotation time.Duration `long:"data_key_rotation" env:"DATA_KEY_ROTATION" description:"how long a data key encrypts the new values before it is replaced by a new one, never replaced if zero" default:"168h"`
+}
+
+// KMS wraps and unwraps the data keys by the master keys it keeps, so the data keys are stored along with the data
+// and the master keys never leave the KMS. The master keys are rotated by the KMS, after which the data keys are
+// rewrapped by the current master key, see Envelope.Rewrap.
+type KMS interface {
+	// CurrentKeyID returns the ID of the master key wrapping the data keys
+	CurrentKeyID(ctx context.Context) (string, error)
+	// Wrap encrypts the data key by the current master key, and returns it along with the ID of the master key
+	Wrap(ctx context.Context, dataKey []byte) (wrapped string, keyID string, err error)
+	// Unwrap decrypts the data key wrapped by any of the master keys
+	Unwrap(ctx context.Context, wrapped string) ([]byte, error)
+}
+
+// NewKMS returns the KMS of the config, it returns nil if the KMS is none, which leaves the fields in plaintext.
+func NewKMS(ctx context.Context, conf *EncryptionConfig) KMS {
+	logger := logkit.FromContext(ctx).With(zap.String("kms", conf.KMS))
+
+	switch conf.KMS {
+	case "local":
+		kms, err := NewLocalKMS(conf.LocalKeys)
+		if err != nil {
+			logger.Fatal("failed to create local KMS", zap.Error(err))
+		}
+
+		return kms
+	case "vault":
+		return NewVaultKMS(conf.VaultAddr, conf.VaultToken, conf.VaultKey)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+package cryptokit
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// LocalKMS keeps the master keys in the config, it is meant for local development and the deployments without
+// a KMS. The wrapped data keys are in the format of id:base64 nonce and ciphertext, the ID of the master key is
+// authenticated along with the data key, so a data key cannot be passed off as wrapped by another master key.
+type LocalKMS struct {
+	keys []masterKey
+}
+
+var _ KMS = (*LocalKMS)(nil)
+
+// NewLocalKMS returns the KMS of the master keys in the format of id=base64 key, the first key wraps the data keys
+// and every key unwraps, so the master keys are rotated by adding the new key first and removing the old one after
+// the data keys are rewrapped.
+func NewLocalKMS(keys []string) (*LocalKMS, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no master key", ErrInvalidMasterKey)
+	}
+
+	kms := &LocalKMS{}
+	for _, key := range keys {
+		i := strings.Index(key, "=")
+		if i <= 0 || strings.Contains(key[:i], ":") {
+			return nil, fmt.Errorf("%w: expect id=base64 key", ErrInvalidMasterKey)
+		}
+
+		secret, err := base64.StdEncoding.DecodeString(key[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidMasterKey, key[:i], err)
+		}
+
+		aead, err := newAEAD(secret)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidMasterKey, key[:i], err)
+		}
+
+		kms.keys = append(kms.keys, masterKey{id: key[:i], aead: aead})
+	}
+
+	return kms, nil
+}
+
+func (kms *LocalKMS) CurrentKeyID(context.Context) (string, error) {
+	return kms.keys[0].id, nil
+}
+
+func (kms *LocalKMS) Wrap(_ context.Context, dataKey []byte) (string, string, error) {
+	key := kms.keys[0]
+
+	sealed, err := seal(key.aead, dataKey, []byte(key.id))
+	if err != nil {
+		return "", "", err
+	}
+
+	return key.id + ":" + base64.StdEncoding.EncodeToString(sealed), key.id, nil
+}
+
+func (kms *LocalKMS) Unwrap(_ context.Context, wrapped string) ([]byte, error) {
+	i := strings.Index(wrapped, ":")
+	if i < 0 {
+		return nil, ErrInvalidCiphertext
+	}
+
+	id := wrapped[:i]
+	for _, key := range kms.keys {
+		if key.id != id {
+			continue
+		}
+
+		sealed, err := base64.StdEncoding.DecodeString(wrapped[i+1:])
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+
+		return open(key.aead, sealed, []byte(id))
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownMasterKey, id)
+}
+
+// newAEAD returns AES-GCM of the key of 16, 24 or 32 bytes.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce, which is prepended to the ciphertext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the ciphertext sealed by seal.
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return plaintext, nil
+}
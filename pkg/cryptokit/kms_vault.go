@@ -0,0 +1,122 @@
+package cryptokit
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// VaultKMS wraps the data keys by a transit key of Vault, the master key is rotated by Vault, e.g.
+//
+//	vault write -f transit/keys/comment/rotate
+//
+// and the ciphertexts of Vault are prefixed by the version of the key, e.g. vault:v2:..., which is the key ID.
+type VaultKMS struct {
+	addr    string
+	token   string
+	keyName string
+	client  *http.Client
+}
+
+var _ KMS = (*VaultKMS)(nil)
+
+func NewVaultKMS(addr, token, keyName string) *VaultKMS {
+	return &VaultKMS{
+		addr:    strings.TrimSuffix(addr, "/"),
+		token:   token,
+		keyName: keyName,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (kms *VaultKMS) CurrentKeyID(ctx context.Context) (string, error) {
+	var data struct {
+		LatestVersion int `json:"latest_version"`
+	}
+	if err := kms.do(ctx, http.MethodGet, "/v1/transit/keys/"+kms.keyName, nil, &data); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("vault:v%d", data.LatestVersion), nil
+}
+
+func (kms *VaultKMS) Wrap(ctx context.Context, dataKey []byte) (string, string, error) {
+	var data struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)}
+	if err := kms.do(ctx, http.MethodPost, "/v1/transit/encrypt/"+kms.keyName, req, &data); err != nil {
+		return "", "", err
+	}
+
+	keyID, err := vaultKeyID(data.Ciphertext)
+	if err != nil {
+		return "", "", err
+	}
+
+	return data.Ciphertext, keyID, nil
+}
+
+func (kms *VaultKMS) Unwrap(ctx context.Context, wrapped string) ([]byte, error) {
+	var data struct {
+		Plaintext string `json:"plaintext"`
+	}
+	req := map[string]string{"ciphertext": wrapped}
+	if err := kms.do(ctx, http.MethodPost, "/v1/transit/decrypt/"+kms.keyName, req, &data); err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(data.Plaintext)
+}
+
+// do sends the request to Vault and decodes the data of the response into data.
+func (kms *VaultKMS) do(ctx context.Context, method, path string, body, data interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, kms.addr+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", kms.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := kms.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var respBody struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+		return fmt.Errorf("vault %s %s: %s: %w", method, path, resp.Status, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("vault %s %s: %s: %s", method, path, resp.Status, strings.Join(respBody.Errors, "; "))
+	}
+
+	return json.Unmarshal(respBody.Data, data)
+}
+
+// vaultKeyID returns the version prefix of the ciphertext of Vault, e.g. vault:v2.
+func vaultKeyID(ciphertext string) (string, error) {
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" {
+		return "", ErrInvalidCiphertext
+	}
+
+	return parts[0] + ":" + parts[1], nil
+}
@@ -0,0 +1,74 @@
+package cryptokit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeTransit is a fake transit secrets engine of Vault, which "encrypts" by prefixing the key version
+func fakeTransit(version *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+			return
+		}
+
+		var body map[string]string
+		_ = json.NewDecoder(req.Body).Decode(&body)
+
+		var data interface{}
+		switch req.URL.Path {
+		case "/v1/transit/keys/comment":
+			data = map[string]int{"latest_version": *version}
+		case "/v1/transit/encrypt/comment":
+			data = map[string]string{"ciphertext": "vault:v" + strconv.Itoa(*version) + ":" + body["plaintext"]}
+		case "/v1/transit/decrypt/comment":
+			data = map[string]string{"plaintext": body["ciphertext"][strings.LastIndex(body["ciphertext"], ":")+1:]}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+}
+
+var _ = Describe("VaultKMS", func() {
+	var (
+		ctx     context.Context
+		version int
+		server  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		version = 1
+		server = httptest.NewServer(fakeTransit(&version))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("wraps the data keys by the latest version of the transit key", func() {
+		kms := NewVaultKMS(server.URL+"/", "token", "comment")
+
+		wrapped, keyID, err := kms.Wrap(ctx, []byte("data key"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyID).To(Equal("vault:v1"))
+		Expect(kms.Unwrap(ctx, wrapped)).To(Equal([]byte("data key")))
+
+		version = 2
+		Expect(kms.CurrentKeyID(ctx)).To(Equal("vault:v2"))
+	})
+
+	It("returns the errors of Vault", func() {
+		_, _, err := NewVaultKMS(server.URL, "invalid", "comment").Wrap(ctx, []byte("data key"))
+		Expect(err).To(MatchError(ContainSubstring("permission denied")))
+	})
+})
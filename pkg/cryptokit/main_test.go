@@ -0,0 +1,13 @@
+package cryptokit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCryptoKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Crypto Kit")
+}
@@ -0,0 +1,167 @@
+package discoverykit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// consulScheme is the scheme of the dial targets resolved by Consul, e.g. consul:///comment-api
+const consulScheme = "consul"
+
+// consulRetryInterval is the interval between the retries of the failed queries
+const consulRetryInterval = time.Second
+
+// consulBuilder builds the resolvers watching the healthy instances of the Consul services.
+type consulBuilder struct {
+	addr   string
+	wait   time.Duration
+	client *http.Client
+}
+
+var _ resolver.Builder = (*consulBuilder)(nil)
+
+func newConsulBuilder(addr string, wait time.Duration) *consulBuilder {
+	return &consulBuilder{
+		addr: strings.TrimSuffix(addr, "/"),
+		wait: wait,
+		// the client timeout must be longer than the blocking queries
+		client: &http.Client{Timeout: wait + 10*time.Second},
+	}
+}
+
+func (b *consulBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := &consulResolver{
+		builder: b,
+		service: strings.TrimPrefix(target.URL.Path, "/"),
+		cc:      cc,
+		cancel:  cancel,
+	}
+
+	go r.watch(ctx)
+
+	return r, nil
+}
+
+func (b *consulBuilder) Scheme() string {
+	return consulScheme
+}
+
+// consulResolver watches the healthy instances of a service with the blocking queries of Consul,
+// the instances failing the health checks of Consul are removed from the addresses.
+type consulResolver struct {
+	builder *consulBuilder
+	service string
+	cc      resolver.ClientConn
+	cancel  context.CancelFunc
+}
+
+var _ resolver.Resolver = (*consulResolver)(nil)
+
+// ResolveNow does nothing, since the changes are pushed by the blocking queries.
+func (r *consulResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (r *consulResolver) Close() {
+	r.cancel()
+}
+
+func (r *consulResolver) watch(ctx context.Context) {
+	var index uint64
+
+	for {
+		addrs, newIndex, err := r.query(ctx, index)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			r.cc.ReportError(err)
+
+			select {
+			case <-time.After(consulRetryInterval):
+			case <-ctx.Done():
+				return
+			}
+
+			continue
+		}
+
+		if newIndex != index {
+			if err := r.cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+				r.cc.ReportError(err)
+			}
+		}
+
+		// the index goes backwards when Consul resets, the watch starts over
+		if newIndex < index {
+			newIndex = 0
+		}
+		index = newIndex
+	}
+}
+
+type consulServiceEntry struct {
+	Node struct {
+		Address string `json:"Address"`
+	} `json:"Node"`
+	Service struct {
+		Address string `json:"Address"`
+		Port    int    `json:"Port"`
+	} `json:"Service"`
+}
+
+// query returns the addresses of the passing instances, it blocks until the index changes or the wait is over.
+func (r *consulResolver) query(ctx context.Context, index uint64) ([]resolver.Address, uint64, error) {
+	query := url.Values{}
+	query.Set("passing", "true")
+	query.Set("index", strconv.FormatUint(index, 10))
+	query.Set("wait", fmt.Sprintf("%ds", int(r.builder.wait.Seconds())))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.builder.addr+"/v1/health/service/"+url.PathEscape(r.service)+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := r.builder.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("failed to query Consul service %s: %s", r.service, resp.Status)
+	}
+
+	newIndex, err := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid Consul index: %w", err)
+	}
+
+	var entries []consulServiceEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, 0, err
+	}
+
+	addrs := make([]resolver.Address, 0, len(entries))
+	for _, entry := range entries {
+		// the service address defaults to the node address if not registered
+		host := entry.Service.Address
+		if host == "" {
+			host = entry.Node.Address
+		}
+
+		addrs = append(addrs, resolver.Address{Addr: net.JoinHostPort(host, strconv.Itoa(entry.Service.Port))})
+	}
+
+	return addrs, newIndex, nil
+}
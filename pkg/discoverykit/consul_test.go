@@ -0,0 +1,153 @@
+package discoverykit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	testpb "google.golang.org/grpc/interop/grpc_testing"
+)
+
+// fakeTestServer counts the requests served by the instance.
+type fakeTestServer struct {
+	testpb.UnimplementedTestServiceServer
+
+	calls int64
+}
+
+func (s *fakeTestServer) EmptyCall(context.Context, *testpb.Empty) (*testpb.Empty, error) {
+	atomic.AddInt64(&s.calls, 1)
+
+	return &testpb.Empty{}, nil
+}
+
+// fakeInstance is an instance of the service registered to the fake Consul.
+type fakeInstance struct {
+	server     *fakeTestServer
+	health     *health.Server
+	grpcServer *grpc.Server
+	port       int
+}
+
+func newFakeInstance() *fakeInstance {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	instance := &fakeInstance{
+		server:     &fakeTestServer{},
+		health:     health.NewServer(),
+		grpcServer: grpc.NewServer(),
+		port:       lis.Addr().(*net.TCPAddr).Port,
+	}
+	testpb.RegisterTestServiceServer(instance.grpcServer, instance.server)
+	healthpb.RegisterHealthServer(instance.grpcServer, instance.health)
+
+	go func() {
+		_ = instance.grpcServer.Serve(lis)
+	}()
+
+	return instance
+}
+
+// fakeConsul serves the health API of Consul for the instances of a service.
+type fakeConsul struct {
+	mu        sync.Mutex
+	index     int
+	instances []*fakeInstance
+}
+
+func (c *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := make([]consulServiceEntry, 0, len(c.instances))
+	for _, instance := range c.instances {
+		var entry consulServiceEntry
+		entry.Node.Address = "127.0.0.1"
+		entry.Service.Port = instance.port
+		entries = append(entries, entry)
+	}
+
+	w.Header().Set("X-Consul-Index", strconv.Itoa(c.index))
+	Expect(json.NewEncoder(w).Encode(entries)).To(Succeed())
+}
+
+var _ = Describe("consulResolver", func() {
+	var (
+		instances []*fakeInstance
+		consul    *httptest.Server
+		conn      *grpc.ClientConn
+	)
+
+	BeforeEach(func() {
+		instances = []*fakeInstance{newFakeInstance(), newFakeInstance()}
+		consul = httptest.NewServer(&fakeConsul{index: 1, instances: instances})
+
+		opts := append(DialOptions(&DiscoveryConfig{Discovery: DiscoveryConsul, ConsulAddr: consul.URL, ConsulWait: time.Second}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig":[{"round_robin":{}}],"healthCheckConfig":{"serviceName":""}}`),
+		)
+
+		var err error
+		conn, err = grpc.Dial(Target(&DiscoveryConfig{Discovery: DiscoveryConsul}, "fake-service"), opts...)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		consul.Close()
+		for _, instance := range instances {
+			instance.grpcServer.Stop()
+		}
+	})
+
+	call := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := testpb.NewTestServiceClient(conn).EmptyCall(ctx, &testpb.Empty{}, grpc.WaitForReady(true))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	calls := func(instance *fakeInstance) int64 {
+		return atomic.LoadInt64(&instance.server.calls)
+	}
+
+	It("spreads the requests over the instances", func() {
+		Eventually(func() bool {
+			call()
+			return calls(instances[0]) > 0 && calls(instances[1]) > 0
+		}).Should(BeTrue())
+	})
+
+	It("skips the unhealthy instances", func() {
+		instances[1].health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+		// wait for the health check to pick up the status
+		Eventually(func() int64 {
+			before := calls(instances[1])
+			for i := 0; i < 4; i++ {
+				call()
+			}
+			return calls(instances[1]) - before
+		}).Should(BeZero())
+
+		before := calls(instances[0])
+		for i := 0; i < 4; i++ {
+			call()
+		}
+		Expect(calls(instances[0]) - before).To(Equal(int64(4)))
+	})
+})
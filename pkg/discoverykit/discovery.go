@@ -0,0 +1,48 @@
+package discoverykit
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+
+	// registers the xds resolver and balancers
+	_ "google.golang.org/grpc/xds"
+)
+
+// The discovery mechanisms of the server addresses
+const (
+	DiscoveryStatic = "static"
+	DiscoveryDNS    = "dns"
+	DiscoveryConsul = "consul"
+	DiscoveryXDS    = "xds"
+)
+
+type DiscoveryConfig struct {
+	Discovery  string        `long:"discovery" env:"DISCOVERY" description:"how the server address is resolved: static dials it as is, dns resolves all the addresses of a Kubernetes headless service, consul resolves the healthy instances of a Consul service, and xds resolves it through the management server of GRPC_XDS_BOOTSTRAP" choice:"static" choice:"dns" choice:"consul" choice:"xds" default:"static"`
+	ConsulAddr string        `long:"consul_addr" env:"CONSUL_ADDR" description:"the address of the Consul HTTP API" default:"http://localhost:8500"`
+	ConsulWait time.Duration `long:"consul_wait" env:"CONSUL_WAIT" description:"how long a Consul blocking query waits for the changes of the service" default:"5m"`
+}
+
+// Target returns the gRPC dial target of the server address resolved by the discovery,
+// the address is the host and port for dns, and the service name for consul and xds.
+func Target(conf *DiscoveryConfig, addr string) string {
+	switch conf.Discovery {
+	case DiscoveryDNS:
+		return "dns:///" + addr
+	case DiscoveryConsul:
+		return consulScheme + ":///" + addr
+	case DiscoveryXDS:
+		return "xds:///" + addr
+	}
+
+	return addr
+}
+
+// DialOptions returns the dial options resolving the target of the discovery.
+func DialOptions(conf *DiscoveryConfig) []grpc.DialOption {
+	if conf.Discovery == DiscoveryConsul {
+		return []grpc.DialOption{grpc.WithResolvers(newConsulBuilder(conf.ConsulAddr, conf.ConsulWait))}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package discoverykit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target", func() {
+	DescribeTable("returns the target of the discovery",
+		func(discovery, target string) {
+			Expect(Target(&DiscoveryConfig{Discovery: discovery}, "comment-api:8081")).To(Equal(target))
+		},
+		Entry("static", DiscoveryStatic, "comment-api:8081"),
+		Entry("dns", DiscoveryDNS, "dns:///comment-api:8081"),
+		Entry("consul", DiscoveryConsul, "consul:///comment-api:8081"),
+		Entry("xds", DiscoveryXDS, "xds:///comment-api:8081"),
+	)
+})
@@ -0,0 +1,13 @@
+package discoverykit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDiscoveryKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Discovery Kit")
+}
@@ -0,0 +1,89 @@
+package eventkit
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CheckCompatibility reports whether consumers built against the previous
+// descriptor can still read payloads written with the next descriptor.
+//
+// The check is one-directional and walks the fields of the previous
+// descriptor only: every field must either keep its number, kind,
+// cardinality, message type and enum values in the next descriptor, or be
+// removed with its number reserved so that it will never be reused.
+// Fields added in the next descriptor are always allowed.
+//
+// Schema changes failing the check must bump the MinCompatibleVersion.
+func CheckCompatibility(prev, next protoreflect.MessageDescriptor) error {
+	return checkMessageCompatibility(prev, next, map[[2]protoreflect.FullName]bool{})
+}
+
+func checkMessageCompatibility(prev, next protoreflect.MessageDescriptor, visited map[[2]protoreflect.FullName]bool) error {
+	key := [2]protoreflect.FullName{prev.FullName(), next.FullName()}
+	if visited[key] {
+		return nil
+	}
+	visited[key] = true
+
+	prevFields := prev.Fields()
+	for i := 0; i < prevFields.Len(); i++ {
+		prevField := prevFields.Get(i)
+
+		nextField := next.Fields().ByNumber(prevField.Number())
+		if nextField == nil {
+			if !next.ReservedRanges().Has(prevField.Number()) {
+				return fmt.Errorf("%w: %s field %d is removed without being reserved", ErrIncompatibleSchema, prev.FullName(), prevField.Number())
+			}
+
+			continue
+		}
+
+		if err := checkFieldCompatibility(prevField, nextField, visited); err != nil {
+			return fmt.Errorf("%s field %d %w", prev.FullName(), prevField.Number(), err)
+		}
+	}
+
+	return nil
+}
+
+func checkFieldCompatibility(prev, next protoreflect.FieldDescriptor, visited map[[2]protoreflect.FullName]bool) error {
+	if prev.Cardinality() != next.Cardinality() {
+		return fmt.Errorf("changes cardinality from %s to %s: %w", prev.Cardinality(), next.Cardinality(), ErrIncompatibleSchema)
+	}
+
+	if prev.Kind() != next.Kind() {
+		return fmt.Errorf("changes kind from %s to %s: %w", prev.Kind(), next.Kind(), ErrIncompatibleSchema)
+	}
+
+	if prev.IsMap() != next.IsMap() {
+		return fmt.Errorf("changes between map and list: %w", ErrIncompatibleSchema)
+	}
+
+	if prev.Message() != nil {
+		if prev.Message().FullName() != next.Message().FullName() {
+			return fmt.Errorf("changes message type from %s to %s: %w", prev.Message().FullName(), next.Message().FullName(), ErrIncompatibleSchema)
+		}
+
+		if err := checkMessageCompatibility(prev.Message(), next.Message(), visited); err != nil {
+			return fmt.Errorf("is incompatible: %w", err)
+		}
+	}
+
+	if prev.Enum() != nil {
+		if prev.Enum().FullName() != next.Enum().FullName() {
+			return fmt.Errorf("changes enum type from %s to %s: %w", prev.Enum().FullName(), next.Enum().FullName(), ErrIncompatibleSchema)
+		}
+
+		prevValues, nextValues := prev.Enum().Values(), next.Enum()
+		for i := 0; i < prevValues.Len(); i++ {
+			number := prevValues.Get(i).Number()
+			if nextValues.Values().ByNumber(number) == nil && !nextValues.ReservedRanges().Has(number) {
+				return fmt.Errorf("removes enum value %d without being reserved: %w", number, ErrIncompatibleSchema)
+			}
+		}
+	}
+
+	return nil
+}
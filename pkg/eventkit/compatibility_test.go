@@ -0,0 +1,174 @@
+package eventkit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("CheckCompatibility", func() {
+	var (
+		prev protoreflect.MessageDescriptor
+		next protoreflect.MessageDescriptor
+
+		err error
+	)
+
+	JustBeforeEach(func() {
+		err = CheckCompatibility(prev, next)
+	})
+
+	When("fields keep their numbers and kinds", func() {
+		BeforeEach(func() {
+			prev = (&durationpb.Duration{}).ProtoReflect().Descriptor()
+			next = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns no error", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("fields are added", func() {
+		BeforeEach(func() {
+			prev = (&emptypb.Empty{}).ProtoReflect().Descriptor()
+			next = (&durationpb.Duration{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns no error", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("fields are removed without reservation", func() {
+		BeforeEach(func() {
+			prev = (&durationpb.Duration{}).ProtoReflect().Descriptor()
+			next = (&emptypb.Empty{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns incompatible schema error", func() {
+			Expect(err).To(MatchError(ErrIncompatibleSchema))
+		})
+	})
+
+	When("field kind changes", func() {
+		BeforeEach(func() {
+			prev = (&wrapperspb.Int64Value{}).ProtoReflect().Descriptor()
+			next = (&wrapperspb.StringValue{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns incompatible schema error", func() {
+			Expect(err).To(MatchError(ErrIncompatibleSchema))
+		})
+	})
+
+	When("field cardinality changes", func() {
+		BeforeEach(func() {
+			prev = (&wrapperspb.StringValue{}).ProtoReflect().Descriptor()
+			next = (&fieldmaskpb.FieldMask{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns incompatible schema error", func() {
+			Expect(err).To(MatchError(ErrIncompatibleSchema))
+		})
+	})
+
+	When("field changes between map and list", func() {
+		BeforeEach(func() {
+			prev = (&structpb.Struct{}).ProtoReflect().Descriptor()
+			next = (&structpb.ListValue{}).ProtoReflect().Descriptor()
+		})
+
+		It("returns incompatible schema error", func() {
+			Expect(err).To(MatchError(ErrIncompatibleSchema))
+		})
+	})
+
+	When("message field changes its type", func() {
+		BeforeEach(func() {
+			prev = newEventDescriptor(`
+				message_type: { name: "Event" field: { name: "at" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.First" } }
+				message_type: { name: "First" field: { name: "value" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 } }
+				message_type: { name: "Second" field: { name: "value" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 } }
+			`)
+			next = newEventDescriptor(`
+				message_type: { name: "Event" field: { name: "at" number: 1 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.Second" } }
+				message_type: { name: "First" field: { name: "value" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 } }
+				message_type: { name: "Second" field: { name: "value" number: 1 label: LABEL_OPTIONAL type: TYPE_INT64 } }
+			`)
+		})
+
+		It("returns incompatible schema error", func() {
+			Expect(err).To(MatchError(ErrIncompatibleSchema))
+		})
+	})
+
+	Context("enum field", func() {
+		BeforeEach(func() {
+			prev = newEventDescriptor(`
+				message_type: { name: "Event" field: { name: "status" number: 1 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.Status" } }
+				enum_type: { name: "Status" value: { name: "UNKNOWN" number: 0 } value: { name: "DONE" number: 1 } }
+			`)
+		})
+
+		When("enum values are removed without reservation", func() {
+			BeforeEach(func() {
+				next = newEventDescriptor(`
+					message_type: { name: "Event" field: { name: "status" number: 1 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.Status" } }
+					enum_type: { name: "Status" value: { name: "UNKNOWN" number: 0 } }
+				`)
+			})
+
+			It("returns incompatible schema error", func() {
+				Expect(err).To(MatchError(ErrIncompatibleSchema))
+			})
+		})
+
+		When("enum values are removed and reserved", func() {
+			BeforeEach(func() {
+				next = newEventDescriptor(`
+					message_type: { name: "Event" field: { name: "status" number: 1 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.Status" } }
+					enum_type: { name: "Status" value: { name: "UNKNOWN" number: 0 } reserved_range: { start: 1 end: 1 } }
+				`)
+			})
+
+			It("returns no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("enum values are added", func() {
+			BeforeEach(func() {
+				next = newEventDescriptor(`
+					message_type: { name: "Event" field: { name: "status" number: 1 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.Status" } }
+					enum_type: { name: "Status" value: { name: "UNKNOWN" number: 0 } value: { name: "DONE" number: 1 } value: { name: "FAILED" number: 2 } }
+				`)
+			})
+
+			It("returns no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+})
+
+// newEventDescriptor builds the descriptor of the "test.Event" message from
+// the text format of a file descriptor.
+func newEventDescriptor(file string) protoreflect.MessageDescriptor {
+	fdp := &descriptorpb.FileDescriptorProto{}
+	Expect(prototext.Unmarshal([]byte(`name: "test.proto" package: "test" syntax: "proto3" `+file), fdp)).To(Succeed())
+
+	fd, err := protodesc.NewFile(fdp, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	return fd.Messages().ByName("Event")
+}
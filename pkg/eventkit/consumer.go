@@ -0,0 +1,85 @@
+package eventkit
+
+import (
+	"github.com/Shopify/sarama"
+	"github.com/justin0u0/protoc-gen-grpc-sarama/pkg/saramakit"
+)
+
+// ConsumerGroupHandler wraps a generated sarama handler so that every
+// consumed message passes Schema.Check before reaching the handler.
+//
+// Messages failing the check or carrying a payload the schema cannot read
+// are unretryable, they are logged, marked as consumed and never handed to
+// the wrapped handler, the same way the generated handlers skip messages
+// failing to unmarshal.
+//
+// Each message is handed to the wrapped handler alone, along with a session
+// whose context carries the span of the message, the child of the span of
+// the producer. A message left unmarked by the wrapped handler is retried,
+// so the claim stops there, the same way the generated handlers return on
+// retryable errors.
+type ConsumerGroupHandler struct {
+	sarama.ConsumerGroupHandler
+
+	schema *Schema
+	logger saramakit.Logger
+}
+
+var _ sarama.ConsumerGroupHandler = (*ConsumerGroupHandler)(nil)
+
+func NewConsumerGroupHandler(schema *Schema, handler sarama.ConsumerGroupHandler, logger saramakit.Logger) *ConsumerGroupHandler {
+	return &ConsumerGroupHandler{
+		ConsumerGroupHandler: handler,
+		schema:               schema,
+		logger:               logger.With("EventType", string(schema.Name())),
+	}
+}
+
+func (h *ConsumerGroupHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		if err := h.schema.Unmarshal(msg, h.schema.newMessage()); err != nil {
+			// unretryable failure, skip and consume the message
+			h.logger.Error("failed to check event schema", err)
+			sess.MarkMessage(msg, "")
+
+			continue
+		}
+
+		msess := newMessageSession(sess, msg)
+		err := h.ConsumerGroupHandler.ConsumeClaim(msess, newMessageClaim(claim, msg))
+		msess.end(err)
+
+		if err != nil {
+			return err
+		}
+
+		if !msess.marked() {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// messageClaim replaces the messages of a claim with a single message.
+type messageClaim struct {
+	sarama.ConsumerGroupClaim
+
+	messages chan *sarama.ConsumerMessage
+}
+
+func newMessageClaim(claim sarama.ConsumerGroupClaim, msg *sarama.ConsumerMessage) *messageClaim {
+	c := &messageClaim{
+		ConsumerGroupClaim: claim,
+		messages:           make(chan *sarama.ConsumerMessage, 1),
+	}
+
+	c.messages <- msg
+	close(c.messages)
+
+	return c
+}
+
+func (c *messageClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
@@ -0,0 +1,159 @@
+package eventkit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// tracingHandler records the events consumed along with the traces of the contexts they are handled in,
+// and marks them unless skipped.
+type tracingHandler struct {
+	mu       sync.Mutex
+	consumed []string
+	traceIDs map[string]trace.TraceID
+	skip     map[string]int
+}
+
+func (h *tracingHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *tracingHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *tracingHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		var event wrapperspb.StringValue
+		if err := proto.Unmarshal(msg.Value, &event); err != nil {
+			return err
+		}
+
+		h.mu.Lock()
+		h.consumed = append(h.consumed, event.GetValue())
+		h.traceIDs[event.GetValue()] = trace.SpanContextFromContext(sess.Context()).TraceID()
+		skip := h.skip[event.GetValue()] > 0
+		if skip {
+			h.skip[event.GetValue()]--
+		}
+		h.mu.Unlock()
+
+		if skip {
+			return nil
+		}
+
+		sess.MarkMessage(msg, "")
+	}
+
+	return nil
+}
+
+func (h *tracingHandler) values() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.consumed...)
+}
+
+func (h *tracingHandler) traceID(value string) trace.TraceID {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.traceIDs[value]
+}
+
+var _ = Describe("ConsumerGroupHandler", func() {
+	var (
+		bus     *MemoryBus
+		schema  *Schema
+		handler *tracingHandler
+		cancel  context.CancelFunc
+		done    chan struct{}
+	)
+
+	BeforeEach(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		bus = NewMemoryBus("video")
+		schema = MustNewSchema(&wrapperspb.StringValue{}, 1, 1)
+		handler = &tracingHandler{
+			traceIDs: make(map[string]trace.TraceID),
+			skip:     make(map[string]int),
+		}
+	})
+
+	JustBeforeEach(func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+
+		consumerHandler := NewConsumerGroupHandler(schema, handler, logkit.NewSaramaLogger(logkit.NewNopLogger()))
+
+		go func() {
+			defer close(done)
+			Expect(bus.Consume(ctx, consumerHandler)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(bus.Close()).To(Succeed())
+
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	// send sends the event in a trace of its own, and returns the trace
+	send := func(value string) trace.TraceID {
+		ctx, span := otel.Tracer("test").Start(context.Background(), "produce")
+		defer span.End()
+
+		msg, err := schema.Marshal(nil, wrapperspb.String(value))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bus.SendMessages(ctx, []*kafkakit.ProducerMessage{msg})).To(Succeed())
+
+		return span.SpanContext().TraceID()
+	}
+
+	It("handles each event in the trace of its producer", func() {
+		traceIDs := map[string]trace.TraceID{
+			"1": send("1"),
+			"2": send("2"),
+			"3": send("3"),
+		}
+
+		Eventually(handler.values).Should(Equal([]string{"1", "2", "3"}))
+		for value, traceID := range traceIDs {
+			Expect(handler.traceID(value)).To(Equal(traceID))
+		}
+	})
+
+	It("skips the events the schema cannot read", func() {
+		Expect(bus.SendMessages(context.Background(), []*kafkakit.ProducerMessage{{Value: []byte("not a payload")}})).To(Succeed())
+		send("1")
+
+		Eventually(handler.values).Should(Equal([]string{"1"}))
+	})
+
+	When("the handler does not mark an event", func() {
+		BeforeEach(func() {
+			handler.skip["2"] = 1
+		})
+
+		It("retries the event before the later ones", func() {
+			send("1")
+			send("2")
+			send("3")
+
+			Eventually(handler.values, "3s").Should(Equal([]string{"1", "2", "2", "3"}))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package eventkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEventKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Event Kit")
+}
@@ -0,0 +1,280 @@
+package eventkit
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/Shopify/sarama"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// memoryRedeliveryDelay is the delay before the messages not marked by the handler are delivered again
+const memoryRedeliveryDelay = time.Second
+
+// MemoryBus is the in-process transport of a topic, both the producer and the consumer of the topic,
+// for running all the modules in one process without Kafka or NATS. The messages are kept in memory only,
+// so they are lost on exit, and the messages not marked by the handler are delivered again like NATS.
+type MemoryBus struct {
+	topic string
+
+	mu     sync.Mutex
+	queue  []*sarama.ConsumerMessage
+	offset int64
+	notify chan struct{}
+}
+
+var (
+	_ Producer = (*MemoryBus)(nil)
+	_ Consumer = (*MemoryBus)(nil)
+)
+
+func NewMemoryBus(topic string) *MemoryBus {
+	return &MemoryBus{
+		topic:  topic,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// SendMessages queues the messages without blocking, so the handlers can send messages to the topic they consume.
+func (b *MemoryBus) SendMessages(ctx context.Context, msgs []*kafkakit.ProducerMessage) error {
+	ctx, span := otelkit.Tracer().Start(ctx, b.topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("memory"),
+			semconv.MessagingDestinationKey.String(b.topic),
+			semconv.MessagingDestinationKindTopic,
+		),
+	)
+
+	b.mu.Lock()
+	for _, msg := range msgs {
+		b.queue = append(b.queue, newMemoryConsumerMessage(b.topic, b.offset, msg.Key, msg.Value, kafkakit.TraceHeaders(ctx, msg.Headers)))
+		b.offset++
+	}
+	b.mu.Unlock()
+
+	b.wake()
+
+	otelkit.EndSpan(span, nil)
+
+	return nil
+}
+
+// Consume runs a consumer group session for every batch of the queued messages until the context is done.
+func (b *MemoryBus) Consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.notify:
+		}
+
+		msgs := b.take()
+		if len(msgs) == 0 {
+			continue
+		}
+
+		unmarked, err := b.consumeBatch(ctx, handler, msgs)
+		if len(unmarked) > 0 {
+			b.requeue(unmarked)
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(memoryRedeliveryDelay):
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (b *MemoryBus) consumeBatch(ctx context.Context, handler sarama.ConsumerGroupHandler, msgs []*sarama.ConsumerMessage) ([]*sarama.ConsumerMessage, error) {
+	sess := &memorySession{ctx: ctx, marked: msgs[0].Offset}
+	claim := newMemoryClaim(b.topic, msgs)
+
+	if err := handler.Setup(sess); err != nil {
+		return msgs, err
+	}
+
+	consumeErr := handler.ConsumeClaim(sess, claim)
+	cleanupErr := handler.Cleanup(sess)
+
+	unmarked := msgs[:0:0]
+	for _, msg := range msgs {
+		if msg.Offset >= sess.markedOffset() {
+			unmarked = append(unmarked, msg)
+		}
+	}
+
+	if consumeErr != nil {
+		return unmarked, consumeErr
+	}
+
+	return unmarked, cleanupErr
+}
+
+// Close drops the queued messages.
+func (b *MemoryBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queue = nil
+
+	return nil
+}
+
+func (b *MemoryBus) take() []*sarama.ConsumerMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msgs := b.queue
+	b.queue = nil
+
+	return msgs
+}
+
+// requeue puts the messages back in front of the queue, so they are delivered in order.
+func (b *MemoryBus) requeue(msgs []*sarama.ConsumerMessage) {
+	b.mu.Lock()
+	b.queue = append(msgs, b.queue...)
+	b.mu.Unlock()
+
+	b.wake()
+}
+
+func (b *MemoryBus) wake() {
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+func newMemoryConsumerMessage(topic string, offset int64, key, value []byte, headers map[string]string) *sarama.ConsumerMessage {
+	// sort header keys so that the same message always produces the same record, like the producers
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	recordHeaders := make([]*sarama.RecordHeader, 0, len(keys))
+	for _, k := range keys {
+		recordHeaders = append(recordHeaders, &sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(headers[k]),
+		})
+	}
+
+	return &sarama.ConsumerMessage{
+		Headers:   recordHeaders,
+		Timestamp: time.Now(),
+		Key:       key,
+		Value:     value,
+		Topic:     topic,
+		Offset:    offset,
+	}
+}
+
+// memorySession adapts a batch to sarama.ConsumerGroupSession, marking an offset marks every message before it.
+type memorySession struct {
+	ctx context.Context
+
+	mu     sync.Mutex
+	marked int64
+}
+
+var _ sarama.ConsumerGroupSession = (*memorySession)(nil)
+
+func (s *memorySession) Claims() map[string][]int32 {
+	return nil
+}
+
+func (s *memorySession) MemberID() string {
+	return ""
+}
+
+func (s *memorySession) GenerationID() int32 {
+	return 0
+}
+
+func (s *memorySession) MarkOffset(topic string, partition int32, offset int64, metadata string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if offset > s.marked {
+		s.marked = offset
+	}
+}
+
+func (s *memorySession) Commit() {}
+
+func (s *memorySession) ResetOffset(topic string, partition int32, offset int64, metadata string) {}
+
+func (s *memorySession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+func (s *memorySession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *memorySession) markedOffset() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.marked
+}
+
+// memoryClaim adapts a batch to sarama.ConsumerGroupClaim, the messages channel is closed
+// after the batch, so the handler returns once the batch is consumed.
+type memoryClaim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+	initial  int64
+	high     int64
+}
+
+var _ sarama.ConsumerGroupClaim = (*memoryClaim)(nil)
+
+func newMemoryClaim(topic string, msgs []*sarama.ConsumerMessage) *memoryClaim {
+	c := &memoryClaim{
+		topic:    topic,
+		messages: make(chan *sarama.ConsumerMessage, len(msgs)),
+		initial:  msgs[0].Offset,
+		high:     msgs[len(msgs)-1].Offset + 1,
+	}
+
+	for _, msg := range msgs {
+		c.messages <- msg
+	}
+	close(c.messages)
+
+	return c
+}
+
+func (c *memoryClaim) Topic() string {
+	return c.topic
+}
+
+func (c *memoryClaim) Partition() int32 {
+	return 0
+}
+
+func (c *memoryClaim) InitialOffset() int64 {
+	return c.initial
+}
+
+func (c *memoryClaim) HighWaterMarkOffset() int64 {
+	return c.high
+}
+
+func (c *memoryClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
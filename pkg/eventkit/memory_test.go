@@ -0,0 +1,107 @@
+package eventkit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingHandler records the values of the consumed messages, and marks them unless skipped.
+type recordingHandler struct {
+	mu       sync.Mutex
+	consumed []string
+	skip     map[string]int
+}
+
+func (h *recordingHandler) Setup(sarama.ConsumerGroupSession) error   { return nil }
+func (h *recordingHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *recordingHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		h.mu.Lock()
+		h.consumed = append(h.consumed, string(msg.Value))
+		skip := h.skip[string(msg.Value)] > 0
+		if skip {
+			h.skip[string(msg.Value)]--
+		}
+		h.mu.Unlock()
+
+		if skip {
+			return nil
+		}
+
+		sess.MarkMessage(msg, "")
+	}
+
+	return nil
+}
+
+func (h *recordingHandler) values() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.consumed...)
+}
+
+var _ = Describe("MemoryBus", func() {
+	var (
+		bus     *MemoryBus
+		handler *recordingHandler
+		cancel  context.CancelFunc
+		done    chan struct{}
+	)
+
+	BeforeEach(func() {
+		bus = NewMemoryBus("video")
+		handler = &recordingHandler{skip: make(map[string]int)}
+	})
+
+	JustBeforeEach(func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+
+		go func() {
+			defer close(done)
+			Expect(bus.Consume(ctx, handler)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+		Expect(bus.Close()).To(Succeed())
+	})
+
+	send := func(values ...string) {
+		msgs := make([]*kafkakit.ProducerMessage, 0, len(values))
+		for _, value := range values {
+			msgs = append(msgs, &kafkakit.ProducerMessage{Value: []byte(value)})
+		}
+
+		Expect(bus.SendMessages(context.Background(), msgs)).To(Succeed())
+	}
+
+	It("consumes the messages in order", func() {
+		send("1", "2")
+		send("3")
+
+		Eventually(handler.values).Should(Equal([]string{"1", "2", "3"}))
+	})
+
+	When("the handler does not mark a message", func() {
+		BeforeEach(func() {
+			handler.skip["2"] = 1
+		})
+
+		It("delivers the unmarked messages again", func() {
+			send("1", "2", "3")
+
+			Eventually(handler.values, "3s").Should(Equal([]string{"1", "2", "2", "3"}))
+		})
+	})
+})
@@ -0,0 +1,188 @@
+package eventkit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/Shopify/sarama"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Headers attached to every event produced through a schema,
+// consumers use them to decide whether the payload is readable.
+const (
+	HeaderEventType                 = "event-type"
+	HeaderEventVersion              = "event-version"
+	HeaderEventMinCompatibleVersion = "event-min-compatible-version"
+)
+
+var (
+	ErrEventTypeMismatch    = errors.New("event type mismatch")
+	ErrIncompatibleVersion  = errors.New("incompatible event version")
+	ErrInvalidVersionHeader = errors.New("invalid event version header")
+	ErrInvalidSchemaVersion = errors.New("invalid schema version")
+	ErrIncompatibleSchema   = errors.New("incompatible schema")
+)
+
+// Schema is a versioned contract of an event payload.
+//
+// Version must be bumped whenever the payload message changes, and
+// MinCompatibleVersion must be bumped whenever the change cannot be read
+// by consumers built against an older version (see CheckCompatibility).
+type Schema struct {
+	msg                  proto.Message
+	version              uint32
+	minCompatibleVersion uint32
+}
+
+// NewSchema creates the schema of the given event payload message.
+func NewSchema(msg proto.Message, version, minCompatibleVersion uint32) (*Schema, error) {
+	if version == 0 || minCompatibleVersion == 0 || minCompatibleVersion > version {
+		return nil, fmt.Errorf("%w: version %d, min compatible version %d", ErrInvalidSchemaVersion, version, minCompatibleVersion)
+	}
+
+	return &Schema{
+		msg:                  msg,
+		version:              version,
+		minCompatibleVersion: minCompatibleVersion,
+	}, nil
+}
+
+// MustNewSchema is like NewSchema but panics on error,
+// it simplifies the declaration of package level schemas.
+func MustNewSchema(msg proto.Message, version, minCompatibleVersion uint32) *Schema {
+	schema, err := NewSchema(msg, version, minCompatibleVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	return schema
+}
+
+// Name returns the fully-qualified name of the event payload message.
+func (s *Schema) Name() protoreflect.FullName {
+	return s.Descriptor().FullName()
+}
+
+// Version returns the version of payloads produced with this schema.
+func (s *Schema) Version() uint32 {
+	return s.version
+}
+
+// MinCompatibleVersion returns the oldest consumer version that is able
+// to read payloads produced with this schema.
+func (s *Schema) MinCompatibleVersion() uint32 {
+	return s.minCompatibleVersion
+}
+
+// Descriptor returns the descriptor of the event payload message.
+//
+// The descriptor is resolved on every call instead of in NewSchema, since
+// package level schemas are initialized before the generated file
+// descriptors are built.
+func (s *Schema) Descriptor() protoreflect.MessageDescriptor {
+	return s.msg.ProtoReflect().Descriptor()
+}
+
+// Marshal serializes the event into a producer message with schema headers.
+func (s *Schema) Marshal(key []byte, msg proto.Message) (*kafkakit.ProducerMessage, error) {
+	if err := s.checkType(msg); err != nil {
+		return nil, err
+	}
+
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkakit.ProducerMessage{
+		Key:   key,
+		Value: value,
+		Headers: map[string]string{
+			HeaderEventType:                 string(s.Name()),
+			HeaderEventVersion:              strconv.FormatUint(uint64(s.version), 10),
+			HeaderEventMinCompatibleVersion: strconv.FormatUint(uint64(s.minCompatibleVersion), 10),
+		},
+	}, nil
+}
+
+// Unmarshal deserializes the consumed message into the event payload
+// after the schema headers pass Check.
+func (s *Schema) Unmarshal(cmsg *sarama.ConsumerMessage, msg proto.Message) error {
+	if err := s.checkType(msg); err != nil {
+		return err
+	}
+
+	if err := s.Check(cmsg); err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(cmsg.Value, msg)
+}
+
+// Check reports whether the consumed message is readable by this schema.
+//
+// Messages without schema headers are produced before schemas are
+// introduced and are treated as the first version.
+func (s *Schema) Check(cmsg *sarama.ConsumerMessage) error {
+	headers := make(map[string]string, len(cmsg.Headers))
+	for _, header := range cmsg.Headers {
+		if header != nil {
+			headers[string(header.Key)] = string(header.Value)
+		}
+	}
+
+	if eventType, ok := headers[HeaderEventType]; ok && eventType != string(s.Name()) {
+		return fmt.Errorf("%w: expect %s, got %s", ErrEventTypeMismatch, s.Name(), eventType)
+	}
+
+	version, err := parseVersionHeader(headers, HeaderEventVersion)
+	if err != nil {
+		return err
+	}
+
+	minCompatibleVersion, err := parseVersionHeader(headers, HeaderEventMinCompatibleVersion)
+	if err != nil {
+		return err
+	}
+
+	if minCompatibleVersion > version {
+		return fmt.Errorf("%w: min compatible version %d is greater than version %d", ErrInvalidVersionHeader, minCompatibleVersion, version)
+	}
+
+	if minCompatibleVersion > s.version {
+		return fmt.Errorf("%w: %s requires version %d, consumer has version %d", ErrIncompatibleVersion, s.Name(), minCompatibleVersion, s.version)
+	}
+
+	return nil
+}
+
+// newMessage returns an empty event payload message.
+func (s *Schema) newMessage() proto.Message {
+	return s.msg.ProtoReflect().New().Interface()
+}
+
+func (s *Schema) checkType(msg proto.Message) error {
+	if name := msg.ProtoReflect().Descriptor().FullName(); name != s.Name() {
+		return fmt.Errorf("%w: expect %s, got %s", ErrEventTypeMismatch, s.Name(), name)
+	}
+
+	return nil
+}
+
+func parseVersionHeader(headers map[string]string, key string) (uint32, error) {
+	value, ok := headers[key]
+	if !ok {
+		return 1, nil
+	}
+
+	version, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || version == 0 {
+		return 0, fmt.Errorf("%w: %s=%q", ErrInvalidVersionHeader, key, value)
+	}
+
+	return uint32(version), nil
+}
@@ -0,0 +1,209 @@
+package eventkit
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Schema", func() {
+	Describe("NewSchema", func() {
+		var (
+			version              uint32
+			minCompatibleVersion uint32
+
+			schema *Schema
+			err    error
+		)
+
+		JustBeforeEach(func() {
+			schema, err = NewSchema(&wrapperspb.StringValue{}, version, minCompatibleVersion)
+		})
+
+		When("min compatible version is greater than version", func() {
+			BeforeEach(func() { version, minCompatibleVersion = 1, 2 })
+
+			It("returns invalid schema version error", func() {
+				Expect(schema).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidSchemaVersion))
+			})
+		})
+
+		When("version is zero", func() {
+			BeforeEach(func() { version, minCompatibleVersion = 0, 0 })
+
+			It("returns invalid schema version error", func() {
+				Expect(schema).To(BeNil())
+				Expect(err).To(MatchError(ErrInvalidSchemaVersion))
+			})
+		})
+
+		When("success", func() {
+			BeforeEach(func() { version, minCompatibleVersion = 2, 1 })
+
+			It("returns the schema with no error", func() {
+				Expect(schema.Name()).To(BeEquivalentTo("google.protobuf.StringValue"))
+				Expect(schema.Version()).To(Equal(uint32(2)))
+				Expect(schema.MinCompatibleVersion()).To(Equal(uint32(1)))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Marshal", func() {
+		var (
+			schema *Schema
+			msg    proto.Message
+
+			resp *kafkakit.ProducerMessage
+			err  error
+		)
+
+		BeforeEach(func() {
+			schema = MustNewSchema(&wrapperspb.StringValue{}, 2, 1)
+		})
+
+		JustBeforeEach(func() {
+			resp, err = schema.Marshal([]byte("key"), msg)
+		})
+
+		When("event type mismatch", func() {
+			BeforeEach(func() { msg = durationpb.New(0) })
+
+			It("returns event type mismatch error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(ErrEventTypeMismatch))
+			})
+		})
+
+		When("success", func() {
+			BeforeEach(func() { msg = wrapperspb.String("event") })
+
+			It("returns the message with schema headers", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Key).To(Equal([]byte("key")))
+				Expect(resp.Headers).To(Equal(map[string]string{
+					HeaderEventType:                 "google.protobuf.StringValue",
+					HeaderEventVersion:              "2",
+					HeaderEventMinCompatibleVersion: "1",
+				}))
+			})
+		})
+	})
+
+	Describe("Unmarshal", func() {
+		var (
+			schema *Schema
+			cmsg   *sarama.ConsumerMessage
+			msg    *wrapperspb.StringValue
+
+			err error
+		)
+
+		BeforeEach(func() {
+			schema = MustNewSchema(&wrapperspb.StringValue{}, 2, 1)
+			msg = &wrapperspb.StringValue{}
+
+			value, merr := proto.Marshal(wrapperspb.String("event"))
+			Expect(merr).NotTo(HaveOccurred())
+
+			cmsg = &sarama.ConsumerMessage{Value: value}
+		})
+
+		JustBeforeEach(func() {
+			err = schema.Unmarshal(cmsg, msg)
+		})
+
+		When("headers are absent", func() {
+			It("decodes the message as the first version", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg.GetValue()).To(Equal("event"))
+			})
+		})
+
+		When("event type mismatch", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{HeaderEventType: "google.protobuf.Duration"})
+			})
+
+			It("returns event type mismatch error", func() {
+				Expect(err).To(MatchError(ErrEventTypeMismatch))
+			})
+		})
+
+		When("version header is invalid", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{HeaderEventMinCompatibleVersion: "v1"})
+			})
+
+			It("returns invalid version header error", func() {
+				Expect(err).To(MatchError(ErrInvalidVersionHeader))
+			})
+		})
+
+		When("event version header is invalid", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{HeaderEventVersion: "0"})
+			})
+
+			It("returns invalid version header error", func() {
+				Expect(err).To(MatchError(ErrInvalidVersionHeader))
+			})
+		})
+
+		When("min compatible version is greater than event version", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{
+					HeaderEventVersion:              "1",
+					HeaderEventMinCompatibleVersion: "2",
+				})
+			})
+
+			It("returns invalid version header error", func() {
+				Expect(err).To(MatchError(ErrInvalidVersionHeader))
+			})
+		})
+
+		When("producer requires a newer consumer", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{
+					HeaderEventType:                 "google.protobuf.StringValue",
+					HeaderEventVersion:              "4",
+					HeaderEventMinCompatibleVersion: "3",
+				})
+			})
+
+			It("returns incompatible version error", func() {
+				Expect(err).To(MatchError(ErrIncompatibleVersion))
+			})
+		})
+
+		When("producer has a newer compatible version", func() {
+			BeforeEach(func() {
+				cmsg.Headers = recordHeaders(map[string]string{
+					HeaderEventType:                 "google.protobuf.StringValue",
+					HeaderEventVersion:              "3",
+					HeaderEventMinCompatibleVersion: "2",
+				})
+			})
+
+			It("decodes the message with no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg.GetValue()).To(Equal("event"))
+			})
+		})
+	})
+})
+
+func recordHeaders(headers map[string]string) []*sarama.RecordHeader {
+	rhs := make([]*sarama.RecordHeader, 0, len(headers))
+	for key, value := range headers {
+		rhs = append(rhs, &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+
+	return rhs
+}
@@ -0,0 +1,81 @@
+package eventkit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/Shopify/sarama"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// messageSession is the session of a single event, its context carries the span of the event since
+// the generated handlers pass the context of the session to the server. The span is the child of
+// the span of the producer propagated through the event headers.
+type messageSession struct {
+	sarama.ConsumerGroupSession
+
+	ctx  context.Context
+	msg  *sarama.ConsumerMessage
+	span trace.Span
+
+	mu       sync.Mutex
+	isMarked bool
+}
+
+func newMessageSession(sess sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) *messageSession {
+	headers := make(map[string]string, len(msg.Headers))
+	for _, header := range msg.Headers {
+		if header != nil {
+			headers[string(header.Key)] = string(header.Value)
+		}
+	}
+
+	ctx := otelkit.ExtractHeaders(sess.Context(), headers)
+	ctx, span := otelkit.Tracer().Start(ctx, msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingDestinationKey.String(msg.Topic),
+			semconv.MessagingDestinationKindTopic,
+			semconv.MessagingOperationProcess,
+		),
+	)
+
+	return &messageSession{
+		ConsumerGroupSession: sess,
+		ctx:                  ctx,
+		msg:                  msg,
+		span:                 span,
+	}
+}
+
+func (s *messageSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *messageSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.ConsumerGroupSession.MarkMessage(msg, metadata)
+
+	if msg != s.msg {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.isMarked = true
+}
+
+// marked reports whether the event is marked by the handler.
+func (s *messageSession) marked() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.isMarked
+}
+
+// end ends the span of the event with the error of the handler, if any.
+func (s *messageSession) end(err error) {
+	otelkit.EndSpan(s.span, err)
+}
@@ -0,0 +1,81 @@
+package eventkit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/natskit"
+	"github.com/Shopify/sarama"
+	"go.uber.org/zap"
+)
+
+// The transports of the event bus
+const (
+	TransportKafka = "kafka"
+	TransportNATS  = "nats"
+)
+
+// TransportConfig selects the transport of the event bus per environment,
+// the clusters that cannot afford running Kafka use NATS JetStream instead.
+type TransportConfig struct {
+	Transport string `long:"event_transport" env:"EVENT_TRANSPORT" description:"the transport of the events" choice:"kafka" choice:"nats" default:"kafka"`
+}
+
+// Producer sends the events through the selected transport.
+type Producer interface {
+	kafkakit.Producer
+
+	Close() error
+}
+
+// Consumer hands the events of the selected transport to the consumer group handlers.
+type Consumer interface {
+	Consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error
+	Close() error
+}
+
+var (
+	_ Producer = (*kafkakit.KafkaProducer)(nil)
+	_ Producer = (*natskit.NATSProducer)(nil)
+	_ Consumer = (*kafkakit.KafkaConsumer)(nil)
+	_ Consumer = (*natskit.NATSConsumer)(nil)
+)
+
+// ProducerConfig holds the configs of all the transports, only the selected one is used.
+type ProducerConfig struct {
+	kafkakit.KafkaProducerConfig `group:"kafka_producer" namespace:"kafka_producer" env-namespace:"KAFKA_PRODUCER"`
+	natskit.NATSProducerConfig   `group:"nats_producer" namespace:"nats_producer" env-namespace:"NATS_PRODUCER"`
+}
+
+// ConsumerConfig holds the configs of all the transports, only the selected one is used.
+type ConsumerConfig struct {
+	kafkakit.KafkaConsumerConfig `group:"kafka_consumer" namespace:"kafka_consumer" env-namespace:"KAFKA_CONSUMER"`
+	natskit.NATSConsumerConfig   `group:"nats_consumer" namespace:"nats_consumer" env-namespace:"NATS_CONSUMER"`
+}
+
+func NewProducer(ctx context.Context, transport *TransportConfig, conf *ProducerConfig) Producer {
+	switch transport.Transport {
+	case TransportKafka:
+		return kafkakit.NewKafkaProducer(ctx, &conf.KafkaProducerConfig)
+	case TransportNATS:
+		return natskit.NewNATSProducer(ctx, &conf.NATSProducerConfig)
+	}
+
+	logkit.FromContext(ctx).Fatal("unknown event transport", zap.String("transport", transport.Transport))
+
+	return nil
+}
+
+func NewConsumer(ctx context.Context, transport *TransportConfig, conf *ConsumerConfig) Consumer {
+	switch transport.Transport {
+	case TransportKafka:
+		return kafkakit.NewKafkaConsumer(ctx, &conf.KafkaConsumerConfig)
+	case TransportNATS:
+		return natskit.NewNATSConsumer(ctx, &conf.NATSConsumerConfig)
+	}
+
+	logkit.FromContext(ctx).Fatal("unknown event transport", zap.String("transport", transport.Transport))
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package fixturekit
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Epoch is the time the fixtures are created after, it is fixed instead of now, so a seed generates the same
+// fixtures on every run.
+var Epoch = time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+var words = []string{
+	"the", "video", "scene", "music", "camera", "edit", "ending", "intro", "part", "moment",
+	"great", "awesome", "funny", "boring", "beautiful", "underrated", "classic", "smooth", "loud", "clean",
+	"really", "totally", "honestly", "again", "still", "finally", "never", "always", "so", "too",
+	"love", "watch", "missed", "laughed", "replayed", "skipped", "shared", "noticed", "waited", "learned",
+	"this", "that", "my", "your", "their", "every", "one", "first", "last", "next",
+	"and", "but", "because", "while", "when", "after", "before", "with", "without", "at",
+}
+
+var emojis = []string{"🔥", "😂", "👍", "🎉", "😮", "❤️"}
+
+// Generator generates the fixtures from a seed, a seed generates the same fixtures on every run, so the fixtures
+// are asserted by the tests and the environments seeded by them are reproduced.
+type Generator struct {
+	seed int64
+	rnd  *rand.Rand
+}
+
+func NewGenerator(seed int64) *Generator {
+	return &Generator{
+		seed: seed,
+		rnd:  rand.New(rand.NewSource(seed)), //nolint:gosec
+	}
+}
+
+// Fork returns the generator of the name, which generates independently of the others, e.g. the comments of
+// each video are generated by the generator of the video, so the other videos stay the same when a video
+// gets more comments.
+func (g *Generator) Fork(name string) *Generator {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+
+	return NewGenerator(g.seed ^ int64(h.Sum64()))
+}
+
+// Intn returns a number within [0, n).
+func (g *Generator) Intn(n int) int {
+	return g.rnd.Intn(n)
+}
+
+// Between returns a number within [min, max].
+func (g *Generator) Between(min, max int) int {
+	return min + g.rnd.Intn(max-min+1)
+}
+
+// Float64 returns a number within [0, 1).
+func (g *Generator) Float64() float64 {
+	return g.rnd.Float64()
+}
+
+// Chance reports true with the probability within [0, 1].
+func (g *Generator) Chance(p float64) bool {
+	return g.rnd.Float64() < p
+}
+
+// Pick returns one of the items.
+func (g *Generator) Pick(items ...string) string {
+	return items[g.rnd.Intn(len(items))]
+}
+
+// Time returns a time within [from, from+within), truncated to milliseconds, which the databases keep.
+func (g *Generator) Time(from time.Time, within time.Duration) time.Time {
+	return from.Add(time.Duration(g.rnd.Int63n(int64(within)))).Truncate(time.Millisecond)
+}
+
+// UUID returns a random UUID.
+func (g *Generator) UUID() uuid.UUID {
+	id, err := uuid.NewRandomFromReader(g.rnd)
+	if err != nil {
+		// the reader of math/rand never fails
+		panic(err)
+	}
+
+	return id
+}
+
+// ObjectID returns an object ID of the time, the object IDs are ordered by their time like the ones of MongoDB.
+func (g *Generator) ObjectID(at time.Time) primitive.ObjectID {
+	id := primitive.NewObjectIDFromTimestamp(at)
+	_, _ = g.rnd.Read(id[4:])
+
+	return id
+}
+
+// Sentence returns a sentence of the words within [min, max].
+func (g *Generator) Sentence(min, max int) string {
+	n := g.Between(min, max)
+
+	sentence := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		sentence = append(sentence, words[g.rnd.Intn(len(words))])
+	}
+	sentence[0] = strings.ToUpper(sentence[0][:1]) + sentence[0][1:]
+
+	return strings.Join(sentence, " ") + g.Pick(".", ".", "!", "?")
+}
+
+// Markdown returns a comment of one to three sentences, some of them emphasized, linked, quoted or with an emoji,
+// in the subset of markdown the renderer of the comments supports.
+func (g *Generator) Markdown() string {
+	n := g.Between(1, 3)
+
+	sentences := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		sentence := g.Sentence(3, 12)
+
+		switch g.rnd.Intn(10) {
+		case 0:
+			sentence = "**" + sentence + "**"
+		case 1:
+			sentence = "*" + sentence + "*"
+		case 2:
+			sentence = "`" + sentence + "`"
+		case 3:
+			sentence = "[" + sentence + "](https://example.com/" + words[g.rnd.Intn(len(words))] + ")"
+		case 4:
+			sentence += " " + g.Pick(emojis...)
+		}
+
+		sentences = append(sentences, sentence)
+	}
+
+	if g.Chance(0.1) {
+		// a quote of another comment
+		return "> " + g.Sentence(3, 8) + "\n\n" + strings.Join(sentences, " ")
+	}
+
+	return strings.Join(sentences, " ")
+}
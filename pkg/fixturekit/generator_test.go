@@ -0,0 +1,64 @@
+package fixturekit
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator", func() {
+	// generate generates a bit of everything, so any randomness of a generator shows in its output
+	generate := func(g *Generator) []interface{} {
+		at := g.Time(Epoch, time.Hour)
+
+		return []interface{}{g.Between(1, 10), at, g.UUID(), g.ObjectID(at), g.Sentence(1, 5), g.Markdown()}
+	}
+
+	It("generates the same fixtures from the same seed", func() {
+		Expect(generate(NewGenerator(1))).To(Equal(generate(NewGenerator(1))))
+		Expect(generate(NewGenerator(1))).NotTo(Equal(generate(NewGenerator(2))))
+	})
+
+	Describe("Fork", func() {
+		It("forks the same generator of the same name whatever generated before", func() {
+			g := NewGenerator(1)
+			before := generate(g.Fork("video-1"))
+			generate(g)
+
+			Expect(generate(g.Fork("video-1"))).To(Equal(before))
+			Expect(generate(g.Fork("video-2"))).NotTo(Equal(before))
+		})
+	})
+
+	Describe("Time", func() {
+		It("returns the times within the range in milliseconds", func() {
+			g := NewGenerator(1)
+			for i := 0; i < 100; i++ {
+				at := g.Time(Epoch, time.Hour)
+				Expect(at).To(BeTemporally(">=", Epoch))
+				Expect(at).To(BeTemporally("<", Epoch.Add(time.Hour)))
+				Expect(at).To(Equal(at.Truncate(time.Millisecond)))
+			}
+		})
+	})
+
+	Describe("ObjectID", func() {
+		It("returns the object IDs of the time", func() {
+			g := NewGenerator(1)
+			at := Epoch.Add(time.Minute)
+
+			Expect(g.ObjectID(at).Timestamp()).To(BeTemporally("==", at))
+			Expect(g.ObjectID(at)).NotTo(Equal(g.ObjectID(at)))
+		})
+	})
+
+	Describe("Sentence", func() {
+		It("returns the sentences of the words within the range", func() {
+			g := NewGenerator(1)
+			for i := 0; i < 100; i++ {
+				Expect(g.Sentence(3, 5)).To(MatchRegexp(`^[A-Z][a-z]*( [a-z]+){2,4}[.!?]$`))
+			}
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package fixturekit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFixtureKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Fixture Kit")
+}
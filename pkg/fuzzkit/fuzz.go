@@ -0,0 +1,109 @@
+package fuzzkit
+
+import (
+	"math/rand"
+	"os"
+	"strconv"
+)
+
+const (
+	// defaultIterations are the inputs tried by make test, FUZZ_ITERATIONS tries more for a longer run
+	defaultIterations = 1000
+	// defaultSeed keeps the inputs of make test the same across the runs, FUZZ_SEED explores other inputs
+	defaultSeed = 1
+)
+
+// Target checks an input, it fails the test if the input breaks a property of the code under test.
+type Target func(input []byte)
+
+// Fuzz calls the target with the inputs of the corpus and their random mutations. The mutations are seeded,
+// so a failing input is reproduced by the seed, and the number of the inputs and the seed are set by
+// FUZZ_ITERATIONS and FUZZ_SEED to fuzz longer than the test suite does.
+func Fuzz(corpus [][]byte, dict [][]byte, target Target) {
+	mutator := NewMutator(envInt("FUZZ_SEED", defaultSeed), dict...)
+	iterations := envInt("FUZZ_ITERATIONS", defaultIterations)
+
+	for _, input := range corpus {
+		target(input)
+	}
+
+	for i := int64(0); i < iterations; i++ {
+		var input []byte
+		if len(corpus) > 0 {
+			input = corpus[mutator.rnd.Intn(len(corpus))]
+		}
+
+		target(mutator.Mutate(input))
+	}
+}
+
+func envInt(key string, fallback int64) int64 {
+	if n, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil {
+		return n
+	}
+
+	return fallback
+}
+
+// Mutator mutates the inputs randomly, the tokens of the dictionary are inserted into the inputs to reach
+// the branches of the parsers guarded by them, e.g. the delimiters of markdown.
+type Mutator struct {
+	rnd  *rand.Rand
+	dict [][]byte
+}
+
+func NewMutator(seed int64, dict ...[]byte) *Mutator {
+	return &Mutator{
+		rnd:  rand.New(rand.NewSource(seed)), //nolint:gosec
+		dict: dict,
+	}
+}
+
+// Mutate returns a copy of the input with one to four random mutations.
+func (m *Mutator) Mutate(input []byte) []byte {
+	out := append([]byte(nil), input...)
+
+	for n := 1 + m.rnd.Intn(4); n > 0; n-- {
+		out = m.mutateOnce(out)
+	}
+
+	return out
+}
+
+func (m *Mutator) mutateOnce(b []byte) []byte {
+	switch op := m.rnd.Intn(6); {
+	case op == 0 && len(b) > 0:
+		// flip a bit
+		i := m.rnd.Intn(len(b))
+		b[i] ^= 1 << uint(m.rnd.Intn(8))
+	case op == 1 && len(b) > 0:
+		// replace a byte
+		b[m.rnd.Intn(len(b))] = byte(m.rnd.Intn(256))
+	case op == 2 && len(b) > 0:
+		// delete a range
+		i := m.rnd.Intn(len(b))
+		j := i + 1 + m.rnd.Intn(len(b)-i)
+		b = append(b[:i], b[j:]...)
+	case op == 3 && len(b) > 0:
+		// duplicate a range
+		i := m.rnd.Intn(len(b))
+		j := i + 1 + m.rnd.Intn(len(b)-i)
+		b = insert(b, m.rnd.Intn(len(b)+1), append([]byte(nil), b[i:j]...))
+	case op == 4 && len(m.dict) > 0:
+		// insert a token of the dictionary
+		b = insert(b, m.rnd.Intn(len(b)+1), m.dict[m.rnd.Intn(len(m.dict))])
+	default:
+		// insert a random byte
+		b = insert(b, m.rnd.Intn(len(b)+1), []byte{byte(m.rnd.Intn(256))})
+	}
+
+	return b
+}
+
+func insert(b []byte, i int, s []byte) []byte {
+	out := make([]byte, 0, len(b)+len(s))
+	out = append(out, b[:i]...)
+	out = append(out, s...)
+
+	return append(out, b[i:]...)
+}
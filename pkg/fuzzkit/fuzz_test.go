@@ -0,0 +1,42 @@
+package fuzzkit
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fuzz", func() {
+	It("tries the corpus and its mutations", func() {
+		corpus := [][]byte{[]byte("first"), []byte("second")}
+
+		var inputs [][]byte
+		Fuzz(corpus, [][]byte{[]byte("TOKEN")}, func(input []byte) {
+			inputs = append(inputs, input)
+		})
+
+		Expect(inputs).To(HaveLen(len(corpus) + defaultIterations))
+		Expect(inputs[:2]).To(Equal(corpus))
+		Expect(inputs).To(ContainElement(WithTransform(func(b []byte) bool {
+			return bytes.Contains(b, []byte("TOKEN"))
+		}, BeTrue())))
+		Expect(corpus).To(Equal([][]byte{[]byte("first"), []byte("second")}), "the corpus is not mutated")
+	})
+
+	It("mutates the inputs by the seed", func() {
+		mutate := func(seed int64) [][]byte {
+			mutator := NewMutator(seed)
+
+			var outs [][]byte
+			for i := 0; i < 10; i++ {
+				outs = append(outs, mutator.Mutate([]byte("input")))
+			}
+
+			return outs
+		}
+
+		Expect(mutate(1)).To(Equal(mutate(1)))
+		Expect(mutate(1)).NotTo(Equal(mutate(2)))
+	})
+})
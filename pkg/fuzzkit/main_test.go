@@ -0,0 +1,13 @@
+package fuzzkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFuzzKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Fuzz Kit")
+}
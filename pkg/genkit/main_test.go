@@ -0,0 +1,13 @@
+package genkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGenKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Gen Kit")
+}
@@ -0,0 +1,124 @@
+package genkit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// generatedHeader is the line marking the generated Go files by the convention of Go, which protoc-gen-go,
+// the other protoc plugins and mockgen write.
+var generatedHeader = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// Snapshot is the digests of the generated files of a tree by their slash-separated paths.
+type Snapshot map[string][sha256.Size]byte
+
+// TakeSnapshot digests the generated Go files of the tree of root, the directories named skipDirs are not walked,
+// e.g. the caches and the binaries.
+func TakeSnapshot(root string, skipDirs ...string) (Snapshot, error) {
+	skip := make(map[string]bool, len(skipDirs))
+	for _, dir := range skipDirs {
+		skip[dir] = true
+	}
+
+	snapshot := Snapshot{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path != root && skip[d.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if !isGenerated(content) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		snapshot[filepath.ToSlash(rel)] = sha256.Sum256(content)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// isGenerated reports whether the header is before the package clause, where the convention puts it.
+func isGenerated(content []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if generatedHeader.MatchString(line) {
+			return true
+		}
+		if strings.HasPrefix(line, "package ") {
+			return false
+		}
+	}
+
+	return false
+}
+
+// ChangeKind is how a generated file changes between two snapshots.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeModified ChangeKind = "modified"
+	ChangeRemoved  ChangeKind = "removed"
+)
+
+type Change struct {
+	Path string
+	Kind ChangeKind
+}
+
+// Diff returns the changes of the generated files from the snapshot to the next one, sorted by the paths.
+func (s Snapshot) Diff(next Snapshot) []Change {
+	var changes []Change
+	for path, digest := range next {
+		prev, ok := s[path]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Path: path, Kind: ChangeAdded})
+		case prev != digest:
+			changes = append(changes, Change{Path: path, Kind: ChangeModified})
+		}
+	}
+	for path := range s {
+		if _, ok := next[path]; !ok {
+			changes = append(changes, Change{Path: path, Kind: ChangeRemoved})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
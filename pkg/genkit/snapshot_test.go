@@ -0,0 +1,77 @@
+package genkit
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Snapshot", func() {
+	var root string
+
+	writeFile := func(path, content string) {
+		path = filepath.Join(root, path)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	const generated = "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n"
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+
+		writeFile("modules/video/pb/rpc.pb.go", generated)
+		writeFile("modules/video/mock/daomock/mock.go", "// Code generated by MockGen. DO NOT EDIT.\n// Source: dao\n\npackage daomock\n")
+		writeFile("modules/video/service/service.go", "package service\n\n// Code generated by hand. DO NOT EDIT.\n")
+		writeFile("modules/video/pb/rpc.proto", "// Code generated by protoc. DO NOT EDIT.\n")
+		writeFile(".cache/gocache/cached.go", generated)
+	})
+
+	Describe("TakeSnapshot", func() {
+		It("digests the generated Go files only", func() {
+			snapshot, err := TakeSnapshot(root, ".cache")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snapshot).To(HaveLen(2))
+			Expect(snapshot).To(HaveKey("modules/video/pb/rpc.pb.go"))
+			Expect(snapshot).To(HaveKey("modules/video/mock/daomock/mock.go"))
+		})
+	})
+
+	Describe("Diff", func() {
+		var prev Snapshot
+
+		BeforeEach(func() {
+			var err error
+			prev, err = TakeSnapshot(root, ".cache")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the files are generated alike", func() {
+			It("returns no changes", func() {
+				writeFile("modules/video/pb/rpc.pb.go", generated)
+
+				next, err := TakeSnapshot(root, ".cache")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(prev.Diff(next)).To(BeEmpty())
+			})
+		})
+
+		When("the files are generated differently", func() {
+			It("returns the changes sorted by the paths", func() {
+				writeFile("modules/video/pb/rpc.pb.go", generated+"\nvar x = 1\n")
+				writeFile("modules/comment/pb/rpc.pb.go", generated)
+				Expect(os.Remove(filepath.Join(root, "modules/video/mock/daomock/mock.go"))).To(Succeed())
+
+				next, err := TakeSnapshot(root, ".cache")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(prev.Diff(next)).To(Equal([]Change{
+					{Path: "modules/comment/pb/rpc.pb.go", Kind: ChangeAdded},
+					{Path: "modules/video/mock/daomock/mock.go", Kind: ChangeRemoved},
+					{Path: "modules/video/pb/rpc.pb.go", Kind: ChangeModified},
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package goldenkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Redacted replaces the values of the redacted fields, which differ on every run, e.g. the generated IDs
+const Redacted = "<redacted>"
+
+var ErrMismatch = errors.New("mismatch with the golden file")
+
+// CompareResponse compares the status and the JSON body of the response to the golden file. The body is indented
+// with the keys sorted, since protojson does not promise a stable output, so the golden files are reviewed line
+// by line, and the values of the redacted fields, at any depth, are replaced with Redacted. The golden file
+// is written instead if GOLDEN_UPDATE is set, e.g. GOLDEN_UPDATE=1 make test, so a change of the responses
+// on purpose is recorded by running the tests.
+func CompareResponse(path string, resp *http.Response, redactedFields ...string) error {
+	defer resp.Body.Close()
+
+	var body interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("failed to decode the response body: %w", err)
+	}
+
+	redact(body, redactedFields)
+
+	// the HTML of the bodies, e.g. the rendered comments, is kept as is to read the golden files
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(map[string]interface{}{"status": resp.StatusCode, "body": body}); err != nil {
+		return err
+	}
+	actual := buf.Bytes()
+
+	if os.Getenv("GOLDEN_UPDATE") != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+
+		return os.WriteFile(path, actual, 0o644) //nolint:gosec
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("%w, record it by GOLDEN_UPDATE=1: %v", ErrMismatch, err)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		return fmt.Errorf("%w %s, record it by GOLDEN_UPDATE=1 if the change is on purpose:\n%s", ErrMismatch, path, diff(string(expected), string(actual)))
+	}
+
+	return nil
+}
+
+// redact replaces the values of the fields in the maps and the slices of the JSON value in place.
+func redact(v interface{}, fields []string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if contains(fields, key) {
+				v[key] = Redacted
+				continue
+			}
+			redact(value, fields)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redact(value, fields)
+		}
+	}
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diff returns the lines of the golden file and the actual response from the first line they differ.
+func diff(expected, actual string) string {
+	expectedLines := strings.Split(expected, "\n")
+	actualLines := strings.Split(actual, "\n")
+
+	i := 0
+	for i < len(expectedLines) && i < len(actualLines) && expectedLines[i] == actualLines[i] {
+		i++
+	}
+
+	var b strings.Builder
+	for _, line := range expectedLines[i:] {
+		fmt.Fprintf(&b, "- %s\n", line)
+	}
+	for _, line := range actualLines[i:] {
+		fmt.Fprintf(&b, "+ %s\n", line)
+	}
+
+	return b.String()
+}
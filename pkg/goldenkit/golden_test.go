@@ -0,0 +1,114 @@
+package goldenkit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CompareResponse", func() {
+	const golden = `{
+  "body": {
+    "comments": [
+      {
+        "content": "<b>hi</b>",
+        "id": "<redacted>"
+      }
+    ],
+    "nextPageToken": ""
+  },
+  "status": 200
+}
+`
+
+	var (
+		path           string
+		body           string
+		update         bool
+		redactedFields []string
+		err            error
+	)
+
+	newResponse := func(body string) *http.Response {
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(http.StatusOK)
+		_, _ = rec.WriteString(body)
+
+		return rec.Result()
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "testdata", "golden.json")
+		body = `{"nextPageToken":"","comments":[{"id":"4f163f5f-0f9a-421d-b295-66c74d10037c","content":"<b>hi</b>"}]}`
+		update = false
+		redactedFields = []string{"id"}
+
+		// the specs set GOLDEN_UPDATE of their own, whichever the tests are run by
+		value, ok := os.LookupEnv("GOLDEN_UPDATE")
+		Expect(os.Unsetenv("GOLDEN_UPDATE")).To(Succeed())
+		DeferCleanup(func() {
+			if ok {
+				Expect(os.Setenv("GOLDEN_UPDATE", value)).To(Succeed())
+			}
+		})
+	})
+
+	JustBeforeEach(func() {
+		if update {
+			Expect(os.Setenv("GOLDEN_UPDATE", "1")).To(Succeed())
+			defer func() {
+				Expect(os.Unsetenv("GOLDEN_UPDATE")).To(Succeed())
+			}()
+		}
+
+		err = CompareResponse(path, newResponse(body), redactedFields...)
+	})
+
+	When("GOLDEN_UPDATE is set", func() {
+		BeforeEach(func() { update = true })
+
+		It("writes the golden file sorted and redacted", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.ReadFile(path)).To(BeEquivalentTo(golden))
+		})
+	})
+
+	When("the golden file is missing", func() {
+		It("returns ErrMismatch", func() {
+			Expect(err).To(MatchError(ErrMismatch))
+		})
+	})
+
+	When("the golden file exists", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+			Expect(os.WriteFile(path, []byte(golden), 0o600)).To(Succeed())
+		})
+
+		It("matches the response", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the response differs", func() {
+			BeforeEach(func() { body = `{"nextPageToken":"","comments":[{"id":"1","content":"bye"}]}` })
+
+			It("returns ErrMismatch with the diff", func() {
+				Expect(err).To(MatchError(ErrMismatch))
+				Expect(err.Error()).To(ContainSubstring(`-         "content": "<b>hi</b>",`))
+				Expect(err.Error()).To(ContainSubstring(`+         "content": "bye",`))
+			})
+		})
+
+		When("the field is not redacted", func() {
+			BeforeEach(func() { redactedFields = nil })
+
+			It("returns ErrMismatch", func() {
+				Expect(err).To(MatchError(ErrMismatch))
+			})
+		})
+	})
+})
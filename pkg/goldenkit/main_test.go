@@ -0,0 +1,13 @@
+package goldenkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGoldenKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Golden Kit")
+}
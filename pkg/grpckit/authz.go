@@ -0,0 +1,170 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	authzpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pb/authz"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tlskit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ScopePublic is the scope of the methods any peer may call, such as the health checks.
+const ScopePublic = "public"
+
+var (
+	ErrScopeNotDeclared = NewError(codes.PermissionDenied, errorDomain, "SCOPE_NOT_DECLARED", "the method declares no scope")
+	ErrScopeNotGranted  = NewError(codes.PermissionDenied, errorDomain, "SCOPE_NOT_GRANTED", "the scope of the method is not granted to the peer")
+
+	ErrUndeclaredMethods = errors.New("methods without scope")
+	ErrInvalidGrant      = errors.New("invalid grant")
+)
+
+type AuthzConfig struct {
+	Grants   []string `long:"grants" env:"GRANTS" env-delim:";" description:"the scopes granted to the SPIFFE IDs of the peers in the format of id=scope,scope, the peers not granted are allowed only the public methods"`
+	AllowAll bool     `long:"allow_all" env:"ALLOW_ALL" description:"grant every scope to any peer, for the local deployments without mTLS only"`
+}
+
+// MethodScopes maps the full names of the methods, e.g. /comment.pb.Comment/GetComment, to the scopes required to
+// call them. The methods not declared are denied, and the server refuses to serve them, so the authorization of
+// a new method is decided along with the method instead of being forgotten.
+type MethodScopes map[string]string
+
+// ScopesOf returns the scopes of the methods of the services in the files, as declared by the authz.scope option
+// of the methods. The methods without the option are left undeclared, so they are denied.
+func ScopesOf(files ...protoreflect.FileDescriptor) MethodScopes {
+	scopes := make(MethodScopes)
+
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				if scope := proto.GetExtension(method.Options(), authzpb.E_Scope).(string); scope != "" {
+					scopes[fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())] = scope
+				}
+			}
+		}
+	}
+
+	return scopes
+}
+
+// Declare returns the scopes along with every method of the service requiring the scope.
+func (s MethodScopes) Declare(desc *grpc.ServiceDesc, scope string) MethodScopes {
+	scopes := make(MethodScopes, len(s)+len(desc.Methods)+len(desc.Streams))
+	for method, scope := range s {
+		scopes[method] = scope
+	}
+
+	for _, method := range desc.Methods {
+		scopes[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = scope
+	}
+	for _, stream := range desc.Streams {
+		scopes[fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)] = scope
+	}
+
+	return scopes
+}
+
+// Check verifies every method of the services declares its scope, the services are as returned by GetServiceInfo
+// of the server. It returns ErrUndeclaredMethods listing the methods otherwise.
+func (s MethodScopes) Check(services map[string]grpc.ServiceInfo) error {
+	var undeclared []string
+
+	for name, info := range services {
+		for _, method := range info.Methods {
+			fullMethod := fmt.Sprintf("/%s/%s", name, method.Name)
+			if _, ok := s[fullMethod]; !ok {
+				undeclared = append(undeclared, fullMethod)
+			}
+		}
+	}
+
+	if len(undeclared) > 0 {
+		sort.Strings(undeclared)
+		return fmt.Errorf("%w: %s", ErrUndeclaredMethods, strings.Join(undeclared, ", "))
+	}
+
+	return nil
+}
+
+// Grants are the scopes granted to the SPIFFE IDs of the peers.
+type Grants map[string]map[string]struct{}
+
+// ParseGrants parses the grants in the format of id=scope,scope.
+func ParseGrants(grants []string) (Grants, error) {
+	parsed := make(Grants, len(grants))
+
+	for _, grant := range grants {
+		i := strings.LastIndex(grant, "=")
+		if i <= 0 || i == len(grant)-1 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidGrant, grant)
+		}
+
+		id := strings.TrimSpace(grant[:i])
+		if parsed[id] == nil {
+			parsed[id] = make(map[string]struct{})
+		}
+		for _, scope := range strings.Split(grant[i+1:], ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				parsed[id][scope] = struct{}{}
+			}
+		}
+	}
+
+	return parsed, nil
+}
+
+// UnaryServerAuthzInterceptor authorizes the requests by the scopes of the methods, the peers identified by
+// the SPIFFE IDs of their certificates are allowed only the public methods and the methods of the scopes
+// granted, a nil grants grants every scope to any peer. The methods not declared in scopes are denied regardless.
+func UnaryServerAuthzInterceptor(scopes MethodScopes, grants Grants) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, scopes, grants, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerAuthzInterceptor authorizes the streams like UnaryServerAuthzInterceptor.
+func StreamServerAuthzInterceptor(scopes MethodScopes, grants Grants) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), scopes, grants, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, scopes MethodScopes, grants Grants, fullMethod string) error {
+	scope, ok := scopes[fullMethod]
+	if !ok {
+		return ErrScopeNotDeclared
+	}
+
+	if scope == ScopePublic || grants == nil {
+		return nil
+	}
+
+	id, err := tlskit.PeerID(ctx)
+	if err != nil {
+		return ErrUnauthenticatedPeer
+	}
+
+	if _, ok := grants[id][scope]; !ok {
+		return ErrScopeNotGranted
+	}
+
+	return nil
+}
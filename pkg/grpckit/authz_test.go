@@ -0,0 +1,155 @@
+package grpckit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+
+	authzpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pb/authz"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Authz", func() {
+	const (
+		fakeID     = "spiffe://nthu-distributed-system/video-api"
+		fakeMethod = "/comment.pb.Comment/DeleteCommentByVideoID"
+	)
+
+	var (
+		ctx    context.Context
+		scopes MethodScopes
+	)
+
+	BeforeEach(func() {
+		u, err := url.Parse(fakeID)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx = peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{u}}},
+				},
+			},
+		})
+
+		scopes = MethodScopes{
+			"/comment.pb.Comment/Healthz": ScopePublic,
+			fakeMethod:                    "comment.write",
+		}
+	})
+
+	call := func(method string, grants ...string) error {
+		parsed, err := ParseGrants(grants)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = UnaryServerAuthzInterceptor(scopes, parsed)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	It("allows the peer granted the scope of the method", func() {
+		Expect(call(fakeMethod, fakeID+"=comment.read,comment.write")).To(Succeed())
+	})
+
+	It("denies the peer not granted the scope of the method", func() {
+		Expect(call(fakeMethod, fakeID+"=comment.read")).To(MatchError(ErrScopeNotGranted))
+		Expect(call(fakeMethod, "spiffe://nthu-distributed-system/comment-api=comment.write")).To(MatchError(ErrScopeNotGranted))
+	})
+
+	It("allows any peer the public methods only without grants", func() {
+		Expect(call("/comment.pb.Comment/Healthz", fakeID+"=comment.read")).To(Succeed())
+		Expect(call("/comment.pb.Comment/Healthz")).To(Succeed())
+		Expect(call(fakeMethod)).To(MatchError(ErrScopeNotGranted))
+	})
+
+	It("allows any peer every scope by the nil grants", func() {
+		_, err := UnaryServerAuthzInterceptor(scopes, nil)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: fakeMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("denies the methods not declared regardless of the grants", func() {
+		Expect(call("/comment.pb.Comment/NewMethod")).To(MatchError(ErrScopeNotDeclared))
+	})
+
+	It("rejects the peer without ID when scopes are granted", func() {
+		ctx = context.Background()
+		Expect(call(fakeMethod, fakeID+"=comment.write")).To(MatchError(ErrUnauthenticatedPeer))
+	})
+
+	It("authorizes the streams", func() {
+		err := StreamServerAuthzInterceptor(scopes, nil)(nil, &deadlineServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/comment.pb.Comment/NewStream"}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		Expect(err).To(MatchError(ErrScopeNotDeclared))
+	})
+
+	Describe("ScopesOf", func() {
+		It("declares the scopes by the authz.scope option", func() {
+			options := &descriptorpb.MethodOptions{}
+			proto.SetExtension(options, authzpb.E_Scope, "comment.write")
+
+			file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+				Name:       proto.String("authz_test.proto"),
+				Package:    proto.String("comment.pb"),
+				Syntax:     proto.String("proto3"),
+				Dependency: []string{"google/protobuf/empty.proto"},
+				Service: []*descriptorpb.ServiceDescriptorProto{{
+					Name: proto.String("Comment"),
+					Method: []*descriptorpb.MethodDescriptorProto{
+						{
+							Name:       proto.String("DeleteCommentByVideoID"),
+							InputType:  proto.String(".google.protobuf.Empty"),
+							OutputType: proto.String(".google.protobuf.Empty"),
+							Options:    options,
+						},
+						{
+							Name:       proto.String("NewMethod"),
+							InputType:  proto.String(".google.protobuf.Empty"),
+							OutputType: proto.String(".google.protobuf.Empty"),
+						},
+					},
+				}},
+			}, protoregistry.GlobalFiles)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ScopesOf(file)).To(Equal(MethodScopes{fakeMethod: "comment.write"}))
+		})
+	})
+
+	Describe("ParseGrants", func() {
+		It("rejects the grants without scopes", func() {
+			_, err := ParseGrants([]string{fakeID})
+			Expect(err).To(MatchError(ErrInvalidGrant))
+
+			_, err = ParseGrants([]string{fakeID + "="})
+			Expect(err).To(MatchError(ErrInvalidGrant))
+		})
+	})
+
+	Describe("Check", func() {
+		It("reports the methods without scopes", func() {
+			server := grpc.NewServer()
+			server.RegisterService(&healthpb.Health_ServiceDesc, nil)
+
+			err := scopes.Check(server.GetServiceInfo())
+			Expect(err).To(MatchError(ErrUndeclaredMethods))
+			Expect(err.Error()).To(ContainSubstring("/grpc.health.v1.Health/Check"))
+
+			Expect(scopes.Declare(&healthpb.Health_ServiceDesc, ScopePublic).Check(server.GetServiceInfo())).To(Succeed())
+		})
+	})
+})
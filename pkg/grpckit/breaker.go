@@ -0,0 +1,53 @@
+package grpckit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryClientBreakerInterceptor rejects the requests with Unavailable while the server is failing,
+// so the callers fail fast instead of waiting for their deadlines on a slow server.
+func UnaryClientBreakerInterceptor(breaker *breakerkit.Breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done, err := breaker.Allow()
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+
+		return err
+	}
+}
+
+// StreamClientBreakerInterceptor rejects the streams like UnaryClientBreakerInterceptor,
+// only the result of opening the stream is reported since the streams may last long.
+func StreamClientBreakerInterceptor(breaker *breakerkit.Breaker) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done, err := breaker.Allow()
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		done(err)
+
+		return stream, err
+	}
+}
+
+// isRPCFailure reports whether the error is a failure of the server rather than of the request,
+// e.g. Unavailable and DeadlineExceeded are failures while NotFound and InvalidArgument are not.
+func isRPCFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package grpckit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("UnaryClientBreakerInterceptor", func() {
+	var (
+		interceptor grpc.UnaryClientInterceptor
+		invokerErr  error
+		invoked     int
+	)
+
+	BeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		breaker := breakerkit.NewBreaker(ctx, "test", &breakerkit.BreakerConfig{
+			FailureThreshold: 2,
+			OpenTimeout:      time.Minute,
+		}, breakerkit.WithFailureFunc(isRPCFailure))
+
+		interceptor = UnaryClientBreakerInterceptor(breaker)
+		invoked = 0
+	})
+
+	invoke := func() error {
+		return interceptor(context.Background(), "/test/Test", nil, nil, nil,
+			func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				invoked++
+				return invokerErr
+			},
+		)
+	}
+
+	When("the server is unavailable", func() {
+		BeforeEach(func() { invokerErr = status.Error(codes.Unavailable, "unavailable") })
+
+		It("rejects the requests with Unavailable after the threshold", func() {
+			Expect(invoke()).To(MatchError(invokerErr))
+			Expect(invoke()).To(MatchError(invokerErr))
+
+			err := invoke()
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+			Expect(status.Convert(err).Message()).To(Equal(breakerkit.ErrOpen.Error()))
+			Expect(invoked).To(Equal(2))
+		})
+	})
+
+	When("the requests fail with the errors of the requests", func() {
+		BeforeEach(func() { invokerErr = status.Error(codes.NotFound, "not found") })
+
+		It("does not reject the requests", func() {
+			for i := 0; i < 3; i++ {
+				Expect(invoke()).To(MatchError(invokerErr))
+			}
+			Expect(invoked).To(Equal(3))
+		})
+	})
+})
@@ -4,7 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tlskit"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -13,6 +16,9 @@ import (
 type GrpcClientConnConfig struct {
 	Timeout    time.Duration `long:"timeout" env:"TIMEOUT" default:"30s"`
 	ServerAddr string        `long:"server_addr" env:"SERVER_ADDR" required:"true"`
+	TLS        tlskit.Config `group:"tls" namespace:"tls" env-namespace:"TLS"`
+
+	Breaker breakerkit.BreakerConfig `group:"breaker" namespace:"breaker" env-namespace:"BREAKER"`
 }
 
 type GrpcClientConn struct {
@@ -29,7 +35,7 @@ func (c *GrpcClientConn) Close() error {
 	return c.ClientConn.Close()
 }
 
-func NewGrpcClientConn(ctx context.Context, conf *GrpcClientConnConfig) *GrpcClientConn {
+func NewGrpcClientConn(ctx context.Context, conf *GrpcClientConnConfig, opts ...grpc.DialOption) *GrpcClientConn {
 	logger := logkit.FromContext(ctx).With(
 		zap.String("server_addr", conf.ServerAddr),
 	)
@@ -37,7 +43,30 @@ func NewGrpcClientConn(ctx context.Context, conf *GrpcClientConnConfig) *GrpcCli
 	var cancel context.CancelFunc
 	ctx, cancel = context.WithTimeout(ctx, conf.Timeout)
 
-	conn, err := grpc.DialContext(ctx, conf.ServerAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds := insecure.NewCredentials()
+	if conf.TLS.Enabled() {
+		creds = tlskit.NewClientCredentials(ctx, &conf.TLS)
+	}
+
+	// the requests are traced outermost, so the span covers the interceptors of the options,
+	// and the request ID and the user ID are forwarded to correlate the log lines of the server
+	defaultOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(otelkit.UnaryClientTracingInterceptor(), UnaryClientCorrelationInterceptor()),
+		grpc.WithChainStreamInterceptor(otelkit.StreamClientTracingInterceptor(), StreamClientCorrelationInterceptor()),
+	}
+
+	// the breaker sees the result of the request after the retries and the hedging of the options
+	if breaker := breakerkit.NewBreaker(ctx, conf.ServerAddr, &conf.Breaker, breakerkit.WithFailureFunc(isRPCFailure)); breaker != nil {
+		defaultOpts = append(defaultOpts,
+			grpc.WithChainUnaryInterceptor(UnaryClientBreakerInterceptor(breaker)),
+			grpc.WithChainStreamInterceptor(StreamClientBreakerInterceptor(breaker)),
+		)
+	}
+
+	opts = append(defaultOpts, opts...)
+
+	conn, err := grpc.DialContext(ctx, conf.ServerAddr, opts...)
 	if err != nil {
 		logger.Fatal("failed to connect to gRPC server", zap.Error(err))
 	}
@@ -0,0 +1,102 @@
+package grpckit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadataKey is the gRPC metadata carrying the request ID, which is generated by the first
+// server if absent and sent back in the response header, UserIDMetadataKey carries the ID of the user
+// calling. The gateways forward RequestIDHTTPHeader and UserIDHTTPHeader as the metadata.
+const (
+	RequestIDMetadataKey = "x-request-id"
+	UserIDMetadataKey    = "x-user-id"
+	RequestIDHTTPHeader  = "X-Request-Id"
+	UserIDHTTPHeader     = "X-User-Id"
+)
+
+// UnaryClientCorrelationInterceptor forwards the request ID and the user ID of the context to the outgoing metadata,
+// so the log lines of the downstream services are correlated with the request.
+func UnaryClientCorrelationInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(correlationOutgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientCorrelationInterceptor forwards the request ID and the user ID like UnaryClientCorrelationInterceptor.
+func StreamClientCorrelationInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(correlationOutgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// CorrelationHeaderMatcher forwards RequestIDHTTPHeader and UserIDHTTPHeader to the gRPC server,
+// other headers are matched by next.
+func CorrelationHeaderMatcher(next runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		switch {
+		case strings.EqualFold(key, RequestIDHTTPHeader):
+			return RequestIDMetadataKey, true
+		case strings.EqualFold(key, UserIDHTTPHeader):
+			return UserIDMetadataKey, true
+		default:
+			return next(key)
+		}
+	}
+}
+
+// correlationIncomingContext injects the request ID and the user ID of the incoming metadata into the context,
+// the request ID is generated if the client sends none.
+func correlationIncomingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstMetadataValue(md, RequestIDMetadataKey)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	ctx = logkit.WithRequestID(ctx, requestID)
+
+	// the clients report the request ID in the response header to find the log lines,
+	// the error is ignored since it fails only if the context is not of a request
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, requestID))
+
+	if userID := firstMetadataValue(md, UserIDMetadataKey); userID != "" {
+		ctx = logkit.WithUserID(ctx, userID)
+	}
+
+	return ctx
+}
+
+// correlationOutgoingContext overrides the request ID and the user ID of the outgoing metadata,
+// the metadata is left untouched if there are none in the context.
+func correlationOutgoingContext(ctx context.Context) context.Context {
+	requestID, userID := logkit.RequestIDFromContext(ctx), logkit.UserIDFromContext(ctx)
+	if requestID == "" && userID == "" {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	if requestID != "" {
+		md.Set(RequestIDMetadataKey, requestID)
+	}
+	if userID != "" {
+		md.Set(UserIDMetadataKey, userID)
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,107 @@
+package grpckit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ = Describe("Correlation", func() {
+	Describe("correlationIncomingContext", func() {
+		var (
+			md metadata.MD
+
+			ctx context.Context
+		)
+
+		BeforeEach(func() {
+			md = metadata.MD{}
+		})
+
+		JustBeforeEach(func() {
+			ctx = correlationIncomingContext(metadata.NewIncomingContext(context.Background(), md))
+		})
+
+		When("the client sends the request ID and the user ID", func() {
+			BeforeEach(func() {
+				md.Set(RequestIDMetadataKey, "request-id")
+				md.Set(UserIDMetadataKey, "user-id")
+			})
+
+			It("injects them into the context", func() {
+				Expect(logkit.RequestIDFromContext(ctx)).To(Equal("request-id"))
+				Expect(logkit.UserIDFromContext(ctx)).To(Equal("user-id"))
+			})
+		})
+
+		When("the client sends nothing", func() {
+			It("generates the request ID", func() {
+				Expect(logkit.RequestIDFromContext(ctx)).NotTo(BeEmpty())
+				Expect(logkit.UserIDFromContext(ctx)).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("UnaryClientCorrelationInterceptor", func() {
+		var (
+			ctx context.Context
+
+			outgoing metadata.MD
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			outgoing = nil
+		})
+
+		JustBeforeEach(func() {
+			err := UnaryClientCorrelationInterceptor()(ctx, "/video.pb.Video/GetVideo", nil, nil, nil, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				outgoing, _ = metadata.FromOutgoingContext(ctx)
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the context carries the request ID and the user ID", func() {
+			BeforeEach(func() {
+				ctx = logkit.WithUserID(logkit.WithRequestID(ctx, "request-id"), "user-id")
+			})
+
+			It("forwards them to the outgoing metadata", func() {
+				Expect(outgoing.Get(RequestIDMetadataKey)).To(Equal([]string{"request-id"}))
+				Expect(outgoing.Get(UserIDMetadataKey)).To(Equal([]string{"user-id"}))
+			})
+		})
+
+		When("the context carries nothing", func() {
+			It("leaves the metadata untouched", func() {
+				Expect(outgoing).To(BeNil())
+			})
+		})
+	})
+
+	Describe("CorrelationHeaderMatcher", func() {
+		matcher := CorrelationHeaderMatcher(func(key string) (string, bool) {
+			return "", false
+		})
+
+		It("forwards the correlation headers", func() {
+			key, ok := matcher("X-Request-Id")
+			Expect(ok).To(BeTrue())
+			Expect(key).To(Equal(RequestIDMetadataKey))
+
+			key, ok = matcher("x-user-id")
+			Expect(ok).To(BeTrue())
+			Expect(key).To(Equal(UserIDMetadataKey))
+		})
+
+		It("matches other headers by next", func() {
+			_, ok := matcher("X-Other")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerDeadlineInterceptor limits the timeout of the requests to maxTimeout,
+// the deadline of the client is kept if it is earlier. The timeout is unlimited if maxTimeout is zero.
+func UnaryServerDeadlineInterceptor(maxTimeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := withMaxTimeout(ctx, maxTimeout)
+		defer cancel()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerDeadlineInterceptor limits the timeout of the streams to maxTimeout like UnaryServerDeadlineInterceptor,
+// the stream exceeding its deadline fails with DeadlineExceeded along with an ErrorInfo
+// of the number of the messages sent and received, so that the client knows the progress made.
+func StreamServerDeadlineInterceptor(maxTimeout time.Duration) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := withMaxTimeout(ss.Context(), maxTimeout)
+		defer cancel()
+
+		stream := &progressServerStream{ServerStream: ss, ctx: ctx}
+
+		err := handler(srv, stream)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return stream.deadlineExceededError()
+		}
+
+		return err
+	}
+}
+
+func withMaxTimeout(ctx context.Context, maxTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if maxTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= maxTimeout {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, maxTimeout)
+}
+
+// progressServerStream counts the messages sent and received by the stream.
+type progressServerStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	sent     int64
+	received int64
+}
+
+func (s *progressServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *progressServerStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&s.sent, 1)
+
+	return nil
+}
+
+func (s *progressServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	atomic.AddInt64(&s.received, 1)
+
+	return nil
+}
+
+func (s *progressServerStream) deadlineExceededError() error {
+	sent := atomic.LoadInt64(&s.sent)
+	received := atomic.LoadInt64(&s.received)
+
+	st := status.New(codes.DeadlineExceeded, fmt.Sprintf("deadline exceeded after sending %d and receiving %d messages", sent, received))
+
+	return withDetails(st, &errdetails.ErrorInfo{
+		Reason: "DEADLINE_EXCEEDED",
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"sent_messages":     strconv.FormatInt(sent, 10),
+			"received_messages": strconv.FormatInt(received, 10),
+		},
+	})
+}
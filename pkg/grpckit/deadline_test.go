@@ -0,0 +1,108 @@
+package grpckit
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Deadline", func() {
+	Describe("UnaryServerDeadlineInterceptor", func() {
+		var deadline func(ctx context.Context, maxTimeout time.Duration) (time.Time, bool)
+
+		BeforeEach(func() {
+			deadline = func(ctx context.Context, maxTimeout time.Duration) (time.Time, bool) {
+				var (
+					d  time.Time
+					ok bool
+				)
+
+				_, err := UnaryServerDeadlineInterceptor(maxTimeout)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					d, ok = ctx.Deadline()
+					return nil, nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				return d, ok
+			}
+		})
+
+		It("sets the max timeout if there is no deadline", func() {
+			d, ok := deadline(context.Background(), time.Minute)
+			Expect(ok).To(BeTrue())
+			Expect(d).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+		})
+
+		It("caps the deadline later than the max timeout", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+			defer cancel()
+
+			d, ok := deadline(ctx, time.Minute)
+			Expect(ok).To(BeTrue())
+			Expect(d).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+		})
+
+		It("keeps the deadline earlier than the max timeout", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			d, ok := deadline(ctx, time.Minute)
+			Expect(ok).To(BeTrue())
+			Expect(d).To(BeTemporally("~", time.Now().Add(time.Second), 500*time.Millisecond))
+		})
+
+		It("does not set a deadline if the max timeout is zero", func() {
+			_, ok := deadline(context.Background(), 0)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("StreamServerDeadlineInterceptor", func() {
+		It("returns the progress of the stream exceeding its deadline", func() {
+			ss := &deadlineServerStream{ctx: context.Background()}
+
+			err := StreamServerDeadlineInterceptor(10*time.Millisecond)(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				for i := 0; i < 3; i++ {
+					Expect(stream.SendMsg(nil)).To(Succeed())
+				}
+				Expect(stream.RecvMsg(nil)).To(Succeed())
+
+				<-stream.Context().Done()
+				return stream.Context().Err()
+			})
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+
+			info := ErrorInfo(err)
+			Expect(info).NotTo(BeNil())
+			Expect(info.Reason).To(Equal("DEADLINE_EXCEEDED"))
+			Expect(info.Metadata).To(Equal(map[string]string{
+				"sent_messages":     "3",
+				"received_messages": "1",
+			}))
+		})
+
+		It("returns the error of the stream within its deadline", func() {
+			ss := &deadlineServerStream{ctx: context.Background()}
+
+			err := StreamServerDeadlineInterceptor(time.Minute)(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				return status.Error(codes.NotFound, "not found")
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
+
+type deadlineServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *deadlineServerStream) Context() context.Context  { return s.ctx }
+func (s *deadlineServerStream) SendMsg(interface{}) error { return nil }
+func (s *deadlineServerStream) RecvMsg(interface{}) error { return nil }
@@ -0,0 +1,62 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorMapping maps the errors matching Err by errors.Is to the status error Status.
+type ErrorMapping struct {
+	Err    error
+	Status error
+}
+
+// MapError maps the error to the status error of the first matching mapping,
+// context errors are mapped to Canceled and DeadlineExceeded, the errors of the open circuit breakers
+// are mapped to Unavailable, other errors are returned as is.
+func MapError(err error, mappings ...ErrorMapping) error {
+	if err == nil {
+		return nil
+	}
+
+	for _, mapping := range mappings {
+		if errors.Is(err, mapping.Err) {
+			return mapping.Status
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, breakerkit.ErrOpen):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	return err
+}
+
+// UnaryServerErrorInterceptor maps the errors returned by the handlers with MapError.
+func UnaryServerErrorInterceptor(mappings ...ErrorMapping) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, MapError(err, mappings...)
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerErrorInterceptor maps the errors returned by the stream handlers with MapError.
+func StreamServerErrorInterceptor(mappings ...ErrorMapping) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return MapError(handler(srv, ss), mappings...)
+	}
+}
@@ -0,0 +1,109 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Error", func() {
+	var (
+		errNotFound    = errors.New("not found")
+		errStatus      = NewError(codes.NotFound, "test", "NOT_FOUND", "not found")
+		errOther       = errors.New("other")
+		errOtherStatus = NewError(codes.Internal, "test", "OTHER", "other")
+
+		mappings = []ErrorMapping{
+			{Err: errNotFound, Status: errStatus},
+			{Err: errOther, Status: errOtherStatus},
+		}
+	)
+
+	Describe("MapError", func() {
+		DescribeTable("maps the error",
+			func(err error, expected error) {
+				Expect(MapError(err, mappings...)).To(Equal(expected))
+			},
+			Entry("matching error", errNotFound, errStatus),
+			Entry("wrapped matching error", fmt.Errorf("wrap: %w", errNotFound), errStatus),
+			Entry("not matching error", errors.New("unknown"), errors.New("unknown")),
+			Entry("status error", errOtherStatus, errOtherStatus),
+		)
+
+		When("error is nil", func() {
+			It("returns nil", func() {
+				Expect(MapError(nil, mappings...)).To(BeNil())
+			})
+		})
+
+		When("error is a context error", func() {
+			It("returns status error with the canonical code", func() {
+				Expect(status.Code(MapError(context.Canceled))).To(Equal(codes.Canceled))
+				Expect(status.Code(MapError(fmt.Errorf("wrap: %w", context.DeadlineExceeded)))).To(Equal(codes.DeadlineExceeded))
+			})
+		})
+
+		When("error is of an open circuit breaker", func() {
+			It("returns status error with Unavailable", func() {
+				Expect(status.Code(MapError(fmt.Errorf("wrap: %w", breakerkit.ErrOpen)))).To(Equal(codes.Unavailable))
+			})
+		})
+	})
+
+	Describe("UnaryServerErrorInterceptor", func() {
+		var (
+			interceptor grpc.UnaryServerInterceptor
+			handlerResp interface{}
+			handlerErr  error
+
+			resp interface{}
+			err  error
+		)
+
+		BeforeEach(func() {
+			interceptor = UnaryServerErrorInterceptor(mappings...)
+			handlerResp = "resp"
+		})
+
+		JustBeforeEach(func() {
+			resp, err = interceptor(context.Background(), "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return handlerResp, handlerErr
+			})
+		})
+
+		When("handler succeeds", func() {
+			BeforeEach(func() { handlerErr = nil })
+
+			It("returns the response", func() {
+				Expect(resp).To(Equal("resp"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("handler fails", func() {
+			BeforeEach(func() { handlerErr = errNotFound })
+
+			It("returns the mapped error", func() {
+				Expect(resp).To(BeNil())
+				Expect(err).To(MatchError(errStatus))
+			})
+		})
+	})
+
+	Describe("StreamServerErrorInterceptor", func() {
+		It("returns the mapped error", func() {
+			interceptor := StreamServerErrorInterceptor(mappings...)
+			err := interceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				return errOther
+			})
+			Expect(err).To(MatchError(errOtherStatus))
+		})
+	})
+})
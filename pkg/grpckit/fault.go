@@ -0,0 +1,321 @@
+package grpckit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidFault = errors.New("invalid fault")
+
+type FaultInjectionConfig struct {
+	Enabled bool `long:"enabled" env:"ENABLED" description:"inject the faults set on the admin endpoint /faults into the requests, for the resilience experiments only"`
+}
+
+// Fault is injected into a percentage of the requests of a method. The requests are delayed by Delay, then fail
+// with Code if set. The streams fail once AbortAfter messages are sent and received if set, so the clients see
+// a stream broken halfway instead of one never started.
+type Fault struct {
+	// Method is the full method, e.g. /comment.pb.Comment/ListComment, or the service of the methods,
+	// e.g. /comment.pb.Comment/
+	Method     string
+	Percentage float64
+	Delay      time.Duration
+	Code       codes.Code
+	AbortAfter int
+}
+
+// Validate reports whether the fault injects anything into a percentage within (0, 100].
+func (f *Fault) Validate() error {
+	switch {
+	case !strings.HasPrefix(f.Method, "/"):
+		return fmt.Errorf("%w: method must be a full method or a service: %q", ErrInvalidFault, f.Method)
+	case f.Percentage <= 0 || f.Percentage > 100:
+		return fmt.Errorf("%w: percentage must be within (0, 100]: %v", ErrInvalidFault, f.Percentage)
+	case f.Delay < 0 || f.AbortAfter < 0:
+		return fmt.Errorf("%w: delay and abort after must not be negative", ErrInvalidFault)
+	case f.AbortAfter > 0 && f.Code == codes.OK:
+		return fmt.Errorf("%w: a stream is aborted with an error code", ErrInvalidFault)
+	case f.Delay == 0 && f.Code == codes.OK:
+		return fmt.Errorf("%w: neither a delay nor an error code", ErrInvalidFault)
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the fault for the admin endpoint, with the delay and the code in their names.
+func (f *Fault) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Method     string  `json:"method"`
+		Percentage float64 `json:"percentage"`
+		Delay      string  `json:"delay,omitempty"`
+		Code       string  `json:"code,omitempty"`
+		AbortAfter int     `json:"abort_after,omitempty"`
+	}{
+		Method:     f.Method,
+		Percentage: f.Percentage,
+		AbortAfter: f.AbortAfter,
+	}
+	if f.Delay > 0 {
+		v.Delay = f.Delay.String()
+	}
+	if f.Code != codes.OK {
+		v.Code = f.Code.String()
+	}
+
+	return json.Marshal(v)
+}
+
+// injectedFaultError is the error of the injected faults, the ErrorInfo tells the injected faults from the real ones.
+func injectedFaultError(c codes.Code) error {
+	return NewError(c, errorDomain, "INJECTED_FAULT", "the fault is injected")
+}
+
+// FaultInjector injects the faults set on the admin endpoint into the requests, so the resilience of the clients,
+// such as the retries, the hedging and the circuit breakers, is tested on a cluster without changing them.
+type FaultInjector struct {
+	random func() float64
+
+	mu     sync.RWMutex
+	faults map[string]*Fault
+}
+
+// NewFaultInjector returns the fault injector, or nil if it is disabled.
+func NewFaultInjector(conf *FaultInjectionConfig) *FaultInjector {
+	if !conf.Enabled {
+		return nil
+	}
+
+	return &FaultInjector{
+		random: rand.Float64,
+		faults: make(map[string]*Fault),
+	}
+}
+
+// Set sets the fault of the method, replacing the one set before.
+func (i *FaultInjector) Set(fault *Fault) error {
+	if err := fault.Validate(); err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.faults[fault.Method] = fault
+
+	return nil
+}
+
+// Clear clears the fault of the method, or every fault if the method is empty.
+func (i *FaultInjector) Clear(method string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if method == "" {
+		i.faults = make(map[string]*Fault)
+		return
+	}
+
+	delete(i.faults, method)
+}
+
+// Faults lists the faults in the order of the method.
+func (i *FaultInjector) Faults() []*Fault {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	faults := make([]*Fault, 0, len(i.faults))
+	for _, fault := range i.faults {
+		faults = append(faults, fault)
+	}
+	sort.Slice(faults, func(a, b int) bool { return faults[a].Method < faults[b].Method })
+
+	return faults
+}
+
+// roll returns the fault to inject into a request of the method, the fault of the method takes precedence
+// over the one of its service.
+func (i *FaultInjector) roll(fullMethod string) (*Fault, bool) {
+	i.mu.RLock()
+	fault, ok := i.faults[fullMethod]
+	if !ok {
+		fault, ok = i.faults[fullMethod[:strings.LastIndex(fullMethod, "/")+1]]
+	}
+	i.mu.RUnlock()
+
+	if !ok || i.random()*100 >= fault.Percentage {
+		return nil, false
+	}
+
+	return fault, true
+}
+
+// delay waits for the delay of the fault, or returns the error of the context if it is done first.
+func (f *Fault) delay(ctx context.Context) error {
+	if f.Delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(f.Delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// UnaryServerInterceptor injects the faults into the unary requests.
+func (i *FaultInjector) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		fault, ok := i.roll(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := fault.delay(ctx); err != nil {
+			return nil, err
+		}
+
+		if fault.Code != codes.OK {
+			return nil, injectedFaultError(fault.Code)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor injects the faults into the streams, the streams with AbortAfter fail halfway.
+func (i *FaultInjector) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		fault, ok := i.roll(info.FullMethod)
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		if err := fault.delay(ss.Context()); err != nil {
+			return err
+		}
+
+		switch {
+		case fault.AbortAfter > 0:
+			return handler(srv, &abortingServerStream{ServerStream: ss, remaining: int32(fault.AbortAfter), err: injectedFaultError(fault.Code)})
+		case fault.Code != codes.OK:
+			return injectedFaultError(fault.Code)
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// abortingServerStream fails the messages sent and received once the remaining messages run out,
+// the handlers return the error, which aborts the stream.
+type abortingServerStream struct {
+	grpc.ServerStream
+
+	remaining int32
+	err       error
+}
+
+func (s *abortingServerStream) SendMsg(m interface{}) error {
+	if err := s.next(); err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *abortingServerStream) RecvMsg(m interface{}) error {
+	if err := s.next(); err != nil {
+		return err
+	}
+
+	return s.ServerStream.RecvMsg(m)
+}
+
+// next counts a message down, the messages may be sent and received on different goroutines.
+func (s *abortingServerStream) next() error {
+	if atomic.AddInt32(&s.remaining, -1) < 0 {
+		return s.err
+	}
+
+	return nil
+}
+
+// ServeHTTP serves the admin endpoint of the faults: GET lists the faults, PUT sets the fault of the method parameter
+// from the percentage, delay, code and abort_after parameters, and DELETE clears the fault of the method parameter,
+// or every fault if absent, e.g.
+//
+//	curl -X PUT -H "Authorization: Bearer $TOKEN" "localhost:6060/faults?method=/comment.pb.Comment/ListComment&percentage=10&code=UNAVAILABLE"
+func (i *FaultInjector) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	switch req.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(i.Faults())
+	case http.MethodPut:
+		fault, err := parseFault(query.Get("method"), query.Get("percentage"), query.Get("delay"), query.Get("code"), query.Get("abort_after"))
+		if err == nil {
+			err = i.Set(fault)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	case http.MethodDelete:
+		i.Clear(query.Get("method"))
+	default:
+		w.Header().Set("Allow", "GET, PUT, DELETE")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// parseFault parses the fault from the parameters of the admin endpoint, the code is the name of the code,
+// e.g. UNAVAILABLE, or its number.
+func parseFault(method, percentage, delay, code, abortAfter string) (*Fault, error) {
+	fault := &Fault{Method: method}
+
+	var err error
+	if fault.Percentage, err = strconv.ParseFloat(percentage, 64); err != nil {
+		return nil, fmt.Errorf("%w: invalid percentage: %q", ErrInvalidFault, percentage)
+	}
+
+	if delay != "" {
+		if fault.Delay, err = time.ParseDuration(delay); err != nil {
+			return nil, fmt.Errorf("%w: invalid delay: %q", ErrInvalidFault, delay)
+		}
+	}
+
+	if code != "" {
+		if _, err := strconv.Atoi(code); err != nil {
+			code = strconv.Quote(strings.ToUpper(code))
+		}
+		if err := fault.Code.UnmarshalJSON([]byte(code)); err != nil {
+			return nil, fmt.Errorf("%w: invalid code: %s", ErrInvalidFault, code)
+		}
+	}
+
+	if abortAfter != "" {
+		if fault.AbortAfter, err = strconv.Atoi(abortAfter); err != nil {
+			return nil, fmt.Errorf("%w: invalid abort after: %q", ErrInvalidFault, abortAfter)
+		}
+	}
+
+	return fault, nil
+}
@@ -0,0 +1,173 @@
+package grpckit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("FaultInjector", func() {
+	var (
+		injector *FaultInjector
+		roll     float64
+	)
+
+	BeforeEach(func() {
+		injector = NewFaultInjector(&FaultInjectionConfig{Enabled: true})
+		roll = 0
+		injector.random = func() float64 { return roll }
+	})
+
+	unaryHandler := func(context.Context, interface{}) (interface{}, error) { return "resp", nil }
+
+	callUnary := func(ctx context.Context, fullMethod string) (interface{}, error) {
+		return injector.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, unaryHandler)
+	}
+
+	expectInjectedFault := func(err error, c codes.Code) {
+		s := status.Convert(err)
+		Expect(s.Code()).To(Equal(c))
+		Expect(s.Details()).To(ContainElement(BeAssignableToTypeOf(&errdetails.ErrorInfo{})))
+		Expect(s.Details()[0].(*errdetails.ErrorInfo).GetReason()).To(Equal("INJECTED_FAULT"))
+	}
+
+	It("is disabled unless enabled", func() {
+		Expect(NewFaultInjector(&FaultInjectionConfig{})).To(BeNil())
+	})
+
+	Describe("UnaryServerInterceptor", func() {
+		It("fails the requests within the percentage of the method with the code", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/ListComment", Percentage: 30, Code: codes.Unavailable})).To(Succeed())
+
+			roll = 0.29
+			_, err := callUnary(context.Background(), "/comment.pb.Comment/ListComment")
+			expectInjectedFault(err, codes.Unavailable)
+
+			roll = 0.3
+			Expect(callUnary(context.Background(), "/comment.pb.Comment/ListComment")).To(Equal("resp"))
+			Expect(callUnary(context.Background(), "/comment.pb.Comment/GetComment")).To(Equal("resp"))
+		})
+
+		It("delays the requests", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/", Percentage: 100, Delay: 50 * time.Millisecond})).To(Succeed())
+
+			start := time.Now()
+			Expect(callUnary(context.Background(), "/comment.pb.Comment/ListComment")).To(Equal("resp"))
+			Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		})
+
+		It("stops delaying once the request is done", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/ListComment", Percentage: 100, Delay: time.Hour})).To(Succeed())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := callUnary(ctx, "/comment.pb.Comment/ListComment")
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		})
+
+		It("prefers the fault of the method to the one of its service", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/", Percentage: 100, Code: codes.Internal})).To(Succeed())
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/ListComment", Percentage: 100, Code: codes.Unavailable})).To(Succeed())
+
+			_, err := callUnary(context.Background(), "/comment.pb.Comment/ListComment")
+			expectInjectedFault(err, codes.Unavailable)
+
+			_, err = callUnary(context.Background(), "/comment.pb.Comment/GetComment")
+			expectInjectedFault(err, codes.Internal)
+		})
+
+		It("stops injecting the cleared faults", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/ListComment", Percentage: 100, Code: codes.Unavailable})).To(Succeed())
+			injector.Clear("")
+
+			Expect(callUnary(context.Background(), "/comment.pb.Comment/ListComment")).To(Equal("resp"))
+		})
+	})
+
+	Describe("StreamServerInterceptor", func() {
+		It("aborts the stream after the messages", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/StreamComments", Percentage: 100, Code: codes.Unavailable, AbortAfter: 2})).To(Succeed())
+
+			sent := 0
+			err := injector.StreamServerInterceptor()(nil, &deadlineServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/comment.pb.Comment/StreamComments"}, func(srv interface{}, stream grpc.ServerStream) error {
+				for {
+					if err := stream.SendMsg(nil); err != nil {
+						return err
+					}
+					sent++
+				}
+			})
+
+			Expect(sent).To(Equal(2))
+			expectInjectedFault(err, codes.Unavailable)
+		})
+
+		It("fails the stream before the handler without AbortAfter", func() {
+			Expect(injector.Set(&Fault{Method: "/comment.pb.Comment/StreamComments", Percentage: 100, Code: codes.Unavailable})).To(Succeed())
+
+			err := injector.StreamServerInterceptor()(nil, &deadlineServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/comment.pb.Comment/StreamComments"}, func(srv interface{}, stream grpc.ServerStream) error {
+				Fail("the handler is called")
+				return nil
+			})
+			expectInjectedFault(err, codes.Unavailable)
+		})
+	})
+
+	DescribeTable("Set returns ErrInvalidFault",
+		func(fault *Fault) {
+			Expect(injector.Set(fault)).To(MatchError(ErrInvalidFault))
+		},
+		Entry("method not full", &Fault{Method: "ListComment", Percentage: 10, Code: codes.Unavailable}),
+		Entry("percentage out of range", &Fault{Method: "/comment.pb.Comment/", Percentage: 101, Code: codes.Unavailable}),
+		Entry("abort without code", &Fault{Method: "/comment.pb.Comment/", Percentage: 10, AbortAfter: 1}),
+		Entry("no fault", &Fault{Method: "/comment.pb.Comment/", Percentage: 10}),
+	)
+
+	Describe("ServeHTTP", func() {
+		serve := func(method, target string) *httptest.ResponseRecorder {
+			rec := httptest.NewRecorder()
+			injector.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+
+			return rec
+		}
+
+		It("sets, lists and clears the faults", func() {
+			rec := serve(http.MethodPut, "/faults?method=/comment.pb.Comment/StreamComments&percentage=10&delay=100ms&code=unavailable&abort_after=3")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(injector.Faults()).To(Equal([]*Fault{{
+				Method:     "/comment.pb.Comment/StreamComments",
+				Percentage: 10,
+				Delay:      100 * time.Millisecond,
+				Code:       codes.Unavailable,
+				AbortAfter: 3,
+			}}))
+
+			rec = serve(http.MethodGet, "/faults")
+			Expect(rec.Body.String()).To(MatchJSON(`[{"method":"/comment.pb.Comment/StreamComments","percentage":10,"delay":"100ms","code":"Unavailable","abort_after":3}]`))
+
+			rec = serve(http.MethodDelete, "/faults?method=/comment.pb.Comment/StreamComments")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(injector.Faults()).To(BeEmpty())
+		})
+
+		It("accepts the number of the code", func() {
+			Expect(serve(http.MethodPut, "/faults?method=/comment.pb.Comment/&percentage=5&code=14").Code).To(Equal(http.StatusOK))
+			Expect(injector.Faults()[0].Code).To(Equal(codes.Unavailable))
+		})
+
+		It("rejects the invalid faults", func() {
+			Expect(serve(http.MethodPut, "/faults?method=/comment.pb.Comment/&percentage=5&code=BROKEN").Code).To(Equal(http.StatusBadRequest))
+			Expect(serve(http.MethodPut, "/faults?method=/comment.pb.Comment/&percentage=5").Code).To(Equal(http.StatusBadRequest))
+			Expect(injector.Faults()).To(BeEmpty())
+		})
+	})
+})
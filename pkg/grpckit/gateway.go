@@ -0,0 +1,10 @@
+package grpckit
+
+import "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+// NewGatewayMux returns the mux of the REST gateways, which forwards the correlation headers and the headers
+// matched by next to the gRPC servers. The JSON of its responses and errors is what the mobile clients parse,
+// so the golden tests of the services serve their routes on it as the gateways do.
+func NewGatewayMux(next runtime.HeaderMatcherFunc) *runtime.ServeMux {
+	return runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(CorrelationHeaderMatcher(next)))
+}
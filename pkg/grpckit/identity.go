@@ -0,0 +1,80 @@
+package grpckit
+
+import (
+	"context"
+	"net"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tlskit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+var (
+	ErrUnauthenticatedPeer = NewError(codes.Unauthenticated, errorDomain, "UNAUTHENTICATED_PEER", "the peer presents no SPIFFE ID")
+	ErrPeerNotAllowed      = NewError(codes.PermissionDenied, errorDomain, "PEER_NOT_ALLOWED", "the SPIFFE ID of the peer is not allowed")
+)
+
+// UnaryServerIdentityInterceptor authenticates the services calling by the SPIFFE IDs of their
+// certificates verified by mTLS, only the services of allowedIDs are allowed.
+func UnaryServerIdentityInterceptor(allowedIDs []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := verifyPeer(ctx, allowedIDs); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerIdentityInterceptor authenticates the streams like UnaryServerIdentityInterceptor.
+func StreamServerIdentityInterceptor(allowedIDs []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := verifyPeer(ss.Context(), allowedIDs); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func verifyPeer(ctx context.Context, allowedIDs []string) error {
+	id, err := tlskit.PeerID(ctx)
+	if err != nil {
+		return ErrUnauthenticatedPeer
+	}
+
+	if err := tlskit.VerifyID(id, allowedIDs); err != nil {
+		return ErrPeerNotAllowed
+	}
+
+	return nil
+}
+
+// AnonymousPrincipal is the principal of a caller without a peer, e.g. an in-process call.
+const AnonymousPrincipal = "anonymous"
+
+// Principal identifies the caller by the SPIFFE ID of the calling service, or the address of the peer otherwise.
+// The user ID is an unverified header any peer could change on every request, so it only tells apart the users
+// of a service identified by mTLS, which is trusted to forward the user ID of its caller.
+func Principal(ctx context.Context) string {
+	if id, err := tlskit.PeerID(ctx); err == nil {
+		if userID := logkit.UserIDFromContext(ctx); userID != "" {
+			return "service:" + id + ":user:" + userID
+		}
+
+		return "service:" + id
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+
+		return "addr:" + host
+	}
+
+	return AnonymousPrincipal
+}
@@ -0,0 +1,89 @@
+package grpckit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+var _ = Describe("Identity", func() {
+	const fakeID = "spiffe://nthu-distributed-system/comment-api"
+
+	var ctx context.Context
+
+	// withPeerID returns the context of the request from the peer of the SPIFFE ID
+	withPeerID := func(id string) context.Context {
+		u, err := url.Parse(id)
+		Expect(err).NotTo(HaveOccurred())
+
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{u}}},
+				},
+			},
+		})
+	}
+
+	call := func(allowedIDs ...string) error {
+		_, err := UnaryServerIdentityInterceptor(allowedIDs)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	BeforeEach(func() {
+		ctx = withPeerID(fakeID)
+	})
+
+	It("allows the peer of the allowed ID", func() {
+		Expect(call(fakeID)).To(Succeed())
+	})
+
+	It("allows any identified peer if no ID is specified", func() {
+		Expect(call()).To(Succeed())
+	})
+
+	It("denies the peer of other IDs", func() {
+		Expect(call("spiffe://nthu-distributed-system/video-api")).To(MatchError(ErrPeerNotAllowed))
+	})
+
+	It("rejects the peer without ID", func() {
+		ctx = context.Background()
+
+		Expect(call()).To(MatchError(ErrUnauthenticatedPeer))
+	})
+
+	It("authenticates the streams", func() {
+		err := StreamServerIdentityInterceptor(nil)(nil, &deadlineServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		Expect(err).To(MatchError(ErrUnauthenticatedPeer))
+	})
+
+	Describe("Principal", func() {
+		It("identifies the service by its SPIFFE ID, and its users by the user IDs it forwards", func() {
+			Expect(Principal(ctx)).To(Equal("service:" + fakeID))
+			Expect(Principal(logkit.WithUserID(ctx, "user-1"))).To(Equal("service:" + fakeID + ":user:user-1"))
+		})
+
+		It("identifies the peer without mTLS by its address, ignoring the user ID it sends", func() {
+			ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50051}})
+			Expect(Principal(ctx)).To(Equal("addr:10.0.0.1"))
+			Expect(Principal(logkit.WithUserID(ctx, "user-1"))).To(Equal("addr:10.0.0.1"))
+		})
+
+		It("identifies no one without the peer, ignoring the user ID", func() {
+			Expect(Principal(logkit.WithUserID(context.Background(), "user-1"))).To(Equal("anonymous"))
+		})
+	})
+})
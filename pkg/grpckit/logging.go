@@ -0,0 +1,107 @@
+package grpckit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerLoggingInterceptor injects the logger into the request context for the handlers,
+// and logs the failed requests, or every request if logRequests is set. Every log line of the request
+// carries the method, the request ID, the user ID and the trace ID, so the log lines are correlated.
+// The server faults are logged along with the request, with the sensitive fields redacted by the logger.
+func UnaryServerLoggingInterceptor(logger *logkit.Logger, logRequests bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, logger := requestLogger(ctx, logger, info.FullMethod)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logRequest(logger, logRequests, err, time.Since(start), requestFields(req)...)
+
+		return resp, err
+	}
+}
+
+// StreamServerLoggingInterceptor injects the logger into the stream context for the handlers,
+// and logs the failed streams, or every stream if logRequests is set.
+func StreamServerLoggingInterceptor(logger *logkit.Logger, logRequests bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, logger := requestLogger(ss.Context(), logger, info.FullMethod)
+
+		start := time.Now()
+		err := handler(srv, &contextServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+		logRequest(logger, logRequests, err, time.Since(start))
+
+		return err
+	}
+}
+
+// requestLogger returns the logger of the request along with the context carrying it.
+func requestLogger(ctx context.Context, logger *logkit.Logger, fullMethod string) (context.Context, *logkit.Logger) {
+	ctx = correlationIncomingContext(ctx)
+
+	logger = logger.With(append([]zap.Field{zap.String("method", fullMethod)}, logkit.ContextFields(ctx)...)...)
+
+	return logger.WithContext(ctx), logger
+}
+
+// requestFields returns the field of the request message, the message is redacted when it is logged.
+func requestFields(req interface{}) []zap.Field {
+	if msg, ok := req.(proto.Message); ok {
+		return []zap.Field{logkit.Proto("request", msg)}
+	}
+
+	return nil
+}
+
+// logRequest logs the server faults as errors along with the request fields,
+// and the other requests as info if logRequests is set.
+func logRequest(logger *logkit.Logger, logRequests bool, err error, duration time.Duration, requestFields ...zap.Field) {
+	code := status.Code(err)
+	fields := []zap.Field{
+		zap.String("code", code.String()),
+		zap.Duration("duration", duration),
+	}
+
+	if isServerFault(code) {
+		logger.Error("failed to handle request", append(append(fields, zap.Error(err)), requestFields...)...)
+		return
+	}
+
+	if logRequests {
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		}
+
+		logger.Info("handled request", fields...)
+	}
+}
+
+func isServerFault(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented, codes.Unavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// contextServerStream overrides the context of the stream.
+type contextServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,102 @@
+package grpckit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Logging", func() {
+	var (
+		logger      *logkit.Logger
+		logs        *observer.ObservedLogs
+		logRequests bool
+		handlerErr  error
+		info        *grpc.UnaryServerInfo
+	)
+
+	BeforeEach(func() {
+		var core zapcore.Core
+		core, logs = observer.New(zapcore.InfoLevel)
+		logger = &logkit.Logger{Logger: zap.New(core)}
+		logRequests = false
+		handlerErr = nil
+		info = &grpc.UnaryServerInfo{FullMethod: "/comment.pb.Comment/GetComment"}
+	})
+
+	Describe("UnaryServerLoggingInterceptor", func() {
+		var handlerLogger *logkit.Logger
+
+		JustBeforeEach(func() {
+			_, _ = UnaryServerLoggingInterceptor(logger, logRequests)(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerLogger = logkit.FromContext(ctx)
+				return nil, handlerErr
+			})
+		})
+
+		It("injects the logger into the request context", func() {
+			handlerLogger.Info("from handler")
+
+			entries := logs.FilterMessage("from handler").All()
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ContextMap()).To(HaveKeyWithValue("method", info.FullMethod))
+			Expect(entries[0].ContextMap()).To(HaveKey("request_id"))
+		})
+
+		When("request succeeds", func() {
+			It("does not log the request", func() {
+				Expect(logs.Len()).To(BeZero())
+			})
+
+			When("every request is logged", func() {
+				BeforeEach(func() { logRequests = true })
+
+				It("logs the request", func() {
+					entries := logs.FilterMessage("handled request").All()
+					Expect(entries).To(HaveLen(1))
+					Expect(entries[0].ContextMap()).To(HaveKeyWithValue("code", "OK"))
+				})
+			})
+		})
+
+		When("request is invalid", func() {
+			BeforeEach(func() { handlerErr = status.Error(codes.InvalidArgument, "invalid") })
+
+			It("does not log the request", func() {
+				Expect(logs.Len()).To(BeZero())
+			})
+		})
+
+		When("server fails", func() {
+			BeforeEach(func() { handlerErr = status.Error(codes.Internal, "internal") })
+
+			It("logs the error", func() {
+				entries := logs.FilterMessage("failed to handle request").All()
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Level).To(Equal(zapcore.ErrorLevel))
+				Expect(entries[0].ContextMap()).To(HaveKeyWithValue("code", "Internal"))
+			})
+		})
+	})
+
+	Describe("StreamServerLoggingInterceptor", func() {
+		It("injects the logger into the stream context", func() {
+			ss := &contextServerStream{ctx: context.Background()}
+
+			err := StreamServerLoggingInterceptor(logger, false)(nil, ss, &grpc.StreamServerInfo{FullMethod: "/comment.pb.Comment/StreamComments"}, func(srv interface{}, stream grpc.ServerStream) error {
+				logkit.FromContext(stream.Context()).Info("from handler")
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs.FilterMessage("from handler").All()).To(HaveLen(1))
+		})
+	})
+})
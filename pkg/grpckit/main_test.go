@@ -0,0 +1,13 @@
+package grpckit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGrpcKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test gRPC Kit")
+}
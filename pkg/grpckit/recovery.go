@@ -0,0 +1,52 @@
+package grpckit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrPanic is returned to the clients instead of the panics of the handlers
+var ErrPanic = status.Error(codes.Internal, "internal error")
+
+// UnaryServerRecoveryInterceptor recovers the panics of the handlers into ErrPanic,
+// so that a bad request never crashes the server.
+func UnaryServerRecoveryInterceptor(logger *logkit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRecoveryInterceptor recovers the panics of the stream handlers into ErrPanic.
+func StreamServerRecoveryInterceptor(logger *logkit.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(logger, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(logger *logkit.Logger, method string, r interface{}) error {
+	logger.Error("recovered from panic",
+		zap.String("method", method),
+		zap.String("panic", fmt.Sprint(r)),
+		zap.Stack("stack"),
+	)
+
+	return ErrPanic
+}
@@ -0,0 +1,45 @@
+package grpckit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Recovery", func() {
+	var logger *logkit.Logger
+
+	BeforeEach(func() {
+		logger = logkit.NewNopLogger()
+	})
+
+	Describe("UnaryServerRecoveryInterceptor", func() {
+		It("recovers the panic of the handler", func() {
+			resp, err := UnaryServerRecoveryInterceptor(logger)(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("boom")
+			})
+			Expect(resp).To(BeNil())
+			Expect(err).To(MatchError(ErrPanic))
+		})
+
+		It("returns the response of the handler", func() {
+			resp, err := UnaryServerRecoveryInterceptor(logger)(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "resp", nil
+			})
+			Expect(resp).To(Equal("resp"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("StreamServerRecoveryInterceptor", func() {
+		It("recovers the panic of the handler", func() {
+			err := StreamServerRecoveryInterceptor(logger)(nil, &fakeServerStream{}, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				panic("boom")
+			})
+			Expect(err).To(MatchError(ErrPanic))
+		})
+	})
+})
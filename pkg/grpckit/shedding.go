@@ -0,0 +1,196 @@
+package grpckit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var ErrOverloaded = NewError(codes.Unavailable, errorDomain, "OVERLOADED", "the server is overloaded")
+
+type LoadSheddingConfig struct {
+	MaxInFlight   int           `long:"max_in_flight" env:"MAX_IN_FLIGHT" description:"the max number of the unary requests handled concurrently, load shedding is disabled if zero" default:"512"`
+	TargetLatency time.Duration `long:"target_latency" env:"TARGET_LATENCY" description:"the limit of the in-flight requests shrinks while the average latency exceeds it, and grows back otherwise; the limit is fixed if zero" default:"500ms"`
+	ReadRatio     float64       `long:"read_ratio" env:"READ_RATIO" description:"the ratio of the limit of the in-flight requests available to the reads, so the reads are shed before the writes" default:"0.8"`
+}
+
+// Validate reports whether the ratio of the reads is within (0, 1].
+func (c *LoadSheddingConfig) Validate() error {
+	if c.MaxInFlight > 0 && (c.ReadRatio <= 0 || c.ReadRatio > 1) {
+		return fmt.Errorf("read ratio of load shedding must be within (0, 1]: %v", c.ReadRatio)
+	}
+
+	return nil
+}
+
+// readMethodPrefixes are the prefixes of the names of the read methods, which are shed before the writes
+// since the clients retry the reads and a lost write is more costly to the user.
+var readMethodPrefixes = []string{"Get", "List", "Stream", "Backup"}
+
+// sheddingExemptServices are never shed, so the health of the server is reported while it is overloaded.
+var sheddingExemptServices = []string{"/grpc.health.v1.Health/", "/grpc.reflection."}
+
+const (
+	// latencyDecay is the weight of the latency of a request in the moving average
+	latencyDecay = 0.1
+	// limitBackoff is the ratio the limit shrinks by when the latency exceeds the target
+	limitBackoff = 0.9
+	// minInFlight is the floor of the limit, so the server recovers once the latency drops
+	minInFlight = 8
+)
+
+// LoadShedder rejects the requests exceeding the limit of the in-flight requests with ErrOverloaded, so the latency
+// of the admitted ones stays bounded during the traffic spikes instead of every request queuing up. The limit
+// adapts to the latency: it shrinks multiplicatively while the moving average latency exceeds the target, and grows
+// additively up to the max otherwise. The reads are admitted up to a ratio of the limit only, so they are shed first.
+type LoadShedder struct {
+	maxInFlight   float64
+	targetLatency time.Duration
+	readRatio     float64
+	now           func() time.Time
+
+	mu           sync.Mutex
+	inFlight     int
+	limit        float64
+	latency      time.Duration
+	lastBackoff  time.Time
+	observedOnce bool
+}
+
+// NewLoadShedder returns the load shedder, or nil if it is disabled.
+func NewLoadShedder(conf *LoadSheddingConfig) *LoadShedder {
+	if conf.MaxInFlight <= 0 {
+		return nil
+	}
+
+	readRatio := conf.ReadRatio
+	if readRatio <= 0 || readRatio > 1 {
+		readRatio = 1
+	}
+
+	return &LoadShedder{
+		maxInFlight:   float64(conf.MaxInFlight),
+		targetLatency: conf.TargetLatency,
+		readRatio:     readRatio,
+		now:           time.Now,
+		limit:         float64(conf.MaxInFlight),
+	}
+}
+
+// UnaryServerInterceptor sheds the unary requests beyond the limit, the latency of the admitted ones adapts the limit.
+func (s *LoadShedder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isSheddingExempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if !s.acquire(isReadMethod(info.FullMethod)) {
+			return nil, ErrOverloaded
+		}
+
+		start := s.now()
+		defer func() { s.release(s.now().Sub(start)) }()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor sheds the new streams when the unary requests reach the limit. The streams are not counted
+// as in flight, since the long-lived streams would hold the slots regardless of the load.
+func (s *LoadShedder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isSheddingExempt(info.FullMethod) && !s.admit(isReadMethod(info.FullMethod)) {
+			return ErrOverloaded
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (s *LoadShedder) acquire(read bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.admitLocked(read) {
+		return false
+	}
+
+	s.inFlight++
+
+	return true
+}
+
+func (s *LoadShedder) admit(read bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.admitLocked(read)
+}
+
+// admitLocked reports whether a request is admitted, it must be called with the lock held.
+func (s *LoadShedder) admitLocked(read bool) bool {
+	limit := s.limit
+	if read {
+		limit *= s.readRatio
+	}
+
+	// a request is admitted at least, so the reads are not starved by a tiny limit
+	return float64(s.inFlight) < math.Max(1, math.Floor(limit))
+}
+
+func (s *LoadShedder) release(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight--
+
+	if !s.observedOnce {
+		s.latency, s.observedOnce = latency, true
+	} else {
+		s.latency += time.Duration(latencyDecay * float64(latency-s.latency))
+	}
+
+	if s.targetLatency <= 0 {
+		return
+	}
+
+	if s.latency <= s.targetLatency {
+		s.limit = math.Min(s.maxInFlight, s.limit+1/s.limit)
+		return
+	}
+
+	// the limit shrinks once per target latency, so the requests admitted under the previous limit
+	// finish before the next backoff
+	if now := s.now(); now.Sub(s.lastBackoff) >= s.targetLatency {
+		s.limit = math.Max(math.Min(minInFlight, s.maxInFlight), s.limit*limitBackoff)
+		s.lastBackoff = now
+	}
+}
+
+func isReadMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSheddingExempt(fullMethod string) bool {
+	for _, prefix := range sheddingExemptServices {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
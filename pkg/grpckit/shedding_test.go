@@ -0,0 +1,107 @@
+package grpckit
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("LoadShedder", func() {
+	var (
+		shedder *LoadShedder
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+
+		shedder = NewLoadShedder(&LoadSheddingConfig{
+			MaxInFlight:   10,
+			TargetLatency: 100 * time.Millisecond,
+			ReadRatio:     0.5,
+		})
+		shedder.now = func() time.Time { return now }
+	})
+
+	When("the in-flight requests reach the limit of the reads", func() {
+		BeforeEach(func() {
+			for i := 0; i < 5; i++ {
+				Expect(shedder.acquire(false)).To(BeTrue())
+			}
+		})
+
+		It("sheds the reads before the writes", func() {
+			Expect(shedder.acquire(true)).To(BeFalse())
+			Expect(shedder.acquire(false)).To(BeTrue())
+		})
+
+		It("sheds the unary reads with ErrOverloaded", func() {
+			interceptor := shedder.UnaryServerInterceptor()
+
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/comment.pb.Comment/ListComment"},
+				func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+			Expect(err).To(MatchError(ErrOverloaded))
+		})
+
+		It("does not shed the health checks", func() {
+			interceptor := shedder.UnaryServerInterceptor()
+
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"},
+				func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("the in-flight requests reach the max", func() {
+		BeforeEach(func() {
+			for i := 0; i < 10; i++ {
+				Expect(shedder.acquire(false)).To(BeTrue())
+			}
+		})
+
+		It("sheds the writes", func() {
+			Expect(shedder.acquire(false)).To(BeFalse())
+		})
+	})
+
+	When("the latency exceeds the target", func() {
+		BeforeEach(func() {
+			Expect(shedder.acquire(false)).To(BeTrue())
+			shedder.release(time.Second)
+		})
+
+		It("shrinks the limit", func() {
+			Expect(shedder.limit).To(BeNumerically("<", 10))
+		})
+
+		It("grows the limit back once the latency drops", func() {
+			limit := shedder.limit
+			for i := 0; i < 100; i++ {
+				Expect(shedder.acquire(false)).To(BeTrue())
+				shedder.release(time.Millisecond)
+			}
+			Expect(shedder.limit).To(BeNumerically(">", limit))
+		})
+	})
+
+	When("load shedding is disabled", func() {
+		It("returns nil", func() {
+			Expect(NewLoadShedder(&LoadSheddingConfig{})).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("isReadMethod", func() {
+	DescribeTable("classifies the method",
+		func(fullMethod string, expected bool) {
+			Expect(isReadMethod(fullMethod)).To(Equal(expected))
+		},
+		Entry("get", "/video.pb.Video/GetVideo", true),
+		Entry("list", "/comment.pb.Comment/ListComment", true),
+		Entry("create", "/comment.pb.Comment/CreateComment", false),
+		Entry("delete", "/video.pb.Video/DeleteVideo", false),
+	)
+})
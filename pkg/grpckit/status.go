@@ -0,0 +1,73 @@
+package grpckit
+
+import (
+	"errors"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// errorDomain is the domain of the ErrorInfo of the errors returned by the interceptors of grpckit
+const errorDomain = "grpckit.nthu-distributed-system"
+
+// NewError returns a status error with an ErrorInfo detail,
+// clients identify the error by the reason and the domain instead of the message.
+func NewError(c codes.Code, domain, reason, msg string) error {
+	return withDetails(status.New(c, msg), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: domain,
+	})
+}
+
+// NewRetryError returns a status error with an ErrorInfo detail and a RetryInfo detail,
+// which tells the clients not to retry the request before the delay.
+func NewRetryError(c codes.Code, domain, reason, msg string, retryDelay time.Duration) error {
+	return withDetails(status.New(c, msg), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: domain,
+	}, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+}
+
+// NewInvalidArgumentError returns an InvalidArgument status error with an ErrorInfo detail
+// and a BadRequest detail describing the violation of the request field.
+func NewInvalidArgumentError(domain, reason, field, msg string) error {
+	return withDetails(status.New(codes.InvalidArgument, msg), &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: domain,
+	}, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: msg},
+		},
+	})
+}
+
+// ErrorInfo returns the ErrorInfo detail of the status error, or nil if there is none.
+func ErrorInfo(err error) *errdetails.ErrorInfo {
+	var se interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &se) {
+		return nil
+	}
+
+	for _, detail := range se.GRPCStatus().Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	return nil
+}
+
+// withDetails attaches the details to the status, the details are dropped if they cannot be marshaled.
+func withDetails(st *status.Status, details ...protoiface.MessageV1) error {
+	if ds, err := st.WithDetails(details...); err == nil {
+		return ds.Err()
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,86 @@
+package grpckit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Status", func() {
+	Describe("NewError", func() {
+		It("returns status error with ErrorInfo", func() {
+			err := NewError(codes.NotFound, "test", "NOT_FOUND", "not found")
+
+			st := status.Convert(err)
+			Expect(st.Code()).To(Equal(codes.NotFound))
+			Expect(st.Message()).To(Equal("not found"))
+			Expect(ErrorInfo(err).GetReason()).To(Equal("NOT_FOUND"))
+			Expect(ErrorInfo(err).GetDomain()).To(Equal("test"))
+		})
+	})
+
+	Describe("NewInvalidArgumentError", func() {
+		It("returns status error with ErrorInfo and BadRequest", func() {
+			err := NewInvalidArgumentError("test", "INVALID_ID", "id", "invalid ID")
+
+			st := status.Convert(err)
+			Expect(st.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ErrorInfo(err).GetReason()).To(Equal("INVALID_ID"))
+
+			var badRequest *errdetails.BadRequest
+			for _, detail := range st.Details() {
+				if d, ok := detail.(*errdetails.BadRequest); ok {
+					badRequest = d
+				}
+			}
+			Expect(badRequest.GetFieldViolations()).To(HaveLen(1))
+			Expect(badRequest.GetFieldViolations()[0].GetField()).To(Equal("id"))
+			Expect(badRequest.GetFieldViolations()[0].GetDescription()).To(Equal("invalid ID"))
+		})
+	})
+
+	Describe("NewRetryError", func() {
+		It("returns status error with ErrorInfo and RetryInfo", func() {
+			err := NewRetryError(codes.ResourceExhausted, "test", "RATE_LIMITED", "rate limited", 3*time.Second)
+
+			st := status.Convert(err)
+			Expect(st.Code()).To(Equal(codes.ResourceExhausted))
+			Expect(ErrorInfo(err).GetReason()).To(Equal("RATE_LIMITED"))
+
+			var retryInfo *errdetails.RetryInfo
+			for _, detail := range st.Details() {
+				if d, ok := detail.(*errdetails.RetryInfo); ok {
+					retryInfo = d
+				}
+			}
+			Expect(retryInfo.GetRetryDelay().AsDuration()).To(Equal(3 * time.Second))
+		})
+	})
+
+	Describe("ErrorInfo", func() {
+		When("error is wrapped", func() {
+			It("returns ErrorInfo of the wrapped error", func() {
+				err := fmt.Errorf("wrap: %w", NewError(codes.Internal, "test", "INTERNAL", "internal"))
+				Expect(ErrorInfo(err).GetReason()).To(Equal("INTERNAL"))
+			})
+		})
+
+		When("error is not status error", func() {
+			It("returns nil", func() {
+				Expect(ErrorInfo(errors.New("error"))).To(BeNil())
+			})
+		})
+
+		When("status error has no ErrorInfo", func() {
+			It("returns nil", func() {
+				Expect(ErrorInfo(status.Error(codes.Internal, "internal"))).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator is implemented by the messages generated by protoc-gen-validate
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by the validation errors generated by protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// multiError is implemented by the errors returned by ValidateAll
+type multiError interface {
+	AllErrors() []error
+}
+
+// UnaryServerValidatorInterceptor rejects the requests violating their validation rules with InvalidArgument.
+func UnaryServerValidatorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerValidatorInterceptor rejects the received messages violating their validation rules with InvalidArgument.
+func StreamServerValidatorInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatorServerStream{ServerStream: ss})
+	}
+}
+
+// validate returns an InvalidArgument status error with a BadRequest detail listing every violation.
+func validate(msg interface{}) error {
+	v, ok := msg.(validator)
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if me, ok := err.(multiError); ok {
+		errs = me.AllErrors()
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, err := range errs {
+		var fe fieldError
+		if errors.As(err, &fe) {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       fe.Field(),
+				Description: fe.Reason(),
+			})
+		}
+	}
+
+	return withDetails(status.New(codes.InvalidArgument, err.Error()), badRequest)
+}
+
+type validatorServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatorServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m)
+}
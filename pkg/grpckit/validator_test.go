@@ -0,0 +1,153 @@
+package grpckit
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Validator", func() {
+	Describe("UnaryServerValidatorInterceptor", func() {
+		var (
+			interceptor grpc.UnaryServerInterceptor
+			req         interface{}
+			called      bool
+
+			resp interface{}
+			err  error
+		)
+
+		BeforeEach(func() {
+			interceptor = UnaryServerValidatorInterceptor()
+			called = false
+		})
+
+		JustBeforeEach(func() {
+			resp, err = interceptor(context.Background(), req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "resp", nil
+			})
+		})
+
+		When("request is valid", func() {
+			BeforeEach(func() { req = &fakeRequest{} })
+
+			It("calls the handler", func() {
+				Expect(called).To(BeTrue())
+				Expect(resp).To(Equal("resp"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("request has no validation rules", func() {
+			BeforeEach(func() { req = "req" })
+
+			It("calls the handler", func() {
+				Expect(called).To(BeTrue())
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("request is invalid", func() {
+			BeforeEach(func() {
+				req = &fakeRequest{errs: []error{
+					fakeFieldError{field: "id", reason: "value must be a valid UUID"},
+					fakeFieldError{field: "content", reason: "value length must be at least 1 runes"},
+				}}
+			})
+
+			It("returns invalid argument error with every violation", func() {
+				Expect(called).To(BeFalse())
+				Expect(resp).To(BeNil())
+
+				st := status.Convert(err)
+				Expect(st.Code()).To(Equal(codes.InvalidArgument))
+				Expect(st.Details()).To(HaveLen(1))
+
+				badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+				Expect(ok).To(BeTrue())
+				Expect(badRequest.GetFieldViolations()).To(HaveLen(2))
+				Expect(badRequest.GetFieldViolations()[0].GetField()).To(Equal("id"))
+				Expect(badRequest.GetFieldViolations()[0].GetDescription()).To(Equal("value must be a valid UUID"))
+				Expect(badRequest.GetFieldViolations()[1].GetField()).To(Equal("content"))
+			})
+		})
+	})
+
+	Describe("StreamServerValidatorInterceptor", func() {
+		It("returns invalid argument error when the received message is invalid", func() {
+			interceptor := StreamServerValidatorInterceptor()
+			ss := &fakeServerStream{msgs: []*fakeRequest{
+				{},
+				{errs: []error{fakeFieldError{field: "filename", reason: "value length must be at least 1 runes"}}},
+			}}
+
+			err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				for {
+					if err := stream.RecvMsg(&fakeRequest{}); err != nil {
+						return err
+					}
+				}
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+})
+
+type fakeRequest struct {
+	errs []error
+}
+
+func (r *fakeRequest) ValidateAll() error {
+	if len(r.errs) == 0 {
+		return nil
+	}
+
+	return fakeMultiError(r.errs)
+}
+
+type fakeMultiError []error
+
+func (m fakeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (m fakeMultiError) AllErrors() []error { return m }
+
+type fakeFieldError struct {
+	field  string
+	reason string
+}
+
+func (e fakeFieldError) Error() string  { return e.field + ": " + e.reason }
+func (e fakeFieldError) Field() string  { return e.field }
+func (e fakeFieldError) Reason() string { return e.reason }
+
+type fakeServerStream struct {
+	grpc.ServerStream
+
+	msgs []*fakeRequest
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.msgs) == 0 {
+		return errors.New("EOF")
+	}
+
+	*m.(*fakeRequest) = *s.msgs[0]
+	s.msgs = s.msgs[1:]
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package grpckit
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+type GrpcWebConfig struct {
+	AllowedOrigins   []string `long:"allowed_origins" env:"ALLOWED_ORIGINS" env-delim:"," description:"the origins allowed to call the gRPC-Web endpoints, all origins are allowed if empty"`
+	EnableWebsockets bool     `long:"enable_websockets" env:"ENABLE_WEBSOCKETS" description:"enable gRPC-Web over websockets for client and bidirectional streaming"`
+}
+
+// NewGrpcWebHandler translates the gRPC-Web requests to the methods of the services into gRPC requests,
+// which are proxied to the gRPC server over HTTP/2, other requests are served by next.
+func NewGrpcWebHandler(conf *GrpcWebConfig, serverAddr string, next http.Handler, services ...*grpc.ServiceDesc) http.Handler {
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "http"
+			req.URL.Host = serverAddr
+		},
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, _ *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		},
+		// flush every message immediately for the streaming methods
+		FlushInterval: -1,
+	}
+
+	endpoints := serviceEndpoints(services...)
+	allowOrigin := originFunc(conf.AllowedOrigins)
+
+	wrapped := grpcweb.WrapHandler(proxy,
+		grpcweb.WithOriginFunc(allowOrigin),
+		grpcweb.WithEndpointsFunc(func() []string { return endpoints }),
+		grpcweb.WithWebsockets(conf.EnableWebsockets),
+		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool {
+			return allowOrigin(req.Header.Get("Origin"))
+		}),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if wrapped.IsGrpcWebRequest(req) || wrapped.IsAcceptableGrpcCorsRequest(req) || wrapped.IsGrpcWebSocketRequest(req) {
+			wrapped.ServeHTTP(w, req)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// serviceEndpoints returns the paths of the methods of the services, e.g. /comment.pb.Comment/ListComment.
+func serviceEndpoints(services ...*grpc.ServiceDesc) []string {
+	var endpoints []string
+
+	for _, service := range services {
+		for _, method := range service.Methods {
+			endpoints = append(endpoints, "/"+service.ServiceName+"/"+method.MethodName)
+		}
+		for _, stream := range service.Streams {
+			endpoints = append(endpoints, "/"+service.ServiceName+"/"+stream.StreamName)
+		}
+	}
+
+	return endpoints
+}
+
+func originFunc(allowedOrigins []string) func(origin string) bool {
+	return func(origin string) bool {
+		if len(allowedOrigins) == 0 {
+			return true
+		}
+
+		for _, allowed := range allowedOrigins {
+			if origin == allowed {
+				return true
+			}
+		}
+
+		return false
+	}
+}
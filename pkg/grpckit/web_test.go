@@ -0,0 +1,131 @@
+package grpckit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("GrpcWeb", func() {
+	var (
+		grpcServer *grpc.Server
+		httpServer *httptest.Server
+		webConf    *GrpcWebConfig
+	)
+
+	BeforeEach(func() {
+		webConf = &GrpcWebConfig{}
+	})
+
+	JustBeforeEach(func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcServer = grpc.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+
+		httpServer = httptest.NewServer(NewGrpcWebHandler(webConf, lis.Addr().String(), next, &healthpb.Health_ServiceDesc))
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+		grpcServer.Stop()
+	})
+
+	When("request is not a gRPC-Web request", func() {
+		It("is served by the next handler", func() {
+			resp, err := http.Get(httpServer.URL + "/v1/healthz")
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusTeapot))
+		})
+	})
+
+	When("request is a gRPC-Web request", func() {
+		It("is proxied to the gRPC server", func() {
+			msg, err := proto.Marshal(&healthpb.HealthCheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest(http.MethodPost, httpServer.URL+"/grpc.health.v1.Health/Check", bytes.NewReader(grpcWebFrame(0, msg)))
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Content-Type", "application/grpc-web+proto")
+			req.Header.Set("X-Grpc-Web", "1")
+
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			// the response is a message frame followed by a trailer frame
+			flag, payload, rest := readGrpcWebFrame(body)
+			Expect(flag).To(Equal(byte(0)))
+
+			var healthResp healthpb.HealthCheckResponse
+			Expect(proto.Unmarshal(payload, &healthResp)).To(Succeed())
+			Expect(healthResp.GetStatus()).To(Equal(healthpb.HealthCheckResponse_SERVING))
+
+			flag, payload, _ = readGrpcWebFrame(rest)
+			Expect(flag).To(Equal(byte(0x80)))
+			Expect(string(payload)).To(ContainSubstring("grpc-status: 0"))
+		})
+	})
+
+	When("origin is not allowed", func() {
+		BeforeEach(func() {
+			webConf.AllowedOrigins = []string{"https://allowed.example.com"}
+		})
+
+		It("does not allow the CORS request", func() {
+			req, err := http.NewRequest(http.MethodOptions, httpServer.URL+"/grpc.health.v1.Health/Check", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Origin", "https://not-allowed.example.com")
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			Expect(resp.Header.Get("Access-Control-Allow-Origin")).To(BeEmpty())
+		})
+	})
+})
+
+func grpcWebFrame(flag byte, payload []byte) []byte {
+	frame := make([]byte, 5, 5+len(payload))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+
+	return append(frame, payload...)
+}
+
+func readGrpcWebFrame(data []byte) (byte, []byte, []byte) {
+	Expect(len(data)).To(BeNumerically(">=", 5))
+
+	length := int(binary.BigEndian.Uint32(data[1:5]))
+	Expect(len(data)).To(BeNumerically(">=", 5+length))
+
+	return data[0], data[5 : 5+length], data[5+length:]
+}
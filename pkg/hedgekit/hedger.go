@@ -0,0 +1,204 @@
+package hedgekit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.uber.org/zap"
+)
+
+type HedgeConfig struct {
+	Quantile     float64       `long:"quantile" env:"QUANTILE" description:"the latency quantile of the reads after which a hedged read is sent to the next replica, hedging is disabled if zero" default:"0.95"`
+	InitialDelay time.Duration `long:"initial_delay" env:"INITIAL_DELAY" description:"the delay before sending a hedged read until enough latencies are observed" default:"50ms"`
+	WindowSize   int           `long:"window_size" env:"WINDOW_SIZE" description:"the number of the recent latencies the quantile is estimated from" default:"1000"`
+}
+
+// Validate reports whether the quantile is less than 1 and the window is positive if hedging is enabled.
+func (c *HedgeConfig) Validate() error {
+	if c.Quantile < 0 || c.Quantile >= 1 {
+		return fmt.Errorf("hedging quantile must be in [0, 1): %v", c.Quantile)
+	}
+
+	if c.Quantile > 0 && c.WindowSize <= 0 {
+		return fmt.Errorf("hedging window size must be positive: %d", c.WindowSize)
+	}
+
+	return nil
+}
+
+// ErrNoAttempts is returned if a read is done without any attempt.
+var ErrNoAttempts = errors.New("no attempts")
+
+// defaultWindowSize is the number of the latencies the quantile is estimated from if not configured
+const defaultWindowSize = 1000
+
+// Func is an attempt of a hedged read, it must be idempotent and return when the context is canceled.
+type Func func(ctx context.Context) (interface{}, error)
+
+// Hedger sends a read to the next replica if the previous ones do not respond within the delay, and takes the first
+// successful response, so a slow replica does not become the tail latency of the reads. The delay follows the latency
+// quantile of the recent reads, so only the slowest reads are hedged and the extra load stays around 1 - quantile.
+// A nil Hedger is a disabled hedger that sends the read to the first replica only.
+type Hedger struct {
+	quantile   float64
+	attributes []attribute.KeyValue
+
+	// delay is the current hedging delay in nanoseconds, re-estimated every recomputeEvery observations
+	delay int64
+
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+	observed  int
+
+	hedgeCounter syncint64.Counter
+	winCounter   syncint64.Counter
+}
+
+// NewHedger returns the hedger of the named reads, or nil if hedging is disabled.
+func NewHedger(ctx context.Context, name string, conf *HedgeConfig, meter metric.Meter) *Hedger {
+	if conf.Quantile <= 0 {
+		return nil
+	}
+
+	logger := logkit.FromContext(ctx).With(zap.String("hedger", name))
+
+	hedgeCounter, err := meter.SyncInt64().Counter("hedge_request", instrument.WithDescription("count number of hedged reads sent to the next replica"))
+	if err != nil {
+		logger.Fatal("failed to create hedge request counter", zap.Error(err))
+	}
+
+	winCounter, err := meter.SyncInt64().Counter("hedge_win", instrument.WithDescription("count number of hedged reads responding before the previous ones"))
+	if err != nil {
+		logger.Fatal("failed to create hedge win counter", zap.Error(err))
+	}
+
+	windowSize := conf.WindowSize
+	if windowSize <= 0 {
+		windowSize = defaultWindowSize
+	}
+
+	return &Hedger{
+		quantile:     conf.Quantile,
+		attributes:   []attribute.KeyValue{attribute.String("name", name)},
+		delay:        int64(conf.InitialDelay),
+		latencies:    make([]time.Duration, 0, windowSize),
+		hedgeCounter: hedgeCounter,
+		winCounter:   winCounter,
+	}
+}
+
+// Delay returns the current delay before sending a hedged read.
+func (h *Hedger) Delay() time.Duration {
+	return time.Duration(atomic.LoadInt64(&h.delay))
+}
+
+type result struct {
+	attempt int
+	value   interface{}
+	err     error
+}
+
+// Do calls the first function, then each of the next ones once the delay elapses without a successful response,
+// e.g. the same read on each replica. It returns the first successful response and cancels the other attempts,
+// or the error of the last attempt sent if all of them fail, no matter which one fails last. An attempt failing
+// before the delay does not hedge the read.
+func (h *Hedger) Do(ctx context.Context, fns ...Func) (interface{}, error) {
+	if len(fns) == 0 {
+		return nil, ErrNoAttempts
+	}
+
+	if h == nil || len(fns) == 1 {
+		return fns[0](ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan result, len(fns))
+	send := func(attempt int) {
+		start := time.Now()
+		value, err := fns[attempt](ctx)
+		if err == nil {
+			h.observe(time.Since(start))
+		}
+
+		results <- result{attempt: attempt, value: value, err: err}
+	}
+
+	go send(0)
+	sent, pending := 1, 1
+	errs := make([]error, len(fns))
+
+	timer := time.NewTimer(h.Delay())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			h.hedgeCounter.Add(ctx, 1, h.attributes...)
+
+			go send(sent)
+			sent++
+			pending++
+
+			if sent < len(fns) {
+				timer.Reset(h.Delay())
+			}
+		case r := <-results:
+			pending--
+
+			if r.err == nil {
+				if r.attempt > 0 {
+					h.winCounter.Add(ctx, 1, h.attributes...)
+				}
+
+				return r.value, nil
+			}
+
+			errs[r.attempt] = r.err
+
+			// the error is returned if no other attempt may succeed
+			if pending == 0 {
+				return nil, errs[sent-1]
+			}
+		}
+	}
+}
+
+// recomputeEvery is how often the delay is re-estimated, so the latencies are not sorted on every read
+const recomputeEvery = 100
+
+// observe records the latency of a successful attempt, and re-estimates the delay periodically.
+func (h *Hedger) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < cap(h.latencies) {
+		h.latencies = append(h.latencies, latency)
+	} else {
+		h.latencies[h.next] = latency
+		h.next = (h.next + 1) % len(h.latencies)
+	}
+
+	h.observed++
+	if h.observed%recomputeEvery != 0 {
+		return
+	}
+
+	sorted := make([]time.Duration, len(h.latencies))
+	copy(sorted, h.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	atomic.StoreInt64(&h.delay, int64(sorted[int(float64(len(sorted)-1)*h.quantile)]))
+}
@@ -0,0 +1,119 @@
+package hedgekit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+var _ = Describe("Hedger", func() {
+	var (
+		ctx    context.Context
+		hedger *Hedger
+		conf   *HedgeConfig
+	)
+
+	// respond returns the attempt responding the value after the latency, or the error if any
+	respond := func(value string, latency time.Duration, err error) Func {
+		return func(ctx context.Context) (interface{}, error) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(latency):
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			return value, nil
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		conf = &HedgeConfig{
+			Quantile:     0.95,
+			InitialDelay: 20 * time.Millisecond,
+			WindowSize:   100,
+		}
+	})
+
+	JustBeforeEach(func() {
+		hedger = NewHedger(ctx, "test", conf, nonrecording.NewNoopMeterProvider().Meter(""))
+	})
+
+	When("the first replica responds within the delay", func() {
+		It("does not hedge the read", func() {
+			hedged := false
+
+			Expect(hedger.Do(ctx, respond("first", 0, nil), func(context.Context) (interface{}, error) {
+				hedged = true
+				return "second", nil
+			})).To(Equal("first"))
+			Expect(hedged).To(BeFalse())
+		})
+	})
+
+	When("the first replica is slow", func() {
+		It("takes the response of the hedged read", func() {
+			start := time.Now()
+
+			Expect(hedger.Do(ctx, respond("first", time.Second, nil), respond("second", 0, nil))).To(Equal("second"))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+
+	When("the hedged read fails", func() {
+		It("waits for the first replica", func() {
+			Expect(hedger.Do(ctx, respond("first", 50*time.Millisecond, nil), respond("", 0, errors.New("unavailable")))).To(Equal("first"))
+		})
+	})
+
+	When("all the replicas fail", func() {
+		It("returns the error of the last attempt sent", func() {
+			errUnavailable := errors.New("unavailable")
+
+			_, err := hedger.Do(ctx, respond("", 50*time.Millisecond, errors.New("timeout")), respond("", 0, errUnavailable))
+			Expect(err).To(MatchError(errUnavailable))
+		})
+	})
+
+	When("there is no attempt", func() {
+		It("returns no attempts error", func() {
+			_, err := hedger.Do(ctx)
+			Expect(err).To(MatchError(ErrNoAttempts))
+		})
+	})
+
+	When("the latencies are observed", func() {
+		It("follows the latency quantile", func() {
+			for i := 1; i <= recomputeEvery; i++ {
+				hedger.observe(time.Duration(i) * time.Millisecond)
+			}
+
+			Expect(hedger.Delay()).To(Equal(95 * time.Millisecond))
+		})
+	})
+
+	When("hedging is disabled", func() {
+		BeforeEach(func() {
+			conf.Quantile = 0
+		})
+
+		It("sends the read to the first replica only", func() {
+			Expect(hedger).To(BeNil())
+			Expect(hedger.Do(ctx, respond("first", 50*time.Millisecond, nil), respond("second", 0, nil))).To(Equal("first"))
+		})
+
+		It("returns no attempts error if there is no attempt", func() {
+			_, err := hedger.Do(ctx)
+			Expect(err).To(MatchError(ErrNoAttempts))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package hedgekit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHedgeKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Hedge Kit")
+}
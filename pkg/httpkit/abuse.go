@@ -0,0 +1,319 @@
+package httpkit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/ratekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidIP = errors.New("invalid IP")
+
+type AbuseConfig struct {
+	Limit          string        `long:"limit" env:"LIMIT" description:"the limit of the requests of each client IP, e.g. 600/1m, unlimited if empty"`
+	BanAfter       int64         `long:"ban_after" env:"BAN_AFTER" description:"ban the client IP once it sends the number of the requests over the limit in a window, never banned automatically if zero"`
+	BanDuration    time.Duration `long:"ban_duration" env:"BAN_DURATION" description:"how long the client IPs are banned automatically" default:"15m"`
+	TrustedProxies []string      `long:"trusted_proxies" env:"TRUSTED_PROXIES" env-delim:"," description:"the CIDRs of the proxies trusted to set X-Forwarded-For, e.g. 10.0.0.0/8, the header is ignored if empty"`
+}
+
+// abuseScript rejects the banned IP along with the remaining time of the ban, which is -1 if the ban is permanent,
+// or counts the request in the window of the IP, and bans the IP if it exceeds the limit by the ban after requests.
+// It returns {1, ban TTL} if the IP is banned, otherwise {0, count, window TTL}.
+var abuseScript = redis.NewScript(`
+local ban = redis.call("PTTL", KEYS[1])
+if ban ~= -2 then
+	return {1, ban}
+end
+local count = redis.call("INCR", KEYS[2])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[1])
+end
+local banAfter = tonumber(ARGV[3])
+if banAfter > 0 and count > tonumber(ARGV[2]) + banAfter then
+	redis.call("SET", KEYS[1], "exceeded the rate limit", "PX", ARGV[4])
+	return {1, tonumber(ARGV[4])}
+end
+return {0, count, redis.call("PTTL", KEYS[2])}
+`)
+
+// AbuseGuard protects the gateway from the abusive clients by their IPs: the requests of each IP exceeding the
+// limit are rejected with 429, and the IPs keeping on exceeding it are banned for a while and rejected with 403.
+// The counters and the bans are kept in Redis, so they hold across the instances of the gateway, and the bans are
+// managed by the admin endpoint. The client IP is taken from X-Forwarded-For only if the request comes through
+// the trusted proxies. The requests are admitted if Redis fails, like the rate limiter of the services.
+type AbuseGuard struct {
+	client         *rediskit.RedisClient
+	logger         *logkit.Logger
+	limit          *ratekit.Limit
+	banAfter       int64
+	banDuration    time.Duration
+	trustedProxies []*net.IPNet
+}
+
+func NewAbuseGuard(ctx context.Context, client *rediskit.RedisClient, conf *AbuseConfig) *AbuseGuard {
+	logger := logkit.FromContext(ctx)
+
+	g := &AbuseGuard{
+		client:      client,
+		logger:      logger,
+		banAfter:    conf.BanAfter,
+		banDuration: conf.BanDuration,
+	}
+
+	if conf.Limit != "" {
+		limit, err := ratekit.ParseLimit(conf.Limit)
+		if err != nil {
+			logger.Fatal("failed to parse abuse limit", zap.Error(err))
+		}
+		g.limit = &limit
+	}
+
+	for _, cidr := range conf.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			logger.Fatal("failed to parse trusted proxy", zap.String("cidr", cidr), zap.Error(err))
+		}
+		g.trustedProxies = append(g.trustedProxies, ipNet)
+	}
+
+	return g
+}
+
+func banKey(ip string) string {
+	return "abuse:ban:" + ip
+}
+
+func abuseCountKey(ip string) string {
+	return "abuse:count:" + ip
+}
+
+// Handler rejects the requests of the banned IPs and the IPs exceeding the limit, other requests are served by next.
+func (g *AbuseGuard) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ip := g.ClientIP(req)
+		if ip == nil {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		if status, retryAfter := g.check(req.Context(), ip.String()); status != http.StatusOK {
+			if retryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.FormatInt(int64((retryAfter+time.Second-1)/time.Second), 10))
+			}
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// check returns the status of the request of the IP along with how long the client should wait before retrying.
+func (g *AbuseGuard) check(ctx context.Context, ip string) (int, time.Duration) {
+	if g.limit == nil {
+		ttl, err := g.client.PTTL(ctx, banKey(ip)).Result()
+		if err != nil {
+			g.logger.Warn("failed to check ban, the request is admitted", zap.Error(err))
+			return http.StatusOK, 0
+		}
+
+		// the TTL is -2 if the key does not exist, and -1 if the key never expires
+		if ttl == -2 {
+			return http.StatusOK, 0
+		}
+
+		return http.StatusForbidden, ttlToRetryAfter(ttl)
+	}
+
+	result, err := abuseScript.Run(ctx, g.client, []string{banKey(ip), abuseCountKey(ip)},
+		g.limit.Period.Milliseconds(), g.limit.Count, g.banAfter, g.banDuration.Milliseconds()).Int64Slice()
+	if err != nil || len(result) < 2 {
+		g.logger.Warn("failed to count request, the request is admitted", zap.Error(err))
+		return http.StatusOK, 0
+	}
+
+	if result[0] == 1 {
+		return http.StatusForbidden, ttlToRetryAfter(time.Duration(result[1]) * time.Millisecond)
+	}
+
+	if len(result) == 3 && result[1] > g.limit.Count {
+		return http.StatusTooManyRequests, time.Duration(result[2]) * time.Millisecond
+	}
+
+	return http.StatusOK, 0
+}
+
+// ttlToRetryAfter returns the TTL of the ban as the time to retry, zero for the permanent bans.
+func ttlToRetryAfter(ttl time.Duration) time.Duration {
+	if ttl < 0 {
+		return 0
+	}
+
+	return ttl
+}
+
+// ClientIP returns the IP of the client of the request. If the request comes from a trusted proxy, the client
+// is the rightmost address of X-Forwarded-For not of a trusted proxy, since the client may set the header itself
+// and only the addresses appended by the trusted proxies are reliable. It returns nil if the address is invalid.
+func (g *AbuseGuard) ClientIP(req *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !g.trusted(ip) {
+		return ip
+	}
+
+	var forwarded []string
+	for _, value := range req.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(value, ",")...)
+	}
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		forwardedIP := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if forwardedIP == nil {
+			break
+		}
+
+		ip = forwardedIP
+		if !g.trusted(ip) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func (g *AbuseGuard) trusted(ip net.IP) bool {
+	for _, ipNet := range g.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ban is a banned IP.
+type Ban struct {
+	IP     string `json:"ip"`
+	Reason string `json:"reason"`
+	// ExpiresAt is nil if the ban is permanent
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// Ban bans the IP for the duration, or permanently if the duration is zero.
+func (g *AbuseGuard) Ban(ctx context.Context, ip, reason string, duration time.Duration) error {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidIP, ip)
+	}
+
+	return g.client.Set(ctx, banKey(parsed.String()), reason, duration).Err()
+}
+
+// Unban lifts the ban of the IP along with its count of the current window.
+func (g *AbuseGuard) Unban(ctx context.Context, ip string) error {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidIP, ip)
+	}
+
+	return g.client.Del(ctx, banKey(parsed.String()), abuseCountKey(parsed.String())).Err()
+}
+
+// Bans lists the banned IPs.
+func (g *AbuseGuard) Bans(ctx context.Context) ([]*Ban, error) {
+	bans := []*Ban{}
+
+	iter := g.client.Scan(ctx, 0, banKey("*"), 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+
+		pipe := g.client.Pipeline()
+		reasonCmd := pipe.Get(ctx, key)
+		ttlCmd := pipe.PTTL(ctx, key)
+		if _, err := pipe.Exec(ctx); errors.Is(err, redis.Nil) {
+			// the ban expired after it was scanned
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		ban := &Ban{
+			IP:     strings.TrimPrefix(key, banKey("")),
+			Reason: reasonCmd.Val(),
+		}
+		if ttl := ttlCmd.Val(); ttl > 0 {
+			expiresAt := time.Now().Add(ttl)
+			ban.ExpiresAt = &expiresAt
+		}
+		bans = append(bans, ban)
+	}
+
+	return bans, iter.Err()
+}
+
+// ServeHTTP serves the admin endpoint of the bans: GET lists the banned IPs, PUT bans the IP of the ip parameter
+// for the duration parameter, permanently if absent, with the reason parameter, and DELETE lifts the ban, e.g.
+//
+//	curl -X PUT -H "Authorization: Bearer $TOKEN" "localhost:6060/abuse/bans?ip=203.0.113.7&duration=1h&reason=scraping"
+func (g *AbuseGuard) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	query := req.URL.Query()
+
+	var err error
+	switch req.Method {
+	case http.MethodGet:
+		var bans []*Ban
+		if bans, err = g.Bans(ctx); err == nil {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(bans)
+			return
+		}
+	case http.MethodPut:
+		var duration time.Duration
+		if s := query.Get("duration"); s != "" {
+			if duration, err = time.ParseDuration(s); err != nil || duration < 0 {
+				http.Error(w, "invalid duration", http.StatusBadRequest)
+				return
+			}
+		}
+
+		reason := query.Get("reason")
+		if reason == "" {
+			reason = "banned by admin"
+		}
+
+		err = g.Ban(ctx, query.Get("ip"), reason, duration)
+	case http.MethodDelete:
+		err = g.Unban(ctx, query.Get("ip"))
+	default:
+		w.Header().Set("Allow", "GET, PUT, DELETE")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch {
+	case errors.Is(err, ErrInvalidIP):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case err != nil:
+		g.logger.Error("failed to manage bans", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
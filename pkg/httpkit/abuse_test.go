@@ -0,0 +1,142 @@
+package httpkit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AbuseGuard", func() {
+	const clientIP = "203.0.113.7"
+
+	var (
+		ctx         context.Context
+		redisClient *rediskit.RedisClient
+		conf        *AbuseConfig
+		guard       *AbuseGuard
+		handler     http.Handler
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		redisConf := &rediskit.RedisConfig{Addr: "localhost:6379"}
+		if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+			redisConf.Addr = addr
+		}
+		redisClient = rediskit.NewRedisClient(ctx, redisConf)
+
+		conf = &AbuseConfig{
+			Limit:          "2/1m",
+			BanAfter:       2,
+			BanDuration:    time.Minute,
+			TrustedProxies: []string{"10.0.0.0/8"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		guard = NewAbuseGuard(ctx, redisClient, conf)
+		Expect(guard.Unban(ctx, clientIP)).To(Succeed())
+
+		handler = guard.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	AfterEach(func() {
+		Expect(guard.Unban(ctx, clientIP)).To(Succeed())
+		Expect(redisClient.Close()).To(Succeed())
+	})
+
+	do := func(remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/comments", nil)
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	Describe("ClientIP", func() {
+		clientIPOf := func(remoteAddr, forwardedFor string) string {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = remoteAddr
+			if forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", forwardedFor)
+			}
+
+			return guard.ClientIP(req).String()
+		}
+
+		It("ignores X-Forwarded-For from the untrusted peers", func() {
+			Expect(clientIPOf(clientIP+":1234", "198.51.100.1")).To(Equal(clientIP))
+		})
+
+		It("takes the rightmost untrusted address from the trusted proxies", func() {
+			Expect(clientIPOf("10.0.0.1:1234", "198.51.100.1, "+clientIP+", 10.0.0.2")).To(Equal(clientIP))
+		})
+
+		It("takes the leftmost address if all the addresses are trusted", func() {
+			Expect(clientIPOf("10.0.0.1:1234", "10.0.0.3, 10.0.0.2")).To(Equal("10.0.0.3"))
+		})
+	})
+
+	It("limits the requests and bans the client IP exceeding the limit", func() {
+		Expect(do(clientIP+":1234", "").Code).To(Equal(http.StatusOK))
+		Expect(do("10.0.0.1:1234", clientIP).Code).To(Equal(http.StatusOK))
+
+		rec := do(clientIP+":1234", "")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rec.Header().Get("Retry-After")).NotTo(BeEmpty())
+		Expect(do(clientIP+":1234", "").Code).To(Equal(http.StatusTooManyRequests))
+
+		Expect(do(clientIP+":1234", "").Code).To(Equal(http.StatusForbidden))
+		Expect(guard.Bans(ctx)).To(ContainElement(HaveField("IP", clientIP)))
+
+		// other clients are not affected
+		Expect(do("198.51.100.1:1234", "").Code).To(Equal(http.StatusOK))
+	})
+
+	Describe("ServeHTTP", func() {
+		BeforeEach(func() {
+			conf.Limit = ""
+		})
+
+		admin := func(method, target string) *httptest.ResponseRecorder {
+			rec := httptest.NewRecorder()
+			guard.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+			return rec
+		}
+
+		It("bans, lists and unbans the IPs", func() {
+			Expect(admin(http.MethodPut, "/abuse/bans?ip="+clientIP+"&reason=scraping").Code).To(Equal(http.StatusNoContent))
+			Expect(do(clientIP+":1234", "").Code).To(Equal(http.StatusForbidden))
+
+			rec := admin(http.MethodGet, "/abuse/bans")
+			Expect(rec.Code).To(Equal(http.StatusOK))
+
+			var bans []*Ban
+			Expect(json.Unmarshal(rec.Body.Bytes(), &bans)).To(Succeed())
+			Expect(bans).To(ContainElement(&Ban{IP: clientIP, Reason: "scraping"}))
+
+			Expect(admin(http.MethodDelete, "/abuse/bans?ip="+clientIP).Code).To(Equal(http.StatusNoContent))
+			Expect(do(clientIP+":1234", "").Code).To(Equal(http.StatusOK))
+		})
+
+		It("rejects the invalid IPs", func() {
+			Expect(admin(http.MethodPut, "/abuse/bans?ip=invalid").Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
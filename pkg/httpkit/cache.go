@@ -0,0 +1,255 @@
+package httpkit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/go-redis/cache/v8"
+)
+
+type CacheConfig struct {
+	TTL  time.Duration `long:"ttl" env:"TTL" default:"5s" description:"the TTL of the cached responses, the cache is disabled if zero"`
+	Size int           `long:"size" env:"SIZE" default:"1024" description:"the maximum number of the cached responses"`
+}
+
+// CacheTagFunc returns the invalidation tag of a cacheable request, or false if the request is not cacheable.
+type CacheTagFunc func(req *http.Request) (string, bool)
+
+// Cache caches the successful responses of the cacheable GET requests per tenant for a short TTL,
+// and serves them with ETags so that polling clients get 304 Not Modified until the response changes.
+type Cache struct {
+	size    int
+	tagFunc CacheTagFunc
+	// store is the *cacheStore replaced by SetTTL
+	store atomic.Value
+
+	mu sync.Mutex
+	// invalidatedAt is the last invalidation time of each tenant and tag,
+	// the responses stored before it are stale.
+	invalidatedAt map[string]time.Time
+}
+
+// cacheStore is the local cache of the responses along with its TTL.
+type cacheStore struct {
+	ttl   time.Duration
+	local *cache.TinyLFU
+}
+
+type cacheEntry struct {
+	Status   int
+	Header   http.Header
+	Body     []byte
+	ETag     string
+	StoredAt time.Time
+}
+
+func NewCache(conf *CacheConfig, tagFunc CacheTagFunc) *Cache {
+	size := conf.Size
+	if size <= 0 {
+		size = 1
+	}
+
+	c := &Cache{
+		size:          size,
+		tagFunc:       tagFunc,
+		invalidatedAt: make(map[string]time.Time),
+	}
+	c.SetTTL(conf.TTL)
+
+	return c
+}
+
+// SetTTL changes the TTL of the cached responses at runtime, the cache is disabled if zero.
+// The cached responses are dropped if the TTL changes, since they expire by the TTL they are stored with.
+func (c *Cache) SetTTL(ttl time.Duration) {
+	if store, ok := c.store.Load().(*cacheStore); ok && store.ttl == ttl {
+		return
+	}
+
+	c.store.Store(&cacheStore{
+		ttl:   ttl,
+		local: cache.NewTinyLFU(c.size, ttl),
+	})
+}
+
+func (c *Cache) loadStore() *cacheStore {
+	return c.store.Load().(*cacheStore)
+}
+
+// Handler serves the cacheable requests from the cache, other requests are served by next.
+func (c *Cache) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		store := c.loadStore()
+		if req.Method != http.MethodGet || store.ttl <= 0 {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		tag, ok := c.tagFunc(req)
+		if !ok {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		tenantID := req.Header.Get(tenantkit.HTTPHeader)
+		if tenantID == "" {
+			tenantID = tenantkit.DefaultTenantID
+		}
+
+		key := tenantID + " " + req.URL.RequestURI()
+		if entry, ok := c.get(store, key, tenantID, tag); ok {
+			c.serve(w, req, store, entry)
+			return
+		}
+
+		// the start time is stored, so that an invalidation during the request makes the response stale
+		startedAt := time.Now()
+
+		rec := newResponseRecorder()
+		next.ServeHTTP(rec, req)
+
+		entry := &cacheEntry{
+			Status:   rec.status,
+			Header:   rec.header,
+			Body:     rec.body.Bytes(),
+			ETag:     etag(rec.body.Bytes()),
+			StoredAt: startedAt,
+		}
+
+		if entry.Status == http.StatusOK {
+			c.set(store, key, entry)
+		}
+
+		c.serve(w, req, store, entry)
+	})
+}
+
+// Invalidate makes the cached responses of the tenant with the tag stale.
+func (c *Cache) Invalidate(tenantID, tag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	c.invalidatedAt[invalidationKey(tenantID, tag)] = now
+
+	// the invalidations older than the TTL are useless since the responses before them have expired
+	if len(c.invalidatedAt) > c.size {
+		ttl := c.loadStore().ttl
+		for key, invalidatedAt := range c.invalidatedAt {
+			if now.Sub(invalidatedAt) > 2*ttl {
+				delete(c.invalidatedAt, key)
+			}
+		}
+	}
+}
+
+func (c *Cache) get(store *cacheStore, key, tenantID, tag string) (*cacheEntry, bool) {
+	data, ok := store.local.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	invalidatedAt, ok := c.invalidatedAt[invalidationKey(tenantID, tag)]
+	c.mu.Unlock()
+
+	if ok && !entry.StoredAt.After(invalidatedAt) {
+		store.local.Del(key)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (c *Cache) set(store *cacheStore, key string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	store.local.Set(key, data)
+}
+
+func (c *Cache) serve(w http.ResponseWriter, req *http.Request, store *cacheStore, entry *cacheEntry) {
+	header := w.Header()
+	for key, values := range entry.Header {
+		header[key] = values
+	}
+
+	if entry.Status != http.StatusOK {
+		w.WriteHeader(entry.Status)
+		_, _ = w.Write(entry.Body)
+		return
+	}
+
+	header.Set("ETag", entry.ETag)
+	header.Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(store.ttl.Seconds())))
+
+	if etagMatch(req.Header.Get("If-None-Match"), entry.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.WriteHeader(entry.Status)
+	_, _ = w.Write(entry.Body)
+}
+
+func invalidationKey(tenantID, tag string) string {
+	return tenantID + " " + tag
+}
+
+func etag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatch reports whether the If-None-Match header matches the ETag.
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+type responseRecorder struct {
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		status: http.StatusOK,
+		header: make(http.Header),
+	}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
@@ -0,0 +1,165 @@
+package httpkit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		conf    *CacheConfig
+		c       *Cache
+		handler http.Handler
+
+		calls  int
+		status int
+		body   string
+	)
+
+	BeforeEach(func() {
+		conf = &CacheConfig{TTL: time.Minute, Size: 16}
+		calls = 0
+		status = http.StatusOK
+		body = "body"
+	})
+
+	JustBeforeEach(func() {
+		c = NewCache(conf, func(req *http.Request) (string, bool) {
+			if !strings.HasPrefix(req.URL.Path, "/v1/items/") {
+				return "", false
+			}
+
+			return "item:" + strings.TrimPrefix(req.URL.Path, "/v1/items/"), true
+		})
+
+		handler = c.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+	})
+
+	do := func(method, target string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	When("request is cacheable", func() {
+		It("serves the cached response with ETag", func() {
+			first := do(http.MethodGet, "/v1/items/1", nil)
+			Expect(first.Code).To(Equal(http.StatusOK))
+			Expect(first.Body.String()).To(Equal("body"))
+			Expect(first.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(first.Header().Get("ETag")).NotTo(BeEmpty())
+			Expect(first.Header().Get("Cache-Control")).To(Equal("private, max-age=60"))
+
+			second := do(http.MethodGet, "/v1/items/1", nil)
+			Expect(second.Code).To(Equal(http.StatusOK))
+			Expect(second.Body.String()).To(Equal("body"))
+			Expect(second.Header().Get("ETag")).To(Equal(first.Header().Get("ETag")))
+
+			Expect(calls).To(Equal(1))
+		})
+
+		It("responds not modified if ETag matches", func() {
+			etag := do(http.MethodGet, "/v1/items/1", nil).Header().Get("ETag")
+
+			rec := do(http.MethodGet, "/v1/items/1", http.Header{"If-None-Match": {`"other", ` + etag}})
+			Expect(rec.Code).To(Equal(http.StatusNotModified))
+			Expect(rec.Body.String()).To(BeEmpty())
+		})
+
+		It("caches the responses of the tenants separately", func() {
+			do(http.MethodGet, "/v1/items/1", nil)
+			do(http.MethodGet, "/v1/items/1", http.Header{tenantkit.HTTPHeader: {"another-tenant"}})
+
+			Expect(calls).To(Equal(2))
+		})
+
+		It("refreshes the response after invalidation", func() {
+			etag := do(http.MethodGet, "/v1/items/1", nil).Header().Get("ETag")
+
+			body = "new body"
+			c.Invalidate(tenantkit.DefaultTenantID, "item:1")
+
+			rec := do(http.MethodGet, "/v1/items/1", http.Header{"If-None-Match": {etag}})
+			Expect(rec.Code).To(Equal(http.StatusOK))
+			Expect(rec.Body.String()).To(Equal("new body"))
+			Expect(rec.Header().Get("ETag")).NotTo(Equal(etag))
+			Expect(calls).To(Equal(2))
+		})
+
+		It("keeps the responses of other tags and tenants after invalidation", func() {
+			do(http.MethodGet, "/v1/items/1", nil)
+
+			c.Invalidate(tenantkit.DefaultTenantID, "item:2")
+			c.Invalidate("another-tenant", "item:1")
+
+			do(http.MethodGet, "/v1/items/1", nil)
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("response is not successful", func() {
+		BeforeEach(func() {
+			status = http.StatusNotFound
+		})
+
+		It("does not cache the response", func() {
+			Expect(do(http.MethodGet, "/v1/items/1", nil).Code).To(Equal(http.StatusNotFound))
+			Expect(do(http.MethodGet, "/v1/items/1", nil).Code).To(Equal(http.StatusNotFound))
+			Expect(calls).To(Equal(2))
+		})
+	})
+
+	When("request is not cacheable", func() {
+		It("does not cache the response", func() {
+			do(http.MethodGet, "/v1/others/1", nil)
+			do(http.MethodGet, "/v1/others/1", nil)
+			do(http.MethodPost, "/v1/items/1", nil)
+			do(http.MethodPost, "/v1/items/1", nil)
+
+			Expect(calls).To(Equal(4))
+		})
+	})
+
+	When("TTL is changed", func() {
+		It("serves the responses with the new TTL", func() {
+			do(http.MethodGet, "/v1/items/1", nil)
+
+			c.SetTTL(30 * time.Second)
+
+			rec := do(http.MethodGet, "/v1/items/1", nil)
+			Expect(rec.Header().Get("Cache-Control")).To(Equal("private, max-age=30"))
+			Expect(calls).To(Equal(2))
+		})
+	})
+
+	When("cache is disabled", func() {
+		BeforeEach(func() {
+			conf.TTL = 0
+		})
+
+		It("does not cache the response", func() {
+			rec := do(http.MethodGet, "/v1/items/1", nil)
+			Expect(rec.Header().Get("ETag")).To(BeEmpty())
+
+			do(http.MethodGet, "/v1/items/1", nil)
+			Expect(calls).To(Equal(2))
+		})
+	})
+})
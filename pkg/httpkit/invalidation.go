@@ -0,0 +1,68 @@
+package httpkit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// CacheInvalidationChannel is the Redis Pub/Sub channel of the cache invalidations of the gateways
+const CacheInvalidationChannel = "httpcache:invalidation"
+
+type cacheInvalidation struct {
+	TenantID string `json:"tenant_id"`
+	Tag      string `json:"tag"`
+}
+
+// PublishCacheInvalidation invalidates the cached responses with the tag in the tenant of the context on every gateway.
+func PublishCacheInvalidation(ctx context.Context, client *rediskit.RedisClient, tag string) error {
+	payload, err := json.Marshal(&cacheInvalidation{
+		TenantID: tenantkit.FromContext(ctx),
+		Tag:      tag,
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.Publish(ctx, CacheInvalidationChannel, payload).Err()
+}
+
+// SubscribeInvalidation invalidates the cache by the published invalidations until the context is done.
+func (c *Cache) SubscribeInvalidation(ctx context.Context, client *rediskit.RedisClient) error {
+	logger := logkit.FromContext(ctx)
+
+	sub := client.Subscribe(ctx, CacheInvalidationChannel)
+	defer func() {
+		if err := sub.Close(); err != nil {
+			logger.Error("failed to close cache invalidation subscription", zap.Error(err))
+		}
+	}()
+
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	msgs := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-msgs:
+			if !ok {
+				return nil
+			}
+
+			var invalidation cacheInvalidation
+			if err := json.Unmarshal([]byte(msg.Payload), &invalidation); err != nil {
+				logger.Error("failed to unmarshal cache invalidation", zap.Error(err))
+				continue
+			}
+
+			c.Invalidate(invalidation.TenantID, invalidation.Tag)
+		}
+	}
+}
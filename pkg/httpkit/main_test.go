@@ -0,0 +1,13 @@
+package httpkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHTTPKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test HTTP Kit")
+}
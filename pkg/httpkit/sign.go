@@ -0,0 +1,159 @@
+package httpkit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+// the query parameters of the signed URLs
+const (
+	signExpiresParam = "exp"
+	signKeyIDParam   = "kid"
+	signParam        = "sig"
+)
+
+var (
+	ErrInvalidSigningKey = errors.New("invalid signing key")
+	ErrInvalidSignature  = errors.New("invalid signature")
+	ErrExpiredSignature  = errors.New("expired signature")
+)
+
+type SignedURLConfig struct {
+	Keys    []string      `long:"keys" env:"KEYS" env-delim:"," description:"the HMAC keys of the signed URLs in the format of id=secret, the first key signs and every key verifies, the URLs are not signed if empty"`
+	Expiry  time.Duration `long:"expiry" env:"EXPIRY" description:"how long the signed URLs are valid" default:"1h"`
+	BaseURL string        `long:"base_url" env:"BASE_URL" description:"the public URL of the gateway serving the signed URLs, e.g. https://video.example.com"`
+}
+
+type signingKey struct {
+	id     string
+	secret []byte
+}
+
+// URLSigner signs the URLs with HMAC-SHA256 along with the expiry, so the URLs are served only until they expire
+// and cannot be altered to reach other objects. The signature covers the path and the query parameters, and names
+// the key signing it, so the keys are rotated without breaking the URLs handed out: add the new key first, which
+// signs from then on while the old one still verifies, and remove the old key after the expiry.
+type URLSigner struct {
+	keys    []signingKey
+	expiry  time.Duration
+	baseURL string
+}
+
+// NewURLSigner returns the signer of the keys, it returns nil if there is no key, which leaves the URLs unsigned.
+func NewURLSigner(ctx context.Context, conf *SignedURLConfig) *URLSigner {
+	if len(conf.Keys) == 0 {
+		return nil
+	}
+
+	logger := logkit.FromContext(ctx)
+
+	s := &URLSigner{
+		expiry:  conf.Expiry,
+		baseURL: strings.TrimSuffix(conf.BaseURL, "/"),
+	}
+
+	for _, key := range conf.Keys {
+		i := strings.Index(key, "=")
+		if i <= 0 || i == len(key)-1 {
+			logger.Fatal("failed to parse signing key", zap.Error(ErrInvalidSigningKey))
+		}
+
+		s.keys = append(s.keys, signingKey{id: key[:i], secret: []byte(key[i+1:])})
+	}
+
+	return s
+}
+
+// BaseURL returns the public URL of the gateway serving the signed URLs.
+func (s *URLSigner) BaseURL() string {
+	return s.baseURL
+}
+
+// Sign returns the URL signed by the first key, which expires after the expiry.
+func (s *URLSigner) Sign(rawURL string) (string, error) {
+	return s.sign(rawURL, time.Now().Add(s.expiry))
+}
+
+func (s *URLSigner) sign(rawURL string, expiresAt time.Time) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	key := s.keys[0]
+
+	query := u.Query()
+	query.Del(signParam)
+	query.Set(signExpiresParam, strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set(signKeyIDParam, key.id)
+	query.Set(signParam, signature(key.secret, u.EscapedPath(), query))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Verify verifies the signature and the expiry of the URL.
+func (s *URLSigner) Verify(u *url.URL) error {
+	query := u.Query()
+
+	sig := query.Get(signParam)
+	query.Del(signParam)
+
+	var key *signingKey
+	for i := range s.keys {
+		if s.keys[i].id == query.Get(signKeyIDParam) {
+			key = &s.keys[i]
+			break
+		}
+	}
+	if key == nil || sig == "" {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(signature(key.secret, u.EscapedPath(), query))) {
+		return ErrInvalidSignature
+	}
+
+	expiresAt, err := strconv.ParseInt(query.Get(signExpiresParam), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if time.Now().Unix() > expiresAt {
+		return fmt.Errorf("%w: expired at %s", ErrExpiredSignature, time.Unix(expiresAt, 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Handler rejects the requests of the URLs not signed or expired with 403, other requests are served by next.
+func (s *URLSigner) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if err := s.Verify(req.URL); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// signature returns the HMAC of the path and the query parameters, the parameters are encoded in the order of
+// the keys, so the signature does not depend on the order the parameters are sent in.
+func signature(secret []byte, path string, query url.Values) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(path + "?" + query.Encode()))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,101 @@
+package httpkit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("URLSigner", func() {
+	const rawURL = "https://video.example.com/v1/media/video.mp4"
+
+	var (
+		ctx    context.Context
+		signer *URLSigner
+	)
+
+	newSigner := func(keys ...string) *URLSigner {
+		return NewURLSigner(ctx, &SignedURLConfig{Keys: keys, Expiry: time.Hour})
+	}
+
+	verify := func(s *URLSigner, signed string) error {
+		u, err := url.Parse(signed)
+		Expect(err).NotTo(HaveOccurred())
+
+		return s.Verify(u)
+	}
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		signer = newSigner("key-1=secret-1")
+	})
+
+	It("is disabled without keys", func() {
+		Expect(newSigner()).To(BeNil())
+	})
+
+	It("verifies the signed URLs", func() {
+		signed, err := signer.Sign(rawURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signed).To(HavePrefix(rawURL + "?"))
+
+		Expect(verify(signer, signed)).To(Succeed())
+	})
+
+	It("rejects the altered URLs", func() {
+		signed, err := signer.Sign(rawURL)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(verify(signer, strings.Replace(signed, "video.mp4", "other.mp4", 1))).To(MatchError(ErrInvalidSignature))
+		Expect(verify(signer, signed+"&quality=1080p")).To(MatchError(ErrInvalidSignature))
+		Expect(verify(signer, rawURL)).To(MatchError(ErrInvalidSignature))
+		Expect(verify(newSigner("key-1=secret-2"), signed)).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("rejects the expired URLs", func() {
+		signed, err := signer.sign(rawURL, time.Now().Add(-time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(verify(signer, signed)).To(MatchError(ErrExpiredSignature))
+	})
+
+	It("verifies the URLs signed by the old keys during the rotation", func() {
+		signed, err := signer.Sign(rawURL)
+		Expect(err).NotTo(HaveOccurred())
+
+		rotated := newSigner("key-2=secret-2", "key-1=secret-1")
+		Expect(verify(rotated, signed)).To(Succeed())
+
+		signed, err = rotated.Sign(rawURL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signed).To(ContainSubstring("kid=key-2"))
+
+		// the URLs signed by the removed keys are rejected
+		Expect(verify(newSigner("key-2=secret-2"), signed)).To(Succeed())
+		Expect(verify(signer, signed)).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("rejects the requests of the URLs not signed", func() {
+		handler := signer.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		signed, err := signer.Sign(rawURL)
+		Expect(err).NotTo(HaveOccurred())
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, signed, nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, rawURL, nil))
+		Expect(rec.Code).To(Equal(http.StatusForbidden))
+	})
+})
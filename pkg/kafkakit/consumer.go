@@ -2,6 +2,7 @@ package kafkakit
 
 import (
 	"context"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/Shopify/sarama"
@@ -9,9 +10,11 @@ import (
 )
 
 type KafkaConsumerConfig struct {
-	Addrs []string `long:"addrs" env:"ADDRS" env-delim:"," description:"the addresses of Kafka servers" required:"true"`
-	Topic string   `long:"topic" env:"TOPIC" description:"the topic for the Kafka consumer group to consume" required:"true"`
-	Group string   `long:"group" env:"GROUP" description:"the ID of the Kafka consumer group" required:"true"`
+	Addrs []string `long:"addrs" env:"ADDRS" env-delim:"," description:"the addresses of Kafka servers"`
+	Topic string   `long:"topic" env:"TOPIC" description:"the topic for the Kafka consumer group to consume"`
+	Group string   `long:"group" env:"GROUP" description:"the ID of the Kafka consumer group"`
+
+	LagInterval time.Duration `long:"lag_interval" env:"LAG_INTERVAL" description:"the interval to poll the lag of the consumer group, the lag is not monitored if zero" default:"15s"`
 }
 
 type KafkaConsumer struct {
@@ -39,6 +42,10 @@ func NewKafkaConsumer(ctx context.Context, conf *KafkaConsumerConfig) *KafkaCons
 		zap.String("group", conf.Group),
 	)
 
+	if len(conf.Addrs) == 0 || conf.Topic == "" || conf.Group == "" {
+		logger.Fatal("the addresses, topic and group of the Kafka consumer are required")
+	}
+
 	config := sarama.NewConfig()
 
 	cg, err := sarama.NewConsumerGroup(conf.Addrs, conf.Group, config)
@@ -0,0 +1,222 @@
+package kafkakit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.uber.org/zap"
+)
+
+// PartitionLag is the lag of the consumer group in a partition.
+type PartitionLag struct {
+	Partition int32 `json:"partition"`
+	// Committed is the checkpoint of the group, -1 if the group has not committed any
+	Committed int64 `json:"committed"`
+	Newest    int64 `json:"newest"`
+	Lag       int64 `json:"lag"`
+}
+
+// ConsumerLag is the number of the messages not consumed by the consumer group yet.
+type ConsumerLag struct {
+	Group      string         `json:"group"`
+	Topic      string         `json:"topic"`
+	Total      int64          `json:"total"`
+	Partitions []PartitionLag `json:"partitions"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+// GetConsumerLag returns the lag of the consumer group in each partition of the topic, which is the newest offset
+// minus the checkpoint of the group. The partitions without checkpoints lag from the oldest offset.
+func GetConsumerLag(client sarama.Client, admin sarama.ClusterAdmin, group, topic string) (*ConsumerLag, error) {
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := admin.ListConsumerGroupOffsets(group, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, err
+	}
+
+	lag := &ConsumerLag{
+		Group:      group,
+		Topic:      topic,
+		Partitions: make([]PartitionLag, 0, len(partitions)),
+		UpdatedAt:  time.Now(),
+	}
+
+	for _, partition := range partitions {
+		newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, err
+		}
+
+		committed := int64(-1)
+		if block := resp.GetBlock(topic, partition); block != nil && block.Err == sarama.ErrNoError {
+			committed = block.Offset
+		}
+
+		from := committed
+		if from < 0 {
+			if from, err = client.GetOffset(topic, partition, sarama.OffsetOldest); err != nil {
+				return nil, err
+			}
+		}
+
+		partitionLag := newest - from
+		if partitionLag < 0 {
+			partitionLag = 0
+		}
+
+		lag.Partitions = append(lag.Partitions, PartitionLag{
+			Partition: partition,
+			Committed: committed,
+			Newest:    newest,
+			Lag:       partitionLag,
+		})
+		lag.Total += partitionLag
+	}
+
+	sort.Slice(lag.Partitions, func(i, j int) bool { return lag.Partitions[i].Partition < lag.Partitions[j].Partition })
+
+	return lag, nil
+}
+
+// LagMonitor polls the lag of the consumer group, and exports it as the kafka_consumer_lag gauge by partition,
+// so the autoscalers scale the consumers by the lag, e.g. an HPA external metric through the Prometheus adapter.
+// It also serves the current lag as JSON, for the autoscalers polling an HTTP endpoint instead.
+type LagMonitor struct {
+	logger *logkit.Logger
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+	group  string
+	topic  string
+
+	mu  sync.Mutex
+	lag *ConsumerLag
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewLagMonitor returns the lag monitor of the consumer group, or nil if it is disabled.
+func NewLagMonitor(ctx context.Context, conf *KafkaConsumerConfig, meter metric.Meter) *LagMonitor {
+	if conf.LagInterval <= 0 {
+		return nil
+	}
+
+	logger := logkit.FromContext(ctx).With(
+		zap.String("topic", conf.Topic),
+		zap.String("group", conf.Group),
+	)
+
+	client, err := sarama.NewClient(conf.Addrs, sarama.NewConfig())
+	if err != nil {
+		logger.Fatal("failed to create Kafka client", zap.Error(err))
+	}
+
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		logger.Fatal("failed to create Kafka cluster admin", zap.Error(err))
+	}
+
+	m := &LagMonitor{
+		logger: logger,
+		client: client,
+		admin:  admin,
+		group:  conf.Group,
+		topic:  conf.Topic,
+		done:   make(chan struct{}),
+	}
+
+	gauge, err := meter.AsyncInt64().Gauge("kafka_consumer_lag", instrument.WithDescription("measure number of messages not consumed by the consumer group"))
+	if err != nil {
+		logger.Fatal("failed to create consumer lag gauge", zap.Error(err))
+	}
+
+	if err := meter.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
+		lag := m.Lag()
+		if lag == nil {
+			return
+		}
+
+		for _, p := range lag.Partitions {
+			gauge.Observe(ctx, p.Lag,
+				attribute.String("group", lag.Group),
+				attribute.String("topic", lag.Topic),
+				attribute.String("partition", strconv.Itoa(int(p.Partition))),
+			)
+		}
+	}); err != nil {
+		logger.Fatal("failed to register consumer lag callback", zap.Error(err))
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	go m.poll(ctx, conf.LagInterval)
+
+	logger.Info("create consumer lag monitor successfully", zap.Duration("interval", conf.LagInterval))
+
+	return m
+}
+
+// Lag returns the lag of the last poll, or nil if none succeeded yet.
+func (m *LagMonitor) Lag() *ConsumerLag {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lag
+}
+
+// ServeHTTP responds the lag of the last poll as JSON, or 503 if none succeeded yet.
+func (m *LagMonitor) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	lag := m.Lag()
+	if lag == nil {
+		http.Error(w, "consumer lag is not polled yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(lag)
+}
+
+// Close stops polling the lag.
+func (m *LagMonitor) Close() error {
+	m.cancel()
+	<-m.done
+
+	return m.admin.Close()
+}
+
+func (m *LagMonitor) poll(ctx context.Context, interval time.Duration) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		lag, err := GetConsumerLag(m.client, m.admin, m.group, m.topic)
+		if err != nil {
+			m.logger.Warn("failed to get consumer lag", zap.Error(err))
+		} else {
+			m.mu.Lock()
+			m.lag = lag
+			m.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
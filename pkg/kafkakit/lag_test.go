@@ -0,0 +1,56 @@
+package kafkakit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LagMonitor", func() {
+	var (
+		monitor *LagMonitor
+		resp    *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		monitor = &LagMonitor{}
+	})
+
+	JustBeforeEach(func() {
+		resp = httptest.NewRecorder()
+		monitor.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/consumer_lag", http.NoBody))
+	})
+
+	When("the lag is not polled yet", func() {
+		It("responds service unavailable", func() {
+			Expect(resp.Code).To(Equal(http.StatusServiceUnavailable))
+		})
+	})
+
+	When("the lag is polled", func() {
+		BeforeEach(func() {
+			monitor.lag = &ConsumerLag{
+				Group: "video-stream",
+				Topic: "video",
+				Total: 7,
+				Partitions: []PartitionLag{
+					{Partition: 0, Committed: 10, Newest: 15, Lag: 5},
+					{Partition: 1, Committed: -1, Newest: 2, Lag: 2},
+				},
+			}
+		})
+
+		It("responds the lag as JSON", func() {
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var lag ConsumerLag
+			Expect(json.Unmarshal(resp.Body.Bytes(), &lag)).To(Succeed())
+			Expect(lag.Total).To(Equal(int64(7)))
+			Expect(lag.Partitions).To(Equal(monitor.lag.Partitions))
+		})
+	})
+})
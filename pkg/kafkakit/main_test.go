@@ -0,0 +1,13 @@
+package kafkakit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKafkaKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Kafka Kit")
+}
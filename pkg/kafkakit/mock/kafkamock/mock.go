@@ -5,6 +5,7 @@
 package kafkamock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	kafkakit "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
@@ -35,15 +36,15 @@ func (m *MockProducer) EXPECT() *MockProducerMockRecorder {
 }
 
 // SendMessages mocks base method.
-func (m *MockProducer) SendMessages(arg0 []*kafkakit.ProducerMessage) error {
+func (m *MockProducer) SendMessages(arg0 context.Context, arg1 []*kafkakit.ProducerMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessages", arg0)
+	ret := m.ctrl.Call(m, "SendMessages", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendMessages indicates an expected call of SendMessages.
-func (mr *MockProducerMockRecorder) SendMessages(arg0 interface{}) *gomock.Call {
+func (mr *MockProducerMockRecorder) SendMessages(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessages", reflect.TypeOf((*MockProducer)(nil).SendMessages), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessages", reflect.TypeOf((*MockProducer)(nil).SendMessages), arg0, arg1)
 }
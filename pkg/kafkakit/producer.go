@@ -2,46 +2,71 @@ package kafkakit
 
 import (
 	"context"
+	"sort"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
 	"github.com/Shopify/sarama"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 type Producer interface {
-	SendMessages(msgs []*ProducerMessage) error
+	// SendMessages sends the messages along with the trace context of the context.
+	SendMessages(ctx context.Context, msgs []*ProducerMessage) error
 }
 
 type ProducerMessage struct {
-	Key   []byte
-	Value []byte
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
 }
 
 type KafkaProducerConfig struct {
-	Addrs        []string `long:"addrs" env:"ADDRS" env-delim:"," description:"the addresses of Kafka servers" required:"true"`
-	Topic        string   `long:"topic" env:"TOPIC" description:"the topic for the Kafka producer to send" required:"true"`
+	Addrs        []string `long:"addrs" env:"ADDRS" env-delim:"," description:"the addresses of Kafka servers"`
+	Topic        string   `long:"topic" env:"TOPIC" description:"the topic for the Kafka producer to send"`
 	RequiredAcks int16    `long:"required_acks" env:"REQUIRED_ACKS" description:"number of replica acks the producer must receive before responding, available values are 0, 1 and -1" default:"-1"`
+
+	Breaker breakerkit.BreakerConfig `group:"breaker" namespace:"breaker" env-namespace:"BREAKER"`
 }
 
 type KafkaProducer struct {
 	sarama.SyncProducer
 
-	topic string
+	topic   string
+	breaker *breakerkit.Breaker
 }
 
 var _ Producer = (*KafkaProducer)(nil)
 
-func (kp *KafkaProducer) SendMessages(msgs []*ProducerMessage) error {
+func (kp *KafkaProducer) SendMessages(ctx context.Context, msgs []*ProducerMessage) error {
+	ctx, span := otelkit.Tracer().Start(ctx, kp.topic+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("kafka"),
+			semconv.MessagingDestinationKey.String(kp.topic),
+			semconv.MessagingDestinationKindTopic,
+		),
+	)
+
 	smsgs := make([]*sarama.ProducerMessage, 0, len(msgs))
 	for _, msg := range msgs {
-		smsgs = append(smsgs, &sarama.ProducerMessage{
-			Topic: kp.topic,
-			Key:   sarama.ByteEncoder(msg.Key),
-			Value: sarama.ByteEncoder(msg.Value),
-		})
+		traced := *msg
+		traced.Headers = TraceHeaders(ctx, msg.Headers)
+
+		smsgs = append(smsgs, newSaramaProducerMessage(kp.topic, &traced))
 	}
 
-	return kp.SyncProducer.SendMessages(smsgs)
+	// the messages are rejected while Kafka is failing, rather than blocking the callers until the retries are exhausted
+	err := kp.breaker.Do(func() error {
+		return kp.SyncProducer.SendMessages(smsgs)
+	})
+
+	otelkit.EndSpan(span, err)
+
+	return err
 }
 
 func (kp *KafkaProducer) Close() error {
@@ -55,6 +80,10 @@ func NewKafkaProducer(ctx context.Context, conf *KafkaProducerConfig) *KafkaProd
 		zap.Int16("required_acks", conf.RequiredAcks),
 	)
 
+	if len(conf.Addrs) == 0 || conf.Topic == "" {
+		logger.Fatal("the addresses and topic of the Kafka producer are required")
+	}
+
 	config := sarama.NewConfig()
 
 	config.Producer.RequiredAcks = sarama.RequiredAcks(conf.RequiredAcks)
@@ -75,5 +104,43 @@ func NewKafkaProducer(ctx context.Context, conf *KafkaProducerConfig) *KafkaProd
 	return &KafkaProducer{
 		SyncProducer: producer,
 		topic:        conf.Topic,
+		breaker:      breakerkit.NewBreaker(ctx, "kafka producer", &conf.Breaker),
+	}
+}
+
+func newSaramaProducerMessage(topic string, msg *ProducerMessage) *sarama.ProducerMessage {
+	// sort header keys so that the same message always produces the same record
+	keys := make([]string, 0, len(msg.Headers))
+	for key := range msg.Headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	headers := make([]sarama.RecordHeader, 0, len(keys))
+	for _, key := range keys {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(msg.Headers[key]),
+		})
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.ByteEncoder(msg.Key),
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
 	}
 }
+
+// TraceHeaders returns the headers of the message along with the trace context,
+// the headers of the message are not modified since the message may be sent again.
+func TraceHeaders(ctx context.Context, headers map[string]string) map[string]string {
+	traced := make(map[string]string, len(headers)+2)
+	for key, value := range headers {
+		traced[key] = value
+	}
+
+	otelkit.InjectHeaders(ctx, traced)
+
+	return traced
+}
@@ -0,0 +1,94 @@
+package kafkakit
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("newSaramaProducerMessage", func() {
+	var (
+		msg *ProducerMessage
+
+		resp *sarama.ProducerMessage
+	)
+
+	BeforeEach(func() {
+		msg = &ProducerMessage{
+			Key:   []byte("key"),
+			Value: []byte("value"),
+		}
+	})
+
+	JustBeforeEach(func() {
+		resp = newSaramaProducerMessage("topic", msg)
+	})
+
+	When("headers are absent", func() {
+		It("returns the message without headers", func() {
+			Expect(resp.Topic).To(Equal("topic"))
+			Expect(resp.Key).To(Equal(sarama.ByteEncoder("key")))
+			Expect(resp.Value).To(Equal(sarama.ByteEncoder("value")))
+			Expect(resp.Headers).To(BeEmpty())
+		})
+	})
+
+	When("headers are present", func() {
+		BeforeEach(func() {
+			msg.Headers = map[string]string{
+				"c": "3",
+				"a": "1",
+				"b": "2",
+			}
+		})
+
+		It("returns the message with headers sorted by key", func() {
+			Expect(resp.Headers).To(Equal([]sarama.RecordHeader{
+				{Key: []byte("a"), Value: []byte("1")},
+				{Key: []byte("b"), Value: []byte("2")},
+				{Key: []byte("c"), Value: []byte("3")},
+			}))
+		})
+	})
+})
+
+var _ = Describe("TraceHeaders", func() {
+	var (
+		ctx     context.Context
+		headers map[string]string
+
+		resp map[string]string
+	)
+
+	BeforeEach(func() {
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x01},
+			SpanID:     trace.SpanID{0x02},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx = trace.ContextWithSpanContext(context.Background(), spanContext)
+		headers = map[string]string{"schema": "v1"}
+	})
+
+	JustBeforeEach(func() {
+		resp = TraceHeaders(ctx, headers)
+	})
+
+	It("returns the headers with the trace context", func() {
+		Expect(resp).To(Equal(map[string]string{
+			"schema":      "v1",
+			"traceparent": "00-01000000000000000000000000000000-0200000000000000-01",
+		}))
+	})
+
+	It("does not modify the headers", func() {
+		Expect(headers).To(Equal(map[string]string{"schema": "v1"}))
+	})
+})
@@ -0,0 +1,177 @@
+package kafkakit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+var (
+	ErrInvalidReplayPosition = errors.New("invalid replay position")
+	// ErrGroupActive is returned when rewinding a consumer group with members, the members would overwrite the offsets.
+	ErrGroupActive = errors.New("consumer group is active")
+)
+
+// ReplayPosition is where a replay starts in each partition, either the first message produced at or after the time,
+// or the offset, which is also sarama.OffsetOldest or sarama.OffsetNewest.
+type ReplayPosition struct {
+	Time   time.Time
+	Offset int64
+}
+
+// ParseReplayPosition parses an RFC 3339 time, an offset, oldest or newest.
+func ParseReplayPosition(s string) (ReplayPosition, error) {
+	switch s = strings.TrimSpace(s); s {
+	case "oldest":
+		return ReplayPosition{Offset: sarama.OffsetOldest}, nil
+	case "newest":
+		return ReplayPosition{Offset: sarama.OffsetNewest}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return ReplayPosition{Time: t}, nil
+	}
+
+	offset, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || offset < 0 {
+		return ReplayPosition{}, fmt.Errorf("%w: %q", ErrInvalidReplayPosition, s)
+	}
+
+	return ReplayPosition{Offset: offset}, nil
+}
+
+func (p ReplayPosition) String() string {
+	switch {
+	case !p.Time.IsZero():
+		return p.Time.Format(time.RFC3339)
+	case p.Offset == sarama.OffsetOldest:
+		return "oldest"
+	case p.Offset == sarama.OffsetNewest:
+		return "newest"
+	}
+
+	return strconv.FormatInt(p.Offset, 10)
+}
+
+// ResolveOffsets returns the offset of the position in each of the partitions of the topic, all the partitions if none is given.
+// The offsets beyond the partitions are clamped to the newest, and the ones of the deleted messages to the oldest.
+func ResolveOffsets(client sarama.Client, topic string, partitions []int32, pos ReplayPosition) (map[int32]int64, error) {
+	if len(partitions) == 0 {
+		var err error
+		if partitions, err = client.Partitions(topic); err != nil {
+			return nil, err
+		}
+	}
+
+	offsets := make(map[int32]int64, len(partitions))
+	for _, partition := range partitions {
+		oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+		if err != nil {
+			return nil, err
+		}
+
+		newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if err != nil {
+			return nil, err
+		}
+
+		offset := pos.Offset
+		switch {
+		case !pos.Time.IsZero():
+			if offset, err = client.GetOffset(topic, partition, pos.Time.UnixMilli()); err != nil {
+				return nil, err
+			}
+
+			// no message is produced since then, skip all the messages
+			if offset == sarama.OffsetNewest {
+				offset = newest
+			}
+		case offset == sarama.OffsetOldest:
+			offset = oldest
+		case offset == sarama.OffsetNewest:
+			offset = newest
+		}
+
+		if offset < oldest {
+			offset = oldest
+		}
+		if offset > newest {
+			offset = newest
+		}
+
+		offsets[partition] = offset
+	}
+
+	return offsets, nil
+}
+
+// ResetOffsets rewinds or forwards the checkpoints of the consumer group in the partitions of the topic, so the group
+// consumes from the offsets when it starts. It returns the previous checkpoints, -1 if none, and ErrGroupActive
+// unless the consumers of the group are stopped.
+func ResetOffsets(client sarama.Client, group, topic string, offsets map[int32]int64) (map[int32]int64, error) {
+	// the admin is not closed, since it shares and would close the client of the caller
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions, err := admin.DescribeConsumerGroups([]string{group})
+	if err != nil {
+		return nil, err
+	}
+	for _, description := range descriptions {
+		if len(description.Members) > 0 {
+			return nil, fmt.Errorf("%w: %s has %d members in state %s", ErrGroupActive, group, len(description.Members), description.State)
+		}
+	}
+
+	partitions := make([]int32, 0, len(offsets))
+	for partition := range offsets {
+		partitions = append(partitions, partition)
+	}
+
+	resp, err := admin.ListConsumerGroupOffsets(group, map[string][]int32{topic: partitions})
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[int32]int64, len(offsets))
+	for _, partition := range partitions {
+		previous[partition] = -1
+		if block := resp.GetBlock(topic, partition); block != nil && block.Err == sarama.ErrNoError {
+			previous[partition] = block.Offset
+		}
+	}
+
+	om, err := sarama.NewOffsetManagerFromClient(group, client)
+	if err != nil {
+		return nil, err
+	}
+
+	poms := make([]sarama.PartitionOffsetManager, 0, len(offsets))
+	defer func() {
+		for _, pom := range poms {
+			pom.AsyncClose()
+		}
+		_ = om.Close()
+	}()
+
+	for partition, offset := range offsets {
+		pom, err := om.ManagePartition(topic, partition)
+		if err != nil {
+			return nil, err
+		}
+		poms = append(poms, pom)
+
+		// ResetOffset also moves the offset forward, unlike MarkOffset
+		pom.ResetOffset(offset, "")
+	}
+
+	om.Commit()
+
+	return previous, nil
+}
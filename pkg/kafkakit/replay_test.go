@@ -0,0 +1,34 @@
+package kafkakit
+
+import (
+	"time"
+
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseReplayPosition", func() {
+	DescribeTable("parses the position",
+		func(s string, expected ReplayPosition) {
+			pos, err := ParseReplayPosition(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pos).To(Equal(expected))
+			Expect(pos.String()).To(Equal(s))
+		},
+		Entry("time", "2026-10-16T08:00:00Z", ReplayPosition{Time: time.Date(2026, 10, 16, 8, 0, 0, 0, time.UTC)}),
+		Entry("offset", "42", ReplayPosition{Offset: 42}),
+		Entry("oldest", "oldest", ReplayPosition{Offset: sarama.OffsetOldest}),
+		Entry("newest", "newest", ReplayPosition{Offset: sarama.OffsetNewest}),
+	)
+
+	DescribeTable("rejects the invalid position",
+		func(s string) {
+			_, err := ParseReplayPosition(s)
+			Expect(err).To(MatchError(ErrInvalidReplayPosition))
+		},
+		Entry("empty", ""),
+		Entry("negative offset", "-5"),
+		Entry("date only", "2026-10-16"),
+	)
+})
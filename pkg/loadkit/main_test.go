@@ -0,0 +1,13 @@
+package loadkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLoadKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Load Kit")
+}
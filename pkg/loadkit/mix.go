@@ -0,0 +1,58 @@
+package loadkit
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+var ErrInvalidMix = errors.New("invalid traffic mix")
+
+// Mix picks the operations of the requests by their weights.
+type Mix struct {
+	ops []string
+	// cumulative are the cumulative weights of the ops
+	cumulative []int
+}
+
+// NewMix returns the mix of the operations by the weights, e.g. list_comment:80 and create_comment:20 make
+// 80% of the requests list comments. The operations of zero weights are never picked.
+func NewMix(weights map[string]int) (*Mix, error) {
+	ops := make([]string, 0, len(weights))
+	for op, weight := range weights {
+		if weight < 0 {
+			return nil, fmt.Errorf("%w: negative weight of %s", ErrInvalidMix, op)
+		}
+		if weight > 0 {
+			ops = append(ops, op)
+		}
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("%w: no operation", ErrInvalidMix)
+	}
+
+	// sort the ops so the same seed picks the same ops
+	sort.Strings(ops)
+
+	m := &Mix{ops: ops}
+	total := 0
+	for _, op := range ops {
+		total += weights[op]
+		m.cumulative = append(m.cumulative, total)
+	}
+
+	return m, nil
+}
+
+// Ops returns the operations of the mix in order.
+func (m *Mix) Ops() []string {
+	return m.ops
+}
+
+// Pick picks an operation by the weights.
+func (m *Mix) Pick(rnd *rand.Rand) string {
+	n := rnd.Intn(m.cumulative[len(m.cumulative)-1])
+
+	return m.ops[sort.SearchInts(m.cumulative, n+1)]
+}
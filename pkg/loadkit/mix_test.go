@@ -0,0 +1,36 @@
+package loadkit
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Mix", func() {
+	It("picks the operations by the weights", func() {
+		mix, err := NewMix(map[string]int{"list": 80, "create": 20, "delete": 0})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mix.Ops()).To(Equal([]string{"create", "list"}))
+
+		rnd := rand.New(rand.NewSource(1))
+		counts := make(map[string]int)
+		for i := 0; i < 10000; i++ {
+			counts[mix.Pick(rnd)]++
+		}
+
+		Expect(counts).To(HaveLen(2))
+		Expect(counts["list"]).To(BeNumerically("~", 8000, 200))
+		Expect(counts["create"]).To(BeNumerically("~", 2000, 200))
+	})
+
+	DescribeTable("returns ErrInvalidMix",
+		func(weights map[string]int) {
+			_, err := NewMix(weights)
+			Expect(err).To(MatchError(ErrInvalidMix))
+		},
+		Entry("no operation", map[string]int{}),
+		Entry("zero weights", map[string]int{"list": 0}),
+		Entry("negative weight", map[string]int{"list": 10, "create": -1}),
+	)
+})
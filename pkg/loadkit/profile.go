@@ -0,0 +1,130 @@
+package loadkit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidProfile = errors.New("invalid load profile")
+
+// Stage is a period of the load, whose rate ramps linearly from From to To requests per second.
+type Stage struct {
+	Duration time.Duration
+	From     float64
+	To       float64
+}
+
+// Profile is the stages of the load in order.
+type Profile []Stage
+
+// ParseProfile parses the stages separated by commas, each in the format of duration@rate for a constant rate,
+// or duration@from-to for a ramp, e.g. 30s@10-100,5m@100 ramps up to 100 requests per second in 30 seconds and
+// holds the rate for 5 minutes.
+func ParseProfile(s string) (Profile, error) {
+	var profile Profile
+	for _, part := range strings.Split(s, ",") {
+		stage, err := parseStage(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidProfile, part)
+		}
+
+		profile = append(profile, stage)
+	}
+
+	return profile, nil
+}
+
+func parseStage(s string) (Stage, error) {
+	parts := strings.SplitN(s, "@", 2)
+	if len(parts) != 2 {
+		return Stage{}, ErrInvalidProfile
+	}
+
+	duration, err := time.ParseDuration(parts[0])
+	if err != nil || duration <= 0 {
+		return Stage{}, ErrInvalidProfile
+	}
+
+	rates := strings.SplitN(parts[1], "-", 2)
+	from, err := parseRate(rates[0])
+	if err != nil {
+		return Stage{}, err
+	}
+
+	to := from
+	if len(rates) == 2 {
+		if to, err = parseRate(rates[1]); err != nil {
+			return Stage{}, err
+		}
+	}
+
+	return Stage{Duration: duration, From: from, To: to}, nil
+}
+
+func parseRate(s string) (float64, error) {
+	rate, err := strconv.ParseFloat(s, 64)
+	if err != nil || rate < 0 {
+		return 0, ErrInvalidProfile
+	}
+
+	return rate, nil
+}
+
+// Duration returns the total duration of the stages.
+func (p Profile) Duration() time.Duration {
+	var duration time.Duration
+	for _, stage := range p {
+		duration += stage.Duration
+	}
+
+	return duration
+}
+
+// Arrivals returns the function returning the times the requests arrive at since the start in order, and false
+// once the profile ends. The requests arrive at even intervals of the rate if rnd is nil, or as a Poisson process
+// of the rate otherwise. The arrivals are open-loop, they never wait for the responses of the earlier requests,
+// so a slow server sees the load it would see from independent clients.
+func (p Profile) Arrivals(rnd *rand.Rand) func() (time.Duration, bool) {
+	stage := 0
+	var stageStart time.Duration
+	// elapsed is the time of the last arrival since the start of its stage in seconds
+	var elapsed float64
+
+	return func() (time.Duration, bool) {
+		// the next request arrives once the integral of the rate since the last arrival reaches the work
+		work := 1.0
+		if rnd != nil {
+			work = rnd.ExpFloat64()
+		}
+
+		for stage < len(p) {
+			s := p[stage]
+			duration := s.Duration.Seconds()
+			slope := (s.To - s.From) / duration
+			// the rate at the last arrival, which ramps linearly with the slope to the end of the stage
+			rate := s.From + slope*elapsed
+
+			remaining := duration - elapsed
+			if available := rate*remaining + slope*remaining*remaining/2; work > available {
+				work -= available
+				stage++
+				stageStart += s.Duration
+				elapsed = 0
+				continue
+			}
+
+			// solve rate*t + slope*t*t/2 = work for the time t since the last arrival, in the form stable
+			// for a zero slope
+			elapsed += 2 * work / (rate + math.Sqrt(math.Max(rate*rate+2*slope*work, 0)))
+
+			return stageStart + time.Duration(elapsed*float64(time.Second)), true
+		}
+
+		return 0, false
+	}
+}
@@ -0,0 +1,98 @@
+package loadkit
+
+import (
+	"math/rand"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Profile", func() {
+	DescribeTable("ParseProfile",
+		func(s string, expected Profile) {
+			profile, err := ParseProfile(s)
+			if expected == nil {
+				Expect(err).To(MatchError(ErrInvalidProfile))
+				return
+			}
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile).To(Equal(expected))
+		},
+		Entry("constant", "1m@100", Profile{{Duration: time.Minute, From: 100, To: 100}}),
+		Entry("ramp and hold", "30s@10-100, 5m@100", Profile{
+			{Duration: 30 * time.Second, From: 10, To: 100},
+			{Duration: 5 * time.Minute, From: 100, To: 100},
+		}),
+		Entry("idle", "10s@0", Profile{{Duration: 10 * time.Second}}),
+		Entry("no rate", "1m", nil),
+		Entry("invalid duration", "0s@10", nil),
+		Entry("negative rate", "1m@-10", nil),
+		Entry("invalid ramp", "1m@10-", nil),
+	)
+
+	// count counts the arrivals of the profile in the windows of the duration
+	count := func(arrivals func() (time.Duration, bool), window time.Duration) []int {
+		var counts []int
+		last := time.Duration(-1)
+		for {
+			at, ok := arrivals()
+			if !ok {
+				return counts
+			}
+			Expect(at).To(BeNumerically(">=", last))
+			last = at
+
+			for int(at/window) >= len(counts) {
+				counts = append(counts, 0)
+			}
+			counts[at/window]++
+		}
+	}
+
+	Describe("Arrivals", func() {
+		When("the rate is constant", func() {
+			It("arrives at even intervals", func() {
+				arrivals := Profile{{Duration: time.Second, From: 4, To: 4}}.Arrivals(nil)
+
+				for _, expected := range []time.Duration{250, 500, 750} {
+					at, ok := arrivals()
+					Expect(ok).To(BeTrue())
+					Expect(at).To(BeNumerically("~", expected*time.Millisecond, time.Microsecond))
+				}
+			})
+		})
+
+		When("the rate ramps", func() {
+			It("arrives at the rate of each moment", func() {
+				profile := Profile{
+					{Duration: 10 * time.Second, From: 0, To: 100},
+					{Duration: 5 * time.Second},
+					{Duration: 10 * time.Second, From: 100, To: 100},
+				}
+
+				counts := count(profile.Arrivals(nil), 5*time.Second)
+				Expect(counts).To(HaveLen(5))
+				// the integrals of the rate in the windows are 125, 375, 0, 500 and 500
+				Expect(counts[0]).To(BeNumerically("~", 125, 1))
+				Expect(counts[1]).To(BeNumerically("~", 375, 1))
+				Expect(counts[2]).To(BeZero())
+				Expect(counts[3]).To(BeNumerically("~", 500, 1))
+				Expect(counts[4]).To(BeNumerically("~", 500, 1))
+			})
+		})
+
+		When("the arrivals are a Poisson process", func() {
+			It("arrives at the rate on average", func() {
+				profile := Profile{{Duration: 100 * time.Second, From: 100, To: 100}}
+
+				total := 0
+				for _, c := range count(profile.Arrivals(rand.New(rand.NewSource(1))), time.Second) {
+					total += c
+				}
+				Expect(total).To(BeNumerically("~", 10000, 300))
+			})
+		})
+	})
+})
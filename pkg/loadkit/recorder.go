@@ -0,0 +1,124 @@
+package loadkit
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recorder records the latencies and the results of the requests of the operations.
+type Recorder struct {
+	mu      sync.Mutex
+	results map[string]*results
+	dropped map[string]int
+}
+
+type results struct {
+	latencies []time.Duration
+	codes     map[codes.Code]int
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		results: make(map[string]*results),
+		dropped: make(map[string]int),
+	}
+}
+
+// Record records the latency and the status code of the error of a request of the operation.
+func (r *Recorder) Record(op string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res, ok := r.results[op]
+	if !ok {
+		res = &results{codes: make(map[codes.Code]int)}
+		r.results[op] = res
+	}
+
+	res.latencies = append(res.latencies, latency)
+	res.codes[status.Code(err)]++
+}
+
+// Drop records a request of the operation not sent, since too many requests are in flight.
+func (r *Recorder) Drop(op string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dropped[op]++
+}
+
+// OpReport is the report of the requests of an operation.
+type OpReport struct {
+	Op string
+	// Count is the number of the requests sent
+	Count   int
+	Dropped int
+	// Codes are the numbers of the requests of the status codes, including OK
+	Codes map[codes.Code]int
+
+	P50  time.Duration
+	P90  time.Duration
+	P99  time.Duration
+	P999 time.Duration
+	Max  time.Duration
+}
+
+// Errors returns the number of the requests failed.
+func (r *OpReport) Errors() int {
+	return r.Count - r.Codes[codes.OK]
+}
+
+// Report returns the reports of the operations ordered by the operations.
+func (r *Recorder) Report() []*OpReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ops := make(map[string]struct{})
+	for op := range r.results {
+		ops[op] = struct{}{}
+	}
+	for op := range r.dropped {
+		ops[op] = struct{}{}
+	}
+
+	reports := make([]*OpReport, 0, len(ops))
+	for op := range ops {
+		report := &OpReport{Op: op, Dropped: r.dropped[op], Codes: make(map[codes.Code]int)}
+
+		if res, ok := r.results[op]; ok {
+			latencies := append([]time.Duration(nil), res.latencies...)
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+			report.Count = len(latencies)
+			report.P50 = percentile(latencies, 0.5)
+			report.P90 = percentile(latencies, 0.9)
+			report.P99 = percentile(latencies, 0.99)
+			report.P999 = percentile(latencies, 0.999)
+			report.Max = latencies[len(latencies)-1]
+			for code, count := range res.codes {
+				report.Codes[code] = count
+			}
+		}
+
+		reports = append(reports, report)
+	}
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Op < reports[j].Op })
+
+	return reports
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+
+	return sorted[rank]
+}
@@ -0,0 +1,47 @@
+package loadkit
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Recorder", func() {
+	It("reports the percentiles and the status codes of the operations", func() {
+		recorder := NewRecorder()
+		for i := 1000; i >= 1; i-- {
+			recorder.Record("list", time.Duration(i)*time.Millisecond, nil)
+		}
+		recorder.Record("create", time.Second, status.Error(codes.Unavailable, "unavailable"))
+		recorder.Drop("create")
+		recorder.Drop("delete")
+
+		reports := recorder.Report()
+		Expect(reports).To(HaveLen(3))
+
+		create, deleted, list := reports[0], reports[1], reports[2]
+
+		Expect(create.Op).To(Equal("create"))
+		Expect(create.Count).To(Equal(1))
+		Expect(create.Dropped).To(Equal(1))
+		Expect(create.Errors()).To(Equal(1))
+		Expect(create.Codes).To(Equal(map[codes.Code]int{codes.Unavailable: 1}))
+		Expect(create.P50).To(Equal(time.Second))
+
+		Expect(deleted.Op).To(Equal("delete"))
+		Expect(deleted.Count).To(BeZero())
+		Expect(deleted.Dropped).To(Equal(1))
+
+		Expect(list.Op).To(Equal("list"))
+		Expect(list.Count).To(Equal(1000))
+		Expect(list.Errors()).To(BeZero())
+		Expect(list.P50).To(Equal(500 * time.Millisecond))
+		Expect(list.P90).To(Equal(900 * time.Millisecond))
+		Expect(list.P99).To(Equal(990 * time.Millisecond))
+		Expect(list.P999).To(Equal(999 * time.Millisecond))
+		Expect(list.Max).To(Equal(time.Second))
+	})
+})
@@ -0,0 +1,141 @@
+package loadkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var ErrUnknownOp = errors.New("unknown operation")
+
+// Op sends a request of an operation.
+type Op func(ctx context.Context) error
+
+// Runner sends the requests of the mix of the operations at the rates of the profile.
+type Runner struct {
+	profile Profile
+	mix     *Mix
+	ops     map[string]Op
+
+	seed        int64
+	poisson     bool
+	maxInFlight int
+	timeout     time.Duration
+}
+
+type RunnerOption func(r *Runner)
+
+// WithSeed seeds the arrivals and the picks of the operations, so the runs of a seed send the same requests.
+func WithSeed(seed int64) RunnerOption {
+	return func(r *Runner) {
+		r.seed = seed
+	}
+}
+
+// WithPoissonArrivals makes the requests arrive as a Poisson process instead of at even intervals,
+// which is closer to the requests of many independent clients.
+func WithPoissonArrivals() RunnerOption {
+	return func(r *Runner) {
+		r.poisson = true
+	}
+}
+
+// WithMaxInFlight drops the requests arriving while max requests are in flight, so an overloaded server
+// does not pile up the requests without bound. The dropped requests are reported.
+func WithMaxInFlight(max int) RunnerOption {
+	return func(r *Runner) {
+		r.maxInFlight = max
+	}
+}
+
+// WithTimeout sets the timeout of each request.
+func WithTimeout(timeout time.Duration) RunnerOption {
+	return func(r *Runner) {
+		r.timeout = timeout
+	}
+}
+
+func NewRunner(profile Profile, mix *Mix, ops map[string]Op, opts ...RunnerOption) (*Runner, error) {
+	for _, op := range mix.Ops() {
+		if _, ok := ops[op]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownOp, op)
+		}
+	}
+
+	r := &Runner{
+		profile:     profile,
+		mix:         mix,
+		ops:         ops,
+		seed:        time.Now().UnixNano(),
+		maxInFlight: 1000,
+		timeout:     10 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r, nil
+}
+
+// Run sends the requests until the profile ends or the context is done, and returns the results once the requests
+// in flight finish. The latency of a request is measured from the time it is scheduled to arrive instead of the time
+// it is sent, so a stalled runner does not hide the latency from the report.
+func (r *Runner) Run(ctx context.Context) *Recorder {
+	recorder := NewRecorder()
+	rnd := rand.New(rand.NewSource(r.seed)) //nolint:gosec
+
+	var arrivals func() (time.Duration, bool)
+	if r.poisson {
+		arrivals = r.profile.Arrivals(rnd)
+	} else {
+		arrivals = r.profile.Arrivals(nil)
+	}
+
+	inFlight := make(chan struct{}, r.maxInFlight)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	start := time.Now()
+	for {
+		at, ok := arrivals()
+		if !ok {
+			return recorder
+		}
+
+		scheduled := start.Add(at)
+		op := r.mix.Pick(rnd)
+
+		if wait := time.Until(scheduled); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return recorder
+			case <-timer.C:
+			}
+		}
+
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			recorder.Drop(op)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-inFlight }()
+
+			reqCtx, cancel := context.WithTimeout(ctx, r.timeout)
+			err := r.ops[op](reqCtx)
+			cancel()
+
+			recorder.Record(op, time.Since(scheduled), err)
+		}()
+	}
+}
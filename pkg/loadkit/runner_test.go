@@ -0,0 +1,87 @@
+package loadkit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		profile Profile
+		mix     *Mix
+	)
+
+	BeforeEach(func() {
+		profile = Profile{{Duration: 200 * time.Millisecond, From: 100, To: 100}}
+
+		var err error
+		mix, err = NewMix(map[string]int{"fast": 1, "slow": 1})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sends the requests of the operations at the rate", func() {
+		var sent int32
+		op := func(ctx context.Context) error {
+			atomic.AddInt32(&sent, 1)
+			return nil
+		}
+
+		runner, err := NewRunner(profile, mix, map[string]Op{"fast": op, "slow": op}, WithSeed(1))
+		Expect(err).NotTo(HaveOccurred())
+
+		reports := runner.Run(context.Background()).Report()
+		// the last request may fall off the end of the profile by the rounding of the arrivals
+		Expect(atomic.LoadInt32(&sent)).To(BeNumerically("~", 20, 1))
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].Count + reports[1].Count).To(BeNumerically("==", atomic.LoadInt32(&sent)))
+	})
+
+	It("drops the requests arriving while too many requests are in flight", func() {
+		release := make(chan struct{})
+		ops := map[string]Op{
+			"fast": func(ctx context.Context) error { return nil },
+			"slow": func(ctx context.Context) error {
+				<-release
+				return ctx.Err()
+			},
+		}
+
+		runner, err := NewRunner(profile, mix, ops, WithSeed(1), WithMaxInFlight(1))
+		Expect(err).NotTo(HaveOccurred())
+
+		time.AfterFunc(300*time.Millisecond, func() { close(release) })
+		reports := runner.Run(context.Background()).Report()
+
+		slow := reports[1]
+		Expect(slow.Op).To(Equal("slow"))
+		Expect(slow.Count).To(Equal(1))
+		// the latency of the slow request is measured from the time it is scheduled
+		Expect(slow.Max).To(BeNumerically(">=", 100*time.Millisecond))
+		Expect(reports[0].Dropped + slow.Dropped).To(BeNumerically(">", 0))
+		Expect(reports[0].Count + reports[0].Dropped + slow.Count + slow.Dropped).To(BeNumerically("~", 20, 1))
+	})
+
+	It("stops once the context is done", func() {
+		runner, err := NewRunner(Profile{{Duration: time.Hour, From: 100, To: 100}}, mix, map[string]Op{
+			"fast": func(ctx context.Context) error { return nil },
+			"slow": func(ctx context.Context) error { return nil },
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		runner.Run(ctx)
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("returns ErrUnknownOp if an operation of the mix is missing", func() {
+		_, err := NewRunner(profile, mix, map[string]Op{"fast": func(ctx context.Context) error { return nil }})
+		Expect(err).To(MatchError(ErrUnknownOp))
+	})
+})
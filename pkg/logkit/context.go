@@ -23,3 +23,13 @@ func FromContext(ctx context.Context) *Logger {
 
 	return logger
 }
+
+// FromContextOr returns the logger in the context, or the fallback if not found,
+// e.g. the logger of a client used both in and out of the requests.
+func FromContextOr(ctx context.Context, fallback *Logger) *Logger {
+	if logger, ok := ctx.Value(contextKeyLogger).(*Logger); ok {
+		return logger
+	}
+
+	return fallback
+}
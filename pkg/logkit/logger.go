@@ -22,10 +22,18 @@ func (l *LoggerLevel) UnmarshalFlag(value string) error {
 type LoggerConfig struct {
 	Level       LoggerLevel `long:"level" description:"set log level" default:"info" env:"LEVEL"`
 	Development bool        `long:"development" description:"enable development mode" env:"DEVELOPMENT"`
+
+	// RedactFields are the proto fields redacted from the messages logged by Proto besides the ones annotated by
+	// (log.redact), by the full name or the name, the emails, the tokens and the passwords are redacted from every
+	// log line regardless.
+	RedactFields []string `long:"redact_fields" description:"the proto fields redacted from the logs besides the ones annotated by (log.redact), by the full name or the name" env:"REDACT_FIELDS" env-delim:","`
 }
 
 type Logger struct {
 	*zap.Logger
+
+	// level is shared by the loggers derived by With, so SetLevel changes the level of all of them
+	level *zap.AtomicLevel
 }
 
 func NewNopLogger() *Logger {
@@ -43,12 +51,24 @@ func NewLogger(conf *LoggerConfig) *Logger {
 
 	config.Level = zap.NewAtomicLevelAt(zapcore.Level(conf.Level))
 
-	logger, err := config.Build()
+	fields := newRedactFields(conf.RedactFields)
+
+	logger, err := config.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return newRedactCore(core, fields)
+	}))
 	if err != nil {
 		log.Fatal("failed to build logger")
 	}
 
-	return &Logger{Logger: logger}
+	return &Logger{Logger: logger, level: &config.Level}
+}
+
+// SetLevel changes the level of the logger and the loggers derived from it at runtime,
+// it is a no-op for the loggers not built by NewLogger.
+func (l *Logger) SetLevel(level LoggerLevel) {
+	if l.level != nil {
+		l.level.SetLevel(zapcore.Level(level))
+	}
 }
 
 func (l *Logger) WithContext(ctx context.Context) context.Context {
@@ -58,5 +78,6 @@ func (l *Logger) WithContext(ctx context.Context) context.Context {
 func (l *Logger) With(fields ...zapcore.Field) *Logger {
 	return &Logger{
 		Logger: l.Logger.With(fields...),
+		level:  l.level,
 	}
 }
@@ -5,6 +5,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 var _ = Describe("Logger", func() {
@@ -22,6 +24,17 @@ var _ = Describe("Logger", func() {
 		})
 	})
 
+	Describe("SetLevel", func() {
+		It("changes the level of the derived loggers", func() {
+			logger := NewLogger(&LoggerConfig{Level: LoggerLevel(zapcore.InfoLevel)})
+			derived := logger.With(zap.String("key", "value"))
+			Expect(derived.Core().Enabled(zapcore.DebugLevel)).To(BeFalse())
+
+			logger.SetLevel(LoggerLevel(zapcore.DebugLevel))
+			Expect(derived.Core().Enabled(zapcore.DebugLevel)).To(BeTrue())
+		})
+	})
+
 	Describe("WithContext", func() {
 		var logger *Logger
 		var ctx context.Context
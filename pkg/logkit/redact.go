@@ -0,0 +1,180 @@
+package logkit
+
+import (
+	"regexp"
+	"strings"
+
+	logpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pb/log"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const redacted = "[REDACTED]"
+
+// redactPatterns match the sensitive values in the free text, e.g. the error messages, in order,
+// so the password of a URL is redacted before its user info looks like an email.
+var redactPatterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`://([^:/@\s]+):[^@/\s]+@`), "://$1:" + redacted + "@"},
+	{regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9._~+/=-]+`), "Bearer " + redacted},
+	{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), redacted},
+	{regexp.MustCompile(`(?i)\b(password|passwd|secret|token|api_key|apikey)=[^&\s]+`), "$1=" + redacted},
+	{regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`), redacted},
+}
+
+// RedactString redacts the emails, the tokens and the passwords in the text.
+func RedactString(s string) string {
+	for _, p := range redactPatterns {
+		s = p.re.ReplaceAllString(s, p.replacement)
+	}
+
+	return s
+}
+
+// Proto returns the field logging the proto message as JSON, with the sensitive fields redacted. The sensitive
+// fields are the ones annotated by (log.redact), along with the redact fields of the logger if it is built by NewLogger.
+func Proto(key string, msg proto.Message) zap.Field {
+	return zap.Stringer(key, &protoMessage{msg: msg})
+}
+
+type protoMessage struct {
+	msg    proto.Message
+	fields redactFields
+}
+
+func (m *protoMessage) String() string {
+	if m.msg == nil {
+		return "null"
+	}
+
+	msg := proto.Clone(m.msg)
+	m.fields.redactMessage(msg.ProtoReflect())
+
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return redacted
+	}
+
+	return string(b)
+}
+
+// redactFields are the sensitive proto fields besides the ones annotated by (log.redact), by the full name,
+// e.g. comment.pb.CommentInfo.content, or by the name to redact the fields of the name in every message.
+type redactFields map[string]struct{}
+
+func newRedactFields(names []string) redactFields {
+	fields := make(redactFields, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			fields[name] = struct{}{}
+		}
+	}
+
+	return fields
+}
+
+func (f redactFields) sensitive(fd protoreflect.FieldDescriptor) bool {
+	if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && proto.GetExtension(opts, logpb.E_Redact).(bool) {
+		return true
+	}
+
+	if _, ok := f[string(fd.FullName())]; ok {
+		return true
+	}
+
+	_, ok := f[string(fd.Name())]
+	return ok
+}
+
+// redactMessage redacts the sensitive fields of the message and its nested messages in place, the sensitive
+// string fields are replaced so the presence of the value is still logged, and the others are cleared.
+func (f redactFields) redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case f.sensitive(fd):
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				f.redactMessage(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				f.redactMessage(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			f.redactMessage(v.Message())
+		}
+
+		return true
+	})
+}
+
+// redactCore redacts the message and the fields of the entries before they are written, so the sensitive
+// values never leave the process even if they are logged by mistake, e.g. within the error of a query.
+type redactCore struct {
+	zapcore.Core
+
+	fields redactFields
+}
+
+func newRedactCore(core zapcore.Core, fields redactFields) zapcore.Core {
+	return &redactCore{Core: core, fields: fields}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), fields: c.fields}
+}
+
+// Check leaves the decision to the wrapped core, so a sampler below still drops the entries over its rate, and adds
+// redactCore itself in place of the cores the wrapped one adds, so the entries are redacted before they are written.
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Core.Check(ent, nil) == nil {
+		return ce
+	}
+
+	return ce.AddCore(ent, c)
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	ent.Message = RedactString(ent.Message)
+
+	return c.Core.Write(ent, c.redact(fields))
+}
+
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	redactedFields := make([]zapcore.Field, 0, len(fields))
+	for _, field := range fields {
+		redactedFields = append(redactedFields, c.redactField(field))
+	}
+
+	return redactedFields
+}
+
+func (c *redactCore) redactField(field zapcore.Field) zapcore.Field {
+	switch field.Type {
+	case zapcore.StringType:
+		return zap.String(field.Key, RedactString(field.String))
+	case zapcore.ErrorType:
+		if err, ok := field.Interface.(error); ok && err != nil {
+			return zap.String(field.Key, RedactString(err.Error()))
+		}
+	case zapcore.StringerType:
+		if m, ok := field.Interface.(*protoMessage); ok {
+			return zap.String(field.Key, RedactString((&protoMessage{msg: m.msg, fields: c.fields}).String()))
+		}
+	}
+
+	return field
+}
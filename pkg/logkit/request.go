@@ -0,0 +1,60 @@
+package logkit
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Inject request ID and user ID into context to correlate the log lines of a request across the services
+
+type requestContextKey int8
+
+const (
+	contextKeyRequestID requestContextKey = iota
+	contextKeyUserID
+)
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKeyRequestID, requestID)
+}
+
+// RequestIDFromContext returns the request ID in the context, or empty if not found.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKeyRequestID).(string)
+	return requestID
+}
+
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, contextKeyUserID, userID)
+}
+
+// UserIDFromContext returns the user ID in the context, or empty if not found.
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(contextKeyUserID).(string)
+	return userID
+}
+
+// ContextFields returns the fields correlating the log lines with the request of the context,
+// that is, the request ID, the user ID and the trace ID. The fields absent from the context are omitted.
+func ContextFields(ctx context.Context) []zap.Field {
+	var fields []zap.Field
+
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+
+	if userID := UserIDFromContext(ctx); userID != "" {
+		fields = append(fields, zap.String("user_id", userID))
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", spanContext.TraceID().String()),
+			zap.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+
+	return fields
+}
@@ -0,0 +1,73 @@
+package logkit
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("ContextFields", func() {
+	var (
+		ctx context.Context
+
+		fields []zap.Field
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	JustBeforeEach(func() {
+		fields = ContextFields(ctx)
+	})
+
+	When("the context is not of a request", func() {
+		It("returns no fields", func() {
+			Expect(fields).To(BeEmpty())
+		})
+	})
+
+	When("the context is of a traced request", func() {
+		BeforeEach(func() {
+			ctx = WithRequestID(ctx, "request-id")
+			ctx = WithUserID(ctx, "user-id")
+			ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{0x01},
+				SpanID:  trace.SpanID{0x02},
+			}))
+		})
+
+		It("returns the fields correlating the request", func() {
+			Expect(fields).To(Equal([]zap.Field{
+				zap.String("request_id", "request-id"),
+				zap.String("user_id", "user-id"),
+				zap.String("trace_id", "01000000000000000000000000000000"),
+				zap.String("span_id", "0200000000000000"),
+			}))
+		})
+	})
+})
+
+var _ = Describe("FromContextOr", func() {
+	var fallback *Logger
+
+	BeforeEach(func() {
+		fallback = NewNopLogger()
+	})
+
+	When("logger is in context", func() {
+		It("returns the logger", func() {
+			logger := NewNopLogger()
+			Expect(FromContextOr(logger.WithContext(context.Background()), fallback)).To(BeIdenticalTo(logger))
+		})
+	})
+
+	When("logger is not in context", func() {
+		It("returns the fallback", func() {
+			Expect(FromContextOr(context.Background(), fallback)).To(BeIdenticalTo(fallback))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package markdownkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMarkdownKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Markdown Kit")
+}
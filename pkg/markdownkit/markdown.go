@@ -0,0 +1,214 @@
+package markdownkit
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// linkPattern matches an inline link at the start of the text, e.g. [text](https://example.com)
+var linkPattern = regexp.MustCompile(`^\[([^\[\]\n]+)\]\(([^()\s]+)\)`)
+
+// orderedItemPattern matches the marker of an ordered list item, e.g. "1. "
+var orderedItemPattern = regexp.MustCompile(`^\d{1,9}\. `)
+
+// linkSchemes are the schemes of the links rendered, the links of the other schemes, e.g. javascript:,
+// are rendered as the text only
+var linkSchemes = map[string]bool{
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// Render renders the safe subset of markdown into HTML: paragraphs and line breaks, *emphasis*, **strong**,
+// `code`, fenced code blocks, [links](https://example.com), lists and block quotes. Everything else, including
+// raw HTML, is rendered as the escaped text, so the HTML is safe to embed as is. The same source always renders
+// into the same HTML, which is stored along with the source.
+func Render(src string) string {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+		case strings.HasPrefix(trimmed, "```"):
+			i = renderCodeBlock(&b, lines, i)
+		case strings.HasPrefix(trimmed, ">"):
+			i = renderQuote(&b, lines, i)
+		case isUnorderedItem(trimmed):
+			i = renderList(&b, lines, i, "ul", isUnorderedItem, func(s string) string { return s[2:] })
+		case isOrderedItem(trimmed):
+			i = renderList(&b, lines, i, "ol", isOrderedItem, func(s string) string {
+				return s[len(orderedItemPattern.FindString(s)):]
+			})
+		default:
+			i = renderParagraph(&b, lines, i)
+		}
+	}
+
+	return b.String()
+}
+
+func isUnorderedItem(line string) bool {
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")
+}
+
+func isOrderedItem(line string) bool {
+	return orderedItemPattern.MatchString(line)
+}
+
+// isBlockStart reports whether the line starts a block other than a paragraph.
+func isBlockStart(line string) bool {
+	return strings.HasPrefix(line, "```") || strings.HasPrefix(line, ">") || isUnorderedItem(line) || isOrderedItem(line)
+}
+
+// renderCodeBlock renders the fenced code block starting at the line, the block runs to the end if it is not closed.
+func renderCodeBlock(b *strings.Builder, lines []string, i int) int {
+	var code []string
+	for i++; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), "```") {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+
+	b.WriteString("<pre><code>")
+	b.WriteString(html.EscapeString(strings.Join(code, "\n")))
+	b.WriteString("</code></pre>")
+
+	return i
+}
+
+func renderQuote(b *strings.Builder, lines []string, i int) int {
+	var quoted []string
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, ">") {
+			break
+		}
+		quoted = append(quoted, strings.TrimPrefix(strings.TrimPrefix(trimmed, ">"), " "))
+	}
+
+	// the quotes are not nested, the quoted lines are rendered as paragraphs
+	b.WriteString("<blockquote>")
+	for j := 0; j < len(quoted); {
+		if strings.TrimSpace(quoted[j]) == "" {
+			j++
+			continue
+		}
+		j = renderParagraph(b, quoted, j)
+	}
+	b.WriteString("</blockquote>")
+
+	return i
+}
+
+func renderList(b *strings.Builder, lines []string, i int, tag string, isItem func(string) bool, content func(string) string) int {
+	b.WriteString("<" + tag + ">")
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !isItem(trimmed) {
+			break
+		}
+
+		b.WriteString("<li>")
+		renderInline(b, content(trimmed), true)
+		b.WriteString("</li>")
+	}
+	b.WriteString("</" + tag + ">")
+
+	return i
+}
+
+// renderParagraph renders the lines up to a blank line or another block as a paragraph, the lines are broken
+// as they are, since the comments are mostly short and written as they should be displayed.
+func renderParagraph(b *strings.Builder, lines []string, i int) int {
+	b.WriteString("<p>")
+	for start := i; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || (i > start && isBlockStart(trimmed)) {
+			break
+		}
+
+		if i > start {
+			b.WriteString("<br>")
+		}
+		renderInline(b, trimmed, true)
+	}
+	b.WriteString("</p>")
+
+	return i
+}
+
+// renderInline renders the inline elements of the text, the links are not rendered in the text of a link.
+func renderInline(b *strings.Builder, s string, links bool) {
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_[]()>#-.!", s[i+1]) >= 0:
+			b.WriteString(html.EscapeString(s[i+1 : i+2]))
+			i += 2
+			continue
+		case c == '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end > 0 {
+				b.WriteString("<code>")
+				b.WriteString(html.EscapeString(s[i+1 : i+1+end]))
+				b.WriteString("</code>")
+				i += end + 2
+				continue
+			}
+		case c == '[' && links:
+			if m := linkPattern.FindStringSubmatch(s[i:]); m != nil {
+				renderLink(b, m[1], m[2])
+				i += len(m[0])
+				continue
+			}
+		case strings.HasPrefix(s[i:], "**"):
+			if end := strings.Index(s[i+2:], "**"); end > 0 && isEmphasized(s[i+2:i+2+end]) {
+				b.WriteString("<strong>")
+				renderInline(b, s[i+2:i+2+end], links)
+				b.WriteString("</strong>")
+				i += end + 4
+				continue
+			}
+		case c == '*':
+			if end := strings.IndexByte(s[i+1:], '*'); end > 0 && isEmphasized(s[i+1:i+1+end]) {
+				b.WriteString("<em>")
+				renderInline(b, s[i+1:i+1+end], links)
+				b.WriteString("</em>")
+				i += end + 2
+				continue
+			}
+		}
+
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+}
+
+// isEmphasized reports whether the text between the delimiters is emphasized, which does not start or end with
+// spaces, so e.g. "2 * 3 * 4" is left as it is.
+func isEmphasized(s string) bool {
+	return strings.TrimSpace(s) == s
+}
+
+// renderLink renders the link if its URL is absolute and of a safe scheme, otherwise only its text.
+func renderLink(b *strings.Builder, text, rawURL string) {
+	u, err := url.Parse(rawURL)
+	if err != nil || !linkSchemes[strings.ToLower(u.Scheme)] || (u.Scheme != "mailto" && u.Host == "") {
+		renderInline(b, text, false)
+		return
+	}
+
+	// the links of the comments are not endorsed by the site
+	b.WriteString(`<a href="`)
+	b.WriteString(html.EscapeString(u.String()))
+	b.WriteString(`" rel="nofollow ugc noopener">`)
+	renderInline(b, text, false)
+	b.WriteString("</a>")
+}
@@ -0,0 +1,57 @@
+package markdownkit
+
+import (
+	"regexp"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fuzzkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// renderedTagPattern matches the tags Render writes, the links are matched with their safe URLs only
+var renderedTagPattern = regexp.MustCompile(`<(/?)(p|br|em|strong|code|pre|ul|ol|li|blockquote|a)(?: href="(?i:https?://|mailto:)[^"<>]*" rel="nofollow ugc noopener")?>`)
+
+// voidTags are the tags without the end tags
+var voidTags = map[string]bool{"br": true}
+
+var _ = Describe("Render fuzzing", func() {
+	It("renders any input into safe and well-formed HTML", func() {
+		corpus := [][]byte{
+			[]byte("**strong** and *em* with `code`\nnext line\n\nparagraph"),
+			[]byte("```\n<b>code</b>\n```\n> quoted\n- item\n1. first"),
+			[]byte("[docs](https://example.com/?a=1&b=2) [mail](mailto:a@example.com) \\*literal\\*"),
+		}
+		dict := [][]byte{
+			[]byte("**"), []byte("*"), []byte("`"), []byte("```"), []byte("["), []byte("]("), []byte(")"),
+			[]byte("\n"), []byte("\r\n"), []byte("> "), []byte("- "), []byte("1. "), []byte("\\"),
+			[]byte("<script>"), []byte(`"`), []byte("&"), []byte("javascript:"), []byte("https://"),
+		}
+
+		fuzzkit.Fuzz(corpus, dict, func(input []byte) {
+			out := Render(string(input))
+			Expect(Render(string(input))).To(Equal(out), "input %q", input)
+
+			// the text between the tags is escaped, so it has no markup of its own
+			text := renderedTagPattern.ReplaceAllString(out, "")
+			Expect(text).NotTo(ContainSubstring("<"), "input %q renders %q", input, out)
+			Expect(text).NotTo(ContainSubstring(">"), "input %q renders %q", input, out)
+			Expect(text).NotTo(ContainSubstring(`"`), "input %q renders %q", input, out)
+
+			// the tags are nested
+			var open []string
+			for _, m := range renderedTagPattern.FindAllStringSubmatch(out, -1) {
+				closing, tag := m[1] == "/", m[2]
+				switch {
+				case voidTags[tag]:
+				case !closing:
+					open = append(open, tag)
+				default:
+					Expect(open).NotTo(BeEmpty(), "input %q renders %q", input, out)
+					Expect(open[len(open)-1]).To(Equal(tag), "input %q renders %q", input, out)
+					open = open[:len(open)-1]
+				}
+			}
+			Expect(open).To(BeEmpty(), "input %q renders %q", input, out)
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package markdownkit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Render", func() {
+	DescribeTable("renders the safe subset of markdown",
+		func(src, expected string) {
+			Expect(Render(src)).To(Equal(expected))
+		},
+		Entry("paragraphs and line breaks", "first\nline\n\nsecond", "<p>first<br>line</p><p>second</p>"),
+		Entry("emphasis", "**strong** and *em*", "<p><strong>strong</strong> and <em>em</em></p>"),
+		Entry("no emphasis around spaces", "2 * 3 * 4", "<p>2 * 3 * 4</p>"),
+		Entry("escaped delimiters", `\*literal\*`, "<p>*literal*</p>"),
+		Entry("code", "`a<b`", "<p><code>a&lt;b</code></p>"),
+		Entry("code blocks", "```\n<b>bold</b>\n```", "<pre><code>&lt;b&gt;bold&lt;/b&gt;</code></pre>"),
+		Entry("links", "[docs](https://example.com/?a=1&b=2)", `<p><a href="https://example.com/?a=1&amp;b=2" rel="nofollow ugc noopener">docs</a></p>`),
+		Entry("lists", "- one\n- two\n\n1. first", "<ul><li>one</li><li>two</li></ul><ol><li>first</li></ol>"),
+		Entry("quotes", "> quoted\n> more\n\nreply", "<blockquote><p>quoted<br>more</p></blockquote><p>reply</p>"),
+	)
+
+	DescribeTable("sanitizes the unsafe input",
+		func(src, expected string) {
+			Expect(Render(src)).To(Equal(expected))
+		},
+		Entry("raw HTML", `<img src=x onerror="alert(1)">`, "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>"),
+		Entry("script links", "[click](javascript:alert)", "<p>click</p>"),
+		Entry("relative links", "[click](/admin)", "<p>click</p>"),
+		Entry("nested links", "[[inner](https://a.example)](https://b.example)", `<p>[<a href="https://a.example" rel="nofollow ugc noopener">inner</a>](https://b.example)</p>`),
+	)
+})
@@ -3,11 +3,13 @@ package migrationkit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"go.uber.org/zap"
 )
@@ -29,6 +31,9 @@ type MigrationConfig struct {
 	URL string `long:"url" env:"URL" description:"the database url" required:"true"`
 }
 
+// ErrNotMigrated means the database is not migrated to the latest version of the source.
+var ErrNotMigrated = errors.New("database is not migrated")
+
 type Migration struct {
 	*migrate.Migrate
 	logger *logkit.Logger
@@ -83,3 +88,69 @@ func NewMigration(ctx context.Context, conf *MigrationConfig) *Migration {
 		logger:  logger,
 	}
 }
+
+// CheckMigrated returns ErrNotMigrated unless the database is migrated to the latest version of the source and is not dirty.
+// Unlike NewMigration, it returns the error instead of exiting, so the services can check it on start until the migration job is done.
+func CheckMigrated(conf *MigrationConfig) error {
+	url := os.ExpandEnv(conf.URL)
+	if url == "" {
+		url = conf.URL
+	}
+
+	latest, err := latestVersion(conf.Source)
+	if err != nil {
+		return err
+	}
+
+	m, err := migrate.New(conf.Source, url)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = m.Close()
+	}()
+
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("%w: no version is applied, latest version %d", ErrNotMigrated, latest)
+	}
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("%w: version %d is dirty", ErrNotMigrated, version)
+	}
+
+	if version < latest {
+		return fmt.Errorf("%w: version %d is behind latest version %d", ErrNotMigrated, version, latest)
+	}
+
+	return nil
+}
+
+// latestVersion returns the last version of the migration files in the source.
+func latestVersion(sourceURL string) (uint, error) {
+	drv, err := source.Open(sourceURL)
+	if err != nil {
+		return 0, err
+	}
+	defer drv.Close()
+
+	version, err := drv.First()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := drv.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		version = next
+	}
+}
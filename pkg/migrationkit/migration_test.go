@@ -3,6 +3,7 @@ package migrationkit
 import (
 	"context"
 	"os"
+	"path/filepath"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
@@ -50,4 +51,32 @@ var _ = Describe("Migration", func() {
 			})
 		})
 	})
+
+	Describe("CheckMigrated", func() {
+		var migrationConf *MigrationConfig
+
+		BeforeEach(func() {
+			url := "postgres://postgres@postgres:5432/postgres?sslmode=disable"
+			if envURL := os.Getenv("POSTGRES_URL"); envURL != "" {
+				url = envURL
+			}
+
+			// the version is later than any applied one, so the database is behind the source
+			dir := GinkgoT().TempDir()
+			for _, name := range []string{"99991231000000_unapplied.up.sql", "99991231000000_unapplied.down.sql"} {
+				Expect(os.WriteFile(filepath.Join(dir, name), []byte("SELECT 1;"), 0o600)).To(Succeed())
+			}
+
+			migrationConf = &MigrationConfig{
+				Source: "file://" + dir,
+				URL:    url,
+			}
+		})
+
+		When("the latest version is not applied", func() {
+			It("returns not migrated error", func() {
+				Expect(CheckMigrated(migrationConf)).To(MatchError(ErrNotMigrated))
+			})
+		})
+	})
 })
@@ -0,0 +1,463 @@
+package migrationkit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/go-pg/pg/v10"
+	"go.uber.org/zap"
+)
+
+// Stage is the stage of an online schema change. A column of a live table is changed without downtime by
+//
+//  1. adding the new column as nullable with a regular migration
+//  2. StageDualWrite: the services write both the columns and still read the old one
+//  3. Backfill copies the old column to the new one for the existing rows, and Verify checks that no row differs
+//  4. StageReadNew: the services read the new column, and still write both so that the change can be rolled back
+//  5. StageComplete: the services stop writing the old column, which is dropped with a regular migration
+//
+// The stages are stored in the schema_changes table and moved one step at a time with SetStage, the services
+// follow them with SchemaChangeFlags.
+type Stage string
+
+const (
+	StageOff       Stage = "off"
+	StageDualWrite Stage = "dual_write"
+	StageReadNew   Stage = "read_new"
+	StageComplete  Stage = "complete"
+)
+
+var stages = []Stage{StageOff, StageDualWrite, StageReadNew, StageComplete}
+
+var (
+	ErrInvalidStage      = errors.New("invalid schema change stage")
+	ErrInvalidTransition = errors.New("invalid schema change stage transition")
+	ErrNotVerified       = errors.New("schema change is not verified since dual write started")
+	ErrNotDualWriting    = errors.New("schema change is not dual writing")
+)
+
+// ParseStage parses the name of the stage.
+func ParseStage(s string) (Stage, error) {
+	for _, stage := range stages {
+		if string(stage) == s {
+			return stage, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidStage, s)
+}
+
+func (s Stage) String() string {
+	return string(s)
+}
+
+// WriteOld reports whether the old column is written in the stage.
+func (s Stage) WriteOld() bool {
+	return s != StageComplete
+}
+
+// WriteNew reports whether the new column is written in the stage.
+func (s Stage) WriteNew() bool {
+	return s != StageOff
+}
+
+// ReadNew reports whether the new column is read in the stage.
+func (s Stage) ReadNew() bool {
+	return s == StageReadNew || s == StageComplete
+}
+
+func (s Stage) index() int {
+	for i, stage := range stages {
+		if stage == s {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// SchemaChange describes how the rows of a table are backfilled and verified. The assignments and the conditions
+// are SQL fragments of the table, which must come from the code and never from the input.
+type SchemaChange struct {
+	// Name identifies the stage of the change, e.g. comments.content_v2
+	Name  string
+	Table string
+	// Key is the unique column to page through the table with, id if empty
+	Key string
+	// Set copies the old column to the new one, e.g. content_v2 = content
+	Set string
+	// Pending matches the rows to backfill, e.g. content_v2 IS NULL AND content IS NOT NULL,
+	// it must not match the rows once Set is applied, or the backfill never ends
+	Pending string
+	// Mismatch matches the rows whose new column differs from the old one, e.g. content_v2 IS DISTINCT FROM content
+	Mismatch string
+}
+
+func (c *SchemaChange) key() string {
+	if c.Key == "" {
+		return "id"
+	}
+
+	return c.Key
+}
+
+const createSchemaChangeTableQuery = `CREATE TABLE IF NOT EXISTS schema_changes (
+	name text PRIMARY KEY,
+	stage text NOT NULL,
+	updated_at timestamptz NOT NULL DEFAULT now(),
+	verified_at timestamptz
+)`
+
+// schemaChange is a row of the schema_changes table, the table is created on demand like the schema_migrations
+// table of the migrations, so the services sharing a database share the stages.
+type schemaChange struct {
+	Name       string
+	Stage      Stage
+	UpdatedAt  time.Time
+	VerifiedAt time.Time
+}
+
+func createSchemaChangeTable(ctx context.Context, client *pgkit.PGClient) error {
+	_, err := client.ExecContext(ctx, createSchemaChangeTableQuery)
+	return err
+}
+
+// ListStages returns the stages of the schema changes, the changes not listed are off.
+func ListStages(ctx context.Context, client *pgkit.PGClient) (map[string]Stage, error) {
+	if err := createSchemaChangeTable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	var changes []*schemaChange
+	if _, err := client.QueryContext(ctx, &changes, "SELECT name, stage FROM schema_changes"); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]Stage, len(changes))
+	for _, change := range changes {
+		result[change.Name] = change.Stage
+	}
+
+	return result, nil
+}
+
+// SetStage moves the schema change one stage forward or backward and returns the previous stage. Moving to
+// StageReadNew requires a successful Verify started after the dual write did, since the reads switch to the new column.
+func SetStage(ctx context.Context, client *pgkit.PGClient, name string, stage Stage) (Stage, error) {
+	if stage.index() < 0 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidStage, stage)
+	}
+
+	if err := createSchemaChangeTable(ctx, client); err != nil {
+		return "", err
+	}
+
+	var previous Stage
+	err := client.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_changes (name, stage) VALUES (?, ?) ON CONFLICT DO NOTHING", name, StageOff); err != nil {
+			return err
+		}
+
+		var change schemaChange
+		if _, err := tx.QueryOneContext(ctx, &change,
+			"SELECT name, stage, updated_at, verified_at FROM schema_changes WHERE name = ? FOR UPDATE", name); err != nil {
+			return err
+		}
+		previous = change.Stage
+
+		if distance := stage.index() - change.Stage.index(); distance > 1 || distance < -1 {
+			return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, change.Stage, stage)
+		}
+
+		if change.Stage == StageDualWrite && stage == StageReadNew && !change.VerifiedAt.After(change.UpdatedAt) {
+			return ErrNotVerified
+		}
+
+		_, err := tx.ExecContext(ctx, "UPDATE schema_changes SET stage = ?, updated_at = now() WHERE name = ?", stage, name)
+		return err
+	})
+
+	return previous, err
+}
+
+// getStage returns the stage of the schema change along with the time it is set.
+func getStage(ctx context.Context, client *pgkit.PGClient, name string) (*schemaChange, error) {
+	if err := createSchemaChangeTable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	change := &schemaChange{Name: name, Stage: StageOff}
+	if _, err := client.QueryOneContext(ctx, change,
+		"SELECT name, stage, updated_at, verified_at FROM schema_changes WHERE name = ?", name); err != nil && !errors.Is(err, pg.ErrNoRows) {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// SchemaChangeFlags keeps the stages of the schema changes for the services, the stages are reloaded periodically,
+// so the instances may disagree for a refresh interval after a stage is set. Each stage is compatible with its
+// neighbours, e.g. a row written by an instance still in StageOff is backfilled later, which is why a stage
+// is moved only after all the instances have picked up the previous one.
+type SchemaChangeFlags struct {
+	client *pgkit.PGClient
+	logger *logkit.Logger
+
+	mu     sync.RWMutex
+	stages map[string]Stage
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewSchemaChangeFlags(ctx context.Context, client *pgkit.PGClient, refreshInterval time.Duration) *SchemaChangeFlags {
+	logger := logkit.FromContext(ctx)
+
+	f := &SchemaChangeFlags{
+		client: client,
+		logger: logger,
+		done:   make(chan struct{}),
+	}
+
+	if err := f.refresh(ctx); err != nil {
+		logger.Fatal("failed to load schema change stages", zap.Error(err))
+	}
+
+	ctx, f.cancel = context.WithCancel(ctx)
+	go f.run(ctx, refreshInterval)
+
+	return f
+}
+
+// Stage returns the stage of the schema change, StageOff if it is not started.
+func (f *SchemaChangeFlags) Stage(name string) Stage {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if stage, ok := f.stages[name]; ok {
+		return stage
+	}
+
+	return StageOff
+}
+
+// Close stops reloading the stages.
+func (f *SchemaChangeFlags) Close() error {
+	f.cancel()
+	<-f.done
+
+	return nil
+}
+
+func (f *SchemaChangeFlags) run(ctx context.Context, interval time.Duration) {
+	defer close(f.done)
+
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// the last loaded stages are kept if the database fails
+			if err := f.refresh(ctx); err != nil {
+				f.logger.Warn("failed to reload schema change stages", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (f *SchemaChangeFlags) refresh(ctx context.Context) error {
+	stages, err := ListStages(ctx, f.client)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.stages = stages
+	f.mu.Unlock()
+
+	return nil
+}
+
+type BackfillConfig struct {
+	BatchSize        int           `long:"batch_size" env:"BATCH_SIZE" description:"the maximum number of the rows updated by a batch" default:"1000"`
+	Pause            time.Duration `long:"pause" env:"PAUSE" description:"the pause between the batches to leave room for the live traffic" default:"100ms"`
+	MaxBatchDuration time.Duration `long:"max_batch_duration" env:"MAX_BATCH_DURATION" description:"the batch size is halved when a batch is slower, and grows back when faster" default:"1s"`
+	VerifyBatchSize  int           `long:"verify_batch_size" env:"VERIFY_BATCH_SIZE" description:"the number of the rows checked by a batch of the verification" default:"10000"`
+}
+
+// Validate reports whether the batch sizes are valid.
+func (c *BackfillConfig) Validate() error {
+	if c.BatchSize <= 0 || c.VerifyBatchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d and verify batch size %d", c.BatchSize, c.VerifyBatchSize)
+	}
+
+	return nil
+}
+
+// Backfill applies the assignments of the schema change to the pending rows batch by batch until none is left,
+// and returns the number of the updated rows. Each batch is a short statement skipping the rows locked by the
+// live traffic, and the batches are throttled by the pause and the maximum batch duration. The schema change
+// must be dual writing, or the rows written during the backfill are left behind.
+func Backfill(ctx context.Context, client *pgkit.PGClient, change *SchemaChange, conf *BackfillConfig) (int, error) {
+	logger := logkit.FromContext(ctx).With(zap.String("schema_change", change.Name))
+
+	current, err := getStage(ctx, client, change.Name)
+	if err != nil {
+		return 0, err
+	}
+	if !current.Stage.WriteNew() {
+		return 0, ErrNotDualWriting
+	}
+
+	key := pg.Ident(change.key())
+	batchSize := conf.BatchSize
+	updated := 0
+
+	for {
+		start := time.Now()
+
+		res, err := client.ExecContext(ctx,
+			"UPDATE ? SET ? WHERE ? IN (SELECT ? FROM ? WHERE ? LIMIT ? FOR UPDATE SKIP LOCKED)",
+			pg.Ident(change.Table), pg.Safe(change.Set), key, key, pg.Ident(change.Table), pg.Safe(change.Pending), batchSize)
+		if err != nil {
+			return updated, err
+		}
+
+		rows := res.RowsAffected()
+		updated += rows
+
+		elapsed := time.Since(start)
+		logger.Info("backfill batch", zap.Int("rows", rows), zap.Int("total", updated), zap.Int("batch_size", batchSize), zap.Duration("elapsed", elapsed))
+
+		if rows == 0 {
+			return updated, nil
+		}
+
+		batchSize = nextBatchSize(batchSize, conf.BatchSize, elapsed, conf.MaxBatchDuration)
+
+		select {
+		case <-ctx.Done():
+			return updated, ctx.Err()
+		case <-time.After(conf.Pause):
+		}
+	}
+}
+
+// nextBatchSize halves the batch size if the batch is slower than the maximum duration, and doubles it up to
+// the maximum batch size if the batch is faster than half of it.
+func nextBatchSize(batchSize, maxBatchSize int, elapsed, maxDuration time.Duration) int {
+	if maxDuration <= 0 {
+		return batchSize
+	}
+
+	switch {
+	case elapsed > maxDuration && batchSize > 1:
+		return batchSize / 2
+	case elapsed < maxDuration/2 && batchSize < maxBatchSize:
+		if batchSize*2 > maxBatchSize {
+			return maxBatchSize
+		}
+
+		return batchSize * 2
+	default:
+		return batchSize
+	}
+}
+
+// VerifyResult is the result of the verification of a schema change.
+type VerifyResult struct {
+	Checked    int
+	Mismatched int
+	// Samples are the keys of the first mismatched rows
+	Samples []string
+}
+
+// maxVerifySamples is the number of the keys of the mismatched rows kept by the verification
+const maxVerifySamples = 10
+
+// Verify pages through the table by the key and counts the rows matching the mismatch condition. When no row
+// differs, the verification is recorded so that the schema change can be moved to StageReadNew. The schema change
+// must be dual writing, so that the rows do not drift apart again after the verification.
+func Verify(ctx context.Context, client *pgkit.PGClient, change *SchemaChange, batchSize int) (*VerifyResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("invalid verify batch size %d", batchSize)
+	}
+
+	current, err := getStage(ctx, client, change.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Stage.WriteNew() {
+		return nil, ErrNotDualWriting
+	}
+
+	// the verification is recorded as of its start, the rows written later are dual written
+	var startedAt time.Time
+	if _, err := client.QueryOneContext(ctx, pg.Scan(&startedAt), "SELECT now()"); err != nil {
+		return nil, err
+	}
+
+	type verifyRow struct {
+		Key      string
+		Mismatch bool
+	}
+
+	key := pg.Ident(change.key())
+	result := &VerifyResult{}
+
+	var after *string
+	for {
+		var rows []*verifyRow
+
+		var err error
+		if after == nil {
+			_, err = client.QueryContext(ctx, &rows, "SELECT ?::text AS key, (?) AS mismatch FROM ? ORDER BY ? LIMIT ?",
+				key, pg.Safe(change.Mismatch), pg.Ident(change.Table), key, batchSize)
+		} else {
+			_, err = client.QueryContext(ctx, &rows, "SELECT ?::text AS key, (?) AS mismatch FROM ? WHERE ? > ? ORDER BY ? LIMIT ?",
+				key, pg.Safe(change.Mismatch), pg.Ident(change.Table), key, *after, key, batchSize)
+		}
+		if err != nil {
+			return result, err
+		}
+
+		for _, row := range rows {
+			result.Checked++
+
+			if row.Mismatch {
+				result.Mismatched++
+				if len(result.Samples) < maxVerifySamples {
+					result.Samples = append(result.Samples, row.Key)
+				}
+			}
+		}
+
+		if len(rows) < batchSize {
+			break
+		}
+
+		after = &rows[len(rows)-1].Key
+	}
+
+	if result.Mismatched > 0 {
+		return result, nil
+	}
+
+	if _, err := client.ExecContext(ctx, "UPDATE schema_changes SET verified_at = ? WHERE name = ?", startedAt, change.Name); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
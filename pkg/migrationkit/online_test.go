@@ -0,0 +1,153 @@
+package migrationkit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/go-pg/pg/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stage", func() {
+	It("parses the stages", func() {
+		Expect(ParseStage("read_new")).To(Equal(StageReadNew))
+
+		_, err := ParseStage("unknown")
+		Expect(err).To(MatchError(ErrInvalidStage))
+	})
+
+	It("writes and reads the columns of the stage", func() {
+		Expect(StageOff.WriteNew()).To(BeFalse())
+		Expect(StageDualWrite.WriteOld()).To(BeTrue())
+		Expect(StageDualWrite.WriteNew()).To(BeTrue())
+		Expect(StageDualWrite.ReadNew()).To(BeFalse())
+		Expect(StageReadNew.WriteOld()).To(BeTrue())
+		Expect(StageReadNew.ReadNew()).To(BeTrue())
+		Expect(StageComplete.WriteOld()).To(BeFalse())
+	})
+})
+
+var _ = Describe("nextBatchSize", func() {
+	It("halves the batch size when the batch is slow", func() {
+		Expect(nextBatchSize(1000, 1000, 2*time.Second, time.Second)).To(Equal(500))
+		Expect(nextBatchSize(1, 1000, 2*time.Second, time.Second)).To(Equal(1))
+	})
+
+	It("grows the batch size back up to the maximum when the batch is fast", func() {
+		Expect(nextBatchSize(500, 1000, 100*time.Millisecond, time.Second)).To(Equal(1000))
+		Expect(nextBatchSize(700, 1000, 100*time.Millisecond, time.Second)).To(Equal(1000))
+		Expect(nextBatchSize(1000, 1000, 100*time.Millisecond, time.Second)).To(Equal(1000))
+	})
+
+	It("keeps the batch size without the maximum duration", func() {
+		Expect(nextBatchSize(1000, 1000, time.Hour, 0)).To(Equal(1000))
+	})
+})
+
+var _ = Describe("SchemaChange", func() {
+	var (
+		ctx      context.Context
+		pgClient *pgkit.PGClient
+		table    string
+		change   *SchemaChange
+		conf     *BackfillConfig
+	)
+
+	BeforeEach(func() {
+		pgConf := &pgkit.PGConfig{
+			URL: "postgres://postgres@postgres:5432/postgres?sslmode=disable",
+		}
+		if url := os.Getenv("POSTGRES_URL"); url != "" {
+			pgConf.URL = url
+		}
+
+		ctx = logkit.NewLogger(&logkit.LoggerConfig{
+			Development: true,
+		}).WithContext(context.Background())
+
+		pgClient = pgkit.NewPGClient(ctx, pgConf)
+
+		// the table and the change are of the test only
+		table = fmt.Sprintf("online_change_%d", time.Now().UnixNano())
+		_, err := pgClient.ExecContext(ctx, "CREATE TABLE ? (id serial PRIMARY KEY, content text NOT NULL, content_v2 text)", pg.Ident(table))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pgClient.ExecContext(ctx, "INSERT INTO ? (content) SELECT 'comment ' || i FROM generate_series(1, 5) AS i", pg.Ident(table))
+		Expect(err).NotTo(HaveOccurred())
+
+		change = &SchemaChange{
+			Name:     table + ".content_v2",
+			Table:    table,
+			Set:      "content_v2 = content",
+			Pending:  "content_v2 IS NULL",
+			Mismatch: "content_v2 IS DISTINCT FROM content",
+		}
+		conf = &BackfillConfig{BatchSize: 2, MaxBatchDuration: time.Second, VerifyBatchSize: 2}
+	})
+
+	AfterEach(func() {
+		_, err := pgClient.ExecContext(ctx, "DROP TABLE ?", pg.Ident(table))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = pgClient.ExecContext(ctx, "DELETE FROM schema_changes WHERE name = ?", change.Name)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pgClient.Close()).To(Succeed())
+	})
+
+	When("the change is off", func() {
+		It("does not backfill or verify", func() {
+			_, err := Backfill(ctx, pgClient, change, conf)
+			Expect(err).To(MatchError(ErrNotDualWriting))
+
+			_, err = Verify(ctx, pgClient, change, conf.VerifyBatchSize)
+			Expect(err).To(MatchError(ErrNotDualWriting))
+		})
+
+		It("moves one stage at a time", func() {
+			_, err := SetStage(ctx, pgClient, change.Name, StageReadNew)
+			Expect(err).To(MatchError(ErrInvalidTransition))
+		})
+	})
+
+	When("the change is dual writing", func() {
+		BeforeEach(func() {
+			Expect(SetStage(ctx, pgClient, change.Name, StageDualWrite)).To(Equal(StageOff))
+		})
+
+		It("does not cut over before the verification", func() {
+			_, err := SetStage(ctx, pgClient, change.Name, StageReadNew)
+			Expect(err).To(MatchError(ErrNotVerified))
+		})
+
+		It("reports the mismatched rows before the backfill", func() {
+			result, err := Verify(ctx, pgClient, change, conf.VerifyBatchSize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Checked).To(Equal(5))
+			Expect(result.Mismatched).To(Equal(5))
+			Expect(result.Samples).To(HaveLen(5))
+		})
+
+		It("backfills, verifies and cuts over", func() {
+			Expect(Backfill(ctx, pgClient, change, conf)).To(Equal(5))
+
+			result, err := Verify(ctx, pgClient, change, conf.VerifyBatchSize)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Checked).To(Equal(5))
+			Expect(result.Mismatched).To(BeZero())
+
+			Expect(SetStage(ctx, pgClient, change.Name, StageReadNew)).To(Equal(StageDualWrite))
+
+			flags := NewSchemaChangeFlags(ctx, pgClient, 0)
+			defer func() {
+				Expect(flags.Close()).To(Succeed())
+			}()
+
+			Expect(flags.Stage(change.Name)).To(Equal(StageReadNew))
+			Expect(flags.Stage("unknown")).To(Equal(StageOff))
+		})
+	})
+})
@@ -3,8 +3,11 @@ package mongokit
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -12,8 +15,9 @@ import (
 )
 
 type MongoConfig struct {
-	URL      string `long:"url" env:"URL" description:"the URL of MongoDB" required:"true"`
-	Database string `long:"database" env:"DATABASE" description:"the database of MongoDB" required:"true"`
+	URL                string        `long:"url" env:"URL" description:"the URL of MongoDB" required:"true"`
+	Database           string        `long:"database" env:"DATABASE" description:"the database of MongoDB" required:"true"`
+	SlowQueryThreshold time.Duration `long:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD" description:"log the commands slower than it as warnings, every command is logged at debug level" default:"200ms"`
 }
 
 type MongoClient struct {
@@ -34,18 +38,42 @@ func (c *MongoClient) Close() error {
 	return c.Client.Disconnect(context.Background())
 }
 
-func NewMongoClient(ctx context.Context, conf *MongoConfig) *MongoClient {
+type mongoClientOptions struct {
+	meter *otelkit.PrometheusServiceMeter
+}
+
+type MongoClientOption func(opts *mongoClientOptions)
+
+// WithMeter measures the latency of the commands.
+func WithMeter(meter *otelkit.PrometheusServiceMeter) MongoClientOption {
+	return func(opts *mongoClientOptions) {
+		opts.meter = meter
+	}
+}
+
+func NewMongoClient(ctx context.Context, conf *MongoConfig, opts ...MongoClientOption) *MongoClient {
+	var mo mongoClientOptions
+	for _, opt := range opts {
+		opt(&mo)
+	}
+
 	if url := os.ExpandEnv(conf.URL); url != "" {
 		conf.URL = url
 	}
 
-	logger := logkit.FromContext(ctx).With(
+	baseLogger := logkit.FromContext(ctx)
+	logger := baseLogger.With(
 		zap.String("url", conf.URL),
 		zap.String("database", conf.Database),
 	)
 
 	o := options.Client()
 	o.ApplyURI(conf.URL)
+	monitors := []*event.CommandMonitor{newTracingMonitor(), newLoggingMonitor(baseLogger, conf.SlowQueryThreshold)}
+	if mo.meter != nil {
+		monitors = append(monitors, newMeteringMonitor(mo.meter))
+	}
+	o.SetMonitor(chainMonitors(monitors...))
 
 	client, err := mongo.NewClient(o)
 	if err != nil {
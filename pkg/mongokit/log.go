@@ -0,0 +1,87 @@
+package mongokit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.mongodb.org/mongo-driver/event"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// loggingMonitor logs every command at debug level and the commands slower than the threshold as warnings,
+// with the logger of the request in the context, so the commands are correlated with the request.
+// The command itself is not logged since it carries the user data.
+type loggingMonitor struct {
+	logger             *logkit.Logger
+	slowQueryThreshold time.Duration
+}
+
+func newLoggingMonitor(logger *logkit.Logger, slowQueryThreshold time.Duration) *event.CommandMonitor {
+	m := &loggingMonitor{
+		logger:             logger,
+		slowQueryThreshold: slowQueryThreshold,
+	}
+
+	return &event.CommandMonitor{
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			m.log(ctx, &evt.CommandFinishedEvent, nil)
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			m.log(ctx, &evt.CommandFinishedEvent, errors.New(evt.Failure))
+		},
+	}
+}
+
+func (m *loggingMonitor) log(ctx context.Context, evt *event.CommandFinishedEvent, err error) {
+	duration := time.Duration(evt.DurationNanos)
+
+	level, msg := zapcore.DebugLevel, "executed command"
+	if m.slowQueryThreshold > 0 && duration >= m.slowQueryThreshold {
+		level, msg = zapcore.WarnLevel, "executed slow command"
+	}
+
+	entry := logkit.FromContextOr(ctx, m.logger).Check(level, msg)
+	if entry == nil {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("command", evt.CommandName),
+		zap.Duration("duration", duration),
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	entry.Write(fields...)
+}
+
+// chainMonitors notifies every monitor of the commands in order, since the client takes a single monitor.
+func chainMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, evt)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, evt)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, evt)
+				}
+			}
+		},
+	}
+}
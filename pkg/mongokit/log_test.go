@@ -0,0 +1,58 @@
+package mongokit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/event"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var _ = Describe("loggingMonitor", func() {
+	var (
+		logs     *observer.ObservedLogs
+		monitor  *event.CommandMonitor
+		duration time.Duration
+	)
+
+	BeforeEach(func() {
+		var core zapcore.Core
+		core, logs = observer.New(zapcore.DebugLevel)
+		monitor = newLoggingMonitor(&logkit.Logger{Logger: zap.New(core)}, 100*time.Millisecond)
+	})
+
+	JustBeforeEach(func() {
+		monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{
+			CommandFinishedEvent: event.CommandFinishedEvent{
+				CommandName:   "find",
+				DurationNanos: duration.Nanoseconds(),
+			},
+		})
+	})
+
+	When("the command is fast", func() {
+		BeforeEach(func() { duration = 10 * time.Millisecond })
+
+		It("logs the command at debug level", func() {
+			entries := logs.FilterMessage("executed command").All()
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Level).To(Equal(zapcore.DebugLevel))
+			Expect(entries[0].ContextMap()).To(HaveKeyWithValue("command", "find"))
+		})
+	})
+
+	When("the command is slow", func() {
+		BeforeEach(func() { duration = time.Second })
+
+		It("logs the command as a warning", func() {
+			entries := logs.FilterMessage("executed slow command").All()
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Level).To(Equal(zapcore.WarnLevel))
+		})
+	})
+})
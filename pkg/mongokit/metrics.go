@@ -0,0 +1,22 @@
+package mongokit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// newMeteringMonitor measures the latency of each command by the command name.
+func newMeteringMonitor(meter *otelkit.PrometheusServiceMeter) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			meter.ObserveQuery(ctx, "mongodb", evt.CommandName, time.Duration(evt.DurationNanos), nil)
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			meter.ObserveQuery(ctx, "mongodb", evt.CommandName, time.Duration(evt.DurationNanos), errors.New(evt.Failure))
+		},
+	}
+}
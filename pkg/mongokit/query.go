@@ -0,0 +1,70 @@
+package mongokit
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Page is the limit and skip of a list query, a non-positive limit means no limit.
+type Page struct {
+	Limit int64
+	Skip  int64
+}
+
+// Order is a sort field of a list query.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+func Asc(field string) Order {
+	return Order{Field: field}
+}
+
+func Desc(field string) Order {
+	return Order{Field: field, Desc: true}
+}
+
+// FindOptions returns the find options of the page sorted by the orders.
+func FindOptions(page Page, orders ...Order) *options.FindOptions {
+	o := options.Find()
+
+	if page.Limit > 0 {
+		o.SetLimit(page.Limit)
+	}
+
+	if page.Skip > 0 {
+		o.SetSkip(page.Skip)
+	}
+
+	if len(orders) > 0 {
+		sort := make(bson.D, 0, len(orders))
+		for _, order := range orders {
+			direction := 1
+			if order.Desc {
+				direction = -1
+			}
+
+			sort = append(sort, bson.E{Key: order.Field, Value: direction})
+		}
+
+		o.SetSort(sort)
+	}
+
+	return o
+}
+
+// DeletedAtField is the field marking a document as soft deleted when set.
+const DeletedAtField = "deleted_at"
+
+// NotDeleted returns a copy of the filter which also filters out the soft deleted documents.
+func NotDeleted(filter bson.M) bson.M {
+	f := make(bson.M, len(filter)+1)
+	for k, v := range filter {
+		f[k] = v
+	}
+
+	f[DeletedAtField] = bson.M{"$exists": false}
+
+	return f
+}
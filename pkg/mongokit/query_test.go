@@ -0,0 +1,36 @@
+package mongokit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var _ = Describe("Query", func() {
+	Describe("FindOptions", func() {
+		It("ignores non-positive limit and skip", func() {
+			o := FindOptions(Page{Limit: 0, Skip: -1})
+
+			Expect(o.Limit).To(BeNil())
+			Expect(o.Skip).To(BeNil())
+			Expect(o.Sort).To(BeNil())
+		})
+
+		It("applies the page and orders", func() {
+			o := FindOptions(Page{Limit: 10, Skip: 20}, Asc("_id"), Desc("created_at"))
+
+			Expect(*o.Limit).To(Equal(int64(10)))
+			Expect(*o.Skip).To(Equal(int64(20)))
+			Expect(o.Sort).To(Equal(bson.D{{Key: "_id", Value: 1}, {Key: "created_at", Value: -1}}))
+		})
+	})
+
+	Describe("NotDeleted", func() {
+		It("returns a copy of the filter excluding soft deleted documents", func() {
+			filter := bson.M{"status": "success"}
+
+			Expect(NotDeleted(filter)).To(Equal(bson.M{"status": "success", "deleted_at": bson.M{"$exists": false}}))
+			Expect(filter).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package mongokit
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingMonitor starts a client span of each command, the command itself is not recorded since it carries the user data.
+type tracingMonitor struct {
+	// spans are the spans of the running commands by the request IDs
+	spans sync.Map
+}
+
+func newTracingMonitor() *event.CommandMonitor {
+	m := &tracingMonitor{}
+
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *tracingMonitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemMongoDB,
+		semconv.DBNameKey.String(evt.DatabaseName),
+		semconv.DBOperationKey.String(evt.CommandName),
+	}
+
+	// the value of the command name is the collection, e.g. {"find": "video", ...}
+	if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+		attributes = append(attributes, semconv.DBMongoDBCollectionKey.String(collection))
+	}
+
+	_, span := otelkit.Tracer().Start(ctx, evt.CommandName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	m.spans.Store(evt.RequestID, span)
+}
+
+func (m *tracingMonitor) succeeded(_ context.Context, evt *event.CommandSucceededEvent) {
+	m.end(evt.RequestID, nil)
+}
+
+func (m *tracingMonitor) failed(_ context.Context, evt *event.CommandFailedEvent) {
+	m.end(evt.RequestID, errors.New(evt.Failure))
+}
+
+func (m *tracingMonitor) end(requestID int64, err error) {
+	if span, ok := m.spans.LoadAndDelete(requestID); ok {
+		otelkit.EndSpan(span.(trace.Span), err)
+	}
+}
@@ -0,0 +1,125 @@
+package natskit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+type NATSConsumerConfig struct {
+	URL       string        `long:"url" env:"URL" description:"the URL of the NATS server" default:"nats://localhost:4222"`
+	Stream    string        `long:"stream" env:"STREAM" description:"the JetStream stream storing the subject, created if not exists"`
+	Subject   string        `long:"subject" env:"SUBJECT" description:"the subject for the NATS consumer to consume"`
+	Durable   string        `long:"durable" env:"DURABLE" description:"the name of the durable JetStream consumer, shared by the consumers of the same group"`
+	BatchSize int           `long:"batch_size" env:"BATCH_SIZE" description:"the maximum number of the messages fetched at once" default:"10"`
+	MaxWait   time.Duration `long:"max_wait" env:"MAX_WAIT" description:"how long a fetch waits for the messages" default:"5s"`
+}
+
+// NATSConsumer consumes a JetStream subject with a durable pull consumer. The fetched batches are
+// handed to the sarama consumer group handlers, so the same handlers serve both Kafka and NATS.
+type NATSConsumer struct {
+	conn *nats.Conn
+	sub  *nats.Subscription
+
+	subject   string
+	batchSize int
+	maxWait   time.Duration
+	logger    *logkit.Logger
+}
+
+// Consume runs a consumer group session for every fetched batch until the context is done.
+// The messages not marked by the handler, like the ones failing with retryable errors,
+// are negatively acked so that JetStream redelivers them.
+func (nc *NATSConsumer) Consume(ctx context.Context, handler sarama.ConsumerGroupHandler) error {
+	for {
+		fetchCtx, cancel := context.WithTimeout(ctx, nc.maxWait)
+		msgs, err := nc.sub.Fetch(nc.batchSize, nats.Context(fetchCtx))
+		cancel()
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, nats.ErrTimeout) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := nc.consumeBatch(ctx, handler, msgs); err != nil {
+			return err
+		}
+	}
+}
+
+func (nc *NATSConsumer) consumeBatch(ctx context.Context, handler sarama.ConsumerGroupHandler, msgs []*nats.Msg) error {
+	sess := newSession(ctx, nc.logger)
+	claim := newClaim(nc.subject, msgs, sess)
+
+	if err := handler.Setup(sess); err != nil {
+		return err
+	}
+
+	consumeErr := handler.ConsumeClaim(sess, claim)
+	cleanupErr := handler.Cleanup(sess)
+
+	sess.nakUnmarked()
+
+	if consumeErr != nil {
+		return consumeErr
+	}
+
+	return cleanupErr
+}
+
+func (nc *NATSConsumer) Close() error {
+	return nc.conn.Drain()
+}
+
+func NewNATSConsumer(ctx context.Context, conf *NATSConsumerConfig) *NATSConsumer {
+	logger := logkit.FromContext(ctx).With(
+		zap.String("url", conf.URL),
+		zap.String("stream", conf.Stream),
+		zap.String("subject", conf.Subject),
+		zap.String("durable", conf.Durable),
+	)
+
+	if conf.Stream == "" || conf.Subject == "" || conf.Durable == "" {
+		logger.Fatal("the stream, subject and durable of the NATS consumer are required")
+	}
+
+	conn, err := nats.Connect(conf.URL)
+	if err != nil {
+		logger.Fatal("failed to connect to NATS", zap.Error(err))
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		logger.Fatal("failed to create JetStream context", zap.Error(err))
+	}
+
+	if err := ensureStream(js, conf.Stream, conf.Subject); err != nil {
+		logger.Fatal("failed to ensure JetStream stream", zap.Error(err))
+	}
+
+	sub, err := js.PullSubscribe(conf.Subject, conf.Durable, nats.BindStream(conf.Stream))
+	if err != nil {
+		logger.Fatal("failed to subscribe to JetStream subject", zap.Error(err))
+	}
+
+	logger.Info("create NATS consumer successfully")
+
+	return &NATSConsumer{
+		conn:      conn,
+		sub:       sub,
+		subject:   conf.Subject,
+		batchSize: conf.BatchSize,
+		maxWait:   conf.MaxWait,
+		logger:    logger,
+	}
+}
@@ -0,0 +1,13 @@
+package natskit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNATSKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test NATS Kit")
+}
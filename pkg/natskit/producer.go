@@ -0,0 +1,116 @@
+package natskit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/nats-io/nats.go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// KeyHeader is the header carrying the key of the messages, since JetStream messages have no keys
+const KeyHeader = "event-key"
+
+type NATSProducerConfig struct {
+	URL     string `long:"url" env:"URL" description:"the URL of the NATS server" default:"nats://localhost:4222"`
+	Stream  string `long:"stream" env:"STREAM" description:"the JetStream stream storing the subject, created if not exists"`
+	Subject string `long:"subject" env:"SUBJECT" description:"the subject for the NATS producer to publish"`
+}
+
+// NATSProducer publishes the messages to a JetStream subject, it is the lightweight
+// alternative of KafkaProducer for the clusters that cannot afford running Kafka.
+type NATSProducer struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+
+	subject string
+}
+
+var _ kafkakit.Producer = (*NATSProducer)(nil)
+
+// SendMessages publishes the messages in order and waits for the acks of JetStream,
+// the messages published before the failed one are not rolled back.
+func (np *NATSProducer) SendMessages(ctx context.Context, msgs []*kafkakit.ProducerMessage) error {
+	ctx, span := otelkit.Tracer().Start(ctx, np.subject+" send",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKey.String("nats"),
+			semconv.MessagingDestinationKey.String(np.subject),
+			semconv.MessagingDestinationKindTopic,
+		),
+	)
+
+	for _, msg := range msgs {
+		traced := *msg
+		traced.Headers = kafkakit.TraceHeaders(ctx, msg.Headers)
+
+		if _, err := np.js.PublishMsg(newNATSMsg(np.subject, &traced), nats.Context(ctx)); err != nil {
+			otelkit.EndSpan(span, err)
+
+			return err
+		}
+	}
+
+	otelkit.EndSpan(span, nil)
+
+	return nil
+}
+
+func (np *NATSProducer) Close() error {
+	return np.conn.Drain()
+}
+
+func NewNATSProducer(ctx context.Context, conf *NATSProducerConfig) *NATSProducer {
+	logger := logkit.FromContext(ctx).With(
+		zap.String("url", conf.URL),
+		zap.String("stream", conf.Stream),
+		zap.String("subject", conf.Subject),
+	)
+
+	if conf.Stream == "" || conf.Subject == "" {
+		logger.Fatal("the stream and subject of the NATS producer are required")
+	}
+
+	conn, err := nats.Connect(conf.URL)
+	if err != nil {
+		logger.Fatal("failed to connect to NATS", zap.Error(err))
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		logger.Fatal("failed to create JetStream context", zap.Error(err))
+	}
+
+	if err := ensureStream(js, conf.Stream, conf.Subject); err != nil {
+		logger.Fatal("failed to ensure JetStream stream", zap.Error(err))
+	}
+
+	logger.Info("create NATS producer successfully")
+
+	return &NATSProducer{
+		conn:    conn,
+		js:      js,
+		subject: conf.Subject,
+	}
+}
+
+func newNATSMsg(subject string, msg *kafkakit.ProducerMessage) *nats.Msg {
+	header := nats.Header{}
+	for key, value := range msg.Headers {
+		header.Set(key, value)
+	}
+
+	if len(msg.Key) > 0 {
+		header.Set(KeyHeader, string(msg.Key))
+	}
+
+	return &nats.Msg{
+		Subject: subject,
+		Header:  header,
+		Data:    msg.Value,
+	}
+}
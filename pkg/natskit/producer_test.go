@@ -0,0 +1,39 @@
+package natskit
+
+import (
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("newNATSMsg", func() {
+	var msg *kafkakit.ProducerMessage
+
+	BeforeEach(func() {
+		msg = &kafkakit.ProducerMessage{
+			Key:     []byte("key"),
+			Value:   []byte("value"),
+			Headers: map[string]string{"event-type": "fake"},
+		}
+	})
+
+	It("returns the message with the key in the header", func() {
+		Expect(newNATSMsg("subject", msg)).To(Equal(&nats.Msg{
+			Subject: "subject",
+			Header: nats.Header{
+				"event-type": []string{"fake"},
+				KeyHeader:    []string{"key"},
+			},
+			Data: []byte("value"),
+		}))
+	})
+
+	When("key is absent", func() {
+		BeforeEach(func() { msg.Key = nil })
+
+		It("returns the message without the key header", func() {
+			Expect(newNATSMsg("subject", msg).Header).NotTo(HaveKey(KeyHeader))
+		})
+	})
+})
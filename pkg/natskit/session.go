@@ -0,0 +1,201 @@
+package natskit
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// session adapts a fetched batch to sarama.ConsumerGroupSession. The offsets of the messages
+// are their stream sequences, and marking an offset acks every pending message before it,
+// the same as committing an offset of Kafka.
+type session struct {
+	ctx    context.Context
+	logger *logkit.Logger
+
+	mu      sync.Mutex
+	pending map[int64]*nats.Msg
+
+	ack func(msg *nats.Msg) error
+	nak func(msg *nats.Msg) error
+}
+
+var _ sarama.ConsumerGroupSession = (*session)(nil)
+
+func newSession(ctx context.Context, logger *logkit.Logger) *session {
+	return &session{
+		ctx:     ctx,
+		logger:  logger,
+		pending: make(map[int64]*nats.Msg),
+		ack:     func(msg *nats.Msg) error { return msg.Ack() },
+		nak:     func(msg *nats.Msg) error { return msg.Nak() },
+	}
+}
+
+func (s *session) Claims() map[string][]int32 {
+	return nil
+}
+
+func (s *session) MemberID() string {
+	return ""
+}
+
+func (s *session) GenerationID() int32 {
+	return 0
+}
+
+func (s *session) MarkOffset(topic string, partition int32, offset int64, metadata string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, seq := range s.sortedPending() {
+		if seq >= offset {
+			break
+		}
+
+		if err := s.ack(s.pending[seq]); err != nil {
+			s.logger.Error("failed to ack NATS message", zap.Int64("sequence", seq), zap.Error(err))
+		}
+		delete(s.pending, seq)
+	}
+}
+
+func (s *session) Commit() {}
+
+func (s *session) ResetOffset(topic string, partition int32, offset int64, metadata string) {}
+
+func (s *session) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+func (s *session) Context() context.Context {
+	return s.ctx
+}
+
+// add adds the message of the stream sequence to the pending ones.
+func (s *session) add(seq int64, msg *nats.Msg) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending[seq] = msg
+}
+
+// nakUnmarked negatively acks the pending messages, so they are redelivered without waiting for the ack timeout.
+func (s *session) nakUnmarked() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, seq := range s.sortedPending() {
+		if err := s.nak(s.pending[seq]); err != nil {
+			s.logger.Error("failed to nak NATS message", zap.Int64("sequence", seq), zap.Error(err))
+		}
+		delete(s.pending, seq)
+	}
+}
+
+func (s *session) sortedPending() []int64 {
+	seqs := make([]int64, 0, len(s.pending))
+	for seq := range s.pending {
+		seqs = append(seqs, seq)
+	}
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+
+	return seqs
+}
+
+// claim adapts a fetched batch to sarama.ConsumerGroupClaim, the messages channel is closed
+// after the batch, so the handler returns once the batch is consumed.
+type claim struct {
+	topic    string
+	messages chan *sarama.ConsumerMessage
+	initial  int64
+	high     int64
+}
+
+var _ sarama.ConsumerGroupClaim = (*claim)(nil)
+
+func newClaim(topic string, msgs []*nats.Msg, sess *session) *claim {
+	c := &claim{
+		topic:    topic,
+		messages: make(chan *sarama.ConsumerMessage, len(msgs)),
+	}
+
+	for _, msg := range msgs {
+		meta, err := msg.Metadata()
+		if err != nil {
+			// not a JetStream message, which can be neither acked nor redelivered
+			sess.logger.Error("failed to get NATS message metadata", zap.Error(err))
+			continue
+		}
+
+		cmsg := newConsumerMessage(msg, meta)
+		if len(c.messages) == 0 {
+			c.initial = cmsg.Offset
+		}
+		c.high = cmsg.Offset + 1
+
+		sess.add(cmsg.Offset, msg)
+		c.messages <- cmsg
+	}
+	close(c.messages)
+
+	return c
+}
+
+func (c *claim) Topic() string {
+	return c.topic
+}
+
+func (c *claim) Partition() int32 {
+	return 0
+}
+
+func (c *claim) InitialOffset() int64 {
+	return c.initial
+}
+
+func (c *claim) HighWaterMarkOffset() int64 {
+	return c.high
+}
+
+func (c *claim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func newConsumerMessage(msg *nats.Msg, meta *nats.MsgMetadata) *sarama.ConsumerMessage {
+	// sort header keys so that the same message always produces the same record, like the producer
+	keys := make([]string, 0, len(msg.Header))
+	for key := range msg.Header {
+		if key != KeyHeader {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	headers := make([]*sarama.RecordHeader, 0, len(keys))
+	for _, key := range keys {
+		headers = append(headers, &sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(msg.Header.Get(key)),
+		})
+	}
+
+	var key []byte
+	if k := msg.Header.Get(KeyHeader); k != "" {
+		key = []byte(k)
+	}
+
+	return &sarama.ConsumerMessage{
+		Headers:   headers,
+		Timestamp: meta.Timestamp,
+		Key:       key,
+		Value:     msg.Data,
+		Topic:     msg.Subject,
+		Offset:    int64(meta.Sequence.Stream),
+	}
+}
@@ -0,0 +1,87 @@
+package natskit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/Shopify/sarama"
+	"github.com/nats-io/nats.go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("session", func() {
+	var (
+		sess  *session
+		acked []*nats.Msg
+		naked []*nats.Msg
+		msgs  []*nats.Msg
+	)
+
+	BeforeEach(func() {
+		acked, naked = nil, nil
+
+		sess = newSession(context.Background(), logkit.NewNopLogger())
+		sess.ack = func(msg *nats.Msg) error { acked = append(acked, msg); return nil }
+		sess.nak = func(msg *nats.Msg) error { naked = append(naked, msg); return nil }
+
+		msgs = []*nats.Msg{{Data: []byte("1")}, {Data: []byte("2")}, {Data: []byte("3")}}
+		for i, msg := range msgs {
+			sess.add(int64(i+1), msg)
+		}
+	})
+
+	// marked returns the consumer message of msgs[i]
+	marked := func(i int) *sarama.ConsumerMessage {
+		return &sarama.ConsumerMessage{Topic: "subject", Offset: int64(i + 1)}
+	}
+
+	It("acks the marked message and the ones before it", func() {
+		sess.MarkMessage(marked(1), "")
+		Expect(acked).To(Equal(msgs[:2]))
+
+		sess.MarkMessage(marked(2), "")
+		Expect(acked).To(Equal(msgs))
+	})
+
+	It("naks the unmarked messages", func() {
+		sess.MarkMessage(marked(0), "")
+		sess.nakUnmarked()
+
+		Expect(acked).To(Equal(msgs[:1]))
+		Expect(naked).To(Equal(msgs[1:]))
+	})
+})
+
+var _ = Describe("newConsumerMessage", func() {
+	It("returns the message with the key and headers sorted by key", func() {
+		timestamp := time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+		msg := &nats.Msg{
+			Subject: "subject",
+			Header: nats.Header{
+				"b":       []string{"2"},
+				"a":       []string{"1"},
+				KeyHeader: []string{"key"},
+			},
+			Data: []byte("value"),
+		}
+		meta := &nats.MsgMetadata{
+			Sequence:  nats.SequencePair{Stream: 10, Consumer: 1},
+			Timestamp: timestamp,
+		}
+
+		Expect(newConsumerMessage(msg, meta)).To(Equal(&sarama.ConsumerMessage{
+			Headers: []*sarama.RecordHeader{
+				{Key: []byte("a"), Value: []byte("1")},
+				{Key: []byte("b"), Value: []byte("2")},
+			},
+			Timestamp: timestamp,
+			Key:       []byte("key"),
+			Value:     []byte("value"),
+			Topic:     "subject",
+			Offset:    10,
+		}))
+	})
+})
@@ -0,0 +1,26 @@
+package natskit
+
+import (
+	"errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+// ensureStream creates the stream of the subject if it does not exist, the dev clusters
+// running NATS are provisioned by the services themselves instead of by the operators.
+func ensureStream(js nats.JetStreamContext, stream, subject string) error {
+	_, err := js.StreamInfo(stream)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     stream,
+		Subjects: []string{subject},
+	})
+
+	return err
+}
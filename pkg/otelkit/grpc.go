@@ -0,0 +1,160 @@
+package otelkit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerTracingInterceptor starts a server span of each request, which is the child of the span of the client
+// propagated through the metadata.
+func UnaryServerTracingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		endRPCSpan(span, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerTracingInterceptor starts a server span of each stream like UnaryServerTracingInterceptor,
+// the span ends when the stream is done.
+func StreamServerTracingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+
+		err := handler(srv, &tracingServerStream{ServerStream: ss, ctx: ctx})
+
+		endRPCSpan(span, err)
+
+		return err
+	}
+}
+
+// UnaryClientTracingInterceptor starts a client span of each request and propagates it to the server through the metadata.
+func UnaryClientTracingInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, method)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		endRPCSpan(span, err)
+
+		return err
+	}
+}
+
+// StreamClientTracingInterceptor starts a client span of each stream like UnaryClientTracingInterceptor,
+// the span ends when the stream is done, that is, the response of the server is received.
+func StreamClientTracingInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method)
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endRPCSpan(span, err)
+
+			return nil, err
+		}
+
+		return &tracingClientStream{ClientStream: cs, span: span, serverStreams: desc.ServerStreams}, nil
+	}
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	return Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+}
+
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	ctx, span := Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+
+	// the metadata is copied since it may be shared with the other requests
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func endRPCSpan(span trace.Span, err error) {
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int64(int64(status.Code(err))))
+
+	EndSpan(span, err)
+}
+
+// rpcAttributes returns the attributes of the full method, e.g. /video.pb.Video/GetVideo.
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{semconv.RPCSystemKey.String("grpc")}
+
+	if parts := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2); len(parts) == 2 {
+		attributes = append(attributes, semconv.RPCServiceKey.String(parts[0]), semconv.RPCMethodKey.String(parts[1]))
+	}
+
+	return attributes
+}
+
+type tracingServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *tracingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+type tracingClientStream struct {
+	grpc.ClientStream
+
+	span          trace.Span
+	serverStreams bool
+	once          sync.Once
+}
+
+func (s *tracingClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	switch {
+	case errors.Is(err, io.EOF):
+		s.end(nil)
+	case err != nil:
+		s.end(err)
+	case !s.serverStreams:
+		// the server sends a single response only
+		s.end(nil)
+	}
+
+	return err
+}
+
+func (s *tracingClientStream) end(err error) {
+	s.once.Do(func() {
+		endRPCSpan(s.span, err)
+	})
+}
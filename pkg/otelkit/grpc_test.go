@@ -0,0 +1,95 @@
+package otelkit
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Tracing interceptors", func() {
+	var (
+		recorder   *tracetest.SpanRecorder
+		fullMethod string
+		handlerErr error
+
+		serverSpanContext trace.SpanContext
+		err               error
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		fullMethod = "/video.pb.Video/GetVideo"
+		handlerErr = nil
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	JustBeforeEach(func() {
+		// the client sends the request to the server through the outgoing metadata
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+
+			_, err := UnaryServerTracingInterceptor()(metadata.NewIncomingContext(context.Background(), md), req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverSpanContext = trace.SpanContextFromContext(ctx)
+				return nil, handlerErr
+			})
+
+			return err
+		}
+
+		err = UnaryClientTracingInterceptor()(context.Background(), fullMethod, nil, nil, nil, invoker)
+	})
+
+	It("propagates the span of the client to the server", func() {
+		Expect(err).NotTo(HaveOccurred())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+
+		server, client := spans[0], spans[1]
+		Expect(client.SpanKind()).To(Equal(trace.SpanKindClient))
+		Expect(server.SpanKind()).To(Equal(trace.SpanKindServer))
+		Expect(server.Parent().SpanID()).To(Equal(client.SpanContext().SpanID()))
+		Expect(server.SpanContext().TraceID()).To(Equal(client.SpanContext().TraceID()))
+		Expect(serverSpanContext).To(Equal(server.SpanContext()))
+
+		Expect(server.Name()).To(Equal("video.pb.Video/GetVideo"))
+		Expect(server.Attributes()).To(ContainElements(
+			semconv.RPCServiceKey.String("video.pb.Video"),
+			semconv.RPCMethodKey.String("GetVideo"),
+			semconv.RPCGRPCStatusCodeKey.Int64(int64(grpccodes.OK)),
+		))
+	})
+
+	When("the handler fails", func() {
+		BeforeEach(func() {
+			handlerErr = status.Error(grpccodes.NotFound, "not found")
+		})
+
+		It("records the error in the spans", func() {
+			Expect(err).To(MatchError(handlerErr))
+
+			for _, span := range recorder.Ended() {
+				Expect(span.Status().Code).To(Equal(codes.Error))
+				Expect(span.Attributes()).To(ContainElement(semconv.RPCGRPCStatusCodeKey.Int64(int64(grpccodes.NotFound))))
+			}
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package otelkit
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewHTTPHandler starts a server span of each HTTP request, which is the child of the span of the client
+// propagated through the traceparent header, so the gRPC requests sent by the gateway join the trace of the browser.
+func NewHTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		// the path is not in the span name to keep the number of the span names small
+		ctx, span := Tracer().Start(ctx, "HTTP "+r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(r.Method),
+				semconv.HTTPTargetKey.String(r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
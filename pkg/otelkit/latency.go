@@ -0,0 +1,96 @@
+package otelkit
+
+import (
+	"context"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// latencyHistograms are the standard latency histograms of the services, in seconds. Each observation carries
+// the trace ID of the context as the OpenMetrics exemplar, so a slow bucket links to the traces of the requests in it.
+type latencyHistograms struct {
+	rpcServer *prom.HistogramVec
+	rpcClient *prom.HistogramVec
+	query     *prom.HistogramVec
+}
+
+func newLatencyHistograms(registerer prom.Registerer) (*latencyHistograms, error) {
+	h := &latencyHistograms{
+		rpcServer: prom.NewHistogramVec(prom.HistogramOpts{
+			Name:    "rpc_server_duration_seconds",
+			Help:    "measure the latency of the gRPC requests handled",
+			Buckets: prom.DefBuckets,
+		}, []string{"method", "code"}),
+		rpcClient: prom.NewHistogramVec(prom.HistogramOpts{
+			Name:    "rpc_client_duration_seconds",
+			Help:    "measure the latency of the gRPC requests sent to the other services",
+			Buckets: prom.DefBuckets,
+		}, []string{"method", "code"}),
+		query: prom.NewHistogramVec(prom.HistogramOpts{
+			Name:    "dao_query_duration_seconds",
+			Help:    "measure the latency of the queries and commands sent to the databases",
+			Buckets: prom.DefBuckets,
+		}, []string{"system", "operation", "error"}),
+	}
+
+	for _, collector := range []prom.Collector{h.rpcServer, h.rpcClient, h.query} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// UnaryClientInterceptor is a gRPC client-side interceptor that measures the latency of the requests to the other services.
+func (m *PrometheusServiceMeter) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		observe(ctx, m.latency.rpcClient.WithLabelValues(method, status.Code(err).String()), time.Since(start))
+
+		return err
+	}
+}
+
+// StreamServerInterceptor is a gRPC server-side interceptor that measures the latency of the streams.
+func (m *PrometheusServiceMeter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		observe(ss.Context(), m.latency.rpcServer.WithLabelValues(info.FullMethod, status.Code(err).String()), time.Since(start))
+
+		return err
+	}
+}
+
+// ObserveQuery measures the latency of a query or command of the database system, e.g. postgresql and SELECT.
+// It is called by the hooks of the database clients, so the DAOs are measured without changes.
+func (m *PrometheusServiceMeter) ObserveQuery(ctx context.Context, system, operation string, duration time.Duration, err error) {
+	failed := "false"
+	if err != nil {
+		failed = "true"
+	}
+
+	observe(ctx, m.latency.query.WithLabelValues(system, operation, failed), duration)
+}
+
+// observe records the duration along with the trace ID of the sampled span of the context as the exemplar.
+func observe(ctx context.Context, observer prom.Observer, duration time.Duration) {
+	spanContext := trace.SpanContextFromContext(ctx)
+
+	if exemplarObserver, ok := observer.(prom.ExemplarObserver); ok && spanContext.IsSampled() {
+		exemplarObserver.ObserveWithExemplar(duration.Seconds(), prom.Labels{"trace_id": spanContext.TraceID().String()})
+		return
+	}
+
+	observer.Observe(duration.Seconds())
+}
@@ -0,0 +1,73 @@
+package otelkit
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	prom "github.com/prometheus/client_golang/prometheus"
+	prompb "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("latencyHistograms", func() {
+	var (
+		registry *prom.Registry
+		latency  *latencyHistograms
+		ctx      context.Context
+
+		buckets []*prompb.Bucket
+	)
+
+	BeforeEach(func() {
+		registry = prom.NewRegistry()
+
+		var err error
+		latency, err = newLatencyHistograms(registry)
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	JustBeforeEach(func() {
+		observe(ctx, latency.query.WithLabelValues("postgresql", "SELECT", "false"), 3*time.Millisecond)
+
+		mfs, err := registry.Gather()
+		Expect(err).NotTo(HaveOccurred())
+
+		buckets = nil
+		for _, mf := range mfs {
+			if mf.GetName() == "dao_query_duration_seconds" {
+				buckets = mf.GetMetric()[0].GetHistogram().GetBucket()
+			}
+		}
+		Expect(buckets).NotTo(BeEmpty())
+	})
+
+	When("the span of the context is sampled", func() {
+		BeforeEach(func() {
+			ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x01},
+				SpanID:     trace.SpanID{0x02},
+				TraceFlags: trace.FlagsSampled,
+			}))
+		})
+
+		It("records the trace ID as the exemplar", func() {
+			exemplar := buckets[0].GetExemplar()
+			Expect(exemplar).NotTo(BeNil())
+			Expect(exemplar.GetLabel()).To(HaveLen(1))
+			Expect(exemplar.GetLabel()[0].GetName()).To(Equal("trace_id"))
+			Expect(exemplar.GetLabel()[0].GetValue()).To(Equal("01000000000000000000000000000000"))
+		})
+	})
+
+	When("the context is not traced", func() {
+		It("records no exemplar", func() {
+			for _, bucket := range buckets {
+				Expect(bucket.GetExemplar()).To(BeNil())
+			}
+		})
+	})
+})
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
@@ -18,6 +21,7 @@ import (
 	selector "go.opentelemetry.io/otel/sdk/metric/selector/simple"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 type PrometheusServiceMeterConfig struct {
@@ -31,6 +35,8 @@ type PrometheusServiceMeterConfig struct {
 // 1. Count number of requests
 // 2. Measure response time
 // 3. Count number of error requests
+// along with the standard latency histograms of the RPCs and the DAO queries with exemplars, and the Go runtime metrics.
+// The metrics are served in the OpenMetrics format if the scraper accepts it, so the exemplars are exposed.
 type PrometheusServiceMeter struct {
 	metric.Meter
 
@@ -38,6 +44,7 @@ type PrometheusServiceMeter struct {
 	requestCounter        syncint64.Counter
 	requestErrorCounter   syncint64.Counter
 	responseTimeHistogram syncint64.Histogram
+	latency               *latencyHistograms
 }
 
 // UnaryServerInterceptor is a gRPC server-side interceptor that provides Prometheus monitoring for Unary RPCs.
@@ -62,6 +69,7 @@ func (m *PrometheusServiceMeter) UnaryServerInterceptor() func(ctx context.Conte
 		// measure response time
 		responseTime := time.Since(start)
 		m.responseTimeHistogram.Record(ctx, responseTime.Milliseconds(), attributes...)
+		observe(ctx, m.latency.rpcServer.WithLabelValues(info.FullMethod, status.Code(err).String()), responseTime)
 
 		return resp, err
 	}
@@ -78,8 +86,21 @@ func NewPrometheusServiceMeter(ctx context.Context, conf *PrometheusServiceMeter
 		zap.String("name", conf.Name),
 	)
 
-	exporter := newPrometheusExporter(conf, logger)
-	server := newPrometheusServer(exporter, conf, logger)
+	registry := prom.NewRegistry()
+	if err := registry.Register(collectors.NewGoCollector()); err != nil {
+		logger.Fatal("failed to register Go runtime collector", zap.Error(err))
+	}
+	if err := registry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})); err != nil {
+		logger.Fatal("failed to register process collector", zap.Error(err))
+	}
+
+	latency, err := newLatencyHistograms(registry)
+	if err != nil {
+		logger.Fatal("failed to create latency histograms", zap.Error(err))
+	}
+
+	exporter := newPrometheusExporter(registry, conf, logger)
+	server := newPrometheusServer(registry, conf, logger)
 
 	meter := exporter.MeterProvider().Meter(conf.Name)
 
@@ -99,15 +120,18 @@ func NewPrometheusServiceMeter(ctx context.Context, conf *PrometheusServiceMeter
 	}
 
 	return &PrometheusServiceMeter{
+		Meter:                 meter,
 		server:                server,
 		requestCounter:        requestCounter,
 		requestErrorCounter:   requestErrorCounter,
 		responseTimeHistogram: responseTimeHistogram,
+		latency:               latency,
 	}
 }
 
-func newPrometheusExporter(conf *PrometheusServiceMeterConfig, logger *logkit.Logger) *prometheus.Exporter {
+func newPrometheusExporter(registry *prom.Registry, conf *PrometheusServiceMeterConfig, logger *logkit.Logger) *prometheus.Exporter {
 	config := prometheus.Config{
+		Registry:                   registry,
 		DefaultHistogramBoundaries: conf.HistogramBoundaries,
 	}
 
@@ -129,12 +153,15 @@ func newPrometheusExporter(conf *PrometheusServiceMeterConfig, logger *logkit.Lo
 	return exporter
 }
 
-func newPrometheusServer(exporter *prometheus.Exporter, conf *PrometheusServiceMeterConfig, logger *logkit.Logger) *http.Server {
+func newPrometheusServer(registry *prom.Registry, conf *PrometheusServiceMeterConfig, logger *logkit.Logger) *http.Server {
+	// the exporter collects the OpenTelemetry metrics through the registry as well
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+
 	server := &http.Server{
 		Addr: conf.Addr,
 		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.Method == http.MethodGet && r.URL.Path == conf.Path {
-				exporter.ServeHTTP(w, r)
+				handler.ServeHTTP(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
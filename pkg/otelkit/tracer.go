@@ -0,0 +1,109 @@
+package otelkit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// instrumentationName is the name of the tracer creating the spans of the kits.
+const instrumentationName = "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+
+type TracerConfig struct {
+	Endpoint    string  `long:"endpoint" env:"ENDPOINT" description:"the OTLP gRPC endpoint to export the spans to, tracing is disabled if empty"`
+	Insecure    bool    `long:"insecure" env:"INSECURE" description:"export the spans without TLS"`
+	Name        string  `long:"name" env:"NAME" description:"the service name of the spans, required if the endpoint is set"`
+	SampleRatio float64 `long:"sample_ratio" env:"SAMPLE_RATIO" description:"the ratio of the traces started by the service to sample, the sampling decision of the parent span is followed" default:"1"`
+}
+
+// TracerProvider exports the spans of the service to the OTLP endpoint. It is registered as the global
+// tracer provider, so the spans are created by the interceptors and hooks of the kits, and the trace
+// context is propagated to the other services through the gRPC metadata and the event headers. Its shutdown
+// is registered to the lifecycle before the other components, so it flushes their spans last.
+type TracerProvider struct {
+	trace.TracerProvider
+
+	shutdownFunc func(context.Context) error
+}
+
+// Shutdown flushes the spans not exported yet until the context is done.
+func (tp *TracerProvider) Shutdown(ctx context.Context) error {
+	if tp.shutdownFunc != nil {
+		return tp.shutdownFunc(ctx)
+	}
+
+	return nil
+}
+
+func NewTracerProvider(ctx context.Context, conf *TracerConfig) *TracerProvider {
+	logger := logkit.FromContext(ctx).With(
+		zap.String("endpoint", conf.Endpoint),
+		zap.String("name", conf.Name),
+	)
+
+	// the trace context is propagated even if tracing is disabled, so the traces are not broken by the service
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if conf.Endpoint == "" {
+		logger.Info("tracing is disabled")
+
+		return &TracerProvider{
+			TracerProvider: otel.GetTracerProvider(),
+		}
+	}
+
+	if conf.Name == "" {
+		logger.Fatal("the name of the tracer is required")
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(conf.Endpoint),
+	}
+	if conf.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		logger.Fatal("failed to create OTLP trace exporter", zap.Error(err))
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(conf.Name))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	logger.Info("create tracer provider successfully")
+
+	return &TracerProvider{
+		TracerProvider: provider,
+		shutdownFunc:   provider.Shutdown,
+	}
+}
+
+// Tracer returns the tracer of the global tracer provider, the spans are dropped until
+// the tracer provider is created, so the kits are usable without tracing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// EndSpan records the error, if any, as the status of the span and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
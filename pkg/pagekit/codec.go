@@ -0,0 +1,118 @@
+package pagekit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid page token")
+	ErrExpiredToken = errors.New("expired page token")
+)
+
+// the layout of the token is: version (1 byte) | expiry in unix seconds (8 bytes) | cursor | HMAC-SHA256 (32 bytes),
+// the version is bumped when the layout changes, so the tokens of the old layout are rejected instead of misread
+const (
+	tokenVersion   byte = 1
+	tokenHeaderLen      = 1 + 8
+)
+
+// minSecretLen is the minimum length of the secret, shorter secrets are easier to brute force
+const minSecretLen = 16
+
+type Config struct {
+	Secret string        `long:"secret" env:"SECRET" description:"the secret signing the page tokens, at least 16 bytes" required:"true"`
+	TTL    time.Duration `long:"ttl" env:"TTL" description:"how long the page tokens are valid" default:"24h"`
+}
+
+// Codec encodes the cursors of the list RPCs into opaque page tokens. The tokens are signed
+// along with the filter of the list, so the clients can neither forge the cursors nor replay
+// the tokens with other filters, and they expire after the TTL.
+type Codec struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+func NewCodec(ctx context.Context, conf *Config) *Codec {
+	logger := logkit.FromContext(ctx)
+
+	if len(conf.Secret) < minSecretLen {
+		logger.Fatal("page token secret is too short", zap.Int("min_length", minSecretLen))
+	}
+
+	if conf.TTL <= 0 {
+		logger.Fatal("page token TTL must be positive", zap.Duration("ttl", conf.TTL))
+	}
+
+	return &Codec{
+		secret: []byte(conf.Secret),
+		ttl:    conf.TTL,
+		now:    time.Now,
+	}
+}
+
+// Encode returns the page token of the cursor listed with the filter,
+// the filter is any string identifying the parameters of the list except the page size.
+func (c *Codec) Encode(filter string, cursor interface{}) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	token := make([]byte, tokenHeaderLen, tokenHeaderLen+len(payload)+sha256.Size)
+	token[0] = tokenVersion
+	binary.BigEndian.PutUint64(token[1:tokenHeaderLen], uint64(c.now().Add(c.ttl).Unix()))
+	token = append(token, payload...)
+	token = append(token, c.sign(filter, token)...)
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decode decodes the page token into the cursor, the filter must be the same as the one encoding the token.
+// It returns ErrInvalidToken if the token is malformed or tampered, and ErrExpiredToken if the token is expired.
+func (c *Codec) Decode(token, filter string, cursor interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) < tokenHeaderLen+sha256.Size || data[0] != tokenVersion {
+		return ErrInvalidToken
+	}
+
+	signed, mac := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+	if !hmac.Equal(mac, c.sign(filter, signed)) {
+		return ErrInvalidToken
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(signed[1:tokenHeaderLen])), 0)
+	if !c.now().Before(expiresAt) {
+		return ErrExpiredToken
+	}
+
+	if err := json.Unmarshal(signed[tokenHeaderLen:], cursor); err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// sign returns the HMAC of the filter and the signed part of the token,
+// the filter is length-prefixed so that it cannot be shifted into the cursor.
+func (c *Codec) sign(filter string, signed []byte) []byte {
+	var filterLen [8]byte
+	binary.BigEndian.PutUint64(filterLen[:], uint64(len(filter)))
+
+	h := hmac.New(sha256.New, c.secret)
+	h.Write(filterLen[:])
+	h.Write([]byte(filter))
+	h.Write(signed)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package pagekit
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/fuzzkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Codec fuzzing", func() {
+	It("decodes nothing but the tokens it encodes", func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		codec := NewCodec(ctx, &Config{Secret: "fake secret of the codec", TTL: time.Hour})
+
+		var corpus [][]byte
+		// the cursors of the tokens by their bytes
+		cursors := make(map[string]fakeCursor)
+		for _, offset := range []int{0, 10, 1 << 40} {
+			token, err := codec.Encode("fake filter", &fakeCursor{Offset: offset})
+			Expect(err).NotTo(HaveOccurred())
+			corpus = append(corpus, []byte(token))
+
+			data, err := base64.RawURLEncoding.DecodeString(token)
+			Expect(err).NotTo(HaveOccurred())
+			cursors[string(data)] = fakeCursor{Offset: offset}
+		}
+
+		// the mutations are decoded both as the tokens and as the bytes of the tokens,
+		// so the mutations reach the checks past the base64 decoding
+		fuzzkit.Fuzz(corpus, [][]byte{[]byte("A"), []byte("_"), []byte("-")}, func(input []byte) {
+			for _, token := range []string{string(input), base64.RawURLEncoding.EncodeToString(input)} {
+				var cursor fakeCursor
+				if err := codec.Decode(token, "fake filter", &cursor); err != nil {
+					Expect(err).To(Or(MatchError(ErrInvalidToken), MatchError(ErrExpiredToken)), "token %q", token)
+					continue
+				}
+
+				data, err := base64.RawURLEncoding.DecodeString(token)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cursors).To(HaveKeyWithValue(string(data), cursor), "token %q", token)
+			}
+		})
+	})
+})
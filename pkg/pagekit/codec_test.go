@@ -0,0 +1,87 @@
+package pagekit
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeCursor struct {
+	Offset int `json:"offset"`
+}
+
+var _ = Describe("Codec", func() {
+	var (
+		codec *Codec
+		now   time.Time
+	)
+
+	BeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		codec = NewCodec(ctx, &Config{Secret: "fake secret of the codec", TTL: time.Hour})
+
+		now = time.Date(2022, time.May, 1, 0, 0, 0, 0, time.UTC)
+		codec.now = func() time.Time { return now }
+	})
+
+	encode := func(filter string, cursor *fakeCursor) string {
+		token, err := codec.Encode(filter, cursor)
+		Expect(err).NotTo(HaveOccurred())
+		return token
+	}
+
+	It("decodes the token encoded with the same filter", func() {
+		var cursor fakeCursor
+		Expect(codec.Decode(encode("fake filter", &fakeCursor{Offset: 10}), "fake filter", &cursor)).To(Succeed())
+		Expect(cursor).To(Equal(fakeCursor{Offset: 10}))
+	})
+
+	It("rejects the token encoded with another filter", func() {
+		var cursor fakeCursor
+		Expect(codec.Decode(encode("fake filter", &fakeCursor{Offset: 10}), "other filter", &cursor)).To(MatchError(ErrInvalidToken))
+	})
+
+	It("rejects the tampered token", func() {
+		data, err := base64.RawURLEncoding.DecodeString(encode("fake filter", &fakeCursor{Offset: 10}))
+		Expect(err).NotTo(HaveOccurred())
+		data[tokenHeaderLen+len(`{"offset":`)] = '9'
+
+		var cursor fakeCursor
+		Expect(codec.Decode(base64.RawURLEncoding.EncodeToString(data), "fake filter", &cursor)).To(MatchError(ErrInvalidToken))
+	})
+
+	It("rejects the token signed with another secret", func() {
+		token := encode("fake filter", &fakeCursor{Offset: 10})
+		codec.secret = []byte("other secret of the codec")
+
+		var cursor fakeCursor
+		Expect(codec.Decode(token, "fake filter", &cursor)).To(MatchError(ErrInvalidToken))
+	})
+
+	It("rejects the token of another version", func() {
+		data, err := base64.RawURLEncoding.DecodeString(encode("fake filter", &fakeCursor{Offset: 10}))
+		Expect(err).NotTo(HaveOccurred())
+		data[0] = tokenVersion + 1
+
+		var cursor fakeCursor
+		Expect(codec.Decode(base64.RawURLEncoding.EncodeToString(data), "fake filter", &cursor)).To(MatchError(ErrInvalidToken))
+	})
+
+	It("rejects the malformed token", func() {
+		var cursor fakeCursor
+		Expect(codec.Decode("invalid token", "fake filter", &cursor)).To(MatchError(ErrInvalidToken))
+		Expect(codec.Decode("", "fake filter", &cursor)).To(MatchError(ErrInvalidToken))
+	})
+
+	It("rejects the expired token", func() {
+		token := encode("fake filter", &fakeCursor{Offset: 10})
+		now = now.Add(time.Hour)
+
+		var cursor fakeCursor
+		Expect(codec.Decode(token, "fake filter", &cursor)).To(MatchError(ErrExpiredToken))
+	})
+})
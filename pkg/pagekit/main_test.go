@@ -0,0 +1,13 @@
+package pagekit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPageKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Page Kit")
+}
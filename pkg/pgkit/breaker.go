@@ -0,0 +1,64 @@
+package pgkit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	"github.com/go-pg/pg/v10"
+)
+
+type breakerDoneContextKey struct{}
+
+// breakingQueryHook rejects the queries with breakerkit.ErrOpen while PostgreSQL is failing.
+// It must be the first hook, so the rejected queries are not traced, logged nor measured by the others.
+type breakingQueryHook struct {
+	breaker *breakerkit.Breaker
+}
+
+var _ pg.QueryHook = (*breakingQueryHook)(nil)
+
+func (h *breakingQueryHook) BeforeQuery(ctx context.Context, _ *pg.QueryEvent) (context.Context, error) {
+	done, err := h.breaker.Allow()
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, breakerDoneContextKey{}, done), nil
+}
+
+func (h *breakingQueryHook) AfterQuery(ctx context.Context, evt *pg.QueryEvent) error {
+	// the hook is called after rejecting the query as well, which is not reported
+	if done, ok := ctx.Value(breakerDoneContextKey{}).(breakerkit.DoneFunc); ok {
+		done(evt.Err)
+	}
+
+	return nil
+}
+
+// isQueryFailure reports whether the error is a failure of PostgreSQL rather than of the query, e.g. the connection
+// errors, the timeouts and the lack of resources are failures while no rows and the constraint violations are not.
+func isQueryFailure(err error) bool {
+	if !breakerkit.IsFailure(queryError(err)) || errors.Is(err, pg.ErrMultiRows) {
+		return false
+	}
+
+	var pgErr pg.Error
+	if !errors.As(err, &pgErr) {
+		return true
+	}
+
+	// the classes of SQLSTATE of connection exception, insufficient resources, operator intervention
+	// including the statement timeout, and system error
+	code := pgErr.Field('C')
+	if len(code) < 2 {
+		return true
+	}
+
+	switch code[:2] {
+	case "08", "53", "57", "58":
+		return true
+	}
+
+	return false
+}
@@ -3,14 +3,38 @@ package pgkit
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
 	"github.com/go-pg/pg/v10"
 	"go.uber.org/zap"
 )
 
 type PGConfig struct {
-	URL string `long:"url" env:"URL" description:"the URL of PostgreSQL" required:"true"`
+	URL                string        `long:"url" env:"URL" description:"the URL of PostgreSQL" required:"true"`
+	ReplicaURLs        []string      `long:"replica_urls" env:"REPLICA_URLS" env-delim:"," description:"the URLs of the PostgreSQL read replicas, the hedged reads are sent to the primary if empty"`
+	StmtCacheSize      int           `long:"stmt_cache_size" env:"STMT_CACHE_SIZE" description:"the max number of prepared statements of each cached query" default:"4"`
+	StatementTimeout   time.Duration `long:"statement_timeout" env:"STATEMENT_TIMEOUT" description:"the max execution time of the statements, unlimited if zero" default:"30s"`
+	SlowQueryThreshold time.Duration `long:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD" description:"log the queries slower than it as warnings, every query is logged at debug level" default:"200ms"`
+	ExplainSampleRatio float64       `long:"explain_sample_ratio" env:"EXPLAIN_SAMPLE_RATIO" description:"the ratio of the slow SELECT queries to capture the plan by EXPLAIN ANALYZE, disabled if zero; the plans logged may contain the parameters"`
+
+	Breaker breakerkit.BreakerConfig `group:"breaker" namespace:"breaker" env-namespace:"BREAKER"`
+}
+
+// Replicas returns the configs of the read replicas, which are the same as the primary except the URL.
+func (c *PGConfig) Replicas() []*PGConfig {
+	replicas := make([]*PGConfig, 0, len(c.ReplicaURLs))
+	for _, url := range c.ReplicaURLs {
+		replica := *c
+		replica.URL = url
+		replica.ReplicaURLs = nil
+
+		replicas = append(replicas, &replica)
+	}
+
+	return replicas
 }
 
 type PGClient struct {
@@ -25,18 +49,55 @@ func (c *PGClient) Close() error {
 	return c.DB.Close()
 }
 
-func NewPGClient(ctx context.Context, conf *PGConfig) *PGClient {
+type pgClientOptions struct {
+	meter *otelkit.PrometheusServiceMeter
+}
+
+type PGClientOption func(opts *pgClientOptions)
+
+// WithMeter measures the latency of the queries.
+func WithMeter(meter *otelkit.PrometheusServiceMeter) PGClientOption {
+	return func(opts *pgClientOptions) {
+		opts.meter = meter
+	}
+}
+
+func NewPGClient(ctx context.Context, conf *PGConfig, opts ...PGClientOption) *PGClient {
+	var o pgClientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	if url := os.ExpandEnv(conf.URL); url != "" {
 		conf.URL = url
 	}
 
-	logger := logkit.FromContext(ctx).With(zap.String("url", conf.URL))
-	opts, err := pg.ParseURL(conf.URL)
+	baseLogger := logkit.FromContext(ctx)
+	logger := baseLogger.With(zap.String("url", conf.URL))
+	pgOpts, err := pg.ParseURL(conf.URL)
 	if err != nil {
 		logger.Fatal("failed to parse PostgreSQL url", zap.Error(err))
 	}
 
-	db := pg.Connect(opts).WithContext(ctx)
+	if conf.StatementTimeout > 0 {
+		pgOpts.OnConnect = func(ctx context.Context, cn *pg.Conn) error {
+			_, err := cn.ExecContext(ctx, "SET statement_timeout = ?", conf.StatementTimeout.Milliseconds())
+			return err
+		}
+	}
+
+	db := pg.Connect(pgOpts).WithContext(ctx)
+	if breaker := breakerkit.NewBreaker(ctx, "postgresql", &conf.Breaker, breakerkit.WithFailureFunc(isQueryFailure)); breaker != nil {
+		db.AddQueryHook(&breakingQueryHook{breaker: breaker})
+	}
+	db.AddQueryHook(tracingQueryHook{})
+	db.AddQueryHook(&loggingQueryHook{logger: baseLogger, slowQueryThreshold: conf.SlowQueryThreshold})
+	if conf.SlowQueryThreshold > 0 && conf.ExplainSampleRatio > 0 {
+		db.AddQueryHook(newExplainQueryHook(db, baseLogger, conf.SlowQueryThreshold, conf.ExplainSampleRatio))
+	}
+	if o.meter != nil {
+		db.AddQueryHook(&meteringQueryHook{meter: o.meter})
+	}
 	if err := db.Ping(ctx); err != nil {
 		logger.Fatal("failed to ping PostgreSQL", zap.Error(err))
 	}
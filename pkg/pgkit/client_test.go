@@ -3,8 +3,10 @@ package pgkit
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -43,5 +45,16 @@ var _ = Describe("PGClient", func() {
 				Expect(pgClient).NotTo(BeNil())
 			})
 		})
+
+		When("statement timeout is set", func() {
+			BeforeEach(func() { pgConf.StatementTimeout = 5 * time.Second })
+
+			It("sets the statement timeout of the connections", func() {
+				var timeout string
+				_, err := pgClient.QueryOne(pg.Scan(&timeout), "SHOW statement_timeout")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(timeout).To(Equal("5s"))
+			})
+		})
 	})
 })
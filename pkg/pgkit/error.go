@@ -0,0 +1,20 @@
+package pgkit
+
+import (
+	"errors"
+
+	"github.com/go-pg/pg/v10"
+)
+
+// uniqueViolation is the SQLSTATE of the unique_violation error
+const uniqueViolation = "23505"
+
+// IsUniqueViolation reports whether the error is caused by a violation of a unique or primary key constraint.
+func IsUniqueViolation(err error) bool {
+	var pgErr pg.Error
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Field('C') == uniqueViolation
+}
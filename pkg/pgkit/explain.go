@@ -0,0 +1,131 @@
+package pgkit
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	"go.uber.org/zap"
+)
+
+// explainTimeout is the max time to capture a plan, EXPLAIN ANALYZE runs the query again.
+const explainTimeout = 10 * time.Second
+
+// explainQueryHook captures the plan of the slow queries by EXPLAIN (ANALYZE, BUFFERS) on a sampled basis,
+// and logs it with the logger of the request, so the plan is correlated with the RPC issuing the query.
+// Only the SELECT queries are explained, since ANALYZE executes the statement. The plan is captured in
+// the background and at most one at a time, so the queries are not slowed down further.
+type explainQueryHook struct {
+	db                 *pg.DB
+	logger             *logkit.Logger
+	slowQueryThreshold time.Duration
+	sampleRatio        float64
+
+	running chan struct{}
+}
+
+var _ pg.QueryHook = (*explainQueryHook)(nil)
+
+func newExplainQueryHook(db *pg.DB, logger *logkit.Logger, slowQueryThreshold time.Duration, sampleRatio float64) *explainQueryHook {
+	return &explainQueryHook{
+		db:                 db,
+		logger:             logger,
+		slowQueryThreshold: slowQueryThreshold,
+		sampleRatio:        sampleRatio,
+		running:            make(chan struct{}, 1),
+	}
+}
+
+func (h *explainQueryHook) BeforeQuery(ctx context.Context, _ *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h *explainQueryHook) AfterQuery(ctx context.Context, evt *pg.QueryEvent) error {
+	duration := time.Since(evt.StartTime)
+	if duration < h.slowQueryThreshold || evt.Err != nil || rand.Float64() >= h.sampleRatio { //nolint:gosec
+		return nil
+	}
+
+	query, err := evt.UnformattedQuery()
+	if err != nil || queryOperation(query) != "SELECT" {
+		return nil
+	}
+
+	explain, ok := h.explainFunc(evt)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case h.running <- struct{}{}:
+	default:
+		// a plan is being captured already
+		return nil
+	}
+
+	logger := logkit.FromContextOr(ctx, h.logger).With(
+		zap.String("query", string(query)),
+		zap.Duration("duration", duration),
+	)
+
+	go func() {
+		defer func() { <-h.running }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), explainTimeout)
+		defer cancel()
+
+		var plan []string
+		if err := explain(ctx, &plan); err != nil {
+			logger.Error("failed to capture query plan", zap.Error(err))
+			return
+		}
+
+		logger.Warn("captured plan of slow query", zap.String("plan", strings.Join(plan, "\n")))
+	}()
+
+	return nil
+}
+
+// explainFunc returns the function explaining the query of the event. The query and the parameters
+// are copied, since the event is valid until the query returns only.
+func (h *explainQueryHook) explainFunc(evt *pg.QueryEvent) (func(ctx context.Context, plan *[]string) error, bool) {
+	// the formatted query is absent for the prepared statements, which are prepared again with the parameters
+	if fmtedQuery, _ := evt.FormattedQuery(); len(fmtedQuery) > 0 {
+		query := explainQuery("EXPLAIN (ANALYZE, BUFFERS) " + string(fmtedQuery))
+
+		return func(ctx context.Context, plan *[]string) error {
+			_, err := h.db.QueryContext(ctx, plan, query)
+			return err
+		}, true
+	}
+
+	stmtQuery, ok := evt.Query.(string)
+	if !ok {
+		return nil, false
+	}
+	params := append([]interface{}(nil), evt.Params...)
+
+	return func(ctx context.Context, plan *[]string) error {
+		stmt, err := h.db.Prepare("EXPLAIN (ANALYZE, BUFFERS) " + stmtQuery)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		_, err = stmt.QueryContext(ctx, plan, params...)
+		return err
+	}, true
+}
+
+// explainQuery is a formatted query sent as is, since the question marks of the values are not placeholders.
+type explainQuery string
+
+var _ orm.QueryAppender = explainQuery("")
+
+func (q explainQuery) AppendQuery(_ orm.QueryFormatter, b []byte) ([]byte, error) {
+	return append(b, q...), nil
+}
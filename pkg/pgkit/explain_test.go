@@ -0,0 +1,90 @@
+package pgkit
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var _ = Describe("explainQueryHook", func() {
+	var (
+		logs     *observer.ObservedLogs
+		pgClient *PGClient
+		query    string
+	)
+
+	BeforeEach(func() {
+		var core zapcore.Core
+		core, logs = observer.New(zapcore.DebugLevel)
+		ctx := (&logkit.Logger{Logger: zap.New(core)}).WithContext(context.Background())
+
+		pgConf := &PGConfig{
+			URL:                "postgres://postgres@postgres:5432/postgres?sslmode=disable",
+			SlowQueryThreshold: 10 * time.Millisecond,
+			ExplainSampleRatio: 1,
+		}
+		if url := os.Getenv("POSTGRES_URL"); url != "" {
+			pgConf.URL = url
+		}
+
+		pgClient = NewPGClient(ctx, pgConf)
+	})
+
+	AfterEach(func() {
+		Expect(pgClient.Close()).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		_, err := pgClient.Exec(query)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("the SELECT query is slow", func() {
+		BeforeEach(func() { query = "SELECT pg_sleep(0.05)" })
+
+		It("logs the plan of the query", func() {
+			Eventually(func() int {
+				return logs.FilterMessage("captured plan of slow query").Len()
+			}).Should(Equal(1))
+
+			entry := logs.FilterMessage("captured plan of slow query").All()[0]
+			Expect(entry.ContextMap()).To(HaveKeyWithValue("query", query))
+			Expect(entry.ContextMap()["plan"]).To(ContainSubstring("actual time"))
+		})
+	})
+
+	When("the SELECT query is fast", func() {
+		BeforeEach(func() { query = "SELECT 1" })
+
+		It("does not explain the query", func() {
+			Consistently(func() int {
+				return logs.FilterMessage("captured plan of slow query").Len()
+			}, 200*time.Millisecond).Should(BeZero())
+		})
+	})
+
+	When("the slow query is not SELECT", func() {
+		BeforeEach(func() { query = "DO $$ BEGIN PERFORM pg_sleep(0.05); END $$" })
+
+		It("does not explain the query", func() {
+			Consistently(func() int {
+				return logs.FilterMessage("captured plan of slow query").Len()
+			}, 200*time.Millisecond).Should(BeZero())
+		})
+	})
+})
+
+var _ = Describe("explainQuery", func() {
+	It("appends the query without formatting the question marks", func() {
+		b, err := explainQuery("SELECT '?'").AppendQuery(nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(Equal("SELECT '?'"))
+	})
+})
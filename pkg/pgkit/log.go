@@ -0,0 +1,55 @@
+package pgkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// loggingQueryHook logs every query at debug level and the queries slower than the threshold as warnings,
+// with the logger of the request in the context, so the queries are correlated with the request.
+// The statement is logged without the parameters like tracingQueryHook.
+type loggingQueryHook struct {
+	logger             *logkit.Logger
+	slowQueryThreshold time.Duration
+}
+
+var _ pg.QueryHook = (*loggingQueryHook)(nil)
+
+func (h *loggingQueryHook) BeforeQuery(ctx context.Context, _ *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h *loggingQueryHook) AfterQuery(ctx context.Context, evt *pg.QueryEvent) error {
+	duration := time.Since(evt.StartTime)
+
+	level, msg := zapcore.DebugLevel, "executed query"
+	if h.slowQueryThreshold > 0 && duration >= h.slowQueryThreshold {
+		level, msg = zapcore.WarnLevel, "executed slow query"
+	}
+
+	// the fields are built only if the entry is logged, since most of the queries are not
+	entry := logkit.FromContextOr(ctx, h.logger).Check(level, msg)
+	if entry == nil {
+		return nil
+	}
+
+	fields := []zap.Field{zap.Duration("duration", duration)}
+	if query, err := evt.UnformattedQuery(); err == nil {
+		fields = append(fields, zap.ByteString("query", query))
+	}
+	if evt.Result != nil {
+		fields = append(fields, zap.Int("rows_affected", evt.Result.RowsAffected()))
+	}
+	if err := queryError(evt.Err); err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	entry.Write(fields...)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package pgkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/go-pg/pg/v10"
+)
+
+// meteringQueryHook measures the latency of each query by the operation.
+type meteringQueryHook struct {
+	meter *otelkit.PrometheusServiceMeter
+}
+
+var _ pg.QueryHook = (*meteringQueryHook)(nil)
+
+func (h *meteringQueryHook) BeforeQuery(ctx context.Context, _ *pg.QueryEvent) (context.Context, error) {
+	return ctx, nil
+}
+
+func (h *meteringQueryHook) AfterQuery(ctx context.Context, evt *pg.QueryEvent) error {
+	operation := "UNKNOWN"
+	if query, err := evt.UnformattedQuery(); err == nil {
+		if op := queryOperation(query); op != "" {
+			operation = op
+		}
+	}
+
+	h.meter.ObserveQuery(ctx, "postgresql", operation, time.Since(evt.StartTime), queryError(evt.Err))
+
+	return nil
+}
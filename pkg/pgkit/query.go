@@ -0,0 +1,61 @@
+package pgkit
+
+import (
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// Page is the limit and offset of a list query, a non-positive limit means no limit.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// Paginate applies the page to the query.
+func Paginate(q *orm.Query, page Page) *orm.Query {
+	if page.Limit > 0 {
+		q = q.Limit(page.Limit)
+	}
+
+	if page.Offset > 0 {
+		q = q.Offset(page.Offset)
+	}
+
+	return q
+}
+
+// Order is an ORDER BY column of a list query.
+type Order struct {
+	Column string
+	Desc   bool
+}
+
+func Asc(column string) Order {
+	return Order{Column: column}
+}
+
+func Desc(column string) Order {
+	return Order{Column: column, Desc: true}
+}
+
+// OrderBy applies the orders to the query, the column is quoted as an identifier.
+func OrderBy(q *orm.Query, orders ...Order) *orm.Query {
+	for _, order := range orders {
+		if order.Desc {
+			q = q.OrderExpr("? DESC", pg.Ident(order.Column))
+		} else {
+			q = q.OrderExpr("? ASC", pg.Ident(order.Column))
+		}
+	}
+
+	return q
+}
+
+// DeletedAtColumn is the column marking a row as soft deleted when not null.
+const DeletedAtColumn = "deleted_at"
+
+// WhereNotDeleted filters out the soft deleted rows. Models tagged with the go-pg
+// `soft_delete` option are filtered by go-pg already, this is for raw table queries.
+func WhereNotDeleted(q *orm.Query) *orm.Query {
+	return q.Where("? IS NULL", pg.Ident(DeletedAtColumn))
+}
@@ -0,0 +1,48 @@
+package pgkit
+
+import (
+	"github.com/go-pg/pg/v10/orm"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Query", func() {
+	type Row struct {
+		ID int
+	}
+
+	var q *orm.Query
+
+	BeforeEach(func() {
+		q = orm.NewQuery(nil, &Row{})
+	})
+
+	Describe("Paginate", func() {
+		It("ignores non-positive limit and offset", func() {
+			Expect(formatQuery(Paginate(q, Page{Limit: 0, Offset: -1}))).NotTo(Or(ContainSubstring("LIMIT"), ContainSubstring("OFFSET")))
+		})
+
+		It("applies the limit and offset", func() {
+			Expect(formatQuery(Paginate(q, Page{Limit: 10, Offset: 20}))).To(HaveSuffix("LIMIT 10 OFFSET 20"))
+		})
+	})
+
+	Describe("OrderBy", func() {
+		It("orders by the quoted columns", func() {
+			Expect(formatQuery(OrderBy(q, Asc("updated_at"), Desc("id")))).To(HaveSuffix(`ORDER BY "updated_at" ASC, "id" DESC`))
+		})
+	})
+
+	Describe("WhereNotDeleted", func() {
+		It("filters out the soft deleted rows", func() {
+			Expect(formatQuery(WhereNotDeleted(q))).To(HaveSuffix(`WHERE ("deleted_at" IS NULL)`))
+		})
+	})
+})
+
+func formatQuery(q *orm.Query) string {
+	b, err := orm.NewSelectQuery(q).AppendQuery(orm.NewFormatter(), nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	return string(b)
+}
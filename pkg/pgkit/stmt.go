@@ -0,0 +1,151 @@
+package pgkit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.uber.org/zap"
+)
+
+// StmtCache reuses prepared statements so that frequent queries are parsed and planned once.
+//
+// A prepared statement sticks to a single connection and runs one query at a time,
+// so each query keeps a pool of up to size statements to serve concurrent queries.
+type StmtCache struct {
+	client *PGClient
+	size   int
+
+	mu    sync.Mutex
+	pools map[string]*stmtPool
+
+	hitCounter  syncint64.Counter
+	missCounter syncint64.Counter
+}
+
+type stmtPool struct {
+	stmts   chan *pg.Stmt
+	created int
+}
+
+func NewStmtCache(ctx context.Context, client *PGClient, conf *PGConfig, meter metric.Meter) *StmtCache {
+	logger := logkit.FromContext(ctx).With(zap.Int("stmt_cache_size", conf.StmtCacheSize))
+
+	hitCounter, err := meter.SyncInt64().Counter("pg_stmt_cache_hit", instrument.WithDescription("count number of queries reusing a prepared statement"))
+	if err != nil {
+		logger.Fatal("failed to create statement cache hit counter", zap.Error(err))
+	}
+
+	missCounter, err := meter.SyncInt64().Counter("pg_stmt_cache_miss", instrument.WithDescription("count number of queries preparing a new statement"))
+	if err != nil {
+		logger.Fatal("failed to create statement cache miss counter", zap.Error(err))
+	}
+
+	size := conf.StmtCacheSize
+	if size <= 0 {
+		size = 1
+	}
+
+	return &StmtCache{
+		client:      client,
+		size:        size,
+		pools:       make(map[string]*stmtPool),
+		hitCounter:  hitCounter,
+		missCounter: missCounter,
+	}
+}
+
+// QueryContext runs the query with a cached prepared statement,
+// the query uses $1, $2, ... as the placeholders of the params.
+func (c *StmtCache) QueryContext(ctx context.Context, model interface{}, query string, params ...interface{}) (pg.Result, error) {
+	stmt, err := c.acquire(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(query, stmt)
+
+	return stmt.QueryContext(ctx, model, params...)
+}
+
+func (c *StmtCache) acquire(ctx context.Context, query string) (*pg.Stmt, error) {
+	attributes := []attribute.KeyValue{
+		attribute.String("query", query),
+	}
+
+	c.mu.Lock()
+	pool, ok := c.pools[query]
+	if !ok {
+		pool = &stmtPool{stmts: make(chan *pg.Stmt, c.size)}
+		c.pools[query] = pool
+	}
+
+	select {
+	case stmt := <-pool.stmts:
+		c.mu.Unlock()
+		c.hitCounter.Add(ctx, 1, attributes...)
+
+		return stmt, nil
+	default:
+	}
+
+	if pool.created < c.size {
+		pool.created++
+		c.mu.Unlock()
+		c.missCounter.Add(ctx, 1, attributes...)
+
+		stmt, err := c.client.Prepare(query)
+		if err != nil {
+			c.mu.Lock()
+			pool.created--
+			c.mu.Unlock()
+
+			return nil, err
+		}
+
+		return stmt, nil
+	}
+	c.mu.Unlock()
+
+	// all the statements are in use, wait for one of them
+	select {
+	case stmt := <-pool.stmts:
+		c.hitCounter.Add(ctx, 1, attributes...)
+
+		return stmt, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *StmtCache) release(query string, stmt *pg.Stmt) {
+	c.mu.Lock()
+	pool := c.pools[query]
+	c.mu.Unlock()
+
+	pool.stmts <- stmt
+}
+
+// Close closes all the idle prepared statements, it must be called after all the queries return.
+func (c *StmtCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var firstErr error
+	for _, pool := range c.pools {
+		close(pool.stmts)
+		for stmt := range pool.stmts {
+			if err := stmt.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	c.pools = make(map[string]*stmtPool)
+
+	return firstErr
+}
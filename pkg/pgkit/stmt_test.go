@@ -0,0 +1,90 @@
+package pgkit
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+var _ = Describe("StmtCache", func() {
+	var (
+		ctx       context.Context
+		pgConf    *PGConfig
+		pgClient  *PGClient
+		stmtCache *StmtCache
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewLogger(&logkit.LoggerConfig{
+			Development: true,
+		}).WithContext(context.Background())
+
+		pgConf = &PGConfig{
+			URL:           "postgres://postgres@postgres:5432/postgres?sslmode=disable",
+			StmtCacheSize: 2,
+		}
+		if url := os.Getenv("POSTGRES_URL"); url != "" {
+			pgConf.URL = url
+		}
+
+		pgClient = NewPGClient(ctx, pgConf)
+		stmtCache = NewStmtCache(ctx, pgClient, pgConf, nonrecording.NewNoopMeterProvider().Meter(""))
+	})
+
+	AfterEach(func() {
+		Expect(stmtCache.Close()).NotTo(HaveOccurred())
+		Expect(pgClient.Close()).NotTo(HaveOccurred())
+	})
+
+	Describe("QueryContext", func() {
+		const query = "SELECT $1::int + $2::int"
+
+		When("the statement is reused", func() {
+			It("returns the result of every query", func() {
+				for i := 0; i < 3; i++ {
+					var sum int
+					_, err := stmtCache.QueryContext(ctx, pg.Scan(&sum), query, i, 1)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sum).To(Equal(i + 1))
+				}
+
+				Expect(stmtCache.pools[query].created).To(Equal(1))
+			})
+		})
+
+		When("queries run concurrently", func() {
+			It("prepares at most size statements", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						var sum int
+						_, err := stmtCache.QueryContext(ctx, pg.Scan(&sum), query, i, i)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(sum).To(Equal(2 * i))
+					}(i)
+				}
+				wg.Wait()
+
+				Expect(stmtCache.pools[query].created).To(BeNumerically("<=", 2))
+			})
+		})
+
+		When("the query is invalid", func() {
+			It("returns error and does not keep the statement", func() {
+				_, err := stmtCache.QueryContext(ctx, pg.Discard, "SELECT FROM not_exist_table WHERE id = $1", 1)
+				Expect(err).To(HaveOccurred())
+				Expect(stmtCache.pools["SELECT FROM not_exist_table WHERE id = $1"].created).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package pgkit
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+// The queries with a context are canceled by go-pg once the context is done, so the remaining
+// deadline of a request is propagated to its queries by passing the request context.
+
+// SetLocalStatementTimeout limits the statements of the transaction to the remaining deadline of the context,
+// so that the statements are aborted by PostgreSQL itself once the deadline is exceeded.
+func SetLocalStatementTimeout(ctx context.Context, tx *pg.Tx) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+
+	timeout := time.Until(deadline).Milliseconds()
+	if timeout <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	_, err := tx.ExecContext(ctx, "SET LOCAL statement_timeout = ?", timeout)
+
+	return err
+}
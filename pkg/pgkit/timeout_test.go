@@ -0,0 +1,79 @@
+package pgkit
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/go-pg/pg/v10"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetLocalStatementTimeout", func() {
+	var (
+		ctx      context.Context
+		pgClient *PGClient
+		tx       *pg.Tx
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewLogger(&logkit.LoggerConfig{
+			Development: true,
+		}).WithContext(context.Background())
+
+		pgConf := &PGConfig{
+			URL: "postgres://postgres@postgres:5432/postgres?sslmode=disable",
+		}
+		if url := os.Getenv("POSTGRES_URL"); url != "" {
+			pgConf.URL = url
+		}
+
+		pgClient = NewPGClient(ctx, pgConf)
+
+		var err error
+		tx, err = pgClient.BeginContext(ctx)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(tx.Rollback()).NotTo(HaveOccurred())
+		Expect(pgClient.Close()).NotTo(HaveOccurred())
+	})
+
+	showStatementTimeout := func() string {
+		var timeout string
+		_, err := tx.QueryOne(pg.Scan(&timeout), "SHOW statement_timeout")
+		Expect(err).NotTo(HaveOccurred())
+
+		return timeout
+	}
+
+	When("context has no deadline", func() {
+		It("does not set the statement timeout", func() {
+			Expect(SetLocalStatementTimeout(ctx, tx)).To(Succeed())
+			Expect(showStatementTimeout()).To(Equal("0"))
+		})
+	})
+
+	When("context has a deadline", func() {
+		It("sets the statement timeout to the remaining deadline", func() {
+			ctx, cancel := context.WithTimeout(ctx, time.Minute)
+			defer cancel()
+
+			Expect(SetLocalStatementTimeout(ctx, tx)).To(Succeed())
+			Expect(showStatementTimeout()).To(HaveSuffix("s"))
+			Expect(showStatementTimeout()).NotTo(Equal("0"))
+		})
+	})
+
+	When("deadline is exceeded", func() {
+		It("returns deadline exceeded error", func() {
+			ctx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+			defer cancel()
+
+			Expect(SetLocalStatementTimeout(ctx, tx)).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+})
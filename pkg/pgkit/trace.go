@@ -0,0 +1,64 @@
+package pgkit
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/go-pg/pg/v10"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingQueryHook starts a client span of each query, including the prepared statements.
+// The statement is recorded without the parameters, so the user data is not exported.
+type tracingQueryHook struct{}
+
+var _ pg.QueryHook = tracingQueryHook{}
+
+func (tracingQueryHook) BeforeQuery(ctx context.Context, evt *pg.QueryEvent) (context.Context, error) {
+	attributes := []attribute.KeyValue{semconv.DBSystemPostgreSQL}
+	operation := "postgres"
+
+	if query, err := evt.UnformattedQuery(); err == nil {
+		attributes = append(attributes, semconv.DBStatementKey.String(string(query)))
+
+		if op := queryOperation(query); op != "" {
+			operation = op
+			attributes = append(attributes, semconv.DBOperationKey.String(operation))
+		}
+	}
+
+	ctx, _ = otelkit.Tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx, nil
+}
+
+func (tracingQueryHook) AfterQuery(ctx context.Context, evt *pg.QueryEvent) error {
+	otelkit.EndSpan(trace.SpanFromContext(ctx), queryError(evt.Err))
+
+	return nil
+}
+
+// queryOperation returns the operation of the query, that is, the first keyword such as SELECT.
+func queryOperation(query []byte) string {
+	if fields := strings.Fields(string(query)); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+
+	return ""
+}
+
+// queryError returns the error of the query, no rows is an expected result rather than a failure of the query.
+func queryError(err error) error {
+	if errors.Is(err, pg.ErrNoRows) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,275 @@
+package ratekit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// errorDomain is the domain of the ErrorInfo of the errors returned by the rate limiter
+const errorDomain = "ratekit.nthu-distributed-system"
+
+var ErrInvalidLimit = errors.New("invalid rate limit")
+
+type RateLimitConfig struct {
+	DefaultLimit    string            `long:"default_limit" env:"DEFAULT_LIMIT" description:"the limit of the requests of each principal to each method, e.g. 100/1m, unlimited if empty"`
+	Limits          map[string]string `long:"limits" env:"LIMITS" env-delim:"," description:"the limits of the methods overriding the default limit, e.g. /comment.pb.Comment/CreateComment:10/1m"`
+	OverridesKey    string            `long:"overrides_key" env:"OVERRIDES_KEY" description:"the Redis hash of the limits of the methods overriding the configured ones, changed without redeploy" default:"ratelimit:overrides"`
+	RefreshInterval time.Duration     `long:"refresh_interval" env:"REFRESH_INTERVAL" description:"the interval to reload the overrides from Redis" default:"30s"`
+}
+
+// Validate reports whether the limits are valid.
+func (c *RateLimitConfig) Validate() error {
+	_, _, err := parseLimits(c)
+	return err
+}
+
+// Limit is the number of the requests allowed in a period.
+type Limit struct {
+	Count  int64
+	Period time.Duration
+}
+
+// ParseLimit parses the limit in the format of count/period, e.g. 100/1m.
+func ParseLimit(s string) (Limit, error) {
+	parts := strings.SplitN(s, "/", 2)
+	if len(parts) != 2 {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, s)
+	}
+
+	count, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	if err != nil || count < 0 {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, s)
+	}
+
+	period, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+	if err != nil || period <= 0 {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, s)
+	}
+
+	return Limit{Count: count, Period: period}, nil
+}
+
+// incrScript increases the counter of the window and returns the count along with the remaining time of the window,
+// the window starts with the first request, so the counters of the idle principals expire.
+var incrScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return {count, redis.call("PTTL", KEYS[1])}
+`)
+
+// RateLimiter limits the requests of each principal to each method with the fixed window counters in Redis,
+// so the limits hold across the instances of the service. The requests exceeding the limit are rejected with
+// ResourceExhausted along with a RetryInfo of the remaining time of the window. The limits are configured by
+// the flags, and overridden by the Redis hash of the overrides key, which maps the full methods to the limits
+// and is reloaded periodically, so the limits are tuned without redeploy, e.g.
+//
+//	HSET ratelimit:overrides /comment.pb.Comment/CreateComment 10/1m
+//
+// The requests are admitted if Redis fails, since the rate limiting must not take the service down with Redis.
+type RateLimiter struct {
+	client       *rediskit.RedisClient
+	logger       *logkit.Logger
+	overridesKey string
+
+	mu           sync.RWMutex
+	defaultLimit *Limit
+	limits       map[string]Limit
+	overrides    map[string]Limit
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRateLimiter(ctx context.Context, client *rediskit.RedisClient, conf *RateLimitConfig) *RateLimiter {
+	logger := logkit.FromContext(ctx).With(zap.String("overrides_key", conf.OverridesKey))
+
+	l := &RateLimiter{
+		client:       client,
+		logger:       logger,
+		overridesKey: conf.OverridesKey,
+		done:         make(chan struct{}),
+	}
+
+	if err := l.SetLimits(conf); err != nil {
+		logger.Fatal("failed to parse rate limits", zap.Error(err))
+	}
+
+	l.refresh(ctx)
+
+	ctx, l.cancel = context.WithCancel(ctx)
+	go l.run(ctx, conf.RefreshInterval)
+
+	return l
+}
+
+// SetLimits replaces the configured limits at runtime, the overrides in Redis still take precedence.
+func (l *RateLimiter) SetLimits(conf *RateLimitConfig) error {
+	defaultLimit, limits, err := parseLimits(conf)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	l.defaultLimit, l.limits = defaultLimit, limits
+	l.mu.Unlock()
+
+	return nil
+}
+
+// Close stops reloading the overrides.
+func (l *RateLimiter) Close() error {
+	l.cancel()
+	<-l.done
+
+	return nil
+}
+
+// UnaryServerInterceptor rejects the requests of the principals exceeding the limits of the methods.
+func (l *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the new streams of the principals exceeding the limits of the methods.
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (l *RateLimiter) allow(ctx context.Context, fullMethod string) error {
+	// the services of gRPC itself, such as the health checks, are not limited
+	if strings.HasPrefix(fullMethod, "/grpc.") {
+		return nil
+	}
+
+	limit, ok := l.limit(fullMethod)
+	if !ok {
+		return nil
+	}
+
+	key := "ratelimit:" + fullMethod + ":" + grpckit.Principal(ctx)
+
+	result, err := incrScript.Run(ctx, l.client, []string{key}, limit.Period.Milliseconds()).Int64Slice()
+	if err != nil || len(result) != 2 {
+		logkit.FromContextOr(ctx, l.logger).Warn("failed to count request, the request is admitted", zap.Error(err))
+		return nil
+	}
+
+	count, ttl := result[0], time.Duration(result[1])*time.Millisecond
+	if count <= limit.Count {
+		return nil
+	}
+
+	return grpckit.NewRetryError(codes.ResourceExhausted, errorDomain, "RATE_LIMITED",
+		fmt.Sprintf("exceeded the limit of %d requests per %s", limit.Count, limit.Period), ttl)
+}
+
+// limit returns the limit of the method, the overrides take precedence over the configured limits.
+func (l *RateLimiter) limit(fullMethod string) (Limit, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if limit, ok := l.overrides[fullMethod]; ok {
+		return limit, true
+	}
+
+	if limit, ok := l.limits[fullMethod]; ok {
+		return limit, true
+	}
+
+	if l.defaultLimit != nil {
+		return *l.defaultLimit, true
+	}
+
+	return Limit{}, false
+}
+
+func (l *RateLimiter) run(ctx context.Context, interval time.Duration) {
+	defer close(l.done)
+
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.refresh(ctx)
+		}
+	}
+}
+
+// refresh reloads the overrides, the previous ones are kept if Redis fails.
+func (l *RateLimiter) refresh(ctx context.Context) {
+	values, err := l.client.HGetAll(ctx, l.overridesKey).Result()
+	if err != nil {
+		l.logger.Error("failed to load rate limit overrides", zap.Error(err))
+		return
+	}
+
+	overrides := make(map[string]Limit, len(values))
+	for method, s := range values {
+		limit, err := ParseLimit(s)
+		if err != nil {
+			l.logger.Error("failed to parse rate limit override", zap.String("method", method), zap.Error(err))
+			continue
+		}
+		overrides[method] = limit
+	}
+
+	l.mu.Lock()
+	l.overrides = overrides
+	l.mu.Unlock()
+}
+
+func parseLimits(conf *RateLimitConfig) (*Limit, map[string]Limit, error) {
+	var defaultLimit *Limit
+	if conf.DefaultLimit != "" {
+		limit, err := ParseLimit(conf.DefaultLimit)
+		if err != nil {
+			return nil, nil, err
+		}
+		defaultLimit = &limit
+	}
+
+	limits := make(map[string]Limit, len(conf.Limits))
+	for method, s := range conf.Limits {
+		limit, err := ParseLimit(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("limit of %s: %w", method, err)
+		}
+		limits[method] = limit
+	}
+
+	return defaultLimit, limits, nil
+}
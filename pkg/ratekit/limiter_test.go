@@ -0,0 +1,170 @@
+package ratekit
+
+import (
+	"context"
+	"net"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext returns the context of the requests of the peer of the address without mTLS.
+func peerContext(ctx context.Context, ip string) context.Context {
+	return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50051}})
+}
+
+var _ = Describe("ParseLimit", func() {
+	DescribeTable("parses the limit",
+		func(s string, expected Limit) {
+			Expect(ParseLimit(s)).To(Equal(expected))
+		},
+		Entry("per minute", "100/1m", Limit{Count: 100, Period: time.Minute}),
+		Entry("with spaces", "10 / 30s", Limit{Count: 10, Period: 30 * time.Second}),
+	)
+
+	DescribeTable("rejects the invalid limit",
+		func(s string) {
+			_, err := ParseLimit(s)
+			Expect(err).To(MatchError(ErrInvalidLimit))
+		},
+		Entry("no period", "100"),
+		Entry("invalid count", "many/1m"),
+		Entry("invalid period", "100/minute"),
+	)
+})
+
+var _ = Describe("RateLimiter", func() {
+	var (
+		ctx          context.Context
+		redisClient  *rediskit.RedisClient
+		rateLimiter  *RateLimiter
+		conf         *RateLimitConfig
+		method       string
+		callerCtx    context.Context
+		invokeUnary  func() error
+		handlerCalls int
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		redisConf := &rediskit.RedisConfig{Addr: "localhost:6379"}
+		if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+			redisConf.Addr = addr
+		}
+		redisClient = rediskit.NewRedisClient(ctx, redisConf)
+
+		// the keys are unique to each test, so the counters of the other tests do not count
+		method = "/test.Test/" + uuid.NewString()
+		conf = &RateLimitConfig{
+			Limits:       map[string]string{method: "2/1m"},
+			OverridesKey: "ratelimit:overrides:" + uuid.NewString(),
+		}
+		callerCtx = peerContext(ctx, "10.0.0.1")
+		handlerCalls = 0
+	})
+
+	JustBeforeEach(func() {
+		rateLimiter = NewRateLimiter(ctx, redisClient, conf)
+
+		interceptor := rateLimiter.UnaryServerInterceptor()
+		invokeUnary = func() error {
+			_, err := interceptor(callerCtx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+				func(context.Context, interface{}) (interface{}, error) {
+					handlerCalls++
+					return nil, nil
+				})
+			return err
+		}
+	})
+
+	AfterEach(func() {
+		Expect(rateLimiter.Close()).To(Succeed())
+		Expect(redisClient.Del(ctx, conf.OverridesKey).Err()).NotTo(HaveOccurred())
+		Expect(redisClient.Close()).To(Succeed())
+	})
+
+	When("the requests exceed the limit", func() {
+		It("rejects the requests with RetryInfo", func() {
+			Expect(invokeUnary()).To(Succeed())
+			Expect(invokeUnary()).To(Succeed())
+
+			err := invokeUnary()
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+			Expect(grpckit.ErrorInfo(err).GetReason()).To(Equal("RATE_LIMITED"))
+
+			var retryInfo *errdetails.RetryInfo
+			for _, detail := range status.Convert(err).Details() {
+				if d, ok := detail.(*errdetails.RetryInfo); ok {
+					retryInfo = d
+				}
+			}
+			Expect(retryInfo.GetRetryDelay().AsDuration()).To(BeNumerically("~", time.Minute, 5*time.Second))
+			Expect(handlerCalls).To(Equal(2))
+		})
+
+		It("limits the principals separately", func() {
+			Expect(invokeUnary()).To(Succeed())
+			Expect(invokeUnary()).To(Succeed())
+
+			callerCtx = peerContext(ctx, "10.0.0.2")
+			Expect(invokeUnary()).To(Succeed())
+		})
+
+		It("limits the peer regardless of the user IDs it sends", func() {
+			Expect(invokeUnary()).To(Succeed())
+			Expect(invokeUnary()).To(Succeed())
+
+			callerCtx = logkit.WithUserID(callerCtx, uuid.NewString())
+			Expect(status.Code(invokeUnary())).To(Equal(codes.ResourceExhausted))
+		})
+	})
+
+	When("the limit is overridden in Redis", func() {
+		BeforeEach(func() {
+			Expect(redisClient.HSet(ctx, conf.OverridesKey, method, "1/1m").Err()).NotTo(HaveOccurred())
+		})
+
+		It("applies the override", func() {
+			Expect(invokeUnary()).To(Succeed())
+			Expect(status.Code(invokeUnary())).To(Equal(codes.ResourceExhausted))
+		})
+	})
+
+	When("the limits are set at runtime", func() {
+		It("applies the new limits", func() {
+			Expect(rateLimiter.SetLimits(&RateLimitConfig{Limits: map[string]string{method: "1/1m"}})).To(Succeed())
+
+			Expect(invokeUnary()).To(Succeed())
+			Expect(status.Code(invokeUnary())).To(Equal(codes.ResourceExhausted))
+		})
+
+		It("keeps the current limits if the new ones are invalid", func() {
+			Expect(rateLimiter.SetLimits(&RateLimitConfig{DefaultLimit: "many"})).To(MatchError(ErrInvalidLimit))
+
+			Expect(invokeUnary()).To(Succeed())
+			Expect(invokeUnary()).To(Succeed())
+		})
+	})
+
+	When("the method is not limited", func() {
+		BeforeEach(func() { conf.Limits = nil })
+
+		It("admits the requests", func() {
+			for i := 0; i < 5; i++ {
+				Expect(invokeUnary()).To(Succeed())
+			}
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package ratekit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRateKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Rate Kit")
+}
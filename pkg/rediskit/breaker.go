@@ -0,0 +1,85 @@
+package rediskit
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
+	"github.com/go-redis/redis/v8"
+)
+
+type breakerDoneContextKey struct{}
+
+// breakingHook rejects the commands and pipelines with breakerkit.ErrOpen while Redis is failing.
+// It must be the first hook, so the rejected commands are not traced nor measured by the others.
+type breakingHook struct {
+	breaker *breakerkit.Breaker
+}
+
+var _ redis.Hook = (*breakingHook)(nil)
+
+func (h *breakingHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return h.allow(ctx)
+}
+
+func (h *breakingHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.done(ctx, cmd.Err())
+
+	return nil
+}
+
+func (h *breakingHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return h.allow(ctx)
+}
+
+func (h *breakingHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if err = cmdError(cmd); err != nil {
+			break
+		}
+	}
+
+	h.done(ctx, err)
+
+	return nil
+}
+
+func (h *breakingHook) allow(ctx context.Context) (context.Context, error) {
+	done, err := h.breaker.Allow()
+	if err != nil {
+		return ctx, err
+	}
+
+	return context.WithValue(ctx, breakerDoneContextKey{}, done), nil
+}
+
+// done reports the result of the admitted command, the hook is called after rejecting the command as well.
+func (h *breakingHook) done(ctx context.Context, err error) {
+	if done, ok := ctx.Value(breakerDoneContextKey{}).(breakerkit.DoneFunc); ok {
+		done(err)
+	}
+}
+
+// isCmdFailure reports whether the error is a failure of Redis rather than of the command, e.g. the connection
+// errors and the timeouts are failures while nil replies and the errors of the arguments like WRONGTYPE are not.
+func isCmdFailure(err error) bool {
+	if !breakerkit.IsFailure(err) || errors.Is(err, redis.Nil) {
+		return false
+	}
+
+	var redisErr redis.Error
+	if !errors.As(err, &redisErr) {
+		return true
+	}
+
+	// the error replies of the server unable to serve the commands
+	for _, prefix := range []string{"LOADING", "READONLY", "MASTERDOWN", "CLUSTERDOWN", "BUSY"} {
+		if strings.HasPrefix(redisErr.Error(), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,7 +3,9 @@ package rediskit
 import (
 	"context"
 
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/breakerkit"
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
 	"github.com/go-redis/redis/v8"
 	"go.uber.org/zap"
 )
@@ -12,6 +14,8 @@ type RedisConfig struct {
 	Addr     string `long:"addr" env:"ADDR" description:"the address of Redis" required:"true"`
 	Password string `long:"password" env:"PASSWORD" description:"the password of Redis"`
 	Database int    `long:"database" env:"DATABASE" description:"the database of Redis"`
+
+	Breaker breakerkit.BreakerConfig `group:"breaker" namespace:"breaker" env-namespace:"BREAKER"`
 }
 
 type RedisClient struct {
@@ -27,7 +31,25 @@ func (c *RedisClient) Close() error {
 	return c.Client.Close()
 }
 
-func NewRedisClient(ctx context.Context, conf *RedisConfig) *RedisClient {
+type redisClientOptions struct {
+	meter *otelkit.PrometheusServiceMeter
+}
+
+type RedisClientOption func(opts *redisClientOptions)
+
+// WithMeter measures the latency of the commands.
+func WithMeter(meter *otelkit.PrometheusServiceMeter) RedisClientOption {
+	return func(opts *redisClientOptions) {
+		opts.meter = meter
+	}
+}
+
+func NewRedisClient(ctx context.Context, conf *RedisConfig, opts ...RedisClientOption) *RedisClient {
+	var o redisClientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	logger := logkit.FromContext(ctx).With(
 		zap.String("addr", conf.Addr),
 		zap.Int("database", conf.Database),
@@ -38,6 +60,13 @@ func NewRedisClient(ctx context.Context, conf *RedisConfig) *RedisClient {
 		Password: conf.Password,
 		DB:       conf.Database,
 	})
+	if breaker := breakerkit.NewBreaker(ctx, "redis", &conf.Breaker, breakerkit.WithFailureFunc(isCmdFailure)); breaker != nil {
+		client.AddHook(&breakingHook{breaker: breaker})
+	}
+	client.AddHook(tracingHook{})
+	if o.meter != nil {
+		client.AddHook(&meteringHook{meter: o.meter})
+	}
 
 	if err := client.Ping(ctx).Err(); err != nil {
 		logger.Fatal("failed to ping to Redis", zap.Error(err))
@@ -0,0 +1,54 @@
+package rediskit
+
+import (
+	"context"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/go-redis/redis/v8"
+)
+
+type startTimeContextKey struct{}
+
+// meteringHook measures the latency of each command by the command name, and each pipeline as a whole.
+type meteringHook struct {
+	meter *otelkit.PrometheusServiceMeter
+}
+
+var _ redis.Hook = (*meteringHook)(nil)
+
+func (h *meteringHook) BeforeProcess(ctx context.Context, _ redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, startTimeContextKey{}, time.Now()), nil
+}
+
+func (h *meteringHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	h.observe(ctx, cmd.Name(), cmdError(cmd))
+
+	return nil
+}
+
+func (h *meteringHook) BeforeProcessPipeline(ctx context.Context, _ []redis.Cmder) (context.Context, error) {
+	return context.WithValue(ctx, startTimeContextKey{}, time.Now()), nil
+}
+
+func (h *meteringHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if err = cmdError(cmd); err != nil {
+			break
+		}
+	}
+
+	h.observe(ctx, "pipeline", err)
+
+	return nil
+}
+
+func (h *meteringHook) observe(ctx context.Context, operation string, err error) {
+	start, ok := ctx.Value(startTimeContextKey{}).(time.Time)
+	if !ok {
+		return
+	}
+
+	h.meter.ObserveQuery(ctx, "redis", operation, time.Since(start), err)
+}
@@ -0,0 +1,69 @@
+package rediskit
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/go-redis/redis/v8"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingHook starts a client span of each command and pipeline,
+// the arguments are not recorded since they carry the user data.
+type tracingHook struct{}
+
+var _ redis.Hook = tracingHook{}
+
+func (tracingHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	ctx, _ = otelkit.Tracer().Start(ctx, strings.ToUpper(cmd.Name()),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemRedis, semconv.DBOperationKey.String(cmd.Name())),
+	)
+
+	return ctx, nil
+}
+
+func (tracingHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	otelkit.EndSpan(trace.SpanFromContext(ctx), cmdError(cmd))
+
+	return nil
+}
+
+func (tracingHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	names := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		names = append(names, cmd.Name())
+	}
+
+	ctx, _ = otelkit.Tracer().Start(ctx, "PIPELINE",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemRedis, semconv.DBOperationKey.String(strings.Join(names, " "))),
+	)
+
+	return ctx, nil
+}
+
+func (tracingHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	var err error
+	for _, cmd := range cmds {
+		if err = cmdError(cmd); err != nil {
+			break
+		}
+	}
+
+	otelkit.EndSpan(trace.SpanFromContext(ctx), err)
+
+	return nil
+}
+
+// cmdError returns the error of the command, a missing key is not an error.
+func cmdError(cmd redis.Cmder) error {
+	if err := cmd.Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	return nil
+}
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
 )
 
 type GracefulConfig struct {
@@ -19,7 +23,65 @@ var (
 
 type GracefulRunFunc func(context.Context) error
 
-func GracefulRun(fn GracefulRunFunc, conf *GracefulConfig) error {
+// ShutdownFunc shuts down a component of the service until the context is done,
+// the component is forced to close when the context is done.
+type ShutdownFunc func(context.Context) error
+
+type shutdownHook struct {
+	name string
+	fn   ShutdownFunc
+}
+
+// Lifecycle shuts down the service binaries gracefully on SIGINT and SIGTERM, so that the rolling deploys
+// do not drop requests. The run function is stopped first, then the shutdown hooks run in the reverse order
+// of registration like defers, e.g. the servers stop accepting and drain the requests before the DAO pools
+// they use are closed. All of them share the deadline of the graceful timeout.
+type Lifecycle struct {
+	timeout time.Duration
+	logger  *logkit.Logger
+	signals chan os.Signal
+
+	quit     chan struct{}
+	quitOnce sync.Once
+
+	mu    sync.Mutex
+	hooks []shutdownHook
+}
+
+func NewLifecycle(ctx context.Context, conf *GracefulConfig) *Lifecycle {
+	return &Lifecycle{
+		timeout: conf.Timeout,
+		logger:  logkit.FromContext(ctx),
+		signals: make(chan os.Signal, 1),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Quit shuts down the service like SIGTERM, e.g. when requested by the admin endpoint.
+func (l *Lifecycle) Quit() {
+	l.quitOnce.Do(func() {
+		close(l.quit)
+	})
+}
+
+// OnShutdown registers the shutdown hook of the component, it runs before the hooks registered earlier.
+func (l *Lifecycle) OnShutdown(name string, fn ShutdownFunc) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hooks = append(l.hooks, shutdownHook{name: name, fn: fn})
+}
+
+// OnClose registers the close function of the component as a shutdown hook, see OnShutdown.
+func (l *Lifecycle) OnClose(name string, close func() error) {
+	l.OnShutdown(name, func(context.Context) error {
+		return close()
+	})
+}
+
+// Run runs the function until it returns, the service is signaled to terminate or Quit is called, and shuts down the service.
+// The context of the function is canceled when the service is shutting down.
+func (l *Lifecycle) Run(fn GracefulRunFunc) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -28,23 +90,75 @@ func GracefulRun(fn GracefulRunFunc, conf *GracefulConfig) error {
 		done <- fn(ctx)
 	}()
 
-	shutdownCh := make(chan os.Signal, 1)
-	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+	signal.Notify(l.signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(l.signals)
+
+	var (
+		runErr  error
+		stopped bool
+	)
 
 	select {
-	case err := <-done:
-		return err
-	case <-shutdownCh:
-		// receive termination signal, cancel manually
-		cancel()
+	case runErr = <-done:
+		stopped = true
+	case sig := <-l.signals:
+		l.logger.Info("receive termination signal", zap.String("signal", sig.String()))
+	case <-l.quit:
+		l.logger.Info("receive quit request")
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), l.timeout)
+	defer cancelShutdown()
+
+	// stop the run function first, so nothing is using the components being shut down
+	cancel()
 
+	if !stopped {
 		select {
-		case err := <-done:
-			// gracefully shutdown
-			return err
-		case <-time.After(conf.Timeout):
-			// timeout shutdown
-			return ErrGracefullyTimeout
+		case runErr = <-done:
+		case <-shutdownCtx.Done():
+			l.logger.Error("failed to stop the run function before the graceful timeout")
 		}
 	}
+
+	shutdownErr := l.Shutdown(shutdownCtx)
+
+	if runErr != nil {
+		return runErr
+	}
+	if errors.Is(shutdownCtx.Err(), context.DeadlineExceeded) {
+		return ErrGracefullyTimeout
+	}
+
+	return shutdownErr
+}
+
+// Shutdown runs the shutdown hooks in the reverse order of registration, the hooks run even if
+// the context is done, so that the components are closed anyway. It returns the first error of the hooks.
+func (l *Lifecycle) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	hooks := l.hooks
+	l.hooks = nil
+	l.mu.Unlock()
+
+	var firstErr error
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hook := hooks[i]
+		start := time.Now()
+
+		if err := hook.fn(ctx); err != nil {
+			l.logger.Error("failed to shut down", zap.String("component", hook.name), zap.Duration("duration", time.Since(start)), zap.Error(err))
+
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		l.logger.Info("shut down successfully", zap.String("component", hook.name), zap.Duration("duration", time.Since(start)))
+	}
+
+	return firstErr
 }
@@ -0,0 +1,117 @@
+package runkit
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lifecycle", func() {
+	var (
+		lifecycle *Lifecycle
+		timeout   time.Duration
+		order     []string
+	)
+
+	BeforeEach(func() {
+		timeout = time.Second
+		order = nil
+	})
+
+	JustBeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+		lifecycle = NewLifecycle(ctx, &GracefulConfig{Timeout: timeout})
+	})
+
+	hook := func(name string, err error) ShutdownFunc {
+		return func(context.Context) error {
+			order = append(order, name)
+			return err
+		}
+	}
+
+	Describe("Run", func() {
+		It("stops the run function and shuts down on the termination signal", func() {
+			lifecycle.OnShutdown("pool", hook("pool", nil))
+			lifecycle.OnShutdown("server", hook("server", nil))
+			lifecycle.signals <- syscall.SIGTERM
+
+			Expect(lifecycle.Run(func(ctx context.Context) error {
+				<-ctx.Done()
+				order = append(order, "run")
+				return nil
+			})).To(Succeed())
+			Expect(order).To(Equal([]string{"run", "server", "pool"}))
+		})
+
+		It("stops the run function and shuts down on quit", func() {
+			lifecycle.OnShutdown("server", hook("server", nil))
+			lifecycle.Quit()
+			lifecycle.Quit()
+
+			Expect(lifecycle.Run(func(ctx context.Context) error {
+				<-ctx.Done()
+				order = append(order, "run")
+				return nil
+			})).To(Succeed())
+			Expect(order).To(Equal([]string{"run", "server"}))
+		})
+
+		It("shuts down when the run function returns", func() {
+			errRun := errors.New("run error")
+			lifecycle.OnShutdown("pool", hook("pool", nil))
+
+			Expect(lifecycle.Run(func(ctx context.Context) error {
+				return errRun
+			})).To(MatchError(errRun))
+			Expect(order).To(Equal([]string{"pool"}))
+		})
+
+		When("the run function does not stop before the graceful timeout", func() {
+			BeforeEach(func() {
+				timeout = 100 * time.Millisecond
+			})
+
+			It("shuts down anyway and returns ErrGracefullyTimeout", func() {
+				lifecycle.OnShutdown("pool", hook("pool", nil))
+				lifecycle.signals <- syscall.SIGTERM
+
+				block := make(chan struct{})
+				defer close(block)
+
+				Expect(lifecycle.Run(func(ctx context.Context) error {
+					<-block
+					return nil
+				})).To(MatchError(ErrGracefullyTimeout))
+				Expect(order).To(Equal([]string{"pool"}))
+			})
+		})
+	})
+
+	Describe("Shutdown", func() {
+		It("runs all the hooks and returns the first error", func() {
+			errFirst := errors.New("first error")
+			lifecycle.OnShutdown("pool", hook("pool", errors.New("second error")))
+			lifecycle.OnShutdown("server", hook("server", errFirst))
+
+			Expect(lifecycle.Shutdown(context.Background())).To(MatchError(errFirst))
+			Expect(order).To(Equal([]string{"server", "pool"}))
+		})
+
+		It("runs the hooks only once", func() {
+			lifecycle.OnClose("pool", func() error {
+				order = append(order, "pool")
+				return nil
+			})
+
+			Expect(lifecycle.Shutdown(context.Background())).To(Succeed())
+			Expect(lifecycle.Shutdown(context.Background())).To(Succeed())
+			Expect(order).To(Equal([]string{"pool"}))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package runkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Run Kit")
+}
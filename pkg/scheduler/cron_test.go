@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseSchedule", func() {
+	// from is a Wednesday
+	from := time.Date(2026, 10, 14, 10, 17, 30, 0, time.UTC)
+
+	DescribeTable("returns the next time",
+		func(expr string, expected time.Time) {
+			schedule, err := ParseSchedule(expr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedule.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2026, 10, 14, 10, 18, 0, 0, time.UTC)),
+		Entry("every 15 minutes", "*/15 * * * *", time.Date(2026, 10, 14, 10, 30, 0, 0, time.UTC)),
+		Entry("the hour range", "0 2-6 * * *", time.Date(2026, 10, 15, 2, 0, 0, 0, time.UTC)),
+		Entry("the list", "5,20 10 * * *", time.Date(2026, 10, 14, 10, 20, 0, 0, time.UTC)),
+		Entry("the weekdays", "0 9 * * 1-5", time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)),
+		Entry("Sunday as 7", "0 0 * * 7", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)),
+		Entry("the day of month or week", "0 0 1 * 5", time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)),
+		Entry("the month", "0 0 1 2 *", time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("daily", "@daily", time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)),
+		Entry("monthly", "@monthly", time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("every duration", "@every 1h", time.Date(2026, 10, 14, 11, 0, 0, 0, time.UTC)),
+	)
+
+	It("never schedules the impossible date", func() {
+		schedule, err := ParseSchedule("0 0 30 2 *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Next(from)).To(BeZero())
+	})
+
+	DescribeTable("rejects the invalid expression",
+		func(expr string) {
+			_, err := ParseSchedule(expr)
+			Expect(err).To(MatchError(ErrInvalidSchedule))
+		},
+		Entry("missing fields", "* * *"),
+		Entry("out of range", "60 * * * *"),
+		Entry("reversed range", "0 6-2 * * *"),
+		Entry("invalid step", "*/0 * * * *"),
+		Entry("invalid value", "a * * * *"),
+		Entry("invalid duration", "@every soon"),
+	)
+})
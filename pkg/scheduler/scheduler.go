@@ -0,0 +1,233 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.uber.org/zap"
+)
+
+type SchedulerConfig struct {
+	Jitter     time.Duration `long:"jitter" env:"JITTER" description:"the max random delay of each run, so the jobs of the same time do not hit the databases at once" default:"10s"`
+	LockTTL    time.Duration `long:"lock_ttl" env:"LOCK_TTL" description:"how long a run is locked in Redis, it must exceed the jitter and the clock skew of the instances" default:"10m"`
+	LockPrefix string        `long:"lock_prefix" env:"LOCK_PREFIX" description:"the prefix of the Redis keys locking the runs" default:"scheduler:"`
+}
+
+// Validate reports whether the lock outlives the jitter, otherwise a run may be locked by more than one instance.
+func (c *SchedulerConfig) Validate() error {
+	if c.Jitter < 0 {
+		return fmt.Errorf("scheduler jitter must not be negative: %v", c.Jitter)
+	}
+
+	if c.LockTTL <= c.Jitter {
+		return fmt.Errorf("scheduler lock TTL %v must exceed the jitter %v", c.LockTTL, c.Jitter)
+	}
+
+	return nil
+}
+
+// JobFunc runs a job, it should return when the context is canceled on shutdown.
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	schedule Schedule
+	fn       JobFunc
+}
+
+// Job results of the metrics
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+	resultPanic   = "panic"
+	resultSkipped = "skipped"
+)
+
+// Scheduler runs the jobs on their schedules. With Redis, each run of a job is locked by the scheduled time,
+// so it runs on one of the instances only, and the other instances skip it. Each run is delayed by a random
+// jitter, recovered from panics and measured by the job and the result.
+type Scheduler struct {
+	logger      *logkit.Logger
+	redisClient *rediskit.RedisClient
+	jitter      time.Duration
+	lockTTL     time.Duration
+	lockPrefix  string
+
+	jobs []*job
+
+	runCounter        syncint64.Counter
+	durationHistogram syncint64.Histogram
+}
+
+type schedulerOptions struct {
+	redisClient *rediskit.RedisClient
+}
+
+type SchedulerOption func(opts *schedulerOptions)
+
+// WithRedisLock locks the runs in Redis, so the scheduler runs on every instance but each run happens once.
+// Without it, every instance runs every job.
+func WithRedisLock(client *rediskit.RedisClient) SchedulerOption {
+	return func(opts *schedulerOptions) {
+		opts.redisClient = client
+	}
+}
+
+func NewScheduler(ctx context.Context, conf *SchedulerConfig, meter metric.Meter, opts ...SchedulerOption) *Scheduler {
+	var o schedulerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	logger := logkit.FromContext(ctx)
+
+	runCounter, err := meter.SyncInt64().Counter("scheduler_job_run", instrument.WithDescription("count number of job runs by the result"))
+	if err != nil {
+		logger.Fatal("failed to create job run counter", zap.Error(err))
+	}
+
+	durationHistogram, err := meter.SyncInt64().Histogram("scheduler_job_duration", instrument.WithDescription("measure the duration of the job runs in milliseconds"))
+	if err != nil {
+		logger.Fatal("failed to create job duration histogram", zap.Error(err))
+	}
+
+	return &Scheduler{
+		logger:            logger,
+		redisClient:       o.redisClient,
+		jitter:            conf.Jitter,
+		lockTTL:           conf.LockTTL,
+		lockPrefix:        conf.LockPrefix,
+		runCounter:        runCounter,
+		durationHistogram: durationHistogram,
+	}
+}
+
+// Add schedules the job by the cron expression, see ParseSchedule. It must be called before Run.
+func (s *Scheduler) Add(name, expr string, fn JobFunc) error {
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return err
+	}
+
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, fn: fn})
+
+	s.logger.Info("schedule job", zap.String("job", name), zap.String("schedule", expr))
+
+	return nil
+}
+
+// Run runs the jobs on their schedules until the context is done, then waits for the running jobs to return.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	for _, j := range s.jobs {
+		wg.Add(1)
+
+		go func(j *job) {
+			defer wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+
+	<-ctx.Done()
+	wg.Wait()
+
+	return nil
+}
+
+// loop runs the job at each scheduled time, the runs of a job never overlap on an instance,
+// so a run longer than the interval skips the scheduled times passed.
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	logger := s.logger.With(zap.String("job", j.name))
+
+	for {
+		scheduled := j.schedule.Next(time.Now())
+		if scheduled.IsZero() {
+			logger.Warn("job is never scheduled again")
+			return
+		}
+
+		delay := time.Until(scheduled)
+		if s.jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(s.jitter)))
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.run(ctx, logger.With(zap.Time("scheduled", scheduled)), j, scheduled)
+	}
+}
+
+// run runs the job once if it locks the run.
+func (s *Scheduler) run(ctx context.Context, logger *logkit.Logger, j *job, scheduled time.Time) {
+	attributes := []attribute.KeyValue{attribute.String("job", j.name)}
+
+	locked, err := s.lock(ctx, j, scheduled)
+	if err != nil {
+		logger.Error("failed to lock job run", zap.Error(err))
+		s.runCounter.Add(ctx, 1, append(attributes, attribute.String("result", resultFailure))...)
+		return
+	}
+	if !locked {
+		logger.Debug("skip job run locked by another instance")
+		s.runCounter.Add(ctx, 1, append(attributes, attribute.String("result", resultSkipped))...)
+		return
+	}
+
+	start := time.Now()
+	result := s.call(ctx, logger, j)
+	duration := time.Since(start)
+
+	s.runCounter.Add(ctx, 1, append(attributes, attribute.String("result", result))...)
+	s.durationHistogram.Record(ctx, duration.Milliseconds(), attributes...)
+
+	if result == resultSuccess {
+		logger.Info("run job successfully", zap.Duration("duration", duration))
+	}
+}
+
+// call calls the job and recovers from the panic, so a job does not crash the others.
+func (s *Scheduler) call(ctx context.Context, logger *logkit.Logger, j *job) (result string) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("job panicked", zap.Any("panic", r), zap.ByteString("stack", debug.Stack()))
+			result = resultPanic
+		}
+	}()
+
+	if err := j.fn(ctx); err != nil {
+		logger.Error("failed to run job", zap.Error(err))
+		return resultFailure
+	}
+
+	return resultSuccess
+}
+
+// lock locks the run of the scheduled time in Redis. The lock is not released after the run but expires,
+// otherwise an instance with a longer jitter would lock and run it again.
+func (s *Scheduler) lock(ctx context.Context, j *job, scheduled time.Time) (bool, error) {
+	if s.redisClient == nil {
+		return true, nil
+	}
+
+	key := fmt.Sprintf("%s%s:%d", s.lockPrefix, j.name, scheduled.Unix())
+
+	return s.redisClient.SetNX(ctx, key, time.Now().UTC().Format(time.RFC3339), s.lockTTL).Result()
+}
@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		ctx         context.Context
+		cancel      context.CancelFunc
+		redisClient *rediskit.RedisClient
+		conf        *SchedulerConfig
+		done        chan struct{}
+	)
+
+	newScheduler := func(opts ...SchedulerOption) *Scheduler {
+		return NewScheduler(ctx, conf, nonrecording.NewNoopMeterProvider().Meter(""), opts...)
+	}
+
+	run := func(schedulers ...*Scheduler) {
+		for _, s := range schedulers {
+			go func(s *Scheduler) {
+				defer GinkgoRecover()
+
+				Expect(s.Run(ctx)).To(Succeed())
+				done <- struct{}{}
+			}(s)
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(logkit.NewNopLogger().WithContext(context.Background()))
+		done = make(chan struct{}, 2)
+
+		redisConf := &rediskit.RedisConfig{Addr: "localhost:6379"}
+		if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+			redisConf.Addr = addr
+		}
+		redisClient = rediskit.NewRedisClient(ctx, redisConf)
+
+		conf = &SchedulerConfig{
+			LockTTL: time.Minute,
+			// the lock keys of each test are isolated
+			LockPrefix: "scheduler:test:" + uuid.NewString() + ":",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+		Expect(redisClient.Close()).To(Succeed())
+	})
+
+	It("runs the job on the schedule", func() {
+		var runs int32
+
+		s := newScheduler()
+		Expect(s.Add("count", "@every 50ms", func(context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return nil
+		})).To(Succeed())
+		run(s)
+
+		Eventually(func() int32 { return atomic.LoadInt32(&runs) }).Should(BeNumerically(">=", 2))
+	})
+
+	It("keeps running the job after it panics or fails", func() {
+		var runs int32
+
+		s := newScheduler()
+		Expect(s.Add("panic", "@every 50ms", func(context.Context) error {
+			if atomic.AddInt32(&runs, 1)%2 == 0 {
+				return errors.New("failed")
+			}
+			panic("panicked")
+		})).To(Succeed())
+		run(s)
+
+		Eventually(func() int32 { return atomic.LoadInt32(&runs) }).Should(BeNumerically(">=", 3))
+	})
+
+	It("runs each run on one instance only with the Redis lock", func() {
+		var runs int32
+		job := func(context.Context) error {
+			atomic.AddInt32(&runs, 1)
+			return nil
+		}
+
+		first, second := newScheduler(WithRedisLock(redisClient)), newScheduler(WithRedisLock(redisClient))
+		Expect(first.Add("lock", "@every 200ms", job)).To(Succeed())
+		Expect(second.Add("lock", "@every 200ms", job)).To(Succeed())
+
+		start := time.Now()
+		run(first, second)
+
+		Eventually(func() int32 { return atomic.LoadInt32(&runs) }).Should(BeNumerically(">=", 2))
+		// each scheduled time since the start is run at most once by both instances
+		Expect(atomic.LoadInt32(&runs)).To(BeNumerically("<=", time.Since(start)/(200*time.Millisecond)+1))
+	})
+
+	It("waits for the running job on shutdown", func() {
+		started := make(chan struct{}, 1)
+		var stopped int32
+
+		s := newScheduler()
+		Expect(s.Add("long", "@every 50ms", func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+
+			<-ctx.Done()
+			atomic.StoreInt32(&stopped, 1)
+
+			return ctx.Err()
+		})).To(Succeed())
+		run(s)
+
+		Eventually(started).Should(Receive())
+		cancel()
+
+		Eventually(done).Should(Receive())
+		Expect(atomic.LoadInt32(&stopped)).To(Equal(int32(1)))
+	})
+
+	It("rejects the invalid schedule", func() {
+		Expect(newScheduler().Add("invalid", "every day", func(context.Context) error { return nil })).To(MatchError(ErrInvalidSchedule))
+	})
+})
@@ -0,0 +1,261 @@
+package serverkit
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/otelkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tlskit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+type GrpcServerConfig struct {
+	LogRequests      bool          `long:"log_requests" env:"LOG_REQUESTS" description:"log every request, otherwise only the server faults are logged"`
+	MaxTimeout       time.Duration `long:"max_timeout" env:"MAX_TIMEOUT" description:"the maximum timeout of the unary requests, unlimited if zero" default:"30s"`
+	MaxStreamTimeout time.Duration `long:"max_stream_timeout" env:"MAX_STREAM_TIMEOUT" description:"the maximum timeout of the streams, unlimited if zero"`
+	TLS              tlskit.Config `group:"tls" namespace:"tls" env-namespace:"TLS"`
+
+	LoadShedding   grpckit.LoadSheddingConfig   `group:"load_shedding" namespace:"load_shedding" env-namespace:"LOAD_SHEDDING"`
+	Authz          grpckit.AuthzConfig          `group:"authz" namespace:"authz" env-namespace:"AUTHZ"`
+	Tenants        []string                     `long:"tenants" env:"TENANTS" env-delim:";" description:"the tenants bound to the SPIFFE IDs of the peers in the format of id=tenant, the requests of a bound peer are of its tenant only"`
+	FaultInjection grpckit.FaultInjectionConfig `group:"fault_injection" namespace:"fault_injection" env-namespace:"FAULT_INJECTION"`
+}
+
+// GrpcServer is the gRPC server along with its health service.
+type GrpcServer struct {
+	*grpc.Server
+
+	health *health.Server
+	faults *grpckit.FaultInjector
+
+	scopes grpckit.MethodScopes
+	logger *logkit.Logger
+}
+
+// Serve serves the requests on the listener. The server refuses to serve if it authorizes the requests
+// by the method scopes while a method registered declares no scope.
+func (s *GrpcServer) Serve(lis net.Listener) error {
+	if s.scopes != nil {
+		if err := s.scopes.Check(s.GetServiceInfo()); err != nil {
+			s.logger.Fatal("failed to check method scopes", zap.Error(err))
+		}
+	}
+
+	return s.Server.Serve(lis)
+}
+
+// Shutdown stops accepting new requests and drains the in-flight ones until the context is done,
+// then the remaining ones, such as the long-lived streams, are closed. The health service reports
+// NOT_SERVING first, so the clients balancing by the health move the new requests to other instances.
+func (s *GrpcServer) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.Server.Stop()
+		return ctx.Err()
+	}
+}
+
+// FaultInjector returns the injector of the faults set on the admin endpoint, or nil if fault injection is disabled.
+func (s *GrpcServer) FaultInjector() *grpckit.FaultInjector {
+	return s.faults
+}
+
+type grpcServerOptions struct {
+	meter              *otelkit.PrometheusServiceMeter
+	methodScopes       grpckit.MethodScopes
+	errorMappings      []grpckit.ErrorMapping
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	serverOptions      []grpc.ServerOption
+}
+
+type GrpcServerOption func(opts *grpcServerOptions)
+
+// WithMeter records the metrics of the requests.
+func WithMeter(meter *otelkit.PrometheusServiceMeter) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.meter = meter
+	}
+}
+
+// WithMethodScopes authorizes the requests by the scopes of the methods, the scopes are granted to the peers
+// by the authz grants, see grpckit.UnaryServerAuthzInterceptor.
+func WithMethodScopes(scopes grpckit.MethodScopes) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.methodScopes = scopes
+	}
+}
+
+// WithErrorMappings maps the errors returned by the handlers to the service errors.
+func WithErrorMappings(mappings ...grpckit.ErrorMapping) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.errorMappings = append(opts.errorMappings, mappings...)
+	}
+}
+
+// WithUnaryInterceptors adds the unary interceptors of the module, such as authentication,
+// tracing and rate limiting, which run after the metrics are recorded and before the errors are mapped.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.unaryInterceptors = append(opts.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors adds the stream interceptors of the module, see WithUnaryInterceptors.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.streamInterceptors = append(opts.streamInterceptors, interceptors...)
+	}
+}
+
+// WithServerOptions adds the options of the gRPC server.
+func WithServerOptions(serverOptions ...grpc.ServerOption) GrpcServerOption {
+	return func(opts *grpcServerOptions) {
+		opts.serverOptions = append(opts.serverOptions, serverOptions...)
+	}
+}
+
+// NewGrpcServer creates the gRPC server with the standard interceptor chain of the modules,
+// from the outermost: tracing, logging, recovery, deadline, metrics, fault injection, load shedding, peer identity, authorization,
+// the interceptors of the options, error mapping, validation and tenant. The server serves TLS if configured,
+// and the peers are identified by the SPIFFE IDs of their certificates if the CA is set (mTLS). The server reflection
+// and the health service, which the clients check to balance the requests over the healthy instances, are registered.
+func NewGrpcServer(ctx context.Context, conf *GrpcServerConfig, opts ...GrpcServerOption) *GrpcServer {
+	logger := logkit.FromContext(ctx)
+
+	var o grpcServerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// the health and the reflection services registered below are public
+	var scopes grpckit.MethodScopes
+	var grants grpckit.Grants
+	if o.methodScopes != nil {
+		scopes = o.methodScopes.
+			Declare(&healthpb.Health_ServiceDesc, grpckit.ScopePublic).
+			Declare(&reflectionpb.ServerReflection_ServiceDesc, grpckit.ScopePublic)
+
+		var err error
+		if grants, err = grpckit.ParseGrants(conf.Authz.Grants); err != nil {
+			logger.Fatal("failed to parse authz grants", zap.Error(err))
+		}
+		if len(grants) > 0 && conf.TLS.CAFile == "" {
+			logger.Fatal("failed to grant scopes without identifying the peers by mTLS")
+		}
+		// the nil grants grant every scope to any peer
+		if conf.Authz.AllowAll {
+			logger.Warn("every scope is granted to any peer")
+			grants = nil
+		}
+	}
+
+	tenants, err := tenantkit.ParseBindings(conf.Tenants)
+	if err != nil {
+		logger.Fatal("failed to parse tenant bindings", zap.Error(err))
+	}
+	if len(tenants) > 0 && conf.TLS.CAFile == "" {
+		logger.Fatal("failed to bind tenants without identifying the peers by mTLS")
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		otelkit.UnaryServerTracingInterceptor(),
+		grpckit.UnaryServerLoggingInterceptor(logger, conf.LogRequests),
+		grpckit.UnaryServerRecoveryInterceptor(logger),
+		grpckit.UnaryServerDeadlineInterceptor(conf.MaxTimeout),
+	}
+	if o.meter != nil {
+		unaryInterceptors = append(unaryInterceptors, o.meter.UnaryServerInterceptor())
+	}
+	// the injected faults are measured and logged as the real ones, so the experiments show on the dashboards
+	faults := grpckit.NewFaultInjector(&conf.FaultInjection)
+	if faults != nil {
+		logger.Warn("fault injection is enabled")
+		unaryInterceptors = append(unaryInterceptors, faults.UnaryServerInterceptor())
+	}
+	// the shed requests are measured and logged as Unavailable, but cost no more than that
+	shedder := grpckit.NewLoadShedder(&conf.LoadShedding)
+	if shedder != nil {
+		unaryInterceptors = append(unaryInterceptors, shedder.UnaryServerInterceptor())
+	}
+	if conf.TLS.CAFile != "" {
+		unaryInterceptors = append(unaryInterceptors, grpckit.UnaryServerIdentityInterceptor(conf.TLS.AllowedIDs))
+	}
+	if scopes != nil {
+		unaryInterceptors = append(unaryInterceptors, grpckit.UnaryServerAuthzInterceptor(scopes, grants))
+	}
+	unaryInterceptors = append(unaryInterceptors, o.unaryInterceptors...)
+	unaryInterceptors = append(unaryInterceptors,
+		grpckit.UnaryServerErrorInterceptor(o.errorMappings...),
+		grpckit.UnaryServerValidatorInterceptor(),
+		tenantkit.UnaryServerInterceptor(tenants),
+	)
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		otelkit.StreamServerTracingInterceptor(),
+		grpckit.StreamServerLoggingInterceptor(logger, conf.LogRequests),
+		grpckit.StreamServerRecoveryInterceptor(logger),
+		grpckit.StreamServerDeadlineInterceptor(conf.MaxStreamTimeout),
+	}
+	if o.meter != nil {
+		streamInterceptors = append(streamInterceptors, o.meter.StreamServerInterceptor())
+	}
+	if faults != nil {
+		streamInterceptors = append(streamInterceptors, faults.StreamServerInterceptor())
+	}
+	if shedder != nil {
+		streamInterceptors = append(streamInterceptors, shedder.StreamServerInterceptor())
+	}
+	if conf.TLS.CAFile != "" {
+		streamInterceptors = append(streamInterceptors, grpckit.StreamServerIdentityInterceptor(conf.TLS.AllowedIDs))
+	}
+	if scopes != nil {
+		streamInterceptors = append(streamInterceptors, grpckit.StreamServerAuthzInterceptor(scopes, grants))
+	}
+	streamInterceptors = append(streamInterceptors, o.streamInterceptors...)
+	streamInterceptors = append(streamInterceptors,
+		grpckit.StreamServerErrorInterceptor(o.errorMappings...),
+		grpckit.StreamServerValidatorInterceptor(),
+		tenantkit.StreamServerInterceptor(tenants),
+	)
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if conf.TLS.Enabled() {
+		serverOptions = append(serverOptions, grpc.Creds(tlskit.NewServerCredentials(ctx, &conf.TLS)))
+	}
+	serverOptions = append(serverOptions, o.serverOptions...)
+
+	grpcServer := &GrpcServer{
+		Server: grpc.NewServer(serverOptions...),
+		health: health.NewServer(),
+		faults: faults,
+		scopes: scopes,
+		logger: logger,
+	}
+	reflection.Register(grpcServer)
+	healthpb.RegisterHealthServer(grpcServer, grpcServer.health)
+
+	return grpcServer
+}
@@ -0,0 +1,211 @@
+package serverkit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	testpb "google.golang.org/grpc/interop/grpc_testing"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var errFakeNotFound = errors.New("fake not found")
+
+// fakeTestServer handles the requests by the check function.
+type fakeTestServer struct {
+	testpb.UnimplementedTestServiceServer
+
+	check func(ctx context.Context) error
+}
+
+func (s *fakeTestServer) EmptyCall(ctx context.Context, _ *testpb.Empty) (*testpb.Empty, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+
+	return &testpb.Empty{}, nil
+}
+
+var _ = Describe("NewGrpcServer", func() {
+	var (
+		server      *fakeTestServer
+		grpcServer  *GrpcServer
+		conn        *grpc.ClientConn
+		intercepted bool
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		server = &fakeTestServer{}
+		intercepted = false
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+
+		grpcServer = NewGrpcServer(ctx, &GrpcServerConfig{MaxTimeout: 30 * time.Second, FaultInjection: grpckit.FaultInjectionConfig{Enabled: true}},
+			WithErrorMappings(grpckit.ErrorMapping{Err: errFakeNotFound, Status: status.Error(codes.NotFound, "not found")}),
+			WithUnaryInterceptors(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				intercepted = true
+				return handler(ctx, req)
+			}),
+		)
+		testpb.RegisterTestServiceServer(grpcServer, server)
+
+		lis := bufconn.Listen(1 << 20)
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+
+		var err error
+		conn, err = grpc.DialContext(ctx, "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		grpcServer.Stop()
+	})
+
+	check := func() error {
+		_, err := testpb.NewTestServiceClient(conn).EmptyCall(metadata.AppendToOutgoingContext(ctx, tenantkit.MetadataKey, "tenant"), &testpb.Empty{})
+		return err
+	}
+
+	It("injects the logger and tenant into the request context", func() {
+		server.check = func(ctx context.Context) error {
+			Expect(logkit.FromContext(ctx)).NotTo(BeNil())
+			Expect(tenantkit.FromContext(ctx)).To(Equal("tenant"))
+			return nil
+		}
+
+		Expect(check()).To(Succeed())
+		Expect(intercepted).To(BeTrue())
+	})
+
+	It("maps the errors of the handlers", func() {
+		server.check = func(ctx context.Context) error { return errFakeNotFound }
+
+		Expect(status.Code(check())).To(Equal(codes.NotFound))
+	})
+
+	It("recovers the panics of the handlers", func() {
+		server.check = func(ctx context.Context) error { panic("boom") }
+
+		Expect(check()).To(MatchError(grpckit.ErrPanic))
+		Expect(check()).To(MatchError(grpckit.ErrPanic))
+	})
+
+	It("enforces the max timeout of the requests", func() {
+		server.check = func(ctx context.Context) error {
+			deadline, ok := ctx.Deadline()
+			Expect(ok).To(BeTrue())
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(30*time.Second), 5*time.Second))
+			return nil
+		}
+
+		Expect(check()).To(Succeed())
+	})
+
+	It("injects the faults set on the fault injector", func() {
+		server.check = func(ctx context.Context) error { return nil }
+
+		Expect(grpcServer.FaultInjector().Set(&grpckit.Fault{
+			Method:     "/grpc.testing.TestService/EmptyCall",
+			Percentage: 100,
+			Code:       codes.Unavailable,
+		})).To(Succeed())
+		Expect(status.Code(check())).To(Equal(codes.Unavailable))
+
+		grpcServer.FaultInjector().Clear("")
+		Expect(check()).To(Succeed())
+	})
+
+	It("registers the health service", func() {
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetStatus()).To(Equal(healthpb.HealthCheckResponse_SERVING))
+	})
+
+	It("registers the server reflection", func() {
+		Expect(grpcServer.GetServiceInfo()).To(HaveKey("grpc.reflection.v1alpha.ServerReflection"))
+	})
+
+	Describe("Shutdown", func() {
+		var (
+			started chan struct{}
+			release chan struct{}
+			callErr chan error
+		)
+
+		BeforeEach(func() {
+			started = make(chan struct{})
+			release = make(chan struct{})
+			callErr = make(chan error, 1)
+
+			server.check = func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				callErr <- check()
+			}()
+			Eventually(started).Should(BeClosed())
+		})
+
+		AfterEach(func() {
+			select {
+			case <-release:
+			default:
+				close(release)
+			}
+		})
+
+		healthStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+			resp, err := grpcServer.health.Check(ctx, &healthpb.HealthCheckRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			return resp.GetStatus()
+		}
+
+		It("reports not serving and drains the in-flight requests", func() {
+			shutdownErr := make(chan error, 1)
+			go func() {
+				shutdownErr <- grpcServer.Shutdown(context.Background())
+			}()
+
+			Eventually(healthStatus).Should(Equal(healthpb.HealthCheckResponse_NOT_SERVING))
+			Consistently(shutdownErr).ShouldNot(Receive())
+
+			close(release)
+			Eventually(shutdownErr).Should(Receive(BeNil()))
+			Eventually(callErr).Should(Receive(BeNil()))
+		})
+
+		It("closes the remaining requests when the context is done", func() {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			Expect(grpcServer.Shutdown(shutdownCtx)).To(MatchError(context.DeadlineExceeded))
+
+			var err error
+			Eventually(callErr).Should(Receive(&err))
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+	})
+})
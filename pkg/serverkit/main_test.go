@@ -0,0 +1,13 @@
+package serverkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServerKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Server Kit")
+}
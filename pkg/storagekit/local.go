@@ -0,0 +1,226 @@
+package storagekit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type LocalConfig struct {
+	Dir    string `long:"dir" env:"DIR" description:"the directory storing the buckets" required:"true"`
+	Bucket string `long:"bucket" env:"BUCKET" description:"the bucket name" required:"true"`
+}
+
+// LocalStorage stores the objects as files under Dir/Bucket, it is meant for
+// local development and tests where running an object storage is overkill.
+type LocalStorage struct {
+	dir        string
+	bucketName string
+}
+
+var _ Storage = (*LocalStorage)(nil)
+
+// multipart uploads are kept under Dir/.multipart/<upload ID> until completed
+const localMultipartDir = ".multipart"
+
+func (s *LocalStorage) Endpoint() string {
+	return s.dir
+}
+
+func (s *LocalStorage) Bucket() string {
+	return s.bucketName
+}
+
+func (s *LocalStorage) PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) error {
+	path, err := s.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+
+	if objectSize >= 0 {
+		reader = io.LimitReader(reader, objectSize)
+	}
+
+	return writeFile(path, reader)
+}
+
+func (s *LocalStorage) GetObject(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	path, err := s.objectPath(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *LocalStorage) DeleteObject(ctx context.Context, objectName string) error {
+	path, err := s.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+
+	// deleting a non-existing object is not an error, the same as S3
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) SignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	return "", ErrSignedURLNotSupported
+}
+
+func (s *LocalStorage) NewMultipartUpload(ctx context.Context, objectName string, opts PutObjectOptions) (string, error) {
+	if _, err := s.objectPath(objectName); err != nil {
+		return "", err
+	}
+
+	uploadID := uuid.New().String()
+	if err := os.MkdirAll(s.uploadPath(uploadID), 0o755); err != nil {
+		return "", err
+	}
+
+	return uploadID, nil
+}
+
+func (s *LocalStorage) PutObjectPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, partSize int64) (ObjectPart, error) {
+	if err := s.checkUpload(uploadID); err != nil {
+		return ObjectPart{}, err
+	}
+
+	etag := strconv.Itoa(partNumber)
+	if err := writeFile(filepath.Join(s.uploadPath(uploadID), etag), io.LimitReader(reader, partSize)); err != nil {
+		return ObjectPart{}, err
+	}
+
+	return ObjectPart{
+		PartNumber: partNumber,
+		ETag:       etag,
+	}, nil
+}
+
+func (s *LocalStorage) CompleteMultipartUpload(ctx context.Context, objectName, uploadID string, parts []ObjectPart) error {
+	if err := s.checkUpload(uploadID); err != nil {
+		return err
+	}
+
+	path, err := s.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+
+	readers := make([]io.Reader, 0, len(parts))
+	for _, part := range parts {
+		f, err := os.Open(filepath.Join(s.uploadPath(uploadID), part.ETag))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		readers = append(readers, f)
+	}
+
+	if err := writeFile(path, io.MultiReader(readers...)); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.uploadPath(uploadID))
+}
+
+func (s *LocalStorage) AbortMultipartUpload(ctx context.Context, objectName, uploadID string) error {
+	if err := s.checkUpload(uploadID); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.uploadPath(uploadID))
+}
+
+// objectPath returns the file path of the object, rejecting names escaping the bucket
+func (s *LocalStorage) objectPath(objectName string) (string, error) {
+	name := filepath.Clean("/" + objectName)
+	if name == "/" {
+		return "", ErrInvalidObjectName
+	}
+
+	return filepath.Join(s.dir, s.bucketName, name), nil
+}
+
+func (s *LocalStorage) uploadPath(uploadID string) string {
+	return filepath.Join(s.dir, localMultipartDir, uploadID)
+}
+
+func (s *LocalStorage) checkUpload(uploadID string) error {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return ErrMultipartUploadNotFound
+	}
+
+	if _, err := os.Stat(s.uploadPath(uploadID)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ErrMultipartUploadNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// writeFile writes the file through a temporary file, so that readers never see a partial file
+func writeFile(path string, reader io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, reader); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func NewLocalStorage(ctx context.Context, conf *LocalConfig) *LocalStorage {
+	logger := logkit.FromContext(ctx).
+		With(zap.String("dir", conf.Dir)).
+		With(zap.String("bucket", conf.Bucket))
+
+	if err := os.MkdirAll(filepath.Join(conf.Dir, conf.Bucket), 0o755); err != nil {
+		logger.Fatal("failed to create bucket directory", zap.Error(err))
+	}
+
+	logger.Info("create local storage successfully")
+
+	return &LocalStorage{
+		dir:        conf.Dir,
+		bucketName: conf.Bucket,
+	}
+}
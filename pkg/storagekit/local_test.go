@@ -0,0 +1,43 @@
+package storagekit
+
+import (
+	"context"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalStorage", func() {
+	describeStorage(func(ctx context.Context) Storage {
+		return NewLocalStorage(logkit.NewNopLogger().WithContext(ctx), &LocalConfig{
+			Dir:    GinkgoT().TempDir(),
+			Bucket: "videos",
+		})
+	})
+
+	Describe("SignedURL", func() {
+		It("is not supported", func() {
+			storage := NewLocalStorage(logkit.NewNopLogger().WithContext(context.Background()), &LocalConfig{
+				Dir:    GinkgoT().TempDir(),
+				Bucket: "videos",
+			})
+
+			_, err := storage.SignedURL(context.Background(), "object", 0)
+			Expect(err).To(MatchError(ErrSignedURLNotSupported))
+		})
+	})
+
+	Describe("objectPath", func() {
+		It("keeps the object in the bucket", func() {
+			storage := &LocalStorage{dir: "/data", bucketName: "videos"}
+
+			path, err := storage.objectPath("../../etc/passwd")
+			Expect(path).To(Equal("/data/videos/etc/passwd"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = storage.objectPath("..")
+			Expect(err).To(MatchError(ErrInvalidObjectName))
+		})
+	})
+})
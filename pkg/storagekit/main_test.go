@@ -0,0 +1,13 @@
+package storagekit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestStorageKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Storage Kit")
+}
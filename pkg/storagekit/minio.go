@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
+	"time"
 
 	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
 	"github.com/minio/minio-go/v7"
@@ -18,8 +20,15 @@ type MinIOConfig struct {
 	Password string `long:"password" env:"PASSWORD" description:"the secret access key (password) to the MinIO server" required:"true"`
 	Insecure bool   `long:"insecure" env:"INSECURE" description:"disable HTTPS or not"`
 	Policy   string `long:"policy" env:"POLICY" description:"the bucket policy" default:"public"`
+	Region   string `long:"region" env:"REGION" description:"the region of the bucket, required by S3 and GCS, where it is the location of the bucket or auto"`
 }
 
+// GCSEndpoint is the XML API endpoint of GCS, which is compatible with S3 for the HMAC keys
+const GCSEndpoint = "storage.googleapis.com"
+
+// MinIOClient works with any S3-compatible storage, including AWS S3 and
+// GCS with HMAC keys through its interoperability endpoint GCSEndpoint.
+
 type MinIOClient struct {
 	*minio.Client
 	bucketName string
@@ -45,6 +54,90 @@ func (c *MinIOClient) PutObject(ctx context.Context, objectName string, reader i
 	return nil
 }
 
+func (c *MinIOClient) GetObject(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	// GetObject is lazy and only fails on read, so stat the object to report not found early
+	if _, err := c.Client.StatObject(ctx, c.bucketName, objectName, minio.StatObjectOptions{}); err != nil {
+		return nil, toStorageError(err)
+	}
+
+	object, err := c.Client.GetObject(ctx, c.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, toStorageError(err)
+	}
+
+	return object, nil
+}
+
+func (c *MinIOClient) DeleteObject(ctx context.Context, objectName string) error {
+	if err := c.Client.RemoveObject(ctx, c.bucketName, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return toStorageError(err)
+	}
+
+	return nil
+}
+
+func (c *MinIOClient) SignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	u, err := c.Client.PresignedGetObject(ctx, c.bucketName, objectName, expiry, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (c *MinIOClient) NewMultipartUpload(ctx context.Context, objectName string, opts PutObjectOptions) (string, error) {
+	return c.core().NewMultipartUpload(ctx, c.bucketName, objectName, minio.PutObjectOptions{
+		ContentType: opts.ContentType,
+	})
+}
+
+func (c *MinIOClient) PutObjectPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, partSize int64) (ObjectPart, error) {
+	part, err := c.core().PutObjectPart(ctx, c.bucketName, objectName, uploadID, partNumber, reader, partSize, "", "", nil)
+	if err != nil {
+		return ObjectPart{}, toStorageError(err)
+	}
+
+	return ObjectPart{
+		PartNumber: part.PartNumber,
+		ETag:       part.ETag,
+	}, nil
+}
+
+func (c *MinIOClient) CompleteMultipartUpload(ctx context.Context, objectName, uploadID string, parts []ObjectPart) error {
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, part := range parts {
+		completeParts = append(completeParts, minio.CompletePart{
+			PartNumber: part.PartNumber,
+			ETag:       part.ETag,
+		})
+	}
+
+	if _, err := c.core().CompleteMultipartUpload(ctx, c.bucketName, objectName, uploadID, completeParts, minio.PutObjectOptions{}); err != nil {
+		return toStorageError(err)
+	}
+
+	return nil
+}
+
+func (c *MinIOClient) AbortMultipartUpload(ctx context.Context, objectName, uploadID string) error {
+	return toStorageError(c.core().AbortMultipartUpload(ctx, c.bucketName, objectName, uploadID))
+}
+
+func (c *MinIOClient) core() *minio.Core {
+	return &minio.Core{Client: c.Client}
+}
+
+func toStorageError(err error) error {
+	switch minio.ToErrorResponse(err).Code {
+	case "NoSuchKey":
+		return ErrObjectNotFound
+	case "NoSuchUpload":
+		return ErrMultipartUploadNotFound
+	}
+
+	return err
+}
+
 func NewMinIOClient(ctx context.Context, conf *MinIOConfig) *MinIOClient {
 	logger := logkit.FromContext(ctx).
 		With(zap.String("endpoint", conf.Endpoint)).
@@ -53,6 +146,7 @@ func NewMinIOClient(ctx context.Context, conf *MinIOConfig) *MinIOClient {
 	client, err := minio.New(conf.Endpoint, &minio.Options{
 		Creds:  credentials.NewStaticV4(conf.Username, conf.Password, ""),
 		Secure: !conf.Insecure,
+		Region: conf.Region,
 	})
 	if err != nil {
 		logger.Fatal("failed to create MinIO client", zap.Error(err))
@@ -65,7 +159,13 @@ func NewMinIOClient(ctx context.Context, conf *MinIOConfig) *MinIOClient {
 		}
 
 		if !ok {
-			if err := client.MakeBucket(ctx, conf.Bucket, minio.MakeBucketOptions{}); err != nil {
+			// the buckets of GCS belong to projects and take the access by IAM instead of the bucket policies,
+			// neither of which the S3 API covers
+			if isGCSEndpoint(conf.Endpoint) {
+				logger.Fatal("failed to find bucket, create it in GCS beforehand")
+			}
+
+			if err := client.MakeBucket(ctx, conf.Bucket, minio.MakeBucketOptions{Region: conf.Region}); err != nil {
 				logger.Fatal("failed to create bucket", zap.Error(err))
 			}
 
@@ -85,6 +185,15 @@ func NewMinIOClient(ctx context.Context, conf *MinIOConfig) *MinIOClient {
 	}
 }
 
+func isGCSEndpoint(endpoint string) bool {
+	host, _, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		host = endpoint
+	}
+
+	return host == GCSEndpoint
+}
+
 func generatePolicy(bucketName string, policy string) string {
 	switch policy {
 	case "public":
@@ -0,0 +1,111 @@
+package storagekit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MinIOClient", func() {
+	var minioConf *MinIOConfig
+
+	BeforeEach(func() {
+		minioConf = &MinIOConfig{
+			Endpoint: "minio:9000",
+			Bucket:   "storagekit-test",
+			Username: "minioadmin",
+			Password: "minioadmin",
+			Insecure: true,
+			Policy:   "private",
+		}
+		if endpoint := os.Getenv("MINIO_TEST_ENDPOINT"); endpoint != "" {
+			minioConf.Endpoint = endpoint
+		}
+	})
+
+	describeStorage(func(ctx context.Context) Storage {
+		return NewMinIOClient(logkit.NewNopLogger().WithContext(ctx), minioConf)
+	})
+
+	describeSignedURL(func(ctx context.Context) Storage {
+		return NewMinIOClient(logkit.NewNopLogger().WithContext(ctx), minioConf)
+	})
+})
+
+var _ = Describe("isGCSEndpoint", func() {
+	It("matches the XML API endpoint of GCS with or without the port", func() {
+		Expect(isGCSEndpoint("storage.googleapis.com")).To(BeTrue())
+		Expect(isGCSEndpoint("storage.googleapis.com:443")).To(BeTrue())
+		Expect(isGCSEndpoint("minio:9000")).To(BeFalse())
+		Expect(isGCSEndpoint("s3.amazonaws.com")).To(BeFalse())
+	})
+})
+
+// GCS is tested through its interoperability endpoint with the HMAC keys of GCS_TEST_ACCESS_KEY and
+// GCS_TEST_SECRET on the existing bucket GCS_TEST_BUCKET, and the specs are skipped without them.
+var _ = Describe("MinIOClient on GCS", func() {
+	var gcsConf *MinIOConfig
+
+	BeforeEach(func() {
+		gcsConf = &MinIOConfig{
+			Endpoint: GCSEndpoint,
+			Bucket:   os.Getenv("GCS_TEST_BUCKET"),
+			Username: os.Getenv("GCS_TEST_ACCESS_KEY"),
+			Password: os.Getenv("GCS_TEST_SECRET"),
+			Region:   "auto",
+		}
+		if gcsConf.Bucket == "" || gcsConf.Username == "" || gcsConf.Password == "" {
+			Skip("GCS_TEST_BUCKET, GCS_TEST_ACCESS_KEY and GCS_TEST_SECRET are not set")
+		}
+	})
+
+	describeStorage(func(ctx context.Context) Storage {
+		return NewMinIOClient(logkit.NewNopLogger().WithContext(ctx), gcsConf)
+	})
+
+	describeSignedURL(func(ctx context.Context) Storage {
+		return NewMinIOClient(logkit.NewNopLogger().WithContext(ctx), gcsConf)
+	})
+})
+
+func describeSignedURL(newStorage func(ctx context.Context) Storage) {
+	Describe("SignedURL", func() {
+		It("downloads the object without credentials", func() {
+			ctx := context.Background()
+			storage := newStorage(ctx)
+
+			Expect(storage.PutObject(ctx, "signed", io.LimitReader(zeroReader{}, 3), 3, PutObjectOptions{})).To(Succeed())
+			defer func() {
+				Expect(storage.DeleteObject(ctx, "signed")).To(Succeed())
+			}()
+
+			url, err := storage.SignedURL(ctx, "signed", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			defer resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+
+	return len(p), nil
+}
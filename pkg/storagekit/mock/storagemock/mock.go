@@ -8,6 +8,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	storagekit "github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +37,20 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AbortMultipartUpload mocks base method.
+func (m *MockStorage) AbortMultipartUpload(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortMultipartUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortMultipartUpload indicates an expected call of AbortMultipartUpload.
+func (mr *MockStorageMockRecorder) AbortMultipartUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortMultipartUpload", reflect.TypeOf((*MockStorage)(nil).AbortMultipartUpload), arg0, arg1, arg2)
+}
+
 // Bucket mocks base method.
 func (m *MockStorage) Bucket() string {
 	m.ctrl.T.Helper()
@@ -50,6 +65,34 @@ func (mr *MockStorageMockRecorder) Bucket() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bucket", reflect.TypeOf((*MockStorage)(nil).Bucket))
 }
 
+// CompleteMultipartUpload mocks base method.
+func (m *MockStorage) CompleteMultipartUpload(arg0 context.Context, arg1, arg2 string, arg3 []storagekit.ObjectPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMultipartUpload", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteMultipartUpload indicates an expected call of CompleteMultipartUpload.
+func (mr *MockStorageMockRecorder) CompleteMultipartUpload(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMultipartUpload", reflect.TypeOf((*MockStorage)(nil).CompleteMultipartUpload), arg0, arg1, arg2, arg3)
+}
+
+// DeleteObject mocks base method.
+func (m *MockStorage) DeleteObject(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockStorageMockRecorder) DeleteObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockStorage)(nil).DeleteObject), arg0, arg1)
+}
+
 // Endpoint mocks base method.
 func (m *MockStorage) Endpoint() string {
 	m.ctrl.T.Helper()
@@ -64,6 +107,36 @@ func (mr *MockStorageMockRecorder) Endpoint() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Endpoint", reflect.TypeOf((*MockStorage)(nil).Endpoint))
 }
 
+// GetObject mocks base method.
+func (m *MockStorage) GetObject(arg0 context.Context, arg1 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockStorageMockRecorder) GetObject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockStorage)(nil).GetObject), arg0, arg1)
+}
+
+// NewMultipartUpload mocks base method.
+func (m *MockStorage) NewMultipartUpload(arg0 context.Context, arg1 string, arg2 storagekit.PutObjectOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewMultipartUpload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewMultipartUpload indicates an expected call of NewMultipartUpload.
+func (mr *MockStorageMockRecorder) NewMultipartUpload(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMultipartUpload", reflect.TypeOf((*MockStorage)(nil).NewMultipartUpload), arg0, arg1, arg2)
+}
+
 // PutObject mocks base method.
 func (m *MockStorage) PutObject(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 int64, arg4 storagekit.PutObjectOptions) error {
 	m.ctrl.T.Helper()
@@ -77,3 +150,33 @@ func (mr *MockStorageMockRecorder) PutObject(arg0, arg1, arg2, arg3, arg4 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObject", reflect.TypeOf((*MockStorage)(nil).PutObject), arg0, arg1, arg2, arg3, arg4)
 }
+
+// PutObjectPart mocks base method.
+func (m *MockStorage) PutObjectPart(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 io.Reader, arg5 int64) (storagekit.ObjectPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutObjectPart", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(storagekit.ObjectPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutObjectPart indicates an expected call of PutObjectPart.
+func (mr *MockStorageMockRecorder) PutObjectPart(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutObjectPart", reflect.TypeOf((*MockStorage)(nil).PutObjectPart), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// SignedURL mocks base method.
+func (m *MockStorage) SignedURL(arg0 context.Context, arg1 string, arg2 time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignedURL", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignedURL indicates an expected call of SignedURL.
+func (mr *MockStorageMockRecorder) SignedURL(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignedURL", reflect.TypeOf((*MockStorage)(nil).SignedURL), arg0, arg1, arg2)
+}
@@ -0,0 +1,176 @@
+package storagekit
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+)
+
+// RegionalMinIOConfig is of the MinIO of the regions the tenants are pinned to, the clients of the regions share
+// the credentials and the other settings of the home one.
+type RegionalMinIOConfig struct {
+	MinIOEndpoints map[string]string `long:"minio_endpoints" env:"MINIO_ENDPOINTS" env-delim:"," description:"the endpoints of MinIO of the regions, as region:endpoint pairs, required for every region a tenant is pinned to"`
+	MinIOBuckets   map[string]string `long:"minio_buckets" env:"MINIO_BUCKETS" env-delim:"," description:"the buckets of the regions, as region:bucket pairs, the bucket of the home region if not listed"`
+}
+
+// regionalStorage routes the objects to the storage of the region the tenant of the context is pinned to,
+// the tenants not pinned are served by the home storage. Endpoint and Bucket have no context, so they are of
+// the home storage, see StorageOf.
+type regionalStorage struct {
+	home    Storage
+	regions map[string]Storage
+	conf    *tenantkit.RegionConfig
+}
+
+var _ Storage = (*regionalStorage)(nil)
+
+var (
+	ErrRegionNotConfigured = errors.New("region not configured")
+)
+
+func NewRegionalStorage(home Storage, regions map[string]Storage, conf *tenantkit.RegionConfig) Storage {
+	return &regionalStorage{
+		home:    home,
+		regions: regions,
+		conf:    conf,
+	}
+}
+
+// NewRegionalMinIOClient returns the storage keeping the objects of the pinned tenants in MinIO of their regions,
+// the home storage is returned as is if no tenant is pinned.
+func NewRegionalMinIOClient(ctx context.Context, homeStorage Storage, minioConf *MinIOConfig, regionalConf *RegionalMinIOConfig, regionConf *tenantkit.RegionConfig) Storage {
+	regions := regionConf.Regions()
+	if len(regions) == 0 {
+		return homeStorage
+	}
+
+	regionStorages := make(map[string]Storage, len(regions))
+	for _, region := range regions {
+		endpoint, ok := regionalConf.MinIOEndpoints[region]
+		if !ok {
+			logkit.FromContext(ctx).Fatal("failed to find MinIO endpoint of region", zap.String("region", region))
+		}
+
+		conf := *minioConf
+		conf.Endpoint = endpoint
+		if bucket, ok := regionalConf.MinIOBuckets[region]; ok {
+			conf.Bucket = bucket
+		}
+
+		regionStorages[region] = NewMinIOClient(ctx, &conf)
+	}
+
+	return NewRegionalStorage(homeStorage, regionStorages, regionConf)
+}
+
+// StorageOf returns the storage of the region the tenant of the context is pinned to if the storage is regional,
+// or the storage itself. The URLs of the objects are built from the endpoint and bucket of the storage it returns,
+// which keeps the objects.
+func StorageOf(ctx context.Context, storage Storage) (Storage, error) {
+	if s, ok := storage.(*regionalStorage); ok {
+		return s.regionOf(ctx)
+	}
+
+	return storage, nil
+}
+
+func (s *regionalStorage) Endpoint() string {
+	return s.home.Endpoint()
+}
+
+func (s *regionalStorage) Bucket() string {
+	return s.home.Bucket()
+}
+
+func (s *regionalStorage) PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) error {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionStorage.PutObject(ctx, objectName, reader, objectSize, opts)
+}
+
+func (s *regionalStorage) GetObject(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return regionStorage.GetObject(ctx, objectName)
+}
+
+func (s *regionalStorage) DeleteObject(ctx context.Context, objectName string) error {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionStorage.DeleteObject(ctx, objectName)
+}
+
+func (s *regionalStorage) SignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return regionStorage.SignedURL(ctx, objectName, expiry)
+}
+
+func (s *regionalStorage) NewMultipartUpload(ctx context.Context, objectName string, opts PutObjectOptions) (string, error) {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return regionStorage.NewMultipartUpload(ctx, objectName, opts)
+}
+
+func (s *regionalStorage) PutObjectPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, partSize int64) (ObjectPart, error) {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return ObjectPart{}, err
+	}
+
+	return regionStorage.PutObjectPart(ctx, objectName, uploadID, partNumber, reader, partSize)
+}
+
+func (s *regionalStorage) CompleteMultipartUpload(ctx context.Context, objectName, uploadID string, parts []ObjectPart) error {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionStorage.CompleteMultipartUpload(ctx, objectName, uploadID, parts)
+}
+
+func (s *regionalStorage) AbortMultipartUpload(ctx context.Context, objectName, uploadID string) error {
+	regionStorage, err := s.regionOf(ctx)
+	if err != nil {
+		return err
+	}
+
+	return regionStorage.AbortMultipartUpload(ctx, objectName, uploadID)
+}
+
+// regionOf returns the storage of the region of the tenant, it returns ErrRegionNotConfigured if the tenant is pinned
+// to a region without a storage, so the objects of the tenant never fall back to the home region.
+func (s *regionalStorage) regionOf(ctx context.Context) (Storage, error) {
+	region, ok := s.conf.Region(ctx)
+	if !ok {
+		return s.home, nil
+	}
+
+	regionStorage, ok := s.regions[region]
+	if !ok {
+		return nil, ErrRegionNotConfigured
+	}
+
+	return regionStorage, nil
+}
@@ -0,0 +1,75 @@
+package storagekit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regionalStorage", func() {
+	var (
+		homeStorage Storage
+		euStorage   Storage
+		storage     Storage
+	)
+
+	BeforeEach(func() {
+		ctx := logkit.NewNopLogger().WithContext(context.Background())
+
+		homeStorage = NewLocalStorage(ctx, &LocalConfig{Dir: GinkgoT().TempDir(), Bucket: "videos"})
+		euStorage = NewLocalStorage(ctx, &LocalConfig{Dir: GinkgoT().TempDir(), Bucket: "videos"})
+		storage = NewRegionalStorage(homeStorage, map[string]Storage{"eu": euStorage}, &tenantkit.RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu": "eu",
+				"course-ap": "ap",
+			},
+		})
+	})
+
+	When("the tenant is pinned to a region", func() {
+		It("keeps the objects in the region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-eu")
+
+			Expect(storage.PutObject(ctx, "video.mp4", strings.NewReader("video"), -1, PutObjectOptions{})).To(Succeed())
+
+			Expect(readObject(ctx, euStorage, "video.mp4")).To(Equal("video"))
+			_, err := homeStorage.GetObject(ctx, "video.mp4")
+			Expect(err).To(MatchError(ErrObjectNotFound))
+
+			Expect(StorageOf(ctx, storage)).To(BeIdenticalTo(euStorage))
+		})
+	})
+
+	When("the tenant is not pinned", func() {
+		It("keeps the objects in the home region", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-tw")
+
+			Expect(storage.PutObject(ctx, "video.mp4", strings.NewReader("video"), -1, PutObjectOptions{})).To(Succeed())
+
+			Expect(readObject(ctx, homeStorage, "video.mp4")).To(Equal("video"))
+			_, err := euStorage.GetObject(ctx, "video.mp4")
+			Expect(err).To(MatchError(ErrObjectNotFound))
+
+			Expect(StorageOf(ctx, storage)).To(BeIdenticalTo(homeStorage))
+		})
+	})
+
+	When("the region of the tenant is not configured", func() {
+		It("returns ErrRegionNotConfigured", func() {
+			ctx := tenantkit.WithTenantID(context.Background(), "course-ap")
+
+			Expect(storage.PutObject(ctx, "video.mp4", strings.NewReader("video"), -1, PutObjectOptions{})).To(MatchError(ErrRegionNotConfigured))
+
+			_, err := StorageOf(ctx, storage)
+			Expect(err).To(MatchError(ErrRegionNotConfigured))
+		})
+	})
+
+	It("returns the storage itself if it is not regional", func() {
+		Expect(StorageOf(context.Background(), homeStorage)).To(BeIdenticalTo(homeStorage))
+	})
+})
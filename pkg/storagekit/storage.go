@@ -2,13 +2,28 @@ package storagekit
 
 import (
 	"context"
+	"errors"
 	"io"
+	"time"
 )
 
 type PutObjectOptions struct {
 	ContentType string
 }
 
+// ObjectPart is an uploaded part of a multipart upload
+type ObjectPart struct {
+	PartNumber int
+	ETag       string
+}
+
+var (
+	ErrObjectNotFound          = errors.New("object not found")
+	ErrInvalidObjectName       = errors.New("invalid object name")
+	ErrSignedURLNotSupported   = errors.New("signed URL not supported")
+	ErrMultipartUploadNotFound = errors.New("multipart upload not found")
+)
+
 // Provide a simplifier interface to upload file
 type Storage interface {
 	// Endpoint returns the endpoint of the object storage
@@ -16,6 +31,21 @@ type Storage interface {
 	// Bucket returns the bucket name in the object storage
 	Bucket() string
 
-	// PutObject add an object into the storage bucket
+	// PutObject add an object into the storage bucket, the object size is -1 if unknown
 	PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) error
+	// GetObject returns the content of the object, the caller must close it
+	GetObject(ctx context.Context, objectName string) (io.ReadCloser, error)
+	// DeleteObject removes the object from the storage bucket
+	DeleteObject(ctx context.Context, objectName string) error
+	// SignedURL returns an URL to download the object without credentials until it expires
+	SignedURL(ctx context.Context, objectName string, expiry time.Duration) (string, error)
+
+	// NewMultipartUpload starts uploading an object in parts and returns the upload ID
+	NewMultipartUpload(ctx context.Context, objectName string, opts PutObjectOptions) (string, error)
+	// PutObjectPart uploads a part of the object, the part number starts from 1
+	PutObjectPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, partSize int64) (ObjectPart, error)
+	// CompleteMultipartUpload assembles the uploaded parts into the object
+	CompleteMultipartUpload(ctx context.Context, objectName, uploadID string, parts []ObjectPart) error
+	// AbortMultipartUpload discards the uploaded parts
+	AbortMultipartUpload(ctx context.Context, objectName, uploadID string) error
 }
@@ -0,0 +1,124 @@
+package storagekit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// describeStorage describes the behaviors shared by all the storage implementations
+func describeStorage(newStorage func(ctx context.Context) Storage) {
+	var (
+		storage    Storage
+		ctx        context.Context
+		objectName string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		storage = newStorage(ctx)
+		objectName = "storagekit-test/" + uuid.New().String()
+	})
+
+	AfterEach(func() {
+		Expect(storage.DeleteObject(ctx, objectName)).To(Succeed())
+	})
+
+	Describe("GetObject", func() {
+		When("object not found", func() {
+			It("returns object not found error", func() {
+				_, err := storage.GetObject(ctx, objectName)
+				Expect(err).To(MatchError(ErrObjectNotFound))
+			})
+		})
+
+		When("success", func() {
+			BeforeEach(func() {
+				content := []byte("object content")
+				Expect(storage.PutObject(ctx, objectName, bytes.NewReader(content), int64(len(content)), PutObjectOptions{
+					ContentType: "text/plain",
+				})).To(Succeed())
+			})
+
+			It("returns the object content", func() {
+				Expect(readObject(ctx, storage, objectName)).To(Equal("object content"))
+			})
+		})
+
+		When("object size is unknown", func() {
+			BeforeEach(func() {
+				Expect(storage.PutObject(ctx, objectName, strings.NewReader("object content"), -1, PutObjectOptions{})).To(Succeed())
+			})
+
+			It("returns the object content", func() {
+				Expect(readObject(ctx, storage, objectName)).To(Equal("object content"))
+			})
+		})
+	})
+
+	Describe("DeleteObject", func() {
+		BeforeEach(func() {
+			Expect(storage.PutObject(ctx, objectName, bytes.NewReader([]byte("a")), 1, PutObjectOptions{})).To(Succeed())
+		})
+
+		It("deletes the object", func() {
+			Expect(storage.DeleteObject(ctx, objectName)).To(Succeed())
+
+			_, err := storage.GetObject(ctx, objectName)
+			Expect(err).To(MatchError(ErrObjectNotFound))
+		})
+	})
+
+	Describe("MultipartUpload", func() {
+		var uploadID string
+
+		BeforeEach(func() {
+			var err error
+			uploadID, err = storage.NewMultipartUpload(ctx, objectName, PutObjectOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("assembles the parts in order", func() {
+			// S3 requires every part but the last one to be at least 5 MiB
+			first := bytes.Repeat([]byte("a"), 5<<20)
+			second := []byte("b")
+
+			part1, err := storage.PutObjectPart(ctx, objectName, uploadID, 1, bytes.NewReader(first), int64(len(first)))
+			Expect(err).NotTo(HaveOccurred())
+			part2, err := storage.PutObjectPart(ctx, objectName, uploadID, 2, bytes.NewReader(second), int64(len(second)))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(storage.CompleteMultipartUpload(ctx, objectName, uploadID, []ObjectPart{part1, part2})).To(Succeed())
+			Expect(readObject(ctx, storage, objectName)).To(Equal(string(first) + string(second)))
+		})
+
+		It("discards the parts on abort", func() {
+			_, err := storage.PutObjectPart(ctx, objectName, uploadID, 1, bytes.NewReader([]byte("a")), 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(storage.AbortMultipartUpload(ctx, objectName, uploadID)).To(Succeed())
+
+			_, err = storage.PutObjectPart(ctx, objectName, uploadID, 2, bytes.NewReader([]byte("b")), 1)
+			Expect(err).To(MatchError(ErrMultipartUploadNotFound))
+
+			_, err = storage.GetObject(ctx, objectName)
+			Expect(err).To(MatchError(ErrObjectNotFound))
+		})
+	})
+}
+
+func readObject(ctx context.Context, storage Storage, objectName string) string {
+	object, err := storage.GetObject(ctx, objectName)
+	Expect(err).NotTo(HaveOccurred())
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	Expect(err).NotTo(HaveOccurred())
+
+	return string(content)
+}
@@ -0,0 +1,54 @@
+package tenantkit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// DefaultTenantID is the tenant of requests without a tenant ID,
+// it keeps the single-tenant deployments and the data created before multi-tenancy working.
+const DefaultTenantID = "default"
+
+var (
+	ErrInvalidTenantID = errors.New("invalid tenant ID")
+	ErrInvalidBinding  = errors.New("invalid tenant binding")
+	ErrTenantMismatch  = errors.New("tenant ID not of the peer")
+)
+
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+type tenantContextKey int8
+
+const contextKeyTenantID tenantContextKey = iota
+
+// Validate reports whether the tenant ID consists of at most 63 lowercase letters, digits, '-' and '_'.
+func Validate(tenantID string) error {
+	if !tenantIDPattern.MatchString(tenantID) {
+		return ErrInvalidTenantID
+	}
+
+	return nil
+}
+
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKeyTenantID, tenantID)
+}
+
+// FromContext returns the tenant ID in the context, or DefaultTenantID if not found.
+func FromContext(ctx context.Context) string {
+	if tenantID, ok := lookup(ctx); ok {
+		return tenantID
+	}
+
+	return DefaultTenantID
+}
+
+func lookup(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(contextKeyTenantID).(string)
+	if !ok || tenantID == "" {
+		return "", false
+	}
+
+	return tenantID, true
+}
@@ -0,0 +1,48 @@
+package tenantkit
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Context", func() {
+	Describe("FromContext", func() {
+		When("tenant ID is in context", func() {
+			It("returns the tenant ID", func() {
+				ctx := WithTenantID(context.Background(), "course-a")
+				Expect(FromContext(ctx)).To(Equal("course-a"))
+			})
+		})
+
+		When("tenant ID is not in context", func() {
+			It("returns the default tenant ID", func() {
+				Expect(FromContext(context.Background())).To(Equal(DefaultTenantID))
+			})
+		})
+
+		When("tenant ID is empty", func() {
+			It("returns the default tenant ID", func() {
+				ctx := WithTenantID(context.Background(), "")
+				Expect(FromContext(ctx)).To(Equal(DefaultTenantID))
+			})
+		})
+	})
+
+	DescribeTable("Validate",
+		func(tenantID string, valid bool) {
+			if valid {
+				Expect(Validate(tenantID)).To(Succeed())
+			} else {
+				Expect(Validate(tenantID)).To(MatchError(ErrInvalidTenantID))
+			}
+		},
+		Entry("lowercase letters and digits", "course2022", true),
+		Entry("dashes and underscores", "demo-env_1", true),
+		Entry("empty", "", false),
+		Entry("uppercase letters", "Course", false),
+		Entry("leading dash", "-course", false),
+		Entry("too long", "a1234567890123456789012345678901234567890123456789012345678901234", false),
+	)
+})
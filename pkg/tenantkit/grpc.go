@@ -0,0 +1,150 @@
+package tenantkit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tlskit"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey is the gRPC metadata carrying the tenant ID,
+// HTTPHeader is the header the gateway forwards as MetadataKey.
+const (
+	MetadataKey = "x-tenant-id"
+	HTTPHeader  = "X-Tenant-Id"
+)
+
+// Bindings are the tenants bound to the SPIFFE IDs of the peers.
+type Bindings map[string]string
+
+// ParseBindings parses the bindings in the format of id=tenant.
+func ParseBindings(bindings []string) (Bindings, error) {
+	parsed := make(Bindings, len(bindings))
+
+	for _, binding := range bindings {
+		i := strings.LastIndex(binding, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidBinding, binding)
+		}
+
+		tenantID := strings.TrimSpace(binding[i+1:])
+		if err := Validate(tenantID); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidBinding, binding)
+		}
+
+		parsed[strings.TrimSpace(binding[:i])] = tenantID
+	}
+
+	return parsed, nil
+}
+
+// UnaryServerInterceptor injects the tenant ID of the request into the request context. The requests of a peer
+// bound to a tenant by the SPIFFE ID of its certificate are of the tenant, and the ones whose metadata has another
+// tenant ID are refused. The requests of the other peers are of the tenant ID of the metadata, since the peers not
+// bound, e.g. the gateways, forward the requests of any tenant.
+func UnaryServerInterceptor(bindings Bindings) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := incomingContext(ctx, bindings)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor injects the tenant ID of the stream into the stream context like UnaryServerInterceptor.
+func StreamServerInterceptor(bindings Bindings) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := incomingContext(ss.Context(), bindings)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientInterceptor forwards the tenant ID of the context to the outgoing metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor forwards the tenant ID of the context to the outgoing metadata.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// HeaderMatcher forwards HTTPHeader to the gRPC server, other headers are matched by the default matcher.
+func HeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, HTTPHeader) {
+		return MetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func incomingContext(ctx context.Context, bindings Bindings) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var tenantID string
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		tenantID = values[0]
+	}
+
+	// the SPIFFE ID is verified by the TLS handshake, unlike the metadata sent by the peer
+	if id, err := tlskit.PeerID(ctx); err == nil {
+		if bound, ok := bindings[id]; ok {
+			if tenantID != "" && tenantID != bound {
+				return nil, status.Errorf(codes.PermissionDenied, "%s: %q of the peer %s", ErrTenantMismatch, tenantID, id)
+			}
+
+			return WithTenantID(ctx, bound), nil
+		}
+	}
+
+	if tenantID == "" {
+		return ctx, nil
+	}
+
+	if err := Validate(tenantID); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %q", err, tenantID)
+	}
+
+	return WithTenantID(ctx, tenantID), nil
+}
+
+// outgoingContext overrides the tenant ID of the outgoing metadata,
+// the metadata is left untouched if there is no tenant ID in the context.
+func outgoingContext(ctx context.Context) context.Context {
+	tenantID, ok := lookup(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(MetadataKey, tenantID)
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
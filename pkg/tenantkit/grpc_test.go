@@ -0,0 +1,202 @@
+package tenantkit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("ParseBindings", func() {
+	It("parses the bindings in the format of id=tenant", func() {
+		Expect(ParseBindings([]string{"spiffe://nthu-distributed-system/course-a = course-a"})).To(Equal(Bindings{
+			"spiffe://nthu-distributed-system/course-a": "course-a",
+		}))
+	})
+
+	DescribeTable("returns ErrInvalidBinding if the binding is malformed",
+		func(binding string) {
+			_, err := ParseBindings([]string{binding})
+			Expect(err).To(MatchError(ErrInvalidBinding))
+		},
+		Entry("no tenant", "spiffe://nthu-distributed-system/course-a"),
+		Entry("no ID", "=course-a"),
+		Entry("invalid tenant", "spiffe://nthu-distributed-system/course-a=Course A"),
+	)
+})
+
+var _ = Describe("UnaryServerInterceptor", func() {
+	const fakeID = "spiffe://nthu-distributed-system/course-a-grader"
+
+	var (
+		ctx      context.Context
+		bindings Bindings
+		tenantID string
+		err      error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		bindings = Bindings{fakeID: "course-a"}
+		tenantID = ""
+	})
+
+	// peerContext returns the context of a request of the peer identified by the SPIFFE ID by mTLS
+	peerContext := func(ctx context.Context, id string) context.Context {
+		u, err := url.Parse(id)
+		Expect(err).NotTo(HaveOccurred())
+
+		return peer.NewContext(ctx, &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{u}}},
+				},
+			},
+		})
+	}
+
+	JustBeforeEach(func() {
+		_, err = UnaryServerInterceptor(bindings)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			tenantID = FromContext(ctx)
+			return nil, nil
+		})
+	})
+
+	When("metadata has the tenant ID", func() {
+		BeforeEach(func() {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, "course-a"))
+		})
+
+		It("injects the tenant ID into context", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tenantID).To(Equal("course-a"))
+		})
+	})
+
+	When("metadata has no tenant ID", func() {
+		It("uses the default tenant ID", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tenantID).To(Equal(DefaultTenantID))
+		})
+	})
+
+	When("tenant ID is invalid", func() {
+		BeforeEach(func() {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, "Course A"))
+		})
+
+		It("returns invalid argument error", func() {
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(tenantID).To(BeEmpty())
+		})
+	})
+
+	When("the peer is bound to a tenant", func() {
+		BeforeEach(func() {
+			ctx = peerContext(ctx, fakeID)
+		})
+
+		It("injects the tenant of the peer into context", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tenantID).To(Equal("course-a"))
+		})
+
+		When("metadata has the tenant ID of the peer", func() {
+			BeforeEach(func() {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, "course-a"))
+			})
+
+			It("injects the tenant of the peer into context", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tenantID).To(Equal("course-a"))
+			})
+		})
+
+		When("metadata has another tenant ID", func() {
+			BeforeEach(func() {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, "course-b"))
+			})
+
+			It("returns permission denied error", func() {
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(err).To(MatchError(ContainSubstring(ErrTenantMismatch.Error())))
+				Expect(tenantID).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the peer is not bound to a tenant", func() {
+		BeforeEach(func() {
+			ctx = metadata.NewIncomingContext(peerContext(ctx, "spiffe://nthu-distributed-system/comment-gateway"), metadata.Pairs(MetadataKey, "course-b"))
+		})
+
+		It("injects the tenant ID of the metadata into context", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tenantID).To(Equal("course-b"))
+		})
+	})
+})
+
+var _ = Describe("UnaryClientInterceptor", func() {
+	var (
+		ctx context.Context
+		md  metadata.MD
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		md = nil
+	})
+
+	JustBeforeEach(func() {
+		err := UnaryClientInterceptor()(ctx, "/test", nil, nil, nil, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	When("context has the tenant ID", func() {
+		BeforeEach(func() {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, "course-b", "other", "value")
+			ctx = WithTenantID(ctx, "course-a")
+		})
+
+		It("overrides the tenant ID of the outgoing metadata", func() {
+			Expect(md.Get(MetadataKey)).To(Equal([]string{"course-a"}))
+			Expect(md.Get("other")).To(Equal([]string{"value"}))
+		})
+	})
+
+	When("context has no tenant ID", func() {
+		BeforeEach(func() {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, "course-b")
+		})
+
+		It("keeps the outgoing metadata", func() {
+			Expect(md.Get(MetadataKey)).To(Equal([]string{"course-b"}))
+		})
+	})
+})
+
+var _ = Describe("HeaderMatcher", func() {
+	It("forwards the tenant header", func() {
+		key, ok := HeaderMatcher("X-Tenant-Id")
+		Expect(ok).To(BeTrue())
+		Expect(key).To(Equal(MetadataKey))
+	})
+
+	It("matches other headers by the default matcher", func() {
+		_, ok := HeaderMatcher("X-Unknown")
+		Expect(ok).To(BeFalse())
+	})
+})
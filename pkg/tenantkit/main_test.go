@@ -0,0 +1,13 @@
+package tenantkit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTenantKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Tenant Kit")
+}
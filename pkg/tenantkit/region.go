@@ -0,0 +1,40 @@
+package tenantkit
+
+import (
+	"context"
+	"sort"
+)
+
+// RegionConfig pins the data of the tenants to the regions, e.g. the EU course sections to the EU databases,
+// the tenants not pinned stay in the home region of the deployment.
+type RegionConfig struct {
+	TenantRegions map[string]string `long:"tenant_regions" env:"TENANT_REGIONS" env-delim:"," description:"the regions the data of the tenants are pinned to, as tenant:region pairs, the tenants not listed stay in the home region"`
+}
+
+// Region returns the region the data of the tenant of the context is pinned to, and false if it is not pinned.
+func (c *RegionConfig) Region(ctx context.Context) (string, bool) {
+	region, ok := c.TenantRegions[FromContext(ctx)]
+	if !ok || region == "" {
+		return "", false
+	}
+
+	return region, true
+}
+
+// Regions returns the regions any tenant is pinned to in order.
+func (c *RegionConfig) Regions() []string {
+	seen := make(map[string]bool)
+	regions := make([]string, 0)
+	for _, region := range c.TenantRegions {
+		if region == "" || seen[region] {
+			continue
+		}
+
+		seen[region] = true
+		regions = append(regions, region)
+	}
+
+	sort.Strings(regions)
+
+	return regions
+}
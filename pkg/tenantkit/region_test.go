@@ -0,0 +1,52 @@
+package tenantkit
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RegionConfig", func() {
+	var conf *RegionConfig
+
+	BeforeEach(func() {
+		conf = &RegionConfig{
+			TenantRegions: map[string]string{
+				"course-eu-a": "eu",
+				"course-eu-b": "eu",
+				"course-us":   "us",
+				"course-home": "",
+			},
+		}
+	})
+
+	Describe("Region", func() {
+		When("the tenant is pinned", func() {
+			It("returns the region", func() {
+				region, ok := conf.Region(WithTenantID(context.Background(), "course-eu-a"))
+				Expect(ok).To(BeTrue())
+				Expect(region).To(Equal("eu"))
+			})
+		})
+
+		When("the tenant is not pinned", func() {
+			It("returns false", func() {
+				_, ok := conf.Region(WithTenantID(context.Background(), "course-tw"))
+				Expect(ok).To(BeFalse())
+
+				_, ok = conf.Region(WithTenantID(context.Background(), "course-home"))
+				Expect(ok).To(BeFalse())
+
+				_, ok = conf.Region(context.Background())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Regions", func() {
+		It("returns the distinct regions in order", func() {
+			Expect(conf.Regions()).To(Equal([]string{"eu", "us"}))
+		})
+	})
+})
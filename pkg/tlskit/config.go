@@ -0,0 +1,16 @@
+package tlskit
+
+import "time"
+
+type Config struct {
+	CertFile       string        `long:"cert_file" env:"CERT_FILE" description:"the certificate file presented to the peers"`
+	KeyFile        string        `long:"key_file" env:"KEY_FILE" description:"the private key file of the certificate"`
+	CAFile         string        `long:"ca_file" env:"CA_FILE" description:"the CA file verifying the peers, the servers require the client certificates (mTLS) if set"`
+	AllowedIDs     []string      `long:"allowed_ids" env:"ALLOWED_IDS" env-delim:"," description:"the SPIFFE IDs of the peers allowed, any peer trusted by the CA is allowed if empty"`
+	ReloadInterval time.Duration `long:"reload_interval" env:"RELOAD_INTERVAL" description:"how often the files are checked for rotation" default:"1m"`
+}
+
+// Enabled returns whether TLS is enabled, it is disabled in the environments without certificates.
+func (c *Config) Enabled() bool {
+	return c.CertFile != "" || c.CAFile != ""
+}
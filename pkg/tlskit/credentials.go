@@ -0,0 +1,126 @@
+package tlskit
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+)
+
+var ErrNoPeerCertificate = errors.New("no peer certificate")
+
+// NewServerCredentials returns the TLS credentials of the gRPC servers,
+// the clients must present certificates signed by the CA if the CA file is set.
+func NewServerCredentials(ctx context.Context, conf *Config) credentials.TransportCredentials {
+	logger := newLogger(ctx, conf)
+
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		logger.Fatal("the certificate and key files of the TLS server are required")
+	}
+
+	r, err := newReloader(conf, logger)
+	if err != nil {
+		logger.Fatal("failed to load TLS files", zap.Error(err))
+	}
+
+	logger.Info("create TLS server credentials successfully")
+
+	return credentials.NewTLS(serverTLSConfig(r))
+}
+
+// NewClientCredentials returns the TLS credentials of the gRPC clients. If the CA file is set,
+// the servers are verified by the CA and their SPIFFE IDs instead of their host names.
+func NewClientCredentials(ctx context.Context, conf *Config) credentials.TransportCredentials {
+	logger := newLogger(ctx, conf)
+
+	r, err := newReloader(conf, logger)
+	if err != nil {
+		logger.Fatal("failed to load TLS files", zap.Error(err))
+	}
+
+	logger.Info("create TLS client credentials successfully")
+
+	return credentials.NewTLS(clientTLSConfig(r, conf.AllowedIDs))
+}
+
+func newLogger(ctx context.Context, conf *Config) *logkit.Logger {
+	return logkit.FromContext(ctx).With(
+		zap.String("cert_file", conf.CertFile),
+		zap.String("ca_file", conf.CAFile),
+		zap.Strings("allowed_ids", conf.AllowedIDs),
+	)
+}
+
+func serverTLSConfig(r *reloader) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// a new config per handshake picks up the rotated certificate and CA
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			conf := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2"},
+				Certificates: []tls.Certificate{*r.certificate()},
+			}
+
+			if pool := r.certPool(); pool != nil {
+				conf.ClientAuth = tls.RequireAndVerifyClientCert
+				conf.ClientCAs = pool
+			}
+
+			return conf, nil
+		},
+	}
+}
+
+func clientTLSConfig(r *reloader, allowedIDs []string) *tls.Config {
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := r.certificate(); cert != nil {
+				return cert, nil
+			}
+
+			// no certificate is sent, the server decides whether it is required
+			return &tls.Certificate{}, nil
+		},
+	}
+
+	if r.caFile == "" {
+		// the servers are verified by the system CAs and their host names
+		conf.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyPeerID(cs.PeerCertificates[0], allowedIDs)
+		}
+
+		return conf
+	}
+
+	// the SPIFFE certificates have no host names, so the default verification
+	// is replaced by the one against the CA, which is reloaded on rotation
+	conf.InsecureSkipVerify = true
+	conf.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return ErrNoPeerCertificate
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range cs.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		if _, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+			Roots:         r.certPool(),
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			return err
+		}
+
+		return verifyPeerID(cs.PeerCertificates[0], allowedIDs)
+	}
+
+	return conf
+}
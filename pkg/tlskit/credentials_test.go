@@ -0,0 +1,124 @@
+package tlskit
+
+import (
+	"context"
+	"net"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	fakeServerID = "spiffe://nthu-distributed-system/video-api"
+	fakeClientID = "spiffe://nthu-distributed-system/comment-api"
+)
+
+// fakeHealthServer records the SPIFFE ID of the peer.
+type fakeHealthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	peerID string
+}
+
+func (s *fakeHealthServer) Check(ctx context.Context, _ *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s.peerID, _ = PeerID(ctx)
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+var _ = Describe("Credentials", func() {
+	var (
+		ctx        context.Context
+		ca         *fakeCA
+		dir        string
+		serverConf *Config
+		clientConf *Config
+		server     *fakeHealthServer
+		grpcServer *grpc.Server
+		lis        *bufconn.Listener
+	)
+
+	BeforeEach(func() {
+		ctx = logkit.NewNopLogger().WithContext(context.Background())
+		ca = newFakeCA()
+		dir = GinkgoT().TempDir()
+		caFile := ca.writeCA(dir)
+
+		serverCert, serverKey := ca.writeCert(dir, "server", fakeServerID)
+		serverConf = &Config{CertFile: serverCert, KeyFile: serverKey, CAFile: caFile}
+
+		clientCert, clientKey := ca.writeCert(dir, "client", fakeClientID)
+		clientConf = &Config{CertFile: clientCert, KeyFile: clientKey, CAFile: caFile, AllowedIDs: []string{fakeServerID}}
+	})
+
+	JustBeforeEach(func() {
+		server = &fakeHealthServer{}
+		grpcServer = grpc.NewServer(grpc.Creds(NewServerCredentials(ctx, serverConf)))
+		healthpb.RegisterHealthServer(grpcServer, server)
+
+		lis = bufconn.Listen(1 << 20)
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+	})
+
+	AfterEach(func() {
+		grpcServer.Stop()
+	})
+
+	check := func(creds credentials.TransportCredentials) error {
+		conn, err := grpc.DialContext(ctx, "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(creds),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	It("identifies the client by its SPIFFE ID", func() {
+		Expect(check(NewClientCredentials(ctx, clientConf))).To(Succeed())
+		Expect(server.peerID).To(Equal(fakeClientID))
+	})
+
+	It("rejects the client without certificate", func() {
+		clientConf.CertFile, clientConf.KeyFile = "", ""
+
+		Expect(check(NewClientCredentials(ctx, clientConf))).NotTo(Succeed())
+	})
+
+	It("rejects the plaintext client", func() {
+		Expect(check(insecure.NewCredentials())).NotTo(Succeed())
+	})
+
+	It("rejects the server not allowed", func() {
+		clientConf.AllowedIDs = []string{"spiffe://nthu-distributed-system/other"}
+
+		Expect(check(NewClientCredentials(ctx, clientConf))).NotTo(Succeed())
+	})
+
+	It("rejects the server signed by another CA", func() {
+		clientConf.CAFile = newFakeCA().writeCA(GinkgoT().TempDir())
+
+		Expect(check(NewClientCredentials(ctx, clientConf))).NotTo(Succeed())
+	})
+
+	When("the server does not require client certificates", func() {
+		BeforeEach(func() { serverConf.CAFile = "" })
+
+		It("accepts the client without certificate", func() {
+			clientConf.CertFile, clientConf.KeyFile = "", ""
+
+			Expect(check(NewClientCredentials(ctx, clientConf))).To(Succeed())
+			Expect(server.peerID).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package tlskit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTLSKit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test TLS Kit")
+}
@@ -0,0 +1,145 @@
+package tlskit
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidCAFile = errors.New("no certificate found in CA file")
+
+// reloader keeps the certificate and the CA pool up to date with the files. The files are checked
+// on the handshakes at most once per interval, so the rotated certificates are picked up without
+// restarting, and the old ones are kept if the new files fail to load, e.g. while being written.
+type reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	interval time.Duration
+	logger   *logkit.Logger
+	now      func() time.Time
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	modTimes  map[string]time.Time
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+}
+
+func newReloader(conf *Config, logger *logkit.Logger) (*reloader, error) {
+	r := &reloader{
+		certFile: conf.CertFile,
+		keyFile:  conf.KeyFile,
+		caFile:   conf.CAFile,
+		interval: conf.ReloadInterval,
+		logger:   logger,
+		now:      time.Now,
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *reloader) certificate() *tls.Certificate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reloadIfModified()
+
+	return r.cert
+}
+
+func (r *reloader) certPool() *x509.CertPool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reloadIfModified()
+
+	return r.pool
+}
+
+func (r *reloader) reloadIfModified() {
+	now := r.now()
+	if now.Sub(r.checkedAt) < r.interval {
+		return
+	}
+	r.checkedAt = now
+
+	modTimes, err := r.statFiles()
+	if err != nil {
+		r.logger.Error("failed to stat TLS files", zap.Error(err))
+		return
+	}
+
+	for file, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[file]) {
+			if err := r.load(); err != nil {
+				r.logger.Error("failed to reload TLS files", zap.Error(err))
+				return
+			}
+
+			r.logger.Info("reload TLS files successfully")
+			return
+		}
+	}
+}
+
+func (r *reloader) load() error {
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return err
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return err
+		}
+		cert = &c
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return err
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return ErrInvalidCAFile
+		}
+	}
+
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+
+	return nil
+}
+
+func (r *reloader) statFiles() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file == "" {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
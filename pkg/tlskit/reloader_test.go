@@ -0,0 +1,63 @@
+package tlskit
+
+import (
+	"os"
+	"time"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("reloader", func() {
+	var (
+		ca       *fakeCA
+		dir      string
+		certFile string
+		keyFile  string
+		r        *reloader
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		ca = newFakeCA()
+		dir = GinkgoT().TempDir()
+		certFile, keyFile = ca.writeCert(dir, "server", fakeServerID)
+
+		var err error
+		r, err = newReloader(&Config{CertFile: certFile, KeyFile: keyFile, ReloadInterval: time.Minute}, logkit.NewNopLogger())
+		Expect(err).NotTo(HaveOccurred())
+
+		now = time.Now()
+		r.now = func() time.Time { return now }
+		r.certificate()
+	})
+
+	// rotate rewrites the certificate files with a newer modification time
+	rotate := func() {
+		ca.writeCert(dir, "server", fakeServerID)
+
+		modTime := time.Now().Add(time.Second)
+		Expect(os.Chtimes(certFile, modTime, modTime)).To(Succeed())
+		Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+	}
+
+	It("reloads the rotated certificate after the interval", func() {
+		old := r.certificate()
+		rotate()
+
+		Expect(r.certificate()).To(BeIdenticalTo(old))
+
+		now = now.Add(time.Minute)
+		Expect(r.certificate()).NotTo(BeIdenticalTo(old))
+	})
+
+	It("keeps the certificate if the rotated files are invalid", func() {
+		old := r.certificate()
+		rotate()
+		Expect(os.WriteFile(keyFile, []byte("invalid key"), 0o600)).To(Succeed())
+
+		now = now.Add(time.Minute)
+		Expect(r.certificate()).To(BeIdenticalTo(old))
+	})
+})
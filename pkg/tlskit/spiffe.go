@@ -0,0 +1,83 @@
+package tlskit
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// spiffeScheme is the URI scheme of the SPIFFE IDs, e.g. spiffe://nthu-distributed-system/comment-api
+const spiffeScheme = "spiffe"
+
+var (
+	ErrNoSPIFFEID   = errors.New("no SPIFFE ID in the peer certificate")
+	ErrIDNotAllowed = errors.New("SPIFFE ID of the peer not allowed")
+)
+
+// SPIFFEID returns the SPIFFE ID in the URI SANs of the certificate,
+// the certificate with none or multiple SPIFFE IDs identifies no one.
+func SPIFFEID(cert *x509.Certificate) (string, error) {
+	var id string
+
+	for _, uri := range cert.URIs {
+		if uri.Scheme != spiffeScheme {
+			continue
+		}
+		if id != "" {
+			return "", ErrNoSPIFFEID
+		}
+		id = uri.String()
+	}
+
+	if id == "" {
+		return "", ErrNoSPIFFEID
+	}
+
+	return id, nil
+}
+
+// PeerID returns the SPIFFE ID of the peer of the gRPC request, which is verified by the TLS handshake.
+func PeerID(ctx context.Context) (string, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", ErrNoSPIFFEID
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) == 0 {
+		return "", ErrNoSPIFFEID
+	}
+
+	return SPIFFEID(info.State.PeerCertificates[0])
+}
+
+// VerifyID verifies the SPIFFE ID is allowed, any ID is allowed if allowedIDs is empty.
+func VerifyID(id string, allowedIDs []string) error {
+	if len(allowedIDs) == 0 {
+		return nil
+	}
+
+	for _, allowed := range allowedIDs {
+		if id == allowed {
+			return nil
+		}
+	}
+
+	return ErrIDNotAllowed
+}
+
+func verifyPeerID(cert *x509.Certificate, allowedIDs []string) error {
+	if len(allowedIDs) == 0 {
+		return nil
+	}
+
+	id, err := SPIFFEID(cert)
+	if err != nil {
+		return err
+	}
+
+	return VerifyID(id, allowedIDs)
+}
@@ -0,0 +1,49 @@
+package tlskit
+
+import (
+	"crypto/x509"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SPIFFEID", func() {
+	newCert := func(uris ...string) *x509.Certificate {
+		cert := &x509.Certificate{}
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			Expect(err).NotTo(HaveOccurred())
+			cert.URIs = append(cert.URIs, u)
+		}
+
+		return cert
+	}
+
+	It("returns the SPIFFE ID of the certificate", func() {
+		id, err := SPIFFEID(newCert("https://example.com", fakeServerID))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(Equal(fakeServerID))
+	})
+
+	It("returns error if there is no SPIFFE ID", func() {
+		_, err := SPIFFEID(newCert("https://example.com"))
+		Expect(err).To(MatchError(ErrNoSPIFFEID))
+	})
+
+	It("returns error if there are multiple SPIFFE IDs", func() {
+		_, err := SPIFFEID(newCert(fakeServerID, fakeClientID))
+		Expect(err).To(MatchError(ErrNoSPIFFEID))
+	})
+})
+
+var _ = Describe("VerifyID", func() {
+	It("allows any ID if no ID is specified", func() {
+		Expect(VerifyID(fakeClientID, nil)).To(Succeed())
+	})
+
+	It("allows the specified IDs only", func() {
+		Expect(VerifyID(fakeClientID, []string{fakeServerID, fakeClientID})).To(Succeed())
+		Expect(VerifyID(fakeClientID, []string{fakeServerID})).To(MatchError(ErrIDNotAllowed))
+	})
+})
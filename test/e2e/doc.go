@@ -0,0 +1,5 @@
+// Package e2e runs the scenarios of the users against a deployed environment, docker-compose or kind, through the
+// public APIs only, so a scenario passes only when the services, the event stream and the databases work together.
+// The scenarios are built with the e2e tag only, run them by `make dc.e2e.test`, or by `make e2e.test` with the
+// addresses of a kind cluster.
+package e2e
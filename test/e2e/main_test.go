@@ -0,0 +1,95 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+func TestE2E(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test E2E")
+}
+
+var (
+	// videoClient and commentClient call the deployed services, and commentGatewayURL is the REST API of
+	// the comment service the web clients call
+	videoClient       videopb.VideoClient
+	commentClient     commentpb.CommentClient
+	commentGatewayURL string
+
+	// dialComment dials another connection to the comment service, which may reach another replica
+	dialComment func() commentpb.CommentClient
+
+	closers []func() error
+)
+
+var _ = BeforeSuite(func() {
+	ctx := logkit.NewLogger(&logkit.LoggerConfig{
+		Development: true,
+	}).WithContext(context.Background())
+
+	// the defaults are the addresses of docker-compose, override them by the port-forwards of kind
+	videoServerAddr := getenv("VIDEO_SERVER_ADDR", "video-api:8081")
+	commentServerAddr := getenv("COMMENT_SERVER_ADDR", "comment-api:8081")
+	commentGatewayURL = getenv("COMMENT_GATEWAY_URL", "http://comment-gateway:8080")
+
+	dial := func(addr string) *grpckit.GrpcClientConn {
+		conn := grpckit.NewGrpcClientConn(ctx, &grpckit.GrpcClientConnConfig{Timeout: 30 * time.Second, ServerAddr: addr},
+			grpc.WithChainUnaryInterceptor(tenantkit.UnaryClientInterceptor()),
+			grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+		)
+		closers = append(closers, conn.Close)
+
+		return conn
+	}
+
+	videoClient = videopb.NewVideoClient(dial(videoServerAddr))
+	commentClient = commentpb.NewCommentClient(dial(commentServerAddr))
+	dialComment = func() commentpb.CommentClient {
+		return commentpb.NewCommentClient(dial(commentServerAddr))
+	}
+
+	// the environment is up once both services answer, the services of kind may still be rolling out
+	Eventually(func() error {
+		if _, err := videoClient.Healthz(ctx, &videopb.HealthzRequest{}); err != nil {
+			return err
+		}
+		_, err := commentClient.Healthz(ctx, &commentpb.HealthzRequest{})
+
+		return err
+	}, 2*time.Minute, time.Second).Should(Succeed())
+})
+
+var _ = AfterSuite(func() {
+	for _, closer := range closers {
+		Expect(closer()).To(Succeed())
+	}
+})
+
+// newTenantContext returns the context of a new tenant, so the scenarios do not see the data of the others
+// and the environment is not reset between the runs.
+func newTenantContext() context.Context {
+	return tenantkit.WithTenantID(context.Background(), fmt.Sprintf("e2e-%d", time.Now().UnixNano()))
+}
+
+func getenv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
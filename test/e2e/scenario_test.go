@@ -0,0 +1,157 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The scenario follows a video from the upload to the comments of its viewers, each step asserts the side effects
+// of the steps before on the other services. There is no search API yet, so the video and its comments are found
+// by the lists of the tenant, which are what the clients browse.
+var _ = Describe("Upload to comments", Ordered, func() {
+	var (
+		ctx       context.Context
+		videoID   string
+		commentID string
+	)
+
+	BeforeAll(func() {
+		ctx = newTenantContext()
+	})
+
+	It("uploads a video", func() {
+		stream, err := videoClient.UploadVideo(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		content := []byte("e2e scenario video")
+		Expect(stream.Send(&videopb.UploadVideoRequest{
+			Data: &videopb.UploadVideoRequest_Header{
+				Header: &videopb.VideoHeader{Filename: "scenario.mp4", Size: uint64(len(content))},
+			},
+		})).To(Succeed())
+		Expect(stream.Send(&videopb.UploadVideoRequest{
+			Data: &videopb.UploadVideoRequest_ChunkData{ChunkData: content},
+		})).To(Succeed())
+
+		resp, err := stream.CloseAndRecv()
+		Expect(err).NotTo(HaveOccurred())
+		videoID = resp.GetId()
+	})
+
+	It("transcodes the video into the variants through the events", func() {
+		// the video stream consumes the created event and produces an event of each variant, which takes
+		// 3 seconds of the mocked transcoding each
+		Eventually(func() map[string]string {
+			resp, err := videoClient.GetVideo(ctx, &videopb.GetVideoRequest{Id: videoID})
+			Expect(err).NotTo(HaveOccurred())
+
+			return resp.GetVideo().GetVariants()
+		}, 2*time.Minute, time.Second).Should(HaveKey("1080"))
+	})
+
+	It("publishes the video to the tenant only", func() {
+		resp, err := videoClient.ListVideo(ctx, &videopb.ListVideoRequest{PageSize: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetVideos()).To(HaveLen(1))
+		Expect(resp.GetVideos()[0].GetId()).To(Equal(videoID))
+
+		resp, err = videoClient.ListVideo(newTenantContext(), &videopb.ListVideoRequest{PageSize: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetVideos()).To(BeEmpty())
+	})
+
+	It("rejects the comments of a video of another tenant", func() {
+		// the comment service checks the video against the video service, in the tenant of the request
+		_, err := commentClient.CreateComment(newTenantContext(), &commentpb.CreateCommentRequest{VideoId: videoID, Content: "elsewhere"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("notifies the subscribers of the comments", func() {
+		// the subscriber is on a connection of its own, so the comment may be created on another replica and
+		// reach the subscriber by the pub/sub of Redis
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := dialComment().StreamComments(streamCtx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stream.Send(&commentpb.StreamCommentsRequest{
+			Data: &commentpb.StreamCommentsRequest_VideoId{VideoId: videoID},
+		})).To(Succeed())
+
+		received := make(chan *commentpb.CommentInfo, 16)
+		go func() {
+			defer close(received)
+
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				received <- resp.GetComment()
+			}
+		}()
+
+		// the subscription is not acknowledged, so the comment is created again until the subscriber receives one
+		Eventually(func() string {
+			resp, err := commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: videoID, Content: "**great** video"})
+			Expect(err).NotTo(HaveOccurred())
+			commentID = resp.GetId()
+
+			select {
+			case comment := <-received:
+				return comment.GetId()
+			case <-time.After(time.Second):
+				return ""
+			}
+		}, 30*time.Second).Should(Equal(commentID))
+	})
+
+	It("serves the comments to the web clients through the gateway", func() {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("%s/v1/comments/%s?limit=100&render_format=RENDER_FORMAT_HTML", commentGatewayURL, videoID), nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set(tenantkit.HTTPHeader, tenantkit.FromContext(ctx))
+
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		var body struct {
+			Comments []struct {
+				ID          string `json:"id"`
+				ContentHTML string `json:"contentHtml"`
+			} `json:"comments"`
+		}
+		Expect(json.NewDecoder(resp.Body).Decode(&body)).To(Succeed())
+		Expect(body.Comments).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"ID":          Equal(commentID),
+			"ContentHTML": ContainSubstring("<strong>great</strong>"),
+		})))
+	})
+
+	It("deletes the comments with the video", func() {
+		_, err := videoClient.DeleteVideo(ctx, &videopb.DeleteVideoRequest{Id: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = commentClient.GetComment(ctx, &commentpb.GetCommentRequest{Id: commentID})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: videoID, Content: "too late"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
@@ -0,0 +1,81 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Comment", func() {
+	var (
+		ctx     context.Context
+		videoID string
+	)
+
+	BeforeEach(func() {
+		ctx = newTenantContext()
+		videoID = uploadVideo(ctx, []byte("integration test video"))
+	})
+
+	It("creates, updates and deletes the comment", func() {
+		createResp, err := commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: videoID, Content: "first"})
+		Expect(err).NotTo(HaveOccurred())
+		id := createResp.GetId()
+
+		listResp, err := commentClient.ListComment(ctx, &commentpb.ListCommentRequest{VideoId: videoID, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.GetComments()).To(HaveLen(1))
+		Expect(listResp.GetComments()[0].GetContent()).To(Equal("first"))
+
+		// the cached list is invalidated by the update
+		_, err = commentClient.UpdateComment(ctx, &commentpb.UpdateCommentRequest{Id: id, Content: "edited"})
+		Expect(err).NotTo(HaveOccurred())
+
+		listResp, err = commentClient.ListComment(ctx, &commentpb.ListCommentRequest{VideoId: videoID, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listResp.GetComments()).To(HaveLen(1))
+		Expect(listResp.GetComments()[0].GetContent()).To(Equal("edited"))
+
+		_, err = commentClient.DeleteComment(ctx, &commentpb.DeleteCommentRequest{Id: id})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = commentClient.GetComment(ctx, &commentpb.GetCommentRequest{Id: id})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	When("the video does not exist", func() {
+		It("returns NotFound", func() {
+			_, err := commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{
+				VideoId: primitive.NewObjectID().Hex(),
+				Content: "orphan",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	When("the content matches a banned pattern of the tenant", func() {
+		It("returns InvalidArgument", func() {
+			_, err := commentClient.CreateBannedPattern(ctx, &commentpb.CreateBannedPatternRequest{
+				Kind:    commentpb.BannedPatternKind_BANNED_PATTERN_KIND_WORD,
+				Pattern: "spam",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: videoID, Content: "more spam"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			// the patterns are of the tenant, so the other tenants are not blocked
+			otherCtx := newTenantContext()
+			otherVideoID := uploadVideo(otherCtx, []byte("integration test video"))
+			_, err = commentClient.CreateComment(otherCtx, &commentpb.CreateCommentRequest{VideoId: otherVideoID, Content: "more spam"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
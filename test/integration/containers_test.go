@@ -0,0 +1,187 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// dependencies are the containers of PostgreSQL, MongoDB, Redis and Kafka started for the run, the images are the ones
+// of docker-compose.
+type dependencies struct {
+	postgresURL string
+	mongoURL    string
+	redisAddr   string
+	kafkaAddr   string
+
+	containers []testcontainers.Container
+}
+
+// startDependencies starts the containers one by one, the ones started are terminated if any of them fails to start.
+func startDependencies(ctx context.Context) (*dependencies, error) {
+	deps := &dependencies{}
+
+	starters := []func(ctx context.Context) error{
+		deps.startPostgres,
+		deps.startMongo,
+		deps.startRedis,
+		deps.startKafka,
+	}
+	for _, start := range starters {
+		if err := start(ctx); err != nil {
+			_ = deps.terminate(ctx)
+			return nil, err
+		}
+	}
+
+	return deps, nil
+}
+
+// terminate terminates the containers, the reaper of testcontainers removes them as well if the run is killed.
+func (d *dependencies) terminate(ctx context.Context) error {
+	var err error
+	for _, container := range d.containers {
+		if terminateErr := container.Terminate(ctx); terminateErr != nil {
+			err = terminateErr
+		}
+	}
+
+	return err
+}
+
+func (d *dependencies) start(ctx context.Context, req testcontainers.ContainerRequest) (testcontainers.Container, error) {
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", req.Image, err)
+	}
+
+	d.containers = append(d.containers, container)
+
+	return container, nil
+}
+
+func (d *dependencies) startPostgres(ctx context.Context) error {
+	container, err := d.start(ctx, testcontainers.ContainerRequest{
+		Image:        "pgvector/pgvector:pg14",
+		Env:          map[string]string{"POSTGRES_HOST_AUTH_METHOD": "trust"},
+		ExposedPorts: []string{"5432/tcp"},
+		// the server restarts once after the init scripts, so it is ready by the second log
+		WaitingFor: wait.ForLog("database system is ready to accept connections").WithOccurrence(2),
+	})
+	if err != nil {
+		return err
+	}
+
+	addr, err := containerAddr(ctx, container, "5432/tcp")
+	if err != nil {
+		return err
+	}
+
+	d.postgresURL = fmt.Sprintf("postgres://postgres@%s/postgres?sslmode=disable", addr)
+
+	return nil
+}
+
+func (d *dependencies) startMongo(ctx context.Context) error {
+	container, err := d.start(ctx, testcontainers.ContainerRequest{
+		Image:        "mongo:5",
+		ExposedPorts: []string{"27017/tcp"},
+		WaitingFor:   wait.ForLog("Waiting for connections"),
+	})
+	if err != nil {
+		return err
+	}
+
+	addr, err := containerAddr(ctx, container, "27017/tcp")
+	if err != nil {
+		return err
+	}
+
+	d.mongoURL = fmt.Sprintf("mongodb://%s/", addr)
+
+	return nil
+}
+
+func (d *dependencies) startRedis(ctx context.Context) error {
+	container, err := d.start(ctx, testcontainers.ContainerRequest{
+		Image:        "redis:6.2-alpine",
+		ExposedPorts: []string{"6379/tcp"},
+		WaitingFor:   wait.ForLog("Ready to accept connections"),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.redisAddr, err = containerAddr(ctx, container, "6379/tcp")
+
+	return err
+}
+
+// kafkaStartScript starts ZooKeeper and Kafka in the container, Kafka advertises the address of the host, which is
+// known only after the container starts, so the container waits for the script to be copied.
+const kafkaStartScript = `#!/bin/bash
+echo 'clientPort=2181' > /tmp/zookeeper.properties
+echo 'dataDir=/var/lib/zookeeper/data' >> /tmp/zookeeper.properties
+echo 'dataLogDir=/var/lib/zookeeper/log' >> /tmp/zookeeper.properties
+zookeeper-server-start /tmp/zookeeper.properties &
+export KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://%s,BROKER://$(hostname -i):9092
+. /etc/confluent/docker/bash-config
+/etc/confluent/docker/configure
+/etc/confluent/docker/launch
+`
+
+const kafkaStartScriptPath = "/tmp/testcontainers_start.sh"
+
+func (d *dependencies) startKafka(ctx context.Context) error {
+	container, err := d.start(ctx, testcontainers.ContainerRequest{
+		Image: "confluentinc/cp-kafka:7.0.1",
+		Env: map[string]string{
+			"KAFKA_BROKER_ID":                        "1",
+			"KAFKA_ZOOKEEPER_CONNECT":                "localhost:2181",
+			"KAFKA_LISTENERS":                        "PLAINTEXT://0.0.0.0:9093,BROKER://0.0.0.0:9092",
+			"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP":   "PLAINTEXT:PLAINTEXT,BROKER:PLAINTEXT",
+			"KAFKA_INTER_BROKER_LISTENER_NAME":       "BROKER",
+			"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR": "1",
+		},
+		ExposedPorts: []string{"9093/tcp"},
+		Entrypoint:   []string{"sh"},
+		Cmd:          []string{"-c", fmt.Sprintf("while [ ! -f %[1]s ]; do sleep 0.1; done; bash %[1]s", kafkaStartScriptPath)},
+	})
+	if err != nil {
+		return err
+	}
+
+	if d.kafkaAddr, err = containerAddr(ctx, container, "9093/tcp"); err != nil {
+		return err
+	}
+
+	if err := container.CopyToContainer(ctx, []byte(fmt.Sprintf(kafkaStartScript, d.kafkaAddr)), kafkaStartScriptPath, 0o755); err != nil {
+		return fmt.Errorf("failed to copy the start script of Kafka: %w", err)
+	}
+
+	return wait.ForLog("started (kafka.server.KafkaServer)").WithStartupTimeout(2*time.Minute).WaitUntilReady(ctx, container)
+}
+
+// containerAddr returns the address of the port of the container on the host.
+func containerAddr(ctx context.Context, container testcontainers.Container, port nat.Port) (string, error) {
+	host, err := container.Host(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	mappedPort, err := container.MappedPort(ctx, port)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s", host, mappedPort.Port()), nil
+}
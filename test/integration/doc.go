@@ -0,0 +1,5 @@
+// Package integration runs the end-to-end tests of the services against PostgreSQL, MongoDB, Redis and Kafka.
+// The dependencies are started in containers by testcontainers for each run, so the tests need Docker only,
+// run them by `make integration.test`. The tests are built with the integration tag only, and they are a module
+// of their own, so `go test ./...` of the repository skips them.
+package integration
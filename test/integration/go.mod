@@ -0,0 +1,19 @@
+// The integration tests are a module of their own, so the Docker client of testcontainers and the dependencies it
+// upgrades are not built into the services.
+module github.com/NTHU-LSALAB/NTHU-Distributed-System/test/integration
+
+go 1.17
+
+require (
+	github.com/NTHU-LSALAB/NTHU-Distributed-System v0.0.0
+	github.com/Shopify/sarama v1.33.0
+	github.com/docker/go-connections v0.4.0
+	github.com/onsi/ginkgo/v2 v2.1.4
+	github.com/onsi/gomega v1.19.0
+	github.com/testcontainers/testcontainers-go v0.14.0
+	go.mongodb.org/mongo-driver v1.9.1
+	go.opentelemetry.io/otel/metric v0.30.0
+	google.golang.org/grpc v1.46.2
+)
+
+replace github.com/NTHU-LSALAB/NTHU-Distributed-System => ../..
@@ -0,0 +1,208 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	commentdao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/dao"
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	commentservice "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/service"
+	videodao "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/dao"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	videoservice "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/service"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/stream"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/kafkakit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/migrationkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/mongokit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/pgkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/rediskit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/serverkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/storagekit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"github.com/Shopify/sarama"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestIntegration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Integration")
+}
+
+var (
+	// commentClient and videoClient call the services through the gRPC server, along with its interceptors
+	commentClient commentpb.CommentClient
+	videoClient   videopb.VideoClient
+
+	closers []func() error
+	cancel  context.CancelFunc
+	done    chan struct{}
+)
+
+var _ = BeforeSuite(func() {
+	ctx := logkit.NewLogger(&logkit.LoggerConfig{
+		Development: true,
+	}).WithContext(context.Background())
+	ctx, cancel = context.WithCancel(ctx)
+
+	deps, err := startDependencies(ctx)
+	Expect(err).NotTo(HaveOccurred())
+
+	pgConf := &pgkit.PGConfig{
+		URL: deps.postgresURL,
+	}
+	mongoConf := &mongokit.MongoConfig{
+		URL:      deps.mongoURL,
+		Database: "nthu_distributed_system",
+	}
+	redisConf := &rediskit.RedisConfig{
+		Addr: deps.redisAddr,
+	}
+	kafkaAddrs := []string{deps.kafkaAddr}
+	// the topic and the group are of the run, so the events of the earlier runs are not consumed
+	kafkaTopic := fmt.Sprintf("video-integration-%d", time.Now().UnixNano())
+
+	migration := migrationkit.NewMigration(ctx, &migrationkit.MigrationConfig{
+		Source: "file://../../modules/comment/migration",
+		URL:    pgConf.URL,
+	})
+	Expect(migration.Up()).To(Succeed())
+	Expect(migration.Close()).To(Succeed())
+
+	noopMeter := nonrecording.NewNoopMeterProvider().Meter("")
+
+	pgClient := pgkit.NewPGClient(ctx, pgConf)
+	stmtCache := pgkit.NewStmtCache(ctx, pgClient, pgConf, noopMeter)
+	mongoClient := mongokit.NewMongoClient(ctx, mongoConf)
+	redisClient := rediskit.NewRedisClient(ctx, redisConf)
+	producer := kafkakit.NewKafkaProducer(ctx, &kafkakit.KafkaProducerConfig{Addrs: kafkaAddrs, Topic: kafkaTopic, RequiredAcks: -1})
+	consumer := kafkakit.NewKafkaConsumer(ctx, &kafkakit.KafkaConsumerConfig{Addrs: kafkaAddrs, Topic: kafkaTopic, Group: kafkaTopic})
+	storageDir, err := os.MkdirTemp("", "integration")
+	Expect(err).NotTo(HaveOccurred())
+	storage := storagekit.NewLocalStorage(ctx, &storagekit.LocalConfig{Dir: storageDir, Bucket: "videos"})
+
+	videoCollection := mongoClient.Database().Collection("videos")
+	Expect(videodao.CreateVideoIndexes(ctx, videoCollection)).To(Succeed())
+
+	lis := bufconn.Listen(1 << 20)
+	conn := grpckit.NewGrpcClientConn(ctx, &grpckit.GrpcClientConnConfig{Timeout: 30 * time.Second, ServerAddr: "in-process"},
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithChainUnaryInterceptor(tenantkit.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+	)
+
+	commentClient = commentpb.NewCommentClient(conn)
+	videoClient = videopb.NewVideoClient(conn)
+
+	bannedPatternDAO := commentdao.NewPGBannedPatternDAO(pgClient)
+	bannedPatternFilter := commentservice.NewBannedPatternFilter(ctx, bannedPatternDAO, commentdao.NewRedisBannedPatternPubSub(redisClient))
+
+	commentDAO := commentdao.NewRedisCommentDAO(redisClient, commentdao.NewPGCommentDAO(pgClient, stmtCache))
+	commentSvc := commentservice.NewService(commentDAO, commentdao.NewRedisCommentPubSub(redisClient), videoClient, storage,
+		commentservice.WithBannedPatterns(bannedPatternDAO, bannedPatternFilter),
+	)
+	videoDAO := videodao.NewRedisVideoDAO(redisClient, videodao.NewMongoVideoDAO(videoCollection))
+	videoSvc := videoservice.NewService(videoDAO, storage, commentClient, producer,
+		videoservice.WithPageTokens(pagekit.NewCodec(ctx, &pagekit.Config{Secret: "integration page token secret", TTL: time.Hour})),
+	)
+
+	scopes := commentservice.MethodScopes()
+	for method, scope := range videoservice.MethodScopes() {
+		scopes[method] = scope
+	}
+
+	grpcServer := serverkit.NewGrpcServer(ctx, &serverkit.GrpcServerConfig{
+		MaxTimeout: 30 * time.Second,
+		Authz:      grpckit.AuthzConfig{AllowAll: true},
+	},
+		serverkit.WithMethodScopes(scopes),
+		serverkit.WithErrorMappings(append(commentservice.ErrorMappings(), videoservice.ErrorMappings()...)...),
+	)
+	commentpb.RegisterCommentServer(grpcServer, commentSvc)
+	videopb.RegisterVideoServer(grpcServer, videoSvc)
+
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+
+	// the videos are uploaded once the consumer joins the group, since a new group consumes the newest events only
+	handler := &readyHandler{
+		ConsumerGroupHandler: videopb.NewHandleVideoCreatedConsumerHandler(stream.NewStream(videoDAO, producer), logkit.NewSaramaLogger(logkit.FromContext(ctx))),
+		ready:                make(chan struct{}),
+	}
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+
+		_ = consumer.Consume(ctx, handler)
+	}()
+	Eventually(handler.ready, 30*time.Second).Should(BeClosed())
+
+	// the components are closed in the reverse order after the server stops
+	closers = []func() error{
+		func() error {
+			grpcServer.Stop()
+			return nil
+		},
+		conn.Close,
+		bannedPatternFilter.Close,
+		consumer.Close,
+		producer.Close,
+		redisClient.Close,
+		mongoClient.Close,
+		stmtCache.Close,
+		pgClient.Close,
+		func() error {
+			return os.RemoveAll(storageDir)
+		},
+		func() error {
+			return deps.terminate(context.Background())
+		},
+	}
+})
+
+var _ = AfterSuite(func() {
+	cancel()
+
+	for _, closer := range closers {
+		Expect(closer()).To(Succeed())
+	}
+
+	Eventually(done).Should(BeClosed())
+})
+
+// newTenantContext returns the context of a new tenant, so the tests do not see the data of the others.
+func newTenantContext() context.Context {
+	return tenantkit.WithTenantID(context.Background(), fmt.Sprintf("it-%d", time.Now().UnixNano()))
+}
+
+// readyHandler reports the consumer joins the group and is assigned the partitions.
+type readyHandler struct {
+	sarama.ConsumerGroupHandler
+
+	ready chan struct{}
+	once  sync.Once
+}
+
+func (h *readyHandler) Setup(session sarama.ConsumerGroupSession) error {
+	h.once.Do(func() {
+		close(h.ready)
+	})
+
+	return h.ConsumerGroupHandler.Setup(session)
+}
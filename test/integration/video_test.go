@@ -0,0 +1,84 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"time"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadVideo uploads a video of the content in two chunks and returns its ID.
+func uploadVideo(ctx context.Context, content []byte) string {
+	stream, err := videoClient.UploadVideo(ctx)
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(stream.Send(&videopb.UploadVideoRequest{
+		Data: &videopb.UploadVideoRequest_Header{
+			Header: &videopb.VideoHeader{Filename: "video.mp4", Size: uint64(len(content))},
+		},
+	})).To(Succeed())
+
+	half := len(content) / 2
+	for _, chunk := range [][]byte{content[:half], content[half:]} {
+		Expect(stream.Send(&videopb.UploadVideoRequest{
+			Data: &videopb.UploadVideoRequest_ChunkData{ChunkData: chunk},
+		})).To(Succeed())
+	}
+
+	resp, err := stream.CloseAndRecv()
+	Expect(err).NotTo(HaveOccurred())
+
+	return resp.GetId()
+}
+
+var _ = Describe("Video", func() {
+	var (
+		ctx     context.Context
+		videoID string
+	)
+
+	BeforeEach(func() {
+		ctx = newTenantContext()
+		videoID = uploadVideo(ctx, []byte("integration test video"))
+	})
+
+	It("transcodes the uploaded video into the variants through the events", func() {
+		// each variant takes 3 seconds of the mocked transcoding, and the variants of the videos
+		// uploaded by the other tests are queued on the same partition
+		Eventually(func() map[string]string {
+			resp, err := videoClient.GetVideo(ctx, &videopb.GetVideoRequest{Id: videoID})
+			Expect(err).NotTo(HaveOccurred())
+
+			return resp.GetVideo().GetVariants()
+		}, 2*time.Minute, time.Second).Should(HaveLen(4))
+	})
+
+	It("lists the videos of the tenant only", func() {
+		resp, err := videoClient.ListVideo(ctx, &videopb.ListVideoRequest{PageSize: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetVideos()).To(HaveLen(1))
+		Expect(resp.GetVideos()[0].GetId()).To(Equal(videoID))
+
+		_, err = videoClient.GetVideo(newTenantContext(), &videopb.GetVideoRequest{Id: videoID})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("deletes the comments of the deleted video", func() {
+		_, err := commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: videoID, Content: "first"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = videoClient.DeleteVideo(ctx, &videopb.DeleteVideoRequest{Id: videoID})
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := commentClient.ListComment(ctx, &commentpb.ListCommentRequest{VideoId: videoID, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetComments()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,238 @@
+// Command gen generates the code of the protos and the mocks by the plugins of the versions pinned by the go.mod of
+// the tools module, so every contributor and the CI generate the same files. It generates the tree of the working
+// directory, so it is built in the tools module and run from the root of the repository, e.g.
+//
+//	(cd tools && go build -mod=mod -o ../bin/gen ./gen) && bin/gen --targets comment
+//
+// With --check it fails if the generated files of the tree are stale, i.e. they differ once generated again,
+// which the CI runs on a clean checkout since the tree is generated in place.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/genkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"go.uber.org/zap"
+)
+
+type GenArgs struct {
+	Targets       []string `long:"targets" env:"TARGETS" env-delim:"," default:"pkg" default:"video" default:"comment" description:"the targets generated, pkg generates the protos of pkg/pb and runs the go:generate of pkg, and a module generates its protos and runs its go:generate"`
+	Check         bool     `long:"check" env:"CHECK" description:"fail if the generated files differ from the ones of the tree, the tree is generated in place"`
+	ProtocVersion string   `long:"protoc_version" env:"PROTOC_VERSION" default:"3.19.3" description:"the version of protoc, the one of the generate image of docker-compose"`
+	BinDir        string   `long:"bin_dir" env:"BIN_DIR" default:"bin" description:"the directory the plugins are built into"`
+
+	logkit.LoggerConfig `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+}
+
+// toolsDir is the directory of the tools module, the plugins are built there, and its go.sum is completed by -mod=mod
+// like the one of the integration tests
+const toolsDir = "tools"
+
+// plugins are built from the modules of tools/tools.go, so their versions are the ones of the go.mod of toolsDir
+var plugins = []struct {
+	name string
+	pkg  string
+}{
+	{name: "protoc-gen-go", pkg: "google.golang.org/protobuf/cmd/protoc-gen-go"},
+	{name: "protoc-gen-go-grpc", pkg: "google.golang.org/grpc/cmd/protoc-gen-go-grpc"},
+	{name: "protoc-gen-grpc-gateway", pkg: "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway"},
+	{name: "protoc-gen-grpc-sarama", pkg: "github.com/justin0u0/protoc-gen-grpc-sarama"},
+	{name: "protoc-gen-validate", pkg: "github.com/envoyproxy/protoc-gen-validate"},
+	{name: "mockgen", pkg: "github.com/golang/mock/mockgen"},
+}
+
+// pkgProtos are the protos of pkg/pb generated by the pkg target, the others there are the imported third-party ones
+var pkgProtos = []string{"authz/authz.proto", "log/log.proto"}
+
+// skipDirs are not walked for the generated files
+var skipDirs = []string{".git", ".cache", "bin"}
+
+func main() {
+	var args GenArgs
+	_ = configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx := logger.WithContext(context.Background())
+
+	binDir, err := filepath.Abs(args.BinDir)
+	if err != nil {
+		logger.Fatal("failed to resolve bin dir", zap.Error(err))
+	}
+
+	if err := checkProtoc(ctx, args.ProtocVersion); err != nil {
+		logger.Fatal("failed to check protoc version, generate by make dc.generate", zap.Error(err), zap.String("expected", args.ProtocVersion))
+	}
+
+	var prev genkit.Snapshot
+	if args.Check {
+		if prev, err = genkit.TakeSnapshot(".", skipDirs...); err != nil {
+			logger.Fatal("failed to take snapshot", zap.Error(err))
+		}
+	}
+
+	for _, plugin := range plugins {
+		if err := run(ctx, toolsDir, nil, "go", "build", "-mod=mod", "-o", filepath.Join(binDir, plugin.name), plugin.pkg); err != nil {
+			logger.Fatal("failed to build plugin", zap.Error(err), zap.String("plugin", plugin.name))
+		}
+	}
+
+	for _, target := range args.Targets {
+		if err := generate(ctx, binDir, target); err != nil {
+			logger.Fatal("failed to generate target", zap.Error(err), zap.String("target", target))
+		}
+
+		logger.Info("generate target successfully", zap.String("target", target))
+	}
+
+	if !args.Check {
+		return
+	}
+
+	next, err := genkit.TakeSnapshot(".", skipDirs...)
+	if err != nil {
+		logger.Fatal("failed to take snapshot", zap.Error(err))
+	}
+
+	changes := prev.Diff(next)
+	for _, change := range changes {
+		logger.Error("generated file is stale", zap.String("path", change.Path), zap.String("change", string(change.Kind)))
+	}
+	if len(changes) > 0 {
+		logger.Fatal("failed to check generated files, run make dc.generate and commit them", zap.Int("stale", len(changes)))
+	}
+
+	logger.Info("generated files are up to date")
+}
+
+// checkProtoc returns an error unless protoc is of the version, as the other versions generate other files.
+func checkProtoc(ctx context.Context, version string) error {
+	out, err := exec.CommandContext(ctx, "protoc", "--version").Output()
+	if err != nil {
+		return err
+	}
+
+	if actual := strings.TrimSpace(string(out)); actual != "libprotoc "+version {
+		return fmt.Errorf("protoc is %s", actual)
+	}
+
+	return nil
+}
+
+// generate generates the protos of the module of the target, or the ones of pkg/pb for pkg, then runs
+// the go:generate of the target.
+func generate(ctx context.Context, binDir, target string) error {
+	dir := "./pkg"
+	if target == "pkg" {
+		protocArgs := []string{
+			"-I", "./pkg/pb",
+			"--plugin=protoc-gen-go=" + filepath.Join(binDir, "protoc-gen-go"),
+			"--go_out=paths=source_relative:./pkg/pb",
+		}
+
+		if err := run(ctx, "", nil, "protoc", append(protocArgs, pkgProtos...)...); err != nil {
+			return err
+		}
+	} else {
+		dir = "./modules/" + target
+
+		protos, err := findProtos(filepath.Join(dir, "pb"))
+		if err != nil {
+			return err
+		}
+
+		saramaDir, err := output(ctx, toolsDir, "go", "list", "-mod=mod", "-f", "{{ .Dir }}", "github.com/justin0u0/protoc-gen-grpc-sarama/proto")
+		if err != nil {
+			return err
+		}
+
+		protocArgs := []string{
+			"-I", ".",
+			"-I", "./pkg/pb",
+			"-I", filepath.Dir(saramaDir),
+		}
+		for _, plugin := range plugins {
+			if strings.HasPrefix(plugin.name, "protoc-gen-") {
+				protocArgs = append(protocArgs, "--plugin="+plugin.name+"="+filepath.Join(binDir, plugin.name))
+			}
+		}
+		protocArgs = append(protocArgs,
+			"--go_out=paths=source_relative:.",
+			"--go-grpc_out=paths=source_relative:.",
+			"--grpc-gateway_out=paths=source_relative:.",
+			"--grpc-sarama_out=paths=source_relative:.",
+			"--validate_out=lang=go,paths=source_relative:.",
+		)
+
+		if err := run(ctx, "", nil, "protoc", append(protocArgs, protos...)...); err != nil {
+			return err
+		}
+	}
+
+	// mockgen of the go:generate directives is looked up in the bin dir first
+	env := []string{"PATH=" + binDir + string(os.PathListSeparator) + os.Getenv("PATH")}
+
+	return run(ctx, "", env, "go", "generate", dir+"/...")
+}
+
+// findProtos returns the protos under the dir, an error if there is none, e.g. the target is not a module.
+func findProtos(dir string) ([]string, error) {
+	var protos []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() && filepath.Ext(path) == ".proto" {
+			protos = append(protos, "./"+filepath.ToSlash(path))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(protos) == 0 {
+		return nil, fmt.Errorf("no protos under %s: %w", dir, os.ErrNotExist)
+	}
+
+	return protos, nil
+}
+
+// run runs the command in the dir, the working directory if empty, with the env added, the output of the command goes
+// to the stderr.
+func run(ctx context.Context, dir string, env []string, name string, args ...string) error {
+	logkit.FromContext(ctx).Debug("run command", zap.String("command", name), zap.Strings("args", args))
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// output runs the command in the dir and returns its output trimmed.
+func output(ctx context.Context, dir, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,19 @@
+// The tools are a module of their own, so the protoc plugins and mockgen pinned by tools.go and the dependencies of
+// their commands are not required by the services.
+module github.com/NTHU-LSALAB/NTHU-Distributed-System/tools
+
+go 1.17
+
+require (
+	github.com/NTHU-LSALAB/NTHU-Distributed-System v0.0.0
+	github.com/envoyproxy/protoc-gen-validate v0.6.7
+	github.com/golang/mock v1.6.0
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.10.0
+	github.com/justin0u0/protoc-gen-grpc-sarama v0.0.1
+	go.uber.org/zap v1.21.0
+	google.golang.org/grpc v1.46.2
+	google.golang.org/grpc/cmd/protoc-gen-go-grpc v1.2.0
+	google.golang.org/protobuf v1.28.0
+)
+
+replace github.com/NTHU-LSALAB/NTHU-Distributed-System => ..
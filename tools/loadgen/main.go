@@ -0,0 +1,215 @@
+// Command loadgen drives a mix of comment and video RPCs against a deployment at the rates of a profile,
+// and reports the latency percentiles and the status codes of each RPC.
+//
+// The requests are sent open-loop, at the arrival times of the profile whatever the latency of the deployment,
+// so a slow deployment shows as high latency instead of a lower rate, e.g.
+//
+//	cd tools && go run -mod=mod ./loadgen --profile 30s@10-200,5m@200 --mix list_comment:70 --mix get_video:20 --mix create_comment:10 \
+//		--comment.server_addr comment-api:8081 --video.server_addr video-api:8081
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	commentpb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/comment/pb/v1"
+	videopb "github.com/NTHU-LSALAB/NTHU-Distributed-System/modules/video/pb"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/configkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/grpckit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/loadkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/logkit"
+	"github.com/NTHU-LSALAB/NTHU-Distributed-System/pkg/tenantkit"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type LoadgenArgs struct {
+	Profile     string         `long:"profile" env:"PROFILE" default:"1m@10" description:"the stages of the request rate, as duration@rate or duration@from-to ramping linearly, e.g. 30s@10-100,5m@100"`
+	Poisson     bool           `long:"poisson" env:"POISSON" description:"send the requests at the random times of a Poisson process instead of at even intervals"`
+	Mix         map[string]int `long:"mix" env:"MIX" env-delim:"," default:"list_comment:70" default:"get_video:20" default:"create_comment:10" description:"the weights of the RPCs, as rpc:weight pairs, of list_comment, create_comment, get_video and list_video"`
+	MaxInFlight int            `long:"max_in_flight" env:"MAX_IN_FLIGHT" default:"1000" description:"the most requests in flight, the requests arriving beyond it are dropped and reported"`
+	Timeout     time.Duration  `long:"timeout" env:"TIMEOUT" default:"10s" description:"the timeout of each request"`
+	Seed        int64          `long:"seed" env:"SEED" description:"the seed of the arrivals and the RPCs picked, random if zero"`
+	TenantID    string         `long:"tenant_id" env:"TENANT_ID" default:"default" description:"the tenant the requests are sent as"`
+	VideoIDs    []string       `long:"video_ids" env:"VIDEO_IDS" env-delim:"," description:"the videos the requests are about, the listed videos if empty"`
+
+	Comment grpckit.GrpcClientConnConfig `group:"comment" namespace:"comment" env-namespace:"COMMENT"`
+	Video   grpckit.GrpcClientConnConfig `group:"video" namespace:"video" env-namespace:"VIDEO"`
+
+	logkit.LoggerConfig `group:"logger" namespace:"logger" env-namespace:"LOGGER"`
+}
+
+func main() {
+	var args LoadgenArgs
+	_ = configkit.Load(&args)
+
+	logger := logkit.NewLogger(&args.LoggerConfig)
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ctx = tenantkit.WithTenantID(logger.WithContext(ctx), args.TenantID)
+
+	profile, err := loadkit.ParseProfile(args.Profile)
+	if err != nil {
+		logger.Fatal("failed to parse profile", zap.Error(err))
+	}
+
+	mix, err := loadkit.NewMix(args.Mix)
+	if err != nil {
+		logger.Fatal("failed to parse mix", zap.Error(err))
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(tenantkit.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenantkit.StreamClientInterceptor()),
+	}
+
+	commentConn := grpckit.NewGrpcClientConn(ctx, &args.Comment, dialOpts...)
+	defer func() {
+		_ = commentConn.Close()
+	}()
+
+	videoConn := grpckit.NewGrpcClientConn(ctx, &args.Video, dialOpts...)
+	defer func() {
+		_ = videoConn.Close()
+	}()
+
+	commentClient := commentpb.NewCommentClient(commentConn)
+	videoClient := videopb.NewVideoClient(videoConn)
+
+	videoIDs := args.VideoIDs
+	if len(videoIDs) == 0 {
+		videoIDs = listVideoIDs(ctx, videoClient, logger)
+	}
+
+	seed := args.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	runnerOpts := []loadkit.RunnerOption{
+		loadkit.WithSeed(seed),
+		loadkit.WithMaxInFlight(args.MaxInFlight),
+		loadkit.WithTimeout(args.Timeout),
+	}
+	if args.Poisson {
+		runnerOpts = append(runnerOpts, loadkit.WithPoissonArrivals())
+	}
+
+	runner, err := loadkit.NewRunner(profile, mix, newOps(commentClient, videoClient, videoIDs, seed), runnerOpts...)
+	if err != nil {
+		logger.Fatal("failed to create runner", zap.Error(err))
+	}
+
+	logger.Info("start load",
+		zap.String("profile", args.Profile),
+		zap.Duration("duration", profile.Duration()),
+		zap.Strings("ops", mix.Ops()),
+		zap.Int("videos", len(videoIDs)),
+		zap.Int64("seed", seed),
+	)
+
+	start := time.Now()
+	recorder := runner.Run(ctx)
+
+	printReport(recorder.Report(), time.Since(start))
+}
+
+// listVideoIDs lists the videos of the tenant to send the requests about.
+func listVideoIDs(ctx context.Context, videoClient videopb.VideoClient, logger *logkit.Logger) []string {
+	resp, err := videoClient.ListVideo(ctx, &videopb.ListVideoRequest{PageSize: 100})
+	if err != nil {
+		logger.Fatal("failed to list videos", zap.Error(err))
+	}
+
+	if len(resp.GetVideos()) == 0 {
+		logger.Fatal("failed to find videos to send requests about")
+	}
+
+	videoIDs := make([]string, 0, len(resp.GetVideos()))
+	for _, video := range resp.GetVideos() {
+		videoIDs = append(videoIDs, video.GetId())
+	}
+
+	return videoIDs
+}
+
+// newOps returns the RPCs of the mix, each about a video picked at random.
+func newOps(commentClient commentpb.CommentClient, videoClient videopb.VideoClient, videoIDs []string, seed int64) map[string]loadkit.Op {
+	pickVideoID := newVideoPicker(videoIDs, seed)
+
+	return map[string]loadkit.Op{
+		"list_comment": func(ctx context.Context) error {
+			_, err := commentClient.ListComment(ctx, &commentpb.ListCommentRequest{VideoId: pickVideoID(), Limit: 10})
+			return err
+		},
+		"create_comment": func(ctx context.Context) error {
+			_, err := commentClient.CreateComment(ctx, &commentpb.CreateCommentRequest{VideoId: pickVideoID(), Content: "load test"})
+			return err
+		},
+		"get_video": func(ctx context.Context) error {
+			_, err := videoClient.GetVideo(ctx, &videopb.GetVideoRequest{Id: pickVideoID()})
+			return err
+		},
+		"list_video": func(ctx context.Context) error {
+			_, err := videoClient.ListVideo(ctx, &videopb.ListVideoRequest{PageSize: 10})
+			return err
+		},
+	}
+}
+
+// newVideoPicker returns a function picking a video at random, safe for the ops running concurrently.
+func newVideoPicker(videoIDs []string, seed int64) func() string {
+	var mu sync.Mutex
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	return func() string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return videoIDs[rnd.Intn(len(videoIDs))]
+	}
+}
+
+func printReport(reports []*loadkit.OpReport, elapsed time.Duration) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "rpc\tcount\trps\tdropped\terrors\tp50\tp90\tp99\tp99.9\tmax\tcodes\t")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			r.Op, r.Count, float64(r.Count)/elapsed.Seconds(), r.Dropped, r.Errors(),
+			r.P50, r.P90, r.P99, r.P999, r.Max, formatCodes(r),
+		)
+	}
+}
+
+// formatCodes formats the status codes of the report in order, e.g. OK:98 Unavailable:2.
+func formatCodes(r *loadkit.OpReport) string {
+	statusCodes := make([]codes.Code, 0, len(r.Codes))
+	for code := range r.Codes {
+		statusCodes = append(statusCodes, code)
+	}
+	sort.Slice(statusCodes, func(i, j int) bool { return statusCodes[i] < statusCodes[j] })
+
+	parts := make([]string, 0, len(statusCodes))
+	for _, code := range statusCodes {
+		parts = append(parts, fmt.Sprintf("%s:%d", code, r.Codes[code]))
+	}
+
+	return strings.Join(parts, " ")
+}
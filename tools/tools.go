@@ -3,6 +3,7 @@
 package tools
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate"
 	_ "github.com/golang/mock/mockgen"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway"
 	_ "github.com/justin0u0/protoc-gen-grpc-sarama"